@@ -52,7 +52,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
-	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
 	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
@@ -142,6 +141,7 @@ import (
 	cwerrorsTypes "github.com/archway-network/archway/x/cwerrors/types"
 
 	"github.com/archway-network/archway/x/rewards"
+	rewardsAnte "github.com/archway-network/archway/x/rewards/ante"
 	rewardsKeeper "github.com/archway-network/archway/x/rewards/keeper"
 	"github.com/archway-network/archway/x/rewards/mintbankkeeper"
 	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
@@ -612,13 +612,17 @@ func NewArchwayApp(
 	// Setting gas recorder here to avoid cyclic loop
 	trackingWasmVm.SetGasRecorder(app.Keepers.TrackingKeeper)
 
+	// x/rewards only invokes contracts via the Sudo entrypoint (the rewards callbacks)
+	var rewardsContractSudoer rewardsKeeper.ContractSudoerExpected = app.Keepers.WASMKeeper
 	app.Keepers.RewardsKeeper = rewardsKeeper.NewKeeper(
 		appCodec,
 		keys[rewardsTypes.StoreKey],
 		app.Keepers.WASMKeeper,
+		rewardsContractSudoer,
 		app.Keepers.TrackingKeeper,
 		app.Keepers.AccountKeeper,
 		app.Keepers.BankKeeper,
+		app.Keepers.TransferKeeper,
 		govModuleAddr,
 		logger,
 	)
@@ -809,6 +813,7 @@ func NewArchwayApp(
 		// wasm
 		ibchookstypes.ModuleName,
 		wasmdTypes.ModuleName,
+		rewardsTypes.ModuleName,
 	)
 
 	app.ModuleManager.SetOrderEndBlockers(
@@ -944,6 +949,8 @@ func NewArchwayApp(
 				FeegrantKeeper:  app.Keepers.FeeGrantKeeper,
 				SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
 				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+				// only the x/rewards dynamic fee and flat fee tip extension options are accepted
+				ExtensionOptionChecker: rewardsAnte.RewardsExtensionOptionChecker,
 			},
 			IBCKeeper:             app.Keepers.IBCKeeper,
 			WasmConfig:            &wasmConfig,
@@ -957,8 +964,11 @@ func NewArchwayApp(
 	if err != nil {
 		panic(fmt.Errorf("failed to create AnteHandler: %s", err))
 	}
-	postHandler, err := posthandler.NewPostHandler(
-		posthandler.HandlerOptions{},
+	postHandler, err := NewPostHandler(
+		PostHandlerOptions{
+			RewardsPostBankKeeper: app.Keepers.BankKeeper,
+			RewardsKeeper:         app.Keepers.RewardsKeeper,
+		},
 	)
 	if err != nil {
 		panic(fmt.Errorf("failed to create PostHandler: %s", err))
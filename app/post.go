@@ -0,0 +1,33 @@
+// DONTCOVER
+package app
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	rewardsPost "github.com/archway-network/archway/x/rewards/post"
+)
+
+// PostHandlerOptions are the options required for constructing the app PostHandler.
+type PostHandlerOptions struct {
+	RewardsPostBankKeeper rewardsPost.BankKeeper
+	RewardsKeeper         rewardsPost.RewardsKeeperExpected
+}
+
+func NewPostHandler(options PostHandlerOptions) (sdk.PostHandler, error) {
+	if options.RewardsPostBankKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "rewards bank keeper is required for PostHandler")
+	}
+	if options.RewardsKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "rewards keeper is required for PostHandler")
+	}
+
+	postDecorators := []sdk.PostDecorator{
+		rewardsPost.NewFeeRefundDecorator(options.RewardsPostBankKeeper, options.RewardsKeeper),
+		rewardsPost.NewDeferredFlatFeeDecorator(options.RewardsPostBankKeeper, options.RewardsKeeper),
+		rewardsPost.NewFeeMetricsDecorator(),
+	}
+
+	return sdk.ChainPostDecorators(postDecorators...), nil
+}
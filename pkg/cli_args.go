@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -37,6 +38,16 @@ func ParseInt64Arg(argName, argValue string) (int64, error) {
 	return v, nil
 }
 
+// ParseDecArg is a helper function to parse math.LegacyDec CLI argument.
+func ParseDecArg(argName, argValue string) (math.LegacyDec, error) {
+	v, err := math.LegacyNewDecFromStr(argValue)
+	if err != nil {
+		return math.LegacyDec{}, fmt.Errorf("parsing %s argument: invalid math.LegacyDec value: %w", argName, err)
+	}
+
+	return v, nil
+}
+
 // ParseCoinArg is a helper function to parse uint64 CLI argument.
 func ParseCoinArg(argName, argValue string) (sdk.Coin, error) {
 	deposit, err := sdk.ParseCoinNormalized(argValue)
@@ -45,3 +56,12 @@ func ParseCoinArg(argName, argValue string) (sdk.Coin, error) {
 	}
 	return deposit, nil
 }
+
+// ParseCoinsArg is a helper function to parse sdk.Coins CLI argument.
+func ParseCoinsArg(argName, argValue string) (sdk.Coins, error) {
+	coins, err := sdk.ParseCoinsNormalized(argValue)
+	if err != nil {
+		return coins, fmt.Errorf("parsing %s argument: invalid sdk.Coins value: %w", argName, err)
+	}
+	return coins, nil
+}
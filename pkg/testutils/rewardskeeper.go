@@ -43,6 +43,7 @@ func RewardsKeeper(tb testing.TB) (keeper.Keeper, sdk.Context, MockBankKeeper) {
 				Txs: nil,
 			}
 		},
+		RemoveBlockTrackingInfoFn: func(ctx sdk.Context, height int64) {},
 	}
 	bankKeeper := MockBankKeeper{
 		BlockedAddrFn: func(addr sdk.AccAddress) bool { // everyaddress except distribution module address is blocked
@@ -61,14 +62,19 @@ func RewardsKeeper(tb testing.TB) (keeper.Keeper, sdk.Context, MockBankKeeper) {
 				Address: "cosmos150j9auccvjdsquttx0qhawvux2m67fcxw49hy9",
 			}
 		},
+		GetAccountFn: func(ctx context.Context, addr sdk.AccAddress) sdk.AccountI {
+			return nil
+		},
 	}
 	k := keeper.NewKeeper(
 		cdc,
 		storeKey,
 		nil,
+		nil,
 		trackingKeeper,
 		authKeeper,
 		bankKeeper,
+		nil,
 		"cosmos1a48wdtjn3egw7swhfkeshwdtjvs6hq9nlyrwut", // random addr for gov module
 		log.NewTestLogger(tb),
 	)
@@ -0,0 +1,29 @@
+package testutils
+
+import (
+	"context"
+
+	cmtBytes "github.com/cometbft/cometbft/libs/bytes"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibcTransferTypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+// MockTransferKeeper mocks x/rewards dependency on the IBC transfer keeper.
+type MockTransferKeeper struct {
+	GetDenomTraceFn func(ctx sdk.Context, denomTraceHash cmtBytes.HexBytes) (ibcTransferTypes.DenomTrace, bool)
+	TransferFn      func(ctx context.Context, msg *ibcTransferTypes.MsgTransfer) (*ibcTransferTypes.MsgTransferResponse, error)
+}
+
+func (k MockTransferKeeper) GetDenomTrace(ctx sdk.Context, denomTraceHash cmtBytes.HexBytes) (ibcTransferTypes.DenomTrace, bool) {
+	if k.GetDenomTraceFn == nil {
+		panic("not supposed to be called!")
+	}
+	return k.GetDenomTraceFn(ctx, denomTraceHash)
+}
+
+func (k MockTransferKeeper) Transfer(ctx context.Context, msg *ibcTransferTypes.MsgTransfer) (*ibcTransferTypes.MsgTransferResponse, error) {
+	if k.TransferFn == nil {
+		panic("not supposed to be called!")
+	}
+	return k.TransferFn(ctx, msg)
+}
@@ -1,7 +1,9 @@
 package testutils
 
 import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	txTypes "github.com/cosmos/cosmos-sdk/types/tx"
 	proto "google.golang.org/protobuf/proto"
 )
 
@@ -14,6 +16,11 @@ type MockFeeTx struct {
 	msgs       []sdk.Msg
 	feePayer   []byte
 	feeGranter []byte
+	signers    [][]byte
+	extOptions []*codectypes.Any
+	gasLimit   uint64 // gas estimation fields gas limit
+	authGas    uint64 // proto tx auth info fee gas limit
+	msgsGas    []uint64
 }
 
 type MockFeeTxOption func(tx *MockFeeTx)
@@ -39,6 +46,22 @@ func WithMockFeeTxPayer(payer sdk.AccAddress) MockFeeTxOption {
 	}
 }
 
+// WithMockFeeTxGranter option sets the feeGranter of the MockFeeTx.
+func WithMockFeeTxGranter(granter sdk.AccAddress) MockFeeTxOption {
+	return func(tx *MockFeeTx) {
+		tx.feeGranter = granter
+	}
+}
+
+// WithMockFeeTxSigners option sets the signers of the MockFeeTx (GetSigners).
+func WithMockFeeTxSigners(signers ...sdk.AccAddress) MockFeeTxOption {
+	return func(tx *MockFeeTx) {
+		for _, signer := range signers {
+			tx.signers = append(tx.signers, signer)
+		}
+	}
+}
+
 // WithMockFeeTxGas option sets the gas limit of the MockFeeTx.
 func WithMockFeeTxGas(gas uint64) MockFeeTxOption {
 	return func(tx *MockFeeTx) {
@@ -46,6 +69,34 @@ func WithMockFeeTxGas(gas uint64) MockFeeTxOption {
 	}
 }
 
+// WithMockFeeTxGasLimit option sets the gas estimation fields gas limit of the MockFeeTx (GetGasLimit).
+func WithMockFeeTxGasLimit(gas uint64) MockFeeTxOption {
+	return func(tx *MockFeeTx) {
+		tx.gasLimit = gas
+	}
+}
+
+// WithMockFeeTxAuthInfoGas option sets the proto tx auth info fee gas limit of the MockFeeTx.
+func WithMockFeeTxAuthInfoGas(gas uint64) MockFeeTxOption {
+	return func(tx *MockFeeTx) {
+		tx.authGas = gas
+	}
+}
+
+// WithMockFeeTxMsgsGas option sets the per-msg gas declared by the MockFeeTx (GetMsgsGas).
+func WithMockFeeTxMsgsGas(gas ...uint64) MockFeeTxOption {
+	return func(tx *MockFeeTx) {
+		tx.msgsGas = gas
+	}
+}
+
+// WithMockFeeTxExtensionOptions option sets the extension options of the MockFeeTx.
+func WithMockFeeTxExtensionOptions(opts ...*codectypes.Any) MockFeeTxOption {
+	return func(tx *MockFeeTx) {
+		tx.extOptions = opts
+	}
+}
+
 // NewMockFeeTx creates a new MockFeeTx instance.
 // CONTRACT: tx has no defaults, so it is up to a developer to set options right.
 func NewMockFeeTx(opts ...MockFeeTxOption) MockFeeTx {
@@ -77,6 +128,16 @@ func (tx MockFeeTx) GetGas() uint64 {
 	return tx.gas
 }
 
+// GetGasLimit returns the gas estimation fields gas limit.
+func (tx MockFeeTx) GetGasLimit() uint64 {
+	return tx.gasLimit
+}
+
+// GetMsgsGas returns the per-msg gas declared.
+func (tx MockFeeTx) GetMsgsGas() []uint64 {
+	return tx.msgsGas
+}
+
 // GetFee implements the sdk.FeeTx interface.
 func (tx MockFeeTx) GetFee() sdk.Coins {
 	return tx.fees
@@ -91,3 +152,30 @@ func (tx MockFeeTx) FeePayer() []byte {
 func (tx MockFeeTx) FeeGranter() []byte {
 	return tx.feeGranter
 }
+
+// GetSigners returns the tx signers (the x/auth/tx wrapper method).
+func (tx MockFeeTx) GetSigners() ([][]byte, error) {
+	return tx.signers, nil
+}
+
+// GetExtensionOptions implements the ante.HasExtensionOptionsTx interface.
+func (tx MockFeeTx) GetExtensionOptions() []*codectypes.Any {
+	return tx.extOptions
+}
+
+// GetNonCriticalExtensionOptions implements the ante.HasExtensionOptionsTx interface.
+func (tx MockFeeTx) GetNonCriticalExtensionOptions() []*codectypes.Any {
+	return nil
+}
+
+// GetProtoTx returns a proto tx with the auth info fee gas limit set only (the x/auth/tx wrapper method).
+func (tx MockFeeTx) GetProtoTx() *txTypes.Tx {
+	authInfo := &txTypes.AuthInfo{}
+	if tx.authGas > 0 {
+		authInfo.Fee = &txTypes.Fee{GasLimit: tx.authGas}
+	}
+
+	return &txTypes.Tx{
+		AuthInfo: authInfo,
+	}
+}
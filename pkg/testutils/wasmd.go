@@ -14,15 +14,17 @@ var _ wasmKeeper.Messenger = (*MockMessenger)(nil)
 // MockContractViewer mocks x/wasmd module dependency.
 // Mock returns a contract info if admin is set.
 type MockContractViewer struct {
-	contractAdminSet map[string]string // key: contractAddr, value: adminAddr
-	returnSudoError  error
+	contractAdminSet  map[string]string // key: contractAddr, value: adminAddr
+	contractCodeIDSet map[string]uint64 // key: contractAddr, value: codeID
+	returnSudoError   error
 }
 
 // NewMockContractViewer creates a new MockContractViewer instance.
 func NewMockContractViewer() *MockContractViewer {
 	return &MockContractViewer{
-		contractAdminSet: make(map[string]string),
-		returnSudoError:  nil,
+		contractAdminSet:  make(map[string]string),
+		contractCodeIDSet: make(map[string]uint64),
+		returnSudoError:   nil,
 	}
 }
 
@@ -31,6 +33,11 @@ func (v *MockContractViewer) AddContractAdmin(contractAddr, adminAddr string) {
 	v.contractAdminSet[contractAddr] = adminAddr
 }
 
+// SetContractCodeID sets a contract code ID (contract admin must be added to be found).
+func (v *MockContractViewer) SetContractCodeID(contractAddr string, codeID uint64) {
+	v.contractCodeIDSet[contractAddr] = codeID
+}
+
 // GetContractInfo returns a contract info if admin is set.
 func (v MockContractViewer) GetContractInfo(ctx context.Context, contractAddress sdk.AccAddress) *wasmdTypes.ContractInfo {
 	adminAddr, found := v.contractAdminSet[contractAddress.String()]
@@ -39,7 +46,8 @@ func (v MockContractViewer) GetContractInfo(ctx context.Context, contractAddress
 	}
 
 	return &wasmdTypes.ContractInfo{
-		Admin: adminAddr,
+		CodeID: v.contractCodeIDSet[contractAddress.String()],
+		Admin:  adminAddr,
 	}
 }
 
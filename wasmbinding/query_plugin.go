@@ -52,6 +52,10 @@ func (d QueryDispatcher) DispatchQuery(ctx sdk.Context, request json.RawMessage)
 		resData, resErr = d.govHandler.GetVote(ctx, *req.GovVote)
 	case req.FlatFee != nil:
 		resData, resErr = d.rewardsHandler.GetFlatFee(ctx, *req.FlatFee)
+	case req.ContractRewards != nil:
+		resData, resErr = d.rewardsHandler.GetContractRewards(ctx, *req.ContractRewards)
+	case req.MinConsensusFee != nil:
+		resData, resErr = d.rewardsHandler.GetMinConsensusFee(ctx, *req.MinConsensusFee)
 	default:
 		// That should never happen, since we validate the input above
 		return nil, wasmVmTypes.UnsupportedRequest{Kind: "no custom querier found"}
@@ -1,5 +1,24 @@
 package rewards_test
 
+import (
+	"encoding/json"
+	"testing"
+
+	math "cosmossdk.io/math"
+	wasmVmTypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	mintTypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+
+	e2eTesting "github.com/archway-network/archway/e2e/testing"
+	"github.com/archway-network/archway/pkg/testutils"
+	"github.com/archway-network/archway/wasmbinding"
+	"github.com/archway-network/archway/wasmbinding/pkg"
+	rewardsWbTypes "github.com/archway-network/archway/wasmbinding/rewards/types"
+	extendedGov "github.com/archway-network/archway/x/gov"
+	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
+)
+
 // import (
 // 	"encoding/json"
 // 	"testing"
@@ -440,3 +459,304 @@ package rewards_test
 // 		assert.Equal(t, recordsRewards.String(), chain.GetBalance(contractAddr).String())
 // 	})
 // }
+
+// TestRewardsWASMBindingsContractRewards tests the contract rewards custom query.
+func TestRewardsWASMBindingsContractRewards(t *testing.T) {
+	chain := e2eTesting.NewTestChain(t, 1)
+	acc := chain.GetAccount(0)
+	contractAddrs := e2eTesting.GenContractAddresses(2)
+	contractAddr, otherContractAddr := contractAddrs[0], contractAddrs[1]
+
+	keepers := chain.GetApp().Keepers
+	ctx, keeper := chain.GetContext(), keepers.RewardsKeeper
+	queryPlugin := wasmbinding.BuildWasmQueryPlugin(keeper, extendedGov.NewKeeper(keepers.GovKeeper))
+
+	require.NoError(t, keeper.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+		ContractAddress: contractAddr.String(),
+		OwnerAddress:    acc.Address.String(),
+		RewardsAddress:  acc.Address.String(),
+	}))
+
+	// Rewards credited by the contract to its rewards address
+	outstandingRewards := sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("uarch", 10))
+	_, err := keeper.CreateRewardsRecord(ctx, acc.Address, contractAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 60)), ctx.BlockHeight(), ctx.BlockTime())
+	require.NoError(t, err)
+	_, err = keeper.CreateRewardsRecord(ctx, acc.Address, contractAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 40), sdk.NewInt64Coin("uarch", 10)), ctx.BlockHeight(), ctx.BlockTime())
+	require.NoError(t, err)
+	// Rewards credited by another contract to the same rewards address (not counted)
+	_, err = keeper.CreateRewardsRecord(ctx, acc.Address, otherContractAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), ctx.BlockHeight(), ctx.BlockTime())
+	require.NoError(t, err)
+	// Rewards credited to the contract address by another contract
+	withdrawableRewards := sdk.NewCoins(sdk.NewInt64Coin("stake", 30))
+	_, err = keeper.CreateRewardsRecord(ctx, contractAddr, otherContractAddr, withdrawableRewards, ctx.BlockHeight(), ctx.BlockTime())
+	require.NoError(t, err)
+
+	queryContractRewards := func(t *testing.T, req rewardsWbTypes.ContractRewardsRequest) (rewardsWbTypes.ContractRewardsResponse, error) {
+		queryBz, err := json.Marshal(map[string]rewardsWbTypes.ContractRewardsRequest{
+			"contract_rewards": req,
+		})
+		require.NoError(t, err)
+
+		resBz, err := queryPlugin.Custom(ctx, queryBz)
+		if err != nil {
+			return rewardsWbTypes.ContractRewardsResponse{}, err
+		}
+
+		var res rewardsWbTypes.ContractRewardsResponse
+		require.NoError(t, json.Unmarshal(resBz, &res))
+
+		return res, nil
+	}
+
+	t.Run("Fail: invalid contract address", func(t *testing.T) {
+		_, err := queryContractRewards(t, rewardsWbTypes.ContractRewardsRequest{ContractAddress: "invalid"})
+		require.ErrorContains(t, err, "contractAddress: parsing")
+	})
+
+	t.Run("Fail: records limit exceeded", func(t *testing.T) {
+		_, err := queryContractRewards(t, rewardsWbTypes.ContractRewardsRequest{
+			ContractAddress: contractAddr.String(),
+			Limit:           rewardsTypes.MaxRecordsQueryLimit + 1,
+		})
+		require.ErrorContains(t, err, "query limit exceeded")
+	})
+
+	t.Run("OK: contract rewards", func(t *testing.T) {
+		res, err := queryContractRewards(t, rewardsWbTypes.ContractRewardsRequest{ContractAddress: contractAddr.String()})
+		require.NoError(t, err)
+
+		outstandingRewardsReceived, err := pkg.WasmCoinsToSDK(res.OutstandingRewards)
+		require.NoError(t, err)
+		require.Equal(t, outstandingRewards.String(), outstandingRewardsReceived.String())
+
+		withdrawableRewardsReceived, err := pkg.WasmCoinsToSDK(res.WithdrawableRewards)
+		require.NoError(t, err)
+		require.Equal(t, withdrawableRewards.String(), withdrawableRewardsReceived.String())
+		require.EqualValues(t, 1, res.WithdrawableRecordsNum)
+		require.False(t, res.LimitReached)
+	})
+
+	t.Run("OK: records limit covers all the records", func(t *testing.T) {
+		res, err := queryContractRewards(t, rewardsWbTypes.ContractRewardsRequest{ContractAddress: contractAddr.String(), Limit: 3})
+		require.NoError(t, err)
+
+		outstandingRewardsReceived, err := pkg.WasmCoinsToSDK(res.OutstandingRewards)
+		require.NoError(t, err)
+		require.Equal(t, outstandingRewards.String(), outstandingRewardsReceived.String())
+		require.EqualValues(t, 1, res.WithdrawableRecordsNum)
+		require.False(t, res.LimitReached)
+	})
+
+	t.Run("OK: records limit reached", func(t *testing.T) {
+		res, err := queryContractRewards(t, rewardsWbTypes.ContractRewardsRequest{ContractAddress: contractAddr.String(), Limit: 1})
+		require.NoError(t, err)
+
+		outstandingRewardsReceived, err := pkg.WasmCoinsToSDK(res.OutstandingRewards)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 60)).String(), outstandingRewardsReceived.String())
+
+		withdrawableRewardsReceived, err := pkg.WasmCoinsToSDK(res.WithdrawableRewards)
+		require.NoError(t, err)
+		require.Equal(t, withdrawableRewards.String(), withdrawableRewardsReceived.String())
+		require.EqualValues(t, 1, res.WithdrawableRecordsNum)
+		require.True(t, res.LimitReached)
+	})
+
+	t.Run("OK: contract without metadata and rewards", func(t *testing.T) {
+		res, err := queryContractRewards(t, rewardsWbTypes.ContractRewardsRequest{ContractAddress: otherContractAddr.String()})
+		require.NoError(t, err)
+		require.Empty(t, res.OutstandingRewards)
+		require.Empty(t, res.WithdrawableRewards)
+		require.EqualValues(t, 0, res.WithdrawableRecordsNum)
+		require.False(t, res.LimitReached)
+	})
+}
+
+// TestRewardsWASMBindingsWithdrawRewards tests the contract self-withdrawal custom message.
+func TestRewardsWASMBindingsWithdrawRewards(t *testing.T) {
+	chain := e2eTesting.NewTestChain(t, 1)
+	contractAddrs := e2eTesting.GenContractAddresses(2)
+	contractAddr, otherContractAddr := contractAddrs[0], contractAddrs[1]
+
+	keepers := chain.GetApp().Keepers
+	ctx, keeper := chain.GetContext(), keepers.RewardsKeeper
+	msgPlugin := wasmbinding.BuildWasmMsgDecorator(keeper)(testutils.NewMockMessenger())
+
+	// Fund the rewards collector and create records credited to both contracts
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	require.NoError(t, keepers.BankKeeper.MintCoins(ctx, mintTypes.ModuleName, rewards.Add(rewards...)))
+	require.NoError(t, keepers.BankKeeper.SendCoinsFromModuleToModule(ctx, mintTypes.ModuleName, rewardsTypes.ContractRewardCollector, rewards.Add(rewards...)))
+
+	ownRecord, err := keeper.CreateRewardsRecord(ctx, contractAddr, contractAddr, rewards, ctx.BlockHeight(), ctx.BlockTime())
+	require.NoError(t, err)
+	otherRecord, err := keeper.CreateRewardsRecord(ctx, otherContractAddr, otherContractAddr, rewards, ctx.BlockHeight(), ctx.BlockTime())
+	require.NoError(t, err)
+
+	withdrawRewards := func(contractAddr sdk.AccAddress, req rewardsWbTypes.WithdrawRewardsRequest) ([][]byte, error) {
+		msgBz, err := json.Marshal(map[string]rewardsWbTypes.WithdrawRewardsRequest{"withdraw_rewards": req})
+		require.NoError(t, err)
+
+		_, data, err := msgPlugin.DispatchMsg(ctx, contractAddr, "", wasmVmTypes.CosmosMsg{Custom: msgBz})
+		return data, err
+	}
+
+	t.Run("Fail: cross-contract withdrawal", func(t *testing.T) {
+		_, err := withdrawRewards(contractAddr, rewardsWbTypes.WithdrawRewardsRequest{RecordIDs: []uint64{otherRecord.Id}})
+		require.ErrorIs(t, err, rewardsTypes.ErrInvalidRequest)
+		require.ErrorContains(t, err, "address mismatch")
+
+		require.True(t, keepers.BankKeeper.GetAllBalances(ctx, contractAddr).IsZero())
+		_, err = keeper.RewardsRecords.Get(ctx, otherRecord.Id)
+		require.NoError(t, err)
+	})
+
+	t.Run("OK: self-withdrawal", func(t *testing.T) {
+		data, err := withdrawRewards(contractAddr, rewardsWbTypes.WithdrawRewardsRequest{RecordIDs: []uint64{ownRecord.Id}})
+		require.NoError(t, err)
+		require.Len(t, data, 1)
+
+		var res rewardsWbTypes.WithdrawRewardsResponse
+		require.NoError(t, json.Unmarshal(data[0], &res))
+		require.EqualValues(t, 1, res.RecordsNum)
+		totalRewards, err := pkg.WasmCoinsToSDK(res.TotalRewards)
+		require.NoError(t, err)
+		require.Equal(t, rewards.String(), totalRewards.String())
+
+		require.Equal(t, rewards.String(), keepers.BankKeeper.GetAllBalances(ctx, contractAddr).String())
+		_, err = keeper.RewardsRecords.Get(ctx, ownRecord.Id)
+		require.Error(t, err)
+	})
+
+	t.Run("OK: records limit only uses own records", func(t *testing.T) {
+		data, err := withdrawRewards(contractAddr, rewardsWbTypes.WithdrawRewardsRequest{RecordsLimit: new(uint64)})
+		require.NoError(t, err)
+
+		var res rewardsWbTypes.WithdrawRewardsResponse
+		require.NoError(t, json.Unmarshal(data[0], &res))
+		require.EqualValues(t, 0, res.RecordsNum)
+
+		require.Equal(t, rewards.String(), keepers.BankKeeper.GetAllBalances(ctx, contractAddr).String())
+		require.True(t, keepers.BankKeeper.GetAllBalances(ctx, otherContractAddr).IsZero())
+	})
+}
+
+// TestRewardsWASMBindingsMinConsensusFee tests the min consensus fee custom query.
+func TestRewardsWASMBindingsMinConsensusFee(t *testing.T) {
+	chain := e2eTesting.NewTestChain(t, 1)
+
+	keepers := chain.GetApp().Keepers
+	ctx, keeper := chain.GetContext(), keepers.RewardsKeeper
+	queryPlugin := wasmbinding.BuildWasmQueryPlugin(keeper, extendedGov.NewKeeper(keepers.GovKeeper))
+
+	minConsFee := sdk.NewDecCoinFromDec("uarch", math.LegacyMustNewDecFromStr("0.012675360000000000"))
+	require.NoError(t, keeper.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+
+	queryMinConsFee := func(t *testing.T, denom string) (rewardsWbTypes.MinConsensusFeeResponse, error) {
+		queryBz, err := json.Marshal(map[string]rewardsWbTypes.MinConsensusFeeRequest{
+			"min_consensus_fee": {Denom: denom},
+		})
+		require.NoError(t, err)
+
+		resBz, err := queryPlugin.Custom(ctx, queryBz)
+		if err != nil {
+			return rewardsWbTypes.MinConsensusFeeResponse{}, err
+		}
+
+		var res rewardsWbTypes.MinConsensusFeeResponse
+		require.NoError(t, json.Unmarshal(resBz, &res))
+
+		return res, nil
+	}
+
+	t.Run("Fail: not found", func(t *testing.T) {
+		_, err := queryMinConsFee(t, "stake")
+		require.ErrorIs(t, err, rewardsTypes.ErrMinConsFeeNotFound)
+	})
+
+	t.Run("OK: current min consensus fee", func(t *testing.T) {
+		res, err := queryMinConsFee(t, "uarch")
+		require.NoError(t, err)
+
+		expected, found := keeper.GetMinConsensusFee(ctx, "uarch")
+		require.True(t, found)
+		require.Equal(t, expected.Denom, res.MinConsensusFee.Denom)
+		require.Equal(t, expected.Amount.String(), res.MinConsensusFee.Amount)
+		require.Equal(t, minConsFee.Amount.String(), res.MinConsensusFee.Amount)
+	})
+}
+
+// TestRewardsWASMBindingsSetFlatFee tests the contract flat fee custom message authorization.
+func TestRewardsWASMBindingsSetFlatFee(t *testing.T) {
+	chain := e2eTesting.NewTestChain(t, 1)
+	acc := chain.GetAccount(0)
+	contractAddrs := e2eTesting.GenContractAddresses(2)
+	contractAddr, otherContractAddr := contractAddrs[0], contractAddrs[1]
+
+	keepers := chain.GetApp().Keepers
+	ctx, keeper := chain.GetContext(), keepers.RewardsKeeper
+
+	// Flat fees can only be set for existing contracts
+	contractViewer := testutils.NewMockContractViewer()
+	contractViewer.AddContractAdmin(contractAddr.String(), acc.Address.String())
+	contractViewer.AddContractAdmin(otherContractAddr.String(), acc.Address.String())
+	keeper.SetContractInfoViewer(contractViewer)
+	msgPlugin := wasmbinding.BuildWasmMsgDecorator(keeper)(testutils.NewMockMessenger())
+
+	// The contract manages itself, the other one is owned by an account
+	require.NoError(t, keeper.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+		ContractAddress: contractAddr.String(),
+		OwnerAddress:    contractAddr.String(),
+		RewardsAddress:  contractAddr.String(),
+	}))
+	require.NoError(t, keeper.ContractMetadata.Set(ctx, otherContractAddr, rewardsTypes.ContractMetadata{
+		ContractAddress: otherContractAddr.String(),
+		OwnerAddress:    acc.Address.String(),
+		RewardsAddress:  acc.Address.String(),
+	}))
+
+	setFlatFee := func(senderAddr sdk.AccAddress, req rewardsWbTypes.SetFlatFeeRequest) error {
+		msgBz, err := json.Marshal(map[string]rewardsWbTypes.SetFlatFeeRequest{"set_flat_fee": req})
+		require.NoError(t, err)
+
+		_, _, err = msgPlugin.DispatchMsg(ctx, senderAddr, "", wasmVmTypes.CosmosMsg{Custom: msgBz})
+		return err
+	}
+	flatFee := sdk.NewInt64Coin("uarch", 100)
+
+	t.Run("Fail: unauthorized self-set (metadata is owned by an account)", func(t *testing.T) {
+		err := setFlatFee(otherContractAddr, rewardsWbTypes.SetFlatFeeRequest{FlatFeeAmount: pkg.SDKCoinToWasm(flatFee)})
+		require.ErrorIs(t, err, rewardsTypes.ErrUnauthorized)
+
+		_, found := keeper.GetFlatFee(ctx, otherContractAddr)
+		require.False(t, found)
+	})
+
+	t.Run("Fail: unauthorized set for another contract", func(t *testing.T) {
+		err := setFlatFee(contractAddr, rewardsWbTypes.SetFlatFeeRequest{
+			ContractAddress: otherContractAddr.String(),
+			FlatFeeAmount:   pkg.SDKCoinToWasm(flatFee),
+		})
+		require.ErrorIs(t, err, rewardsTypes.ErrUnauthorized)
+
+		_, found := keeper.GetFlatFee(ctx, otherContractAddr)
+		require.False(t, found)
+	})
+
+	t.Run("OK: authorized self-set", func(t *testing.T) {
+		require.NoError(t, setFlatFee(contractAddr, rewardsWbTypes.SetFlatFeeRequest{FlatFeeAmount: pkg.SDKCoinToWasm(flatFee)}))
+
+		fee, found := keeper.GetFlatFee(ctx, contractAddr)
+		require.True(t, found)
+		require.Equal(t, flatFee, fee)
+	})
+
+	t.Run("OK: authorized self-set with the contract address specified", func(t *testing.T) {
+		require.NoError(t, setFlatFee(contractAddr, rewardsWbTypes.SetFlatFeeRequest{
+			ContractAddress: contractAddr.String(),
+			FlatFeeAmount:   pkg.SDKCoinToWasm(sdk.NewInt64Coin("uarch", 0)),
+		}))
+
+		_, found := keeper.GetFlatFee(ctx, contractAddr)
+		require.False(t, found)
+	})
+}
@@ -13,8 +13,8 @@ import (
 // KeeperWriterExpected defines the x/rewards keeper expected write operations.
 type KeeperWriterExpected interface {
 	SetContractMetadata(ctx sdk.Context, senderAddr, contractAddr sdk.AccAddress, metaUpdates rewardsTypes.ContractMetadata) error
-	WithdrawRewardsByRecordsLimit(ctx sdk.Context, rewardsAddr sdk.AccAddress, recordsLimit uint64) (sdk.Coins, int, error)
-	WithdrawRewardsByRecordIDs(ctx sdk.Context, rewardsAddr sdk.AccAddress, recordIDs []uint64) (sdk.Coins, int, error)
+	WithdrawRewardsByRecordsLimit(ctx sdk.Context, rewardsAddr sdk.AccAddress, recordsLimit uint64, denoms ...string) (sdk.Coins, int, error)
+	WithdrawRewardsByRecordIDs(ctx sdk.Context, rewardsAddr sdk.AccAddress, recordIDs []uint64, denoms ...string) (sdk.Coins, int, error)
 	SetFlatFee(ctx sdk.Context, senderAddr sdk.AccAddress, flatFeeUpdate rewardsTypes.FlatFee) error
 }
 
@@ -78,12 +78,17 @@ func (h MsgHandler) WithdrawContractRewards(ctx sdk.Context, contractAddr sdk.Ac
 	return nil, [][]byte{resBz}, nil
 }
 
-// SetFlatFee sets the flat fee for the contract address.
+// SetFlatFee sets the flat fee for the contract address (the sender contract address if not set).
+// Request is authorized only if the sender contract is the target contract metadata owner.
 func (h MsgHandler) SetFlatFee(ctx sdk.Context, senderAddr sdk.AccAddress, req rewardsMsgTypes.SetFlatFeeRequest) ([]sdk.Event, [][]byte, error) {
 	if err := req.Validate(); err != nil {
 		return nil, nil, fmt.Errorf("setFlatFee: %w", err)
 	}
 
+	if _, isSet := req.MustGetContractAddressOk(); !isSet {
+		req.ContractAddress = senderAddr.String()
+	}
+
 	if err := h.rewardsKeeper.SetFlatFee(ctx, senderAddr, req.ToSDK()); err != nil {
 		return nil, nil, err
 	}
@@ -17,6 +17,8 @@ type KeeperReaderExpected interface {
 	GetRewardsRecords(ctx sdk.Context, rewardsAddr sdk.AccAddress, pageReq *query.PageRequest) ([]rewardsTypes.RewardsRecord, *query.PageResponse, error)
 	MaxWithdrawRecords(ctx sdk.Context) uint64
 	GetFlatFee(ctx sdk.Context, contractAddr sdk.AccAddress) (sdk.Coin, bool)
+	GetContractRewardsRecordsLimited(ctx sdk.Context, contractAddr sdk.AccAddress, limit uint64) ([]rewardsTypes.RewardsRecord, bool, error)
+	GetMinConsensusFee(ctx sdk.Context, denom string) (sdk.DecCoin, bool)
 }
 
 // QueryHandler provides a custom WASM query handler for the x/rewards module.
@@ -76,3 +78,47 @@ func (h QueryHandler) GetRewardsRecords(ctx sdk.Context, req types.RewardsRecord
 
 	return types.NewRewardsRecordsResponse(records, *pageResp), nil
 }
+
+// GetContractRewards returns the outstanding rewards credited by the contract and the rewards credited to the contract address.
+// Each of the totals iterates over a limited number of rewards records (refer to types.ContractRewardsRequest).
+func (h QueryHandler) GetContractRewards(ctx sdk.Context, req types.ContractRewardsRequest) (types.ContractRewardsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return types.ContractRewardsResponse{}, fmt.Errorf("contractRewards: %w", err)
+	}
+	contractAddr, limit := req.MustGetContractAddress(), req.GetLimit()
+
+	contractRecords, outstandingLimitReached, err := h.rewardsKeeper.GetContractRewardsRecordsLimited(ctx, contractAddr, limit)
+	if err != nil {
+		return types.ContractRewardsResponse{}, err
+	}
+	outstandingRewards := sdk.NewCoins()
+	for _, record := range contractRecords {
+		outstandingRewards = outstandingRewards.Add(record.Rewards...)
+	}
+
+	withdrawableRecords, pageResp, err := h.rewardsKeeper.GetRewardsRecords(ctx, contractAddr, &query.PageRequest{Limit: limit})
+	if err != nil {
+		return types.ContractRewardsResponse{}, errorsmod.Wrap(rewardsTypes.ErrInternal, err.Error())
+	}
+	withdrawableRewards := sdk.NewCoins()
+	for _, record := range withdrawableRecords {
+		withdrawableRewards = withdrawableRewards.Add(record.Rewards...)
+	}
+	limitReached := outstandingLimitReached || len(pageResp.NextKey) != 0
+
+	return types.NewContractRewardsResponse(outstandingRewards, withdrawableRewards, len(withdrawableRecords), limitReached), nil
+}
+
+// GetMinConsensusFee returns the current minimum consensus fee for the given denom.
+func (h QueryHandler) GetMinConsensusFee(ctx sdk.Context, req types.MinConsensusFeeRequest) (types.MinConsensusFeeResponse, error) {
+	if err := req.Validate(); err != nil {
+		return types.MinConsensusFeeResponse{}, fmt.Errorf("minConsensusFee: %w", err)
+	}
+
+	minConsFee, found := h.rewardsKeeper.GetMinConsensusFee(ctx, req.Denom)
+	if !found {
+		return types.MinConsensusFeeResponse{}, errorsmod.Wrapf(rewardsTypes.ErrMinConsFeeNotFound, "denom (%s)", req.Denom)
+	}
+
+	return types.NewMinConsensusFeeResponse(minConsFee), nil
+}
@@ -13,6 +13,7 @@ import (
 // SetFlatFeeRequest is the Msg.SetFlatFee request.
 type SetFlatFeeRequest struct {
 	// ContractAddress is the contract for which flatfee needs to be set.
+	// If empty, the sender contract address is used.
 	ContractAddress string `json:"contract_address"`
 	// RewardsAddress if not empty, changes the rewards distribution destination address.
 	FlatFeeAmount wasmVmTypes.Coin `json:"flat_fee_amount"`
@@ -20,8 +21,10 @@ type SetFlatFeeRequest struct {
 
 // Validate performs request fields validation.
 func (r SetFlatFeeRequest) Validate() error {
-	if _, err := sdk.AccAddressFromBech32(r.ContractAddress); err != nil {
-		return fmt.Errorf("contractAddress: parsing: %w", err)
+	if r.ContractAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(r.ContractAddress); err != nil {
+			return fmt.Errorf("contractAddress: parsing: %w", err)
+		}
 	}
 
 	coin, err := pkg.WasmCoinToSDK(r.FlatFeeAmount)
@@ -37,6 +40,22 @@ func (r SetFlatFeeRequest) Validate() error {
 	return nil
 }
 
+// MustGetContractAddressOk returns the target contract address as sdk.AccAddress if set.
+// CONTRACT: panics in case of an error.
+func (r SetFlatFeeRequest) MustGetContractAddressOk() (sdk.AccAddress, bool) {
+	if r.ContractAddress == "" {
+		return nil, false
+	}
+
+	addr, err := sdk.AccAddressFromBech32(r.ContractAddress)
+	if err != nil {
+		// Should not happen since we validate the request before this call
+		panic(fmt.Errorf("wasm bindings: flat fee update: parsing contractAddress: %w", err))
+	}
+
+	return addr, true
+}
+
 // MustGetSdkCoinOk returns the contract address as sdk.AccAddress if set to be updated.
 // CONTRACT: panics in case of an error.
 func (r SetFlatFeeRequest) MustGetSdkCoinOk() sdk.Coin {
@@ -187,6 +187,15 @@ func TestSetFlatFeeRequestValidate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "OK: SetFlatFeeRequest without contractAddress",
+			msg: SetFlatFeeRequest{
+				FlatFeeAmount: wasmVmTypes.Coin{
+					Denom:  "test",
+					Amount: "10",
+				},
+			},
+		},
 		{
 			name:        "Fail: invalid SetFlatFeeRequest: no changes",
 			msg:         SetFlatFeeRequest{},
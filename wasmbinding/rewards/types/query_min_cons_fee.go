@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+
+	wasmVmTypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MinConsensusFeeRequest is the Query.MinConsensusFee request.
+type MinConsensusFeeRequest struct {
+	// Denom is the fee denom to get the minimum consensus fee for.
+	Denom string `json:"denom"`
+}
+
+// MinConsensusFeeResponse is the Query.MinConsensusFee response.
+type MinConsensusFeeResponse struct {
+	// MinConsensusFee is the current minimum consensus fee (minimum gas unit price) for the requested denom.
+	MinConsensusFee wasmVmTypes.DecCoin `json:"min_consensus_fee"`
+}
+
+// Validate performs request fields validation.
+func (r MinConsensusFeeRequest) Validate() error {
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return fmt.Errorf("denom: %w", err)
+	}
+
+	return nil
+}
+
+// NewMinConsensusFeeResponse builds a new MinConsensusFeeResponse.
+func NewMinConsensusFeeResponse(minConsFee sdk.DecCoin) MinConsensusFeeResponse {
+	return MinConsensusFeeResponse{
+		MinConsensusFee: wasmVmTypes.DecCoin{
+			Denom:  minConsFee.Denom,
+			Amount: minConsFee.Amount.String(),
+		},
+	}
+}
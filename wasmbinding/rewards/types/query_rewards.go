@@ -0,0 +1,78 @@
+package types
+
+import (
+	"fmt"
+
+	wasmdTypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmVmTypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
+)
+
+// ContractRewardsRequest is the Query.ContractRewards request.
+type ContractRewardsRequest struct {
+	// ContractAddress is the bech32 encoded contract address.
+	ContractAddress string `json:"contract_address"`
+	// Limit is an optional max number of rewards records iterated to build each of the response totals.
+	// Should not exceed the rewardsTypes.MaxRecordsQueryLimit value (used if not set).
+	Limit uint64 `json:"limit,omitempty"`
+}
+
+// ContractRewardsResponse is the Query.ContractRewards response.
+type ContractRewardsResponse struct {
+	// OutstandingRewards are the total rewards credited by the contract (to the metadata rewards address and
+	// rewards split recipients) that are not withdrawn yet.
+	OutstandingRewards wasmVmTypes.Coins `json:"outstanding_rewards"`
+	// WithdrawableRewards are the total rewards credited to the contract address itself (could be withdrawn by the
+	// contract using the WithdrawRewards message).
+	WithdrawableRewards wasmVmTypes.Coins `json:"withdrawable_rewards"`
+	// WithdrawableRecordsNum is the number of RewardsRecord objects credited to the contract address.
+	WithdrawableRecordsNum uint64 `json:"withdrawable_records_num"`
+	// LimitReached is true if the records limit was reached and the totals above are incomplete.
+	LimitReached bool `json:"limit_reached"`
+}
+
+// Validate performs request fields validation.
+func (r ContractRewardsRequest) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.ContractAddress); err != nil {
+		return fmt.Errorf("contractAddress: parsing: %w", err)
+	}
+
+	if r.Limit > rewardsTypes.MaxRecordsQueryLimit {
+		return fmt.Errorf("limit: max records (%d) query limit exceeded", rewardsTypes.MaxRecordsQueryLimit)
+	}
+
+	return nil
+}
+
+// MustGetContractAddress returns the contract address as sdk.AccAddress.
+// CONTRACT: panics in case of an error (should not happen since we validate the request).
+func (r ContractRewardsRequest) MustGetContractAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(r.ContractAddress)
+	if err != nil {
+		// Should not happen since we validate the request before this call
+		panic(fmt.Errorf("wasm bindings: contractRewards request: parsing contractAddress: %w", err))
+	}
+
+	return addr
+}
+
+// GetLimit returns the records limit falling back to the rewardsTypes.MaxRecordsQueryLimit value.
+func (r ContractRewardsRequest) GetLimit() uint64 {
+	if r.Limit == 0 {
+		return rewardsTypes.MaxRecordsQueryLimit
+	}
+
+	return r.Limit
+}
+
+// NewContractRewardsResponse builds a new ContractRewardsResponse.
+func NewContractRewardsResponse(outstandingRewards, withdrawableRewards sdk.Coins, withdrawableRecordsNum int, limitReached bool) ContractRewardsResponse {
+	return ContractRewardsResponse{
+		OutstandingRewards:     wasmdTypes.NewWasmCoins(outstandingRewards),
+		WithdrawableRewards:    wasmdTypes.NewWasmCoins(withdrawableRewards),
+		WithdrawableRecordsNum: uint64(withdrawableRecordsNum),
+		LimitReached:           limitReached,
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
 )
 
 func TestContractMetadataRequestValidate(t *testing.T) {
@@ -111,3 +113,98 @@ func TestContractFlatFeeRequestValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestContractRewardsRequestValidate(t *testing.T) {
+	type testCase struct {
+		name        string
+		query       ContractRewardsRequest
+		errExpected bool
+	}
+
+	testCases := []testCase{
+		{
+			name:        "Fail: Empty req",
+			query:       ContractRewardsRequest{},
+			errExpected: true,
+		},
+		{
+			name: "Fail: Invalid req",
+			query: ContractRewardsRequest{
+				ContractAddress: "👻",
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: Limit exceeded",
+			query: ContractRewardsRequest{
+				ContractAddress: "cosmos1zj8lgj0zp06c8n4rreyzgu3tls9yhy4mm4vu8c",
+				Limit:           rewardsTypes.MaxRecordsQueryLimit + 1,
+			},
+			errExpected: true,
+		},
+		{
+			name: "OK: Valid req",
+			query: ContractRewardsRequest{
+				ContractAddress: "cosmos1zj8lgj0zp06c8n4rreyzgu3tls9yhy4mm4vu8c",
+			},
+		},
+		{
+			name: "OK: Valid req with limit",
+			query: ContractRewardsRequest{
+				ContractAddress: "cosmos1zj8lgj0zp06c8n4rreyzgu3tls9yhy4mm4vu8c",
+				Limit:           rewardsTypes.MaxRecordsQueryLimit,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.query.Validate()
+			if tc.errExpected {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestMinConsensusFeeRequestValidate(t *testing.T) {
+	type testCase struct {
+		name        string
+		query       MinConsensusFeeRequest
+		errExpected bool
+	}
+
+	testCases := []testCase{
+		{
+			name:        "Fail: Empty req",
+			query:       MinConsensusFeeRequest{},
+			errExpected: true,
+		},
+		{
+			name: "Fail: Invalid denom",
+			query: MinConsensusFeeRequest{
+				Denom: "👻",
+			},
+			errExpected: true,
+		},
+		{
+			name: "OK: Valid req",
+			query: MinConsensusFeeRequest{
+				Denom: "uarch",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.query.Validate()
+			if tc.errExpected {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
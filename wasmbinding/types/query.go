@@ -21,6 +21,12 @@ type Query struct {
 
 	// FlatFee returns the contracts flat fee
 	FlatFee *rewardsTypes.ContractFlatFeeRequest `json:"flat_fee"`
+
+	// ContractRewards returns the contract outstanding rewards and the rewards ready to be withdrawn by the contract.
+	ContractRewards *rewardsTypes.ContractRewardsRequest `json:"contract_rewards"`
+
+	// MinConsensusFee returns the current minimum consensus fee for a denom.
+	MinConsensusFee *rewardsTypes.MinConsensusFeeRequest `json:"min_consensus_fee"`
 }
 
 // Validate validates the query fields.
@@ -43,6 +49,14 @@ func (q Query) Validate() error {
 		cnt++
 	}
 
+	if q.ContractRewards != nil {
+		cnt++
+	}
+
+	if q.MinConsensusFee != nil {
+		cnt++
+	}
+
 	if cnt != 1 {
 		return fmt.Errorf("one and only one sub-query must be set (fields=%v)", cnt)
 	}
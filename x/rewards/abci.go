@@ -11,6 +11,15 @@ import (
 	"github.com/archway-network/archway/x/rewards/types"
 )
 
+// BeginBlocker applies the rewards ratios changes scheduled for the current block.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	k.ApplyScheduledRewardsRatios(ctx)
+
+	return nil
+}
+
 // EndBlocker calculates and distributes dApp rewards for the current block updating the treasury.
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) ([]abci.ValidatorUpdate, error) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
@@ -1,6 +1,9 @@
 package ante
 
 import (
+	"bytes"
+	"encoding/json"
+
 	errorsmod "cosmossdk.io/errors"
 	math "cosmossdk.io/math"
 	wasmTypes "github.com/CosmWasm/wasmd/x/wasm/types"
@@ -8,6 +11,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
 )
 
 // RewardsKeeperExpected defines the expected interface for the x/rewards keeper.
@@ -15,19 +20,51 @@ type RewardsKeeperExpected interface {
 	// Used in MinFeeDecorator
 	ComputationalPriceOfGas(ctx sdk.Context) sdk.DecCoin
 	GetFlatFee(ctx sdk.Context, contractAddr sdk.AccAddress) (sdk.Coin, bool)
-	CreateFlatFeeRewardsRecords(ctx sdk.Context, contractAddress sdk.AccAddress, flatfee sdk.Coins)
+	GetMethodFlatFee(ctx sdk.Context, contractAddr sdk.AccAddress, method string) (sdk.Coin, bool)
+	GetFlatFeeOverride(ctx sdk.Context, contractAddr sdk.AccAddress) (sdk.Coin, bool)
+	GetContractMetadata(ctx sdk.Context, contractAddr sdk.AccAddress) *rewardsTypes.ContractMetadata
+	CreateFlatFeeRewardsRecords(ctx sdk.Context, contractAddress sdk.AccAddress, flatfee sdk.Coins) bool
+	AcceptedFeeDenoms(ctx sdk.Context) []string
+	DynamicFeeEnabled(ctx sdk.Context) bool
+	FlatFeeDeliverTxOnly(ctx sdk.Context) bool
+	FlatFeeOncePerBlock(ctx sdk.Context) bool
+	IsFlatFeeChargedInBlock(ctx sdk.Context, contractAddr sdk.AccAddress) bool
+	MinFeeFloorEnabled(ctx sdk.Context) bool
+	TxSizeFeePerByte(ctx sdk.Context) uint64
+	SingleDenomFeesOnly(ctx sdk.Context) bool
+	MinContractExecutionGas(ctx sdk.Context) uint64
+	ConsumeFreeTx(ctx sdk.Context, accAddr sdk.AccAddress) bool
+	ConsumeFlatFeeCredit(ctx sdk.Context, contractAddr sdk.AccAddress) bool
+	FlatFeePayerMustSign(ctx sdk.Context) bool
+	FlatFeesEnabled(ctx sdk.Context) bool
+	FlatFeeConversionRates(ctx sdk.Context) []rewardsTypes.FlatFeeConversionRate
+	CheckTxMinFeeEventEnabled(ctx sdk.Context) bool
+	MaxFlatFeeMsgsPerTx(ctx sdk.Context) uint64
+	FlatFeeAbsorbedInGasFee(ctx sdk.Context) bool
+	FeePromotionDiscount(ctx sdk.Context) uint64
+	FlatFeeRefundOnFailure(ctx sdk.Context) bool
+	CheckTxLocalMinGasPriceEnabled(ctx sdk.Context) bool
+	DistributeFlatFeeTip(ctx sdk.Context, contractAddress sdk.AccAddress, tip sdk.Coins) bool
 
 	// Used in DeductFeeDecorator
 	TxFeeRebateRatio(ctx sdk.Context) math.LegacyDec
 	TrackFeeRebatesRewards(ctx sdk.Context, rewards sdk.Coins)
+	RouteFeeCollectorFees(ctx sdk.Context, fees sdk.Coins) (sdk.Coins, error)
+	TrackTxFeeDistribution(ctx sdk.Context, feeCollectorFees, burntFees, rewardsFees, flatFees, routedFees sdk.Coins)
 }
 
+// maxExecuteMsgMethodParseSize defines the max execute msg size the method name is parsed for.
+// Larger msgs are charged the contract-wide flat fee.
+const maxExecuteMsgMethodParseSize = 64 * 1024
+
 type contractFlatFee struct {
 	ContractAddress sdk.AccAddress
 	FlatFees        sdk.Coins
 }
 
-func GetContractFlatFees(ctx sdk.Context, rk RewardsKeeperExpected, codec codec.BinaryCodec, m sdk.Msg) (contractFlatFees []contractFlatFee, hasWasmMsgs bool, err error) {
+// GetContractFlatFees returns contract flat fees for the given msg (unwrapping authz msgs) and whether it is wasm related.
+// Flat fees are converted to the tx fee denoms using the FlatFeeConversionRates param if the tx fees have no flat fee denom.
+func GetContractFlatFees(ctx sdk.Context, rk RewardsKeeperExpected, codec codec.BinaryCodec, m sdk.Msg, txFees sdk.Coins) (contractFlatFees []contractFlatFee, hasWasmMsgs bool, err error) {
 	switch msg := m.(type) {
 	case *wasmTypes.MsgMigrateContract:
 		{
@@ -39,8 +76,22 @@ func GetContractFlatFees(ctx sdk.Context, rk RewardsKeeperExpected, codec codec.
 			if err != nil {
 				return nil, true, err
 			}
-			fee, found := rk.GetFlatFee(ctx, ca)
+			if !rk.FlatFeesEnabled(ctx) || isFlatFeeSkippedInCheckTx(ctx, rk) || isFlatFeeChargedInBlock(ctx, rk, ca) {
+				return nil, true, nil
+			}
+			fee, found := getExecuteMsgFlatFee(ctx, rk, ca, msg.Msg)
+			if found && isFlatFeeExemptCaller(ctx, rk, ca, msg.Sender) {
+				return nil, true, nil
+			}
 			if found {
+				// Stored flat fee is expected to be valid, report a broken state without panicking (only txs targeting the contract are affected)
+				if err := fee.Validate(); err != nil {
+					return nil, true, errorsmod.Wrapf(rewardsTypes.ErrInternal, "invalid flat fee for contract (%s), denom (%s): %v", ca, fee.Denom, err)
+				}
+				fee = rewardsTypes.ConvertFlatFee(fee, txFees, rk.FlatFeeConversionRates(ctx))
+				if !isFlatFeeDenomAccepted(ctx, rk, ca, fee.Denom) {
+					return nil, true, errorsmod.Wrapf(sdkErrors.ErrInvalidCoins, "contract (%s) flat fee could not be paid in %s", ca, fee.Denom)
+				}
 				contractFlatFees = append(contractFlatFees, contractFlatFee{ContractAddress: ca, FlatFees: sdk.NewCoins(fee)})
 				return contractFlatFees, true, nil
 			}
@@ -53,11 +104,13 @@ func GetContractFlatFees(ctx sdk.Context, rk RewardsKeeperExpected, codec codec.
 				return nil, false, errorsmod.Wrapf(sdkErrors.ErrUnauthorized, "error decoding authz messages")
 			}
 
+			// The tx is wasm related if any of the wrapped msgs is (other msgs, like MsgWithdrawRewards, are not charged)
 			for _, wrappedMsg := range authzMsgs {
-				cff, hasWasmMsgs, err := GetContractFlatFees(ctx, rk, codec, wrappedMsg)
+				cff, hwm, err := GetContractFlatFees(ctx, rk, codec, wrappedMsg, txFees)
 				if err != nil {
-					return nil, hasWasmMsgs, err
+					return nil, hasWasmMsgs || hwm, err
 				}
+				hasWasmMsgs = hasWasmMsgs || hwm
 				contractFlatFees = append(contractFlatFees, cff...)
 			}
 			return contractFlatFees, hasWasmMsgs, nil
@@ -65,3 +118,121 @@ func GetContractFlatFees(ctx sdk.Context, rk RewardsKeeperExpected, codec codec.
 	}
 	return nil, false, nil
 }
+
+// getExecuteMsgFlatFee returns the flat fee for the contract execute msg: the method flat fee if set for the msg method,
+// the contract-wide flat fee otherwise.
+// The active governance flat fee override takes precedence over the contract owner flat fees (a zero override waives them)
+// and applies to contracts without an owner flat fee as well.
+func getExecuteMsgFlatFee(ctx sdk.Context, rk RewardsKeeperExpected, contractAddr sdk.AccAddress, msg []byte) (sdk.Coin, bool) {
+	if override, ok := rk.GetFlatFeeOverride(ctx, contractAddr); ok {
+		return override, override.IsPositive()
+	}
+
+	return getOwnerExecuteMsgFlatFee(ctx, rk, contractAddr, msg)
+}
+
+// getOwnerExecuteMsgFlatFee returns the contract owner flat fee for the contract execute msg (overrides are not applied).
+func getOwnerExecuteMsgFlatFee(ctx sdk.Context, rk RewardsKeeperExpected, contractAddr sdk.AccAddress, msg []byte) (sdk.Coin, bool) {
+	if method, ok := getExecuteMsgMethod(msg); ok {
+		if fee, found := rk.GetMethodFlatFee(ctx, contractAddr, method); found {
+			return fee, true
+		}
+	}
+
+	return rk.GetFlatFee(ctx, contractAddr)
+}
+
+// getExecuteMsgMethod returns the method name of the contract execute msg following the CosmWasm enum JSON encoding:
+// the top-level object key ({"method":{...}}) or the top-level string ("method") for unit variants.
+// Returns false for an empty, oversized or malformed msg, a non-enum msg or a method name exceeding the length limit.
+func getExecuteMsgMethod(msg []byte) (string, bool) {
+	if len(msg) == 0 || len(msg) > maxExecuteMsgMethodParseSize || !json.Valid(msg) {
+		return "", false
+	}
+
+	// Only the leading tokens are decoded, the method value is not parsed
+	dec := json.NewDecoder(bytes.NewReader(msg))
+	tok, err := dec.Token()
+	if err != nil {
+		return "", false
+	}
+	if tok == json.Delim('{') {
+		if tok, err = dec.Token(); err != nil {
+			return "", false
+		}
+	}
+
+	method, ok := tok.(string)
+	if !ok || method == "" || len(method) > rewardsTypes.MaxFlatFeeMethodLength {
+		return "", false
+	}
+
+	return method, true
+}
+
+// isFlatFeeSkippedInCheckTx checks if flat fees are charged in DeliverTx only and the tx is in CheckTx.
+// Simulation is run with the CheckTx context, but reports flat fees to keep the fee estimation accurate.
+func isFlatFeeSkippedInCheckTx(ctx sdk.Context, rk RewardsKeeperExpected) bool {
+	if !ctx.IsCheckTx() || ctx.ExecMode() == sdk.ExecModeSimulate {
+		return false
+	}
+
+	return rk.FlatFeeDeliverTxOnly(ctx)
+}
+
+// isFlatFeeChargedInBlock checks if flat fees are charged once per block and the contract flat fee was already charged
+// by another tx within the current block.
+func isFlatFeeChargedInBlock(ctx sdk.Context, rk RewardsKeeperExpected, contractAddr sdk.AccAddress) bool {
+	if !rk.FlatFeeOncePerBlock(ctx) {
+		return false
+	}
+
+	return rk.IsFlatFeeChargedInBlock(ctx, contractAddr)
+}
+
+// getFlatFeeMsgSigner returns the signer of the msg charged the contract flat fees: the contract execute msg sender
+// or the authz msg grantee (wrapped msgs are executed on behalf of the granter, but signed by the grantee).
+func getFlatFeeMsgSigner(m sdk.Msg) string {
+	switch msg := m.(type) {
+	case *wasmTypes.MsgExecuteContract:
+		return msg.Sender
+	case *authz.MsgExec:
+		return msg.Grantee
+	}
+	return ""
+}
+
+// isFlatFeeOnSuccess checks if the contract flat fees are charged only once the tx msgs are executed successfully
+// (for every contract if the flat fees are refunded for failed executions).
+func isFlatFeeOnSuccess(ctx sdk.Context, rk RewardsKeeperExpected, contractAddr sdk.AccAddress) bool {
+	if rk.FlatFeeRefundOnFailure(ctx) {
+		return true
+	}
+
+	metadata := rk.GetContractMetadata(ctx, contractAddr)
+	if metadata == nil {
+		return false
+	}
+
+	return metadata.FlatFeeOnSuccess
+}
+
+// isFlatFeeDenomAccepted checks if the contract flat fee could be paid in the given denom (the contract accepted denoms).
+func isFlatFeeDenomAccepted(ctx sdk.Context, rk RewardsKeeperExpected, contractAddr sdk.AccAddress, denom string) bool {
+	metadata := rk.GetContractMetadata(ctx, contractAddr)
+	if metadata == nil {
+		return true
+	}
+
+	return metadata.IsFlatFeeDenomAccepted(denom)
+}
+
+// isFlatFeeExemptCaller checks if the caller is in the contract flat fee exempt callers list.
+func isFlatFeeExemptCaller(ctx sdk.Context, rk RewardsKeeperExpected, contractAddr sdk.AccAddress, callerAddr string) bool {
+	metadata := rk.GetContractMetadata(ctx, contractAddr)
+	if metadata == nil {
+		return false
+	}
+
+	return metadata.IsFlatFeeExemptCaller(callerAddr)
+}
@@ -0,0 +1,61 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	"github.com/archway-network/archway/pkg"
+	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
+)
+
+// getMaxPriorityPrice returns the max priority gas price defined by the tx dynamic fee extension option (nil if not set).
+func getMaxPriorityPrice(tx sdk.Tx) *math.LegacyDec {
+	extTx, ok := tx.(ante.HasExtensionOptionsTx)
+	if !ok {
+		return nil
+	}
+
+	for _, opt := range extTx.GetExtensionOptions() {
+		if dynamicFeeOpt, ok := opt.GetCachedValue().(*rewardsTypes.ExtensionOptionDynamicFee); ok {
+			return &dynamicFeeOpt.MaxPriorityPrice
+		}
+	}
+
+	return nil
+}
+
+// estimateDynamicFeeRefund compares the tx max gas price with the base gas price and returns the gas fees to be withheld until the tx execution ends.
+// The tx max gas price is estimated using the tx fees (excluding contract flat fees) in the base gas price denom.
+// The effective gas price is the base gas price plus the max priority price capped by the tx max gas price.
+// If the max priority price is not set, the effective gas price is the tx max gas price.
+// Only the gas fees are withheld: contract flat fees are a fixed charge and are never refunded.
+func estimateDynamicFeeRefund(txFees, flatFees sdk.Coins, txGas uint64, baseGasPrice sdk.DecCoin, maxPriorityPrice *math.LegacyDec) (rewardsTypes.DynamicFeeRefund, error) {
+	denom := baseGasPrice.Denom
+	gasFeesPaid := txFees.AmountOf(denom).Sub(flatFees.AmountOf(denom))
+	if gasFeesPaid.IsNegative() {
+		return rewardsTypes.DynamicFeeRefund{}, errorsmod.Wrapf(sdkErrors.ErrInsufficientFee, "tx fee %s does not cover the flat fees %s", txFees, flatFees)
+	}
+
+	gasLimit := pkg.NewDecFromUint64(txGas)
+	maxGasPrice := math.LegacyNewDecFromInt(gasFeesPaid).Quo(gasLimit)
+	if maxGasPrice.LT(baseGasPrice.Amount) {
+		return rewardsTypes.DynamicFeeRefund{}, errorsmod.Wrapf(sdkErrors.ErrInsufficientFee, "tx max gas price %s%s is less than the base gas price %s", maxGasPrice, denom, baseGasPrice)
+	}
+
+	effectiveGasPrice := maxGasPrice
+	if maxPriorityPrice != nil && !maxPriorityPrice.IsNil() {
+		if maxPriorityPrice.IsNegative() {
+			return rewardsTypes.DynamicFeeRefund{}, errorsmod.Wrapf(sdkErrors.ErrInvalidRequest, "tx max priority gas price %s must be GTE 0", maxPriorityPrice)
+		}
+		effectiveGasPrice = math.LegacyMinDec(maxGasPrice, baseGasPrice.Amount.Add(*maxPriorityPrice))
+	}
+
+	return rewardsTypes.DynamicFeeRefund{
+		GasLimit: txGas,
+		GasPrice: sdk.NewDecCoinFromDec(denom, effectiveGasPrice),
+		GasFees:  sdk.NewCoins(sdk.NewCoin(denom, gasFeesPaid)),
+	}, nil
+}
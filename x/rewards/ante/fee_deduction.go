@@ -75,9 +75,15 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		return ctx, errorsmod.Wrapf(sdkErrors.ErrUnknownAddress, "fee payer address (%s) does not exist", deductFeesFrom)
 	}
 
+	// Set the dynamic fee refund recipient (if any) for the post handler
+	if refund, found := rewardsTypes.GetDynamicFeeRefund(ctx); found {
+		refund.FeePayer = deductFeesFrom
+		ctx = rewardsTypes.WithDynamicFeeRefund(ctx, refund)
+	}
+
 	// Deduct the fees
 	if !feeTx.GetFee().IsZero() {
-		if err := dfd.deductFees(ctx, tx, deductFeesFromAcc, feeTx.GetFee()); err != nil {
+		if ctx, err = dfd.deductFees(ctx, tx, deductFeesFromAcc, feeTx.GetFee()); err != nil {
 			return ctx, err
 		}
 	}
@@ -133,6 +139,9 @@ func (dfd DeductFeeDecorator) getFeePayer(ctx sdk.Context, tx sdk.Tx) (payer sdk
 
 	// we check x/feegrant
 	case dfd.feegrantKeeper != nil:
+		if err := dfd.validateGrantedFees(ctx, tx, feeTx.GetFee()); err != nil {
+			return nil, errorsmod.Wrapf(err, "%s not allowed to pay fees from %s", granter, payer)
+		}
 		err = dfd.feegrantKeeper.UseGrantedFees(ctx, granter, payer, feeTx.GetFee(), feeTx.GetMsgs())
 		if err != nil {
 			return nil, errorsmod.Wrapf(err, "%s not allowed to pay fees from %s", granter, payer)
@@ -144,21 +153,65 @@ func (dfd DeductFeeDecorator) getFeePayer(ctx sdk.Context, tx sdk.Tx) (payer sdk
 	}
 }
 
+// validateGrantedFees checks that the tx fees charged against the x/feegrant allowance cover the tx min fee including
+// the contract flat fees (authz wrapped executes are charged as well, while the allowance only sees the tx fees).
+// The min fee set by the MinFeeDecorator is used if any, otherwise only the contract flat fees are checked.
+func (dfd DeductFeeDecorator) validateGrantedFees(ctx sdk.Context, tx sdk.Tx, fees sdk.Coins) error {
+	flatFees, found := rewardsTypes.GetTxFlatFees(ctx)
+	if !found {
+		for _, m := range tx.GetMsgs() {
+			contractFlatFees, _, err := GetContractFlatFees(ctx, dfd.rewardsKeeper, dfd.codec, m, fees)
+			if err != nil {
+				return err
+			}
+			for _, cff := range contractFlatFees {
+				flatFees = flatFees.Add(cff.FlatFees...)
+			}
+		}
+	}
+
+	var gasFees sdk.Coins
+	minFees, found := rewardsTypes.GetTxMinFee(ctx)
+	if found {
+		gasFees, _ = minFees.SafeSub(flatFees...)
+	} else {
+		minFees = flatFees
+	}
+
+	if !rewardsTypes.IsTxFeeSufficient(fees, gasFees, flatFees) {
+		return errorsmod.Wrapf(sdkErrors.ErrInsufficientFee, "granted fees %s do not cover the min fee %s (contract flat fees included)", fees, minFees)
+	}
+
+	return nil
+}
+
 // deductFees deducts fees from the given account if rewards calculation and distribution is enabled.
 // If rewards module is disabled, all the fees are sent to the fee collector account.
+// Dynamic fee mode gas fees (if any) are withheld on the fee collector account and settled by the post handler.
+// Deferred contract flat fees (if any) are left on the fee payer account to be charged by the post handler.
 // NOTE: this is the only logic being changed.
-func (dfd DeductFeeDecorator) deductFees(ctx sdk.Context, tx sdk.Tx, acc sdk.AccountI, fees sdk.Coins) error {
+func (dfd DeductFeeDecorator) deductFees(ctx sdk.Context, tx sdk.Tx, acc sdk.AccountI, fees sdk.Coins) (sdk.Context, error) {
 	if !fees.IsValid() {
-		return errorsmod.Wrapf(sdkErrors.ErrInsufficientFee, "invalid fee amount: %s", fees)
+		return ctx, errorsmod.Wrapf(sdkErrors.ErrInsufficientFee, "invalid fee amount: %s", fees)
+	}
+	txFees := fees // flat fees conversion is based on the tx fees
+
+	// Withhold the dynamic fee gas fees (if any), contract flat fees are processed as usual
+	refund, refundFound := rewardsTypes.GetDynamicFeeRefund(ctx)
+	if refundFound {
+		if err := dfd.bankKeeper.SendCoinsFromAccountToModule(ctx, acc.GetAddress(), authTypes.FeeCollectorName, refund.GasFees); err != nil {
+			return ctx, errorsmod.Wrapf(sdkErrors.ErrInsufficientFunds, err.Error())
+		}
+		fees = fees.Sub(refund.GasFees...)
 	}
 
 	var flatFees sdk.Coins
 	// Check if transaction has wasmd operations
 	hasWasmMsgs := false
 	for _, m := range tx.GetMsgs() {
-		contractFlatFees, hwm, err := GetContractFlatFees(ctx, dfd.rewardsKeeper, dfd.codec, m)
+		contractFlatFees, hwm, err := GetContractFlatFees(ctx, dfd.rewardsKeeper, dfd.codec, m, txFees)
 		if err != nil {
-			return err
+			return ctx, err
 		}
 		// set hasWasmMsgs, if it is still false;
 		if !hasWasmMsgs {
@@ -168,19 +221,48 @@ func (dfd DeductFeeDecorator) deductFees(ctx sdk.Context, tx sdk.Tx, acc sdk.Acc
 			flatFees = flatFees.Add(cff.FlatFees...)
 		}
 	}
+	// Flat fees charged by the MinFeeDecorator (if used) take the prepaid executions into account
+	if txFlatFees, ok := rewardsTypes.GetTxFlatFees(ctx); ok {
+		flatFees = txFlatFees
+	}
+
+	// Deferred flat fees are not deducted, they are charged by the post handler once the tx msgs succeed
+	if deferred, found := rewardsTypes.GetDeferredFlatFees(ctx); found {
+		deferredFees := deferred.Total()
+		feesLeft, anyNeg := fees.SafeSub(deferredFees...)
+		if anyNeg {
+			return ctx, errorsmod.Wrapf(sdkErrors.ErrInsufficientFee, "tx fees %s do not cover the deferred flat fees %s", fees, deferredFees)
+		}
+		fees = feesLeft
+		flatFees = flatFees.Sub(deferredFees...)
+
+		deferred.FeePayer = acc.GetAddress()
+		ctx = rewardsTypes.WithDeferredFlatFees(ctx, deferred)
+	}
 
 	// Send everything to the fee collector account if rewards are disabled or transaction is not wasm related
+	// (fees in the routed denoms are then sent to the route module accounts)
 	rebateRatio := dfd.rewardsKeeper.TxFeeRebateRatio(ctx)
+	if refundFound {
+		refund.FeeRebateEligible = !rebateRatio.IsZero() && hasWasmMsgs
+		ctx = rewardsTypes.WithDynamicFeeRefund(ctx, refund)
+	}
+
 	if rebateRatio.IsZero() || !hasWasmMsgs {
 		if err := dfd.bankKeeper.SendCoinsFromAccountToModule(ctx, acc.GetAddress(), authTypes.FeeCollectorName, fees); err != nil {
-			return errorsmod.Wrapf(sdkErrors.ErrInsufficientFunds, err.Error())
+			return ctx, errorsmod.Wrapf(sdkErrors.ErrInsufficientFunds, err.Error())
 		}
-		return nil
+		routedFees, err := dfd.rewardsKeeper.RouteFeeCollectorFees(ctx, fees)
+		if err != nil {
+			return ctx, err
+		}
+		dfd.rewardsKeeper.TrackTxFeeDistribution(ctx, fees.Sub(routedFees...), nil, nil, nil, routedFees)
+		return ctx, nil
 	}
 
 	if !flatFees.Empty() {
 		if err := dfd.bankKeeper.SendCoinsFromAccountToModule(ctx, acc.GetAddress(), rewardsTypes.ContractRewardCollector, flatFees); err != nil {
-			return errorsmod.Wrapf(sdkErrors.ErrInsufficientFunds, err.Error())
+			return ctx, errorsmod.Wrapf(sdkErrors.ErrInsufficientFunds, err.Error())
 		}
 		fees = fees.Sub(flatFees...) // reduce flatfees from the sent fees amount
 	}
@@ -190,22 +272,23 @@ func (dfd DeductFeeDecorator) deductFees(ctx sdk.Context, tx sdk.Tx, acc sdk.Acc
 
 	if !authFees.Empty() {
 		if err := dfd.bankKeeper.SendCoinsFromAccountToModule(ctx, acc.GetAddress(), authTypes.FeeCollectorName, authFees); err != nil {
-			return errorsmod.Wrapf(sdkErrors.ErrInsufficientFunds, err.Error())
+			return ctx, errorsmod.Wrapf(sdkErrors.ErrInsufficientFunds, err.Error())
 		}
 		// burn the auth fees.
 		if err := dfd.bankKeeper.BurnCoins(ctx, authTypes.FeeCollectorName, authFees); err != nil {
-			return errorsmod.Wrapf(sdkErrors.ErrInsufficientFunds, err.Error())
+			return ctx, errorsmod.Wrapf(sdkErrors.ErrInsufficientFunds, err.Error())
 		}
 	}
 
 	if !rewardsFees.Empty() {
 		if err := dfd.bankKeeper.SendCoinsFromAccountToModule(ctx, acc.GetAddress(), rewardsTypes.ContractRewardCollector, rewardsFees); err != nil {
-			return errorsmod.Wrapf(sdkErrors.ErrInsufficientFunds, err.Error())
+			return ctx, errorsmod.Wrapf(sdkErrors.ErrInsufficientFunds, err.Error())
 		}
 	}
 
 	// Track transaction fee rewards
 	dfd.rewardsKeeper.TrackFeeRebatesRewards(ctx, rewardsFees)
+	dfd.rewardsKeeper.TrackTxFeeDistribution(ctx, nil, authFees, rewardsFees, flatFees, nil)
 
-	return nil
+	return ctx, nil
 }
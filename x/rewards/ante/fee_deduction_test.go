@@ -1,5 +1,29 @@
 package ante_test
 
+import (
+	"fmt"
+	"testing"
+
+	math "cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
+	wasmdTypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	cmtTypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	distrTypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	mintTypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	e2eTesting "github.com/archway-network/archway/e2e/testing"
+	"github.com/archway-network/archway/pkg/testutils"
+	"github.com/archway-network/archway/x/rewards/ante"
+	rewardsKeeper "github.com/archway-network/archway/x/rewards/keeper"
+	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
+)
+
 // import (
 // 	"testing"
 
@@ -172,3 +196,403 @@ package ante_test
 // 		})
 // 	}
 // }
+
+func TestRewardsFeeDeductionAnteHandlerTxFeeDistribution(t *testing.T) {
+	chain := e2eTesting.NewTestChain(t, 1,
+		e2eTesting.WithTxFeeRebatesRewardsRatio(math.LegacyNewDecWithPrec(5, 1)),
+	)
+	acc := chain.GetAccount(0)
+	ctx := chain.GetContext()
+	keepers := chain.GetApp().Keepers
+	querySrvr := rewardsKeeper.NewQueryServer(keepers.RewardsKeeper)
+
+	feeCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	require.NoError(t, keepers.BankKeeper.MintCoins(ctx, mintTypes.ModuleName, feeCoins.Add(feeCoins...)))
+	require.NoError(t, keepers.BankKeeper.SendCoinsFromModuleToAccount(ctx, mintTypes.ModuleName, acc.Address, feeCoins.Add(feeCoins...)))
+
+	anteHandler := ante.NewDeductFeeDecorator(chain.GetAppCodec(), keepers.AccountKeeper, keepers.BankKeeper, keepers.FeeGrantKeeper, keepers.RewardsKeeper, keepers.CWFeesKeeper)
+	deductFees := func(txBytes []byte, msgs ...sdk.Msg) string {
+		keepers.TrackingKeeper.TrackNewTx(ctx) // tracking Ante handler provides a unique tx ID
+
+		tx := testutils.NewMockFeeTx(
+			testutils.WithMockFeeTxFees(feeCoins),
+			testutils.WithMockFeeTxPayer(acc.Address),
+			testutils.WithMockFeeTxMsgs(msgs...),
+		)
+		_, err := anteHandler.AnteHandle(ctx.WithTxBytes(txBytes), tx, false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+
+		return fmt.Sprintf("%X", cmtTypes.Tx(txBytes).Hash())
+	}
+
+	wasmTxHash := deductFees([]byte("wasmTx"), &wasmdTypes.MsgExecuteContract{
+		Contract: e2eTesting.GenContractAddresses(1)[0].String(),
+	})
+	nonWasmTxHash := deductFees([]byte("nonWasmTx"), testutils.NewMockMsg())
+
+	t.Run("OK: wasm tx fees are split between burn and rewards", func(t *testing.T) {
+		res, err := querySrvr.TxFeeDistribution(ctx, &rewardsTypes.QueryTxFeeDistributionRequest{TxHash: wasmTxHash})
+		require.NoError(t, err)
+		require.Len(t, res.Distributions, 1)
+
+		distr := res.Distributions[0]
+		assert.Equal(t, ctx.BlockHeight(), distr.Height)
+		assert.Empty(t, distr.FeeCollectorFees)
+		assert.Equal(t, "500stake", sdk.Coins(distr.BurntFees).String())
+		assert.Equal(t, "500stake", sdk.Coins(distr.RewardsFees).String())
+		assert.Empty(t, distr.FlatFees)
+	})
+
+	t.Run("OK: non-wasm tx fees are sent to the fee collector", func(t *testing.T) {
+		res, err := querySrvr.TxFeeDistribution(ctx, &rewardsTypes.QueryTxFeeDistributionRequest{TxHash: nonWasmTxHash})
+		require.NoError(t, err)
+		require.Len(t, res.Distributions, 1)
+
+		distr := res.Distributions[0]
+		assert.Equal(t, "1000stake", sdk.Coins(distr.FeeCollectorFees).String())
+		assert.Empty(t, distr.BurntFees)
+		assert.Empty(t, distr.RewardsFees)
+	})
+
+	t.Run("OK: query by block height", func(t *testing.T) {
+		res, err := querySrvr.TxFeeDistribution(ctx, &rewardsTypes.QueryTxFeeDistributionRequest{Height: ctx.BlockHeight()})
+		require.NoError(t, err)
+		assert.Len(t, res.Distributions, 2)
+	})
+
+	t.Run("Fail: neither tx hash nor height is set", func(t *testing.T) {
+		_, err := querySrvr.TxFeeDistribution(ctx, &rewardsTypes.QueryTxFeeDistributionRequest{})
+		require.Error(t, err)
+	})
+}
+
+func TestRewardsFeeDeductionAnteHandlerBlockFlatFeeTxs(t *testing.T) {
+	chain := e2eTesting.NewTestChain(t, 1)
+	acc := chain.GetAccount(0)
+	ctx := chain.GetContext()
+	keepers := chain.GetApp().Keepers
+	querySrvr := rewardsKeeper.NewQueryServer(keepers.RewardsKeeper)
+
+	// Gas fees are 1000stake (100000 gas * 0.01stake), the first contract flat fee is 50stake, the second one has none
+	gasPrice := sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyMustNewDecFromStr("0.01"))
+	params := keepers.RewardsKeeper.GetParams(ctx)
+	params.MinPriceOfGas = gasPrice
+	require.NoError(t, keepers.RewardsKeeper.Params.Set(ctx, params))
+	require.NoError(t, keepers.RewardsKeeper.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(gasPrice)}))
+
+	contractAddrs := e2eTesting.GenContractAddresses(2)
+	for _, contractAddr := range contractAddrs {
+		require.NoError(t, keepers.RewardsKeeper.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+			ContractAddress: contractAddr.String(),
+			OwnerAddress:    acc.Address.String(),
+			RewardsAddress:  acc.Address.String(),
+		}))
+	}
+	require.NoError(t, keepers.RewardsKeeper.FlatFees.Set(ctx, contractAddrs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)))
+
+	feeCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1100))
+	require.NoError(t, keepers.BankKeeper.MintCoins(ctx, mintTypes.ModuleName, feeCoins.MulInt(math.NewInt(4))))
+	require.NoError(t, keepers.BankKeeper.SendCoinsFromModuleToAccount(ctx, mintTypes.ModuleName, acc.Address, feeCoins.MulInt(math.NewInt(4))))
+
+	anteHandler := sdk.ChainAnteDecorators(
+		ante.NewMinFeeDecorator(chain.GetAppCodec(), keepers.RewardsKeeper),
+		ante.NewDeductFeeDecorator(chain.GetAppCodec(), keepers.AccountKeeper, keepers.BankKeeper, keepers.FeeGrantKeeper, keepers.RewardsKeeper, keepers.CWFeesKeeper),
+	)
+	executeMsg := func(contractIdx int) sdk.Msg {
+		return &wasmdTypes.MsgExecuteContract{Sender: acc.Address.String(), Contract: contractAddrs[contractIdx].String()}
+	}
+	deliverTx := func(txBytes []byte, msgs ...sdk.Msg) (uint64, string) {
+		keepers.TrackingKeeper.TrackNewTx(ctx) // tracking Ante handler provides a unique tx ID
+
+		tx := testutils.NewMockFeeTx(
+			testutils.WithMockFeeTxFees(feeCoins),
+			testutils.WithMockFeeTxGas(100_000),
+			testutils.WithMockFeeTxPayer(acc.Address),
+			testutils.WithMockFeeTxMsgs(msgs...),
+		)
+		_, err := anteHandler(ctx.WithTxBytes(txBytes), tx, false)
+		require.NoError(t, err)
+
+		return keepers.TrackingKeeper.GetCurrentTxID(ctx), fmt.Sprintf("%X", cmtTypes.Tx(txBytes).Hash())
+	}
+
+	flatFeeTxID, flatFeeTxHash := deliverTx([]byte("flatFeeTx"), executeMsg(0))
+	deliverTx([]byte("noFlatFeeTx"), executeMsg(1))
+	deliverTx([]byte("nonWasmTx"), testutils.NewMockMsg())
+	doubleFlatFeeTxID, doubleFlatFeeTxHash := deliverTx([]byte("doubleFlatFeeTx"), executeMsg(0), executeMsg(1), executeMsg(0))
+
+	t.Run("OK: flat fee txs only are listed", func(t *testing.T) {
+		res, err := querySrvr.BlockFlatFeeTxs(ctx, &rewardsTypes.QueryBlockFlatFeeTxsRequest{Height: ctx.BlockHeight()})
+		require.NoError(t, err)
+		assert.False(t, res.Truncated)
+		require.Len(t, res.Txs, 2)
+
+		assert.Equal(t, flatFeeTxID, res.Txs[0].TxId)
+		assert.Equal(t, flatFeeTxHash, res.Txs[0].TxHash)
+		assert.Equal(t, "50stake", sdk.Coins(res.Txs[0].FlatFees).String())
+
+		assert.Equal(t, doubleFlatFeeTxID, res.Txs[1].TxId)
+		assert.Equal(t, doubleFlatFeeTxHash, res.Txs[1].TxHash)
+		assert.Equal(t, "100stake", sdk.Coins(res.Txs[1].FlatFees).String())
+	})
+
+	t.Run("OK: result is truncated by the limit", func(t *testing.T) {
+		txs, truncated, err := keepers.RewardsKeeper.GetBlockFlatFeeTxs(ctx, ctx.BlockHeight(), 1)
+		require.NoError(t, err)
+		assert.True(t, truncated)
+		require.Len(t, txs, 1)
+		assert.Equal(t, flatFeeTxID, txs[0].TxId)
+	})
+
+	t.Run("Fail: invalid height", func(t *testing.T) {
+		_, err := querySrvr.BlockFlatFeeTxs(ctx, nil)
+		assert.Error(t, err)
+
+		_, err = querySrvr.BlockFlatFeeTxs(ctx, &rewardsTypes.QueryBlockFlatFeeTxsRequest{Height: 0})
+		assert.Error(t, err)
+
+		_, err = querySrvr.BlockFlatFeeTxs(ctx, &rewardsTypes.QueryBlockFlatFeeTxsRequest{Height: ctx.BlockHeight() + 1})
+		assert.Error(t, err)
+	})
+
+	t.Run("OK: pruned block has no txs", func(t *testing.T) {
+		pruneCtx, _ := ctx.CacheContext()
+		keepers.RewardsKeeper.DeleteBlockRewardsCascade(pruneCtx, ctx.BlockHeight())
+
+		res, err := querySrvr.BlockFlatFeeTxs(pruneCtx, &rewardsTypes.QueryBlockFlatFeeTxsRequest{Height: ctx.BlockHeight()})
+		require.NoError(t, err)
+		assert.Empty(t, res.Txs)
+	})
+}
+
+func TestRewardsFeeDeductionAnteHandlerAuthzWithdrawRewards(t *testing.T) {
+	chain := e2eTesting.NewTestChain(t, 1,
+		e2eTesting.WithTxFeeRebatesRewardsRatio(math.LegacyNewDecWithPrec(5, 1)),
+	)
+	acc := chain.GetAccount(0)
+	ctx := chain.GetContext()
+	keepers := chain.GetApp().Keepers
+	querySrvr := rewardsKeeper.NewQueryServer(keepers.RewardsKeeper)
+
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	require.NoError(t, keepers.RewardsKeeper.FlatFees.Set(ctx, contractAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+
+	feeCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	require.NoError(t, keepers.BankKeeper.MintCoins(ctx, mintTypes.ModuleName, feeCoins.Add(feeCoins...)))
+	require.NoError(t, keepers.BankKeeper.SendCoinsFromModuleToAccount(ctx, mintTypes.ModuleName, acc.Address, feeCoins.Add(feeCoins...)))
+
+	anteHandler := ante.NewDeductFeeDecorator(chain.GetAppCodec(), keepers.AccountKeeper, keepers.BankKeeper, keepers.FeeGrantKeeper, keepers.RewardsKeeper, keepers.CWFeesKeeper)
+	deductFees := func(txBytes []byte, msgs ...sdk.Msg) string {
+		keepers.TrackingKeeper.TrackNewTx(ctx) // tracking Ante handler provides a unique tx ID
+
+		execMsg := authz.NewMsgExec(acc.Address, msgs)
+		tx := testutils.NewMockFeeTx(
+			testutils.WithMockFeeTxFees(feeCoins),
+			testutils.WithMockFeeTxPayer(acc.Address),
+			testutils.WithMockFeeTxMsgs(&execMsg),
+		)
+		_, err := anteHandler.AnteHandle(ctx.WithTxBytes(txBytes), tx, false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+
+		return fmt.Sprintf("%X", cmtTypes.Tx(txBytes).Hash())
+	}
+
+	withdrawMsg := rewardsTypes.NewMsgWithdrawRewardsByLimit(acc.Address, 1)
+	executeMsg := &wasmdTypes.MsgExecuteContract{
+		Sender:   acc.Address.String(),
+		Contract: contractAddr.String(),
+	}
+
+	t.Run("OK: wrapped withdraw is neither charged a flat fee nor rebated", func(t *testing.T) {
+		txHash := deductFees([]byte("withdrawTx"), withdrawMsg)
+
+		res, err := querySrvr.TxFeeDistribution(ctx, &rewardsTypes.QueryTxFeeDistributionRequest{TxHash: txHash})
+		require.NoError(t, err)
+		require.Len(t, res.Distributions, 1)
+
+		distr := res.Distributions[0]
+		assert.Equal(t, "1000stake", sdk.Coins(distr.FeeCollectorFees).String())
+		assert.Empty(t, distr.BurntFees)
+		assert.Empty(t, distr.RewardsFees)
+		assert.Empty(t, distr.FlatFees)
+	})
+
+	t.Run("OK: wrapped withdraw next to a wrapped contract execution", func(t *testing.T) {
+		txHash := deductFees([]byte("withdrawAndExecuteTx"), executeMsg, withdrawMsg)
+
+		res, err := querySrvr.TxFeeDistribution(ctx, &rewardsTypes.QueryTxFeeDistributionRequest{TxHash: txHash})
+		require.NoError(t, err)
+		require.Len(t, res.Distributions, 1)
+
+		// Only the contract execution is charged the flat fee, the rest is split between burn and rewards
+		distr := res.Distributions[0]
+		assert.Empty(t, distr.FeeCollectorFees)
+		assert.Equal(t, "100stake", sdk.Coins(distr.FlatFees).String())
+		assert.Equal(t, "450stake", sdk.Coins(distr.BurntFees).String())
+		assert.Equal(t, "450stake", sdk.Coins(distr.RewardsFees).String())
+	})
+}
+
+func TestRewardsFeeDeductionAnteHandlerFeeDenomRoutes(t *testing.T) {
+	chain := e2eTesting.NewTestChain(t, 1)
+	acc := chain.GetAccount(0)
+	ctx := chain.GetContext()
+	keepers := chain.GetApp().Keepers
+	querySrvr := rewardsKeeper.NewQueryServer(keepers.RewardsKeeper)
+
+	// Stable fees are routed to the x/distribution module account, native ones to the rewards treasury
+	params := keepers.RewardsKeeper.GetParams(ctx)
+	params.FeeDenomRoutes = []rewardsTypes.FeeDenomRoute{
+		{Denom: "uusdc", ModuleAccount: distrTypes.ModuleName},
+		{Denom: sdk.DefaultBondDenom, ModuleAccount: rewardsTypes.TreasuryCollector},
+	}
+	require.NoError(t, keepers.RewardsKeeper.Params.Set(ctx, params))
+
+	feeCoins := sdk.NewCoins(
+		sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000),
+		sdk.NewInt64Coin("uusdc", 200),
+		sdk.NewInt64Coin("uatom", 50),
+	)
+	require.NoError(t, keepers.BankKeeper.MintCoins(ctx, mintTypes.ModuleName, feeCoins.Add(feeCoins...)))
+	require.NoError(t, keepers.BankKeeper.SendCoinsFromModuleToAccount(ctx, mintTypes.ModuleName, acc.Address, feeCoins.Add(feeCoins...)))
+
+	getBalance := func(moduleName string) sdk.Coins {
+		return keepers.BankKeeper.GetAllBalances(ctx, keepers.AccountKeeper.GetModuleAddress(moduleName))
+	}
+
+	anteHandler := ante.NewDeductFeeDecorator(chain.GetAppCodec(), keepers.AccountKeeper, keepers.BankKeeper, keepers.FeeGrantKeeper, keepers.RewardsKeeper, keepers.CWFeesKeeper)
+	deductFees := func(ctx sdk.Context, txBytes []byte) (string, error) {
+		keepers.TrackingKeeper.TrackNewTx(ctx) // tracking Ante handler provides a unique tx ID
+
+		tx := testutils.NewMockFeeTx(
+			testutils.WithMockFeeTxFees(feeCoins),
+			testutils.WithMockFeeTxPayer(acc.Address),
+			testutils.WithMockFeeTxMsgs(testutils.NewMockMsg()),
+		)
+		_, err := anteHandler.AnteHandle(ctx.WithTxBytes(txBytes), tx, false, testutils.NoopAnteHandler)
+
+		return fmt.Sprintf("%X", cmtTypes.Tx(txBytes).Hash()), err
+	}
+
+	t.Run("OK: two denoms are routed to different destinations", func(t *testing.T) {
+		feeCollectorBefore := getBalance(authTypes.FeeCollectorName)
+		distrBefore := getBalance(distrTypes.ModuleName)
+		treasuryBefore := getBalance(rewardsTypes.TreasuryCollector)
+
+		txHash, err := deductFees(ctx, []byte("routedTx"))
+		require.NoError(t, err)
+
+		assert.Equal(t, "50uatom", getBalance(authTypes.FeeCollectorName).Sub(feeCollectorBefore...).String())
+		assert.Equal(t, "200uusdc", getBalance(distrTypes.ModuleName).Sub(distrBefore...).String())
+		assert.Equal(t, "1000stake", getBalance(rewardsTypes.TreasuryCollector).Sub(treasuryBefore...).String())
+
+		res, err := querySrvr.TxFeeDistribution(ctx, &rewardsTypes.QueryTxFeeDistributionRequest{TxHash: txHash})
+		require.NoError(t, err)
+		require.Len(t, res.Distributions, 1)
+
+		distr := res.Distributions[0]
+		assert.Equal(t, "50uatom", sdk.Coins(distr.FeeCollectorFees).String())
+		assert.Equal(t, "1000stake,200uusdc", sdk.Coins(distr.RoutedFees).String())
+	})
+
+	t.Run("Fail: route module account not found", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+
+		params.FeeDenomRoutes = []rewardsTypes.FeeDenomRoute{
+			{Denom: "uusdc", ModuleAccount: "unknown"},
+		}
+		require.NoError(t, keepers.RewardsKeeper.Params.Set(ctx, params))
+
+		_, err := deductFees(ctx, []byte("unroutedTx"))
+		require.ErrorIs(t, err, rewardsTypes.ErrInternal)
+	})
+}
+
+func TestRewardsFeeDeductionAnteHandlerFeegrantAuthzFlatFees(t *testing.T) {
+	chain := e2eTesting.NewTestChain(t, 2,
+		e2eTesting.WithTxFeeRebatesRewardsRatio(math.LegacyNewDecWithPrec(5, 1)),
+	)
+	granterAcc, granteeAcc := chain.GetAccount(0), chain.GetAccount(1)
+	ctx := chain.GetContext()
+	keepers := chain.GetApp().Keepers
+
+	// Min fee for the 1000 gas tx wrapping a single execute: 500stake gas fees + 100stake flat fee
+	gasPrice := sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyMustNewDecFromStr("0.5"))
+	params := keepers.RewardsKeeper.GetParams(ctx)
+	params.MinPriceOfGas = gasPrice
+	require.NoError(t, keepers.RewardsKeeper.Params.Set(ctx, params))
+	require.NoError(t, keepers.RewardsKeeper.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(gasPrice)}))
+
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	require.NoError(t, keepers.RewardsKeeper.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+		ContractAddress: contractAddr.String(),
+		OwnerAddress:    granterAcc.Address.String(),
+		RewardsAddress:  granterAcc.Address.String(),
+	}))
+	require.NoError(t, keepers.RewardsKeeper.FlatFees.Set(ctx, contractAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+
+	execMsg := authz.NewMsgExec(granteeAcc.Address, []sdk.Msg{
+		&wasmdTypes.MsgExecuteContract{
+			Sender:   granterAcc.Address.String(),
+			Contract: contractAddr.String(),
+		},
+	})
+	newTx := func(fees sdk.Coins) sdk.Tx {
+		return testutils.NewMockFeeTx(
+			testutils.WithMockFeeTxFees(fees),
+			testutils.WithMockFeeTxGas(1000),
+			testutils.WithMockFeeTxPayer(granteeAcc.Address),
+			testutils.WithMockFeeTxGranter(granterAcc.Address),
+			testutils.WithMockFeeTxMsgs(&execMsg),
+		)
+	}
+	grantAllowance := func(ctx sdk.Context, spendLimit sdk.Coins) {
+		require.NoError(t, keepers.FeeGrantKeeper.GrantAllowance(ctx, granterAcc.Address, granteeAcc.Address, &feegrant.BasicAllowance{
+			SpendLimit: spendLimit,
+		}))
+	}
+	getSpendLimit := func(ctx sdk.Context) sdk.Coins {
+		allowance, err := keepers.FeeGrantKeeper.GetAllowance(ctx, granterAcc.Address, granteeAcc.Address)
+		require.NoError(t, err)
+		return allowance.(*feegrant.BasicAllowance).SpendLimit
+	}
+
+	minFeeDecorator := ante.NewMinFeeDecorator(chain.GetAppCodec(), keepers.RewardsKeeper)
+	deductFeeDecorator := ante.NewDeductFeeDecorator(chain.GetAppCodec(), keepers.AccountKeeper, keepers.BankKeeper, keepers.FeeGrantKeeper, keepers.RewardsKeeper, keepers.CWFeesKeeper)
+	anteHandler := sdk.ChainAnteDecorators(minFeeDecorator, deductFeeDecorator)
+
+	t.Run("OK: allowance covers the gas and flat fees of the wrapped execute", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		keepers.TrackingKeeper.TrackNewTx(ctx) // tracking Ante handler provides a unique tx ID
+		grantAllowance(ctx, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+
+		granterBalanceBefore := keepers.BankKeeper.GetAllBalances(ctx, granterAcc.Address)
+		granteeBalanceBefore := keepers.BankKeeper.GetAllBalances(ctx, granteeAcc.Address)
+
+		_, err := anteHandler(ctx, newTx(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 600))), false)
+		require.NoError(t, err)
+
+		assert.Equal(t, "600stake", granterBalanceBefore.Sub(keepers.BankKeeper.GetAllBalances(ctx, granterAcc.Address)...).String())
+		assert.Equal(t, granteeBalanceBefore.String(), keepers.BankKeeper.GetAllBalances(ctx, granteeAcc.Address).String())
+		assert.Equal(t, "400stake", getSpendLimit(ctx).String())
+	})
+
+	t.Run("Fail: allowance covers the gas fees only", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		grantAllowance(ctx, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500)))
+
+		_, err := anteHandler(ctx, newTx(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 600))), false)
+		require.ErrorIs(t, err, feegrant.ErrFeeLimitExceeded)
+		assert.Equal(t, "500stake", getSpendLimit(ctx).String())
+	})
+
+	t.Run("Fail: granted fees do not cover the wrapped execute flat fee", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		grantAllowance(ctx, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+
+		// The MinFeeDecorator is skipped, so the combined check relies on the contract flat fees only
+		_, err := deductFeeDecorator.AnteHandle(ctx, newTx(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50))), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+		assert.Equal(t, "1000stake", getSpendLimit(ctx).String())
+	})
+}
@@ -0,0 +1,63 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	wasmTypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
+)
+
+// RewardsExtensionOptionChecker is an ante.ExtensionOptionChecker that only accepts the x/rewards tx extension options
+// (the dynamic fee and the flat fee tip ones).
+func RewardsExtensionOptionChecker(any *codectypes.Any) bool {
+	switch any.GetCachedValue().(type) {
+	case *rewardsTypes.ExtensionOptionDynamicFee, *rewardsTypes.ExtensionOptionFlatFeeTip:
+		return true
+	}
+	return false
+}
+
+// getFlatFeeTips returns the contract tips defined by the tx flat fee tip extension option (nil if not set).
+func getFlatFeeTips(tx sdk.Tx) ([]rewardsTypes.FlatFeeTip, error) {
+	extTx, ok := tx.(ante.HasExtensionOptionsTx)
+	if !ok {
+		return nil, nil
+	}
+
+	for _, opt := range extTx.GetExtensionOptions() {
+		if tipOpt, ok := opt.GetCachedValue().(*rewardsTypes.ExtensionOptionFlatFeeTip); ok {
+			if err := tipOpt.Validate(); err != nil {
+				return nil, errorsmod.Wrapf(sdkErrors.ErrInvalidRequest, "flat fee tip extension option: %v", err)
+			}
+			return tipOpt.Tips, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// getExecutedContracts returns the set of contracts executed by the tx msgs (unwrapping authz msgs).
+func getExecutedContracts(msgs []sdk.Msg) map[string]struct{} {
+	contracts := make(map[string]struct{})
+	for _, m := range msgs {
+		switch msg := m.(type) {
+		case *wasmTypes.MsgExecuteContract:
+			if contractAddr, err := sdk.AccAddressFromBech32(msg.Contract); err == nil {
+				contracts[contractAddr.String()] = struct{}{}
+			}
+		case *authz.MsgExec:
+			if authzMsgs, err := msg.GetMessages(); err == nil {
+				for contractAddr := range getExecutedContracts(authzMsgs) {
+					contracts[contractAddr] = struct{}{}
+				}
+			}
+		}
+	}
+
+	return contracts
+}
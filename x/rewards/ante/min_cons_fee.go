@@ -1,11 +1,16 @@
 package ante
 
 import (
+	"math"
+
 	errorsmod "cosmossdk.io/errors"
-	math "cosmossdk.io/math"
+	sdkMath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txTypes "github.com/cosmos/cosmos-sdk/types/tx"
+
+	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
 )
 
 // MinFeeDecorator rejects transaction if its fees are less than minimum fees defined by the x/rewards module.
@@ -32,30 +37,315 @@ func (mfd MinFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool,
 		return ctx, errorsmod.Wrap(sdkErrors.ErrTxDecode, "Tx must be a FeeTx")
 	}
 
-	var expectedFees sdk.Coins // All the fees which need to be paid for the given tx. includes min consensus fee + every contract flat fee
-	computationalGasPrice := mfd.rewardsKeeper.ComputationalPriceOfGas(ctx)
-	expectedFees = expectedFees.Add(
-		sdk.NewCoin(
-			computationalGasPrice.Denom,
-			computationalGasPrice.Amount.MulInt(math.NewIntFromUint64(feeTx.GetGas())).TruncateInt(),
-		),
-	)
+	// Resolved once, so the flat fee signer check and the fee-free txs budget refer to the same (possibly multisig) account
+	feePayer := getTxFeePayer(tx, feeTx)
+
+	txGas := getTxGasLimit(tx, feeTx)
+	if err := validateTxGas(ctx, txGas); err != nil {
+		return ctx, err
+	}
+
+	// Governance fee promotion discounts the min fee: the gas price (so the dynamic fee base gas price is discounted too),
+	// the tx size surcharge and the contract flat fees (voluntary tips are not discounted)
+	promotionDiscount := mfd.rewardsKeeper.FeePromotionDiscount(ctx)
+
+	computationalGasPrice := rewardsTypes.DiscountGasPrice(mfd.rewardsKeeper.ComputationalPriceOfGas(ctx), promotionDiscount)
+	// Mempool admission might be tightened by the node local min gas price (not discounted, it is up to the node operator),
+	// the local price only raises the bar, so the CheckTx admitted txs are never rejected by DeliverTx for the min fee
+	if ctx.IsCheckTx() && !simulate && mfd.rewardsKeeper.CheckTxLocalMinGasPriceEnabled(ctx) {
+		localGasPrice := ctx.MinGasPrices().AmountOf(computationalGasPrice.Denom)
+		computationalGasPrice = sdk.NewDecCoinFromDec(computationalGasPrice.Denom, sdkMath.LegacyMaxDec(localGasPrice, computationalGasPrice.Amount))
+	}
+	gasFees := rewardsTypes.MinGasFees(computationalGasPrice, txGas)
+
+	// Tx size surcharge is a part of the gas fees (the gas price denom) taken from the encoded tx bytes
+	sizeFees := rewardsTypes.TxSizeFees(computationalGasPrice.Denom, len(ctx.TxBytes()), mfd.rewardsKeeper.TxSizeFeePerByte(ctx))
+	sizeFees = rewardsTypes.ApplyFeeDiscount(sizeFees, promotionDiscount)
+	gasFees = gasFees.Add(sizeFees...)
 
 	// Get flatfees for any contracts being called in the tx.msgs
-	for _, m := range tx.GetMsgs() {
-		contractFlatFees, _, err := GetContractFlatFees(ctx, mfd.rewardsKeeper, mfd.codec, m)
+	var flatFees sdk.Coins
+	var deferredFlatFees rewardsTypes.DeferredFlatFees
+	msgsFlatFees := make([]sdk.Coins, len(tx.GetMsgs())) // charged flat fees per msg (prepaid ones excluded)
+	hasWasmMsgs := false
+	maxFlatFeeMsgs, flatFeeMsgs := mfd.rewardsKeeper.MaxFlatFeeMsgsPerTx(ctx), uint64(0)
+	for i, m := range tx.GetMsgs() {
+		contractFlatFees, hwm, err := GetContractFlatFees(ctx, mfd.rewardsKeeper, mfd.codec, m, feeTx.GetFee())
 		if err != nil {
 			return ctx, err
 		}
+		hasWasmMsgs = hasWasmMsgs || hwm
+		// Checked before any flat fee is charged to bound the work done for pathological msg batches
+		flatFeeMsgs += uint64(len(contractFlatFees))
+		if maxFlatFeeMsgs > 0 && flatFeeMsgs > maxFlatFeeMsgs {
+			return ctx, errorsmod.Wrapf(sdkErrors.ErrInvalidRequest, "tx flat fee msgs number exceeds the limit %d", maxFlatFeeMsgs)
+		}
 		for _, cff := range contractFlatFees {
-			mfd.rewardsKeeper.CreateFlatFeeRewardsRecords(ctx, cff.ContractAddress, cff.FlatFees)
-			expectedFees = expectedFees.Add(cff.FlatFees...)
+			// Prepaid executions are not charged (the flat fee was paid by the contract owner in advance)
+			if mfd.rewardsKeeper.ConsumeFlatFeeCredit(ctx, cff.ContractAddress) {
+				continue
+			}
+			// Third parties paying the tx fee must not be able to charge flat fees for msgs they don't sign
+			if mfd.rewardsKeeper.FlatFeePayerMustSign(ctx) {
+				if err := validateFlatFeePayer(feePayer, m, cff.ContractAddress); err != nil {
+					return ctx, err
+				}
+			}
+			// Contracts are rewarded the discounted flat fee (the one actually charged)
+			contractFlatFee := rewardsTypes.ApplyFeeDiscount(cff.FlatFees, promotionDiscount)
+			// Contracts opted in for the on-success flat fees are charged by the post handler (the fee must still be paid)
+			if isFlatFeeOnSuccess(ctx, mfd.rewardsKeeper, cff.ContractAddress) {
+				deferredFlatFees.Fees = append(deferredFlatFees.Fees, rewardsTypes.DeferredFlatFee{
+					MsgIndex:        i,
+					ContractAddress: cff.ContractAddress,
+					FlatFees:        contractFlatFee,
+				})
+			} else {
+				// Flat fees that can't be attributed to the contract are not charged
+				if !mfd.rewardsKeeper.CreateFlatFeeRewardsRecords(ctx, cff.ContractAddress, contractFlatFee) {
+					continue
+				}
+				rewardsTypes.EmitContractFlatFeeChargedEvent(ctx, i, cff.ContractAddress, contractFlatFee)
+			}
+			flatFees = flatFees.Add(contractFlatFee...)
+			msgsFlatFees[i] = msgsFlatFees[i].Add(contractFlatFee...)
 		}
 	}
 
-	txFees := feeTx.GetFee()
-	if simulate || expectedFees.IsZero() || txFees.IsAllGTE(expectedFees) {
+	// Voluntary tips are charged on top of the flat fees and attributed to the executed contracts (others are ignored)
+	var tipFees sdk.Coins
+	tips, err := getFlatFeeTips(tx)
+	if err != nil {
+		return ctx, err
+	}
+	if len(tips) > 0 {
+		executedContracts := getExecutedContracts(tx.GetMsgs())
+		for _, tip := range tips {
+			contractAddr := sdk.MustAccAddressFromBech32(tip.ContractAddress)
+			if _, ok := executedContracts[contractAddr.String()]; !ok {
+				continue
+			}
+			if !mfd.rewardsKeeper.DistributeFlatFeeTip(ctx, contractAddr, tip.Amount) {
+				continue
+			}
+			tipFees = tipFees.Add(tip.Amount...)
+			rewardsTypes.EmitContractFlatFeeTipEvent(ctx, contractAddr, feePayer, tip.Amount)
+		}
+	}
+
+	// Same denom flat fees might be counted toward the gas fees instead of being stacked on top of them
+	// (the dynamic fee mode settles the gas fees paid separately from the flat fees, so they are always stacked there)
+	// (if the tx declares the per-msg gas, every msg flat fee is absorbed into the gas fees of the msg gas share only)
+	if mfd.rewardsKeeper.FlatFeeAbsorbedInGasFee(ctx) && !mfd.rewardsKeeper.DynamicFeeEnabled(ctx) {
+		if msgsGas := getTxMsgsGas(tx); msgsGas != nil {
+			gasFees = rewardsTypes.AbsorbMsgFlatFees(gasFees, computationalGasPrice, rewardsTypes.SplitTxGas(txGas, msgsGas), msgsFlatFees)
+		} else {
+			gasFees = rewardsTypes.AbsorbFlatFees(gasFees, flatFees)
+		}
+	}
+	// Tips are never absorbed, those are always paid on top of the min fee
+	flatFees = flatFees.Add(tipFees...)
+
+	ctx = rewardsTypes.WithTxFlatFees(ctx, flatFees) // used by the DeductFeeDecorator to split the fees
+	if len(deferredFlatFees.Fees) > 0 {
+		ctx = rewardsTypes.WithDeferredFlatFees(ctx, deferredFlatFees) // withheld by the DeductFeeDecorator
+	}
+
+	// Zero min fee is floored to 1 unit of the gas price denom (zero-fee txs are rejected)
+	if gasFees.IsZero() && flatFees.IsZero() && mfd.rewardsKeeper.MinFeeFloorEnabled(ctx) {
+		gasFees = rewardsTypes.MinFeeFloor(computationalGasPrice.Denom)
+	}
+
+	// Simulation is never rejected, the estimated fees are reported via an event for the simulation response instead
+	if simulate {
+		rewardsTypes.EmitTxFeesEstimateEvent(ctx, gasFees, flatFees)
 		return next(ctx, tx, simulate)
 	}
-	return ctx, errorsmod.Wrapf(sdkErrors.ErrInsufficientFee, "tx fee %s is less than min fee: %s", txFees, expectedFees.String())
+
+	// Under-estimated gas would fail the contract execution after the fees are taken
+	if minGas := mfd.rewardsKeeper.MinContractExecutionGas(ctx); hasWasmMsgs && txGas < minGas {
+		return ctx, errorsmod.Wrapf(sdkErrors.ErrInvalidRequest, "tx gas limit %d is less than the min contract execution gas %d", txGas, minGas)
+	}
+
+	// All the fees which need to be paid for the given tx. includes min consensus fee + every contract flat fee
+	expectedFees, err := rewardsTypes.MinTxFees(gasFees, flatFees)
+	if err != nil {
+		return ctx, err
+	}
+
+	txFees := feeTx.GetFee()
+	if err := validateTxFees(txFees, mfd.rewardsKeeper.AcceptedFeeDenoms(ctx)); err != nil {
+		return ctx, err
+	}
+	if len(txFees) > 1 && mfd.rewardsKeeper.SingleDenomFeesOnly(ctx) {
+		return ctx, errorsmod.Wrapf(sdkErrors.ErrInvalidCoins, "tx fee %s must be paid in a single denom", txFees)
+	}
+
+	if err := validateTxFeeDenomsRelevant(txFees, expectedFees); err != nil {
+		return ctx, err
+	}
+	if !rewardsTypes.IsTxFeeSufficient(txFees, gasFees, flatFees) {
+		// Fee payer (the primary signer unless set explicitly) might have fee-free txs left (flat fees are always charged)
+		if flatFees.IsZero() && feePayer != nil && mfd.rewardsKeeper.ConsumeFreeTx(ctx, feePayer) {
+			return next(ctx, tx, simulate)
+		}
+		return ctx, rewardsTypes.NewInsufficientFeeError(txFees, expectedFees)
+	}
+	ctx = rewardsTypes.WithTxMinFee(ctx, expectedFees) // reported by the FeeMetricsDecorator post handler
+
+	// Wallets reading the CheckTx response could get the min fee expected via the estimation event
+	// (the response log is empty for accepted txs, while the ante handler events are included)
+	if ctx.IsCheckTx() && !ctx.IsReCheckTx() && mfd.rewardsKeeper.CheckTxMinFeeEventEnabled(ctx) {
+		rewardsTypes.EmitTxFeesEstimateEvent(ctx, gasFees, flatFees)
+	}
+
+	// Dynamic fee mode: the computational gas price is the base gas price, gas fees are settled by the post handler
+	// (the tx size surcharge is not refundable, so it is withheld along with the flat fees)
+	if txGas > 0 && mfd.rewardsKeeper.DynamicFeeEnabled(ctx) {
+		refund, err := estimateDynamicFeeRefund(txFees, flatFees.Add(sizeFees...), txGas, computationalGasPrice, getMaxPriorityPrice(tx))
+		if err != nil {
+			return ctx, err
+		}
+		if !refund.GasFees.IsZero() {
+			ctx = rewardsTypes.WithDynamicFeeRefund(ctx, refund)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// validateTxFees checks that the tx fees are a valid coins set and are paid in the accepted denoms only
+// (any denom if the list is empty).
+// The fees are matched against the min fee per denom, so unsorted or duplicated denoms would be matched incorrectly.
+func validateTxFees(txFees sdk.Coins, acceptedDenoms []string) error {
+	if err := txFees.Validate(); err != nil {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidCoins, "invalid tx fees (%s): %v", txFees, err)
+	}
+
+	for _, fee := range txFees {
+		if !rewardsTypes.IsFeeDenomAccepted(acceptedDenoms, fee.Denom) {
+			return errorsmod.Wrapf(sdkErrors.ErrInvalidCoins, "tx fee denom %s is not accepted (accepted denoms: %v)", fee.Denom, acceptedDenoms)
+		}
+	}
+
+	return nil
+}
+
+// validateTxFeeDenomsRelevant checks that the tx fees are paid in at least one of the min fee denoms (the gas price denom
+// or a contract flat fee denom). Fees paid entirely in other denoms could never cover the min fee regardless of the amount.
+// Empty tx fees and zero min fees are not checked (rejected or accepted by the amount comparison).
+func validateTxFeeDenomsRelevant(txFees, expectedFees sdk.Coins) error {
+	if txFees.IsZero() || expectedFees.IsZero() {
+		return nil
+	}
+
+	for _, fee := range txFees {
+		if expectedFees.AmountOf(fee.Denom).IsPositive() {
+			return nil
+		}
+	}
+
+	return errorsmod.Wrapf(rewardsTypes.ErrIrrelevantFeeDenom, "tx fee %s is not paid in any of the min fee %s denoms", txFees, expectedFees)
+}
+
+// validateFlatFeePayer checks that the fee payer is the signer of the msg charged the contract flat fees.
+func validateFlatFeePayer(feePayer sdk.AccAddress, m sdk.Msg, contractAddr sdk.AccAddress) error {
+	signerAddr, err := sdk.AccAddressFromBech32(getFlatFeeMsgSigner(m))
+	if err != nil || !signerAddr.Equals(feePayer) {
+		return errorsmod.Wrapf(sdkErrors.ErrUnauthorized, "fee payer %s must sign the msg charged the contract (%s) flat fee", feePayer, contractAddr)
+	}
+
+	return nil
+}
+
+// validateTxGas checks that the tx gas limit is within the bounds a block can accommodate.
+// Gas limit is reported by the tx itself, so a malformed tx could report a value that can never be consumed
+// (up to math.MaxUint64) producing a misleading min fee estimation.
+func validateTxGas(ctx sdk.Context, txGas uint64) error {
+	if txGas > math.MaxInt64 {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidRequest, "tx gas limit %d exceeds the max allowed value %d", txGas, int64(math.MaxInt64))
+	}
+
+	if blockParams := ctx.ConsensusParams().Block; blockParams != nil && blockParams.MaxGas > 0 {
+		if txGas > uint64(blockParams.MaxGas) {
+			return errorsmod.Wrapf(sdkErrors.ErrInvalidRequest, "tx gas limit %d exceeds the block max gas %d", txGas, blockParams.MaxGas)
+		}
+	}
+
+	return nil
+}
+
+// signersTx defines the interface of a tx reporting its signers (the x/auth/tx wrapper).
+type signersTx interface {
+	GetSigners() ([][]byte, error)
+}
+
+// getTxFeePayer returns the effective tx fee payer: the explicitly set fee payer or the first tx signer otherwise.
+// A multisig account signs with a single multisig public key, so the signer address is the multisig account address
+// (not the one of an individual key holder) and is used as is.
+// Returns nil if the fee payer could not be resolved.
+func getTxFeePayer(tx sdk.Tx, feeTx sdk.FeeTx) sdk.AccAddress {
+	if payer := feeTx.FeePayer(); len(payer) > 0 {
+		return payer
+	}
+
+	if sTx, ok := tx.(signersTx); ok {
+		if signers, err := sTx.GetSigners(); err == nil && len(signers) > 0 {
+			return signers[0]
+		}
+	}
+
+	return nil
+}
+
+// gasLimitTx defines the interface of a tx conveying the gas limit via the gas estimation fields
+// instead of the fee gas limit.
+type gasLimitTx interface {
+	GetGasLimit() uint64
+}
+
+// getTxGasLimit returns the effective tx gas limit resolved from all the supported sources.
+// The first non-zero value is used (sources are listed by priority):
+//   - the explicit fee tx gas limit (sdk.FeeTx.GetGas);
+//   - the gas estimation fields gas limit (gasLimitTx);
+//   - the proto tx auth info fee gas limit (the x/auth/tx wrapper);
+func getTxGasLimit(tx sdk.Tx, feeTx sdk.FeeTx) uint64 {
+	if gas := feeTx.GetGas(); gas > 0 {
+		return gas
+	}
+
+	if glTx, ok := tx.(gasLimitTx); ok {
+		if gas := glTx.GetGasLimit(); gas > 0 {
+			return gas
+		}
+	}
+
+	if protoTx, ok := tx.(interface{ GetProtoTx() *txTypes.Tx }); ok {
+		if fee := protoTx.GetProtoTx().GetAuthInfo().GetFee(); fee != nil {
+			return fee.GasLimit
+		}
+	}
+
+	return 0
+}
+
+// msgsGasTx defines the interface of a tx declaring the gas allocated to every msg.
+type msgsGasTx interface {
+	GetMsgsGas() []uint64
+}
+
+// getTxMsgsGas returns the per-msg gas declared by the tx (msgsGasTx).
+// Nil is returned if the tx doesn't declare it or the values don't match the tx msgs.
+func getTxMsgsGas(tx sdk.Tx) []uint64 {
+	mgTx, ok := tx.(msgsGasTx)
+	if !ok {
+		return nil
+	}
+
+	msgsGas := mgTx.GetMsgsGas()
+	if len(msgsGas) == 0 || len(msgsGas) != len(tx.GetMsgs()) {
+		return nil
+	}
+
+	return msgsGas
 }
@@ -1,5 +1,33 @@
 package ante_test
 
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkMath "cosmossdk.io/math"
+	wasmTypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtProto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptoTypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/archway-network/archway/pkg/testutils"
+	"github.com/archway-network/archway/x/rewards/ante"
+	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
+)
+
 // func TestRewardsMinFeeAnteHandler(t *testing.T) {
 // 	type testCase struct {
 // 		name string
@@ -374,3 +402,3064 @@ package ante_test
 // 	_, err = anteHandler.AnteHandle(chain.GetContext(), tx, false, testutils.NoopAnteHandler)
 // 	require.NoError(t, err)
 // }
+
+func TestRewardsMinFeeAnteHandlerMultiDenom(t *testing.T) {
+	type testCase struct {
+		name string
+		// Inputs
+		txFees string // transaction fees [sdk.Coins]
+		// Output expected
+		errExpected error // concrete error expected (or nil if no error expected)
+	}
+
+	// Min fee is 100stake (1000 gas * 0.1stake) + 50uarch (contract flat fee)
+	contractAddr := sdk.AccAddress("contractAddr________")
+	rewardsAddr := sdk.AccAddress("rewardsAddr_________")
+
+	testCases := []testCase{
+		{
+			name:   "OK: both denoms covered",
+			txFees: "100stake,50uarch",
+		},
+		{
+			name:        "Fail: one denom is not covered",
+			txFees:      "100stake,49uarch",
+			errExpected: sdkErrors.ErrInsufficientFee,
+		},
+		{
+			name:        "Fail: flat fee denom is missing",
+			txFees:      "1000stake",
+			errExpected: sdkErrors.ErrInsufficientFee,
+		},
+		{
+			name:        "Fail: gas fee denom is not covered",
+			txFees:      "1stake,1000uarch",
+			errExpected: sdkErrors.ErrInsufficientFee,
+		},
+		{
+			name:        "Fail: no denom covered",
+			txFees:      "99stake,49uarch",
+			errExpected: sdkErrors.ErrInsufficientFee,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx, _ := testutils.RewardsKeeper(t)
+
+			minConsFee, err := sdk.ParseDecCoin("0.1stake")
+			require.NoError(t, err)
+			require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+
+			require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+				ContractAddress: contractAddr.String(),
+				OwnerAddress:    rewardsAddr.String(),
+				RewardsAddress:  rewardsAddr.String(),
+			}))
+			require.NoError(t, k.FlatFees.Set(ctx, contractAddr, sdk.NewInt64Coin("uarch", 50)))
+
+			txFees, err := sdk.ParseCoinsNormalized(tc.txFees)
+			require.NoError(t, err)
+			tx := testutils.NewMockFeeTx(
+				testutils.WithMockFeeTxFees(txFees),
+				testutils.WithMockFeeTxGas(1000),
+				testutils.WithMockFeeTxMsgs(&wasmTypes.MsgExecuteContract{
+					Sender:   rewardsAddr.String(),
+					Contract: contractAddr.String(),
+				}),
+			)
+
+			cdc := codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+			anteHandler := ante.NewMinFeeDecorator(cdc, k)
+			_, err = anteHandler.AnteHandle(ctx, tx, false, testutils.NoopAnteHandler)
+			if tc.errExpected != nil {
+				require.ErrorIs(t, err, tc.errExpected)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRewardsMinFeeAnteHandlerGasBounds(t *testing.T) {
+	type testCase struct {
+		name string
+		// Inputs
+		txGasLimit  uint64 // transaction gas limit
+		blockMaxGas int64  // block max gas consensus param (0 - not set)
+		// Output expected
+		errExpected error // concrete error expected (or nil if no error expected)
+	}
+
+	testCases := []testCase{
+		{
+			name:       "OK: reasonable gas limit",
+			txGasLimit: 1000,
+		},
+		{
+			name:        "OK: gas limit equals the block max gas",
+			txGasLimit:  1000,
+			blockMaxGas: 1000,
+		},
+		{
+			name:        "Fail: gas limit exceeds the block max gas",
+			txGasLimit:  1001,
+			blockMaxGas: 1000,
+			errExpected: sdkErrors.ErrInvalidRequest,
+		},
+		{
+			name:        "Fail: gas limit is uint64 max",
+			txGasLimit:  math.MaxUint64,
+			errExpected: sdkErrors.ErrInvalidRequest,
+		},
+		{
+			name:        "Fail: gas limit is int64 max + 1",
+			txGasLimit:  math.MaxInt64 + 1,
+			errExpected: sdkErrors.ErrInvalidRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx, _ := testutils.RewardsKeeper(t)
+			ctx = ctx.WithConsensusParams(cmtProto.ConsensusParams{
+				Block: &cmtProto.BlockParams{MaxGas: tc.blockMaxGas},
+			})
+
+			minConsFee, err := sdk.ParseDecCoin("0.1stake")
+			require.NoError(t, err)
+			require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+
+			tx := testutils.NewMockFeeTx(
+				testutils.WithMockFeeTxFees(sdk.NewCoins(sdk.NewInt64Coin("stake", 100))),
+				testutils.WithMockFeeTxGas(tc.txGasLimit),
+			)
+
+			cdc := codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+			anteHandler := ante.NewMinFeeDecorator(cdc, k)
+			_, err = anteHandler.AnteHandle(ctx, tx, false, testutils.NoopAnteHandler)
+			if tc.errExpected != nil {
+				require.ErrorIs(t, err, tc.errExpected)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRewardsMinFeeAnteHandlerFlatFeeExemptCallers(t *testing.T) {
+	type testCase struct {
+		name string
+		// Inputs
+		exemptCallers []sdk.AccAddress // contract flat fee exempt callers
+		txFees        string           // transaction fees [sdk.Coins]
+		// Output expected
+		errExpected error // concrete error expected (or nil if no error expected)
+	}
+
+	// Min fee is 100stake (1000 gas * 0.1stake) + 50stake (contract flat fee)
+	contractAddr := sdk.AccAddress("contractAddr________")
+	ownerAddr := sdk.AccAddress("ownerAddr___________")
+	callerAddr := sdk.AccAddress("callerAddr__________")
+	otherAddr := sdk.AccAddress("otherAddr___________")
+
+	testCases := []testCase{
+		{
+			name:          "OK: allowlisted caller is not charged the flat fee",
+			exemptCallers: []sdk.AccAddress{otherAddr, callerAddr},
+			txFees:        "100stake",
+		},
+		{
+			name:          "Fail: non-allowlisted caller is charged the flat fee",
+			exemptCallers: []sdk.AccAddress{otherAddr},
+			txFees:        "100stake",
+			errExpected:   sdkErrors.ErrInsufficientFee,
+		},
+		{
+			name:        "Fail: empty allowlist",
+			txFees:      "100stake",
+			errExpected: sdkErrors.ErrInsufficientFee,
+		},
+		{
+			name:   "OK: empty allowlist with the flat fee paid",
+			txFees: "150stake",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx, _ := testutils.RewardsKeeper(t)
+
+			minConsFee, err := sdk.ParseDecCoin("0.1stake")
+			require.NoError(t, err)
+			require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+
+			var exemptCallers []string
+			for _, addr := range tc.exemptCallers {
+				exemptCallers = append(exemptCallers, addr.String())
+			}
+			require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+				ContractAddress:      contractAddr.String(),
+				OwnerAddress:         ownerAddr.String(),
+				RewardsAddress:       ownerAddr.String(),
+				FlatFeeExemptCallers: exemptCallers,
+			}))
+			require.NoError(t, k.FlatFees.Set(ctx, contractAddr, sdk.NewInt64Coin("stake", 50)))
+
+			txFees, err := sdk.ParseCoinsNormalized(tc.txFees)
+			require.NoError(t, err)
+			tx := testutils.NewMockFeeTx(
+				testutils.WithMockFeeTxFees(txFees),
+				testutils.WithMockFeeTxGas(1000),
+				testutils.WithMockFeeTxMsgs(&wasmTypes.MsgExecuteContract{
+					Sender:   callerAddr.String(),
+					Contract: contractAddr.String(),
+				}),
+			)
+
+			cdc := codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+			anteHandler := ante.NewMinFeeDecorator(cdc, k)
+			_, err = anteHandler.AnteHandle(ctx, tx, false, testutils.NoopAnteHandler)
+			if tc.errExpected != nil {
+				require.ErrorIs(t, err, tc.errExpected)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRewardsMinFeeAnteHandlerFlatFeeAcceptedDenoms(t *testing.T) {
+	type testCase struct {
+		name string
+		// Inputs
+		acceptedDenoms []string // contract flat fee accepted denoms
+		conversion     bool     // uarch flat fee is accepted in stake (2stake per 1uarch)
+		txFees         string   // transaction fees [sdk.Coins]
+		// Output expected
+		errExpected error // concrete error expected (or nil if no error expected)
+	}
+
+	// Min fee is 100stake (1000 gas * 0.1stake) + 50uarch (contract flat fee, 100stake if converted)
+	contractAddr := sdk.AccAddress("contractAddr________")
+	ownerAddr := sdk.AccAddress("ownerAddr___________")
+
+	testCases := []testCase{
+		{
+			name:   "OK: any denom is accepted if not declared",
+			txFees: "100stake,50uarch",
+		},
+		{
+			name:           "OK: flat fee paid in the accepted denom",
+			acceptedDenoms: []string{"ibc/usdc", "uarch"},
+			txFees:         "100stake,50uarch",
+		},
+		{
+			name:           "Fail: flat fee denom is not accepted",
+			acceptedDenoms: []string{"ibc/usdc"},
+			txFees:         "100stake,50uarch",
+			errExpected:    sdkErrors.ErrInvalidCoins,
+		},
+		{
+			name:           "OK: converted flat fee paid in the accepted denom",
+			acceptedDenoms: []string{"stake"},
+			conversion:     true,
+			txFees:         "200stake",
+		},
+		{
+			name:           "Fail: converted flat fee denom is not accepted",
+			acceptedDenoms: []string{"uarch"},
+			conversion:     true,
+			txFees:         "200stake",
+			errExpected:    sdkErrors.ErrInvalidCoins,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx, _ := testutils.RewardsKeeper(t)
+
+			if tc.conversion {
+				params := k.GetParams(ctx)
+				params.FlatFeeConversionRates = []rewardsTypes.FlatFeeConversionRate{
+					{Denom: "uarch", FeeDenom: "stake", Rate: sdkMath.LegacyNewDec(2)},
+				}
+				require.NoError(t, k.Params.Set(ctx, params))
+			}
+
+			minConsFee, err := sdk.ParseDecCoin("0.1stake")
+			require.NoError(t, err)
+			require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+
+			require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+				ContractAddress:       contractAddr.String(),
+				OwnerAddress:          ownerAddr.String(),
+				RewardsAddress:        ownerAddr.String(),
+				FlatFeeAcceptedDenoms: tc.acceptedDenoms,
+			}))
+			require.NoError(t, k.FlatFees.Set(ctx, contractAddr, sdk.NewInt64Coin("uarch", 50)))
+
+			txFees, err := sdk.ParseCoinsNormalized(tc.txFees)
+			require.NoError(t, err)
+			tx := testutils.NewMockFeeTx(
+				testutils.WithMockFeeTxFees(txFees),
+				testutils.WithMockFeeTxGas(1000),
+				testutils.WithMockFeeTxMsgs(&wasmTypes.MsgExecuteContract{
+					Sender:   ownerAddr.String(),
+					Contract: contractAddr.String(),
+				}),
+			)
+
+			cdc := codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+			anteHandler := ante.NewMinFeeDecorator(cdc, k)
+			_, err = anteHandler.AnteHandle(ctx, tx, false, testutils.NoopAnteHandler)
+			if tc.errExpected != nil {
+				require.ErrorIs(t, err, tc.errExpected)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRewardsMinFeeAnteHandlerMethodFlatFee(t *testing.T) {
+	type testCase struct {
+		name string
+		// Inputs
+		executeMsg string // contract execute msg JSON
+		txFees     string // transaction fees [sdk.Coins]
+		// Output expected
+		errExpected error // concrete error expected (or nil if no error expected)
+	}
+
+	// Min fee is 100stake (1000 gas * 0.1stake) + 50stake (contract flat fee) or 200stake ("mint" method flat fee)
+	contractAddr := sdk.AccAddress("contractAddr________")
+	ownerAddr := sdk.AccAddress("ownerAddr___________")
+	callerAddr := sdk.AccAddress("callerAddr__________")
+
+	testCases := []testCase{
+		{
+			name:       "OK: method flat fee paid",
+			executeMsg: `{"mint":{"amount":"100"}}`,
+			txFees:     "300stake",
+		},
+		{
+			name:        "Fail: method flat fee not paid (contract flat fee paid)",
+			executeMsg:  `{"mint":{"amount":"100"}}`,
+			txFees:      "150stake",
+			errExpected: sdkErrors.ErrInsufficientFee,
+		},
+		{
+			name:       "OK: unit variant method flat fee paid",
+			executeMsg: `"mint"`,
+			txFees:     "300stake",
+		},
+		{
+			name:       "OK: fallback to the contract flat fee for other methods",
+			executeMsg: `{"transfer":{"recipient":"addr"}}`,
+			txFees:     "150stake",
+		},
+		{
+			name:        "Fail: fallback to the contract flat fee not paid",
+			executeMsg:  `{"transfer":{"recipient":"addr"}}`,
+			txFees:      "100stake",
+			errExpected: sdkErrors.ErrInsufficientFee,
+		},
+		{
+			name:       "OK: fallback to the contract flat fee for a malformed msg",
+			executeMsg: `{"mint":{"amount":`,
+			txFees:     "150stake",
+		},
+		{
+			name:       "OK: fallback to the contract flat fee for a non-enum msg",
+			executeMsg: `["mint"]`,
+			txFees:     "150stake",
+		},
+		{
+			name:       "OK: fallback to the contract flat fee for an empty msg",
+			executeMsg: ``,
+			txFees:     "150stake",
+		},
+		{
+			name:       "OK: fallback to the contract flat fee for an oversized msg",
+			executeMsg: `{"mint":"` + strings.Repeat("a", 64*1024) + `"}`,
+			txFees:     "150stake",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx, _ := testutils.RewardsKeeper(t)
+
+			minConsFee, err := sdk.ParseDecCoin("0.1stake")
+			require.NoError(t, err)
+			require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+
+			require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+				ContractAddress: contractAddr.String(),
+				OwnerAddress:    ownerAddr.String(),
+				RewardsAddress:  ownerAddr.String(),
+			}))
+			require.NoError(t, k.FlatFees.Set(ctx, contractAddr, sdk.NewInt64Coin("stake", 50)))
+			require.NoError(t, k.MethodFlatFees.Set(ctx, collections.Join(contractAddr.Bytes(), "mint"), sdk.NewInt64Coin("stake", 200)))
+
+			txFees, err := sdk.ParseCoinsNormalized(tc.txFees)
+			require.NoError(t, err)
+			tx := testutils.NewMockFeeTx(
+				testutils.WithMockFeeTxFees(txFees),
+				testutils.WithMockFeeTxGas(1000),
+				testutils.WithMockFeeTxMsgs(&wasmTypes.MsgExecuteContract{
+					Sender:   callerAddr.String(),
+					Contract: contractAddr.String(),
+					Msg:      []byte(tc.executeMsg),
+				}),
+			)
+
+			cdc := codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+			anteHandler := ante.NewMinFeeDecorator(cdc, k)
+			_, err = anteHandler.AnteHandle(ctx, tx, false, testutils.NoopAnteHandler)
+			if tc.errExpected != nil {
+				require.ErrorIs(t, err, tc.errExpected)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRewardsMinFeeAnteHandlerRecommendedFee(t *testing.T) {
+	type testCase struct {
+		name string
+		// Inputs
+		txFees string // transaction fees [sdk.Coins]
+		// Output expected
+		recommendedFeeExp string // recommended fee [sdk.Coins]
+	}
+
+	// Min fee is 100stake (1000 gas * 0.1stake) + 50uarch (contract flat fee)
+	contractAddr := sdk.AccAddress("contractAddr________")
+	ownerAddr := sdk.AccAddress("ownerAddr___________")
+
+	testCases := []testCase{
+		{
+			name:              "Empty fee",
+			txFees:            "",
+			recommendedFeeExp: "100stake,50uarch",
+		},
+		{
+			name:              "Gas fees only",
+			txFees:            "100stake",
+			recommendedFeeExp: "100stake,50uarch",
+		},
+		{
+			name:              "Partially covered fees",
+			txFees:            "40uarch,99stake",
+			recommendedFeeExp: "100stake,50uarch",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx, _ := testutils.RewardsKeeper(t)
+
+			minConsFee, err := sdk.ParseDecCoin("0.1stake")
+			require.NoError(t, err)
+			require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+
+			require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+				ContractAddress: contractAddr.String(),
+				OwnerAddress:    ownerAddr.String(),
+				RewardsAddress:  ownerAddr.String(),
+			}))
+			require.NoError(t, k.FlatFees.Set(ctx, contractAddr, sdk.NewInt64Coin("uarch", 50)))
+
+			txFees, err := sdk.ParseCoinsNormalized(tc.txFees)
+			require.NoError(t, err)
+			tx := testutils.NewMockFeeTx(
+				testutils.WithMockFeeTxFees(txFees),
+				testutils.WithMockFeeTxGas(1000),
+				testutils.WithMockFeeTxMsgs(&wasmTypes.MsgExecuteContract{
+					Sender:   ownerAddr.String(),
+					Contract: contractAddr.String(),
+				}),
+			)
+
+			cdc := codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+			anteHandler := ante.NewMinFeeDecorator(cdc, k)
+			_, err = anteHandler.AnteHandle(ctx, tx, false, testutils.NoopAnteHandler)
+			require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+
+			// Structured error fields
+			var feeErr *rewardsTypes.InsufficientFeeError
+			require.ErrorAs(t, err, &feeErr)
+			assert.Equal(t, tc.recommendedFeeExp, sdk.Coins(feeErr.RecommendedFees).String())
+			assert.Equal(t, txFees.String(), sdk.Coins(feeErr.TxFees).String())
+
+			// ABCI response (code and log) reported to clients
+			codespace, code, log := errorsmod.ABCIInfo(err, false)
+			assert.Equal(t, sdkErrors.ErrInsufficientFee.Codespace(), codespace)
+			assert.Equal(t, sdkErrors.ErrInsufficientFee.ABCICode(), code)
+
+			recommendedFee, ok := rewardsTypes.ParseRecommendedFee(log)
+			require.True(t, ok)
+			assert.Equal(t, tc.recommendedFeeExp, recommendedFee.String())
+
+			// Resubmitting with the recommended fee passes
+			tx = testutils.NewMockFeeTx(
+				testutils.WithMockFeeTxFees(recommendedFee),
+				testutils.WithMockFeeTxGas(1000),
+				testutils.WithMockFeeTxMsgs(&wasmTypes.MsgExecuteContract{
+					Sender:   ownerAddr.String(),
+					Contract: contractAddr.String(),
+				}),
+			)
+			_, err = anteHandler.AnteHandle(ctx, tx, false, testutils.NoopAnteHandler)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRewardsMinFeeAnteHandlerMixedDenoms(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+
+	// Min fee is 150stake (1001 gas * 0.15stake truncated) + 50uarch and 30ubtc (contract flat fees)
+	minConsFee, err := sdk.ParseDecCoin("0.15stake")
+	require.NoError(t, err)
+	require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+
+	ownerAddr := sdk.AccAddress("ownerAddr___________")
+	contractAddrB, contractAddrC := sdk.AccAddress("contractAddrB_______"), sdk.AccAddress("contractAddrC_______")
+	for _, flatFee := range []struct {
+		contractAddr sdk.AccAddress
+		fee          sdk.Coin
+	}{
+		{contractAddr: contractAddrB, fee: sdk.NewInt64Coin("uarch", 50)},
+		{contractAddr: contractAddrC, fee: sdk.NewInt64Coin("ubtc", 30)},
+	} {
+		require.NoError(t, k.ContractMetadata.Set(ctx, flatFee.contractAddr, rewardsTypes.ContractMetadata{
+			ContractAddress: flatFee.contractAddr.String(),
+			OwnerAddress:    ownerAddr.String(),
+			RewardsAddress:  ownerAddr.String(),
+		}))
+		require.NoError(t, k.FlatFees.Set(ctx, flatFee.contractAddr, flatFee.fee))
+	}
+
+	type testCase struct {
+		name   string
+		txFees sdk.Coins
+		// Output expected
+		errExpected error
+	}
+
+	testCases := []testCase{
+		{
+			name:   "OK: every denom is covered",
+			txFees: sdk.NewCoins(sdk.NewInt64Coin("stake", 150), sdk.NewInt64Coin("uarch", 50), sdk.NewInt64Coin("ubtc", 30)),
+		},
+		{
+			name:   "OK: overpayment in every denom",
+			txFees: sdk.NewCoins(sdk.NewInt64Coin("stake", 151), sdk.NewInt64Coin("uarch", 51), sdk.NewInt64Coin("ubtc", 31)),
+		},
+		{
+			name:        "Fail: one of the flat fees denoms is not covered by the other denoms overpayment",
+			txFees:      sdk.NewCoins(sdk.NewInt64Coin("stake", 1000), sdk.NewInt64Coin("uarch", 49), sdk.NewInt64Coin("ubtc", 1000)),
+			errExpected: sdkErrors.ErrInsufficientFee,
+		},
+		{
+			name:        "Fail: gas fees denom is not covered (no rounding up)",
+			txFees:      sdk.NewCoins(sdk.NewInt64Coin("stake", 149), sdk.NewInt64Coin("uarch", 50), sdk.NewInt64Coin("ubtc", 30)),
+			errExpected: sdkErrors.ErrInsufficientFee,
+		},
+		{
+			name:        "Fail: one of the flat fees denoms is not covered",
+			txFees:      sdk.NewCoins(sdk.NewInt64Coin("stake", 1000), sdk.NewInt64Coin("uarch", 1000), sdk.NewInt64Coin("ubtc", 29)),
+			errExpected: sdkErrors.ErrInsufficientFee,
+		},
+		{
+			name:        "Fail: unsorted tx fees",
+			txFees:      sdk.Coins{sdk.NewInt64Coin("ubtc", 30), sdk.NewInt64Coin("uarch", 50), sdk.NewInt64Coin("stake", 150)},
+			errExpected: sdkErrors.ErrInvalidCoins,
+		},
+		{
+			name:        "Fail: duplicated tx fees denom",
+			txFees:      sdk.Coins{sdk.NewInt64Coin("stake", 150), sdk.NewInt64Coin("uarch", 25), sdk.NewInt64Coin("uarch", 25), sdk.NewInt64Coin("ubtc", 30)},
+			errExpected: sdkErrors.ErrInvalidCoins,
+		},
+	}
+
+	cdc := codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+	anteHandler := ante.NewMinFeeDecorator(cdc, k)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx := testutils.NewMockFeeTx(
+				testutils.WithMockFeeTxFees(tc.txFees),
+				testutils.WithMockFeeTxGas(1001),
+				testutils.WithMockFeeTxMsgs(
+					&wasmTypes.MsgExecuteContract{Sender: ownerAddr.String(), Contract: contractAddrB.String()},
+					&wasmTypes.MsgExecuteContract{Sender: ownerAddr.String(), Contract: contractAddrC.String()},
+				),
+			)
+
+			_, err := anteHandler.AnteHandle(ctx, tx, false, testutils.NoopAnteHandler)
+			if tc.errExpected != nil {
+				require.ErrorIs(t, err, tc.errExpected)
+
+				var feeErr *rewardsTypes.InsufficientFeeError
+				if errors.As(err, &feeErr) {
+					recommendedFees := sdk.Coins(feeErr.RecommendedFees)
+					assert.NoError(t, recommendedFees.Validate())
+					assert.Equal(t, "150stake,50uarch,30ubtc", recommendedFees.String())
+				}
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRewardsMinFeeAnteHandlerFlatFeeCredits(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+
+	// Min fee is 100stake (1000 gas * 0.1stake) + 50uarch (contract flat fee) unless the execution is prepaid
+	minConsFee, err := sdk.ParseDecCoin("0.1stake")
+	require.NoError(t, err)
+	require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+
+	contractAddr := sdk.AccAddress("contractAddr________")
+	ownerAddr := sdk.AccAddress("ownerAddr___________")
+	require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+		ContractAddress: contractAddr.String(),
+		OwnerAddress:    ownerAddr.String(),
+		RewardsAddress:  ownerAddr.String(),
+	}))
+	require.NoError(t, k.FlatFees.Set(ctx, contractAddr, sdk.NewInt64Coin("uarch", 50)))
+	require.NoError(t, k.FlatFeeCredits.Set(ctx, contractAddr, 2))
+
+	cdc := codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+	anteHandler := ante.NewMinFeeDecorator(cdc, k)
+	executeMsg := &wasmTypes.MsgExecuteContract{
+		Sender:   ownerAddr.String(),
+		Contract: contractAddr.String(),
+	}
+	newTx := func(txFees string, msgsNum int) sdk.Tx {
+		fees, err := sdk.ParseCoinsNormalized(txFees)
+		require.NoError(t, err)
+
+		msgs := make([]sdk.Msg, 0, msgsNum)
+		for i := 0; i < msgsNum; i++ {
+			msgs = append(msgs, executeMsg)
+		}
+
+		return testutils.NewMockFeeTx(
+			testutils.WithMockFeeTxFees(fees),
+			testutils.WithMockFeeTxGas(1000),
+			testutils.WithMockFeeTxMsgs(msgs...),
+		)
+	}
+	// Returns the charged flat fees reported to the DeductFeeDecorator
+	chargedFlatFees := func(t *testing.T) sdk.AnteHandler {
+		return func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+			flatFees, ok := rewardsTypes.GetTxFlatFees(ctx)
+			require.True(t, ok)
+			require.Equal(t, "", flatFees.String())
+			return ctx, nil
+		}
+	}
+
+	t.Run("OK: prepaid execution is not charged the flat fee", func(t *testing.T) {
+		_, err := anteHandler.AnteHandle(ctx, newTx("100stake", 1), false, chargedFlatFees(t))
+		require.NoError(t, err)
+		require.EqualValues(t, 1, k.GetFlatFeeCredits(ctx, contractAddr))
+	})
+
+	t.Run("OK: msgs exceeding the credit are charged the flat fee", func(t *testing.T) {
+		// Rejected tx state changes are discarded
+		cacheCtx, _ := ctx.CacheContext()
+		_, err := anteHandler.AnteHandle(cacheCtx, newTx("100stake", 2), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+		require.EqualValues(t, 1, k.GetFlatFeeCredits(ctx, contractAddr))
+
+		_, err = anteHandler.AnteHandle(ctx, newTx("100stake,50uarch", 2), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+		require.Zero(t, k.GetFlatFeeCredits(ctx, contractAddr))
+	})
+
+	t.Run("Fail: exhausted credit falls back to the standard flat fee", func(t *testing.T) {
+		_, err := anteHandler.AnteHandle(ctx, newTx("100stake", 1), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+
+		_, err = anteHandler.AnteHandle(ctx, newTx("100stake,50uarch", 1), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+	})
+}
+
+func TestRewardsMinFeeAnteHandlerFlatFeeOverride(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	ctx = ctx.WithBlockHeight(100)
+
+	// Min fee is 100stake (1000 gas * 0.1stake) + the contract flat fee (50uarch unless overridden)
+	minConsFee, err := sdk.ParseDecCoin("0.1stake")
+	require.NoError(t, err)
+	require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+
+	contractAddr := sdk.AccAddress("contractAddr________")
+	ownerAddr := sdk.AccAddress("ownerAddr___________")
+	require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+		ContractAddress: contractAddr.String(),
+		OwnerAddress:    ownerAddr.String(),
+		RewardsAddress:  ownerAddr.String(),
+	}))
+	require.NoError(t, k.FlatFees.Set(ctx, contractAddr, sdk.NewInt64Coin("uarch", 50)))
+
+	cdc := codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+	anteHandler := ante.NewMinFeeDecorator(cdc, k)
+	newTx := func(txFees string) sdk.Tx {
+		fees, err := sdk.ParseCoinsNormalized(txFees)
+		require.NoError(t, err)
+
+		return testutils.NewMockFeeTx(
+			testutils.WithMockFeeTxFees(fees),
+			testutils.WithMockFeeTxGas(1000),
+			testutils.WithMockFeeTxMsgs(&wasmTypes.MsgExecuteContract{
+				Sender:   ownerAddr.String(),
+				Contract: contractAddr.String(),
+			}),
+		)
+	}
+	// Checks the charged flat fees reported to the DeductFeeDecorator
+	chargedFlatFees := func(t *testing.T, expected string) sdk.AnteHandler {
+		return func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+			flatFees, ok := rewardsTypes.GetTxFlatFees(ctx)
+			require.True(t, ok)
+			require.Equal(t, expected, flatFees.String())
+			return ctx, nil
+		}
+	}
+
+	t.Run("OK: no override charges the owner flat fee", func(t *testing.T) {
+		_, err := anteHandler.AnteHandle(ctx, newTx("100stake,20uarch"), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+
+		_, err = anteHandler.AnteHandle(ctx, newTx("100stake,50uarch"), false, chargedFlatFees(t, "50uarch"))
+		require.NoError(t, err)
+	})
+
+	t.Run("OK: active override takes precedence over the owner flat fee", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		require.NoError(t, k.SetFlatFeeOverride(cacheCtx, contractAddr, sdk.NewInt64Coin("uarch", 20), 101))
+
+		_, err := anteHandler.AnteHandle(cacheCtx, newTx("100stake,20uarch"), false, chargedFlatFees(t, "20uarch"))
+		require.NoError(t, err)
+	})
+
+	t.Run("OK: active zero override waives the owner flat fee", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		require.NoError(t, k.SetFlatFeeOverride(cacheCtx, contractAddr, sdk.NewInt64Coin("uarch", 0), 101))
+
+		_, err := anteHandler.AnteHandle(cacheCtx, newTx("100stake"), false, chargedFlatFees(t, ""))
+		require.NoError(t, err)
+	})
+
+	t.Run("OK: active override applies to a contract without an owner flat fee", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		require.NoError(t, k.FlatFees.Remove(cacheCtx, contractAddr))
+		require.NoError(t, k.SetFlatFeeOverride(cacheCtx, contractAddr, sdk.NewInt64Coin("uarch", 20), 101))
+
+		_, err := anteHandler.AnteHandle(cacheCtx, newTx("100stake"), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+
+		_, err = anteHandler.AnteHandle(cacheCtx, newTx("100stake,20uarch"), false, chargedFlatFees(t, "20uarch"))
+		require.NoError(t, err)
+	})
+
+	t.Run("OK: expired override falls back to the owner flat fee", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		require.NoError(t, k.SetFlatFeeOverride(cacheCtx, contractAddr, sdk.NewInt64Coin("uarch", 20), 101))
+		cacheCtx = cacheCtx.WithBlockHeight(101)
+
+		_, err := anteHandler.AnteHandle(cacheCtx, newTx("100stake,20uarch"), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+
+		_, err = anteHandler.AnteHandle(cacheCtx, newTx("100stake,50uarch"), false, chargedFlatFees(t, "50uarch"))
+		require.NoError(t, err)
+	})
+}
+
+func TestRewardsMinFeeAnteHandlerIrrelevantFeeDenom(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+
+	// Min fee is 100stake (1000 gas * 0.1stake) + 50uarch (contract flat fee)
+	minConsFee, err := sdk.ParseDecCoin("0.1stake")
+	require.NoError(t, err)
+	require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+
+	contractAddr := sdk.AccAddress("contractAddr________")
+	ownerAddr := sdk.AccAddress("ownerAddr___________")
+	require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+		ContractAddress: contractAddr.String(),
+		OwnerAddress:    ownerAddr.String(),
+		RewardsAddress:  ownerAddr.String(),
+	}))
+	require.NoError(t, k.FlatFees.Set(ctx, contractAddr, sdk.NewInt64Coin("uarch", 50)))
+
+	cdc := codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+	anteHandler := ante.NewMinFeeDecorator(cdc, k)
+	newTx := func(txFees string) sdk.Tx {
+		fees, err := sdk.ParseCoinsNormalized(txFees)
+		require.NoError(t, err)
+
+		return testutils.NewMockFeeTx(
+			testutils.WithMockFeeTxFees(fees),
+			testutils.WithMockFeeTxGas(1000),
+			testutils.WithMockFeeTxMsgs(&wasmTypes.MsgExecuteContract{
+				Sender:   ownerAddr.String(),
+				Contract: contractAddr.String(),
+			}),
+		)
+	}
+
+	t.Run("Fail: fee paid in an irrelevant denom", func(t *testing.T) {
+		_, err := anteHandler.AnteHandle(ctx, newTx("1000000uatom"), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, rewardsTypes.ErrIrrelevantFeeDenom)
+		require.NotErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+	})
+
+	t.Run("Fail: fee paid in the gas price denom is compared by amount", func(t *testing.T) {
+		_, err := anteHandler.AnteHandle(ctx, newTx("10stake"), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+	})
+
+	t.Run("Fail: fee paid in the flat fee denom is compared by amount", func(t *testing.T) {
+		_, err := anteHandler.AnteHandle(ctx, newTx("50uarch,1000000uatom"), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+	})
+
+	t.Run("OK: fee covering all the components", func(t *testing.T) {
+		_, err := anteHandler.AnteHandle(ctx, newTx("100stake,50uarch"), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+	})
+}
+
+func TestRewardsMinFeeAnteHandlerFlatFeePayerMustSign(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+
+	// Min fee is 100stake (1000 gas * 0.1stake) + 50stake (contract flat fee)
+	minConsFee, err := sdk.ParseDecCoin("0.1stake")
+	require.NoError(t, err)
+	require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+
+	contractAddr, noFlatFeeContractAddr := sdk.AccAddress("contractAddr________"), sdk.AccAddress("noFlatFeeContract___")
+	senderAddr, payerAddr := sdk.AccAddress("senderAddr__________"), sdk.AccAddress("payerAddr___________")
+	require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+		ContractAddress: contractAddr.String(),
+		OwnerAddress:    senderAddr.String(),
+		RewardsAddress:  senderAddr.String(),
+	}))
+	require.NoError(t, k.FlatFees.Set(ctx, contractAddr, sdk.NewInt64Coin("stake", 50)))
+
+	setPayerMustSign := func(enabled bool) {
+		params := k.GetParams(ctx)
+		params.FlatFeePayerMustSign = enabled
+		require.NoError(t, k.Params.Set(ctx, params))
+	}
+
+	cdc := codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+	anteHandler := ante.NewMinFeeDecorator(cdc, k)
+	newExecuteMsg := func(sender, contract sdk.AccAddress) *wasmTypes.MsgExecuteContract {
+		return &wasmTypes.MsgExecuteContract{
+			Sender:   sender.String(),
+			Contract: contract.String(),
+		}
+	}
+	newTx := func(payer sdk.AccAddress, msgs ...sdk.Msg) sdk.Tx {
+		return testutils.NewMockFeeTx(
+			testutils.WithMockFeeTxFees(sdk.NewCoins(sdk.NewInt64Coin("stake", 150))),
+			testutils.WithMockFeeTxGas(1000),
+			testutils.WithMockFeeTxPayer(payer),
+			testutils.WithMockFeeTxMsgs(msgs...),
+		)
+	}
+	newAuthzMsg := func(grantee sdk.AccAddress, msgs ...sdk.Msg) sdk.Msg {
+		execMsg := authz.NewMsgExec(grantee, msgs)
+		return &execMsg
+	}
+
+	type testCase struct {
+		name        string
+		enabled     bool
+		tx          sdk.Tx
+		errExpected error
+	}
+
+	testCases := []testCase{
+		{
+			name: "OK: disabled: fee payer doesn't match the execute sender",
+			tx:   newTx(payerAddr, newExecuteMsg(senderAddr, contractAddr)),
+		},
+		{
+			name:    "OK: enabled: fee payer matches the execute sender",
+			enabled: true,
+			tx:      newTx(senderAddr, newExecuteMsg(senderAddr, contractAddr)),
+		},
+		{
+			name:        "Fail: enabled: fee payer doesn't match the execute sender",
+			enabled:     true,
+			tx:          newTx(payerAddr, newExecuteMsg(senderAddr, contractAddr)),
+			errExpected: sdkErrors.ErrUnauthorized,
+		},
+		{
+			name:        "Fail: enabled: one of the flat-fee-bearing executes is not signed by the fee payer",
+			enabled:     true,
+			tx:          newTx(payerAddr, newExecuteMsg(payerAddr, contractAddr), newExecuteMsg(senderAddr, contractAddr)),
+			errExpected: sdkErrors.ErrUnauthorized,
+		},
+		{
+			name:    "OK: enabled: fee payer doesn't match the sender of an execute without a flat fee",
+			enabled: true,
+			tx:      newTx(payerAddr, newExecuteMsg(senderAddr, noFlatFeeContractAddr)),
+		},
+		{
+			name:    "OK: enabled: fee payer is the authz grantee",
+			enabled: true,
+			tx:      newTx(payerAddr, newAuthzMsg(payerAddr, newExecuteMsg(senderAddr, contractAddr))),
+		},
+		{
+			name:        "Fail: enabled: fee payer is not the authz grantee",
+			enabled:     true,
+			tx:          newTx(payerAddr, newAuthzMsg(senderAddr, newExecuteMsg(senderAddr, contractAddr))),
+			errExpected: sdkErrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			setPayerMustSign(tc.enabled)
+
+			cacheCtx, _ := ctx.CacheContext()
+			_, err := anteHandler.AnteHandle(cacheCtx, tc.tx, false, testutils.NoopAnteHandler)
+			if tc.errExpected != nil {
+				require.ErrorIs(t, err, tc.errExpected)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRewardsMinFeeAnteHandlerFlatFeesEnabled(t *testing.T) {
+	// Min fee is 100stake (1000 gas * 0.1stake) + 50stake (contract flat fee)
+	contractAddr, senderAddr := sdk.AccAddress("contractAddr________"), sdk.AccAddress("senderAddr__________")
+
+	type testCase struct {
+		name            string
+		enabled         bool
+		txFees          string // [sdk.Coins]
+		errExpected     error
+		recordsExpected int // flat fee rewards records created
+	}
+
+	testCases := []testCase{
+		{
+			name:            "OK: enabled: gas and flat fees covered",
+			enabled:         true,
+			txFees:          "150stake",
+			recordsExpected: 1,
+		},
+		{
+			name:        "Fail: enabled: flat fee not covered",
+			enabled:     true,
+			txFees:      "149stake",
+			errExpected: sdkErrors.ErrInsufficientFee,
+		},
+		{
+			name:   "OK: disabled: flat fee ignored",
+			txFees: "100stake",
+		},
+		{
+			name:        "Fail: disabled: gas fees not covered",
+			txFees:      "99stake",
+			errExpected: sdkErrors.ErrInsufficientFee,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx, _ := testutils.RewardsKeeper(t)
+
+			minConsFee, err := sdk.ParseDecCoin("0.1stake")
+			require.NoError(t, err)
+			require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+
+			require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+				ContractAddress: contractAddr.String(),
+				OwnerAddress:    senderAddr.String(),
+				RewardsAddress:  senderAddr.String(),
+			}))
+			require.NoError(t, k.FlatFees.Set(ctx, contractAddr, sdk.NewInt64Coin("stake", 50)))
+
+			params := k.GetParams(ctx)
+			params.FlatFeesEnabled = tc.enabled
+			require.NoError(t, k.Params.Set(ctx, params))
+
+			txFees, err := sdk.ParseCoinsNormalized(tc.txFees)
+			require.NoError(t, err)
+			tx := testutils.NewMockFeeTx(
+				testutils.WithMockFeeTxFees(txFees),
+				testutils.WithMockFeeTxGas(1000),
+				testutils.WithMockFeeTxMsgs(&wasmTypes.MsgExecuteContract{
+					Sender:   senderAddr.String(),
+					Contract: contractAddr.String(),
+				}),
+			)
+
+			anteHandler := ante.NewMinFeeDecorator(codec.NewProtoCodec(codecTypes.NewInterfaceRegistry()), k)
+			_, err = anteHandler.AnteHandle(ctx, tx, false, testutils.NoopAnteHandler)
+			if tc.errExpected != nil {
+				require.ErrorIs(t, err, tc.errExpected)
+				return
+			}
+			require.NoError(t, err)
+
+			records, err := k.GetRewardsRecordsByWithdrawAddress(ctx, senderAddr)
+			require.NoError(t, err)
+			require.Len(t, records, tc.recordsExpected)
+		})
+	}
+}
+
+func TestRewardsMinFeeAnteHandlerFlatFeeConversion(t *testing.T) {
+	// Min fee is 100stake (1000 gas * 0.1stake) + 50uarch (contract flat fee) or 125stake if converted (rate 2.5)
+	contractAddr, senderAddr := sdk.AccAddress("contractAddr________"), sdk.AccAddress("senderAddr__________")
+	conversionRates := []rewardsTypes.FlatFeeConversionRate{
+		{Denom: "uarch", FeeDenom: "stake", Rate: sdkMath.LegacyNewDecWithPrec(25, 1)},
+	}
+
+	type testCase struct {
+		name            string
+		rates           []rewardsTypes.FlatFeeConversionRate
+		txFees          string // [sdk.Coins]
+		errExpected     error
+		flatFeeExpected string // charged flat fees [sdk.Coins]
+	}
+
+	testCases := []testCase{
+		{
+			name:            "OK: same denom: flat fee is not converted",
+			rates:           conversionRates,
+			txFees:          "100stake,50uarch",
+			flatFeeExpected: "50uarch",
+		},
+		{
+			name:        "Fail: same denom: flat fee not covered",
+			rates:       conversionRates,
+			txFees:      "225stake,49uarch",
+			errExpected: sdkErrors.ErrInsufficientFee,
+		},
+		{
+			name:            "OK: cross denom: flat fee is converted to the tx fee denom",
+			rates:           conversionRates,
+			txFees:          "225stake",
+			flatFeeExpected: "125stake",
+		},
+		{
+			name:        "Fail: cross denom: converted flat fee not covered",
+			rates:       conversionRates,
+			txFees:      "224stake",
+			errExpected: sdkErrors.ErrInsufficientFee,
+		},
+		{
+			name:        "Fail: cross denom: conversion disabled",
+			txFees:      "225stake",
+			errExpected: sdkErrors.ErrInsufficientFee,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx, _ := testutils.RewardsKeeper(t)
+
+			minConsFee, err := sdk.ParseDecCoin("0.1stake")
+			require.NoError(t, err)
+			require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+
+			require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+				ContractAddress: contractAddr.String(),
+				OwnerAddress:    senderAddr.String(),
+				RewardsAddress:  senderAddr.String(),
+			}))
+			require.NoError(t, k.FlatFees.Set(ctx, contractAddr, sdk.NewInt64Coin("uarch", 50)))
+
+			params := k.GetParams(ctx)
+			params.FlatFeeConversionRates = tc.rates
+			require.NoError(t, k.Params.Set(ctx, params))
+
+			txFees, err := sdk.ParseCoinsNormalized(tc.txFees)
+			require.NoError(t, err)
+			tx := testutils.NewMockFeeTx(
+				testutils.WithMockFeeTxFees(txFees),
+				testutils.WithMockFeeTxGas(1000),
+				testutils.WithMockFeeTxMsgs(&wasmTypes.MsgExecuteContract{
+					Sender:   senderAddr.String(),
+					Contract: contractAddr.String(),
+				}),
+			)
+
+			anteHandler := ante.NewMinFeeDecorator(codec.NewProtoCodec(codecTypes.NewInterfaceRegistry()), k)
+			_, err = anteHandler.AnteHandle(ctx, tx, false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+				flatFees, ok := rewardsTypes.GetTxFlatFees(ctx)
+				require.True(t, ok)
+				assert.Equal(t, tc.flatFeeExpected, flatFees.String())
+				return ctx, nil
+			})
+			if tc.errExpected != nil {
+				require.ErrorIs(t, err, tc.errExpected)
+				return
+			}
+			require.NoError(t, err)
+
+			// Contract is credited the flat fee in the denom it is collected in
+			records, err := k.GetRewardsRecordsByWithdrawAddress(ctx, senderAddr)
+			require.NoError(t, err)
+			require.Len(t, records, 1)
+			assert.Equal(t, tc.flatFeeExpected, sdk.Coins(records[0].Rewards).String())
+		})
+	}
+}
+
+func TestRewardsMinFeeAnteHandlerAuthzWithdrawRewards(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	contractAddr := sdk.AccAddress("contractAddr________")
+	senderAddr := sdk.AccAddress("senderAddr__________")
+
+	// Min fee is 100stake (1000 gas * 0.1stake) + 50stake (contract flat fee, if executed)
+	minConsFee, err := sdk.ParseDecCoin("0.1stake")
+	require.NoError(t, err)
+	require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+	require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+		ContractAddress: contractAddr.String(),
+		OwnerAddress:    senderAddr.String(),
+		RewardsAddress:  senderAddr.String(),
+	}))
+	require.NoError(t, k.FlatFees.Set(ctx, contractAddr, sdk.NewInt64Coin("stake", 50)))
+
+	cdc := codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+	anteHandler := ante.NewMinFeeDecorator(cdc, k)
+	newTx := func(txFees int64, msgs ...sdk.Msg) sdk.Tx {
+		execMsg := authz.NewMsgExec(senderAddr, msgs)
+		return testutils.NewMockFeeTx(
+			testutils.WithMockFeeTxFees(sdk.NewCoins(sdk.NewInt64Coin("stake", txFees))),
+			testutils.WithMockFeeTxGas(1000),
+			testutils.WithMockFeeTxMsgs(&execMsg),
+		)
+	}
+
+	withdrawMsg := rewardsTypes.NewMsgWithdrawRewardsByLimit(senderAddr, 1)
+	executeMsg := &wasmTypes.MsgExecuteContract{
+		Sender:   senderAddr.String(),
+		Contract: contractAddr.String(),
+	}
+
+	t.Run("OK: wrapped withdraw is not charged a flat fee", func(t *testing.T) {
+		_, err := anteHandler.AnteHandle(ctx, newTx(100, withdrawMsg), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+	})
+
+	t.Run("Fail: wrapped withdraw next to a wrapped contract execution without the flat fee", func(t *testing.T) {
+		_, err := anteHandler.AnteHandle(ctx, newTx(100, withdrawMsg, executeMsg), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+	})
+
+	t.Run("OK: wrapped withdraw next to a wrapped contract execution with the flat fee", func(t *testing.T) {
+		_, err := anteHandler.AnteHandle(ctx, newTx(150, withdrawMsg, executeMsg), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+	})
+}
+
+func TestRewardsMinFeeAnteHandlerWithdrawTxFeeEstimate(t *testing.T) {
+	type testCase struct {
+		name string
+		// Inputs
+		minConsFee   string // min consensus fee [sdk.DecCoin] (not set if empty)
+		floorEnabled bool   // MinFeeFloorEnabled param
+		gasLimit     uint64 // tx gas limit
+		// Output expected
+		estimateExpected string // expected estimate [sdk.Coins]
+	}
+
+	senderAddr := sdk.AccAddress("senderAddr__________")
+
+	testCases := []testCase{
+		{
+			name:             "OK: gas-based fee",
+			minConsFee:       "0.1stake",
+			gasLimit:         1000,
+			estimateExpected: "100stake",
+		},
+		{
+			name:             "OK: gas-based fee is truncated",
+			minConsFee:       "0.0015stake",
+			gasLimit:         1000,
+			estimateExpected: "1stake",
+		},
+		{
+			name:             "OK: zero fee floored",
+			floorEnabled:     true,
+			gasLimit:         1000,
+			estimateExpected: "1stake",
+		},
+		{
+			name:     "OK: zero fee",
+			gasLimit: 1000,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx, _ := testutils.RewardsKeeper(t)
+
+			params := rewardsTypes.DefaultParams()
+			params.MinFeeFloorEnabled = tc.floorEnabled
+			require.NoError(t, k.Params.Set(ctx, params))
+			if tc.minConsFee != "" {
+				minConsFee, err := sdk.ParseDecCoin(tc.minConsFee)
+				require.NoError(t, err)
+				require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+			}
+
+			estimate := k.EstimateWithdrawTxFee(ctx, tc.gasLimit)
+			require.Equal(t, tc.estimateExpected, estimate.String())
+
+			cdc := codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+			anteHandler := ante.NewMinFeeDecorator(cdc, k)
+			newTx := func(txFees sdk.Coins) sdk.Tx {
+				return testutils.NewMockFeeTx(
+					testutils.WithMockFeeTxFees(txFees),
+					testutils.WithMockFeeTxGas(tc.gasLimit),
+					testutils.WithMockFeeTxMsgs(rewardsTypes.NewMsgWithdrawRewardsByLimit(senderAddr, 1)),
+				)
+			}
+
+			// The estimate is accepted by the Ante handler
+			_, err := anteHandler.AnteHandle(ctx, newTx(estimate), false, testutils.NoopAnteHandler)
+			require.NoError(t, err)
+
+			// Anything below the estimate is rejected
+			if !estimate.IsZero() {
+				lowerFees := estimate.Sub(sdk.NewInt64Coin(estimate[0].Denom, 1))
+				_, err := anteHandler.AnteHandle(ctx, newTx(lowerFees), false, testutils.NoopAnteHandler)
+				require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+			}
+		})
+	}
+}
+
+func TestRewardsMinFeeAnteHandlerInvalidFlatFee(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	contractAddr := sdk.AccAddress("contractAddr________")
+	otherContractAddr := sdk.AccAddress("otherContractAddr___")
+	senderAddr := sdk.AccAddress("senderAddr__________")
+
+	minConsFee, err := sdk.ParseDecCoin("0.1stake")
+	require.NoError(t, err)
+	require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+	// Seed a broken flat fee (negative amount) bypassing the keeper validation
+	require.NoError(t, k.FlatFees.Set(ctx, contractAddr, sdk.Coin{Denom: "uarch", Amount: sdkMath.NewInt(-1)}))
+
+	cdc := codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+	anteHandler := ante.NewMinFeeDecorator(cdc, k)
+	newTx := func(contract sdk.AccAddress) sdk.Tx {
+		return testutils.NewMockFeeTx(
+			testutils.WithMockFeeTxFees(sdk.NewCoins(sdk.NewInt64Coin("stake", 100))),
+			testutils.WithMockFeeTxGas(1000),
+			testutils.WithMockFeeTxMsgs(&wasmTypes.MsgExecuteContract{
+				Sender:   senderAddr.String(),
+				Contract: contract.String(),
+			}),
+		)
+	}
+
+	t.Run("Fail: tx targeting the contract with an invalid flat fee", func(t *testing.T) {
+		require.NotPanics(t, func() {
+			_, err = anteHandler.AnteHandle(ctx, newTx(contractAddr), false, testutils.NoopAnteHandler)
+		})
+		require.ErrorIs(t, err, rewardsTypes.ErrInternal)
+		require.ErrorContains(t, err, contractAddr.String())
+		require.ErrorContains(t, err, "denom (uarch)")
+	})
+
+	t.Run("OK: unrelated tx is not affected", func(t *testing.T) {
+		_, err := anteHandler.AnteHandle(ctx, newTx(otherContractAddr), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+	})
+}
+
+func TestRewardsMinFeeAnteHandlerSimulateEstimate(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	contractAddr := sdk.AccAddress("contractAddr________")
+	rewardsAddr := sdk.AccAddress("rewardsAddr_________")
+
+	minConsFee, err := sdk.ParseDecCoin("0.1stake")
+	require.NoError(t, err)
+	require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+	require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+		ContractAddress: contractAddr.String(),
+		OwnerAddress:    rewardsAddr.String(),
+		RewardsAddress:  rewardsAddr.String(),
+	}))
+	require.NoError(t, k.FlatFees.Set(ctx, contractAddr, sdk.NewInt64Coin("uarch", 50)))
+
+	// Tx without fees, that would be rejected in a non-simulation mode
+	tx := testutils.NewMockFeeTx(
+		testutils.WithMockFeeTxGas(1000),
+		testutils.WithMockFeeTxMsgs(&wasmTypes.MsgExecuteContract{
+			Sender:   rewardsAddr.String(),
+			Contract: contractAddr.String(),
+		}),
+	)
+	cdc := codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+	anteHandler := ante.NewMinFeeDecorator(cdc, k)
+
+	t.Run("Fail: non-simulation mode", func(t *testing.T) {
+		_, err := anteHandler.AnteHandle(ctx, tx, false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+	})
+
+	t.Run("OK: simulation mode reports the flat fee estimate", func(t *testing.T) {
+		simCtx := ctx.WithEventManager(sdk.NewEventManager())
+		_, err := anteHandler.AnteHandle(simCtx, tx, true, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+
+		var estimateEvent *rewardsTypes.TxFeesEstimateEvent
+		for _, event := range simCtx.EventManager().Events() {
+			msg, err := sdk.ParseTypedEvent(abci.Event(event))
+			require.NoError(t, err)
+			if e, ok := msg.(*rewardsTypes.TxFeesEstimateEvent); ok {
+				estimateEvent = e
+			}
+		}
+		require.NotNil(t, estimateEvent)
+		require.Equal(t, "100stake", sdk.Coins(estimateEvent.GasFees).String())
+		require.Equal(t, "50uarch", sdk.Coins(estimateEvent.FlatFees).String())
+	})
+}
+
+func TestRewardsMinFeeAnteHandlerDynamicFee(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+
+	minConsFee, err := sdk.ParseDecCoin("0.1stake")
+	require.NoError(t, err)
+	require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+
+	params := k.GetParams(ctx)
+	params.DynamicFeeEnabled = true
+	require.NoError(t, k.Params.Set(ctx, params))
+
+	newPriorityOption := func(price string) *codecTypes.Any {
+		opt, err := codecTypes.NewAnyWithValue(&rewardsTypes.ExtensionOptionDynamicFee{
+			MaxPriorityPrice: sdkMath.LegacyMustNewDecFromStr(price),
+		})
+		require.NoError(t, err)
+		return opt
+	}
+
+	type testCase struct {
+		name             string
+		txFees           string
+		priorityPrice    string // empty if the extension option is not set
+		errExpected      bool
+		gasPriceExpected string // effective gas price
+	}
+
+	testCases := []testCase{
+		{
+			name:        "Fail: tx max gas price is under the base gas price",
+			txFees:      "50stake",
+			errExpected: true,
+		},
+		{
+			name:          "Fail: tx max gas price is under the base gas price with priority price",
+			txFees:        "99stake",
+			priorityPrice: "0.05",
+			errExpected:   true,
+		},
+		{
+			name:             "OK: tx max gas price is the effective gas price without priority price",
+			txFees:           "300stake",
+			gasPriceExpected: "0.300000000000000000stake",
+		},
+		{
+			name:             "OK: effective gas price is the base + priority gas price",
+			txFees:           "300stake",
+			priorityPrice:    "0.05",
+			gasPriceExpected: "0.150000000000000000stake",
+		},
+		{
+			name:             "OK: effective gas price is capped by the tx max gas price",
+			txFees:           "120stake",
+			priorityPrice:    "0.05",
+			gasPriceExpected: "0.120000000000000000stake",
+		},
+		{
+			name:             "OK: tx max gas price equals the base gas price",
+			txFees:           "100stake",
+			priorityPrice:    "0",
+			gasPriceExpected: "0.100000000000000000stake",
+		},
+	}
+
+	cdc := codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+	anteHandler := ante.NewMinFeeDecorator(cdc, k)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			txFees, err := sdk.ParseCoinsNormalized(tc.txFees)
+			require.NoError(t, err)
+
+			txOpts := []testutils.MockFeeTxOption{
+				testutils.WithMockFeeTxFees(txFees),
+				testutils.WithMockFeeTxGas(1000),
+			}
+			if tc.priorityPrice != "" {
+				txOpts = append(txOpts, testutils.WithMockFeeTxExtensionOptions(newPriorityOption(tc.priorityPrice)))
+			}
+
+			newCtx, err := anteHandler.AnteHandle(ctx, testutils.NewMockFeeTx(txOpts...), false, testutils.NoopAnteHandler)
+			if tc.errExpected {
+				require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+				return
+			}
+			require.NoError(t, err)
+
+			refund, found := rewardsTypes.GetDynamicFeeRefund(newCtx)
+			require.True(t, found)
+			require.EqualValues(t, 1000, refund.GasLimit)
+			require.Equal(t, tc.gasPriceExpected, refund.GasPrice.String())
+			require.Equal(t, tc.txFees, refund.GasFees.String())
+		})
+	}
+
+	t.Run("OK: contract flat fees are not withheld", func(t *testing.T) {
+		contractAddr := sdk.AccAddress("contractAddr________")
+		ownerAddr := sdk.AccAddress("ownerAddr___________")
+		require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+			ContractAddress: contractAddr.String(),
+			OwnerAddress:    ownerAddr.String(),
+			RewardsAddress:  ownerAddr.String(),
+		}))
+		require.NoError(t, k.FlatFees.Set(ctx, contractAddr, sdk.NewInt64Coin("stake", 50)))
+
+		tx := testutils.NewMockFeeTx(
+			testutils.WithMockFeeTxFees(sdk.NewCoins(sdk.NewInt64Coin("stake", 350))),
+			testutils.WithMockFeeTxGas(1000),
+			testutils.WithMockFeeTxExtensionOptions(newPriorityOption("0.05")),
+			testutils.WithMockFeeTxMsgs(&wasmTypes.MsgExecuteContract{
+				Sender:   ownerAddr.String(),
+				Contract: contractAddr.String(),
+			}),
+		)
+		newCtx, err := anteHandler.AnteHandle(ctx, tx, false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+
+		refund, found := rewardsTypes.GetDynamicFeeRefund(newCtx)
+		require.True(t, found)
+		require.Equal(t, "300stake", refund.GasFees.String())
+		require.Equal(t, "0.150000000000000000stake", refund.GasPrice.String())
+
+		// Large gas limit, small actual usage: only the gas fees surplus is refunded
+		charged, refunded := refund.Settle(100)
+		require.Equal(t, "15stake", charged.String())
+		require.Equal(t, "285stake", refunded.String())
+
+		// Gas usage over the gas limit is capped
+		charged, refunded = refund.Settle(5000)
+		require.Equal(t, "150stake", charged.String())
+		require.Equal(t, "150stake", refunded.String())
+	})
+
+	t.Run("OK: no refund if the dynamic fee mode is disabled", func(t *testing.T) {
+		params := k.GetParams(ctx)
+		params.DynamicFeeEnabled = false
+		require.NoError(t, k.Params.Set(ctx, params))
+
+		tx := testutils.NewMockFeeTx(
+			testutils.WithMockFeeTxFees(sdk.NewCoins(sdk.NewInt64Coin("stake", 300))),
+			testutils.WithMockFeeTxGas(1000),
+			testutils.WithMockFeeTxExtensionOptions(newPriorityOption("0.05")),
+		)
+		newCtx, err := anteHandler.AnteHandle(ctx, tx, false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+
+		_, found := rewardsTypes.GetDynamicFeeRefund(newCtx)
+		require.False(t, found)
+	})
+}
+
+func TestRewardsMinFeeAnteHandlerFlatFeeDeliverTxOnly(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	contractAddr := sdk.AccAddress("contractAddr________")
+	senderAddr := sdk.AccAddress("senderAddr__________")
+
+	// Min fee is 100stake (1000 gas * 0.1stake) + 50stake (contract flat fee, if charged)
+	minConsFee, err := sdk.ParseDecCoin("0.1stake")
+	require.NoError(t, err)
+	require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+	require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+		ContractAddress: contractAddr.String(),
+		OwnerAddress:    senderAddr.String(),
+		RewardsAddress:  senderAddr.String(),
+	}))
+	require.NoError(t, k.FlatFees.Set(ctx, contractAddr, sdk.NewInt64Coin("stake", 50)))
+
+	setDeliverTxOnly := func(enabled bool) {
+		params := k.GetParams(ctx)
+		params.FlatFeeDeliverTxOnly = enabled
+		require.NoError(t, k.Params.Set(ctx, params))
+	}
+
+	cdc := codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+	anteHandler := ante.NewMinFeeDecorator(cdc, k)
+	newTx := func(txFees int64) sdk.Tx {
+		return testutils.NewMockFeeTx(
+			testutils.WithMockFeeTxFees(sdk.NewCoins(sdk.NewInt64Coin("stake", txFees))),
+			testutils.WithMockFeeTxGas(1000),
+			testutils.WithMockFeeTxMsgs(&wasmTypes.MsgExecuteContract{
+				Sender:   senderAddr.String(),
+				Contract: contractAddr.String(),
+			}),
+		)
+	}
+	checkTxCtx, deliverTxCtx := ctx.WithIsCheckTx(true), ctx.WithIsCheckTx(false)
+
+	t.Run("Fail: disabled: CheckTx charges the flat fee", func(t *testing.T) {
+		setDeliverTxOnly(false)
+
+		_, err := anteHandler.AnteHandle(checkTxCtx, newTx(100), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+	})
+
+	t.Run("OK: enabled: CheckTx does not charge the flat fee", func(t *testing.T) {
+		setDeliverTxOnly(true)
+
+		_, err := anteHandler.AnteHandle(checkTxCtx, newTx(100), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+	})
+
+	t.Run("Fail: enabled: DeliverTx charges the flat fee", func(t *testing.T) {
+		_, err := anteHandler.AnteHandle(deliverTxCtx, newTx(100), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+	})
+
+	t.Run("OK: enabled: DeliverTx with the flat fee paid", func(t *testing.T) {
+		_, err := anteHandler.AnteHandle(deliverTxCtx, newTx(150), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+	})
+
+	t.Run("OK: enabled: simulation reports the flat fee estimate", func(t *testing.T) {
+		simCtx := checkTxCtx.WithExecMode(sdk.ExecModeSimulate).WithEventManager(sdk.NewEventManager())
+		_, err := anteHandler.AnteHandle(simCtx, newTx(0), true, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+
+		var estimateEvent *rewardsTypes.TxFeesEstimateEvent
+		for _, event := range simCtx.EventManager().Events() {
+			msg, err := sdk.ParseTypedEvent(abci.Event(event))
+			require.NoError(t, err)
+			if e, ok := msg.(*rewardsTypes.TxFeesEstimateEvent); ok {
+				estimateEvent = e
+			}
+		}
+		require.NotNil(t, estimateEvent)
+		require.Equal(t, "50stake", sdk.Coins(estimateEvent.FlatFees).String())
+	})
+}
+
+func TestRewardsMinFeeAnteHandlerFlatFeeOncePerBlock(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	contractAddr := sdk.AccAddress("contractAddr________")
+	senderAddr := sdk.AccAddress("senderAddr__________")
+
+	// Min fee is 100stake (1000 gas * 0.1stake) + 50stake (contract flat fee, if charged)
+	minConsFee, err := sdk.ParseDecCoin("0.1stake")
+	require.NoError(t, err)
+	require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+	require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+		ContractAddress: contractAddr.String(),
+		OwnerAddress:    senderAddr.String(),
+		RewardsAddress:  senderAddr.String(),
+	}))
+	require.NoError(t, k.FlatFees.Set(ctx, contractAddr, sdk.NewInt64Coin("stake", 50)))
+
+	setOncePerBlock := func(enabled bool) {
+		params := k.GetParams(ctx)
+		params.FlatFeeOncePerBlock = enabled
+		require.NoError(t, k.Params.Set(ctx, params))
+	}
+
+	cdc := codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+	anteHandler := ante.NewMinFeeDecorator(cdc, k)
+	newTx := func(txFees int64, msgsNum int) sdk.Tx {
+		msgs := make([]sdk.Msg, 0, msgsNum)
+		for i := 0; i < msgsNum; i++ {
+			msgs = append(msgs, &wasmTypes.MsgExecuteContract{
+				Sender:   senderAddr.String(),
+				Contract: contractAddr.String(),
+			})
+		}
+		return testutils.NewMockFeeTx(
+			testutils.WithMockFeeTxFees(sdk.NewCoins(sdk.NewInt64Coin("stake", txFees))),
+			testutils.WithMockFeeTxGas(1000),
+			testutils.WithMockFeeTxMsgs(msgs...),
+		)
+	}
+	// Txs within the same block are identified by the tx bytes
+	ctx = ctx.WithIsCheckTx(false)
+	tx1Ctx, tx2Ctx, tx3Ctx := ctx.WithTxBytes([]byte("tx1")), ctx.WithTxBytes([]byte("tx2")), ctx.WithTxBytes([]byte("tx3"))
+
+	t.Run("OK: disabled: every tx is charged the flat fee", func(t *testing.T) {
+		setOncePerBlock(false)
+
+		_, err := anteHandler.AnteHandle(tx1Ctx, newTx(150, 1), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+
+		_, err = anteHandler.AnteHandle(tx2Ctx, newTx(100, 1), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+	})
+
+	t.Run("Fail: enabled: the first tx is charged the flat fee", func(t *testing.T) {
+		setOncePerBlock(true)
+
+		_, err := anteHandler.AnteHandle(tx1Ctx, newTx(100, 1), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+	})
+
+	t.Run("OK: enabled: the first tx pays the flat fee for every contract msg", func(t *testing.T) {
+		_, err := anteHandler.AnteHandle(tx1Ctx, newTx(150, 2), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+
+		_, err = anteHandler.AnteHandle(tx1Ctx, newTx(200, 2), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+	})
+
+	t.Run("OK: enabled: the second tx within the block is not charged the flat fee", func(t *testing.T) {
+		_, err := anteHandler.AnteHandle(tx2Ctx, newTx(100, 1), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+
+		_, err = anteHandler.AnteHandle(tx3Ctx, newTx(100, 2), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+	})
+
+	t.Run("Fail: enabled: the charging tx is reported the flat fee again (fee deduction re-evaluation)", func(t *testing.T) {
+		_, err := anteHandler.AnteHandle(tx1Ctx, newTx(100, 1), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+	})
+
+	t.Run("Fail: enabled: CheckTx always charges the flat fee", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		require.NoError(t, k.FlatFeeBlockCharges.Clear(ctx, nil))
+		tx1CheckCtx, tx2CheckCtx := ctx.WithTxBytes([]byte("tx1")).WithIsCheckTx(true), ctx.WithTxBytes([]byte("tx2")).WithIsCheckTx(true)
+
+		// The first tx passing CheckTx doesn't exempt other mempool txs
+		_, err := anteHandler.AnteHandle(tx1CheckCtx, newTx(150, 1), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+		_, err = anteHandler.AnteHandle(tx2CheckCtx, newTx(100, 1), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+
+		// Nor does it exempt the txs in DeliverTx (the failed tx state changes are reverted)
+		failedTxCtx, _ := ctx.CacheContext()
+		_, err = anteHandler.AnteHandle(failedTxCtx.WithTxBytes([]byte("tx2")), newTx(100, 1), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+
+		// The DeliverTx charge exempts other DeliverTx txs only
+		_, err = anteHandler.AnteHandle(ctx.WithTxBytes([]byte("tx1")), newTx(150, 1), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+		_, err = anteHandler.AnteHandle(ctx.WithTxBytes([]byte("tx2")), newTx(100, 1), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+		_, err = anteHandler.AnteHandle(tx2CheckCtx, newTx(100, 1), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+		_, err = anteHandler.AnteHandle(tx2CheckCtx.WithIsCheckTx(false).WithIsReCheckTx(true), newTx(100, 1), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+	})
+}
+
+func TestRewardsMinFeeAnteHandlerAcceptedFeeDenoms(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+
+	// Min fee is 100stake (1000 gas * 0.1stake)
+	minConsFee, err := sdk.ParseDecCoin("0.1stake")
+	require.NoError(t, err)
+	require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+
+	setAcceptedFeeDenoms := func(denoms ...string) {
+		params := k.GetParams(ctx)
+		params.AcceptedFeeDenoms = denoms
+		require.NoError(t, params.Validate())
+		require.NoError(t, k.Params.Set(ctx, params))
+	}
+
+	cdc := codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+	anteHandler := ante.NewMinFeeDecorator(cdc, k)
+	newTx := func(txFees sdk.Coins) sdk.Tx {
+		return testutils.NewMockFeeTx(
+			testutils.WithMockFeeTxFees(txFees),
+			testutils.WithMockFeeTxGas(1000),
+		)
+	}
+	bondDenomFees := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	mixedFees := bondDenomFees.Add(sdk.NewInt64Coin("uarch", 10))
+
+	t.Run("OK: empty list accepts any denom", func(t *testing.T) {
+		setAcceptedFeeDenoms()
+
+		_, err := anteHandler.AnteHandle(ctx, newTx(mixedFees), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+	})
+
+	t.Run("OK: fees in the bond denom only", func(t *testing.T) {
+		setAcceptedFeeDenoms("stake")
+
+		_, err := anteHandler.AnteHandle(ctx, newTx(bondDenomFees), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+	})
+
+	t.Run("Fail: fees in a denom not accepted", func(t *testing.T) {
+		_, err := anteHandler.AnteHandle(ctx, newTx(mixedFees), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInvalidCoins)
+	})
+
+	t.Run("OK: fees in the accepted denoms", func(t *testing.T) {
+		setAcceptedFeeDenoms("stake", "uarch")
+
+		_, err := anteHandler.AnteHandle(ctx, newTx(mixedFees), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+	})
+}
+
+func TestRewardsMinFeeAnteHandlerSingleDenomFeesOnly(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+
+	// Min fee is 100stake (1000 gas * 0.1stake)
+	minConsFee, err := sdk.ParseDecCoin("0.1stake")
+	require.NoError(t, err)
+	require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+
+	setSingleDenomFeesOnly := func(enabled bool) {
+		params := k.GetParams(ctx)
+		params.SingleDenomFeesOnly = enabled
+		require.NoError(t, k.Params.Set(ctx, params))
+	}
+
+	cdc := codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+	anteHandler := ante.NewMinFeeDecorator(cdc, k)
+	newTx := func(txFees sdk.Coins) sdk.Tx {
+		return testutils.NewMockFeeTx(
+			testutils.WithMockFeeTxFees(txFees),
+			testutils.WithMockFeeTxGas(1000),
+		)
+	}
+	singleDenomFees := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	multiDenomFees := singleDenomFees.Add(sdk.NewInt64Coin("uarch", 10))
+
+	t.Run("OK: disabled: multi-denom fee", func(t *testing.T) {
+		setSingleDenomFeesOnly(false)
+
+		_, err := anteHandler.AnteHandle(ctx, newTx(multiDenomFees), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+	})
+
+	t.Run("OK: enabled: single-denom fee", func(t *testing.T) {
+		setSingleDenomFeesOnly(true)
+
+		_, err := anteHandler.AnteHandle(ctx, newTx(singleDenomFees), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+	})
+
+	t.Run("Fail: enabled: multi-denom fee", func(t *testing.T) {
+		_, err := anteHandler.AnteHandle(ctx, newTx(multiDenomFees), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInvalidCoins)
+	})
+
+	t.Run("Fail: enabled: multi-denom fee is rejected before the min fee check", func(t *testing.T) {
+		insufficientFees := sdk.NewCoins(sdk.NewInt64Coin("stake", 1), sdk.NewInt64Coin("uarch", 1))
+
+		_, err := anteHandler.AnteHandle(ctx, newTx(insufficientFees), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInvalidCoins)
+		require.NotErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+	})
+}
+
+func TestRewardsMinFeeAnteHandlerTxSizeFee(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+
+	// Min fee is 100stake (1000 gas * 0.1stake) + the tx size surcharge (if enabled)
+	minConsFee, err := sdk.ParseDecCoin("0.1stake")
+	require.NoError(t, err)
+	require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+
+	setTxSizeFeePerByte := func(fee uint64) {
+		params := k.GetParams(ctx)
+		params.TxSizeFeePerByte = fee
+		require.NoError(t, k.Params.Set(ctx, params))
+	}
+
+	cdc := codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+	anteHandler := ante.NewMinFeeDecorator(cdc, k)
+	newTx := func(txFees int64) sdk.Tx {
+		return testutils.NewMockFeeTx(
+			testutils.WithMockFeeTxFees(sdk.NewCoins(sdk.NewInt64Coin("stake", txFees))),
+			testutils.WithMockFeeTxGas(1000),
+		)
+	}
+	smallTxCtx, largeTxCtx := ctx.WithTxBytes(make([]byte, 10)), ctx.WithTxBytes(make([]byte, 1000))
+
+	type testCase struct {
+		name        string
+		feePerByte  uint64
+		ctx         sdk.Context
+		txFees      int64
+		errExpected bool
+	}
+
+	testCases := []testCase{
+		{
+			name:   "OK: disabled: small tx",
+			ctx:    smallTxCtx,
+			txFees: 100,
+		},
+		{
+			name:   "OK: disabled: large tx",
+			ctx:    largeTxCtx,
+			txFees: 100,
+		},
+		{
+			name:        "Fail: enabled: small tx without the surcharge",
+			feePerByte:  2,
+			ctx:         smallTxCtx,
+			txFees:      100,
+			errExpected: true,
+		},
+		{
+			name:       "OK: enabled: small tx with the surcharge",
+			feePerByte: 2,
+			ctx:        smallTxCtx,
+			txFees:     120,
+		},
+		{
+			name:        "Fail: enabled: large tx with the small tx surcharge",
+			feePerByte:  2,
+			ctx:         largeTxCtx,
+			txFees:      120,
+			errExpected: true,
+		},
+		{
+			name:       "OK: enabled: large tx with the surcharge",
+			feePerByte: 2,
+			ctx:        largeTxCtx,
+			txFees:     2100,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			setTxSizeFeePerByte(tc.feePerByte)
+
+			_, err := anteHandler.AnteHandle(tc.ctx, newTx(tc.txFees), false, testutils.NoopAnteHandler)
+			if tc.errExpected {
+				require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRewardsMinFeeAnteHandlerGasLimitSources(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+
+	// Min fee is 100stake (1000 gas * 0.1stake)
+	minConsFee, err := sdk.ParseDecCoin("0.1stake")
+	require.NoError(t, err)
+	require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+
+	cdc := codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+	anteHandler := ante.NewMinFeeDecorator(cdc, k)
+
+	newTx := func(txFees int64, opts ...testutils.MockFeeTxOption) sdk.Tx {
+		opts = append(opts, testutils.WithMockFeeTxFees(sdk.NewCoins(sdk.NewInt64Coin("stake", txFees))))
+		return testutils.NewMockFeeTx(opts...)
+	}
+
+	type testCase struct {
+		name   string
+		txOpts []testutils.MockFeeTxOption
+	}
+
+	testCases := []testCase{
+		{
+			name:   "Fee tx gas",
+			txOpts: []testutils.MockFeeTxOption{testutils.WithMockFeeTxGas(1000)},
+		},
+		{
+			name:   "Gas estimation fields gas",
+			txOpts: []testutils.MockFeeTxOption{testutils.WithMockFeeTxGasLimit(1000)},
+		},
+		{
+			name:   "Proto tx auth info gas",
+			txOpts: []testutils.MockFeeTxOption{testutils.WithMockFeeTxAuthInfoGas(1000)},
+		},
+		{
+			name: "Fee tx gas is preferred",
+			txOpts: []testutils.MockFeeTxOption{
+				testutils.WithMockFeeTxGas(1000),
+				testutils.WithMockFeeTxGasLimit(500),
+				testutils.WithMockFeeTxAuthInfoGas(500),
+			},
+		},
+		{
+			name: "Gas estimation fields gas is preferred over the auth info one",
+			txOpts: []testutils.MockFeeTxOption{
+				testutils.WithMockFeeTxGasLimit(1000),
+				testutils.WithMockFeeTxAuthInfoGas(500),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := anteHandler.AnteHandle(ctx, newTx(100, tc.txOpts...), false, testutils.NoopAnteHandler)
+			require.NoError(t, err)
+
+			_, err = anteHandler.AnteHandle(ctx, newTx(99, tc.txOpts...), false, testutils.NoopAnteHandler)
+			require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+		})
+	}
+
+	t.Run("OK: no gas limit set", func(t *testing.T) {
+		_, err := anteHandler.AnteHandle(ctx, newTx(0), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+	})
+}
+
+func TestRewardsMinFeeAnteHandlerMinFeeFloor(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+
+	// Derived min fee is zero: MinPriceOfGas is 0stake and the min consensus fee is not set
+	setFloorEnabled := func(enabled bool) {
+		params := rewardsTypes.DefaultParams()
+		params.MinFeeFloorEnabled = enabled
+		require.NoError(t, k.Params.Set(ctx, params))
+		require.True(t, k.ComputationalPriceOfGas(ctx).IsZero())
+	}
+
+	cdc := codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+	anteHandler := ante.NewMinFeeDecorator(cdc, k)
+	newTx := func(txFees sdk.Coins) sdk.Tx {
+		return testutils.NewMockFeeTx(
+			testutils.WithMockFeeTxFees(txFees),
+			testutils.WithMockFeeTxGas(1000),
+		)
+	}
+
+	t.Run("OK: disabled: zero-fee tx is accepted", func(t *testing.T) {
+		setFloorEnabled(false)
+
+		_, err := anteHandler.AnteHandle(ctx, newTx(nil), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+	})
+
+	t.Run("Fail: enabled: zero-fee tx is rejected", func(t *testing.T) {
+		setFloorEnabled(true)
+
+		_, err := anteHandler.AnteHandle(ctx, newTx(nil), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+		require.ErrorContains(t, err, "min fee: 1stake")
+	})
+
+	t.Run("Fail: enabled: fee in another denom is rejected", func(t *testing.T) {
+		_, err := anteHandler.AnteHandle(ctx, newTx(sdk.NewCoins(sdk.NewInt64Coin("uarch", 1))), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, rewardsTypes.ErrIrrelevantFeeDenom)
+	})
+
+	t.Run("OK: enabled: 1 unit of the gas price denom is accepted", func(t *testing.T) {
+		_, err := anteHandler.AnteHandle(ctx, newTx(sdk.NewCoins(sdk.NewInt64Coin("stake", 1))), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+	})
+}
+
+func TestRewardsMinFeeAnteHandlerFlatFeeChargedEvents(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	contractAddrA := sdk.AccAddress("contractAddrA_______")
+	contractAddrB := sdk.AccAddress("contractAddrB_______")
+	senderAddr := sdk.AccAddress("senderAddr__________")
+
+	// Min fee is 100stake (1000 gas * 0.1stake) + 160stake (contract flat fees: 50stake + 30stake + 50stake + 30stake)
+	minConsFee, err := sdk.ParseDecCoin("0.1stake")
+	require.NoError(t, err)
+	require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+	for _, contractAddr := range []sdk.AccAddress{contractAddrA, contractAddrB} {
+		require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+			ContractAddress: contractAddr.String(),
+			OwnerAddress:    senderAddr.String(),
+			RewardsAddress:  senderAddr.String(),
+		}))
+	}
+	require.NoError(t, k.FlatFees.Set(ctx, contractAddrA, sdk.NewInt64Coin("stake", 50)))
+	require.NoError(t, k.FlatFees.Set(ctx, contractAddrB, sdk.NewInt64Coin("stake", 30)))
+
+	cdc := codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+	anteHandler := ante.NewMinFeeDecorator(cdc, k)
+
+	executeMsgA := &wasmTypes.MsgExecuteContract{Sender: senderAddr.String(), Contract: contractAddrA.String()}
+	executeMsgB := &wasmTypes.MsgExecuteContract{Sender: senderAddr.String(), Contract: contractAddrB.String()}
+	execMsg := authz.NewMsgExec(senderAddr, []sdk.Msg{executeMsgA, executeMsgB})
+	tx := testutils.NewMockFeeTx(
+		testutils.WithMockFeeTxFees(sdk.NewCoins(sdk.NewInt64Coin("stake", 260))),
+		testutils.WithMockFeeTxGas(1000),
+		testutils.WithMockFeeTxMsgs(
+			executeMsgA,
+			rewardsTypes.NewMsgWithdrawRewardsByLimit(senderAddr, 1),
+			executeMsgB,
+			&execMsg,
+		),
+	)
+
+	getChargedEvents := func(ctx sdk.Context) []*rewardsTypes.ContractFlatFeeChargedEvent {
+		var chargedEvents []*rewardsTypes.ContractFlatFeeChargedEvent
+		for _, event := range ctx.EventManager().Events() {
+			msg, err := sdk.ParseTypedEvent(abci.Event(event))
+			require.NoError(t, err)
+			if e, ok := msg.(*rewardsTypes.ContractFlatFeeChargedEvent); ok {
+				chargedEvents = append(chargedEvents, e)
+			}
+		}
+		return chargedEvents
+	}
+
+	t.Run("OK: one event per flat fee bearing message", func(t *testing.T) {
+		ctx := ctx.WithEventManager(sdk.NewEventManager())
+		_, err := anteHandler.AnteHandle(ctx, tx, false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+
+		chargedEvents := getChargedEvents(ctx)
+		require.Len(t, chargedEvents, 4)
+
+		expected := []struct {
+			msgIndex     uint32
+			contractAddr sdk.AccAddress
+			flatFees     string
+		}{
+			{msgIndex: 0, contractAddr: contractAddrA, flatFees: "50stake"},
+			{msgIndex: 2, contractAddr: contractAddrB, flatFees: "30stake"},
+			{msgIndex: 3, contractAddr: contractAddrA, flatFees: "50stake"},
+			{msgIndex: 3, contractAddr: contractAddrB, flatFees: "30stake"},
+		}
+		for i, e := range expected {
+			assert.Equal(t, e.msgIndex, chargedEvents[i].MsgIndex, "event %d", i)
+			assert.Equal(t, e.contractAddr.String(), chargedEvents[i].ContractAddress, "event %d", i)
+			assert.Equal(t, e.flatFees, sdk.Coins(chargedEvents[i].FlatFees).String(), "event %d", i)
+		}
+	})
+
+	t.Run("OK: no events for a tx without flat fees", func(t *testing.T) {
+		ctx := ctx.WithEventManager(sdk.NewEventManager())
+		tx := testutils.NewMockFeeTx(
+			testutils.WithMockFeeTxFees(sdk.NewCoins(sdk.NewInt64Coin("stake", 100))),
+			testutils.WithMockFeeTxGas(1000),
+			testutils.WithMockFeeTxMsgs(rewardsTypes.NewMsgWithdrawRewardsByLimit(senderAddr, 1)),
+		)
+		_, err := anteHandler.AnteHandle(ctx, tx, false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+
+		assert.Empty(t, getChargedEvents(ctx))
+	})
+}
+
+func TestRewardsMinFeeAnteHandlerMinContractExecutionGas(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	contractAddr := sdk.AccAddress("contractAddr________")
+	senderAddr := sdk.AccAddress("senderAddr__________")
+
+	setMinGas := func(minGas uint64) {
+		params := k.GetParams(ctx)
+		params.MinContractExecutionGas = minGas
+		require.NoError(t, k.Params.Set(ctx, params))
+	}
+
+	cdc := codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+	anteHandler := ante.NewMinFeeDecorator(cdc, k)
+	executeMsg := &wasmTypes.MsgExecuteContract{
+		Sender:   senderAddr.String(),
+		Contract: contractAddr.String(),
+	}
+	newTx := func(txGas uint64, msgs ...sdk.Msg) sdk.Tx {
+		return testutils.NewMockFeeTx(
+			testutils.WithMockFeeTxGas(txGas),
+			testutils.WithMockFeeTxMsgs(msgs...),
+		)
+	}
+
+	t.Run("OK: disabled: below the floor execution", func(t *testing.T) {
+		setMinGas(0)
+
+		_, err := anteHandler.AnteHandle(ctx, newTx(1000, executeMsg), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+	})
+
+	t.Run("Fail: below the floor execution", func(t *testing.T) {
+		setMinGas(50000)
+
+		_, err := anteHandler.AnteHandle(ctx, newTx(49999, executeMsg), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInvalidRequest)
+	})
+
+	t.Run("Fail: below the floor authz wrapped execution", func(t *testing.T) {
+		execMsg := authz.NewMsgExec(senderAddr, []sdk.Msg{executeMsg})
+
+		_, err := anteHandler.AnteHandle(ctx, newTx(49999, &execMsg), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInvalidRequest)
+	})
+
+	t.Run("OK: at the floor execution", func(t *testing.T) {
+		_, err := anteHandler.AnteHandle(ctx, newTx(50000, executeMsg), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+	})
+
+	t.Run("OK: above the floor execution", func(t *testing.T) {
+		_, err := anteHandler.AnteHandle(ctx, newTx(100000, executeMsg), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+	})
+
+	t.Run("OK: below the floor non-wasm tx", func(t *testing.T) {
+		_, err := anteHandler.AnteHandle(ctx, newTx(1000, rewardsTypes.NewMsgWithdrawRewardsByLimit(senderAddr, 1)), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+	})
+
+	t.Run("OK: below the floor execution simulation", func(t *testing.T) {
+		_, err := anteHandler.AnteHandle(ctx, newTx(1000, executeMsg), true, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+	})
+}
+
+func TestRewardsMinFeeAnteHandlerMaxFlatFeeMsgsPerTx(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	contractAddr, noFlatFeeContractAddr := sdk.AccAddress("contractAddr________"), sdk.AccAddress("noFlatFeeContract___")
+	senderAddr := sdk.AccAddress("senderAddr__________")
+
+	require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+		ContractAddress: contractAddr.String(),
+		OwnerAddress:    senderAddr.String(),
+		RewardsAddress:  senderAddr.String(),
+	}))
+	require.NoError(t, k.FlatFees.Set(ctx, contractAddr, sdk.NewInt64Coin("stake", 50)))
+
+	setMaxMsgs := func(maxMsgs uint64) {
+		params := k.GetParams(ctx)
+		params.MaxFlatFeeMsgsPerTx = maxMsgs
+		require.NoError(t, k.Params.Set(ctx, params))
+	}
+
+	cdc := codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+	anteHandler := ante.NewMinFeeDecorator(cdc, k)
+	newExecuteMsg := func(contract sdk.AccAddress) *wasmTypes.MsgExecuteContract {
+		return &wasmTypes.MsgExecuteContract{
+			Sender:   senderAddr.String(),
+			Contract: contract.String(),
+		}
+	}
+	newTx := func(msgs ...sdk.Msg) sdk.Tx {
+		return testutils.NewMockFeeTx(
+			testutils.WithMockFeeTxFees(sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))),
+			testutils.WithMockFeeTxGas(1000),
+			testutils.WithMockFeeTxMsgs(msgs...),
+		)
+	}
+	newExecuteMsgs := func(contract sdk.AccAddress, n int) []sdk.Msg {
+		msgs := make([]sdk.Msg, 0, n)
+		for i := 0; i < n; i++ {
+			msgs = append(msgs, newExecuteMsg(contract))
+		}
+		return msgs
+	}
+
+	t.Run("OK: disabled", func(t *testing.T) {
+		setMaxMsgs(0)
+
+		_, err := anteHandler.AnteHandle(ctx, newTx(newExecuteMsgs(contractAddr, 10)...), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+	})
+
+	t.Run("OK: at the limit", func(t *testing.T) {
+		setMaxMsgs(3)
+
+		_, err := anteHandler.AnteHandle(ctx, newTx(newExecuteMsgs(contractAddr, 3)...), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+	})
+
+	t.Run("Fail: just over the limit", func(t *testing.T) {
+		setMaxMsgs(3)
+
+		_, err := anteHandler.AnteHandle(ctx, newTx(newExecuteMsgs(contractAddr, 4)...), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInvalidRequest)
+	})
+
+	t.Run("Fail: just over the limit with authz wrapped msgs", func(t *testing.T) {
+		setMaxMsgs(3)
+
+		execMsg := authz.NewMsgExec(senderAddr, newExecuteMsgs(contractAddr, 2))
+		_, err := anteHandler.AnteHandle(ctx, newTx(&execMsg, newExecuteMsg(contractAddr), newExecuteMsg(contractAddr)), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInvalidRequest)
+	})
+
+	t.Run("Fail: just over the limit simulation", func(t *testing.T) {
+		setMaxMsgs(3)
+
+		_, err := anteHandler.AnteHandle(ctx, newTx(newExecuteMsgs(contractAddr, 4)...), true, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInvalidRequest)
+	})
+
+	t.Run("OK: msgs without flat fees are not counted", func(t *testing.T) {
+		setMaxMsgs(3)
+
+		msgs := append(newExecuteMsgs(contractAddr, 3), newExecuteMsgs(noFlatFeeContractAddr, 5)...)
+		_, err := anteHandler.AnteHandle(ctx, newTx(msgs...), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+	})
+}
+
+// TestRewardsMinFeeAnteHandlerFlatFeeAbsorbedInGasFee compares the min fee with the same denom flat fees stacked on top
+// of the gas fees and absorbed into them.
+func TestRewardsMinFeeAnteHandlerFlatFeeAbsorbedInGasFee(t *testing.T) {
+	type testCase struct {
+		name           string
+		absorbed       bool
+		dynamicFee     bool
+		flatFees       []sdk.Coin // per contract executed
+		minFeeExpected string     // [sdk.Coins]
+	}
+
+	// Gas fees are 150stake (1000 gas * 0.15stake)
+	testCases := []testCase{
+		{
+			name:           "Stacked: same denom flat fee is added to the gas fees",
+			flatFees:       []sdk.Coin{sdk.NewInt64Coin("stake", 100)},
+			minFeeExpected: "250stake",
+		},
+		{
+			name:           "Absorbed: same denom flat fee lower than the gas fees",
+			absorbed:       true,
+			flatFees:       []sdk.Coin{sdk.NewInt64Coin("stake", 100)},
+			minFeeExpected: "150stake",
+		},
+		{
+			name:           "Absorbed: same denom flat fees greater than the gas fees",
+			absorbed:       true,
+			flatFees:       []sdk.Coin{sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("stake", 100)},
+			minFeeExpected: "200stake",
+		},
+		{
+			name:           "Stacked: mixed denoms",
+			flatFees:       []sdk.Coin{sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("uarch", 50)},
+			minFeeExpected: "250stake,50uarch",
+		},
+		{
+			name:           "Absorbed: other denom flat fee is not absorbed",
+			absorbed:       true,
+			flatFees:       []sdk.Coin{sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("uarch", 50)},
+			minFeeExpected: "150stake,50uarch",
+		},
+		{
+			name:           "Absorbed: not applied in the dynamic fee mode",
+			absorbed:       true,
+			dynamicFee:     true,
+			flatFees:       []sdk.Coin{sdk.NewInt64Coin("stake", 100)},
+			minFeeExpected: "250stake",
+		},
+	}
+
+	senderAddr := sdk.AccAddress("senderAddr__________")
+	cdc := codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx, _ := testutils.RewardsKeeper(t)
+
+			params := k.GetParams(ctx)
+			params.FlatFeeAbsorbedInGasFee = tc.absorbed
+			params.DynamicFeeEnabled = tc.dynamicFee
+			require.NoError(t, k.Params.Set(ctx, params))
+
+			minConsFee, err := sdk.ParseDecCoin("0.15stake")
+			require.NoError(t, err)
+			require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+
+			var msgs []sdk.Msg
+			for i, flatFee := range tc.flatFees {
+				contractAddr := sdk.AccAddress(strings.Repeat(string(rune('a'+i)), 20))
+				require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+					ContractAddress: contractAddr.String(),
+					OwnerAddress:    senderAddr.String(),
+					RewardsAddress:  senderAddr.String(),
+				}))
+				require.NoError(t, k.FlatFees.Set(ctx, contractAddr, flatFee))
+				msgs = append(msgs, &wasmTypes.MsgExecuteContract{Sender: senderAddr.String(), Contract: contractAddr.String()})
+			}
+
+			anteHandler := ante.NewMinFeeDecorator(cdc, k)
+			newTx := func(fees sdk.Coins) sdk.Tx {
+				return testutils.NewMockFeeTx(
+					testutils.WithMockFeeTxFees(fees),
+					testutils.WithMockFeeTxGas(1000),
+					testutils.WithMockFeeTxMsgs(msgs...),
+				)
+			}
+
+			minFeeExpected, err := sdk.ParseCoinsNormalized(tc.minFeeExpected)
+			require.NoError(t, err)
+
+			// A unit less of any denom is rejected with the expected min fee recommended
+			for _, fee := range minFeeExpected {
+				cacheCtx, _ := ctx.CacheContext()
+				_, err := anteHandler.AnteHandle(cacheCtx, newTx(minFeeExpected.Sub(sdk.NewInt64Coin(fee.Denom, 1))), false, testutils.NoopAnteHandler)
+				require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+
+				var feeErr *rewardsTypes.InsufficientFeeError
+				if errors.As(err, &feeErr) {
+					assert.Equal(t, tc.minFeeExpected, sdk.Coins(feeErr.RecommendedFees).String())
+				}
+			}
+
+			_, err = anteHandler.AnteHandle(ctx, newTx(minFeeExpected), false, testutils.NoopAnteHandler)
+			require.NoError(t, err)
+		})
+	}
+}
+
+// TestRewardsMinFeeAnteHandlerFlatFeeAbsorbedMsgsGas checks the absorbed flat fees for a batch with uneven per-msg gas:
+// a msg flat fee is only absorbed into the gas fees of the msg gas share.
+func TestRewardsMinFeeAnteHandlerFlatFeeAbsorbedMsgsGas(t *testing.T) {
+	type testCase struct {
+		name           string
+		msgsGas        []uint64 // [flat fee contract msg, no flat fee contract msg]
+		minFeeExpected string   // [sdk.Coins]
+	}
+
+	// Gas fees are 150stake (1000 gas * 0.15stake), the flat fee is 100stake
+	testCases := []testCase{
+		{
+			name:           "No msgs gas declared: flat fee absorbed into the tx gas fees",
+			minFeeExpected: "150stake",
+		},
+		{
+			name:           "Msgs gas mismatch: flat fee absorbed into the tx gas fees",
+			msgsGas:        []uint64{200},
+			minFeeExpected: "150stake",
+		},
+		{
+			name:           "Light flat fee msg: only 30stake absorbed",
+			msgsGas:        []uint64{200, 800},
+			minFeeExpected: "220stake",
+		},
+		{
+			name:           "Heavy flat fee msg: the whole flat fee absorbed",
+			msgsGas:        []uint64{800, 200},
+			minFeeExpected: "150stake",
+		},
+		{
+			name:           "Declared gas is scaled to the tx gas limit",
+			msgsGas:        []uint64{100, 400},
+			minFeeExpected: "220stake",
+		},
+		{
+			name:           "Zero msgs gas: even split",
+			msgsGas:        []uint64{0, 0},
+			minFeeExpected: "175stake",
+		},
+	}
+
+	contractAddr, noFlatFeeContractAddr := sdk.AccAddress("contractAddr________"), sdk.AccAddress("noFlatFeeContract___")
+	senderAddr := sdk.AccAddress("senderAddr__________")
+	cdc := codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx, _ := testutils.RewardsKeeper(t)
+
+			params := k.GetParams(ctx)
+			params.FlatFeeAbsorbedInGasFee = true
+			require.NoError(t, k.Params.Set(ctx, params))
+
+			minConsFee, err := sdk.ParseDecCoin("0.15stake")
+			require.NoError(t, err)
+			require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+
+			require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+				ContractAddress: contractAddr.String(),
+				OwnerAddress:    senderAddr.String(),
+				RewardsAddress:  senderAddr.String(),
+			}))
+			require.NoError(t, k.FlatFees.Set(ctx, contractAddr, sdk.NewInt64Coin("stake", 100)))
+
+			anteHandler := ante.NewMinFeeDecorator(cdc, k)
+			newTx := func(fees sdk.Coins) sdk.Tx {
+				return testutils.NewMockFeeTx(
+					testutils.WithMockFeeTxFees(fees),
+					testutils.WithMockFeeTxGas(1000),
+					testutils.WithMockFeeTxMsgsGas(tc.msgsGas...),
+					testutils.WithMockFeeTxMsgs(
+						&wasmTypes.MsgExecuteContract{Sender: senderAddr.String(), Contract: contractAddr.String()},
+						&wasmTypes.MsgExecuteContract{Sender: senderAddr.String(), Contract: noFlatFeeContractAddr.String()},
+					),
+				)
+			}
+
+			minFeeExpected, err := sdk.ParseCoinsNormalized(tc.minFeeExpected)
+			require.NoError(t, err)
+
+			cacheCtx, _ := ctx.CacheContext()
+			_, err = anteHandler.AnteHandle(cacheCtx, newTx(minFeeExpected.Sub(sdk.NewInt64Coin("stake", 1))), false, testutils.NoopAnteHandler)
+			require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+
+			_, err = anteHandler.AnteHandle(ctx, newTx(minFeeExpected), false, testutils.NoopAnteHandler)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRewardsExtensionOptionChecker(t *testing.T) {
+	dynamicFeeOpt, err := codecTypes.NewAnyWithValue(&rewardsTypes.ExtensionOptionDynamicFee{MaxPriorityPrice: sdkMath.LegacyOneDec()})
+	require.NoError(t, err)
+	assert.True(t, ante.RewardsExtensionOptionChecker(dynamicFeeOpt))
+
+	flatFeeTipOpt, err := codecTypes.NewAnyWithValue(&rewardsTypes.ExtensionOptionFlatFeeTip{})
+	require.NoError(t, err)
+	assert.True(t, ante.RewardsExtensionOptionChecker(flatFeeTipOpt))
+
+	otherOpt, err := codecTypes.NewAnyWithValue(&rewardsTypes.FlatFeeTip{})
+	require.NoError(t, err)
+	assert.False(t, ante.RewardsExtensionOptionChecker(otherOpt))
+}
+
+func TestRewardsMinFeeAnteHandlerFlatFeeTip(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	contractAddr, noFlatFeeContractAddr := sdk.AccAddress("contractAddr________"), sdk.AccAddress("noFlatFeeContract___")
+	noMetadataContractAddr, otherContractAddr := sdk.AccAddress("noMetadataContract__"), sdk.AccAddress("otherContractAddr___")
+	senderAddr := sdk.AccAddress("senderAddr__________")
+
+	// Min fee is 100stake (1000 gas * 0.1stake) + 50stake (contract flat fee)
+	minConsFee, err := sdk.ParseDecCoin("0.1stake")
+	require.NoError(t, err)
+	require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+	for _, addr := range []sdk.AccAddress{contractAddr, noFlatFeeContractAddr, otherContractAddr} {
+		require.NoError(t, k.ContractMetadata.Set(ctx, addr, rewardsTypes.ContractMetadata{
+			ContractAddress: addr.String(),
+			OwnerAddress:    senderAddr.String(),
+			RewardsAddress:  senderAddr.String(),
+		}))
+	}
+	require.NoError(t, k.FlatFees.Set(ctx, contractAddr, sdk.NewInt64Coin("stake", 50)))
+
+	cdc := codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+	anteHandler := ante.NewMinFeeDecorator(cdc, k)
+	newExecuteMsg := func(contract sdk.AccAddress) *wasmTypes.MsgExecuteContract {
+		return &wasmTypes.MsgExecuteContract{
+			Sender:   senderAddr.String(),
+			Contract: contract.String(),
+		}
+	}
+	newTipOption := func(tips ...rewardsTypes.FlatFeeTip) *codecTypes.Any {
+		opt, err := codecTypes.NewAnyWithValue(&rewardsTypes.ExtensionOptionFlatFeeTip{Tips: tips})
+		require.NoError(t, err)
+		return opt
+	}
+	newTip := func(contract sdk.AccAddress, amount string) rewardsTypes.FlatFeeTip {
+		coins, err := sdk.ParseCoinsNormalized(amount)
+		require.NoError(t, err)
+		return rewardsTypes.FlatFeeTip{ContractAddress: contract.String(), Amount: coins}
+	}
+
+	type testCase struct {
+		name          string
+		txFees        string
+		msgs          []sdk.Msg
+		tips          []rewardsTypes.FlatFeeTip
+		absorbed      bool // FlatFeeAbsorbedInGasFee param
+		errExpected   error
+		flatFees      string            // tx flat fees expected (tips included)
+		revenue       map[string]string // contract flat fee revenue expected (tips included)
+		tipsPaidCount int               // number of ContractFlatFeeTipEvent events expected
+	}
+
+	testCases := []testCase{
+		{
+			name:     "OK: tip is accepted and attributed",
+			txFees:   "1000stake",
+			msgs:     []sdk.Msg{newExecuteMsg(contractAddr)},
+			tips:     []rewardsTypes.FlatFeeTip{newTip(contractAddr, "30stake")},
+			flatFees: "80stake",
+			revenue: map[string]string{
+				contractAddr.String(): "80stake",
+			},
+			tipsPaidCount: 1,
+		},
+		{
+			name:     "OK: tip in another denom for a contract without a flat fee",
+			txFees:   "100stake,5uarch",
+			msgs:     []sdk.Msg{newExecuteMsg(noFlatFeeContractAddr)},
+			tips:     []rewardsTypes.FlatFeeTip{newTip(noFlatFeeContractAddr, "5uarch")},
+			flatFees: "5uarch",
+			revenue: map[string]string{
+				noFlatFeeContractAddr.String(): "5uarch",
+			},
+			tipsPaidCount: 1,
+		},
+		{
+			name:   "OK: authz wrapped execution is tipped",
+			txFees: "180stake",
+			msgs: func() []sdk.Msg {
+				execMsg := authz.NewMsgExec(senderAddr, []sdk.Msg{newExecuteMsg(contractAddr)})
+				return []sdk.Msg{&execMsg}
+			}(),
+			tips:     []rewardsTypes.FlatFeeTip{newTip(contractAddr, "30stake")},
+			flatFees: "80stake",
+			revenue: map[string]string{
+				contractAddr.String(): "80stake",
+			},
+			tipsPaidCount: 1,
+		},
+		{
+			name:   "OK: tips for contracts not executed or without metadata are ignored",
+			txFees: "150stake",
+			msgs:   []sdk.Msg{newExecuteMsg(contractAddr), newExecuteMsg(noMetadataContractAddr)},
+			tips: []rewardsTypes.FlatFeeTip{
+				newTip(otherContractAddr, "30stake"),
+				newTip(noMetadataContractAddr, "30stake"),
+			},
+			flatFees: "50stake",
+			revenue: map[string]string{
+				contractAddr.String():      "50stake",
+				otherContractAddr.String(): "",
+			},
+		},
+		{
+			name:     "OK: tip is stacked on top of the absorbed flat fees",
+			txFees:   "130stake",
+			msgs:     []sdk.Msg{newExecuteMsg(contractAddr)},
+			tips:     []rewardsTypes.FlatFeeTip{newTip(contractAddr, "30stake")},
+			absorbed: true,
+			flatFees: "80stake",
+			revenue: map[string]string{
+				contractAddr.String(): "80stake",
+			},
+			tipsPaidCount: 1,
+		},
+		{
+			name:        "Fail: absorbed flat fees do not cover the tip",
+			txFees:      "129stake",
+			msgs:        []sdk.Msg{newExecuteMsg(contractAddr)},
+			tips:        []rewardsTypes.FlatFeeTip{newTip(contractAddr, "30stake")},
+			absorbed:    true,
+			errExpected: sdkErrors.ErrInsufficientFee,
+		},
+		{
+			name:        "Fail: tip not covered by the tx fees",
+			txFees:      "179stake",
+			msgs:        []sdk.Msg{newExecuteMsg(contractAddr)},
+			tips:        []rewardsTypes.FlatFeeTip{newTip(contractAddr, "30stake")},
+			errExpected: sdkErrors.ErrInsufficientFee,
+		},
+		{
+			name:        "Fail: invalid tip",
+			txFees:      "1000stake",
+			msgs:        []sdk.Msg{newExecuteMsg(contractAddr)},
+			tips:        []rewardsTypes.FlatFeeTip{{ContractAddress: contractAddr.String()}},
+			errExpected: sdkErrors.ErrInvalidRequest,
+		},
+		{
+			name:   "Fail: duplicated contract tips",
+			txFees: "1000stake",
+			msgs:   []sdk.Msg{newExecuteMsg(contractAddr)},
+			tips: []rewardsTypes.FlatFeeTip{
+				newTip(contractAddr, "30stake"),
+				newTip(contractAddr, "10stake"),
+			},
+			errExpected: sdkErrors.ErrInvalidRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			txFees, err := sdk.ParseCoinsNormalized(tc.txFees)
+			require.NoError(t, err)
+			tx := testutils.NewMockFeeTx(
+				testutils.WithMockFeeTxFees(txFees),
+				testutils.WithMockFeeTxGas(1000),
+				testutils.WithMockFeeTxPayer(senderAddr),
+				testutils.WithMockFeeTxMsgs(tc.msgs...),
+				testutils.WithMockFeeTxExtensionOptions(newTipOption(tc.tips...)),
+			)
+
+			cacheCtx, _ := ctx.CacheContext()
+			cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+			params := k.GetParams(cacheCtx)
+			params.FlatFeeAbsorbedInGasFee = tc.absorbed
+			require.NoError(t, k.Params.Set(cacheCtx, params))
+
+			newCtx, err := anteHandler.AnteHandle(cacheCtx, tx, false, testutils.NoopAnteHandler)
+			if tc.errExpected != nil {
+				require.ErrorIs(t, err, tc.errExpected)
+				return
+			}
+			require.NoError(t, err)
+
+			flatFees, _ := rewardsTypes.GetTxFlatFees(newCtx)
+			assert.Equal(t, tc.flatFees, flatFees.String())
+
+			for contract, revenueExpected := range tc.revenue {
+				revenue, err := k.ContractFlatFeeRevenue(cacheCtx, sdk.MustAccAddressFromBech32(contract), 1)
+				require.NoError(t, err)
+				assert.Equal(t, revenueExpected, revenue.String(), contract)
+			}
+
+			var tipsPaidCount int
+			for _, event := range cacheCtx.EventManager().Events() {
+				msg, err := sdk.ParseTypedEvent(abci.Event(event))
+				require.NoError(t, err)
+				if e, ok := msg.(*rewardsTypes.ContractFlatFeeTipEvent); ok {
+					assert.Equal(t, senderAddr.String(), e.FeePayer)
+					tipsPaidCount++
+				}
+			}
+			assert.Equal(t, tc.tipsPaidCount, tipsPaidCount)
+		})
+	}
+}
+
+func TestRewardsMinFeeAnteHandlerFreeTxBudget(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	contractAddr := sdk.AccAddress("contractAddr________")
+	payerAddr := sdk.AccAddress("payerAddr___________")
+	otherPayerAddr := sdk.AccAddress("otherPayerAddr______")
+
+	// Min fee is 100stake (1000 gas * 0.1stake)
+	params := k.GetParams(ctx)
+	params.FreeTxBudget = 2
+	require.NoError(t, k.Params.Set(ctx, params))
+
+	minConsFee, err := sdk.ParseDecCoin("0.1stake")
+	require.NoError(t, err)
+	require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+
+	cdc := codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+	anteHandler := ante.NewMinFeeDecorator(cdc, k)
+	newTx := func(payer sdk.AccAddress, fees sdk.Coins, msgs ...sdk.Msg) sdk.Tx {
+		return testutils.NewMockFeeTx(
+			testutils.WithMockFeeTxPayer(payer),
+			testutils.WithMockFeeTxFees(fees),
+			testutils.WithMockFeeTxGas(1000),
+			testutils.WithMockFeeTxMsgs(msgs...),
+		)
+	}
+	withdrawMsg := rewardsTypes.NewMsgWithdrawRewardsByLimit(payerAddr, 1)
+
+	t.Run("OK: fee covering tx does not consume the budget", func(t *testing.T) {
+		_, err := anteHandler.AnteHandle(ctx, newTx(payerAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), withdrawMsg), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+		assert.EqualValues(t, 2, k.GetRemainingFreeTxs(ctx, payerAddr))
+	})
+
+	t.Run("OK: fee-free txs within the budget", func(t *testing.T) {
+		for i := 1; i >= 0; i-- {
+			_, err := anteHandler.AnteHandle(ctx, newTx(payerAddr, sdk.NewCoins(), withdrawMsg), false, testutils.NoopAnteHandler)
+			require.NoError(t, err)
+			assert.EqualValues(t, i, k.GetRemainingFreeTxs(ctx, payerAddr))
+		}
+	})
+
+	t.Run("Fail: budget is exhausted", func(t *testing.T) {
+		_, err := anteHandler.AnteHandle(ctx, newTx(payerAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 99)), withdrawMsg), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+		assert.EqualValues(t, 0, k.GetRemainingFreeTxs(ctx, payerAddr))
+	})
+
+	t.Run("OK: fee check engages after the budget is exhausted", func(t *testing.T) {
+		_, err := anteHandler.AnteHandle(ctx, newTx(payerAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), withdrawMsg), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+	})
+
+	t.Run("OK: budget is tracked per fee payer", func(t *testing.T) {
+		_, err := anteHandler.AnteHandle(ctx, newTx(otherPayerAddr, sdk.NewCoins(), withdrawMsg), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+		assert.EqualValues(t, 1, k.GetRemainingFreeTxs(ctx, otherPayerAddr))
+	})
+
+	t.Run("Fail: flat fee charged tx is not fee-free", func(t *testing.T) {
+		require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+			ContractAddress: contractAddr.String(),
+			OwnerAddress:    payerAddr.String(),
+			RewardsAddress:  payerAddr.String(),
+		}))
+		require.NoError(t, k.FlatFees.Set(ctx, contractAddr, sdk.NewInt64Coin("stake", 50)))
+
+		executeMsg := &wasmTypes.MsgExecuteContract{
+			Sender:   otherPayerAddr.String(),
+			Contract: contractAddr.String(),
+		}
+		_, err := anteHandler.AnteHandle(ctx, newTx(otherPayerAddr, sdk.NewCoins(), executeMsg), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+		assert.EqualValues(t, 1, k.GetRemainingFreeTxs(ctx, otherPayerAddr))
+	})
+
+	t.Run("Fail: budget is disabled", func(t *testing.T) {
+		params := k.GetParams(ctx)
+		params.FreeTxBudget = 0
+		require.NoError(t, k.Params.Set(ctx, params))
+
+		_, err := anteHandler.AnteHandle(ctx, newTx(otherPayerAddr, sdk.NewCoins(), withdrawMsg), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+	})
+}
+
+func TestRewardsMinFeeAnteHandlerMultisigFeePayer(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	contractAddr := sdk.AccAddress("contractAddr________")
+
+	// 2-of-3 multisig account (the account address is derived from the multisig public key)
+	pubKeys := make([]cryptoTypes.PubKey, 3)
+	for i := range pubKeys {
+		pubKeys[i] = secp256k1.GenPrivKey().PubKey()
+	}
+	multisigAddr := sdk.AccAddress(kmultisig.NewLegacyAminoPubKey(2, pubKeys).Address())
+	memberAddr := sdk.AccAddress(pubKeys[0].Address())
+
+	// Min fee is 100stake (1000 gas * 0.1stake) + 50stake (contract flat fee)
+	params := k.GetParams(ctx)
+	params.FlatFeePayerMustSign = true
+	params.FreeTxBudget = 1
+	require.NoError(t, k.Params.Set(ctx, params))
+
+	minConsFee, err := sdk.ParseDecCoin("0.1stake")
+	require.NoError(t, err)
+	require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+
+	require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+		ContractAddress: contractAddr.String(),
+		OwnerAddress:    multisigAddr.String(),
+		RewardsAddress:  multisigAddr.String(),
+	}))
+	require.NoError(t, k.FlatFees.Set(ctx, contractAddr, sdk.NewInt64Coin("stake", 50)))
+
+	cdc := codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+	anteHandler := ante.NewMinFeeDecorator(cdc, k)
+	executeMsg := &wasmTypes.MsgExecuteContract{
+		Sender:   multisigAddr.String(),
+		Contract: contractAddr.String(),
+	}
+	withdrawMsg := rewardsTypes.NewMsgWithdrawRewardsByLimit(multisigAddr, 1)
+
+	// Fee payer is not set explicitly, so it is resolved from the tx signers
+	newTx := func(fees string, signer sdk.AccAddress, msgs ...sdk.Msg) sdk.Tx {
+		feeCoins, err := sdk.ParseCoinsNormalized(fees)
+		require.NoError(t, err)
+
+		return testutils.NewMockFeeTx(
+			testutils.WithMockFeeTxFees(feeCoins),
+			testutils.WithMockFeeTxGas(1000),
+			testutils.WithMockFeeTxSigners(signer),
+			testutils.WithMockFeeTxMsgs(msgs...),
+		)
+	}
+
+	t.Run("OK: multisig fee payer meets the min fee", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		_, err := anteHandler.AnteHandle(cacheCtx, newTx("150stake", multisigAddr, executeMsg), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+	})
+
+	t.Run("Fail: multisig fee payer doesn't meet the min fee", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		_, err := anteHandler.AnteHandle(cacheCtx, newTx("149stake", multisigAddr, executeMsg), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+	})
+
+	t.Run("Fail: multisig member key is not the flat fee msg signer", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		_, err := anteHandler.AnteHandle(cacheCtx, newTx("150stake", memberAddr, executeMsg), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrUnauthorized)
+	})
+
+	t.Run("OK: fee-free tx consumes the multisig account budget", func(t *testing.T) {
+		_, err := anteHandler.AnteHandle(ctx, newTx("", multisigAddr, withdrawMsg), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+		assert.EqualValues(t, 0, k.GetRemainingFreeTxs(ctx, multisigAddr))
+		assert.EqualValues(t, 1, k.GetRemainingFreeTxs(ctx, memberAddr))
+	})
+
+	t.Run("Fail: multisig account budget is exhausted", func(t *testing.T) {
+		_, err := anteHandler.AnteHandle(ctx, newTx("", multisigAddr, withdrawMsg), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+	})
+}
+
+func TestRewardsMinFeeAnteHandlerCheckTxMinFeeEvent(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	payerAddr := sdk.AccAddress("payerAddr___________")
+
+	// Min fee is 100stake (1000 gas * 0.1stake)
+	minConsFee, err := sdk.ParseDecCoin("0.1stake")
+	require.NoError(t, err)
+	require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+
+	setEventEnabled := func(enabled bool) {
+		params := k.GetParams(ctx)
+		params.CheckTxMinFeeEventEnabled = enabled
+		require.NoError(t, k.Params.Set(ctx, params))
+	}
+
+	tx := testutils.NewMockFeeTx(
+		testutils.WithMockFeeTxFees(sdk.NewCoins(sdk.NewInt64Coin("stake", 150))),
+		testutils.WithMockFeeTxGas(1000),
+		testutils.WithMockFeeTxPayer(payerAddr),
+	)
+	cdc := codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+	anteHandler := ante.NewMinFeeDecorator(cdc, k)
+
+	getEstimateEvent := func(ctx sdk.Context) *rewardsTypes.TxFeesEstimateEvent {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		_, err := anteHandler.AnteHandle(ctx, tx, false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+
+		for _, event := range ctx.EventManager().Events() {
+			msg, err := sdk.ParseTypedEvent(abci.Event(event))
+			require.NoError(t, err)
+			if e, ok := msg.(*rewardsTypes.TxFeesEstimateEvent); ok {
+				return e
+			}
+		}
+		return nil
+	}
+
+	t.Run("OK: disabled", func(t *testing.T) {
+		setEventEnabled(false)
+		assert.Nil(t, getEstimateEvent(ctx.WithIsCheckTx(true)))
+	})
+
+	t.Run("OK: enabled: CheckTx reports the min fee", func(t *testing.T) {
+		setEventEnabled(true)
+		estimateEvent := getEstimateEvent(ctx.WithIsCheckTx(true))
+		require.NotNil(t, estimateEvent)
+		assert.Equal(t, "100stake", sdk.Coins(estimateEvent.GasFees).String())
+		assert.Empty(t, estimateEvent.FlatFees)
+	})
+
+	t.Run("OK: enabled: ReCheckTx and DeliverTx are not reported", func(t *testing.T) {
+		setEventEnabled(true)
+		assert.Nil(t, getEstimateEvent(ctx.WithIsReCheckTx(true)))
+		assert.Nil(t, getEstimateEvent(ctx.WithIsCheckTx(false)))
+	})
+}
+
+// TestRewardsMinFeeAnteHandlerFeePromotion checks the fee promotion discount is applied to both the gas and flat fees
+// within the promotion height window only.
+func TestRewardsMinFeeAnteHandlerFeePromotion(t *testing.T) {
+	type testCase struct {
+		name             string
+		promotion        rewardsTypes.FeePromotion
+		height           int64
+		minFeeExpected   string // [sdk.Coins]
+		flatFeesExpected string // [sdk.Coins]
+	}
+
+	// Undiscounted min fee is 150stake (1000 gas * 0.15stake) + 100stake, 50uarch (contract flat fees)
+	testCases := []testCase{
+		{
+			name:             "No promotion",
+			height:           15,
+			minFeeExpected:   "250stake,50uarch",
+			flatFeesExpected: "100stake,50uarch",
+		},
+		{
+			name:             "Before the window",
+			promotion:        rewardsTypes.FeePromotion{Discount: 2000, StartHeight: 10, EndHeight: 20},
+			height:           9,
+			minFeeExpected:   "250stake,50uarch",
+			flatFeesExpected: "100stake,50uarch",
+		},
+		{
+			name:             "Window start: 20%",
+			promotion:        rewardsTypes.FeePromotion{Discount: 2000, StartHeight: 10, EndHeight: 20},
+			height:           10,
+			minFeeExpected:   "200stake,40uarch",
+			flatFeesExpected: "80stake,40uarch",
+		},
+		{
+			name:             "Window end: 50%",
+			promotion:        rewardsTypes.FeePromotion{Discount: 5000, StartHeight: 10, EndHeight: 20},
+			height:           20,
+			minFeeExpected:   "125stake,25uarch",
+			flatFeesExpected: "50stake,25uarch",
+		},
+		{
+			name:             "After the window",
+			promotion:        rewardsTypes.FeePromotion{Discount: 5000, StartHeight: 10, EndHeight: 20},
+			height:           21,
+			minFeeExpected:   "250stake,50uarch",
+			flatFeesExpected: "100stake,50uarch",
+		},
+		{
+			name:             "Single block window: 33.33% (flat fees rounded up)",
+			promotion:        rewardsTypes.FeePromotion{Discount: 3333, StartHeight: 15, EndHeight: 15},
+			height:           15,
+			minFeeExpected:   "167stake,34uarch",
+			flatFeesExpected: "67stake,34uarch",
+		},
+		{
+			name:             "99.99%: non-zero flat fees stay non-zero",
+			promotion:        rewardsTypes.FeePromotion{Discount: 9999, StartHeight: 10, EndHeight: 20},
+			height:           15,
+			minFeeExpected:   "1stake,1uarch",
+			flatFeesExpected: "1stake,1uarch",
+		},
+	}
+
+	senderAddr := sdk.AccAddress("senderAddr__________")
+	cdc := codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+	flatFees := []sdk.Coin{sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("uarch", 50)}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx, _ := testutils.RewardsKeeper(t)
+			ctx = ctx.WithBlockHeight(tc.height)
+
+			params := k.GetParams(ctx)
+			params.FeePromotion = tc.promotion
+			require.NoError(t, params.Validate())
+			require.NoError(t, k.Params.Set(ctx, params))
+
+			minConsFee, err := sdk.ParseDecCoin("0.15stake")
+			require.NoError(t, err)
+			require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+
+			var msgs []sdk.Msg
+			for i, flatFee := range flatFees {
+				contractAddr := sdk.AccAddress(strings.Repeat(string(rune('a'+i)), 20))
+				require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+					ContractAddress: contractAddr.String(),
+					OwnerAddress:    senderAddr.String(),
+					RewardsAddress:  senderAddr.String(),
+				}))
+				require.NoError(t, k.FlatFees.Set(ctx, contractAddr, flatFee))
+				msgs = append(msgs, &wasmTypes.MsgExecuteContract{Sender: senderAddr.String(), Contract: contractAddr.String()})
+			}
+
+			anteHandler := ante.NewMinFeeDecorator(cdc, k)
+			newTx := func(fees sdk.Coins) sdk.Tx {
+				return testutils.NewMockFeeTx(
+					testutils.WithMockFeeTxFees(fees),
+					testutils.WithMockFeeTxGas(1000),
+					testutils.WithMockFeeTxMsgs(msgs...),
+				)
+			}
+
+			minFeeExpected, err := sdk.ParseCoinsNormalized(tc.minFeeExpected)
+			require.NoError(t, err)
+
+			// A unit less of any denom is rejected with the discounted min fee recommended
+			for _, fee := range minFeeExpected {
+				cacheCtx, _ := ctx.CacheContext()
+				_, err := anteHandler.AnteHandle(cacheCtx, newTx(minFeeExpected.Sub(sdk.NewInt64Coin(fee.Denom, 1))), false, testutils.NoopAnteHandler)
+				require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+
+				var feeErr *rewardsTypes.InsufficientFeeError
+				if errors.As(err, &feeErr) {
+					assert.Equal(t, tc.minFeeExpected, sdk.Coins(feeErr.RecommendedFees).String())
+				}
+			}
+
+			// Contracts are charged the discounted flat fees
+			newCtx, err := anteHandler.AnteHandle(ctx, newTx(minFeeExpected), false, testutils.NoopAnteHandler)
+			require.NoError(t, err)
+
+			txFlatFees, found := rewardsTypes.GetTxFlatFees(newCtx)
+			require.True(t, found)
+			assert.Equal(t, tc.flatFeesExpected, txFlatFees.String())
+		})
+	}
+}
+
+func TestRewardsMinFeeAnteHandlerCheckTxLocalMinGasPrice(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+
+	// Consensus min fee is 100stake (1000 gas * 0.1stake)
+	minConsFee, err := sdk.ParseDecCoin("0.1stake")
+	require.NoError(t, err)
+	require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+
+	setLocalMinGasPriceEnabled := func(enabled bool) {
+		params := k.GetParams(ctx)
+		params.CheckTxLocalMinGasPriceEnabled = enabled
+		require.NoError(t, k.Params.Set(ctx, params))
+	}
+
+	cdc := codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+	anteHandler := ante.NewMinFeeDecorator(cdc, k)
+	newTx := func(txFees int64) sdk.Tx {
+		return testutils.NewMockFeeTx(
+			testutils.WithMockFeeTxFees(sdk.NewCoins(sdk.NewInt64Coin("stake", txFees))),
+			testutils.WithMockFeeTxGas(1000),
+		)
+	}
+	// Local min fee is 10stake (1000 gas * 0.01stake, lower than the consensus one), the node config is set for both modes
+	localMinGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdkMath.LegacyNewDecWithPrec(1, 2)))
+	checkTxCtx := ctx.WithIsCheckTx(true).WithMinGasPrices(localMinGasPrices)
+	deliverTxCtx := ctx.WithIsCheckTx(false).WithMinGasPrices(localMinGasPrices)
+
+	t.Run("Fail: disabled: CheckTx uses the consensus min gas price", func(t *testing.T) {
+		setLocalMinGasPriceEnabled(false)
+
+		_, err := anteHandler.AnteHandle(checkTxCtx, newTx(20), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+	})
+
+	t.Run("Fail: enabled: CheckTx ignores the lower local min gas price", func(t *testing.T) {
+		setLocalMinGasPriceEnabled(true)
+
+		_, err := anteHandler.AnteHandle(checkTxCtx, newTx(20), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+
+		_, err = anteHandler.AnteHandle(checkTxCtx, newTx(100), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+	})
+
+	t.Run("Fail: enabled: DeliverTx enforces the consensus min gas price", func(t *testing.T) {
+		_, err := anteHandler.AnteHandle(deliverTxCtx, newTx(20), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+
+		_, err = anteHandler.AnteHandle(deliverTxCtx, newTx(100), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+	})
+
+	t.Run("Fail: enabled: CheckTx uses the higher local min gas price", func(t *testing.T) {
+		highLocalMinGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdkMath.LegacyNewDecWithPrec(2, 1)))
+
+		_, err := anteHandler.AnteHandle(checkTxCtx.WithMinGasPrices(highLocalMinGasPrices), newTx(100), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+
+		_, err = anteHandler.AnteHandle(checkTxCtx.WithMinGasPrices(highLocalMinGasPrices), newTx(200), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+	})
+
+	t.Run("OK: enabled: DeliverTx ignores the higher local min gas price", func(t *testing.T) {
+		highLocalMinGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdkMath.LegacyNewDecWithPrec(2, 1)))
+
+		_, err := anteHandler.AnteHandle(deliverTxCtx.WithMinGasPrices(highLocalMinGasPrices), newTx(100), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+	})
+
+	t.Run("Fail: enabled: local min gas price is not set for the consensus denom", func(t *testing.T) {
+		otherDenomMinGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("uarch", sdkMath.LegacyNewDecWithPrec(1, 2)))
+
+		_, err := anteHandler.AnteHandle(checkTxCtx.WithMinGasPrices(otherDenomMinGasPrices), newTx(20), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+
+		_, err = anteHandler.AnteHandle(checkTxCtx.WithMinGasPrices(otherDenomMinGasPrices), newTx(100), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+	})
+}
@@ -1,12 +1,37 @@
 package cli
 
-import "github.com/spf13/cobra"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/archway-network/archway/pkg"
+	"github.com/archway-network/archway/x/rewards/types"
+)
 
 const (
 	flagOwnerAddress   = "owner-address"
 	flagRewardsAddress = "rewards-address"
 	flagRecordsLimit   = "records-limit"
 	flagRecordIDs      = "record-ids"
+	flagDenoms         = "denoms"
+
+	flagFlatFeeExemptCallers = "flat-fee-exempt-callers"
+	flagFlatFeeDenoms        = "flat-fee-accepted-denoms"
+	flagRewardsSplits        = "rewards-splits"
+	flagRewardsSweepAddress  = "rewards-sweep-address"
+	flagFlatFeeSchedule      = "schedule"
+	flagMigrateRecords       = "migrate-rewards-records"
+	flagFlatFeeMethod        = "method"
+	flagFlatFeeMultiplier    = "min-cons-fee-multiplier"
+	flagTxSize               = "tx-size"
+	flagGasLimit             = "gas-limit"
+	flagIBCUnwrapReceiver    = "ibc-unwrap-receiver"
+	flagIBCUnwrapTimeout     = "ibc-unwrap-timeout"
 )
 
 func addOwnerAddressFlag(cmd *cobra.Command) {
@@ -24,3 +49,128 @@ func addRecordsLimitFlag(cmd *cobra.Command) {
 func addRecordIDsFlag(cmd *cobra.Command) {
 	cmd.Flags().StringSlice(flagRecordIDs, []string{}, "Rewards record IDs to use (number of IDs can not be higher than the MaxWithdrawRecords module param")
 }
+
+func addDenomsFlag(cmd *cobra.Command) {
+	cmd.Flags().StringSlice(flagDenoms, []string{}, "Rewards denoms to withdraw (the rest of the records rewards stays pending), all denoms are withdrawn if not set")
+}
+
+func addIBCUnwrapFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagIBCUnwrapReceiver, "", "Receiver address on the source chain to send the withdrawn IBC voucher rewards back to, vouchers are kept if not set")
+	cmd.Flags().Uint64(flagIBCUnwrapTimeout, 0, "IBC unwrap transfer timeout in seconds (the IBC transfer default is used if not set)")
+}
+
+func addFlatFeeExemptCallersFlag(cmd *cobra.Command) {
+	cmd.Flags().StringSlice(flagFlatFeeExemptCallers, []string{}, "Caller addresses (bech 32) that are not charged the contract flat fee (replaces the existing list)")
+}
+
+func addFlatFeeAcceptedDenomsFlag(cmd *cobra.Command) {
+	cmd.Flags().StringSlice(flagFlatFeeDenoms, []string{}, "Denoms the contract flat fee could be paid in, must be accepted by the chain (replaces the existing list)")
+}
+
+func addMigrateRecordsFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool(flagMigrateRecords, false, "Re-point the outstanding contract rewards records to the new rewards address (if the rewards address is changed)")
+}
+
+func addRewardsSweepAddressFlag(cmd *cobra.Command) {
+	cmd.Flags().String(flagRewardsSweepAddress, "", "Address to send the outstanding contract rewards to (bech 32), records are kept if not set")
+}
+
+func addRewardsSplitsFlag(cmd *cobra.Command) {
+	cmd.Flags().StringSlice(flagRewardsSplits, []string{}, fmt.Sprintf("Rewards recipients in the {address}:{weight} format, weights must sum up to %d (replaces the existing list)", types.RewardsSplitWeightTotal))
+}
+
+// parseRewardsSplitsFlag parses the rewards splits flag value.
+func parseRewardsSplitsFlag(cmd *cobra.Command) ([]types.RewardsSplit, error) {
+	values, err := cmd.Flags().GetStringSlice(flagRewardsSplits)
+	if err != nil {
+		return nil, err
+	}
+
+	splits := make([]types.RewardsSplit, 0, len(values))
+	for i, value := range values {
+		addrRaw, weightRaw, found := strings.Cut(value, ":")
+		if !found {
+			return nil, fmt.Errorf("parsing %s flag [%d]: {address}:{weight} format expected", flagRewardsSplits, i)
+		}
+
+		addr, err := sdk.AccAddressFromBech32(addrRaw)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s flag [%d] address: %w", flagRewardsSplits, i, err)
+		}
+
+		weight, err := strconv.ParseUint(weightRaw, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s flag [%d] weight: %w", flagRewardsSplits, i, err)
+		}
+
+		splits = append(splits, types.RewardsSplit{
+			Address: addr.String(),
+			Weight:  weight,
+		})
+	}
+
+	return splits, nil
+}
+
+func addFlatFeeScheduleFlag(cmd *cobra.Command) {
+	cmd.Flags().String(flagFlatFeeSchedule, "", "Flat fee schedule in the {start-height}:{end-height}:{start-fee} format, the fee is linearly changed to the fee-amount (a constant fee if not set)")
+}
+
+func addFlatFeeMethodFlag(cmd *cobra.Command) {
+	cmd.Flags().String(flagFlatFeeMethod, "", "Execute msg method name (the top-level msg JSON key) to set the flat fee for, the contract-wide flat fee is set if not set")
+}
+
+func addFlatFeeMultiplierFlag(cmd *cobra.Command) {
+	cmd.Flags().String(flagFlatFeeMultiplier, "", "Minimum consensus fee multiplier to charge the flat fee relative to, the fee-amount is the minimum charged (an absolute fee if not set)")
+}
+
+// parseFlatFeeMultiplierFlag parses the flat fee min consensus fee multiplier flag value (nil if not set).
+func parseFlatFeeMultiplierFlag(cmd *cobra.Command) (math.LegacyDec, error) {
+	value, err := cmd.Flags().GetString(flagFlatFeeMultiplier)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	if value == "" {
+		return math.LegacyDec{}, nil
+	}
+
+	return pkg.ParseDecArg(flagFlatFeeMultiplier, value)
+}
+
+// parseFlatFeeScheduleFlag parses the flat fee schedule flag value (nil if not set).
+func parseFlatFeeScheduleFlag(cmd *cobra.Command, endFee sdk.Coin) (*types.FlatFeeSchedule, error) {
+	value, err := cmd.Flags().GetString(flagFlatFeeSchedule)
+	if err != nil {
+		return nil, err
+	}
+	if value == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(value, ":")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("parsing %s flag: {start-height}:{end-height}:{start-fee} format expected", flagFlatFeeSchedule)
+	}
+
+	startHeight, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s flag start height: %w", flagFlatFeeSchedule, err)
+	}
+
+	endHeight, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s flag end height: %w", flagFlatFeeSchedule, err)
+	}
+
+	startFee, err := sdk.ParseCoinNormalized(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s flag start fee: %w", flagFlatFeeSchedule, err)
+	}
+
+	return &types.FlatFeeSchedule{
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+		StartFee:    startFee,
+		EndFee:      endFee,
+	}, nil
+}
@@ -1,6 +1,9 @@
 package cli
 
 import (
+	"fmt"
+
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cobra"
@@ -20,13 +23,44 @@ func GetQueryCmd() *cobra.Command {
 	}
 	cmd.AddCommand(
 		getQueryParamsCmd(),
+		getQueryParamsMetadataCmd(),
+		getQueryRewardsRatiosCmd(),
+		getQueryDistributionConfigCmd(),
 		getQueryBlockRewardsTrackingCmd(),
+		getQueryBlockRewardsTrackingRangeCmd(),
 		getQueryContractMetadataCmd(),
+		getQueryContractRewardsEligibilityCmd(),
 		getQueryUndistributedPoolFundsCmd(),
+		getQueryTotalPendingRewardsCmd(),
 		getQueryEstimateTxFeesCmd(),
+		getQueryEstimateTxFeesForContractsCmd(),
+		getQueryTxFeeEstimateCmd(),
+		getQueryEstimateTxFeesForSimulatedGasCmd(),
+		getQueryFlatFeeBreakEvenCmd(),
+		getQueryMsgTypeFlatFeeCmd(),
+		getQueryWouldAcceptFeeCmd(),
+		getQueryTxFeeSplitCmd(),
+		getQueryTopContractsByRewardsCmd(),
 		getQueryOutstandingRewardsCmd(),
+		getQueryWithdrawMinBalanceCmd(),
 		getQueryRewardsRecordsCmd(),
+		getQueryRewardsRecordsByHeightRangeCmd(),
+		getQueryRewardsRecordByIDCmd(),
+		getQueryContractMetadataCountCmd(),
+		getQueryContractsByCodeIDCmd(),
+		getQueryMinConsensusFeeDebugCmd(),
+		getQueryProjectedMinConsensusFeeCmd(),
+		getQueryContractFlatFeeRevenueCmd(),
+		getQueryContractRewardsHistoryCmd(),
+		getQueryRewardsPoolSolvencyCmd(),
+		getQueryAcceptedFeeDenomsCmd(),
 		getQueryContractFlatFeeCmd(),
+		getQueryFlatFeeWithPendingChangeCmd(),
+		getQueryContractFlatFeesCmd(),
+		getQueryTxFeeDistributionCmd(),
+		getQueryBlockPoolInflowsCmd(),
+		getQueryBlockFlatFeeTxsCmd(),
+		getQueryReconcileRewardsCmd(),
 	)
 
 	return cmd
@@ -58,6 +92,110 @@ func getQueryParamsCmd() *cobra.Command {
 	return cmd
 }
 
+func getQueryParamsMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params-metadata",
+		Args:  cobra.NoArgs,
+		Short: "Query module parameters along with their validation constraints",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ParamsMetadata(cmd.Context(), &types.QueryParamsMetadataRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getQueryRewardsRatiosCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rewards-ratios",
+		Args:  cobra.NoArgs,
+		Short: "Query inflation rewards and tx fee rebate ratios",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RewardsRatios(cmd.Context(), &types.QueryRewardsRatiosRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getQueryDistributionConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distribution-config",
+		Args:  cobra.NoArgs,
+		Short: "Query all the params affecting the fees and rewards distribution",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DistributionConfig(cmd.Context(), &types.QueryDistributionConfigRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getQueryContractMetadataCountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-metadata-count",
+		Args:  cobra.NoArgs,
+		Short: "Query the number of contracts with metadata set",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ContractMetadataCount(cmd.Context(), &types.QueryContractMetadataCountRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func getQueryBlockRewardsTrackingCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "block-rewards-tracking",
@@ -84,6 +222,45 @@ func getQueryBlockRewardsTrackingCmd() *cobra.Command {
 	return cmd
 }
 
+func getQueryBlockRewardsTrackingRangeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-rewards-tracking-range [from-height] [to-height]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query rewards tracking data for the given range of block heights",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			fromHeight, err := pkg.ParseInt64Arg("from-height", args[0])
+			if err != nil {
+				return err
+			}
+
+			toHeight, err := pkg.ParseInt64Arg("to-height", args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BlockRewardsTrackingRange(cmd.Context(), &types.QueryBlockRewardsTrackingRangeRequest{
+				FromHeight: fromHeight,
+				ToHeight:   toHeight,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func getQueryContractMetadataCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "contract-metadata [contract-address]",
@@ -143,6 +320,32 @@ func getQueryUndistributedPoolFundsCmd() *cobra.Command {
 	return cmd
 }
 
+func getQueryTotalPendingRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-pending-rewards",
+		Args:  cobra.NoArgs,
+		Short: "Query the total rewards owed to dApps along with the undistributed rewards pool funds backing them",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TotalPendingRewards(cmd.Context(), &types.QueryTotalPendingRewardsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func getQueryEstimateTxFeesCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "estimate-fees [gas-limit] [contract-address]",
@@ -186,11 +389,11 @@ func getQueryEstimateTxFeesCmd() *cobra.Command {
 	return cmd
 }
 
-func getQueryOutstandingRewardsCmd() *cobra.Command {
+func getQueryEstimateTxFeesForSimulatedGasCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "outstanding-rewards [rewards-address]",
-		Args:  cobra.ExactArgs(1),
-		Short: "Query current credited rewards for a given address (the address set in contract(s) metadata rewards_address field)",
+		Use:   "estimate-fees-for-simulated-gas [simulated-gas] [gas-adjustment]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Query transaction fees estimation for the simulated gas and the gas limit adjusted by the gas adjustment factor (1.0 if not provided)",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -198,14 +401,25 @@ func getQueryOutstandingRewardsCmd() *cobra.Command {
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			rewardsAddr, err := pkg.ParseAccAddressArg("rewards-address", args[0])
+			simulatedGas, err := pkg.ParseUint64Arg("simulated-gas", args[0])
 			if err != nil {
 				return err
 			}
 
-			res, err := queryClient.OutstandingRewards(cmd.Context(), &types.QueryOutstandingRewardsRequest{
-				RewardsAddress: rewardsAddr.String(),
-			})
+			req := types.QueryEstimateTxFeesForSimulatedGasRequest{
+				SimulatedGas:  simulatedGas,
+				GasAdjustment: math.LegacyOneDec(),
+			}
+
+			if len(args) > 1 {
+				gasAdjustment, err := pkg.ParseDecArg("gas-adjustment", args[1])
+				if err != nil {
+					return err
+				}
+				req.GasAdjustment = gasAdjustment
+			}
+
+			res, err := queryClient.EstimateTxFeesForSimulatedGas(cmd.Context(), &req)
 			if err != nil {
 				return err
 			}
@@ -219,11 +433,11 @@ func getQueryOutstandingRewardsCmd() *cobra.Command {
 	return cmd
 }
 
-func getQueryRewardsRecordsCmd() *cobra.Command {
+func getQueryEstimateTxFeesForContractsCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "rewards-records [rewards-address]",
-		Args:  cobra.ExactArgs(1),
-		Short: "Query rewards records stored for a given address (the address set in contract(s) metadata rewards_address field) with pagination",
+		Use:   "estimate-fees-for-contracts [gas-limit] [contract-address...]",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Query transaction fees estimation for a given gas limit including the combined flat fees of the given contracts",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -231,20 +445,30 @@ func getQueryRewardsRecordsCmd() *cobra.Command {
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			rewardsAddr, err := pkg.ParseAccAddressArg("rewards-address", args[0])
+			gasLimit, err := pkg.ParseUint64Arg("gas-limit", args[0])
 			if err != nil {
 				return err
 			}
 
-			pageReq, err := pkg.ReadPageRequest(cmd.Flags())
+			txSize, err := pkg.GetUint64Flag(cmd, flagTxSize, true)
 			if err != nil {
 				return err
 			}
 
-			res, err := queryClient.RewardsRecords(cmd.Context(), &types.QueryRewardsRecordsRequest{
-				RewardsAddress: rewardsAddr.String(),
-				Pagination:     pageReq,
-			})
+			req := types.QueryEstimateTxFeesForContractsRequest{
+				GasLimit: gasLimit,
+				TxSize:   txSize,
+			}
+
+			for _, arg := range args[1:] {
+				contractAddr, err := pkg.ParseAccAddressArg("contract-address", arg)
+				if err != nil {
+					return err
+				}
+				req.ContractAddresses = append(req.ContractAddresses, contractAddr.String())
+			}
+
+			res, err := queryClient.EstimateTxFeesForContracts(cmd.Context(), &req)
 			if err != nil {
 				return err
 			}
@@ -254,16 +478,66 @@ func getQueryRewardsRecordsCmd() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
-	flags.AddPaginationFlagsToCmd(cmd, "rewards-records")
+	cmd.Flags().Uint64(flagTxSize, 0, "Encoded transaction size in bytes to estimate the tx size surcharge for")
 
 	return cmd
 }
 
-func getQueryContractFlatFeeCmd() *cobra.Command {
+func getQueryTxFeeEstimateCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "flat-fee [contract-address]",
-		Args:  cobra.ExactArgs(1),
-		Short: "Query contract flat-fee",
+		Use:   "tx-fee-estimate [gas-limit] [contract-address...]",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Query transaction fees estimation for a given gas limit and contracts in the versioned response shape",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			gasLimit, err := pkg.ParseUint64Arg("gas-limit", args[0])
+			if err != nil {
+				return err
+			}
+
+			txSize, err := pkg.GetUint64Flag(cmd, flagTxSize, true)
+			if err != nil {
+				return err
+			}
+
+			req := types.QueryTxFeeEstimateRequest{
+				GasLimit: gasLimit,
+				TxSize:   txSize,
+			}
+
+			for _, arg := range args[1:] {
+				contractAddr, err := pkg.ParseAccAddressArg("contract-address", arg)
+				if err != nil {
+					return err
+				}
+				req.ContractAddresses = append(req.ContractAddresses, contractAddr.String())
+			}
+
+			res, err := queryClient.TxFeeEstimate(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Uint64(flagTxSize, 0, "Encoded transaction size in bytes to estimate the tx size surcharge for")
+
+	return cmd
+}
+
+func getQueryFlatFeeBreakEvenCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "flat-fee-break-even [contract-address] [gas-limit]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query transaction fees estimation for a given contract and gas limit along with the share of the contract flat fee",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -276,14 +550,916 @@ func getQueryContractFlatFeeCmd() *cobra.Command {
 				return err
 			}
 
-			res, err := queryClient.FlatFee(cmd.Context(), &types.QueryFlatFeeRequest{
+			gasLimit, err := pkg.ParseUint64Arg("gas-limit", args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FlatFeeBreakEven(cmd.Context(), &types.QueryFlatFeeBreakEvenRequest{
 				ContractAddress: contractAddr.String(),
+				GasLimit:        gasLimit,
 			})
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(&res.FlatFeeAmount)
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getQueryWouldAcceptFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "would-accept-fee [gas-limit] [fee] [contract-address...]",
+		Args:  cobra.MinimumNArgs(2),
+		Short: "Query whether a transaction fee covers the minimum fee for a given gas limit and contracts along with the missing amount",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			gasLimit, err := pkg.ParseUint64Arg("gas-limit", args[0])
+			if err != nil {
+				return err
+			}
+
+			fee, err := pkg.ParseCoinsArg("fee", args[1])
+			if err != nil {
+				return err
+			}
+
+			req := types.QueryWouldAcceptFeeRequest{
+				GasLimit: gasLimit,
+				Fee:      fee,
+			}
+
+			for _, arg := range args[2:] {
+				contractAddr, err := pkg.ParseAccAddressArg("contract-address", arg)
+				if err != nil {
+					return err
+				}
+				req.ContractAddresses = append(req.ContractAddresses, contractAddr.String())
+			}
+
+			res, err := queryClient.WouldAcceptFee(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getQueryTxFeeSplitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tx-fee-split [gas-limit] [fee] [contract-address...]",
+		Args:  cobra.MinimumNArgs(2),
+		Short: "Query how a transaction fee is split between the minimum gas fees, contract flat fees and the surplus",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			gasLimit, err := pkg.ParseUint64Arg("gas-limit", args[0])
+			if err != nil {
+				return err
+			}
+
+			fee, err := pkg.ParseCoinsArg("fee", args[1])
+			if err != nil {
+				return err
+			}
+
+			req := types.QueryTxFeeSplitRequest{
+				GasLimit: gasLimit,
+				Fee:      fee,
+			}
+
+			for _, arg := range args[2:] {
+				contractAddr, err := pkg.ParseAccAddressArg("contract-address", arg)
+				if err != nil {
+					return err
+				}
+				req.ContractAddresses = append(req.ContractAddresses, contractAddr.String())
+			}
+
+			res, err := queryClient.TxFeeSplit(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getQueryContractRewardsEligibilityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-rewards-eligibility [contract-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query whether a contract is eligible for the dApp rewards along with the underlying conditions",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			contractAddr, err := pkg.ParseAccAddressArg("contract-address", args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ContractRewardsEligibility(cmd.Context(), &types.QueryContractRewardsEligibilityRequest{
+				ContractAddress: contractAddr.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getQueryMsgTypeFlatFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "msg-type-flat-fee [msg-type-url] [contract-address]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the contract flat fee charged for a msg type targeting the contract",
+		Long: "Query the contract flat fee charged for a msg type (e.g. /cosmwasm.wasm.v1.MsgExecuteContract) targeting the contract. " +
+			"If the execute msg method is set, the method flat fee is looked up first.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			contractAddr, err := pkg.ParseAccAddressArg("contract-address", args[1])
+			if err != nil {
+				return err
+			}
+
+			method, err := cmd.Flags().GetString(flagFlatFeeMethod)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.MsgTypeFlatFee(cmd.Context(), &types.QueryMsgTypeFlatFeeRequest{
+				MsgTypeUrl:      args[0],
+				ContractAddress: contractAddr.String(),
+				Method:          method,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(flagFlatFeeMethod, "", "Execute msg method name (the top-level msg JSON key) to look up the method flat fee for")
+
+	return cmd
+}
+
+func getQueryTopContractsByRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "top-contracts-by-rewards [window] [limit]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query contracts with the highest rewards distributed within a number of recent blocks",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			window, err := pkg.ParseUint64Arg("window", args[0])
+			if err != nil {
+				return err
+			}
+
+			limit, err := pkg.ParseUint64Arg("limit", args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TopContractsByRewards(cmd.Context(), &types.QueryTopContractsByRewardsRequest{
+				Window: window,
+				Limit:  limit,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getQueryOutstandingRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "outstanding-rewards [rewards-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query current credited rewards for a given address (the address set in contract(s) metadata rewards_address field)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			rewardsAddr, err := pkg.ParseAccAddressArg("rewards-address", args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.OutstandingRewards(cmd.Context(), &types.QueryOutstandingRewardsRequest{
+				RewardsAddress: rewardsAddr.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getQueryWithdrawMinBalanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-min-balance [rewards-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the min fee a rewards address must hold to withdraw all its rewards records",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			rewardsAddr, err := pkg.ParseAccAddressArg("rewards-address", args[0])
+			if err != nil {
+				return err
+			}
+
+			gasLimit, err := pkg.GetUint64Flag(cmd, flagGasLimit, true)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.WithdrawMinBalance(cmd.Context(), &types.QueryWithdrawMinBalanceRequest{
+				RewardsAddress: rewardsAddr.String(),
+				GasLimit:       gasLimit,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Uint64(flagGasLimit, 0, "Withdraw transaction gas limit (estimated by the withdrawn records number if not set)")
+
+	return cmd
+}
+
+func getQueryRewardsRecordsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rewards-records [rewards-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query rewards records stored for a given address (the address set in contract(s) metadata rewards_address field) with pagination",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			rewardsAddr, err := pkg.ParseAccAddressArg("rewards-address", args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := pkg.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RewardsRecords(cmd.Context(), &types.QueryRewardsRecordsRequest{
+				RewardsAddress: rewardsAddr.String(),
+				Pagination:     pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "rewards-records")
+
+	return cmd
+}
+
+func getQueryRewardsRecordsByHeightRangeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rewards-records-by-height-range [rewards-address] [from-height] [to-height]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Query rewards records stored for a given address created within the given range of block heights with pagination",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			rewardsAddr, err := pkg.ParseAccAddressArg("rewards-address", args[0])
+			if err != nil {
+				return err
+			}
+
+			fromHeight, err := pkg.ParseInt64Arg("from-height", args[1])
+			if err != nil {
+				return err
+			}
+
+			toHeight, err := pkg.ParseInt64Arg("to-height", args[2])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := pkg.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RewardsRecordsByAddressAndHeightRange(cmd.Context(), &types.QueryRewardsRecordsByAddressAndHeightRangeRequest{
+				RewardsAddress: rewardsAddr.String(),
+				FromHeight:     fromHeight,
+				ToHeight:       toHeight,
+				Pagination:     pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "rewards-records-by-height-range")
+
+	return cmd
+}
+
+func getQueryRewardsRecordByIDCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rewards-record [record-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a single rewards record by its ID",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			recordID, err := pkg.ParseUint64Arg("record-id", args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RewardsRecordByID(cmd.Context(), &types.QueryRewardsRecordByIDRequest{
+				Id: recordID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Record)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getQueryContractsByCodeIDCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contracts-by-code-id [code-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query addresses of contracts with metadata instantiated from a given code ID with pagination",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			codeID, err := pkg.ParseUint64Arg("code-id", args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := pkg.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ContractsByCodeID(cmd.Context(), &types.QueryContractsByCodeIDRequest{
+				CodeId:     codeID,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "contracts-by-code-id")
+
+	return cmd
+}
+
+func getQueryMinConsensusFeeDebugCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "min-consensus-fee-debug [height]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query minimum consensus fee calculation inputs and the recomputed fee for the given (or the current) block height",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var height int64
+			if len(args) > 0 {
+				height, err = pkg.ParseInt64Arg("height", args[0])
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.MinConsensusFeeDebug(cmd.Context(), &types.QueryMinConsensusFeeDebugRequest{
+				Height: height,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getQueryContractFlatFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "flat-fee [contract-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query contract flat-fee",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			contractAddr, err := pkg.ParseAccAddressArg("contract-address", args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FlatFee(cmd.Context(), &types.QueryFlatFeeRequest{
+				ContractAddress: contractAddr.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.FlatFeeAmount)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getQueryFlatFeeWithPendingChangeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "flat-fee-with-pending-change [contract-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query contract flat-fee along with its pending scheduled change",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			contractAddr, err := pkg.ParseAccAddressArg("contract-address", args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FlatFeeWithPendingChange(cmd.Context(), &types.QueryFlatFeeWithPendingChangeRequest{
+				ContractAddress: contractAddr.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getQueryContractFlatFeesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "flat-fees [contract-address...]",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Query flat-fees of multiple contracts",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			contractAddrs := make([]string, 0, len(args))
+			for _, arg := range args {
+				contractAddr, err := pkg.ParseAccAddressArg("contract-address", arg)
+				if err != nil {
+					return err
+				}
+				contractAddrs = append(contractAddrs, contractAddr.String())
+			}
+
+			res, err := queryClient.FlatFees(cmd.Context(), &types.QueryFlatFeesRequest{
+				ContractAddresses: contractAddrs,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getQueryProjectedMinConsensusFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projected-min-consensus-fee [window]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the best-effort projection of the minimum price of gas for the next block",
+		Long: fmt.Sprintf(`Query the best-effort projection of the minimum price of gas for the next block.
+The projection is extrapolated from the minimum consensus fee trend of the recent blocks (window, up to %d).
+The actual fee depends on the next block inflation rewards and could differ.`,
+			types.MaxMinConsensusFeeProjectionWindow,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			window, err := pkg.ParseUint64Arg("window", args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ProjectedMinConsensusFee(cmd.Context(), &types.QueryProjectedMinConsensusFeeRequest{
+				Window: window,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getQueryContractFlatFeeRevenueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-flat-fee-revenue [contract-address] [window]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the contract flat fees collected within a number of recent blocks",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			contractAddr, err := pkg.ParseAccAddressArg("contract-address", args[0])
+			if err != nil {
+				return err
+			}
+
+			window, err := pkg.ParseUint64Arg("window", args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ContractFlatFeeRevenue(cmd.Context(), &types.QueryContractFlatFeeRevenueRequest{
+				ContractAddress: contractAddr.String(),
+				Window:          window,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getQueryContractRewardsHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-rewards-history [contract-address] [from-height] [to-height]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Query the rewards and flat fees distributed for a contract within the given range of block heights with pagination (one row per block)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			contractAddr, err := pkg.ParseAccAddressArg("contract-address", args[0])
+			if err != nil {
+				return err
+			}
+
+			fromHeight, err := pkg.ParseInt64Arg("from-height", args[1])
+			if err != nil {
+				return err
+			}
+
+			toHeight, err := pkg.ParseInt64Arg("to-height", args[2])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := pkg.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ContractRewardsHistory(cmd.Context(), &types.QueryContractRewardsHistoryRequest{
+				ContractAddress: contractAddr.String(),
+				FromHeight:      fromHeight,
+				ToHeight:        toHeight,
+				Pagination:      pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "contract-rewards-history")
+
+	return cmd
+}
+
+func getQueryRewardsPoolSolvencyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rewards-pool-solvency",
+		Args:  cobra.NoArgs,
+		Short: "Check the rewards pool balance covers the rewards records pending withdrawal",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RewardsPoolSolvency(cmd.Context(), &types.QueryRewardsPoolSolvencyRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getQueryAcceptedFeeDenomsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accepted-fee-denoms",
+		Args:  cobra.NoArgs,
+		Short: "Query the tx fee denoms accepted with their current minimum gas prices",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AcceptedFeeDenoms(cmd.Context(), &types.QueryAcceptedFeeDenomsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getQueryTxFeeDistributionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tx-fee-distribution [height-or-tx-hash]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query how the transaction fees were distributed for a tx hash or for all the txs within a block",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := types.QueryTxFeeDistributionRequest{}
+			if height, err := pkg.ParseInt64Arg("height", args[0]); err == nil {
+				req.Height = height
+			} else {
+				req.TxHash = args[0]
+			}
+
+			res, err := queryClient.TxFeeDistribution(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getQueryBlockPoolInflowsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-pool-inflows [height]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the rewards pool inflows breakdown (fee rebates, inflation, flat fees) for the given block height",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			height, err := pkg.ParseInt64Arg("height", args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BlockPoolInflows(cmd.Context(), &types.QueryBlockPoolInflowsRequest{
+				Height: height,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getQueryBlockFlatFeeTxsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-flat-fee-txs [height]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the transactions which paid the contract flat fees within the given block height",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			height, err := pkg.ParseInt64Arg("height", args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BlockFlatFeeTxs(cmd.Context(), &types.QueryBlockFlatFeeTxsRequest{
+				Height: height,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getQueryReconcileRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reconcile-rewards",
+		Args:  cobra.NoArgs,
+		Short: "Compare the rewards pool balance against the pending rewards and report the discrepancies",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ReconcileRewards(cmd.Context(), &types.QueryReconcileRewardsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
 		},
 	}
 
@@ -6,7 +6,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 
 	"github.com/archway-network/archway/pkg"
@@ -26,6 +25,10 @@ func GetTxCmd() *cobra.Command {
 		getTxSetContractMetadataCmd(),
 		getTxWithdrawRewardsCmd(),
 		getTxSetFlatFeeCmd(),
+		getTxRemoveContractMetadataCmd(),
+		getTxPrepayFlatFeeCmd(),
+		getTxTransferContractOwnershipCmd(),
+		getTxResetLifetimeRewardsCmd(),
 	)
 
 	return cmd
@@ -37,8 +40,8 @@ func getTxSetContractMetadataCmd() *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		Short: "Create / modify contract metadata (contract rewards parameters)",
 		Long: fmt.Sprintf(`Create / modify contract metadata (contract rewards parameters).
-Use the %q and / or the %q flag to specify which metadata field to set / update.`,
-			flagOwnerAddress, flagRewardsAddress,
+Use the %q, %q, %q, %q and / or the %q flag to specify which metadata field to set / update.`,
+			flagOwnerAddress, flagRewardsAddress, flagFlatFeeExemptCallers, flagFlatFeeDenoms, flagRewardsSplits,
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -63,7 +66,31 @@ Use the %q and / or the %q flag to specify which metadata field to set / update.
 				return err
 			}
 
+			exemptCallers, err := cmd.Flags().GetStringSlice(flagFlatFeeExemptCallers)
+			if err != nil {
+				return err
+			}
+
+			flatFeeDenoms, err := cmd.Flags().GetStringSlice(flagFlatFeeDenoms)
+			if err != nil {
+				return err
+			}
+
+			rewardsSplits, err := parseRewardsSplitsFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			migrateRecords, err := cmd.Flags().GetBool(flagMigrateRecords)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgSetContractMetadata(senderAddr, contractAddress, ownerAddress, rewardsAddress)
+			msg.Metadata.FlatFeeExemptCallers = exemptCallers
+			msg.Metadata.FlatFeeAcceptedDenoms = flatFeeDenoms
+			msg.Metadata.RewardsSplits = rewardsSplits
+			msg.MigrateRewardsRecords = migrateRecords
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -72,6 +99,10 @@ Use the %q and / or the %q flag to specify which metadata field to set / update.
 	flags.AddTxFlagsToCmd(cmd)
 	addOwnerAddressFlag(cmd)
 	addRewardsAddressFlag(cmd)
+	addFlatFeeExemptCallersFlag(cmd)
+	addFlatFeeAcceptedDenomsFlag(cmd)
+	addRewardsSplitsFlag(cmd)
+	addMigrateRecordsFlag(cmd)
 
 	return cmd
 }
@@ -103,12 +134,33 @@ func getTxWithdrawRewardsCmd() *cobra.Command {
 				return fmt.Errorf("one of (%q, %q) flags must be set", flagRecordIDs, flagRecordsLimit)
 			}
 
-			var msg sdk.Msg
+			denoms, err := cmd.Flags().GetStringSlice(flagDenoms)
+			if err != nil {
+				return err
+			}
+
+			var msg *types.MsgWithdrawRewards
 			if recordsLimit > 0 {
 				msg = types.NewMsgWithdrawRewardsByLimit(senderAddr, recordsLimit)
 			} else {
 				msg = types.NewMsgWithdrawRewardsByIDs(senderAddr, recordIDs)
 			}
+			msg.Denoms = denoms
+
+			unwrapReceiver, err := cmd.Flags().GetString(flagIBCUnwrapReceiver)
+			if err != nil {
+				return err
+			}
+			if unwrapReceiver != "" {
+				unwrapTimeout, err := cmd.Flags().GetUint64(flagIBCUnwrapTimeout)
+				if err != nil {
+					return err
+				}
+				msg.IbcUnwrap = &types.MsgWithdrawRewards_IBCUnwrap{
+					Receiver:       unwrapReceiver,
+					TimeoutSeconds: unwrapTimeout,
+				}
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -116,6 +168,8 @@ func getTxWithdrawRewardsCmd() *cobra.Command {
 
 	addRecordsLimitFlag(cmd)
 	addRecordIDsFlag(cmd)
+	addDenomsFlag(cmd)
+	addIBCUnwrapFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -126,6 +180,12 @@ func getTxSetFlatFeeCmd() *cobra.Command {
 		Use:   "set-flat-fee [contract-address] [fee-amount]",
 		Args:  cobra.ExactArgs(2),
 		Short: "Set / modify contract flat fee",
+		Long: fmt.Sprintf(`Set / modify contract flat fee.
+Use the %q flag to linearly change the fee from the start fee to the fee-amount between the start and end heights.
+Use the %q flag to set the fee for a specific execute msg method (other methods are charged the contract-wide fee).
+Use the %q flag to charge the fee relative to the current minimum consensus fee (the fee-amount is the minimum charged).`,
+			flagFlatFeeSchedule, flagFlatFeeMethod, flagFlatFeeMultiplier,
+		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -144,7 +204,183 @@ func getTxSetFlatFeeCmd() *cobra.Command {
 				return err
 			}
 
+			schedule, err := parseFlatFeeScheduleFlag(cmd, deposit)
+			if err != nil {
+				return err
+			}
+
+			method, err := cmd.Flags().GetString(flagFlatFeeMethod)
+			if err != nil {
+				return err
+			}
+
+			multiplier, err := parseFlatFeeMultiplierFlag(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgFlatFee(senderAddr, contractAddress, deposit)
+			msg.Schedule = schedule
+			msg.Method = method
+			msg.MinConsFeeMultiplier = multiplier
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addFlatFeeScheduleFlag(cmd)
+	addFlatFeeMethodFlag(cmd)
+	addFlatFeeMultiplierFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getTxRemoveContractMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-contract-metadata [contract-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Remove contract metadata along with the contract flat fee",
+		Long: fmt.Sprintf(`Remove contract metadata along with the contract flat fee.
+Use the %q flag to send the outstanding contract rewards to the specified address.`,
+			flagRewardsSweepAddress,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			senderAddr := clientCtx.GetFromAddress()
+
+			contractAddress, err := pkg.ParseAccAddressArg("contract-address", args[0])
+			if err != nil {
+				return err
+			}
+
+			sweepAddress, err := pkg.ParseAccAddressFlag(cmd, flagRewardsSweepAddress, false)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveContractMetadata(senderAddr, contractAddress, sweepAddress)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addRewardsSweepAddressFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getTxPrepayFlatFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prepay-flat-fee [contract-address] [executions]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Prepay contract executions at the discounted flat fee",
+		Long: `Prepay contract executions at the discounted flat fee.
+Prepaid executions are not charged the contract flat fee until the credit is exhausted.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			senderAddr := clientCtx.GetFromAddress()
+
+			contractAddress, err := pkg.ParseAccAddressArg("contract-address", args[0])
+			if err != nil {
+				return err
+			}
+
+			executions, err := pkg.ParseUint64Arg("executions", args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPrepayFlatFee(senderAddr, contractAddress, executions)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getTxTransferContractOwnershipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-contract-ownership [contract-address] [new-owner-address]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Transfer contract metadata ownership and rewards address at once",
+		Long: fmt.Sprintf(`Transfer contract metadata ownership and rewards address at once.
+Use the %q flag to change the rewards address as well (kept if not set).`,
+			flagRewardsAddress,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			senderAddr := clientCtx.GetFromAddress()
+
+			contractAddress, err := pkg.ParseAccAddressArg("contract-address", args[0])
+			if err != nil {
+				return err
+			}
+
+			newOwnerAddress, err := pkg.ParseAccAddressArg("new-owner-address", args[1])
+			if err != nil {
+				return err
+			}
+
+			rewardsAddress, err := pkg.ParseAccAddressFlag(cmd, flagRewardsAddress, false)
+			if err != nil {
+				return err
+			}
+
+			migrateRecords, err := cmd.Flags().GetBool(flagMigrateRecords)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferContractOwnership(senderAddr, contractAddress, newOwnerAddress, rewardsAddress)
+			msg.MigrateRewardsRecords = migrateRecords
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	addRewardsAddressFlag(cmd)
+	addMigrateRecordsFlag(cmd)
+
+	return cmd
+}
+
+func getTxResetLifetimeRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset-lifetime-rewards [contract-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Reset the contract lifetime rewards counter (contract owner only)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			senderAddr := clientCtx.GetFromAddress()
+
+			contractAddress, err := pkg.ParseAccAddressArg("contract-address", args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgResetLifetimeRewards(senderAddr, contractAddress)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -24,9 +24,10 @@ type KeeperTestSuite struct {
 
 // withdrawTestRecordData is a helper struct to store RewardsRecord data for Withdraw tests.
 type withdrawTestRecordData struct {
-	RecordID    uint64         // expected recordID to be created
-	RewardsAddr sdk.AccAddress // rewards address
-	Rewards     sdk.Coins      // record rewards
+	RecordID     uint64         // expected recordID to be created
+	RewardsAddr  sdk.AccAddress // rewards address
+	Rewards      sdk.Coins      // record rewards
+	ContractAddr sdk.AccAddress // contract address the rewards were accrued for (optional)
 }
 
 func (s *KeeperTestSuite) SetupTest() {
@@ -63,6 +64,7 @@ func SetupWithdrawTest(k keeper.Keeper, ctx sdk.Context, testData []withdrawTest
 		_, err = k.CreateRewardsRecord(
 			ctx,
 			testRecord.RewardsAddr,
+			testRecord.ContractAddr,
 			testRecord.Rewards,
 			ctx.BlockHeight(), ctx.BlockTime(),
 		)
@@ -1,9 +1,13 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
+	"time"
 
+	"cosmossdk.io/collections"
 	math "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/archway-network/archway/dmap"
@@ -19,6 +23,9 @@ type (
 		Contracts          map[string]*contractRewardsDistributionState // contract rewards state [key: contract address]
 		RewardsTotal       sdk.Coins                                    // total rewards for the block (inflationary + txs rewards)
 		RewardsDistributed sdk.Coins                                    // total rewards distributed for the block
+		RemaindersReserve  sdk.Coins                                    // pool tokens reserved for the rewards remainders before the distribution
+		ScaledDenoms       map[string]struct{}                          // denoms the contract rewards are scaled down for (the pool can't cover them)
+		CallbacksGasLeft   uint64                                       // contract rewards callbacks gas budget left for the block
 	}
 
 	// contractRewardsDistributionState is used to gather gas usage and rewards for a contract.
@@ -31,16 +38,44 @@ type (
 
 		FeeRewards          sdk.Coins // fee rewards for this contract (for all txs)
 		InflationaryRewards sdk.Coin  // inflation rewards for this contract (for the block)
+		GasRebateMultiplier uint64    // tx fee rebate gas weight multiplier (basis points, 1.0x if not set)
+
+		ExactRewards sdk.DecCoins // untruncated inflation and fee rebate rewards for this contract
+
+		TxFeeRewards          map[uint64]sdk.Coins // fee rewards attributable to a transaction [key: txID]
+		TxInflationaryRewards map[uint64]sdk.Coin  // inflation rewards attributable to a transaction gas used [key: txID]
 	}
 )
 
 // AllocateBlockRewards creates rewards records for the given block height.
+// The allocation runs at most once per height: a repeated call (e.g. an upgrade handler bug running the EndBlocker
+// twice) is skipped preventing the rewards from being credited twice.
 func (k Keeper) AllocateBlockRewards(ctx sdk.Context, height int64) {
+	distributed, err := k.DistributedHeights.Has(ctx, uint64(height))
+	if err != nil {
+		panic(err)
+	}
+	if distributed {
+		k.Logger(ctx).Error("Block rewards are already distributed (skip)", "height", height)
+		return
+	}
+	if err := k.DistributedHeights.Set(ctx, uint64(height)); err != nil {
+		panic(err)
+	}
+
 	blockDistrState := k.estimateBlockGasUsage(ctx, height)
 	blockDistrState = k.estimateBlockRewards(ctx, blockDistrState)
+	k.trackContractTxRewards(ctx, blockDistrState)
+	k.applyRewardsDistributionHooks(ctx, blockDistrState)
 	k.createRewardsRecords(ctx, blockDistrState)
+	k.payoutFlatFees(ctx)
+	k.cleanupFlatFeeBlockCharges(ctx)
+	k.reconcileMigratedContracts(ctx, height)
 	k.cleanupRewardsPool(ctx, blockDistrState)
 	k.cleanupTracking(ctx, height)
+	k.pruneContractBlockRewards(ctx, ctx.BlockHeight())
+	k.pruneContractBlockFlatFees(ctx, ctx.BlockHeight())
+	k.pruneBlockPoolInflows(ctx, ctx.BlockHeight())
 }
 
 // estimateBlockGasUsage creates a new distribution state for the given block height.
@@ -76,9 +111,12 @@ func (k Keeper) estimateBlockGasUsage(ctx sdk.Context, height int64) *blockRewar
 			contractDistrState := blockDistrState.Contracts[contractOp.ContractAddress]
 			if contractDistrState == nil {
 				contractDistrState = &contractRewardsDistributionState{
-					ContractAddress:     contractOp.MustGetContractAddress(),
-					TxGasUsed:           make(map[uint64]uint64, 0),
-					InflationaryRewards: sdk.Coin{Amount: math.ZeroInt()}, // necessary to avoid nil pointer panic on Coins.Add call
+					ContractAddress:       contractOp.MustGetContractAddress(),
+					TxGasUsed:             make(map[uint64]uint64, 0),
+					TxFeeRewards:          make(map[uint64]sdk.Coins, 0),
+					TxInflationaryRewards: make(map[uint64]sdk.Coin, 0),
+					InflationaryRewards:   sdk.Coin{Amount: math.ZeroInt()}, // necessary to avoid nil pointer panic on Coins.Add call
+					ExactRewards:          sdk.NewDecCoins(),
 				}
 				// we only add it to the contract distribution state only if a metadata is found for the provided contract.
 				if metadata, err := k.ContractMetadata.Get(ctx, contractDistrState.ContractAddress); err == nil {
@@ -127,6 +165,9 @@ func (k Keeper) estimateBlockRewards(ctx sdk.Context, blockDistrState *blockRewa
 		}
 	}
 
+	// Estimate transactions gas weighted by contracts gas rebate multipliers (equals the tx gas if none is set)
+	txsWeightedGas := k.estimateTxsWeightedGas(ctx, blockDistrState)
+
 	// Estimate contract rewards
 	for _, key := range dmap.SortedKeys(blockDistrState.Contracts) {
 		contractDistrState := blockDistrState.Contracts[key]
@@ -135,11 +176,21 @@ func (k Keeper) estimateBlockRewards(ctx sdk.Context, blockDistrState *blockRewa
 			gasUsed := pkg.NewDecFromUint64(contractDistrState.BlockGasUsed)
 			rewardsShare := gasUsed.Quo(pkg.NewDecFromUint64(blockRewards.MaxGas))
 
-			inflationRewards := sdk.NewCoin(
+			inflationRewards := sdk.NewDecCoinFromDec(
 				blockRewards.InflationRewards.Denom,
-				math.LegacyNewDecFromInt(blockRewards.InflationRewards.Amount).Mul(rewardsShare).TruncateInt(),
+				math.LegacyNewDecFromInt(blockRewards.InflationRewards.Amount).Mul(rewardsShare),
 			)
-			contractDistrState.InflationaryRewards = inflationRewards
+			contractDistrState.InflationaryRewards = sdk.NewCoin(inflationRewards.Denom, inflationRewards.Amount.TruncateInt())
+			contractDistrState.ExactRewards = contractDistrState.ExactRewards.Add(inflationRewards)
+
+			// Attribute the inflation rewards to transactions by the gas used within them
+			for _, txID := range dmap.SortedKeys(contractDistrState.TxGasUsed) {
+				txRewardsShare := pkg.NewDecFromUint64(contractDistrState.TxGasUsed[txID]).Quo(pkg.NewDecFromUint64(blockRewards.MaxGas))
+				contractDistrState.TxInflationaryRewards[txID] = sdk.NewCoin(
+					blockRewards.InflationRewards.Denom,
+					math.LegacyNewDecFromInt(blockRewards.InflationRewards.Amount).Mul(txRewardsShare).TruncateInt(),
+				)
+			}
 		}
 
 		// Estimate contract tx fee rebate rewards (sum of all transactions involved)
@@ -150,15 +201,17 @@ func (k Keeper) estimateBlockRewards(ctx sdk.Context, blockDistrState *blockRewa
 				continue
 			}
 
-			gasTotal := pkg.NewDecFromUint64(blockDistrState.Txs[txID])
-			rewardsShare := pkg.NewDecFromUint64(gasUsed).Quo(gasTotal)
+			rewardsShare := contractDistrState.weightedGas(gasUsed).Quo(txsWeightedGas[txID])
 
 			for _, feeCoin := range txFees {
-				feeRewards := sdk.NewCoin(
+				feeRewards := sdk.NewDecCoinFromDec(
 					feeCoin.Denom,
-					math.LegacyNewDecFromInt(feeCoin.Amount).Mul(rewardsShare).TruncateInt(),
+					math.LegacyNewDecFromInt(feeCoin.Amount).Mul(rewardsShare),
 				)
-				contractDistrState.FeeRewards = contractDistrState.FeeRewards.Add(feeRewards)
+				feeRewardsCoin := sdk.NewCoin(feeRewards.Denom, feeRewards.Amount.TruncateInt())
+				contractDistrState.FeeRewards = contractDistrState.FeeRewards.Add(feeRewardsCoin)
+				contractDistrState.TxFeeRewards[txID] = contractDistrState.TxFeeRewards[txID].Add(feeRewardsCoin)
+				contractDistrState.ExactRewards = contractDistrState.ExactRewards.Add(feeRewards)
 			}
 		}
 	}
@@ -166,15 +219,126 @@ func (k Keeper) estimateBlockRewards(ctx sdk.Context, blockDistrState *blockRewa
 	return blockDistrState
 }
 
+// estimateTxsWeightedGas sets contracts gas rebate multipliers (clamped by the MaxGasRebateMultiplier param)
+// and returns the transactions gas where contracts operations gas is weighted by their multipliers.
+// A contract with a higher multiplier gets a larger share of the tx fee rebate rewards at the expense of
+// other contracts of the same transaction (the tx rewards total is not changed).
+func (k Keeper) estimateTxsWeightedGas(ctx sdk.Context, blockDistrState *blockRewardsDistributionState) map[uint64]math.LegacyDec {
+	txsWeightedGas := make(map[uint64]math.LegacyDec, len(blockDistrState.Txs))
+	for _, txID := range dmap.SortedKeys(blockDistrState.Txs) {
+		txsWeightedGas[txID] = pkg.NewDecFromUint64(blockDistrState.Txs[txID])
+	}
+
+	maxMultiplier := k.MaxGasRebateMultiplier(ctx)
+	for _, key := range dmap.SortedKeys(blockDistrState.Contracts) {
+		contractDistrState := blockDistrState.Contracts[key]
+		contractDistrState.GasRebateMultiplier = types.GasRebateMultiplierBase
+		if contractDistrState.Metadata != nil {
+			contractDistrState.GasRebateMultiplier = contractDistrState.Metadata.EffectiveGasRebateMultiplier(maxMultiplier)
+		}
+		if contractDistrState.GasRebateMultiplier == types.GasRebateMultiplierBase {
+			continue
+		}
+
+		for _, txID := range dmap.SortedKeys(contractDistrState.TxGasUsed) {
+			gasUsed := contractDistrState.TxGasUsed[txID]
+			extraGas := contractDistrState.weightedGas(gasUsed).Sub(pkg.NewDecFromUint64(gasUsed))
+			txsWeightedGas[txID] = txsWeightedGas[txID].Add(extraGas)
+		}
+	}
+
+	return txsWeightedGas
+}
+
+// weightedGas returns the given gas weighted by the contract gas rebate multiplier.
+func (s *contractRewardsDistributionState) weightedGas(gas uint64) math.LegacyDec {
+	weightedGas := pkg.NewDecFromUint64(gas)
+	if s.GasRebateMultiplier == types.GasRebateMultiplierBase {
+		return weightedGas
+	}
+
+	return weightedGas.MulInt(math.NewIntFromUint64(s.GasRebateMultiplier)).QuoInt(math.NewIntFromUint64(types.GasRebateMultiplierBase))
+}
+
+// trackContractTxRewards stores the contract rewards attributable to every transaction of the block.
+// Contracts not eligible for the distribution (no metadata or rewards recipients) earn nothing, so they are skipped.
+// Rewards are estimated before the block level adjustments (hooks deductions, MaxContractBlockRewards cap, pool
+// shortage scaling and rewards remainders carry-over) and are truncated per transaction, so they might not sum up to the distributed ones.
+func (k Keeper) trackContractTxRewards(ctx sdk.Context, blockDistrState *blockRewardsDistributionState) {
+	for _, key := range dmap.SortedKeys(blockDistrState.Contracts) {
+		contractDistrState := blockDistrState.Contracts[key]
+		if contractDistrState.Metadata == nil || !contractDistrState.Metadata.HasRewardsRecipients() {
+			continue
+		}
+
+		for _, txID := range dmap.SortedKeys(contractDistrState.TxGasUsed) {
+			inflationRewards := sdk.NewCoins()
+			if coin, found := contractDistrState.TxInflationaryRewards[txID]; found {
+				inflationRewards = inflationRewards.Add(coin)
+			}
+			feeRewards := contractDistrState.TxFeeRewards[txID]
+			if inflationRewards.IsZero() && feeRewards.IsZero() {
+				continue
+			}
+
+			txRewards := types.ContractTxRewards{
+				TxId:             txID,
+				Height:           blockDistrState.Height,
+				ContractAddress:  contractDistrState.ContractAddress.String(),
+				GasUsed:          contractDistrState.TxGasUsed[txID],
+				FeeRewards:       feeRewards,
+				InflationRewards: inflationRewards,
+			}
+			key := collections.Join3(uint64(blockDistrState.Height), txID, contractDistrState.ContractAddress.Bytes())
+			if err := k.ContractTxRewards.Set(ctx, key, txRewards); err != nil {
+				panic(fmt.Errorf("failed to track contract tx rewards (%s, %d): %w", contractDistrState.ContractAddress, txID, err))
+			}
+		}
+	}
+}
+
+// applyRewardsDistributionHooks runs the BeforeRewardsDistribution hooks with the block rewards total and reduces
+// the block rewards by the pool part deducted by them (limited by the total): the deducted tokens are transferred to
+// the treasury pool and contract rewards are scaled down proportionally per denom (by the remaining / total ratio).
+func (k Keeper) applyRewardsDistributionHooks(ctx sdk.Context, blockDistrState *blockRewardsDistributionState) {
+	if blockDistrState.RewardsTotal.IsZero() {
+		return
+	}
+
+	deducted := blockDistrState.RewardsTotal.Min(k.Hooks().BeforeRewardsDistribution(ctx, blockDistrState.RewardsTotal))
+	if deducted.IsZero() {
+		return
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ContractRewardCollector, types.TreasuryCollector, deducted); err != nil {
+		panic(fmt.Errorf("failed to transfer hooks deducted rewards (%s) to %s: %w", deducted, types.TreasuryCollector, err))
+	}
+
+	scaleRatios := make(map[string]math.LegacyDec, len(deducted))
+	for _, coin := range deducted {
+		total := blockDistrState.RewardsTotal.AmountOf(coin.Denom)
+		scaleRatios[coin.Denom] = math.LegacyNewDecFromInt(total.Sub(coin.Amount)).QuoInt(total)
+	}
+	for _, contractDistrState := range blockDistrState.Contracts {
+		contractDistrState.ExactRewards = scaleContractRewards(contractDistrState.ExactRewards, scaleRatios)
+	}
+	blockDistrState.RewardsTotal = blockDistrState.RewardsTotal.Sub(deducted...)
+
+	k.Logger(ctx).Info("Block rewards are deducted by hooks before the distribution", "height", blockDistrState.Height, "deducted", deducted)
+}
+
 // createRewardsRecords creates types.RewardsRecord entries for a respective reward addresses if set (otherwise, skip)
 // and emit calculation events. An actual distribution (x/bank transfer) is performed later.
-// Leftovers caused by Int truncation or by a tx-less block (inflation rewards are tracked even if there were no transactions)
-// stay in the pool.
+// Sub-unit rewards caused by Int truncation are carried over to the next contract distribution (see carryOverRewardsRemainder).
+// Leftovers caused by a tx-less block (inflation rewards are tracked even if there were no transactions), by contracts
+// not eligible for the distribution or by contracts exceeding the MaxContractBlockRewards cap stay in the pool.
 func (k Keeper) createRewardsRecords(ctx sdk.Context, blockDistrState *blockRewardsDistributionState) {
 	calculationHeight, calculationTime := ctx.BlockHeight(), ctx.BlockTime()
+	blockDistrState.RemaindersReserve = k.rewardsRemaindersReserve(ctx)
+	blockDistrState.CallbacksGasLeft = k.RewardsCallbacksBlockGasLimit(ctx)
 
 	// Convert contract distribution states to a sorted slice preventing the consensus failure due to x/bank operations order.
-	// Filter out contracts without: rewards, metadata or rewardsAddress.
+	// Filter out contracts without: rewards, metadata or rewardsAddress / rewardsSplits.
 	// Emit calculation events for each contract.
 	contractStates := make([]*contractRewardsDistributionState, 0, len(blockDistrState.Contracts))
 	for _, key := range dmap.SortedKeys(blockDistrState.Contracts) {
@@ -190,7 +354,7 @@ func (k Keeper) createRewardsRecords(ctx sdk.Context, blockDistrState *blockRewa
 		)
 
 		// Filter out
-		if contractDistrState.FeeRewards.IsZero() && contractDistrState.InflationaryRewards.IsZero() {
+		if contractDistrState.ExactRewards.IsZero() {
 			k.Logger(ctx).Debug("No contract rewards to distribute (skip)", "contract", contractDistrState.ContractAddress)
 			continue
 		}
@@ -198,37 +362,158 @@ func (k Keeper) createRewardsRecords(ctx sdk.Context, blockDistrState *blockRewa
 			k.Logger(ctx).Debug("Contract metadata is not set (skip)", "contract", contractDistrState.ContractAddress)
 			continue
 		}
-		if !contractDistrState.Metadata.HasRewardsAddress() {
-			k.Logger(ctx).Debug("Contract rewards address is not set (skip)", "contract", contractDistrState.ContractAddress)
+		if !contractDistrState.Metadata.HasRewardsRecipients() {
+			k.Logger(ctx).Debug("Contract rewards address / splits are not set (skip)", "contract", contractDistrState.ContractAddress)
 			continue
 		}
 
 		contractStates = append(contractStates, contractDistrState)
 	}
 
+	// Cap contract rewards
+	maxRewards := k.MaxContractBlockRewards(ctx)
+	contractsExactRewards := make([]sdk.DecCoins, len(contractStates))
+	plannedRewards := sdk.NewDecCoins()
+	for i, contractDistrState := range contractStates {
+		contractsExactRewards[i] = capContractRewards(contractDistrState.ExactRewards, maxRewards)
+		if !contractsExactRewards[i].Equal(contractDistrState.ExactRewards) {
+			k.Logger(ctx).Debug("Contract rewards are capped", "contract", contractDistrState.ContractAddress, "rewards", contractDistrState.ExactRewards, "cap", maxRewards)
+		}
+		plannedRewards = plannedRewards.Add(contractsExactRewards[i]...)
+	}
+
+	// Scale contract rewards down if the pool can't cover the planned distribution
+	if scaleRatios := k.estimateRewardsScaleRatios(ctx, blockDistrState, plannedRewards); len(scaleRatios) > 0 {
+		blockDistrState.ScaledDenoms = make(map[string]struct{}, len(scaleRatios))
+		for denom := range scaleRatios {
+			blockDistrState.ScaledDenoms[denom] = struct{}{}
+		}
+		for i := range contractsExactRewards {
+			contractsExactRewards[i] = scaleContractRewards(contractsExactRewards[i], scaleRatios)
+		}
+	}
+
 	// Distribute
-	for _, contractDistrState := range contractStates {
-		// Transfer to the rewardsAddress
-		rewardsAddr := contractDistrState.Metadata.MustGetRewardsAddress()
-		rewards := sdk.NewCoins().
-			Add(contractDistrState.InflationaryRewards).
-			Add(contractDistrState.FeeRewards...)
-
-		// if the metadata says we distribute to the wallet then we do a bank send
-		if contractDistrState.Metadata.WithdrawToWallet {
-			err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ContractRewardCollector, rewardsAddr, rewards)
-			if err != nil {
-				panic(err)
-			}
-		} else { // otherwise we create a rewards record
-			_, err := k.CreateRewardsRecord(ctx, rewardsAddr, rewards, calculationHeight, calculationTime)
-			if err != nil {
-				panic(err)
+	for i, contractDistrState := range contractStates {
+		rewards := k.carryOverRewardsRemainder(ctx, contractDistrState.ContractAddress, contractsExactRewards[i])
+		if rewards.IsZero() {
+			continue
+		}
+
+		// Track the contract rewards stats (used to estimate the contract APR and to rank contracts)
+		k.trackContractRewardsStats(ctx, contractDistrState.ContractAddress, rewards, calculationHeight, calculationTime)
+
+		// Transfer the callback share to the rewards callback contract if set (the rest is distributed as usual)
+		callbackRewards, rewards := k.executeRewardsCallback(ctx, blockDistrState, contractDistrState.ContractAddress, contractDistrState.Metadata, rewards)
+		blockDistrState.RewardsDistributed = blockDistrState.RewardsDistributed.Add(callbackRewards...)
+		if rewards.IsZero() {
+			continue
+		}
+
+		// Split rewards between recipients if set, otherwise the rewardsAddress gets everything
+		if !contractDistrState.Metadata.HasRewardsSplits() {
+			k.distributeContractRewards(ctx, contractDistrState.ContractAddress, contractDistrState.Metadata, contractDistrState.Metadata.MustGetRewardsAddress(), rewards, calculationHeight, calculationTime)
+			blockDistrState.RewardsDistributed = blockDistrState.RewardsDistributed.Add(rewards...)
+			continue
+		}
+
+		shares := contractDistrState.Metadata.SplitRewards(rewards)
+		for i, split := range contractDistrState.Metadata.RewardsSplits {
+			if shares[i].IsZero() {
+				continue
 			}
+
+			k.distributeContractRewards(ctx, contractDistrState.ContractAddress, contractDistrState.Metadata, split.MustGetAddress(), shares[i], calculationHeight, calculationTime)
+			blockDistrState.RewardsDistributed = blockDistrState.RewardsDistributed.Add(shares[i]...)
 		}
+	}
+}
 
-		// Update the total rewards distributed counter
-		blockDistrState.RewardsDistributed = blockDistrState.RewardsDistributed.Add(rewards...)
+// capContractRewards limits the contract block rewards by the given cap per denom (denoms not listed are not limited).
+func capContractRewards(rewards sdk.DecCoins, maxRewards sdk.Coins) sdk.DecCoins {
+	if maxRewards.Empty() {
+		return rewards
+	}
+
+	capped := sdk.NewDecCoins()
+	for _, coin := range rewards {
+		if maxAmt := maxRewards.AmountOf(coin.Denom); maxAmt.IsPositive() && coin.Amount.GT(math.LegacyNewDecFromInt(maxAmt)) {
+			coin.Amount = math.LegacyNewDecFromInt(maxAmt)
+		}
+		capped = capped.Add(coin)
+	}
+
+	return capped
+}
+
+// estimateRewardsScaleRatios compares the planned contract rewards with the rewards pool tokens available for the
+// distribution and returns the scale down ratio for every denom the pool can't cover (empty if the pool covers it).
+// Available tokens are the block rewards tracked limited by the pool balance except the rewards remainders reserve
+// and the flat fees queued for the direct payout.
+// Emits the RewardsDistributionScaledEvent event if rewards are scaled.
+func (k Keeper) estimateRewardsScaleRatios(ctx sdk.Context, blockDistrState *blockRewardsDistributionState, plannedRewards sdk.DecCoins) map[string]math.LegacyDec {
+	if plannedRewards.IsZero() {
+		return nil
+	}
+
+	pool := k.UndistributedRewardsPool(ctx)
+	reserved := blockDistrState.RemaindersReserve.Add(k.getFlatFeePayoutsTotal(ctx)...)
+
+	scaleRatios := make(map[string]math.LegacyDec)
+	scaledPlanned, scaledAvailable := sdk.NewDecCoins(), sdk.NewCoins()
+	for _, coin := range plannedRewards {
+		available := math.MinInt(pool.AmountOf(coin.Denom).Sub(reserved.AmountOf(coin.Denom)), blockDistrState.RewardsTotal.AmountOf(coin.Denom))
+		if available.IsNegative() {
+			available = math.ZeroInt()
+		}
+		availableDec := math.LegacyNewDecFromInt(available)
+		if coin.Amount.LTE(availableDec) {
+			continue
+		}
+
+		scaleRatios[coin.Denom] = availableDec.Quo(coin.Amount)
+		scaledPlanned = scaledPlanned.Add(coin)
+		scaledAvailable = scaledAvailable.Add(sdk.NewCoin(coin.Denom, available))
+	}
+	if len(scaleRatios) == 0 {
+		return nil
+	}
+
+	k.Logger(ctx).Error("Rewards pool can't cover the planned distribution, contract rewards are scaled down", "height", blockDistrState.Height, "planned", scaledPlanned, "available", scaledAvailable)
+	types.EmitRewardsDistributionScaledEvent(ctx, blockDistrState.Height, scaledPlanned, scaledAvailable)
+
+	return scaleRatios
+}
+
+// scaleContractRewards multiplies the contract rewards by the given per denom ratios (denoms not listed are not scaled).
+func scaleContractRewards(rewards sdk.DecCoins, scaleRatios map[string]math.LegacyDec) sdk.DecCoins {
+	scaled := sdk.NewDecCoins()
+	for _, coin := range rewards {
+		if ratio, ok := scaleRatios[coin.Denom]; ok {
+			coin.Amount = coin.Amount.Mul(ratio)
+		}
+		scaled = scaled.Add(coin)
+	}
+
+	return scaled
+}
+
+// distributeContractRewards transfers rewards to the given recipient if the contract metadata says so, otherwise
+// a new rewards record is created.
+func (k Keeper) distributeContractRewards(ctx sdk.Context, contractAddr sdk.AccAddress, metadata *types.ContractMetadata, rewardsAddr sdk.AccAddress, rewards sdk.Coins, calculationHeight int64, calculationTime time.Time) {
+	// if the metadata says we distribute to the wallet then we do a bank send
+	if metadata.WithdrawToWallet {
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ContractRewardCollector, rewardsAddr, rewards)
+		if err != nil {
+			panic(err)
+		}
+		return
+	}
+
+	// otherwise we create a rewards record
+	_, err := k.CreateRewardsRecord(ctx, rewardsAddr, contractAddr, rewards, calculationHeight, calculationTime)
+	if err != nil {
+		panic(err)
 	}
 }
 
@@ -242,12 +527,38 @@ func (k Keeper) cleanupTracking(ctx sdk.Context, height int64) {
 	}
 
 	k.trackingKeeper.RemoveBlockTrackingInfo(ctx, heightToPrune)
-	k.DeleteBlockRewardsCascade(ctx, heightToPrune)
+	prunedRecords, prunedRewards := k.DeleteBlockRewardsCascade(ctx, heightToPrune)
+	// Nothing is left to distribute for the pruned height, the allocation guard is not needed anymore
+	if err := k.DistributedHeights.Remove(ctx, uint64(heightToPrune)); err != nil {
+		panic(err)
+	}
+
+	// Report the pruned objects number for the block and the total pruned rewards amount (per denom)
+	telemetry.ModuleSetGauge(types.ModuleName, float32(prunedRecords), types.MetricKeyPrunedRecords)
+	for _, coin := range prunedRewards {
+		// Amounts could exceed int64 (18 decimals denoms), the float conversion is lossy but never overflows
+		amount, err := math.LegacyNewDecFromInt(coin.Amount).Float64()
+		if err != nil {
+			k.Logger(ctx).Error("Converting the pruned rewards amount for telemetry", "rewards", coin, "error", err)
+			continue
+		}
+		telemetry.IncrCounter(float32(amount), types.ModuleName, types.MetricKeyPrunedRewards, coin.Denom)
+	}
 }
 
 // cleanupRewardsPool transfers all undistributed block rewards to the treasury pool.
+// Tokens reserved for the rewards remainders (the remainders total rounded up) stay in the pool: the reserve increase
+// is kept from the block rewards, the reserve decrease (remainders paid out) is covered by the previously kept tokens.
+// Denoms the contract rewards are scaled down for have no leftovers (the pool tokens available are distributed).
 func (k Keeper) cleanupRewardsPool(ctx sdk.Context, blockDistrState *blockRewardsDistributionState) {
-	rewardsLeftovers := blockDistrState.RewardsTotal.Sub(blockDistrState.RewardsDistributed...)
+	rewardsAvailable := blockDistrState.RewardsTotal.Add(blockDistrState.RemaindersReserve...)
+	rewardsKept := blockDistrState.RewardsDistributed.Add(k.rewardsRemaindersReserve(ctx)...)
+	rewardsLeftovers := sdk.NewCoins()
+	for _, coin := range rewardsAvailable.Sub(rewardsAvailable.Min(rewardsKept)...) {
+		if _, ok := blockDistrState.ScaledDenoms[coin.Denom]; !ok {
+			rewardsLeftovers = rewardsLeftovers.Add(coin)
+		}
+	}
 	if rewardsLeftovers.Empty() {
 		return
 	}
@@ -258,10 +569,23 @@ func (k Keeper) cleanupRewardsPool(ctx sdk.Context, blockDistrState *blockReward
 }
 
 // DeleteBlockRewardsCascade deletes all block rewards for a given height.
-// Function removes BlockRewards and TxRewards objects cleaning up their indexes.
-func (k Keeper) DeleteBlockRewardsCascade(ctx sdk.Context, height int64) {
+// Function removes BlockRewards, TxRewards, TxFeeDistribution and ContractTxRewards objects cleaning up their indexes.
+// Returns the number of removed objects and the total rewards amount tracked by the removed BlockRewards and TxRewards.
+func (k Keeper) DeleteBlockRewardsCascade(ctx sdk.Context, height int64) (prunedRecords uint64, prunedRewards sdk.Coins) {
+	prunedRewards = sdk.NewCoins()
+
 	// remove block rewards references
-	err := k.BlockRewards.Remove(ctx, uint64(height))
+	blockRewards, err := k.BlockRewards.Get(ctx, uint64(height))
+	switch {
+	case err == nil:
+		prunedRecords++
+		if blockRewards.HasRewards() {
+			prunedRewards = prunedRewards.Add(blockRewards.InflationRewards)
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		panic(fmt.Errorf("failed to delete block rewards for height %d: %w", height, err))
+	}
+	err = k.BlockRewards.Remove(ctx, uint64(height))
 	if err != nil {
 		panic(fmt.Errorf("failed to delete block rewards for height %d: %w", height, err))
 	}
@@ -278,9 +602,51 @@ func (k Keeper) DeleteBlockRewardsCascade(ctx sdk.Context, height int64) {
 	}
 	// remove them from state
 	for _, key := range keys {
-		err := k.TxRewards.Remove(ctx, key)
+		txRewards, err := k.TxRewards.Get(ctx, key)
+		if err != nil {
+			panic(fmt.Errorf("failed to delete tx rewards for height %d: %w", height, err))
+		}
+		prunedRecords++
+		prunedRewards = prunedRewards.Add(txRewards.FeeRewards...)
+
+		err = k.TxRewards.Remove(ctx, key)
 		if err != nil {
 			panic(fmt.Errorf("failed to delete tx rewards for height %d: %w", height, err))
 		}
 	}
+
+	// remove tx fee distribution references
+	iter, err = k.TxFeeDistributions.Indexes.Block.MatchExact(ctx, uint64(height))
+	if err != nil {
+		panic(fmt.Errorf("failed to delete tx fee distributions for height %d: %w", height, err))
+	}
+	keys, err = iter.PrimaryKeys()
+	if err != nil {
+		panic(fmt.Errorf("failed to delete tx fee distributions for height %d: %w", height, err))
+	}
+	for _, key := range keys {
+		err := k.TxFeeDistributions.Remove(ctx, key)
+		if err != nil {
+			panic(fmt.Errorf("failed to delete tx fee distributions for height %d: %w", height, err))
+		}
+		prunedRecords++
+	}
+
+	// remove contract tx rewards
+	txRewardsIter, err := k.ContractTxRewards.Iterate(ctx, collections.NewPrefixedTripleRange[uint64, uint64, []byte](uint64(height)))
+	if err != nil {
+		panic(fmt.Errorf("failed to delete contract tx rewards for height %d: %w", height, err))
+	}
+	txRewardsKeys, err := txRewardsIter.Keys()
+	if err != nil {
+		panic(fmt.Errorf("failed to delete contract tx rewards for height %d: %w", height, err))
+	}
+	for _, key := range txRewardsKeys {
+		if err := k.ContractTxRewards.Remove(ctx, key); err != nil {
+			panic(fmt.Errorf("failed to delete contract tx rewards for height %d: %w", height, err))
+		}
+		prunedRecords++
+	}
+
+	return prunedRecords, prunedRewards
 }
@@ -1,5 +1,31 @@
 package keeper_test
 
+import (
+	"context"
+	"errors"
+	"fmt"
+	stdMath "math"
+	"strings"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	math "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtTypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	mintTypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/hashicorp/go-metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	e2eTesting "github.com/archway-network/archway/e2e/testing"
+	"github.com/archway-network/archway/pkg/testutils"
+	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
+	trackingTypes "github.com/archway-network/archway/x/tracking/types"
+)
+
 // import (
 // 	"testing"
 
@@ -708,3 +734,939 @@ package keeper_test
 // 		})
 // 	}
 // }
+
+// TestRewardsKeeper_PruningMetrics checks the tracking data pruning telemetry reported by the EndBlocker.
+func TestRewardsKeeper_PruningMetrics(t *testing.T) {
+	// Enable telemetry with an in-memory sink
+	_, err := telemetry.New(telemetry.Config{Enabled: true})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = telemetry.New(telemetry.Config{Enabled: false})
+	})
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	metricsCfg := metrics.DefaultConfig("")
+	metricsCfg.EnableHostname = false
+	metricsCfg.EnableRuntimeMetrics = false
+	_, err = metrics.NewGlobal(metricsCfg, sink)
+	require.NoError(t, err)
+
+	getGauge := func(key string) (float32, bool) {
+		intervals := sink.Data()
+		gauge, found := intervals[len(intervals)-1].Gauges[key+";module="+rewardsTypes.ModuleName]
+		return gauge.Value, found
+	}
+	getCounter := func(key string) (float64, bool) {
+		intervals := sink.Data()
+		counter, found := intervals[len(intervals)-1].Counters[key]
+		return counter.Sum, found
+	}
+
+	k, ctx, _ := testutils.RewardsKeeper(t)
+
+	// Seed tracking data for the block to prune
+	const height = int64(5)
+	require.NoError(t, k.BlockRewards.Set(ctx, uint64(height), rewardsTypes.BlockRewards{
+		Height:           height,
+		InflationRewards: sdk.NewInt64Coin("stake", 100),
+		MaxGas:           1000,
+	}))
+	require.NoError(t, k.TxRewards.Set(ctx, 1, rewardsTypes.TxRewards{
+		TxId:       1,
+		Height:     height,
+		FeeRewards: sdk.NewCoins(sdk.NewInt64Coin("stake", 20), sdk.NewInt64Coin("uarch", 5)),
+	}))
+	require.NoError(t, k.TxRewards.Set(ctx, 2, rewardsTypes.TxRewards{
+		TxId:       2,
+		Height:     height,
+		FeeRewards: sdk.NewCoins(sdk.NewInt64Coin("stake", 30)),
+	}))
+
+	t.Run("block is not expired yet", func(t *testing.T) {
+		k.AllocateBlockRewards(ctx.WithBlockHeight(height+9), height+9)
+
+		prunedRecords, found := getGauge(rewardsTypes.MetricKeyPrunedRecords)
+		require.True(t, found)
+		require.EqualValues(t, 0, prunedRecords)
+		_, err := k.BlockRewards.Get(ctx, uint64(height))
+		require.NoError(t, err)
+	})
+
+	t.Run("block is pruned", func(t *testing.T) {
+		k.AllocateBlockRewards(ctx.WithBlockHeight(height+10), height+10)
+
+		prunedRecords, found := getGauge(rewardsTypes.MetricKeyPrunedRecords)
+		require.True(t, found)
+		require.EqualValues(t, 3, prunedRecords)
+
+		prunedStake, found := getCounter(rewardsTypes.ModuleName + "." + rewardsTypes.MetricKeyPrunedRewards + ".stake")
+		require.True(t, found)
+		require.EqualValues(t, 150, prunedStake)
+
+		prunedUarch, found := getCounter(rewardsTypes.ModuleName + "." + rewardsTypes.MetricKeyPrunedRewards + ".uarch")
+		require.True(t, found)
+		require.EqualValues(t, 5, prunedUarch)
+
+		_, err := k.BlockRewards.Get(ctx, uint64(height))
+		require.Error(t, err)
+	})
+
+	t.Run("nothing to prune", func(t *testing.T) {
+		k.AllocateBlockRewards(ctx.WithBlockHeight(height+11), height+11)
+
+		prunedRecords, found := getGauge(rewardsTypes.MetricKeyPrunedRecords)
+		require.True(t, found)
+		require.EqualValues(t, 0, prunedRecords)
+
+		prunedStake, _ := getCounter(rewardsTypes.ModuleName + "." + rewardsTypes.MetricKeyPrunedRewards + ".stake")
+		require.EqualValues(t, 150, prunedStake)
+	})
+
+	t.Run("amounts above int64 are reported", func(t *testing.T) {
+		const largeHeight = height + 2
+		largeAmount, ok := math.NewIntFromString("100000000000000000000") // 100 * 10^18
+		require.True(t, ok)
+		require.True(t, largeAmount.GT(math.NewInt(stdMath.MaxInt64)))
+
+		require.NoError(t, k.TxRewards.Set(ctx, 3, rewardsTypes.TxRewards{
+			TxId:       3,
+			Height:     largeHeight,
+			FeeRewards: sdk.NewCoins(sdk.NewCoin("aarch", largeAmount)),
+		}))
+
+		require.NotPanics(t, func() {
+			k.AllocateBlockRewards(ctx.WithBlockHeight(largeHeight+10), largeHeight+10)
+		})
+
+		prunedAarch, found := getCounter(rewardsTypes.ModuleName + "." + rewardsTypes.MetricKeyPrunedRewards + ".aarch")
+		require.True(t, found)
+		require.InEpsilon(t, 1e20, prunedAarch, 1e-6)
+	})
+}
+
+// TestRewardsKeeper_GasRebateMultiplier checks the tx fee rebate rewards split between contracts of a transaction
+// with contract gas rebate multipliers set.
+func TestRewardsKeeper_GasRebateMultiplier(t *testing.T) {
+	chain := e2eTesting.NewTestChain(t, 1)
+	keepers := chain.GetApp().Keepers
+	k := keepers.RewardsKeeper
+	ctx := chain.GetContext().WithBlockTime(chain.GetBlockTime())
+
+	contractAddrs := e2eTesting.GenContractAddresses(2)
+	for _, contractAddr := range contractAddrs {
+		rewardsAddr := testutils.AccAddress()
+		require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+			ContractAddress: contractAddr.String(),
+			OwnerAddress:    rewardsAddr.String(),
+			RewardsAddress:  rewardsAddr.String(),
+		}))
+	}
+
+	setMultipliers := func(multiplier, maxMultiplier uint64) {
+		meta := k.GetContractMetadata(ctx, contractAddrs[0])
+		meta.GasRebateMultiplier = multiplier
+		require.NoError(t, k.ContractMetadata.Set(ctx, contractAddrs[0], *meta))
+
+		params := k.GetParams(ctx)
+		params.MaxGasRebateMultiplier = maxMultiplier
+		require.NoError(t, k.Params.Set(ctx, params))
+	}
+
+	// Emulates a tx with both contracts consuming the same gas and distributes its 300stake fee rebate rewards.
+	// Next blocks are used to skip the current block rewards which are already distributed by the chain.
+	distributeTxRewards := func(height int64) (sdk.Coins, sdk.Coins) {
+		blockCtx := ctx.WithBlockHeight(height)
+
+		feeRewards := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300))
+		require.NoError(t, keepers.BankKeeper.MintCoins(blockCtx, mintTypes.ModuleName, feeRewards))
+		require.NoError(t, keepers.BankKeeper.SendCoinsFromModuleToModule(blockCtx, mintTypes.ModuleName, rewardsTypes.ContractRewardCollector, feeRewards))
+
+		keepers.TrackingKeeper.TrackNewTx(blockCtx)
+		for _, contractAddr := range contractAddrs {
+			keepers.TrackingKeeper.TrackNewContractOperation(blockCtx, contractAddr, trackingTypes.ContractOperation_CONTRACT_OPERATION_EXECUTION, 100, 0)
+		}
+		keepers.TrackingKeeper.FinalizeBlockTxTracking(blockCtx)
+		k.TrackFeeRebatesRewards(blockCtx, feeRewards)
+
+		k.AllocateBlockRewards(blockCtx, height)
+
+		rewards := make([]sdk.Coins, 0, len(contractAddrs))
+		for _, contractAddr := range contractAddrs {
+			blockRewards, err := k.ContractBlockRewards.Get(ctx, collections.Join(uint64(height), contractAddr.Bytes()))
+			require.NoError(t, err)
+			rewards = append(rewards, blockRewards.Rewards)
+		}
+
+		return rewards[0], rewards[1]
+	}
+
+	t.Run("OK: default multiplier", func(t *testing.T) {
+		setMultipliers(0, 20000)
+
+		rewards1, rewards2 := distributeTxRewards(ctx.BlockHeight() + 1)
+		require.Equal(t, "150stake", rewards1.String())
+		require.Equal(t, "150stake", rewards2.String())
+	})
+
+	t.Run("OK: custom multiplier", func(t *testing.T) {
+		setMultipliers(20000, 20000)
+
+		// Weighted gas: 200 and 100 (shares are truncated, the leftover goes to the treasury)
+		rewards1, rewards2 := distributeTxRewards(ctx.BlockHeight() + 2)
+		require.Equal(t, "200stake", rewards1.String())
+		require.Equal(t, "99stake", rewards2.String())
+	})
+
+	t.Run("OK: custom multiplier is clamped", func(t *testing.T) {
+		setMultipliers(40000, 15000)
+
+		// Weighted gas: 150 and 100
+		rewards1, rewards2 := distributeTxRewards(ctx.BlockHeight() + 3)
+		require.Equal(t, "180stake", rewards1.String())
+		require.Equal(t, "120stake", rewards2.String())
+	})
+
+	t.Run("OK: custom multipliers are disabled", func(t *testing.T) {
+		setMultipliers(20000, 0)
+
+		rewards1, rewards2 := distributeTxRewards(ctx.BlockHeight() + 4)
+		require.Equal(t, "150stake", rewards1.String())
+		require.Equal(t, "150stake", rewards2.String())
+	})
+}
+
+func TestRewardsKeeper_MaxContractBlockRewards(t *testing.T) {
+	chain := e2eTesting.NewTestChain(t, 1)
+	keepers := chain.GetApp().Keepers
+	k := keepers.RewardsKeeper
+	ctx := chain.GetContext().WithBlockTime(chain.GetBlockTime())
+
+	contractAddrs := e2eTesting.GenContractAddresses(2)
+	for _, contractAddr := range contractAddrs {
+		rewardsAddr := testutils.AccAddress()
+		require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+			ContractAddress: contractAddr.String(),
+			OwnerAddress:    rewardsAddr.String(),
+			RewardsAddress:  rewardsAddr.String(),
+		}))
+	}
+
+	setMaxRewards := func(maxRewards ...sdk.Coin) {
+		params := k.GetParams(ctx)
+		params.MaxContractBlockRewards = maxRewards
+		require.NoError(t, k.Params.Set(ctx, params))
+	}
+	getTreasuryBalance := func() math.Int {
+		return k.TreasuryPool(ctx).AmountOf(sdk.DefaultBondDenom)
+	}
+
+	// Emulates a tx where the first contract consumes 3 times more gas than the second one and distributes
+	// its 400stake fee rebate rewards (300stake and 100stake shares).
+	// Next blocks are used to skip the current block rewards which are already distributed by the chain.
+	distributeTxRewards := func(height int64) (sdk.Coins, sdk.Coins) {
+		blockCtx := ctx.WithBlockHeight(height)
+
+		feeRewards := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 400))
+		require.NoError(t, keepers.BankKeeper.MintCoins(blockCtx, mintTypes.ModuleName, feeRewards))
+		require.NoError(t, keepers.BankKeeper.SendCoinsFromModuleToModule(blockCtx, mintTypes.ModuleName, rewardsTypes.ContractRewardCollector, feeRewards))
+
+		keepers.TrackingKeeper.TrackNewTx(blockCtx)
+		keepers.TrackingKeeper.TrackNewContractOperation(blockCtx, contractAddrs[0], trackingTypes.ContractOperation_CONTRACT_OPERATION_EXECUTION, 300, 0)
+		keepers.TrackingKeeper.TrackNewContractOperation(blockCtx, contractAddrs[1], trackingTypes.ContractOperation_CONTRACT_OPERATION_EXECUTION, 100, 0)
+		keepers.TrackingKeeper.FinalizeBlockTxTracking(blockCtx)
+		k.TrackFeeRebatesRewards(blockCtx, feeRewards)
+
+		k.AllocateBlockRewards(blockCtx, height)
+
+		rewards := make([]sdk.Coins, 0, len(contractAddrs))
+		for _, contractAddr := range contractAddrs {
+			blockRewards, err := k.ContractBlockRewards.Get(ctx, collections.Join(uint64(height), contractAddr.Bytes()))
+			require.NoError(t, err)
+			rewards = append(rewards, blockRewards.Rewards)
+		}
+
+		return rewards[0], rewards[1]
+	}
+
+	t.Run("OK: no cap", func(t *testing.T) {
+		setMaxRewards()
+
+		treasuryBefore := getTreasuryBalance()
+		rewards1, rewards2 := distributeTxRewards(ctx.BlockHeight() + 1)
+		require.Equal(t, "300stake", rewards1.String())
+		require.Equal(t, "100stake", rewards2.String())
+		require.True(t, getTreasuryBalance().Equal(treasuryBefore))
+	})
+
+	t.Run("OK: contract hits the cap, the excess is returned to the treasury", func(t *testing.T) {
+		setMaxRewards(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200))
+
+		treasuryBefore := getTreasuryBalance()
+		rewards1, rewards2 := distributeTxRewards(ctx.BlockHeight() + 2)
+		require.Equal(t, "200stake", rewards1.String())
+		require.Equal(t, "100stake", rewards2.String())
+		require.Equal(t, math.NewInt(100), getTreasuryBalance().Sub(treasuryBefore))
+	})
+
+	t.Run("OK: cap in another denom doesn't limit the rewards", func(t *testing.T) {
+		setMaxRewards(sdk.NewInt64Coin("uarch", 1))
+
+		rewards1, rewards2 := distributeTxRewards(ctx.BlockHeight() + 3)
+		require.Equal(t, "300stake", rewards1.String())
+		require.Equal(t, "100stake", rewards2.String())
+	})
+}
+
+// TestRewardsKeeper_RewardsRemainders checks the sub-unit rewards carried over between distributions:
+// over many blocks, rewards distributed plus remainders carried must equal the contracts exact rewards share.
+func TestRewardsKeeper_RewardsRemainders(t *testing.T) {
+	chain := e2eTesting.NewTestChain(t, 1)
+	keepers := chain.GetApp().Keepers
+	k := keepers.RewardsKeeper
+	ctx := chain.GetContext().WithBlockTime(chain.GetBlockTime())
+
+	// Three contracts with equal gas usage share the tx fee rebate rewards: 100stake / 3 per block for each
+	contractAddrs := e2eTesting.GenContractAddresses(3)
+	for _, contractAddr := range contractAddrs {
+		rewardsAddr := testutils.AccAddress()
+		require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+			ContractAddress:  contractAddr.String(),
+			OwnerAddress:     rewardsAddr.String(),
+			RewardsAddress:   rewardsAddr.String(),
+			WithdrawToWallet: true,
+		}))
+		require.NoError(t, k.ContractCodeIDs.Set(ctx, contractAddr, 1))
+	}
+
+	const blocksNum = 50
+	feeRewards := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	startHeight := ctx.BlockHeight() + 1
+	treasuryBefore := keepers.BankKeeper.GetBalance(ctx, keepers.AccountKeeper.GetModuleAddress(rewardsTypes.TreasuryCollector), sdk.DefaultBondDenom)
+
+	distributed := make([]sdk.Coins, len(contractAddrs))
+	for height := startHeight; height < startHeight+blocksNum; height++ {
+		blockCtx := ctx.WithBlockHeight(height)
+
+		require.NoError(t, keepers.BankKeeper.MintCoins(blockCtx, mintTypes.ModuleName, feeRewards))
+		require.NoError(t, keepers.BankKeeper.SendCoinsFromModuleToModule(blockCtx, mintTypes.ModuleName, rewardsTypes.ContractRewardCollector, feeRewards))
+
+		keepers.TrackingKeeper.TrackNewTx(blockCtx)
+		for _, contractAddr := range contractAddrs {
+			keepers.TrackingKeeper.TrackNewContractOperation(blockCtx, contractAddr, trackingTypes.ContractOperation_CONTRACT_OPERATION_EXECUTION, 100, 0)
+		}
+		keepers.TrackingKeeper.FinalizeBlockTxTracking(blockCtx)
+		k.TrackFeeRebatesRewards(blockCtx, feeRewards)
+
+		k.AllocateBlockRewards(blockCtx, height)
+
+		for i, contractAddr := range contractAddrs {
+			blockRewards, err := k.ContractBlockRewards.Get(ctx, collections.Join(uint64(height), contractAddr.Bytes()))
+			if err == nil {
+				distributed[i] = distributed[i].Add(blockRewards.Rewards...)
+			}
+		}
+	}
+
+	// Every contract is credited its exact share: 50 * 100 * (100 / 300) ~ 1666.(6)stake, the fractional part is carried over
+	exactShare := math.LegacyNewDec(100).Mul(math.LegacyNewDec(100).QuoInt64(300)).MulInt64(blocksNum)
+	remaindersTotal := math.LegacyZeroDec()
+	for i, contractAddr := range contractAddrs {
+		remainder := k.GetRewardsRemainder(ctx, contractAddr)
+		require.Equal(t, "1666stake", distributed[i].String())
+		require.True(t, remainder.AmountOf(sdk.DefaultBondDenom).LT(math.LegacyOneDec()))
+		require.Equal(t, exactShare, math.LegacyNewDecFromInt(distributed[i].AmountOf(sdk.DefaultBondDenom)).Add(remainder.AmountOf(sdk.DefaultBondDenom)))
+
+		remaindersTotal = remaindersTotal.Add(remainder.AmountOf(sdk.DefaultBondDenom))
+	}
+	require.Equal(t, remaindersTotal, k.GetRewardsRemaindersTotal(ctx).AmountOf(sdk.DefaultBondDenom))
+
+	// Conservation: distributed + treasury + reserved remainders equal the total rewards
+	treasuryAfter := keepers.BankKeeper.GetBalance(ctx, keepers.AccountKeeper.GetModuleAddress(rewardsTypes.TreasuryCollector), sdk.DefaultBondDenom)
+	distributedTotal := sdk.NewCoins()
+	for _, coins := range distributed {
+		distributedTotal = distributedTotal.Add(coins...)
+	}
+	reserve := remaindersTotal.Ceil().TruncateInt()
+	require.Equal(t,
+		math.NewInt(blocksNum*100),
+		distributedTotal.AmountOf(sdk.DefaultBondDenom).Add(treasuryAfter.Amount.Sub(treasuryBefore.Amount)).Add(reserve),
+	)
+
+	t.Run("Metadata removal releases the reserve", func(t *testing.T) {
+		for _, contractAddr := range contractAddrs {
+			meta := k.GetContractMetadata(ctx, contractAddr)
+			_, err := k.RemoveContractMetadata(ctx, sdk.MustAccAddressFromBech32(meta.OwnerAddress), contractAddr, nil)
+			require.NoError(t, err)
+			require.True(t, k.GetRewardsRemainder(ctx, contractAddr).IsZero())
+		}
+		require.True(t, k.GetRewardsRemaindersTotal(ctx).IsZero())
+
+		treasuryReleased := keepers.BankKeeper.GetBalance(ctx, keepers.AccountKeeper.GetModuleAddress(rewardsTypes.TreasuryCollector), sdk.DefaultBondDenom)
+		require.Equal(t, reserve, treasuryReleased.Amount.Sub(treasuryAfter.Amount))
+	})
+}
+
+func TestRewardsKeeper_RewardsDistributionScaling(t *testing.T) {
+	chain := e2eTesting.NewTestChain(t, 1)
+	keepers := chain.GetApp().Keepers
+	k := keepers.RewardsKeeper
+	ctx := chain.GetContext().WithBlockTime(chain.GetBlockTime())
+
+	contractAddrs := e2eTesting.GenContractAddresses(2)
+	for _, contractAddr := range contractAddrs {
+		rewardsAddr := testutils.AccAddress()
+		require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+			ContractAddress: contractAddr.String(),
+			OwnerAddress:    rewardsAddr.String(),
+			RewardsAddress:  rewardsAddr.String(),
+		}))
+	}
+
+	getTreasuryBalance := func() math.Int {
+		return k.TreasuryPool(ctx).AmountOf(sdk.DefaultBondDenom)
+	}
+
+	// Emulates a tx where the first contract consumes 3 times more gas than the second one and distributes
+	// its 400stake fee rebate rewards (300stake and 100stake shares) with the given pool funds only (the pool is reset).
+	// Next blocks are used to skip the current block rewards which are already distributed by the chain.
+	distributeTxRewards := func(height int64, poolFunds int64) (sdk.Coins, sdk.Coins, *rewardsTypes.RewardsDistributionScaledEvent) {
+		blockCtx := ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+
+		poolInitial := k.UndistributedRewardsPool(blockCtx)
+		require.NoError(t, keepers.BankKeeper.SendCoinsFromModuleToModule(blockCtx, rewardsTypes.ContractRewardCollector, mintTypes.ModuleName, poolInitial))
+		poolCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, poolFunds))
+		require.NoError(t, keepers.BankKeeper.MintCoins(blockCtx, mintTypes.ModuleName, poolCoins))
+		require.NoError(t, keepers.BankKeeper.SendCoinsFromModuleToModule(blockCtx, mintTypes.ModuleName, rewardsTypes.ContractRewardCollector, poolCoins))
+
+		keepers.TrackingKeeper.TrackNewTx(blockCtx)
+		keepers.TrackingKeeper.TrackNewContractOperation(blockCtx, contractAddrs[0], trackingTypes.ContractOperation_CONTRACT_OPERATION_EXECUTION, 300, 0)
+		keepers.TrackingKeeper.TrackNewContractOperation(blockCtx, contractAddrs[1], trackingTypes.ContractOperation_CONTRACT_OPERATION_EXECUTION, 100, 0)
+		keepers.TrackingKeeper.FinalizeBlockTxTracking(blockCtx)
+		k.TrackFeeRebatesRewards(blockCtx, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 400)))
+
+		k.AllocateBlockRewards(blockCtx, height)
+
+		rewards := make([]sdk.Coins, 0, len(contractAddrs))
+		for _, contractAddr := range contractAddrs {
+			// Entry is not found if nothing is distributed for the contract
+			blockRewards, err := k.ContractBlockRewards.Get(ctx, collections.Join(uint64(height), contractAddr.Bytes()))
+			if !errors.Is(err, collections.ErrNotFound) {
+				require.NoError(t, err)
+			}
+			rewards = append(rewards, blockRewards.Rewards)
+		}
+
+		var scaledEvent *rewardsTypes.RewardsDistributionScaledEvent
+		for _, event := range blockCtx.EventManager().Events() {
+			msg, err := sdk.ParseTypedEvent(abci.Event(event))
+			if err != nil {
+				continue
+			}
+			if e, ok := msg.(*rewardsTypes.RewardsDistributionScaledEvent); ok {
+				scaledEvent = e
+			}
+		}
+
+		return rewards[0], rewards[1], scaledEvent
+	}
+
+	t.Run("OK: pool covers the distribution", func(t *testing.T) {
+		rewards1, rewards2, scaledEvent := distributeTxRewards(ctx.BlockHeight()+1, 400)
+		assert.Equal(t, "300stake", rewards1.String())
+		assert.Equal(t, "100stake", rewards2.String())
+		assert.Nil(t, scaledEvent)
+	})
+
+	t.Run("OK: insufficient pool, rewards are scaled down proportionally", func(t *testing.T) {
+		treasuryBefore := getTreasuryBalance()
+
+		rewards1, rewards2, scaledEvent := distributeTxRewards(ctx.BlockHeight()+2, 200)
+		assert.Equal(t, "150stake", rewards1.String())
+		assert.Equal(t, "50stake", rewards2.String())
+
+		require.NotNil(t, scaledEvent)
+		assert.Equal(t, ctx.BlockHeight()+2, scaledEvent.Height)
+		assert.Equal(t, "400.000000000000000000stake", sdk.DecCoins(scaledEvent.PlannedRewards).String())
+		assert.Equal(t, "200stake", sdk.Coins(scaledEvent.AvailableRewards).String())
+
+		// The pool doesn't go negative: the scaled denom has no leftovers sent to the treasury
+		assert.True(t, getTreasuryBalance().Equal(treasuryBefore))
+		assert.Equal(t, "200stake", k.UndistributedRewardsPool(ctx).String())
+	})
+
+	t.Run("OK: empty pool, nothing is distributed", func(t *testing.T) {
+		rewards1, rewards2, scaledEvent := distributeTxRewards(ctx.BlockHeight()+3, 0)
+		assert.True(t, rewards1.IsZero())
+		assert.True(t, rewards2.IsZero())
+
+		require.NotNil(t, scaledEvent)
+		assert.Equal(t, "400.000000000000000000stake", sdk.DecCoins(scaledEvent.PlannedRewards).String())
+		assert.Empty(t, scaledEvent.AvailableRewards)
+	})
+}
+
+// TestRewardsKeeper_BeforeRewardsDistributionHooks checks the block rewards pool deductions made by the
+// BeforeRewardsDistribution hooks (a hook skims a fixed amount which is transferred to the treasury pool) before contracts distribution.
+func TestRewardsKeeper_BeforeRewardsDistributionHooks(t *testing.T) {
+	chain := e2eTesting.NewTestChain(t, 1)
+	keepers := chain.GetApp().Keepers
+	ctx := chain.GetContext().WithBlockTime(chain.GetBlockTime())
+
+	// Hooks are set for the keeper copy (the app keeper has no hooks), distribution is triggered manually
+	skimAmounts := make([]sdk.Coins, 2)
+	hooks := make([]*mockRewardsHooks, len(skimAmounts))
+	for i := range hooks {
+		i := i
+		hooks[i] = &mockRewardsHooks{
+			skimFn: func(_ sdk.Context, _ sdk.Coins) sdk.Coins {
+				return skimAmounts[i]
+			},
+		}
+	}
+	k := keepers.RewardsKeeper
+	k.SetHooks(rewardsTypes.NewMultiRewardsHooks(hooks[0], hooks[1]))
+
+	contractAddrs := e2eTesting.GenContractAddresses(2)
+	for _, contractAddr := range contractAddrs {
+		rewardsAddr := testutils.AccAddress()
+		require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+			ContractAddress: contractAddr.String(),
+			OwnerAddress:    rewardsAddr.String(),
+			RewardsAddress:  rewardsAddr.String(),
+		}))
+	}
+
+	getTreasuryBalance := func() math.Int {
+		return k.TreasuryPool(ctx).AmountOf(sdk.DefaultBondDenom)
+	}
+
+	// Emulates a tx where the first contract consumes 3 times more gas than the second one and distributes
+	// its 400stake fee rebate rewards (the pool is reset to cover them exactly).
+	// Returns the contracts rewards and the amount transferred to the treasury pool.
+	distributeTxRewards := func(height int64) (sdk.Coins, sdk.Coins, math.Int) {
+		blockCtx := ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+
+		poolInitial := k.UndistributedRewardsPool(blockCtx)
+		require.NoError(t, keepers.BankKeeper.SendCoinsFromModuleToModule(blockCtx, rewardsTypes.ContractRewardCollector, mintTypes.ModuleName, poolInitial))
+		poolCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 400))
+		require.NoError(t, keepers.BankKeeper.MintCoins(blockCtx, mintTypes.ModuleName, poolCoins))
+		require.NoError(t, keepers.BankKeeper.SendCoinsFromModuleToModule(blockCtx, mintTypes.ModuleName, rewardsTypes.ContractRewardCollector, poolCoins))
+		treasuryBalanceBefore := getTreasuryBalance()
+
+		keepers.TrackingKeeper.TrackNewTx(blockCtx)
+		keepers.TrackingKeeper.TrackNewContractOperation(blockCtx, contractAddrs[0], trackingTypes.ContractOperation_CONTRACT_OPERATION_EXECUTION, 300, 0)
+		keepers.TrackingKeeper.TrackNewContractOperation(blockCtx, contractAddrs[1], trackingTypes.ContractOperation_CONTRACT_OPERATION_EXECUTION, 100, 0)
+		keepers.TrackingKeeper.FinalizeBlockTxTracking(blockCtx)
+		k.TrackFeeRebatesRewards(blockCtx, poolCoins)
+
+		k.AllocateBlockRewards(blockCtx, height)
+
+		rewards := make([]sdk.Coins, 0, len(contractAddrs))
+		for _, contractAddr := range contractAddrs {
+			// Entry is not found if nothing is distributed for the contract
+			blockRewards, err := k.ContractBlockRewards.Get(ctx, collections.Join(uint64(height), contractAddr.Bytes()))
+			if !errors.Is(err, collections.ErrNotFound) {
+				require.NoError(t, err)
+			}
+			rewards = append(rewards, blockRewards.Rewards)
+		}
+
+		return rewards[0], rewards[1], getTreasuryBalance().Sub(treasuryBalanceBefore)
+	}
+
+	t.Run("OK: no deductions", func(t *testing.T) {
+		rewards1, rewards2, skimmed := distributeTxRewards(ctx.BlockHeight() + 1)
+		assert.Equal(t, "300stake", rewards1.String())
+		assert.Equal(t, "100stake", rewards2.String())
+		assert.True(t, skimmed.IsZero())
+		assert.Equal(t, "400stake", k.UndistributedRewardsPool(ctx).String())
+
+		for _, hook := range hooks {
+			require.Len(t, hook.distributionPools, 1)
+			assert.Equal(t, "400stake", hook.distributionPools[0].String())
+		}
+	})
+
+	t.Run("OK: hooks deductions are composed, the rest is distributed", func(t *testing.T) {
+		skimAmounts[0] = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 40))
+		skimAmounts[1] = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 60))
+
+		rewards1, rewards2, skimmed := distributeTxRewards(ctx.BlockHeight() + 2)
+		assert.Equal(t, "225stake", rewards1.String())
+		assert.Equal(t, "75stake", rewards2.String())
+		assert.Equal(t, "100", skimmed.String())
+
+		// The second hook gets the pool left after the first one deduction
+		assert.Equal(t, "400stake", hooks[0].distributionPools[1].String())
+		assert.Equal(t, "360stake", hooks[1].distributionPools[1].String())
+		assert.Equal(t, "300stake", k.UndistributedRewardsPool(ctx).String())
+	})
+
+	t.Run("OK: deductions are limited by the pool", func(t *testing.T) {
+		skimAmounts[0] = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 40))
+		skimAmounts[1] = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+
+		rewards1, rewards2, skimmed := distributeTxRewards(ctx.BlockHeight() + 3)
+		assert.True(t, rewards1.IsZero())
+		assert.True(t, rewards2.IsZero())
+		assert.Equal(t, "400", skimmed.String())
+		assert.True(t, k.UndistributedRewardsPool(ctx).IsZero())
+	})
+}
+
+// TestRewardsKeeper_AllocateBlockRewardsOncePerHeight checks a repeated distribution for the same block height
+// (e.g. the EndBlocker running twice) doesn't credit contract rewards twice.
+func TestRewardsKeeper_AllocateBlockRewardsOncePerHeight(t *testing.T) {
+	chain := e2eTesting.NewTestChain(t, 1)
+	keepers := chain.GetApp().Keepers
+	k := keepers.RewardsKeeper
+	ctx := chain.GetContext().WithBlockTime(chain.GetBlockTime())
+
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	rewardsAddr := testutils.AccAddress()
+	require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+		ContractAddress: contractAddr.String(),
+		OwnerAddress:    rewardsAddr.String(),
+		RewardsAddress:  rewardsAddr.String(),
+	}))
+
+	// Next block is used to skip the current block rewards which are already distributed by the chain
+	height := ctx.BlockHeight() + 1
+	blockCtx := ctx.WithBlockHeight(height)
+
+	poolCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 400))
+	require.NoError(t, keepers.BankKeeper.MintCoins(blockCtx, mintTypes.ModuleName, poolCoins))
+	require.NoError(t, keepers.BankKeeper.SendCoinsFromModuleToModule(blockCtx, mintTypes.ModuleName, rewardsTypes.ContractRewardCollector, poolCoins))
+
+	keepers.TrackingKeeper.TrackNewTx(blockCtx)
+	keepers.TrackingKeeper.TrackNewContractOperation(blockCtx, contractAddr, trackingTypes.ContractOperation_CONTRACT_OPERATION_EXECUTION, 100, 0)
+	keepers.TrackingKeeper.FinalizeBlockTxTracking(blockCtx)
+	k.TrackFeeRebatesRewards(blockCtx, poolCoins)
+
+	getRecords := func() []rewardsTypes.RewardsRecord {
+		records, _, err := k.GetRewardsRecords(ctx, rewardsAddr, nil)
+		require.NoError(t, err)
+		return records
+	}
+
+	k.AllocateBlockRewards(blockCtx, height)
+	records := getRecords()
+	require.Len(t, records, 1)
+	assert.Equal(t, "400stake", sdk.Coins(records[0].Rewards).String())
+	poolAfter := k.UndistributedRewardsPool(ctx)
+
+	// Repeated distribution is skipped
+	k.AllocateBlockRewards(blockCtx, height)
+	assert.Equal(t, records, getRecords())
+	assert.Equal(t, poolAfter.String(), k.UndistributedRewardsPool(ctx).String())
+
+	distributed, err := k.DistributedHeights.Has(ctx, uint64(height))
+	require.NoError(t, err)
+	assert.True(t, distributed)
+}
+
+func TestRewardsKeeper_ContractRewardsFromTx(t *testing.T) {
+	chain := e2eTesting.NewTestChain(t, 1)
+	keepers := chain.GetApp().Keepers
+	k := keepers.RewardsKeeper
+	ctx := chain.GetContext().WithBlockTime(chain.GetBlockTime())
+
+	contractAddrs := e2eTesting.GenContractAddresses(3)
+	for _, contractAddr := range contractAddrs[:2] { // the last one has no metadata (not eligible for rewards)
+		rewardsAddr := testutils.AccAddress()
+		require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+			ContractAddress: contractAddr.String(),
+			OwnerAddress:    rewardsAddr.String(),
+			RewardsAddress:  rewardsAddr.String(),
+		}))
+	}
+
+	// Next block is used to skip the current block rewards which are already distributed by the chain
+	height := ctx.BlockHeight() + 1
+	blockCtx := ctx.WithBlockHeight(height)
+
+	// Block inflation rewards are 1000stake for the 1000 max gas
+	inflationRewards := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+	require.NoError(t, k.BlockRewards.Set(blockCtx, uint64(height), rewardsTypes.BlockRewards{
+		Height:           height,
+		InflationRewards: inflationRewards,
+		MaxGas:           1000,
+	}))
+	require.NoError(t, keepers.BankKeeper.MintCoins(blockCtx, mintTypes.ModuleName, sdk.NewCoins(inflationRewards)))
+	require.NoError(t, keepers.BankKeeper.SendCoinsFromModuleToModule(blockCtx, mintTypes.ModuleName, rewardsTypes.ContractRewardCollector, sdk.NewCoins(inflationRewards)))
+
+	// Emulates a tx with the given contract operations gas used and the fee rebate rewards, returns the tx hash
+	trackTx := func(txBytes []byte, feeRewardsAmount int64, contractsGas map[int]uint64) string {
+		txCtx := blockCtx.WithTxBytes(txBytes)
+
+		feeRewards := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, feeRewardsAmount))
+		require.NoError(t, keepers.BankKeeper.MintCoins(txCtx, mintTypes.ModuleName, feeRewards))
+		require.NoError(t, keepers.BankKeeper.SendCoinsFromModuleToModule(txCtx, mintTypes.ModuleName, rewardsTypes.ContractRewardCollector, feeRewards))
+
+		keepers.TrackingKeeper.TrackNewTx(txCtx)
+		for i := range contractAddrs {
+			if gas, ok := contractsGas[i]; ok {
+				keepers.TrackingKeeper.TrackNewContractOperation(txCtx, contractAddrs[i], trackingTypes.ContractOperation_CONTRACT_OPERATION_EXECUTION, gas, 0)
+			}
+		}
+		k.TrackFeeRebatesRewards(txCtx, feeRewards)
+		k.TrackTxFeeDistribution(txCtx, nil, nil, feeRewards, nil, nil)
+
+		return fmt.Sprintf("%X", cmtTypes.Tx(txBytes).Hash())
+	}
+
+	tx1Hash := trackTx([]byte("tx1"), 400, map[int]uint64{0: 100, 1: 200, 2: 100})
+	tx2Hash := trackTx([]byte("tx2"), 100, map[int]uint64{0: 200})
+	keepers.TrackingKeeper.FinalizeBlockTxTracking(blockCtx)
+
+	k.AllocateBlockRewards(blockCtx, height)
+
+	type rewardsExpected struct {
+		contractAddr     sdk.AccAddress
+		gasUsed          uint64
+		feeRewards       string
+		inflationRewards string
+	}
+	checkTxRewards := func(txHash string, expected []rewardsExpected) {
+		txRewards, err := k.ContractRewardsFromTx(blockCtx, txHash)
+		require.NoError(t, err)
+		require.Len(t, txRewards, len(expected))
+
+		for _, exp := range expected {
+			var found bool
+			for _, rewards := range txRewards {
+				if rewards.ContractAddress != exp.contractAddr.String() {
+					continue
+				}
+				found = true
+
+				assert.Equal(t, height, rewards.Height)
+				assert.Equal(t, exp.gasUsed, rewards.GasUsed)
+				assert.Equal(t, exp.feeRewards, sdk.Coins(rewards.FeeRewards).String())
+				assert.Equal(t, exp.inflationRewards, sdk.Coins(rewards.InflationRewards).String())
+			}
+			assert.True(t, found, exp.contractAddr.String())
+		}
+	}
+
+	t.Run("OK: tx with multiple contracts", func(t *testing.T) {
+		// Fee rebate shares: 100/400 and 200/400 of 400stake, inflation shares: 100/1000 and 200/1000 of 1000stake
+		checkTxRewards(tx1Hash, []rewardsExpected{
+			{contractAddr: contractAddrs[0], gasUsed: 100, feeRewards: "100stake", inflationRewards: "100stake"},
+			{contractAddr: contractAddrs[1], gasUsed: 200, feeRewards: "200stake", inflationRewards: "200stake"},
+		})
+	})
+
+	t.Run("OK: tx with a single contract (lowercase hash)", func(t *testing.T) {
+		checkTxRewards(strings.ToLower(tx2Hash), []rewardsExpected{
+			{contractAddr: contractAddrs[0], gasUsed: 200, feeRewards: "100stake", inflationRewards: "200stake"},
+		})
+	})
+
+	t.Run("OK: unknown tx", func(t *testing.T) {
+		checkTxRewards(fmt.Sprintf("%X", cmtTypes.Tx("tx3").Hash()), nil)
+	})
+
+	t.Run("OK: pruned with the block rewards", func(t *testing.T) {
+		k.DeleteBlockRewardsCascade(blockCtx, height)
+		checkTxRewards(tx1Hash, nil)
+	})
+}
+
+// mockContractSudoer records the rewards callback sudo calls.
+type mockContractSudoer struct {
+	contractAddrs []sdk.AccAddress
+	msgs          []string
+	sudoFn        func(ctx sdk.Context) error
+}
+
+func (s *mockContractSudoer) Sudo(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+	s.contractAddrs = append(s.contractAddrs, contractAddress)
+	s.msgs = append(s.msgs, string(msg))
+	if s.sudoFn != nil {
+		return nil, s.sudoFn(sdk.UnwrapSDKContext(ctx))
+	}
+	return nil, nil
+}
+
+func TestRewardsKeeper_RewardsCallback(t *testing.T) {
+	chain := e2eTesting.NewTestChain(t, 1)
+	keepers := chain.GetApp().Keepers
+	ctx := chain.GetContext().WithBlockTime(chain.GetBlockTime())
+
+	// Sudoer is set for the keeper copy, distribution is triggered manually
+	sudoer := &mockContractSudoer{}
+	k := keepers.RewardsKeeper
+	k.SetContractSudoer(sudoer)
+
+	// Every distribution uses its own contract and callback contract pair
+	contractAddrs := e2eTesting.GenContractAddresses(10)
+
+	// Emulates a tx where the contract with the rewards callback (25% share) distributes its 400stake fee rebate rewards.
+	// Returns the rewards record amount, the callback contract balance and the callback event emitted.
+	distributeTxRewards := func(height int64) (sdk.Coins, sdk.Coins, *rewardsTypes.ContractRewardsCallbackEvent) {
+		blockCtx := ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+
+		i := 2 * (height - ctx.BlockHeight() - 1)
+		contractAddr, callbackAddr := contractAddrs[i], contractAddrs[i+1]
+		rewardsAddr := testutils.AccAddress()
+		require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+			ContractAddress:        contractAddr.String(),
+			OwnerAddress:           rewardsAddr.String(),
+			RewardsAddress:         rewardsAddr.String(),
+			RewardsCallbackAddress: callbackAddr.String(),
+			RewardsCallbackShare:   2500,
+		}))
+
+		poolCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 400))
+		require.NoError(t, keepers.BankKeeper.MintCoins(blockCtx, mintTypes.ModuleName, poolCoins))
+		require.NoError(t, keepers.BankKeeper.SendCoinsFromModuleToModule(blockCtx, mintTypes.ModuleName, rewardsTypes.ContractRewardCollector, poolCoins))
+
+		keepers.TrackingKeeper.TrackNewTx(blockCtx)
+		keepers.TrackingKeeper.TrackNewContractOperation(blockCtx, contractAddr, trackingTypes.ContractOperation_CONTRACT_OPERATION_EXECUTION, 100, 0)
+		keepers.TrackingKeeper.FinalizeBlockTxTracking(blockCtx)
+		k.TrackFeeRebatesRewards(blockCtx, poolCoins)
+
+		k.AllocateBlockRewards(blockCtx, height)
+
+		records, _, err := k.GetRewardsRecords(ctx, rewardsAddr, nil)
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+
+		var callbackEvent *rewardsTypes.ContractRewardsCallbackEvent
+		for _, event := range blockCtx.EventManager().ABCIEvents() {
+			if event.Type != "archway.rewards.v1.ContractRewardsCallbackEvent" {
+				continue
+			}
+			msg, err := sdk.ParseTypedEvent(event)
+			require.NoError(t, err)
+			callbackEvent = msg.(*rewardsTypes.ContractRewardsCallbackEvent)
+		}
+		require.NotNil(t, callbackEvent)
+		assert.Equal(t, contractAddr.String(), callbackEvent.ContractAddress)
+		assert.Equal(t, callbackAddr.String(), callbackEvent.CallbackAddress)
+
+		require.NotEmpty(t, sudoer.contractAddrs)
+		assert.Equal(t, callbackAddr, sudoer.contractAddrs[len(sudoer.contractAddrs)-1])
+		assert.Equal(t,
+			fmt.Sprintf(`{"rewards_callback":{"contract_address":"%s","rewards":[{"denom":"stake","amount":"100"}]}}`, contractAddr),
+			sudoer.msgs[len(sudoer.msgs)-1],
+		)
+
+		return records[0].Rewards, keepers.BankKeeper.GetAllBalances(ctx, callbackAddr), callbackEvent
+	}
+
+	t.Run("OK: callback gets its share", func(t *testing.T) {
+		recordRewards, callbackBalance, event := distributeTxRewards(ctx.BlockHeight() + 1)
+		assert.Equal(t, "300stake", recordRewards.String())
+		assert.Equal(t, "100stake", callbackBalance.String())
+		assert.Equal(t, "100stake", sdk.Coins(event.Rewards).String())
+		assert.Empty(t, event.Error)
+	})
+
+	t.Run("OK: failed callback is reverted, distribution continues", func(t *testing.T) {
+		sudoer.sudoFn = func(ctx sdk.Context) error {
+			return errors.New("callback failed")
+		}
+		defer func() { sudoer.sudoFn = nil }()
+
+		recordRewards, callbackBalance, event := distributeTxRewards(ctx.BlockHeight() + 2)
+		assert.Equal(t, "400stake", recordRewards.String())
+		assert.True(t, callbackBalance.IsZero())
+		assert.Equal(t, "callback failed", event.Error)
+	})
+
+	t.Run("OK: callback is limited by gas", func(t *testing.T) {
+		params := k.GetParams(ctx)
+		params.RewardsCallbackGasLimit = 50_000
+		require.NoError(t, k.Params.Set(ctx, params))
+
+		sudoer.sudoFn = func(ctx sdk.Context) error {
+			ctx.GasMeter().ConsumeGas(60_000, "callback")
+			return nil
+		}
+		defer func() { sudoer.sudoFn = nil }()
+
+		recordRewards, callbackBalance, event := distributeTxRewards(ctx.BlockHeight() + 3)
+		assert.Equal(t, "400stake", recordRewards.String())
+		assert.True(t, callbackBalance.IsZero())
+		assert.Contains(t, event.Error, "out of gas")
+		assert.GreaterOrEqual(t, event.GasUsed, uint64(50_000))
+	})
+
+	t.Run("OK: callbacks are limited by the block gas budget", func(t *testing.T) {
+		// The budget covers a single callback of two within the block
+		params := k.GetParams(ctx)
+		params.RewardsCallbackGasLimit = 100_000
+		params.RewardsCallbacksBlockGasLimit = 150_000
+		require.NoError(t, k.Params.Set(ctx, params))
+
+		sudoer.sudoFn = func(ctx sdk.Context) error {
+			ctx.GasMeter().ConsumeGas(60_000, "callback")
+			return nil
+		}
+		defer func() { sudoer.sudoFn = nil }()
+
+		height := ctx.BlockHeight() + 4
+		blockCtx := ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+
+		poolCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 800))
+		require.NoError(t, keepers.BankKeeper.MintCoins(blockCtx, mintTypes.ModuleName, poolCoins))
+		require.NoError(t, keepers.BankKeeper.SendCoinsFromModuleToModule(blockCtx, mintTypes.ModuleName, rewardsTypes.ContractRewardCollector, poolCoins))
+
+		keepers.TrackingKeeper.TrackNewTx(blockCtx)
+		rewardsAddrs := make(map[string]sdk.AccAddress)
+		for i := 6; i < 10; i += 2 {
+			contractAddr, callbackAddr := contractAddrs[i], contractAddrs[i+1]
+			rewardsAddr := testutils.AccAddress()
+			rewardsAddrs[callbackAddr.String()] = rewardsAddr
+			require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+				ContractAddress:        contractAddr.String(),
+				OwnerAddress:           rewardsAddr.String(),
+				RewardsAddress:         rewardsAddr.String(),
+				RewardsCallbackAddress: callbackAddr.String(),
+				RewardsCallbackShare:   2500,
+			}))
+			keepers.TrackingKeeper.TrackNewContractOperation(blockCtx, contractAddr, trackingTypes.ContractOperation_CONTRACT_OPERATION_EXECUTION, 100, 0)
+		}
+		keepers.TrackingKeeper.FinalizeBlockTxTracking(blockCtx)
+		k.TrackFeeRebatesRewards(blockCtx, poolCoins)
+
+		sudoCallsBefore := len(sudoer.contractAddrs)
+		k.AllocateBlockRewards(blockCtx, height)
+
+		// Only the first callback is executed
+		require.Len(t, sudoer.contractAddrs, sudoCallsBefore+1)
+		executedCallbackAddr := sudoer.contractAddrs[sudoCallsBefore]
+
+		var callbackEvents []*rewardsTypes.ContractRewardsCallbackEvent
+		for _, event := range blockCtx.EventManager().ABCIEvents() {
+			if event.Type != "archway.rewards.v1.ContractRewardsCallbackEvent" {
+				continue
+			}
+			msg, err := sdk.ParseTypedEvent(event)
+			require.NoError(t, err)
+			callbackEvents = append(callbackEvents, msg.(*rewardsTypes.ContractRewardsCallbackEvent))
+		}
+		require.Len(t, callbackEvents, 2)
+
+		for _, event := range callbackEvents {
+			callbackAddr := sdk.MustAccAddressFromBech32(event.CallbackAddress)
+
+			records, _, err := k.GetRewardsRecords(ctx, rewardsAddrs[event.CallbackAddress], nil)
+			require.NoError(t, err)
+			require.Len(t, records, 1)
+
+			if callbackAddr.Equals(executedCallbackAddr) {
+				assert.Empty(t, event.Error)
+				assert.Equal(t, "300stake", sdk.Coins(records[0].Rewards).String())
+				assert.Equal(t, "100stake", keepers.BankKeeper.GetAllBalances(ctx, callbackAddr).String())
+				continue
+			}
+
+			assert.Equal(t, rewardsTypes.ErrRewardsCallbacksBudgetExhausted.Error(), event.Error)
+			assert.Zero(t, event.GasUsed)
+			assert.Equal(t, "400stake", sdk.Coins(records[0].Rewards).String())
+			assert.True(t, keepers.BankKeeper.GetAllBalances(ctx, callbackAddr).IsZero())
+		}
+	})
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/archway-network/archway/x/rewards/types"
+)
+
+// RouteFeeCollectorFees sends the fee collector fees in the routed denoms (FeeDenomRoutes param) to the route module
+// accounts. Fees in other denoms are kept by the fee collector. Returns the fees routed.
+func (k Keeper) RouteFeeCollectorFees(ctx sdk.Context, fees sdk.Coins) (sdk.Coins, error) {
+	routed := sdk.NewCoins()
+	for _, route := range k.FeeDenomRoutes(ctx) {
+		amount := fees.AmountOf(route.Denom)
+		if !amount.IsPositive() {
+			continue
+		}
+
+		// Module account must be registered, otherwise x/bank panics
+		if k.authKeeper.GetModuleAccount(ctx, route.ModuleAccount) == nil {
+			return nil, errorsmod.Wrapf(types.ErrInternal, "fee denom (%s) route module account (%s) not found", route.Denom, route.ModuleAccount)
+		}
+
+		coins := sdk.NewCoins(sdk.NewCoin(route.Denom, amount))
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authTypes.FeeCollectorName, route.ModuleAccount, coins); err != nil {
+			return nil, errorsmod.Wrapf(err, "routing fees (%s)", coins)
+		}
+		routed = routed.Add(coins...)
+	}
+
+	return routed, nil
+}
@@ -1,15 +1,27 @@
 package keeper
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	cmtTypes "github.com/cometbft/cometbft/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/archway-network/archway/x/rewards/types"
+	trackingTypes "github.com/archway-network/archway/x/tracking/types"
 )
 
 // SetFlatFee checks if a contract has metadata set and stores the given flat fee to be associated with that contract
 func (k Keeper) SetFlatFee(ctx sdk.Context, senderAddr sdk.AccAddress, feeUpdate types.FlatFee) error {
-	// Check if the contract metadata exists
+	// Check if the contract and its metadata exist
+	if k.contractInfoView.GetContractInfo(ctx, feeUpdate.MustGetContractAddress()) == nil {
+		return types.ErrFlatFeeNotContract
+	}
 	contractInfo := k.GetContractMetadata(ctx, feeUpdate.MustGetContractAddress())
 	if contractInfo == nil {
 		return types.ErrMetadataNotFound
@@ -17,8 +29,21 @@ func (k Keeper) SetFlatFee(ctx sdk.Context, senderAddr sdk.AccAddress, feeUpdate
 	if contractInfo.OwnerAddress != senderAddr.String() {
 		return errorsmod.Wrap(types.ErrUnauthorized, "flat_fee can only be set or changed by the contract owner")
 	}
+	if err := k.checkFlatFeeUpdateInterval(ctx, feeUpdate.MustGetContractAddress()); err != nil {
+		return err
+	}
+
+	if feeUpdate.Method != "" {
+		return k.setMethodFlatFee(ctx, *contractInfo, feeUpdate)
+	}
 
 	if feeUpdate.FlatFee.Amount.IsZero() {
+		if feeUpdate.Schedule != nil {
+			return errorsmod.Wrap(types.ErrInvalidRequest, "flat_fee schedule can not be set when removing the flat fee")
+		}
+		if feeUpdate.HasMinConsFeeMultiplier() {
+			return errorsmod.Wrap(types.ErrInvalidRequest, "flat_fee min_cons_fee_multiplier can not be set when removing the flat fee")
+		}
 		err := k.FlatFees.Remove(ctx, feeUpdate.MustGetContractAddress())
 		if err != nil {
 			return err
@@ -33,33 +58,646 @@ func (k Keeper) SetFlatFee(ctx sdk.Context, senderAddr sdk.AccAddress, feeUpdate
 		}
 	}
 
+	// An update without a schedule replaces the existing one (if any) with a constant fee
+	if err := k.setFlatFeeSchedule(ctx, feeUpdate.MustGetContractAddress(), feeUpdate.FlatFee, feeUpdate.Schedule); err != nil {
+		return err
+	}
+	// Same for the min consensus fee multiplier: an update without one makes the flat fee absolute
+	if err := k.setFlatFeeMultiplier(ctx, feeUpdate.MustGetContractAddress(), feeUpdate.MinConsFeeMultiplier, feeUpdate.Schedule != nil); err != nil {
+		return err
+	}
+
+	if err := k.FlatFeeUpdateHeights.Set(ctx, feeUpdate.MustGetContractAddress(), uint64(ctx.BlockHeight())); err != nil {
+		return err
+	}
+
 	types.EmitContractFlatFeeSetEvent(
 		ctx,
 		feeUpdate.MustGetContractAddress(),
 		feeUpdate.FlatFee,
+		"",
 	)
 	return nil
 }
 
-// GetFlatFee retreives the flat fee stored for a given contract
+// setMethodFlatFee stores (or removes if the amount is zero) the flat fee for the contract execute msg method.
+// The contract-wide flat fee and its schedule are not affected.
+func (k Keeper) setMethodFlatFee(ctx sdk.Context, contractInfo types.ContractMetadata, feeUpdate types.FlatFee) error {
+	contractAddr := feeUpdate.MustGetContractAddress()
+
+	if err := types.ValidateFlatFeeMethod(feeUpdate.Method); err != nil {
+		return err
+	}
+	if feeUpdate.Schedule != nil {
+		return errorsmod.Wrap(types.ErrInvalidRequest, "flat_fee schedule can not be set for a method flat fee")
+	}
+	if feeUpdate.HasMinConsFeeMultiplier() {
+		return errorsmod.Wrap(types.ErrInvalidRequest, "flat_fee min_cons_fee_multiplier can not be set for a method flat fee")
+	}
+
+	key := collections.Join(contractAddr.Bytes(), feeUpdate.Method)
+	if feeUpdate.FlatFee.Amount.IsZero() {
+		if err := k.MethodFlatFees.Remove(ctx, key); err != nil {
+			return err
+		}
+	} else {
+		if contractInfo.RewardsAddress == "" {
+			return errorsmod.Wrap(types.ErrMetadataNotFound, "flat_fee can only be set when rewards address has been configured")
+		}
+		if err := k.MethodFlatFees.Set(ctx, key, feeUpdate.FlatFee); err != nil {
+			return err
+		}
+	}
+
+	if err := k.FlatFeeUpdateHeights.Set(ctx, contractAddr, uint64(ctx.BlockHeight())); err != nil {
+		return err
+	}
+
+	types.EmitContractFlatFeeSetEvent(ctx, contractAddr, feeUpdate.FlatFee, feeUpdate.Method)
+	return nil
+}
+
+// SetFlatFeeByCodeID sets (or removes if the amount is zero) the flat fee for all the contracts with metadata
+// instantiated from the given code ID. This is a governance operation: contract ownership and the flat fee update
+// rate-limit are not checked. Contracts without a rewards address configured are skipped if the flat fee is set.
+// The number of contracts is limited by the MaxFlatFeeUpdateContracts param, the operation is rejected if exceeded.
+// The flat fee is also stored as the code ID default (inherited by contracts migrated to that code ID depending on the
+// FlatFeeMigrationPolicy param).
+func (k Keeper) SetFlatFeeByCodeID(ctx sdk.Context, codeID uint64, fee sdk.Coin) (uint64, error) {
+	maxContracts := k.MaxFlatFeeUpdateContracts(ctx)
+	if maxContracts == 0 {
+		return 0, errorsmod.Wrap(types.ErrInvalidRequest, "bulk flat fee updates are disabled")
+	}
+
+	// Collect contracts using the code ID index (skipping contracts migrated to a different code since the index update)
+	var contracts []types.ContractMetadata
+	limitExceeded := false
+	err := k.IterateContractsByCodeID(ctx, codeID, func(contractAddr sdk.AccAddress) bool {
+		if uint64(len(contracts)) == maxContracts {
+			limitExceeded = true
+			return true
+		}
+		if contractInfo := k.contractInfoView.GetContractInfo(ctx, contractAddr); contractInfo == nil || contractInfo.CodeID != codeID {
+			return false
+		}
+		meta := k.GetContractMetadata(ctx, contractAddr)
+		if meta == nil {
+			return false
+		}
+		contracts = append(contracts, *meta)
+		return false
+	})
+	if err != nil {
+		return 0, err
+	}
+	if limitExceeded {
+		return 0, errorsmod.Wrapf(types.ErrInvalidRequest, "code ID (%d) contracts number exceeds the limit (%d)", codeID, maxContracts)
+	}
+
+	updated := uint64(0)
+	for _, meta := range contracts {
+		contractAddr := meta.MustGetContractAddress()
+		if err := k.FlatFeeSchedules.Remove(ctx, contractAddr); err != nil {
+			return 0, err
+		}
+		if err := k.FlatFeeMultipliers.Remove(ctx, contractAddr); err != nil {
+			return 0, err
+		}
+		if fee.Amount.IsZero() {
+			if err := k.FlatFees.Remove(ctx, contractAddr); err != nil {
+				return 0, err
+			}
+		} else {
+			if meta.RewardsAddress == "" {
+				continue
+			}
+			if err := k.FlatFees.Set(ctx, contractAddr, fee); err != nil {
+				return 0, err
+			}
+		}
+		updated++
+
+		types.EmitContractFlatFeeSetEvent(ctx, contractAddr, fee, "")
+	}
+
+	if fee.Amount.IsZero() {
+		if err := k.CodeFlatFees.Remove(ctx, codeID); err != nil {
+			return 0, err
+		}
+	} else {
+		if err := k.CodeFlatFees.Set(ctx, codeID, fee); err != nil {
+			return 0, err
+		}
+	}
+
+	return updated, nil
+}
+
+// GetCodeFlatFee returns the code ID default flat fee (if set).
+func (k Keeper) GetCodeFlatFee(ctx sdk.Context, codeID uint64) (sdk.Coin, bool) {
+	fee, err := k.CodeFlatFees.Get(ctx, codeID)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return sdk.Coin{}, false
+		}
+		panic(err)
+	}
+
+	return fee, true
+}
+
+// ReconcileMigratedContractFlatFee is the contract migration hook: it updates the contract code ID (the contracts by
+// code ID index) if the contract has been migrated to a new code and reconciles the contract flat fee depending on the
+// FlatFeeMigrationPolicy param. The flat fee is either kept or replaced with the new code ID default flat fee (kept if
+// the new code ID has no default). Contracts without metadata are skipped.
+func (k Keeper) ReconcileMigratedContractFlatFee(ctx sdk.Context, contractAddr sdk.AccAddress) error {
+	meta := k.GetContractMetadata(ctx, contractAddr)
+	if meta == nil {
+		return nil
+	}
+	contractInfo := k.contractInfoView.GetContractInfo(ctx, contractAddr)
+	if contractInfo == nil {
+		return nil
+	}
+
+	prevCodeID, err := k.ContractCodeIDs.Get(ctx, contractAddr)
+	switch {
+	case err == nil && prevCodeID == contractInfo.CodeID:
+		return nil
+	case err != nil && !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+	if err := k.ContractCodeIDs.Set(ctx, contractAddr, contractInfo.CodeID); err != nil {
+		return err
+	}
+
+	if k.FlatFeeMigrationPolicy(ctx) != types.FlatFeeMigrationPolicy_FLAT_FEE_MIGRATION_POLICY_INHERIT_CODE_ID {
+		return nil
+	}
+	fee, found := k.GetCodeFlatFee(ctx, contractInfo.CodeID)
+	if !found || meta.RewardsAddress == "" {
+		return nil
+	}
+
+	if err := k.FlatFeeSchedules.Remove(ctx, contractAddr); err != nil {
+		return err
+	}
+	if err := k.FlatFeeMultipliers.Remove(ctx, contractAddr); err != nil {
+		return err
+	}
+	if err := k.FlatFees.Set(ctx, contractAddr, fee); err != nil {
+		return err
+	}
+
+	types.EmitContractFlatFeeSetEvent(ctx, contractAddr, fee, "")
+
+	return nil
+}
+
+// reconcileMigratedContracts calls the contract migration hook for every contract migrated within the given block
+// (contracts are resolved using the x/tracking migrate operations).
+func (k Keeper) reconcileMigratedContracts(ctx sdk.Context, height int64) {
+	blockGasTrackingInfo := k.trackingKeeper.GetBlockTrackingInfo(ctx, height)
+
+	reconciled := make(map[string]struct{})
+	for _, txGasTrackingInfo := range blockGasTrackingInfo.Txs {
+		for _, contractOp := range txGasTrackingInfo.ContractOperations {
+			if contractOp.OperationType != trackingTypes.ContractOperation_CONTRACT_OPERATION_MIGRATE {
+				continue
+			}
+			if _, ok := reconciled[contractOp.ContractAddress]; ok {
+				continue
+			}
+			reconciled[contractOp.ContractAddress] = struct{}{}
+
+			contractAddr, err := sdk.AccAddressFromBech32(contractOp.ContractAddress)
+			if err != nil {
+				panic(fmt.Errorf("invalid contract address (%s): %w", contractOp.ContractAddress, err))
+			}
+			if err := k.ReconcileMigratedContractFlatFee(ctx, contractAddr); err != nil {
+				panic(fmt.Errorf("reconciling migrated contract (%s) flat fee: %w", contractAddr, err))
+			}
+		}
+	}
+}
+
+// checkFlatFeeUpdateInterval checks that the FlatFeeUpdateInterval number of blocks has passed since the last contract flat fee update.
+func (k Keeper) checkFlatFeeUpdateInterval(ctx sdk.Context, contractAddr sdk.AccAddress) error {
+	interval := k.FlatFeeUpdateInterval(ctx)
+	if interval == 0 {
+		return nil
+	}
+
+	lastHeight, err := k.FlatFeeUpdateHeights.Get(ctx, contractAddr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if nextHeight := lastHeight + interval; uint64(ctx.BlockHeight()) < nextHeight {
+		return errorsmod.Wrapf(types.ErrFlatFeeUpdateTooSoon, "flat_fee was updated at height %d, next update is allowed at height %d", lastHeight, nextHeight)
+	}
+
+	return nil
+}
+
+// setFlatFeeSchedule sets the flat fee schedule verifying it matches the flat fee or removes it if not provided.
+func (k Keeper) setFlatFeeSchedule(ctx sdk.Context, contractAddr sdk.AccAddress, fee sdk.Coin, schedule *types.FlatFeeSchedule) error {
+	if schedule == nil {
+		return k.FlatFeeSchedules.Remove(ctx, contractAddr)
+	}
+
+	if err := schedule.Validate(); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidRequest, "invalid flat_fee schedule: %v", err)
+	}
+	if !schedule.EndFee.Equal(fee) {
+		return errorsmod.Wrapf(types.ErrInvalidRequest, "flat_fee (%s) must match the schedule end fee (%s)", fee, schedule.EndFee)
+	}
+
+	return k.FlatFeeSchedules.Set(ctx, contractAddr, *schedule)
+}
+
+// setFlatFeeMultiplier sets the flat fee min consensus fee multiplier or removes it if not provided.
+func (k Keeper) setFlatFeeMultiplier(ctx sdk.Context, contractAddr sdk.AccAddress, multiplier math.LegacyDec, hasSchedule bool) error {
+	if multiplier.IsNil() || multiplier.IsZero() {
+		return k.FlatFeeMultipliers.Remove(ctx, contractAddr)
+	}
+
+	if err := types.ValidateFlatFeeMultiplier(multiplier, hasSchedule, false); err != nil {
+		return err
+	}
+
+	return k.FlatFeeMultipliers.Set(ctx, contractAddr, multiplier)
+}
+
+// GetFlatFeeMultiplier returns the flat fee min consensus fee multiplier for a given contract (if set).
+func (k Keeper) GetFlatFeeMultiplier(ctx sdk.Context, contractAddr sdk.AccAddress) (math.LegacyDec, bool) {
+	multiplier, err := k.FlatFeeMultipliers.Get(ctx, contractAddr)
+	if err != nil {
+		return math.LegacyDec{}, false
+	}
+
+	return multiplier, true
+}
+
+// GetFlatFee retreives the flat fee stored for a given contract.
+// If the flat fee schedule is set, the fee for the current block height is returned (a zero fee is reported as not found).
+// If the min consensus fee multiplier is set, the fee is the current min consensus fee (in the flat fee denom) times
+// the multiplier (rounded up) with the stored flat fee being the minimum.
 func (k Keeper) GetFlatFee(ctx sdk.Context, contractAddr sdk.AccAddress) (sdk.Coin, bool) {
 	fee, err := k.FlatFees.Get(ctx, contractAddr)
 	if err != nil {
 		return sdk.Coin{}, false
 	}
 
+	if multiplier, found := k.GetFlatFeeMultiplier(ctx, contractAddr); found {
+		if minConsFee, found := k.GetMinConsensusFee(ctx, fee.Denom); found {
+			relativeAmt := minConsFee.Amount.Mul(multiplier).Ceil().TruncateInt()
+			if relativeAmt.GT(fee.Amount) {
+				fee.Amount = relativeAmt
+			}
+		}
+		return fee, true
+	}
+
+	schedule, err := k.FlatFeeSchedules.Get(ctx, contractAddr)
+	if err != nil {
+		return fee, true
+	}
+	if fee = schedule.FeeAt(ctx.BlockHeight()); fee.IsZero() {
+		return sdk.Coin{}, false
+	}
+
 	return fee, true
 }
 
-// CreateFlatFeeRewardsRecords creates a rewards record for the flatfees of the given contract
-func (k Keeper) CreateFlatFeeRewardsRecords(ctx sdk.Context, contractAddress sdk.AccAddress, flatfees sdk.Coins) {
-	calculationHeight, calculationTime := ctx.BlockHeight(), ctx.BlockTime()
+// GetFlatFees returns the flat fees of the given contracts keyed by the contract address (bech32 encoded).
+// Contracts without a flat fee (see GetFlatFee) are omitted.
+func (k Keeper) GetFlatFees(ctx sdk.Context, contractAddrs []sdk.AccAddress) map[string]sdk.Coin {
+	fees := make(map[string]sdk.Coin, len(contractAddrs))
+	for _, contractAddr := range contractAddrs {
+		if fee, found := k.GetFlatFee(ctx, contractAddr); found {
+			fees[contractAddr.String()] = fee
+		}
+	}
+
+	return fees
+}
+
+// GetMethodFlatFee returns the flat fee stored for the given contract execute msg method.
+// The contract-wide flat fee is not taken into account (callers should fall back to GetFlatFee if not found).
+func (k Keeper) GetMethodFlatFee(ctx sdk.Context, contractAddr sdk.AccAddress, method string) (sdk.Coin, bool) {
+	fee, err := k.MethodFlatFees.Get(ctx, collections.Join(contractAddr.Bytes(), method))
+	if err != nil {
+		return sdk.Coin{}, false
+	}
 
+	return fee, true
+}
+
+// removeMethodFlatFees removes all the execute msg method flat fees of the contract.
+func (k Keeper) removeMethodFlatFees(ctx sdk.Context, contractAddr sdk.AccAddress) error {
+	return k.MethodFlatFees.Clear(ctx, collections.NewPrefixedPairRange[[]byte, string](contractAddr.Bytes()))
+}
+
+// GetFlatFeeSchedule returns the flat fee schedule for a given contract (if set).
+func (k Keeper) GetFlatFeeSchedule(ctx sdk.Context, contractAddr sdk.AccAddress) (types.FlatFeeSchedule, bool) {
+	schedule, err := k.FlatFeeSchedules.Get(ctx, contractAddr)
+	if err != nil {
+		return types.FlatFeeSchedule{}, false
+	}
+
+	return schedule, true
+}
+
+// CreateFlatFeeRewardsRecords creates a rewards record for the flatfees of the given contract.
+// If the contract opted in for the direct payout, flatfees are queued to be transferred to the rewards address
+// at the block end instead.
+// Returns false if the contract has no metadata or rewards address set (the flat fees could not be attributed).
+func (k Keeper) CreateFlatFeeRewardsRecords(ctx sdk.Context, contractAddress sdk.AccAddress, flatfees sdk.Coins) bool {
 	metadata := k.GetContractMetadata(ctx, contractAddress)
+	if metadata == nil || !metadata.HasRewardsAddress() {
+		return false
+	}
+
+	if k.FlatFeeOncePerBlock(ctx) && !isMempoolCheck(ctx) {
+		k.trackFlatFeeBlockCharge(ctx, contractAddress)
+	}
+
+	k.distributeFlatFees(ctx, contractAddress, *metadata, flatfees)
+
+	return true
+}
+
+// DistributeFlatFeeTip distributes the tip paid on top of the contract flat fee the same way the flat fees are
+// (the once per block flat fee charge is not tracked). Returns false if the contract has no rewards address set
+// (the tip could not be attributed).
+func (k Keeper) DistributeFlatFeeTip(ctx sdk.Context, contractAddress sdk.AccAddress, tip sdk.Coins) bool {
+	metadata := k.GetContractMetadata(ctx, contractAddress)
+	if metadata == nil || !metadata.HasRewardsAddress() {
+		return false
+	}
+
+	k.distributeFlatFees(ctx, contractAddress, *metadata, tip)
+
+	return true
+}
+
+// distributeFlatFees creates a rewards record for the flatfees of the given contract (or queues them for the direct
+// payout if the contract opted in).
+func (k Keeper) distributeFlatFees(ctx sdk.Context, contractAddress sdk.AccAddress, metadata types.ContractMetadata, flatfees sdk.Coins) {
 	rewardsAddr := sdk.MustAccAddressFromBech32(metadata.RewardsAddress)
+	k.trackContractBlockFlatFees(ctx, contractAddress, flatfees)
+	k.trackBlockPoolInflows(ctx, nil, nil, flatfees)
 
-	_, err := k.CreateRewardsRecord(ctx, rewardsAddr, flatfees, calculationHeight, calculationTime)
+	if metadata.FlatFeeDirectPayout {
+		k.queueFlatFeePayout(ctx, contractAddress, rewardsAddr, flatfees)
+		return
+	}
+
+	_, err := k.CreateRewardsRecord(ctx, rewardsAddr, contractAddress, flatfees, ctx.BlockHeight(), ctx.BlockTime())
 	if err != nil {
 		panic(err)
 	}
 }
+
+// PrepayFlatFee prepays the given number of contract executions at the discounted contract flat fee (refer to the
+// FlatFeePrepayDiscount param). The fee is paid by the sender (the contract owner) and distributed the same way
+// the charged flat fees are. Returns the paid fee and the total number of prepaid executions left.
+func (k Keeper) PrepayFlatFee(ctx sdk.Context, senderAddr, contractAddr sdk.AccAddress, executions uint64) (sdk.Coin, uint64, error) {
+	if executions == 0 || executions > types.MaxFlatFeePrepayExecutions {
+		return sdk.Coin{}, 0, errorsmod.Wrapf(types.ErrInvalidRequest, "executions must be in the [1, %d] range", types.MaxFlatFeePrepayExecutions)
+	}
+
+	metadata := k.GetContractMetadata(ctx, contractAddr)
+	if metadata == nil {
+		return sdk.Coin{}, 0, types.ErrMetadataNotFound
+	}
+	if metadata.OwnerAddress != senderAddr.String() {
+		return sdk.Coin{}, 0, errorsmod.Wrap(types.ErrUnauthorized, "flat_fee executions can only be prepaid by the contract owner")
+	}
+	if !metadata.HasRewardsAddress() {
+		return sdk.Coin{}, 0, errorsmod.Wrap(types.ErrMetadataNotFound, "flat_fee executions can only be prepaid when rewards address has been configured")
+	}
+	flatFee, found := k.GetFlatFee(ctx, contractAddr)
+	if !found {
+		return sdk.Coin{}, 0, errorsmod.Wrap(types.ErrInvalidRequest, "contract has no flat_fee set")
+	}
+
+	paidFee := types.PrepaidFlatFee(flatFee, executions, k.FlatFeePrepayDiscount(ctx))
+	if paidFee.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, types.ContractRewardCollector, sdk.NewCoins(paidFee)); err != nil {
+			return sdk.Coin{}, 0, errorsmod.Wrapf(sdkErrors.ErrInsufficientFunds, "paying the prepaid flat_fee (%s): %v", paidFee, err)
+		}
+		k.distributeFlatFees(ctx, contractAddr, *metadata, sdk.NewCoins(paidFee))
+	}
+
+	credits := k.GetFlatFeeCredits(ctx, contractAddr) + executions
+	if err := k.FlatFeeCredits.Set(ctx, contractAddr, credits); err != nil {
+		return sdk.Coin{}, 0, err
+	}
+
+	types.EmitContractFlatFeePrepaidEvent(ctx, contractAddr, executions, sdk.NewCoins(paidFee), credits)
+
+	return paidFee, credits, nil
+}
+
+// GetFlatFeeCredits returns the number of prepaid contract executions left.
+func (k Keeper) GetFlatFeeCredits(ctx sdk.Context, contractAddr sdk.AccAddress) uint64 {
+	credits, err := k.FlatFeeCredits.Get(ctx, contractAddr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return 0
+		}
+		panic(err)
+	}
+
+	return credits
+}
+
+// ConsumeFlatFeeCredit decrements the contract prepaid executions credit by one (the credit is removed once exhausted).
+// Returns false if the contract has no prepaid executions left, the state is not changed in that case.
+func (k Keeper) ConsumeFlatFeeCredit(ctx sdk.Context, contractAddr sdk.AccAddress) bool {
+	credits := k.GetFlatFeeCredits(ctx, contractAddr)
+	if credits == 0 {
+		return false
+	}
+
+	var err error
+	if credits == 1 {
+		err = k.FlatFeeCredits.Remove(ctx, contractAddr)
+	} else {
+		err = k.FlatFeeCredits.Set(ctx, contractAddr, credits-1)
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	return true
+}
+
+// SetFlatFeeOverride stores the governance contract flat fee override which takes precedence over the contract owner
+// flat fees until the expiry height (a zero fee waives the flat fees).
+func (k Keeper) SetFlatFeeOverride(ctx sdk.Context, contractAddr sdk.AccAddress, flatFee sdk.Coin, expiryHeight int64) error {
+	if k.GetContractMetadata(ctx, contractAddr) == nil {
+		return types.ErrMetadataNotFound
+	}
+	if expiryHeight <= ctx.BlockHeight() {
+		return errorsmod.Wrapf(types.ErrInvalidRequest, "expiry height (%d) must be GT the current block height (%d)", expiryHeight, ctx.BlockHeight())
+	}
+
+	override := types.FlatFeeOverride{
+		ContractAddress: contractAddr.String(),
+		FlatFee:         flatFee,
+		ExpiryHeight:    expiryHeight,
+	}
+	if err := override.Validate(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+
+	if err := k.FlatFeeOverrides.Set(ctx, contractAddr, override); err != nil {
+		return err
+	}
+
+	types.EmitContractFlatFeeOverrideSetEvent(ctx, contractAddr, flatFee, expiryHeight)
+
+	return nil
+}
+
+// GetFlatFeeOverride returns the governance contract flat fee override if set and not expired at the current block height.
+func (k Keeper) GetFlatFeeOverride(ctx sdk.Context, contractAddr sdk.AccAddress) (sdk.Coin, bool) {
+	override, err := k.FlatFeeOverrides.Get(ctx, contractAddr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return sdk.Coin{}, false
+		}
+		panic(err)
+	}
+	if !override.IsActive(ctx.BlockHeight()) {
+		return sdk.Coin{}, false
+	}
+
+	return override.FlatFee, true
+}
+
+// IsFlatFeeChargedInBlock checks if the contract flat fee was charged by another transaction within the current block.
+// Transactions are identified by the context tx bytes hash, so a transaction with multiple msgs targeting the contract
+// is charged for every msg.
+// Charges are tracked during the block execution only: CheckTx always requires the flat fee since the mempool
+// state is not reset between blocks and the charging tx might not be included (or ordered first) in the block.
+func (k Keeper) IsFlatFeeChargedInBlock(ctx sdk.Context, contractAddr sdk.AccAddress) bool {
+	if isMempoolCheck(ctx) {
+		return false
+	}
+
+	txHash, err := k.FlatFeeBlockCharges.Get(ctx, contractAddr)
+	if err != nil {
+		return false
+	}
+
+	return !bytes.Equal(txHash, cmtTypes.Tx(ctx.TxBytes()).Hash())
+}
+
+// trackFlatFeeBlockCharge marks the contract flat fee as charged by the current transaction within the current block.
+func (k Keeper) trackFlatFeeBlockCharge(ctx sdk.Context, contractAddr sdk.AccAddress) {
+	if err := k.FlatFeeBlockCharges.Set(ctx, contractAddr, cmtTypes.Tx(ctx.TxBytes()).Hash()); err != nil {
+		panic(err)
+	}
+}
+
+// isMempoolCheck returns true if the context is the CheckTx / ReCheckTx (mempool) one.
+func isMempoolCheck(ctx sdk.Context) bool {
+	return ctx.IsCheckTx() || ctx.IsReCheckTx()
+}
+
+// cleanupFlatFeeBlockCharges removes the contract flat fee charges tracked within the current block.
+func (k Keeper) cleanupFlatFeeBlockCharges(ctx sdk.Context) {
+	if err := k.FlatFeeBlockCharges.Clear(ctx, nil); err != nil {
+		panic(err)
+	}
+}
+
+// queueFlatFeePayout adds the flatfees of the given contract to the current block direct payouts.
+func (k Keeper) queueFlatFeePayout(ctx sdk.Context, contractAddr, rewardsAddr sdk.AccAddress, flatfees sdk.Coins) {
+	key := collections.Join(contractAddr.Bytes(), rewardsAddr.Bytes())
+
+	payout, err := k.FlatFeePayouts.Get(ctx, key)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		payout = types.ContractRewards{ContractAddress: contractAddr.String()}
+	case err != nil:
+		panic(err)
+	}
+	payout.Rewards = sdk.Coins(payout.Rewards).Add(flatfees...)
+
+	if err := k.FlatFeePayouts.Set(ctx, key, payout); err != nil {
+		panic(err)
+	}
+}
+
+// payoutFlatFees transfers the flat fees queued within the current block to the contracts rewards addresses.
+func (k Keeper) payoutFlatFees(ctx sdk.Context) {
+	iter, err := k.FlatFeePayouts.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+	payouts, err := iter.KeyValues()
+	if err != nil {
+		panic(err)
+	}
+
+	// Keys are sorted (contract address, rewards address), so the x/bank operations order is deterministic
+	for _, payout := range payouts {
+		rewardsAddr := sdk.AccAddress(payout.Key.K2())
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ContractRewardCollector, rewardsAddr, payout.Value.Rewards); err != nil {
+			panic(fmt.Errorf("failed to pay out flat fees (%s) of contract (%s) to %s: %w", sdk.Coins(payout.Value.Rewards), payout.Value.ContractAddress, rewardsAddr, err))
+		}
+	}
+
+	if err := k.FlatFeePayouts.Clear(ctx, nil); err != nil {
+		panic(err)
+	}
+}
+
+// getFlatFeePayoutsTotal returns the total flat fees queued for the direct payout within the current block.
+func (k Keeper) getFlatFeePayoutsTotal(ctx sdk.Context) sdk.Coins {
+	total := sdk.NewCoins()
+	err := k.FlatFeePayouts.Walk(ctx, nil, func(_ collections.Pair[[]byte, []byte], payout types.ContractRewards) (bool, error) {
+		total = total.Add(payout.Rewards...)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return total
+}
+
+// sweepFlatFeePayouts sends the flat fees queued for the contract direct payout within the current block
+// to the given address and removes the queued payouts.
+func (k Keeper) sweepFlatFeePayouts(ctx sdk.Context, contractAddr, sweepAddr sdk.AccAddress) (sdk.Coins, error) {
+	rng := collections.NewPrefixedPairRange[[]byte, []byte](contractAddr.Bytes())
+
+	totalFees := sdk.NewCoins()
+	err := k.FlatFeePayouts.Walk(ctx, rng, func(_ collections.Pair[[]byte, []byte], payout types.ContractRewards) (bool, error) {
+		totalFees = totalFees.Add(payout.Rewards...)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !totalFees.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ContractRewardCollector, sweepAddr, totalFees); err != nil {
+			return nil, errorsmod.Wrapf(types.ErrInternal, "sending flat fees (%s) to the sweep address (%s): %v", totalFees, sweepAddr, err)
+		}
+	}
+
+	if err := k.FlatFeePayouts.Clear(ctx, rng); err != nil {
+		return nil, err
+	}
+
+	return totalFees, nil
+}
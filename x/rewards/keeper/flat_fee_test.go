@@ -3,7 +3,10 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	mintTypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	"github.com/stretchr/testify/require"
 
 	e2eTesting "github.com/archway-network/archway/e2e/testing"
@@ -20,6 +23,24 @@ func TestSetFlatFee(t *testing.T) {
 	contractAddr := e2eTesting.GenContractAddresses(1)[0]
 	fee := sdk.NewInt64Coin("test", 10)
 
+	t.Run("Fail: non-existing contract", func(t *testing.T) {
+		err := k.SetFlatFee(ctx, contractAdminAcc, rewardsTypes.FlatFee{
+			ContractAddress: contractAddr.String(),
+			FlatFee:         fee,
+		})
+		require.ErrorIs(t, err, rewardsTypes.ErrFlatFeeNotContract)
+	})
+
+	t.Run("Fail: plain account address", func(t *testing.T) {
+		err := k.SetFlatFee(ctx, contractAdminAcc, rewardsTypes.FlatFee{
+			ContractAddress: contractAdminAcc.String(),
+			FlatFee:         fee,
+		})
+		require.ErrorIs(t, err, rewardsTypes.ErrFlatFeeNotContract)
+	})
+
+	wk.AddContractAdmin(contractAddr.String(), contractAdminAcc.String())
+
 	t.Run("Fail: non-existing contract metadata", func(t *testing.T) {
 		err := k.SetFlatFee(ctx, contractAdminAcc, rewardsTypes.FlatFee{
 			ContractAddress: contractAddr.String(),
@@ -28,7 +49,6 @@ func TestSetFlatFee(t *testing.T) {
 		require.ErrorIs(t, err, rewardsTypes.ErrMetadataNotFound)
 	})
 
-	wk.AddContractAdmin(contractAddr.String(), contractAdminAcc.String())
 	var metaCurrent rewardsTypes.ContractMetadata
 	metaCurrent.ContractAddress = contractAddr.String()
 	metaCurrent.OwnerAddress = contractAdminAcc.String()
@@ -71,3 +91,839 @@ func TestSetFlatFee(t *testing.T) {
 		require.Equal(t, sdk.Coin{}, flatFee)
 	})
 }
+
+func TestGetFlatFees(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	ctx = ctx.WithBlockHeight(150)
+
+	contractAddrs := e2eTesting.GenContractAddresses(4)
+	require.NoError(t, k.FlatFees.Set(ctx, contractAddrs[0], sdk.NewInt64Coin("test", 100)))
+	require.NoError(t, k.FlatFees.Set(ctx, contractAddrs[2], sdk.NewInt64Coin("test", 200)))
+	require.NoError(t, k.FlatFeeSchedules.Set(ctx, contractAddrs[2], rewardsTypes.FlatFeeSchedule{
+		StartHeight: 100,
+		EndHeight:   200,
+		StartFee:    sdk.NewInt64Coin("test", 0),
+		EndFee:      sdk.NewInt64Coin("test", 200),
+	}))
+	require.NoError(t, k.FlatFees.Set(ctx, contractAddrs[3], sdk.NewInt64Coin("test", 300)))
+	require.NoError(t, k.FlatFeeSchedules.Set(ctx, contractAddrs[3], rewardsTypes.FlatFeeSchedule{
+		StartHeight: 200,
+		EndHeight:   300,
+		StartFee:    sdk.NewInt64Coin("test", 0),
+		EndFee:      sdk.NewInt64Coin("test", 300),
+	}))
+	// contractAddrs[1] has no flat fee, contractAddrs[3] schedule has not started yet (zero fee)
+
+	fees := k.GetFlatFees(ctx, contractAddrs)
+	require.Equal(t, map[string]sdk.Coin{
+		contractAddrs[0].String(): sdk.NewInt64Coin("test", 100),
+		contractAddrs[2].String(): sdk.NewInt64Coin("test", 100),
+	}, fees)
+
+	require.Empty(t, k.GetFlatFees(ctx, nil))
+}
+
+func TestSetFlatFeeUpdateInterval(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	wk := testutils.NewMockContractViewer()
+	k.SetContractInfoViewer(wk)
+	contractAdminAcc := testutils.AccAddress()
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+
+	wk.AddContractAdmin(contractAddr.String(), contractAdminAcc.String())
+	err := k.SetContractMetadata(ctx, contractAdminAcc, contractAddr, rewardsTypes.ContractMetadata{
+		ContractAddress: contractAddr.String(),
+		OwnerAddress:    contractAdminAcc.String(),
+		RewardsAddress:  contractAdminAcc.String(),
+	})
+	require.NoError(t, err)
+
+	params := k.GetParams(ctx)
+	params.FlatFeeUpdateInterval = 10
+	require.NoError(t, k.Params.Set(ctx, params))
+
+	ctx = ctx.WithBlockHeight(100)
+	setFlatFee := func(ctx sdk.Context, amount int64) error {
+		return k.SetFlatFee(ctx, contractAdminAcc, rewardsTypes.FlatFee{
+			ContractAddress: contractAddr.String(),
+			FlatFee:         sdk.NewInt64Coin("test", amount),
+		})
+	}
+
+	t.Run("OK: first update", func(t *testing.T) {
+		require.NoError(t, setFlatFee(ctx, 10))
+	})
+
+	t.Run("Fail: update within the interval", func(t *testing.T) {
+		err := setFlatFee(ctx.WithBlockHeight(109), 20)
+		require.ErrorIs(t, err, rewardsTypes.ErrFlatFeeUpdateTooSoon)
+		require.ErrorContains(t, err, "next update is allowed at height 110")
+
+		flatFee, ok := k.GetFlatFee(ctx, contractAddr)
+		require.True(t, ok)
+		require.Equal(t, sdk.NewInt64Coin("test", 10), flatFee)
+	})
+
+	t.Run("OK: update after the interval", func(t *testing.T) {
+		require.NoError(t, setFlatFee(ctx.WithBlockHeight(110), 20))
+
+		flatFee, ok := k.GetFlatFee(ctx, contractAddr)
+		require.True(t, ok)
+		require.Equal(t, sdk.NewInt64Coin("test", 20), flatFee)
+	})
+
+	t.Run("Fail: removal is rate-limited as well", func(t *testing.T) {
+		err := setFlatFee(ctx.WithBlockHeight(115), 0)
+		require.ErrorIs(t, err, rewardsTypes.ErrFlatFeeUpdateTooSoon)
+	})
+}
+
+func TestSetMethodFlatFee(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	wk := testutils.NewMockContractViewer()
+	k.SetContractInfoViewer(wk)
+	contractAdminAcc := testutils.AccAddress()
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+
+	wk.AddContractAdmin(contractAddr.String(), contractAdminAcc.String())
+	err := k.SetContractMetadata(ctx, contractAdminAcc, contractAddr, rewardsTypes.ContractMetadata{
+		ContractAddress: contractAddr.String(),
+		OwnerAddress:    contractAdminAcc.String(),
+		RewardsAddress:  contractAdminAcc.String(),
+	})
+	require.NoError(t, err)
+
+	contractFee, mintFee := sdk.NewInt64Coin("test", 10), sdk.NewInt64Coin("test", 50)
+	require.NoError(t, k.SetFlatFee(ctx, contractAdminAcc, rewardsTypes.FlatFee{
+		ContractAddress: contractAddr.String(),
+		FlatFee:         contractFee,
+	}))
+
+	t.Run("Fail: schedule for a method flat fee", func(t *testing.T) {
+		err := k.SetFlatFee(ctx, contractAdminAcc, rewardsTypes.FlatFee{
+			ContractAddress: contractAddr.String(),
+			FlatFee:         mintFee,
+			Method:          "mint",
+			Schedule: &rewardsTypes.FlatFeeSchedule{
+				StartHeight: 1,
+				EndHeight:   10,
+				StartFee:    contractFee,
+				EndFee:      mintFee,
+			},
+		})
+		require.ErrorIs(t, err, rewardsTypes.ErrInvalidRequest)
+	})
+
+	t.Run("Fail: invalid method", func(t *testing.T) {
+		err := k.SetFlatFee(ctx, contractAdminAcc, rewardsTypes.FlatFee{
+			ContractAddress: contractAddr.String(),
+			FlatFee:         mintFee,
+			Method:          " mint",
+		})
+		require.ErrorIs(t, err, rewardsTypes.ErrInvalidRequest)
+	})
+
+	t.Run("OK: set method flat fee", func(t *testing.T) {
+		require.NoError(t, k.SetFlatFee(ctx, contractAdminAcc, rewardsTypes.FlatFee{
+			ContractAddress: contractAddr.String(),
+			FlatFee:         mintFee,
+			Method:          "mint",
+		}))
+
+		fee, ok := k.GetMethodFlatFee(ctx, contractAddr, "mint")
+		require.True(t, ok)
+		require.Equal(t, mintFee, fee)
+
+		_, ok = k.GetMethodFlatFee(ctx, contractAddr, "transfer")
+		require.False(t, ok)
+
+		// Contract-wide flat fee is not affected
+		fee, ok = k.GetFlatFee(ctx, contractAddr)
+		require.True(t, ok)
+		require.Equal(t, contractFee, fee)
+	})
+
+	t.Run("OK: genesis export / import", func(t *testing.T) {
+		genesis := k.ExportGenesis(ctx)
+		require.Len(t, genesis.FlatFees, 2)
+
+		k2, ctx2, _ := testutils.RewardsKeeper(t)
+		k2.InitGenesis(ctx2, genesis)
+
+		fee, ok := k2.GetMethodFlatFee(ctx2, contractAddr, "mint")
+		require.True(t, ok)
+		require.Equal(t, mintFee, fee)
+		fee, ok = k2.GetFlatFee(ctx2, contractAddr)
+		require.True(t, ok)
+		require.Equal(t, contractFee, fee)
+	})
+
+	t.Run("OK: remove method flat fee", func(t *testing.T) {
+		require.NoError(t, k.SetFlatFee(ctx, contractAdminAcc, rewardsTypes.FlatFee{
+			ContractAddress: contractAddr.String(),
+			FlatFee:         sdk.NewInt64Coin("test", 0),
+			Method:          "mint",
+		}))
+
+		_, ok := k.GetMethodFlatFee(ctx, contractAddr, "mint")
+		require.False(t, ok)
+
+		_, ok = k.GetFlatFee(ctx, contractAddr)
+		require.True(t, ok)
+	})
+
+	t.Run("OK: method flat fees are removed with the contract metadata", func(t *testing.T) {
+		require.NoError(t, k.SetFlatFee(ctx, contractAdminAcc, rewardsTypes.FlatFee{
+			ContractAddress: contractAddr.String(),
+			FlatFee:         mintFee,
+			Method:          "mint",
+		}))
+
+		_, err := k.RemoveContractMetadata(ctx, contractAdminAcc, contractAddr, nil)
+		require.NoError(t, err)
+
+		_, ok := k.GetMethodFlatFee(ctx, contractAddr, "mint")
+		require.False(t, ok)
+	})
+}
+
+func TestSetFlatFeeSchedule(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	wk := testutils.NewMockContractViewer()
+	k.SetContractInfoViewer(wk)
+	contractAdminAcc := testutils.AccAddress()
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+
+	wk.AddContractAdmin(contractAddr.String(), contractAdminAcc.String())
+	err := k.SetContractMetadata(ctx, contractAdminAcc, contractAddr, rewardsTypes.ContractMetadata{
+		ContractAddress: contractAddr.String(),
+		OwnerAddress:    contractAdminAcc.String(),
+		RewardsAddress:  contractAdminAcc.String(),
+	})
+	require.NoError(t, err)
+
+	schedule := rewardsTypes.FlatFeeSchedule{
+		StartHeight: 100,
+		EndHeight:   200,
+		StartFee:    sdk.NewInt64Coin("test", 1000),
+		EndFee:      sdk.NewInt64Coin("test", 100),
+	}
+
+	t.Run("Fail: schedule end fee mismatch", func(t *testing.T) {
+		err := k.SetFlatFee(ctx, contractAdminAcc, rewardsTypes.FlatFee{
+			ContractAddress: contractAddr.String(),
+			FlatFee:         sdk.NewInt64Coin("test", 200),
+			Schedule:        &schedule,
+		})
+		require.ErrorIs(t, err, rewardsTypes.ErrInvalidRequest)
+	})
+
+	t.Run("OK: set flat fee with schedule", func(t *testing.T) {
+		err := k.SetFlatFee(ctx, contractAdminAcc, rewardsTypes.FlatFee{
+			ContractAddress: contractAddr.String(),
+			FlatFee:         schedule.EndFee,
+			Schedule:        &schedule,
+		})
+		require.NoError(t, err)
+
+		scheduleReceived, found := k.GetFlatFeeSchedule(ctx, contractAddr)
+		require.True(t, found)
+		require.Equal(t, schedule, scheduleReceived)
+	})
+
+	t.Run("OK: fee at the schedule start", func(t *testing.T) {
+		flatFee, ok := k.GetFlatFee(ctx.WithBlockHeight(100), contractAddr)
+		require.True(t, ok)
+		require.Equal(t, sdk.NewInt64Coin("test", 1000), flatFee)
+	})
+
+	t.Run("OK: fee at the schedule midpoint", func(t *testing.T) {
+		flatFee, ok := k.GetFlatFee(ctx.WithBlockHeight(150), contractAddr)
+		require.True(t, ok)
+		require.Equal(t, sdk.NewInt64Coin("test", 550), flatFee)
+	})
+
+	t.Run("OK: fee at the schedule end", func(t *testing.T) {
+		flatFee, ok := k.GetFlatFee(ctx.WithBlockHeight(200), contractAddr)
+		require.True(t, ok)
+		require.Equal(t, sdk.NewInt64Coin("test", 100), flatFee)
+
+		flatFee, ok = k.GetFlatFee(ctx.WithBlockHeight(1000), contractAddr)
+		require.True(t, ok)
+		require.Equal(t, sdk.NewInt64Coin("test", 100), flatFee)
+	})
+
+	t.Run("OK: zero fee at the schedule start is not charged", func(t *testing.T) {
+		growingSchedule := rewardsTypes.FlatFeeSchedule{
+			StartHeight: 100,
+			EndHeight:   200,
+			StartFee:    sdk.NewInt64Coin("test", 0),
+			EndFee:      sdk.NewInt64Coin("test", 100),
+		}
+		err := k.SetFlatFee(ctx, contractAdminAcc, rewardsTypes.FlatFee{
+			ContractAddress: contractAddr.String(),
+			FlatFee:         growingSchedule.EndFee,
+			Schedule:        &growingSchedule,
+		})
+		require.NoError(t, err)
+
+		_, ok := k.GetFlatFee(ctx.WithBlockHeight(100), contractAddr)
+		require.False(t, ok)
+
+		flatFee, ok := k.GetFlatFee(ctx.WithBlockHeight(150), contractAddr)
+		require.True(t, ok)
+		require.Equal(t, sdk.NewInt64Coin("test", 50), flatFee)
+	})
+
+	t.Run("OK: update without schedule removes it", func(t *testing.T) {
+		err := k.SetFlatFee(ctx, contractAdminAcc, rewardsTypes.FlatFee{
+			ContractAddress: contractAddr.String(),
+			FlatFee:         sdk.NewInt64Coin("test", 10),
+		})
+		require.NoError(t, err)
+
+		_, found := k.GetFlatFeeSchedule(ctx, contractAddr)
+		require.False(t, found)
+
+		flatFee, ok := k.GetFlatFee(ctx.WithBlockHeight(100), contractAddr)
+		require.True(t, ok)
+		require.Equal(t, sdk.NewInt64Coin("test", 10), flatFee)
+	})
+}
+
+func TestSetFlatFeeMinConsFeeMultiplier(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	wk := testutils.NewMockContractViewer()
+	k.SetContractInfoViewer(wk)
+	contractAdminAcc := testutils.AccAddress()
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+
+	wk.AddContractAdmin(contractAddr.String(), contractAdminAcc.String())
+	err := k.SetContractMetadata(ctx, contractAdminAcc, contractAddr, rewardsTypes.ContractMetadata{
+		ContractAddress: contractAddr.String(),
+		OwnerAddress:    contractAdminAcc.String(),
+		RewardsAddress:  contractAdminAcc.String(),
+	})
+	require.NoError(t, err)
+
+	setMinConsFee := func(amount string) {
+		require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{
+			Fees: sdk.NewDecCoins(sdk.NewDecCoinFromDec("test", math.LegacyMustNewDecFromStr(amount))),
+		}))
+	}
+
+	t.Run("Fail: multiplier with schedule", func(t *testing.T) {
+		err := k.SetFlatFee(ctx, contractAdminAcc, rewardsTypes.FlatFee{
+			ContractAddress: contractAddr.String(),
+			FlatFee:         sdk.NewInt64Coin("test", 100),
+			Schedule: &rewardsTypes.FlatFeeSchedule{
+				StartHeight: 100,
+				EndHeight:   200,
+				StartFee:    sdk.NewInt64Coin("test", 10),
+				EndFee:      sdk.NewInt64Coin("test", 100),
+			},
+			MinConsFeeMultiplier: math.LegacyNewDec(1000),
+		})
+		require.ErrorIs(t, err, rewardsTypes.ErrInvalidRequest)
+	})
+
+	t.Run("Fail: multiplier for a method", func(t *testing.T) {
+		err := k.SetFlatFee(ctx, contractAdminAcc, rewardsTypes.FlatFee{
+			ContractAddress:      contractAddr.String(),
+			FlatFee:              sdk.NewInt64Coin("test", 100),
+			Method:               "transfer",
+			MinConsFeeMultiplier: math.LegacyNewDec(1000),
+		})
+		require.ErrorIs(t, err, rewardsTypes.ErrInvalidRequest)
+	})
+
+	t.Run("Fail: negative multiplier", func(t *testing.T) {
+		err := k.SetFlatFee(ctx, contractAdminAcc, rewardsTypes.FlatFee{
+			ContractAddress:      contractAddr.String(),
+			FlatFee:              sdk.NewInt64Coin("test", 100),
+			MinConsFeeMultiplier: math.LegacyNewDec(-1),
+		})
+		require.ErrorIs(t, err, rewardsTypes.ErrInvalidRequest)
+	})
+
+	t.Run("OK: set flat fee with multiplier", func(t *testing.T) {
+		err := k.SetFlatFee(ctx, contractAdminAcc, rewardsTypes.FlatFee{
+			ContractAddress:      contractAddr.String(),
+			FlatFee:              sdk.NewInt64Coin("test", 100),
+			MinConsFeeMultiplier: math.LegacyNewDec(1000),
+		})
+		require.NoError(t, err)
+
+		multiplier, found := k.GetFlatFeeMultiplier(ctx, contractAddr)
+		require.True(t, found)
+		require.Equal(t, math.LegacyNewDec(1000), multiplier)
+	})
+
+	t.Run("OK: min flat fee is charged without the min consensus fee", func(t *testing.T) {
+		flatFee, ok := k.GetFlatFee(ctx, contractAddr)
+		require.True(t, ok)
+		require.Equal(t, sdk.NewInt64Coin("test", 100), flatFee)
+	})
+
+	t.Run("OK: min flat fee is charged for a low min consensus fee", func(t *testing.T) {
+		setMinConsFee("0.05")
+
+		flatFee, ok := k.GetFlatFee(ctx, contractAddr)
+		require.True(t, ok)
+		require.Equal(t, sdk.NewInt64Coin("test", 100), flatFee)
+	})
+
+	t.Run("OK: fee follows the min consensus fee", func(t *testing.T) {
+		setMinConsFee("0.5")
+
+		flatFee, ok := k.GetFlatFee(ctx, contractAddr)
+		require.True(t, ok)
+		require.Equal(t, sdk.NewInt64Coin("test", 500), flatFee)
+
+		setMinConsFee("2.0001")
+
+		flatFee, ok = k.GetFlatFee(ctx, contractAddr)
+		require.True(t, ok)
+		require.Equal(t, sdk.NewInt64Coin("test", 2001), flatFee) // rounded up
+	})
+
+	t.Run("OK: update without multiplier removes it", func(t *testing.T) {
+		err := k.SetFlatFee(ctx, contractAdminAcc, rewardsTypes.FlatFee{
+			ContractAddress: contractAddr.String(),
+			FlatFee:         sdk.NewInt64Coin("test", 100),
+		})
+		require.NoError(t, err)
+
+		_, found := k.GetFlatFeeMultiplier(ctx, contractAddr)
+		require.False(t, found)
+
+		flatFee, ok := k.GetFlatFee(ctx, contractAddr)
+		require.True(t, ok)
+		require.Equal(t, sdk.NewInt64Coin("test", 100), flatFee)
+	})
+}
+
+func TestSetFlatFeeByCodeID(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	wk := testutils.NewMockContractViewer()
+	k.SetContractInfoViewer(wk)
+	contractAdminAcc := testutils.AccAddress()
+
+	// Code ID 1: 3 contracts with metadata (one without a rewards address) and 1 contract without metadata
+	// Code ID 2: 1 contract with metadata
+	contractAddrs := e2eTesting.GenContractAddresses(5)
+	for i, contractAddr := range contractAddrs {
+		codeID := uint64(1)
+		if i == 4 {
+			codeID = 2
+		}
+		wk.AddContractAdmin(contractAddr.String(), contractAdminAcc.String())
+		wk.SetContractCodeID(contractAddr.String(), codeID)
+
+		if i == 3 {
+			continue
+		}
+		meta := rewardsTypes.ContractMetadata{
+			ContractAddress: contractAddr.String(),
+			OwnerAddress:    contractAdminAcc.String(),
+		}
+		if i != 2 {
+			meta.RewardsAddress = contractAdminAcc.String()
+		}
+		require.NoError(t, k.SetContractMetadata(ctx, contractAdminAcc, contractAddr, meta))
+	}
+
+	fee := sdk.NewInt64Coin("test", 10)
+	setMaxContracts := func(maxContracts uint64) {
+		params := k.GetParams(ctx)
+		params.MaxFlatFeeUpdateContracts = maxContracts
+		require.NoError(t, k.Params.Set(ctx, params))
+	}
+
+	t.Run("Fail: bulk updates disabled", func(t *testing.T) {
+		setMaxContracts(0)
+
+		_, err := k.SetFlatFeeByCodeID(ctx, 1, fee)
+		require.ErrorIs(t, err, rewardsTypes.ErrInvalidRequest)
+	})
+
+	t.Run("Fail: contracts limit exceeded", func(t *testing.T) {
+		setMaxContracts(2)
+
+		_, err := k.SetFlatFeeByCodeID(ctx, 1, fee)
+		require.ErrorIs(t, err, rewardsTypes.ErrInvalidRequest)
+		require.ErrorContains(t, err, "exceeds the limit (2)")
+
+		for _, contractAddr := range contractAddrs {
+			_, found := k.GetFlatFee(ctx, contractAddr)
+			require.False(t, found)
+		}
+	})
+
+	t.Run("OK: set flat fee for the code ID contracts", func(t *testing.T) {
+		setMaxContracts(3)
+
+		updated, err := k.SetFlatFeeByCodeID(ctx, 1, fee)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, updated)
+
+		for i, contractAddr := range contractAddrs {
+			flatFee, found := k.GetFlatFee(ctx, contractAddr)
+			if i < 2 {
+				require.True(t, found)
+				require.Equal(t, fee, flatFee)
+				continue
+			}
+			// No rewards address, no metadata or a different code ID
+			require.False(t, found)
+		}
+
+		codeFee, found := k.GetCodeFlatFee(ctx, 1)
+		require.True(t, found)
+		require.Equal(t, fee, codeFee)
+	})
+
+	t.Run("OK: remove flat fee for the code ID contracts", func(t *testing.T) {
+		updated, err := k.SetFlatFeeByCodeID(ctx, 1, sdk.NewInt64Coin("test", 0))
+		require.NoError(t, err)
+		require.EqualValues(t, 3, updated)
+
+		for _, contractAddr := range contractAddrs {
+			_, found := k.GetFlatFee(ctx, contractAddr)
+			require.False(t, found)
+		}
+
+		_, found := k.GetCodeFlatFee(ctx, 1)
+		require.False(t, found)
+	})
+
+	t.Run("OK: unknown code ID", func(t *testing.T) {
+		updated, err := k.SetFlatFeeByCodeID(ctx, 3, fee)
+		require.NoError(t, err)
+		require.EqualValues(t, 0, updated)
+	})
+
+	t.Run("OK: contracts migrated to a different code are skipped", func(t *testing.T) {
+		wk.SetContractCodeID(contractAddrs[0].String(), 3)
+
+		updated, err := k.SetFlatFeeByCodeID(ctx, 1, fee)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, updated)
+
+		_, found := k.GetFlatFee(ctx, contractAddrs[0])
+		require.False(t, found)
+		_, found = k.GetFlatFee(ctx, contractAddrs[1])
+		require.True(t, found)
+	})
+}
+
+func TestReconcileMigratedContractFlatFee(t *testing.T) {
+	type testCase struct {
+		name string
+		// Inputs
+		policy        rewardsTypes.FlatFeeMigrationPolicy
+		newCodeID     uint64 // code ID the contract is migrated to
+		newCodeHasFee bool   // the new code ID has a default flat fee
+		// Output expected
+		feeExpected sdk.Coin
+	}
+
+	ownerFee := sdk.NewInt64Coin("test", 10)
+	codeFee := sdk.NewInt64Coin("test", 25)
+
+	testCases := []testCase{
+		{
+			name:          "OK: keep policy",
+			policy:        rewardsTypes.FlatFeeMigrationPolicy_FLAT_FEE_MIGRATION_POLICY_KEEP,
+			newCodeID:     2,
+			newCodeHasFee: true,
+			feeExpected:   ownerFee,
+		},
+		{
+			name:          "OK: unspecified policy falls back to keep",
+			policy:        rewardsTypes.FlatFeeMigrationPolicy_FLAT_FEE_MIGRATION_POLICY_UNSPECIFIED,
+			newCodeID:     2,
+			newCodeHasFee: true,
+			feeExpected:   ownerFee,
+		},
+		{
+			name:          "OK: inherit policy",
+			policy:        rewardsTypes.FlatFeeMigrationPolicy_FLAT_FEE_MIGRATION_POLICY_INHERIT_CODE_ID,
+			newCodeID:     2,
+			newCodeHasFee: true,
+			feeExpected:   codeFee,
+		},
+		{
+			name:        "OK: inherit policy with no new code ID default",
+			policy:      rewardsTypes.FlatFeeMigrationPolicy_FLAT_FEE_MIGRATION_POLICY_INHERIT_CODE_ID,
+			newCodeID:   2,
+			feeExpected: ownerFee,
+		},
+		{
+			name:          "OK: inherit policy with the same code ID",
+			policy:        rewardsTypes.FlatFeeMigrationPolicy_FLAT_FEE_MIGRATION_POLICY_INHERIT_CODE_ID,
+			newCodeID:     1,
+			newCodeHasFee: true,
+			feeExpected:   ownerFee,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx, _ := testutils.RewardsKeeper(t)
+			wk := testutils.NewMockContractViewer()
+			k.SetContractInfoViewer(wk)
+			contractAdminAcc := testutils.AccAddress()
+			contractAddr := e2eTesting.GenContractAddresses(1)[0]
+
+			params := k.GetParams(ctx)
+			params.FlatFeeMigrationPolicy = tc.policy
+			require.NoError(t, k.Params.Set(ctx, params))
+
+			wk.AddContractAdmin(contractAddr.String(), contractAdminAcc.String())
+			wk.SetContractCodeID(contractAddr.String(), 1)
+			require.NoError(t, k.SetContractMetadata(ctx, contractAdminAcc, contractAddr, rewardsTypes.ContractMetadata{
+				ContractAddress: contractAddr.String(),
+				OwnerAddress:    contractAdminAcc.String(),
+				RewardsAddress:  contractAdminAcc.String(),
+			}))
+			require.NoError(t, k.SetFlatFee(ctx, contractAdminAcc, rewardsTypes.FlatFee{
+				ContractAddress: contractAddr.String(),
+				FlatFee:         ownerFee,
+			}))
+			if tc.newCodeHasFee {
+				require.NoError(t, k.CodeFlatFees.Set(ctx, tc.newCodeID, codeFee))
+			}
+
+			// Migrate the contract
+			wk.SetContractCodeID(contractAddr.String(), tc.newCodeID)
+			require.NoError(t, k.ReconcileMigratedContractFlatFee(ctx, contractAddr))
+
+			fee, found := k.GetFlatFee(ctx, contractAddr)
+			require.True(t, found)
+			require.Equal(t, tc.feeExpected, fee)
+
+			codeID, err := k.ContractCodeIDs.Get(ctx, contractAddr)
+			require.NoError(t, err)
+			require.Equal(t, tc.newCodeID, codeID)
+		})
+	}
+}
+
+func TestFlatFeePayouts(t *testing.T) {
+	chain := e2eTesting.NewTestChain(t, 1)
+	keepers := chain.GetApp().Keepers
+	k := keepers.RewardsKeeper
+	ctx := chain.GetContext().WithBlockTime(chain.GetBlockTime())
+
+	contractAddrs := e2eTesting.GenContractAddresses(3)
+	pooledContractAddr, directContractAddr, noMetaContractAddr := contractAddrs[0], contractAddrs[1], contractAddrs[2]
+	pooledRewardsAddr, directRewardsAddr := testutils.AccAddress(), testutils.AccAddress()
+	for _, meta := range []rewardsTypes.ContractMetadata{
+		{
+			ContractAddress: pooledContractAddr.String(),
+			OwnerAddress:    pooledRewardsAddr.String(),
+			RewardsAddress:  pooledRewardsAddr.String(),
+		},
+		{
+			ContractAddress:     directContractAddr.String(),
+			OwnerAddress:        directRewardsAddr.String(),
+			RewardsAddress:      directRewardsAddr.String(),
+			FlatFeeDirectPayout: true,
+		},
+	} {
+		require.NoError(t, k.ContractMetadata.Set(ctx, meta.MustGetContractAddress(), meta))
+	}
+
+	// Emulate the flat fees collected by the DeductFeeDecorator
+	flatFee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	collectFlatFee := func(contractAddr sdk.AccAddress) {
+		require.NoError(t, keepers.BankKeeper.MintCoins(ctx, mintTypes.ModuleName, flatFee))
+		require.NoError(t, keepers.BankKeeper.SendCoinsFromModuleToModule(ctx, mintTypes.ModuleName, rewardsTypes.ContractRewardCollector, flatFee))
+		require.True(t, k.CreateFlatFeeRewardsRecords(ctx, contractAddr, flatFee))
+	}
+
+	t.Run("OK: flat fees of a contract without metadata are not attributed", func(t *testing.T) {
+		require.False(t, k.CreateFlatFeeRewardsRecords(ctx, noMetaContractAddr, flatFee))
+
+		records, err := k.GetRewardsRecordsByWithdrawAddress(ctx, noMetaContractAddr)
+		require.NoError(t, err)
+		require.Empty(t, records)
+	})
+
+	collectFlatFee(pooledContractAddr)
+	collectFlatFee(directContractAddr)
+	collectFlatFee(directContractAddr)
+
+	t.Run("OK: pooled flat fees are credited via rewards records", func(t *testing.T) {
+		records, err := k.GetContractRewardsRecords(ctx, pooledContractAddr)
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		require.Equal(t, pooledRewardsAddr.String(), records[0].RewardsAddress)
+		require.Equal(t, flatFee, sdk.Coins(records[0].Rewards))
+	})
+
+	t.Run("OK: direct flat fees are queued", func(t *testing.T) {
+		records, err := k.GetContractRewardsRecords(ctx, directContractAddr)
+		require.NoError(t, err)
+		require.Empty(t, records)
+
+		payout, err := k.FlatFeePayouts.Get(ctx, collections.Join(directContractAddr.Bytes(), directRewardsAddr.Bytes()))
+		require.NoError(t, err)
+		require.Equal(t, flatFee.Add(flatFee...), sdk.Coins(payout.Rewards))
+	})
+
+	// Next block is used to skip the current block rewards which are already distributed by the chain
+	nextHeight := ctx.BlockHeight() + 1
+	k.AllocateBlockRewards(ctx.WithBlockHeight(nextHeight), nextHeight)
+
+	t.Run("OK: direct flat fees are paid out at the block end", func(t *testing.T) {
+		require.Equal(t, flatFee.Add(flatFee...), keepers.BankKeeper.GetAllBalances(ctx, directRewardsAddr))
+
+		found, err := k.FlatFeePayouts.Has(ctx, collections.Join(directContractAddr.Bytes(), directRewardsAddr.Bytes()))
+		require.NoError(t, err)
+		require.False(t, found)
+	})
+
+	t.Run("OK: pooled flat fees stay in the pool", func(t *testing.T) {
+		require.True(t, keepers.BankKeeper.GetAllBalances(ctx, pooledRewardsAddr).IsZero())
+
+		records, err := k.GetContractRewardsRecords(ctx, pooledContractAddr)
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+	})
+}
+
+func TestFlatFeeBlockCharges(t *testing.T) {
+	chain := e2eTesting.NewTestChain(t, 1)
+	keepers := chain.GetApp().Keepers
+	k := keepers.RewardsKeeper
+	ctx := chain.GetContext().WithBlockTime(chain.GetBlockTime()).WithIsCheckTx(false)
+
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	rewardsAddr := testutils.AccAddress()
+	require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+		ContractAddress: contractAddr.String(),
+		OwnerAddress:    rewardsAddr.String(),
+		RewardsAddress:  rewardsAddr.String(),
+	}))
+
+	setOncePerBlock := func(enabled bool) {
+		params := k.GetParams(ctx)
+		params.FlatFeeOncePerBlock = enabled
+		require.NoError(t, k.Params.Set(ctx, params))
+	}
+
+	flatFee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	tx1Ctx, tx2Ctx := ctx.WithTxBytes([]byte("tx1")), ctx.WithTxBytes([]byte("tx2"))
+
+	t.Run("OK: disabled: charges are not tracked", func(t *testing.T) {
+		setOncePerBlock(false)
+		k.CreateFlatFeeRewardsRecords(tx1Ctx, contractAddr, flatFee)
+
+		require.False(t, k.IsFlatFeeChargedInBlock(tx2Ctx, contractAddr))
+	})
+
+	t.Run("OK: enabled: charge is tracked for other txs", func(t *testing.T) {
+		setOncePerBlock(true)
+		k.CreateFlatFeeRewardsRecords(tx1Ctx, contractAddr, flatFee)
+
+		require.False(t, k.IsFlatFeeChargedInBlock(tx1Ctx, contractAddr))
+		require.True(t, k.IsFlatFeeChargedInBlock(tx2Ctx, contractAddr))
+	})
+
+	t.Run("OK: enabled: charges are not tracked nor skipped in CheckTx", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		require.NoError(t, k.FlatFeeBlockCharges.Clear(ctx, nil))
+		tx1CheckCtx, tx2CheckCtx := ctx.WithTxBytes([]byte("tx1")).WithIsCheckTx(true), ctx.WithTxBytes([]byte("tx2")).WithIsCheckTx(true)
+
+		// CheckTx charge is not tracked (the mempool state is not reset between blocks)
+		k.CreateFlatFeeRewardsRecords(tx1CheckCtx, contractAddr, flatFee)
+		require.False(t, k.IsFlatFeeChargedInBlock(tx2CheckCtx, contractAddr))
+		require.False(t, k.IsFlatFeeChargedInBlock(ctx.WithTxBytes([]byte("tx2")), contractAddr))
+
+		// DeliverTx charge doesn't exempt txs in CheckTx / ReCheckTx
+		k.CreateFlatFeeRewardsRecords(ctx.WithTxBytes([]byte("tx1")), contractAddr, flatFee)
+		require.True(t, k.IsFlatFeeChargedInBlock(ctx.WithTxBytes([]byte("tx2")), contractAddr))
+		require.False(t, k.IsFlatFeeChargedInBlock(tx2CheckCtx, contractAddr))
+		require.False(t, k.IsFlatFeeChargedInBlock(tx2CheckCtx.WithIsCheckTx(false).WithIsReCheckTx(true), contractAddr))
+	})
+
+	// Next block is used to skip the current block rewards which are already distributed by the chain
+	nextHeight := ctx.BlockHeight() + 1
+	k.AllocateBlockRewards(ctx.WithBlockHeight(nextHeight), nextHeight)
+
+	t.Run("OK: charges are removed at the block end", func(t *testing.T) {
+		require.False(t, k.IsFlatFeeChargedInBlock(tx2Ctx, contractAddr))
+	})
+}
+
+func TestPrepayFlatFee(t *testing.T) {
+	chain := e2eTesting.NewTestChain(t, 1)
+	keepers := chain.GetApp().Keepers
+	k := keepers.RewardsKeeper
+	ctx := chain.GetContext().WithBlockTime(chain.GetBlockTime()).WithIsCheckTx(false)
+
+	ownerAddr := chain.GetAccount(0).Address
+	rewardsAddr := testutils.AccAddress()
+	contractAddr, noFeeContractAddr := e2eTesting.GenContractAddresses(2)[0], e2eTesting.GenContractAddresses(2)[1]
+	for _, addr := range []sdk.AccAddress{contractAddr, noFeeContractAddr} {
+		require.NoError(t, k.ContractMetadata.Set(ctx, addr, rewardsTypes.ContractMetadata{
+			ContractAddress: addr.String(),
+			OwnerAddress:    ownerAddr.String(),
+			RewardsAddress:  rewardsAddr.String(),
+		}))
+	}
+	require.NoError(t, k.FlatFees.Set(ctx, contractAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+
+	// 20% discount
+	params := k.GetParams(ctx)
+	params.FlatFeePrepayDiscount = 2000
+	require.NoError(t, k.Params.Set(ctx, params))
+
+	t.Run("Fail: not the contract owner", func(t *testing.T) {
+		_, _, err := k.PrepayFlatFee(ctx, rewardsAddr, contractAddr, 1)
+		require.ErrorIs(t, err, rewardsTypes.ErrUnauthorized)
+	})
+
+	t.Run("Fail: contract flat fee is not set", func(t *testing.T) {
+		_, _, err := k.PrepayFlatFee(ctx, ownerAddr, noFeeContractAddr, 1)
+		require.ErrorIs(t, err, rewardsTypes.ErrInvalidRequest)
+	})
+
+	t.Run("Fail: zero executions", func(t *testing.T) {
+		_, _, err := k.PrepayFlatFee(ctx, ownerAddr, contractAddr, 0)
+		require.ErrorIs(t, err, rewardsTypes.ErrInvalidRequest)
+	})
+
+	t.Run("OK: executions are prepaid at the discounted fee", func(t *testing.T) {
+		ownerBalanceBefore := keepers.BankKeeper.GetBalance(ctx, ownerAddr, sdk.DefaultBondDenom)
+
+		paidFee, credits, err := k.PrepayFlatFee(ctx, ownerAddr, contractAddr, 3)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 240), paidFee)
+		require.EqualValues(t, 3, credits)
+		require.EqualValues(t, 3, k.GetFlatFeeCredits(ctx, contractAddr))
+
+		ownerBalanceAfter := keepers.BankKeeper.GetBalance(ctx, ownerAddr, sdk.DefaultBondDenom)
+		require.Equal(t, ownerBalanceBefore.Sub(paidFee), ownerBalanceAfter)
+
+		// Paid fee is distributed to the contract rewards address
+		records, _, err := k.GetRewardsRecords(ctx, rewardsAddr, nil)
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		require.Equal(t, "240stake", sdk.Coins(records[0].Rewards).String())
+	})
+
+	t.Run("OK: credits are consumed and exhausted", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			require.True(t, k.ConsumeFlatFeeCredit(ctx, contractAddr))
+		}
+		require.False(t, k.ConsumeFlatFeeCredit(ctx, contractAddr))
+		require.Zero(t, k.GetFlatFeeCredits(ctx, contractAddr))
+
+		has, err := k.FlatFeeCredits.Has(ctx, contractAddr)
+		require.NoError(t, err)
+		require.False(t, has)
+	})
+}
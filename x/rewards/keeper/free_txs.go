@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetRemainingFreeTxs returns the number of fee-free transactions the account could still send.
+func (k Keeper) GetRemainingFreeTxs(ctx sdk.Context, accAddr sdk.AccAddress) uint64 {
+	budget, used := k.FreeTxBudget(ctx), k.getFreeTxsUsed(ctx, accAddr)
+	if used >= budget {
+		return 0
+	}
+
+	return budget - used
+}
+
+// ConsumeFreeTx decrements the account fee-free transactions budget by one.
+// Returns false if the budget is exhausted (or disabled), the state is not changed in that case.
+func (k Keeper) ConsumeFreeTx(ctx sdk.Context, accAddr sdk.AccAddress) bool {
+	if k.GetRemainingFreeTxs(ctx, accAddr) == 0 {
+		return false
+	}
+
+	if err := k.FreeTxsUsed.Set(ctx, accAddr, k.getFreeTxsUsed(ctx, accAddr)+1); err != nil {
+		panic(err)
+	}
+
+	return true
+}
+
+// getFreeTxsUsed returns the number of fee-free transactions sent by the account.
+func (k Keeper) getFreeTxsUsed(ctx sdk.Context, accAddr sdk.AccAddress) uint64 {
+	used, err := k.FreeTxsUsed.Get(ctx, accAddr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return 0
+		}
+		panic(err)
+	}
+
+	return used
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/archway-network/archway/pkg"
@@ -9,7 +11,87 @@ import (
 
 // ExportGenesis exports the module genesis for the current block.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
-	minConsFee, _ := k.MinConsFee.Get(ctx) // default sdk.Coin value is ok
+	genesis := k.exportGenesisBase(ctx)
+
+	err := k.walkGenesisFlatFees(ctx, func(flatFee types.FlatFee) error {
+		genesis.FlatFees = append(genesis.FlatFees, flatFee)
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.RewardsRecords.Walk(ctx, nil, func(key uint64, value types.RewardsRecord) (stop bool, err error) {
+		genesis.RewardsRecords = append(genesis.RewardsRecords, value)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return genesis
+}
+
+// ExportGenesisStreaming exports the module genesis for the current block in chunks passed to the handler.
+// The first chunk holds the genesis state except for the flat fees and rewards records, those are exported by the
+// following chunks (up to chunkSize entries each), so that they are not held in memory at once.
+// Chunks combined (flat fees and rewards records appended in order) match the ExportGenesis state.
+func (k Keeper) ExportGenesisStreaming(ctx sdk.Context, chunkSize int, handler func(chunk *types.GenesisState) error) error {
+	if chunkSize <= 0 {
+		return errorsmod.Wrap(types.ErrInvalidRequest, "chunk size must be GT 0")
+	}
+
+	if err := handler(k.exportGenesisBase(ctx)); err != nil {
+		return err
+	}
+
+	chunk := &types.GenesisState{}
+	flushChunk := func() error {
+		if len(chunk.FlatFees) == 0 && len(chunk.RewardsRecords) == 0 {
+			return nil
+		}
+		if err := handler(chunk); err != nil {
+			return err
+		}
+		chunk = &types.GenesisState{}
+		return nil
+	}
+
+	err := k.walkGenesisFlatFees(ctx, func(flatFee types.FlatFee) error {
+		chunk.FlatFees = append(chunk.FlatFees, flatFee)
+		if len(chunk.FlatFees) < chunkSize {
+			return nil
+		}
+		return flushChunk()
+	})
+	if err != nil {
+		return err
+	}
+	if err := flushChunk(); err != nil {
+		return err
+	}
+
+	err = k.RewardsRecords.Walk(ctx, nil, func(_ uint64, value types.RewardsRecord) (stop bool, err error) {
+		chunk.RewardsRecords = append(chunk.RewardsRecords, value)
+		if len(chunk.RewardsRecords) < chunkSize {
+			return false, nil
+		}
+		return false, flushChunk()
+	})
+	if err != nil {
+		return err
+	}
+
+	return flushChunk()
+}
+
+// exportGenesisBase exports the module genesis for the current block except for the flat fees and rewards records.
+func (k Keeper) exportGenesisBase(ctx sdk.Context) *types.GenesisState {
+	// Genesis keeps a single min consensus fee since the fee is estimated for the inflation rewards denom only
+	var minConsFee sdk.DecCoin // default sdk.DecCoin value is ok
+	if minConsFees := k.GetMinConsensusFees(ctx); len(minConsFees) > 0 {
+		minConsFee = minConsFees[0]
+	}
 
 	var contractMetadata []types.ContractMetadata
 	err := k.ContractMetadata.Walk(ctx, nil, func(key []byte, value types.ContractMetadata) (stop bool, err error) {
@@ -20,11 +102,11 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
-	var flatFees []types.FlatFee
-	err = k.FlatFees.Walk(ctx, nil, func(key []byte, value sdk.Coin) (stop bool, err error) {
-		flatFees = append(flatFees, types.FlatFee{
+	var contractCodeIDs []types.ContractCodeID
+	err = k.ContractCodeIDs.Walk(ctx, nil, func(key []byte, value uint64) (stop bool, err error) {
+		contractCodeIDs = append(contractCodeIDs, types.ContractCodeID{
 			ContractAddress: sdk.AccAddress(key).String(),
-			FlatFee:         value,
+			CodeId:          value,
 		})
 		return false, nil
 	})
@@ -50,30 +132,90 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
-	var rewardsRecords []types.RewardsRecord
-	err = k.RewardsRecords.Walk(ctx, nil, func(key uint64, value types.RewardsRecord) (stop bool, err error) {
-		rewardsRecords = append(rewardsRecords, value)
-		return false, nil
-	})
-	if err != nil {
-		panic(err)
-	}
-
 	rewardsRecordLastID, err := k.RewardsRecordID.Peek(ctx)
 	if err != nil {
 		panic(err)
 	}
 
-	return types.NewGenesisState(
+	genesis := types.NewGenesisState(
 		k.GetParams(ctx),
 		contractMetadata,
 		blockRewards,
 		txRewards,
 		minConsFee,
 		rewardsRecordLastID,
-		rewardsRecords,
-		flatFees,
+		nil,
+		nil,
 	)
+	genesis.ContractCodeIds = contractCodeIDs
+
+	err = k.FlatFeeCredits.Walk(ctx, nil, func(key []byte, value uint64) (stop bool, err error) {
+		genesis.FlatFeeCredits = append(genesis.FlatFeeCredits, types.FlatFeeCredit{
+			ContractAddress: sdk.AccAddress(key).String(),
+			Executions:      value,
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.FlatFeeOverrides.Walk(ctx, nil, func(_ []byte, value types.FlatFeeOverride) (stop bool, err error) {
+		genesis.FlatFeeOverrides = append(genesis.FlatFeeOverrides, value)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.ScheduledRewardsRatios.Walk(ctx, nil, func(_ int64, value types.ScheduledRewardsRatios) (stop bool, err error) {
+		genesis.ScheduledRewardsRatios = append(genesis.ScheduledRewardsRatios, value)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.CodeFlatFees.Walk(ctx, nil, func(codeID uint64, value sdk.Coin) (stop bool, err error) {
+		genesis.CodeFlatFees = append(genesis.CodeFlatFees, types.CodeFlatFee{
+			CodeId:  codeID,
+			FlatFee: value,
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return genesis
+}
+
+// walkGenesisFlatFees iterates over the contract-wide (schedules and multipliers included) and the method flat fees genesis entries.
+func (k Keeper) walkGenesisFlatFees(ctx sdk.Context, fn func(flatFee types.FlatFee) error) error {
+	err := k.FlatFees.Walk(ctx, nil, func(key []byte, value sdk.Coin) (stop bool, err error) {
+		flatFee := types.FlatFee{
+			ContractAddress: sdk.AccAddress(key).String(),
+			FlatFee:         value,
+		}
+		if schedule, found := k.GetFlatFeeSchedule(ctx, key); found {
+			flatFee.Schedule = &schedule
+		}
+		if multiplier, found := k.GetFlatFeeMultiplier(ctx, key); found {
+			flatFee.MinConsFeeMultiplier = multiplier
+		}
+		return false, fn(flatFee)
+	})
+	if err != nil {
+		return err
+	}
+
+	return k.MethodFlatFees.Walk(ctx, nil, func(key collections.Pair[[]byte, string], value sdk.Coin) (stop bool, err error) {
+		return false, fn(types.FlatFee{
+			ContractAddress: sdk.AccAddress(key.K1()).String(),
+			FlatFee:         value,
+			Method:          key.K2(),
+		})
+	})
 }
 
 // InitGenesis initializes the module genesis state.
@@ -81,18 +223,78 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state *types.GenesisState) {
 	if err := k.Params.Set(ctx, state.Params); err != nil {
 		panic(err)
 	}
+	metadataCount := k.GetContractMetadataCount(ctx)
 	for _, contractMetadata := range state.ContractsMetadata {
-		err := k.ContractMetadata.Set(ctx, contractMetadata.MustGetContractAddress(), contractMetadata)
+		exists, err := k.ContractMetadata.Has(ctx, contractMetadata.MustGetContractAddress())
+		if err != nil {
+			panic(err)
+		}
+		if !exists {
+			metadataCount++
+		}
+
+		err = k.ContractMetadata.Set(ctx, contractMetadata.MustGetContractAddress(), contractMetadata)
+		if err != nil {
+			panic(err)
+		}
+	}
+	if err := k.ContractMetadataCount.Set(ctx, metadataCount); err != nil {
+		panic(err)
+	}
+
+	// Contract code IDs are imported since x/wasmd state is not initialized yet
+	for _, contractCodeID := range state.ContractCodeIds {
+		err := k.ContractCodeIDs.Set(ctx, contractCodeID.MustGetContractAddress(), contractCodeID.CodeId)
 		if err != nil {
 			panic(err)
 		}
 	}
 
 	for _, flatFee := range state.FlatFees {
+		if flatFee.Method != "" {
+			if err := k.MethodFlatFees.Set(ctx, collections.Join(flatFee.MustGetContractAddress().Bytes(), flatFee.Method), flatFee.FlatFee); err != nil {
+				panic(err)
+			}
+			continue
+		}
 		err := k.FlatFees.Set(ctx, flatFee.MustGetContractAddress(), flatFee.FlatFee)
 		if err != nil {
 			panic(err)
 		}
+		if flatFee.Schedule != nil {
+			if err := k.FlatFeeSchedules.Set(ctx, flatFee.MustGetContractAddress(), *flatFee.Schedule); err != nil {
+				panic(err)
+			}
+		}
+		if flatFee.HasMinConsFeeMultiplier() {
+			if err := k.FlatFeeMultipliers.Set(ctx, flatFee.MustGetContractAddress(), flatFee.MinConsFeeMultiplier); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	for _, credit := range state.FlatFeeCredits {
+		if err := k.FlatFeeCredits.Set(ctx, credit.MustGetContractAddress(), credit.Executions); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, override := range state.FlatFeeOverrides {
+		if err := k.FlatFeeOverrides.Set(ctx, override.MustGetContractAddress(), override); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, scheduled := range state.ScheduledRewardsRatios {
+		if err := k.ScheduledRewardsRatios.Set(ctx, scheduled.ActivationHeight, scheduled); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, codeFlatFee := range state.CodeFlatFees {
+		if err := k.CodeFlatFees.Set(ctx, codeFlatFee.CodeId, codeFlatFee.FlatFee); err != nil {
+			panic(err)
+		}
 	}
 
 	for _, blockReward := range state.BlockRewards {
@@ -121,7 +323,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state *types.GenesisState) {
 	}
 
 	if !pkg.DecCoinIsZero(state.MinConsensusFee) && !pkg.DecCoinIsNegative(state.MinConsensusFee) {
-		err := k.MinConsFee.Set(ctx, state.MinConsensusFee)
+		err := k.MinConsFee.Set(ctx, types.MinConsensusFees{Fees: sdk.NewDecCoins(state.MinConsensusFee)})
 		if err != nil {
 			panic(err)
 		}
@@ -1,9 +1,12 @@
 package keeper_test
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
+	"cosmossdk.io/collections"
 	math "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -32,6 +35,7 @@ func TestGenesisImportExport(t *testing.T) {
 		require.Empty(t, genesisState.RewardsRecordLastId)
 		require.Empty(t, genesisState.RewardsRecords)
 		require.Empty(t, genesisState.FlatFees)
+		require.Empty(t, genesisState.ContractCodeIds)
 
 		genesisStateInitial = *genesisState
 	})
@@ -112,6 +116,23 @@ func TestGenesisImportExport(t *testing.T) {
 		{
 			ContractAddress: contractAddrs[1].String(),
 			FlatFee:         sdk.NewCoin("uarch", math.NewInt(1)),
+			Schedule: &types.FlatFeeSchedule{
+				StartHeight: 10,
+				EndHeight:   20,
+				StartFee:    sdk.NewCoin("uarch", math.NewInt(10)),
+				EndFee:      sdk.NewCoin("uarch", math.NewInt(1)),
+			},
+		},
+	}
+
+	newContractCodeIDs := []types.ContractCodeID{
+		{
+			ContractAddress: contractAddrs[0].String(),
+			CodeId:          1,
+		},
+		{
+			ContractAddress: contractAddrs[1].String(),
+			CodeId:          2,
 		},
 	}
 
@@ -125,29 +146,112 @@ func TestGenesisImportExport(t *testing.T) {
 		newRewardsRecords,
 		newFlatFees,
 	)
+	genesisStateImported.ContractCodeIds = newContractCodeIDs
+	newScheduledRewardsRatios := []types.ScheduledRewardsRatios{
+		{
+			ActivationHeight:      ctx.BlockHeight() + 10,
+			InflationRewardsRatio: math.LegacyNewDecWithPrec(1, 1),
+			TxFeeRebateRatio:      math.LegacyNewDecWithPrec(2, 1),
+		},
+	}
+	genesisStateImported.ScheduledRewardsRatios = newScheduledRewardsRatios
+	newCodeFlatFees := []types.CodeFlatFee{
+		{
+			CodeId:  2,
+			FlatFee: sdk.NewCoin("uarch", math.NewInt(5)),
+		},
+	}
+	genesisStateImported.CodeFlatFees = newCodeFlatFees
 	t.Run("Check import of an updated genesis", func(t *testing.T) {
 		k.InitGenesis(ctx, genesisStateImported)
 
 		genesisStateExpected := types.GenesisState{
-			Params:              newParams,
-			ContractsMetadata:   append(genesisStateInitial.ContractsMetadata, newMetadata...),
-			BlockRewards:        append(genesisStateInitial.BlockRewards, newBlockRewards...),
-			TxRewards:           append(genesisStateInitial.TxRewards, newTxRewards...),
-			MinConsensusFee:     newMinConsFee,
-			RewardsRecordLastId: newRewardsRecords[len(newRewardsRecords)-1].Id,
-			RewardsRecords:      append(genesisStateInitial.RewardsRecords, newRewardsRecords...),
-			FlatFees:            append(genesisStateInitial.FlatFees, newFlatFees...),
+			Params:                 newParams,
+			ContractsMetadata:      append(genesisStateInitial.ContractsMetadata, newMetadata...),
+			BlockRewards:           append(genesisStateInitial.BlockRewards, newBlockRewards...),
+			TxRewards:              append(genesisStateInitial.TxRewards, newTxRewards...),
+			MinConsensusFee:        newMinConsFee,
+			RewardsRecordLastId:    newRewardsRecords[len(newRewardsRecords)-1].Id,
+			RewardsRecords:         append(genesisStateInitial.RewardsRecords, newRewardsRecords...),
+			FlatFees:               append(genesisStateInitial.FlatFees, newFlatFees...),
+			ContractCodeIds:        append(genesisStateInitial.ContractCodeIds, newContractCodeIDs...),
+			ScheduledRewardsRatios: newScheduledRewardsRatios,
+			CodeFlatFees:           newCodeFlatFees,
 		}
 
 		genesisStateReceived := k.ExportGenesis(ctx)
 		require.NotNil(t, genesisStateReceived)
 		require.Equal(t, genesisStateExpected.Params, genesisStateReceived.Params)
 		require.ElementsMatch(t, genesisStateExpected.ContractsMetadata, genesisStateReceived.ContractsMetadata)
+		require.EqualValues(t, len(genesisStateExpected.ContractsMetadata), k.GetContractMetadataCount(ctx))
 		require.ElementsMatch(t, genesisStateExpected.BlockRewards, genesisStateReceived.BlockRewards)
 		require.ElementsMatch(t, genesisStateExpected.TxRewards, genesisStateReceived.TxRewards)
 		require.Equal(t, genesisStateExpected.MinConsensusFee.String(), genesisStateReceived.MinConsensusFee.String())
 		require.Equal(t, genesisStateExpected.RewardsRecordLastId, genesisStateReceived.RewardsRecordLastId)
 		require.ElementsMatch(t, genesisStateExpected.RewardsRecords, genesisStateReceived.RewardsRecords)
 		require.ElementsMatch(t, genesisStateExpected.FlatFees, genesisStateReceived.FlatFees)
+		require.ElementsMatch(t, genesisStateExpected.ContractCodeIds, genesisStateReceived.ContractCodeIds)
+		require.ElementsMatch(t, genesisStateExpected.ScheduledRewardsRatios, genesisStateReceived.ScheduledRewardsRatios)
+		require.ElementsMatch(t, genesisStateExpected.CodeFlatFees, genesisStateReceived.CodeFlatFees)
 	})
 }
+
+// TestGenesisExportStreaming checks the chunked genesis export matches the standard one.
+func TestGenesisExportStreaming(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	contractAddrs := e2eTesting.GenContractAddresses(3)
+	accAddrs, _ := e2eTesting.GenAccounts(1)
+
+	require.NoError(t, k.ContractMetadata.Set(ctx, contractAddrs[0], types.ContractMetadata{
+		ContractAddress: contractAddrs[0].String(),
+		OwnerAddress:    accAddrs[0].String(),
+	}))
+	for i, contractAddr := range contractAddrs {
+		require.NoError(t, k.FlatFees.Set(ctx, contractAddr, sdk.NewInt64Coin("stake", int64(i+1))))
+	}
+	require.NoError(t, k.MethodFlatFees.Set(ctx, collections.Join(contractAddrs[0].Bytes(), "mint"), sdk.NewInt64Coin("stake", 10)))
+	for i := 0; i < 5; i++ {
+		_, err := k.CreateRewardsRecord(ctx, accAddrs[0], contractAddrs[0], sdk.NewCoins(sdk.NewInt64Coin("stake", int64(i+1))), ctx.BlockHeight(), ctx.BlockTime())
+		require.NoError(t, err)
+	}
+
+	t.Run("Fail: invalid chunk size", func(t *testing.T) {
+		err := k.ExportGenesisStreaming(ctx, 0, func(*types.GenesisState) error { return nil })
+		require.ErrorIs(t, err, types.ErrInvalidRequest)
+	})
+
+	t.Run("Fail: handler error is returned", func(t *testing.T) {
+		handlerErr := errors.New("handler error")
+		chunksNum := 0
+		err := k.ExportGenesisStreaming(ctx, 2, func(*types.GenesisState) error {
+			if chunksNum++; chunksNum == 2 {
+				return handlerErr
+			}
+			return nil
+		})
+		require.ErrorIs(t, err, handlerErr)
+		require.Equal(t, 2, chunksNum)
+	})
+
+	for _, chunkSize := range []int{1, 2, 100} {
+		t.Run(fmt.Sprintf("OK: chunk size %d", chunkSize), func(t *testing.T) {
+			var chunks []*types.GenesisState
+			err := k.ExportGenesisStreaming(ctx, chunkSize, func(chunk *types.GenesisState) error {
+				chunks = append(chunks, chunk)
+				return nil
+			})
+			require.NoError(t, err)
+
+			// Flat fees and rewards records chunks follow the base one
+			require.Len(t, chunks, 1+(4+chunkSize-1)/chunkSize+(5+chunkSize-1)/chunkSize)
+			merged := chunks[0]
+			for _, chunk := range chunks[1:] {
+				require.LessOrEqual(t, len(chunk.FlatFees)+len(chunk.RewardsRecords), chunkSize)
+				merged.FlatFees = append(merged.FlatFees, chunk.FlatFees...)
+				merged.RewardsRecords = append(merged.RewardsRecords, chunk.RewardsRecords...)
+			}
+
+			require.Equal(t, k.ExportGenesis(ctx), merged)
+		})
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 
 	math "cosmossdk.io/math"
+	wasmTypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -13,6 +15,9 @@ import (
 
 var _ types.QueryServer = &QueryServer{}
 
+// maxEstimateTxSize defines the max encoded tx size the tx size surcharge is estimated for.
+const maxEstimateTxSize = 1<<31 - 1
+
 // QueryServer implements the module gRPC query service.
 type QueryServer struct {
 	keeper Keeper
@@ -38,6 +43,45 @@ func (s *QueryServer) Params(c context.Context, request *types.QueryParamsReques
 	}, nil
 }
 
+// ParamsMetadata implements the types.QueryServer interface.
+func (s *QueryServer) ParamsMetadata(c context.Context, request *types.QueryParamsMetadataRequest) (*types.QueryParamsMetadataResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsMetadataResponse{
+		Params:   s.keeper.GetParams(ctx),
+		Metadata: types.ParamsMetadata(),
+	}, nil
+}
+
+// RewardsRatios implements the types.QueryServer interface.
+func (s *QueryServer) RewardsRatios(c context.Context, request *types.QueryRewardsRatiosRequest) (*types.QueryRewardsRatiosResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryRewardsRatiosResponse{
+		InflationRewardsRatio: s.keeper.InflationRewardsRatio(ctx),
+		TxFeeRebateRatio:      s.keeper.TxFeeRebateRatio(ctx),
+	}, nil
+}
+
+// DistributionConfig implements the types.QueryServer interface.
+func (s *QueryServer) DistributionConfig(c context.Context, request *types.QueryDistributionConfigRequest) (*types.QueryDistributionConfigResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryDistributionConfigResponse{
+		Config: s.keeper.GetDistributionConfig(sdk.UnwrapSDKContext(c)),
+	}, nil
+}
+
 // ContractMetadata implements the types.QueryServer interface.
 func (s *QueryServer) ContractMetadata(c context.Context, request *types.QueryContractMetadataRequest) (*types.QueryContractMetadataResponse, error) {
 	if request == nil {
@@ -89,6 +133,46 @@ func (s *QueryServer) BlockRewardsTracking(c context.Context, request *types.Que
 	}, nil
 }
 
+// BlockRewardsTrackingRange implements the types.QueryServer interface.
+func (s *QueryServer) BlockRewardsTrackingRange(c context.Context, request *types.QueryBlockRewardsTrackingRangeRequest) (*types.QueryBlockRewardsTrackingRangeResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if request.FromHeight <= 0 || request.ToHeight < request.FromHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height range: [%d, %d]", request.FromHeight, request.ToHeight)
+	}
+	if rangeLen := uint64(request.ToHeight-request.FromHeight) + 1; rangeLen > types.MaxBlockTrackingRangeQueryLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "height range length (%d) exceeds the limit (%d)", rangeLen, types.MaxBlockTrackingRangeQueryLimit)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	blocks := make([]types.BlockTracking, 0)
+	for height := request.FromHeight; height <= request.ToHeight; height++ {
+		blockRewards, err := s.keeper.BlockRewards.Get(ctx, uint64(height))
+		blockRewardsFound := err == nil
+
+		txRewards, err := s.keeper.GetTxRewardsByBlock(ctx, uint64(height))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "block rewards tracking for the block %d: %v", height, err)
+		}
+
+		if !blockRewardsFound && len(txRewards) == 0 {
+			continue
+		}
+
+		blocks = append(blocks, types.BlockTracking{
+			InflationRewards: blockRewards,
+			TxRewards:        txRewards,
+		})
+	}
+
+	return &types.QueryBlockRewardsTrackingRangeResponse{
+		Blocks: blocks,
+	}, nil
+}
+
 // RewardsPool implements the types.QueryServer interface.
 func (s *QueryServer) RewardsPool(c context.Context, request *types.QueryRewardsPoolRequest) (*types.QueryRewardsPoolResponse, error) {
 	if request == nil {
@@ -111,11 +195,10 @@ func (s *QueryServer) EstimateTxFees(c context.Context, request *types.QueryEsti
 
 	ctx := sdk.UnwrapSDKContext(c)
 
-	fees := sdk.NewCoins()
-	computationalPoG := s.keeper.ComputationalPriceOfGas(ctx)
-	fees = fees.Add(sdk.NewCoin(computationalPoG.Denom, computationalPoG.Amount.MulInt(math.NewIntFromUint64(request.GasLimit)).RoundInt()))
+	computationalPoG, gasFee := s.estimateGasFee(ctx, request.GasLimit)
+	fees := sdk.NewCoins(gasFee)
 
-	if request.ContractAddress != "" { // if contract address is passed in, get the flat fee and add that.
+	if request.ContractAddress != "" && s.keeper.FlatFeesEnabled(ctx) { // if contract address is passed in, get the flat fee and add that.
 		contractAddr, err := sdk.AccAddressFromBech32(request.ContractAddress)
 		if err != nil {
 			return nil, status.Error(codes.InvalidArgument, "invalid contract address: "+err.Error())
@@ -132,6 +215,378 @@ func (s *QueryServer) EstimateTxFees(c context.Context, request *types.QueryEsti
 	}, nil
 }
 
+// EstimateTxFeesForSimulatedGas implements the types.QueryServer interface.
+func (s *QueryServer) EstimateTxFeesForSimulatedGas(c context.Context, request *types.QueryEstimateTxFeesForSimulatedGasRequest) (*types.QueryEstimateTxFeesForSimulatedGasResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	gasAdjustment := request.GasAdjustment
+	if gasAdjustment.IsNil() || gasAdjustment.IsZero() {
+		gasAdjustment = math.LegacyOneDec()
+	}
+	adjustedGasLimit, err := types.AdjustedGasLimit(request.SimulatedGas, gasAdjustment)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	simulatedGasFees, _, _, err := s.estimateTxMinFee(ctx, request.SimulatedGas, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+	adjustedGasFees, _, _, err := s.estimateTxMinFee(ctx, adjustedGasLimit, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEstimateTxFeesForSimulatedGasResponse{
+		GasUnitPrice:     types.DiscountGasPrice(s.keeper.ComputationalPriceOfGas(ctx), s.keeper.FeePromotionDiscount(ctx)),
+		AdjustedGasLimit: adjustedGasLimit,
+		SimulatedGasFee:  simulatedGasFees,
+		AdjustedGasFee:   adjustedGasFees,
+	}, nil
+}
+
+// EstimateTxFeesForContracts implements the types.QueryServer interface.
+func (s *QueryServer) EstimateTxFeesForContracts(c context.Context, request *types.QueryEstimateTxFeesForContractsRequest) (*types.QueryEstimateTxFeesForContractsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// Tx size is bounded by the block max bytes (if limited), a tx can never be larger
+	maxTxSize := uint64(maxEstimateTxSize)
+	if blockParams := ctx.ConsensusParams().Block; blockParams != nil && blockParams.MaxBytes > 0 && uint64(blockParams.MaxBytes) < maxTxSize {
+		maxTxSize = uint64(blockParams.MaxBytes)
+	}
+	if request.TxSize > maxTxSize {
+		return nil, status.Errorf(codes.InvalidArgument, "tx size %d exceeds the max allowed value %d", request.TxSize, maxTxSize)
+	}
+
+	gasFees, sizeFees, contractFlatFees, err := s.estimateTxMinFee(ctx, request.GasLimit, int(request.TxSize), request.ContractAddresses)
+	if err != nil {
+		return nil, err
+	}
+	flatFees := sdk.NewCoins()
+	for _, contractFlatFee := range contractFlatFees {
+		flatFees = flatFees.Add(contractFlatFee.FlatFee)
+	}
+
+	// Combined the same way the MinFeeDecorator does (the size surcharge is a part of the gas fees there)
+	fees, err := types.MinTxFees(gasFees.Add(sizeFees...), flatFees)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEstimateTxFeesForContractsResponse{
+		GasUnitPrice: types.DiscountGasPrice(s.keeper.ComputationalPriceOfGas(ctx), s.keeper.FeePromotionDiscount(ctx)),
+		EstimatedFee: fees,
+		FlatFees:     flatFees,
+		GasFees:      gasFees,
+		TxSizeFees:   sizeFees,
+	}, nil
+}
+
+// TxFeeEstimate implements the types.QueryServer interface.
+func (s *QueryServer) TxFeeEstimate(c context.Context, request *types.QueryTxFeeEstimateRequest) (*types.QueryTxFeeEstimateResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	estimate, err := s.EstimateTxFeesForContracts(c, &types.QueryEstimateTxFeesForContractsRequest{
+		GasLimit:          request.GasLimit,
+		ContractAddresses: request.ContractAddresses,
+		TxSize:            request.TxSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTxFeeEstimateResponse{
+		Version:      types.TxFeeEstimateVersion,
+		GasUnitPrice: estimate.GasUnitPrice,
+		EstimatedFee: estimate.EstimatedFee,
+		GasFees:      estimate.GasFees,
+		TxSizeFees:   estimate.TxSizeFees,
+		FlatFees:     estimate.FlatFees,
+	}, nil
+}
+
+// FlatFeeBreakEven implements the types.QueryServer interface.
+func (s *QueryServer) FlatFeeBreakEven(c context.Context, request *types.QueryFlatFeeBreakEvenRequest) (*types.QueryFlatFeeBreakEvenResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(request.ContractAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid contract address: "+err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	computationalPoG, gasFee := s.estimateGasFee(ctx, request.GasLimit)
+	fees := sdk.NewCoins(gasFee)
+
+	resp := types.QueryFlatFeeBreakEvenResponse{
+		GasUnitPrice: computationalPoG,
+		FlatFeeShare: math.LegacyZeroDec(),
+	}
+
+	flatFee, found := s.keeper.GetFlatFee(ctx, contractAddr)
+	if found {
+		fees = fees.Add(flatFee)
+
+		resp.FlatFee = flatFee
+		resp.FlatFeeShare = math.LegacyNewDecFromInt(flatFee.Amount).QuoInt(fees.AmountOf(flatFee.Denom))
+		if flatFee.Denom == computationalPoG.Denom && computationalPoG.Amount.IsPositive() {
+			resp.BreakEvenGasLimit = math.LegacyNewDecFromInt(flatFee.Amount).Quo(computationalPoG.Amount).Ceil().TruncateInt().Uint64()
+		}
+	}
+	resp.EstimatedFee = fees
+
+	return &resp, nil
+}
+
+// WouldAcceptFee implements the types.QueryServer interface.
+func (s *QueryServer) WouldAcceptFee(c context.Context, request *types.QueryWouldAcceptFeeRequest) (*types.QueryWouldAcceptFeeResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	txFees := sdk.Coins(request.Fee)
+	if err := txFees.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid fee: "+err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	gasFees, _, contractFlatFees, err := s.estimateTxMinFee(ctx, request.GasLimit, 0, request.ContractAddresses)
+	if err != nil {
+		return nil, err
+	}
+	flatFees := sdk.NewCoins()
+	for _, contractFlatFee := range contractFlatFees {
+		flatFees = flatFees.Add(contractFlatFee.FlatFee)
+	}
+
+	expectedFees := gasFees.Add(flatFees...)
+	if types.IsTxFeeSufficient(txFees, gasFees, flatFees) {
+		return &types.QueryWouldAcceptFeeResponse{
+			Accepted: true,
+		}, nil
+	}
+
+	return &types.QueryWouldAcceptFeeResponse{
+		Accepted:  false,
+		Shortfall: types.FeeShortfall(txFees, expectedFees),
+	}, nil
+}
+
+// TopContractsByRewards implements the types.QueryServer interface.
+func (s *QueryServer) TopContractsByRewards(c context.Context, request *types.QueryTopContractsByRewardsRequest) (*types.QueryTopContractsByRewardsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if request.Window == 0 || request.Window > types.ContractRewardsHistoryBlocks {
+		return nil, status.Errorf(codes.InvalidArgument, "window must be within the [1, %d] range", types.ContractRewardsHistoryBlocks)
+	}
+	if request.Limit == 0 || request.Limit > types.MaxTopContractsLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be within the [1, %d] range", types.MaxTopContractsLimit)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	contracts, err := s.keeper.TopContractsByRewards(ctx, request.Window, request.Limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTopContractsByRewardsResponse{
+		Contracts: contracts,
+	}, nil
+}
+
+// TxFeeSplit implements the types.QueryServer interface.
+func (s *QueryServer) TxFeeSplit(c context.Context, request *types.QueryTxFeeSplitRequest) (*types.QueryTxFeeSplitResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	txFees := sdk.Coins(request.Fee)
+	if err := txFees.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid fee: "+err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	gasFees, _, contractFlatFees, err := s.estimateTxMinFee(ctx, request.GasLimit, 0, request.ContractAddresses)
+	if err != nil {
+		return nil, err
+	}
+	flatFees, flatFeesList := sdk.NewCoins(), make([]sdk.Coins, 0, len(contractFlatFees))
+	for _, contractFlatFee := range contractFlatFees {
+		flatFees = flatFees.Add(contractFlatFee.FlatFee)
+		flatFeesList = append(flatFeesList, sdk.NewCoins(contractFlatFee.FlatFee))
+	}
+
+	split := types.SplitTxFee(txFees, gasFees, flatFeesList)
+
+	resp := types.QueryTxFeeSplitResponse{
+		Accepted: types.IsTxFeeSufficient(txFees, gasFees, flatFees),
+		GasFees:  split.GasFees,
+		FlatFees: make([]types.ContractFlatFeeSplit, 0, len(contractFlatFees)),
+		Surplus:  split.Surplus,
+	}
+	for i, contractFlatFee := range contractFlatFees {
+		resp.FlatFees = append(resp.FlatFees, types.ContractFlatFeeSplit{
+			ContractAddress: contractFlatFee.ContractAddress,
+			Fee:             split.FlatFees[i],
+		})
+	}
+
+	return &resp, nil
+}
+
+// ContractRewardsEligibility implements the types.QueryServer interface.
+func (s *QueryServer) ContractRewardsEligibility(c context.Context, request *types.QueryContractRewardsEligibilityRequest) (*types.QueryContractRewardsEligibilityResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(request.ContractAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid contract address: "+err.Error())
+	}
+
+	eligible, eligibility := s.keeper.IsContractEligibleForRewards(sdk.UnwrapSDKContext(c), contractAddr)
+
+	return &types.QueryContractRewardsEligibilityResponse{
+		Eligible:             eligible,
+		HasMetadata:          eligibility.HasMetadata,
+		HasRewardsRecipients: eligibility.HasRewardsRecipients,
+		RewardsEnabled:       eligibility.RewardsEnabled,
+	}, nil
+}
+
+// MsgTypeFlatFee implements the types.QueryServer interface.
+func (s *QueryServer) MsgTypeFlatFee(c context.Context, request *types.QueryMsgTypeFlatFeeRequest) (*types.QueryMsgTypeFlatFeeResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := s.keeper.cdc.InterfaceRegistry().Resolve(request.MsgTypeUrl); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid msg type URL: "+err.Error())
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(request.ContractAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid contract address: "+err.Error())
+	}
+	if request.Method != "" {
+		if err := types.ValidateFlatFeeMethod(request.Method); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid method: "+err.Error())
+		}
+	}
+
+	// Only contract execute msgs are charged flat fees (authz wrapped msgs are charged per wrapped msg type)
+	var resp types.QueryMsgTypeFlatFeeResponse
+	if request.MsgTypeUrl != sdk.MsgTypeURL(&wasmTypes.MsgExecuteContract{}) {
+		return &resp, nil
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !s.keeper.FlatFeesEnabled(ctx) {
+		return &resp, nil
+	}
+
+	if request.Method != "" {
+		if fee, found := s.keeper.GetMethodFlatFee(ctx, contractAddr, request.Method); found {
+			resp.FlatFee, resp.MethodFlatFee = fee, true
+			return &resp, nil
+		}
+	}
+	if fee, found := s.keeper.GetFlatFee(ctx, contractAddr); found {
+		resp.FlatFee = fee
+	}
+
+	return &resp, nil
+}
+
+// TotalPendingRewards implements the types.QueryServer interface.
+func (s *QueryServer) TotalPendingRewards(c context.Context, request *types.QueryTotalPendingRewardsRequest) (*types.QueryTotalPendingRewardsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pendingRewards, err := s.keeper.GetTotalPendingRewards(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTotalPendingRewardsResponse{
+		PendingRewards:     pendingRewards,
+		UndistributedFunds: s.keeper.UndistributedRewardsPool(ctx),
+	}, nil
+}
+
+// estimateTxMinFee returns the min gas fees, the tx size surcharge and the contract flat fees (contracts without
+// a flat fee are skipped) for the given gas limit, encoded tx size and contracts.
+// Min fee is built the same way the MinFeeDecorator does (flat fee exempt callers are not considered).
+// Contracts flat fees are skipped if the flat fees are disabled (addresses are still validated).
+func (s *QueryServer) estimateTxMinFee(ctx sdk.Context, gasLimit uint64, txSize int, contractAddresses []string) (sdk.Coins, sdk.Coins, []types.FlatFee, error) {
+	// Discounted by the fee promotion the same way the MinFeeDecorator does
+	promotionDiscount := s.keeper.FeePromotionDiscount(ctx)
+	computationalPoG := types.DiscountGasPrice(s.keeper.ComputationalPriceOfGas(ctx), promotionDiscount)
+	gasFees := types.MinGasFees(computationalPoG, gasLimit)
+	sizeFees := types.ApplyFeeDiscount(types.TxSizeFees(computationalPoG.Denom, txSize, s.keeper.TxSizeFeePerByte(ctx)), promotionDiscount)
+
+	var flatFees []types.FlatFee
+	flatFeesTotal := sdk.NewCoins()
+	flatFeesEnabled := s.keeper.FlatFeesEnabled(ctx)
+	for _, addr := range contractAddresses {
+		contractAddr, err := sdk.AccAddressFromBech32(addr)
+		if err != nil {
+			return nil, nil, nil, status.Error(codes.InvalidArgument, "invalid contract address: "+err.Error())
+		}
+		if !flatFeesEnabled {
+			continue
+		}
+		if contractFlatFee, found := s.keeper.GetFlatFee(ctx, contractAddr); found {
+			contractFlatFee = types.DiscountFee(contractFlatFee, promotionDiscount)
+			flatFees = append(flatFees, types.FlatFee{ContractAddress: addr, FlatFee: contractFlatFee})
+			flatFeesTotal = flatFeesTotal.Add(contractFlatFee)
+		}
+	}
+
+	// Absorbed the same way the MinFeeDecorator does (gas fees first, the tx size surcharge next)
+	if s.keeper.FlatFeeAbsorbedInGasFee(ctx) && !s.keeper.DynamicFeeEnabled(ctx) {
+		absorbedGasFees := types.AbsorbFlatFees(gasFees, flatFeesTotal)
+		flatFeesLeft := flatFeesTotal.Sub(gasFees.Sub(absorbedGasFees...)...)
+		gasFees, sizeFees = absorbedGasFees, types.AbsorbFlatFees(sizeFees, flatFeesLeft)
+	}
+
+	if gasFees.IsZero() && sizeFees.IsZero() && flatFeesTotal.IsZero() && s.keeper.MinFeeFloorEnabled(ctx) {
+		gasFees = types.MinFeeFloor(computationalPoG.Denom)
+	}
+
+	return gasFees, sizeFees, flatFees, nil
+}
+
+// estimateGasFee returns the computational price of gas and the gas fee for the given gas limit (flat fees excluded).
+func (s *QueryServer) estimateGasFee(ctx sdk.Context, gasLimit uint64) (sdk.DecCoin, sdk.Coin) {
+	computationalPoG := s.keeper.ComputationalPriceOfGas(ctx)
+	gasFee := sdk.NewCoin(computationalPoG.Denom, computationalPoG.Amount.MulInt(math.NewIntFromUint64(gasLimit)).RoundInt())
+
+	return computationalPoG, gasFee
+}
+
 // OutstandingRewards implements the types.QueryServer interface.
 func (s *QueryServer) OutstandingRewards(c context.Context, request *types.QueryOutstandingRewardsRequest) (*types.QueryOutstandingRewardsResponse, error) {
 	if request == nil {
@@ -184,6 +639,234 @@ func (s *QueryServer) RewardsRecords(c context.Context, request *types.QueryRewa
 	}, nil
 }
 
+// RewardsRecordsByAddressAndHeightRange implements the types.QueryServer interface.
+func (s *QueryServer) RewardsRecordsByAddressAndHeightRange(c context.Context, request *types.QueryRewardsRecordsByAddressAndHeightRangeRequest) (*types.QueryRewardsRecordsByAddressAndHeightRangeResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	rewardsAddr, err := sdk.AccAddressFromBech32(request.RewardsAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid rewards address: "+err.Error())
+	}
+	if request.FromHeight <= 0 || request.ToHeight < request.FromHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height range: [%d, %d]", request.FromHeight, request.ToHeight)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	records, pageResp, err := s.keeper.GetRewardsRecordsByAddressAndHeightRange(ctx, rewardsAddr, uint64(request.FromHeight), uint64(request.ToHeight), request.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "pagination request: "+err.Error())
+	}
+
+	return &types.QueryRewardsRecordsByAddressAndHeightRangeResponse{
+		Records:    records,
+		Pagination: pageResp,
+	}, nil
+}
+
+// ContractRewardsHistory implements the types.QueryServer interface.
+func (s *QueryServer) ContractRewardsHistory(c context.Context, request *types.QueryContractRewardsHistoryRequest) (*types.QueryContractRewardsHistoryResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(request.ContractAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid contract address: "+err.Error())
+	}
+	if request.FromHeight <= 0 || request.ToHeight < request.FromHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height range: [%d, %d]", request.FromHeight, request.ToHeight)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	rows, pageResp, err := s.keeper.GetContractRewardsHistory(ctx, contractAddr, uint64(request.FromHeight), uint64(request.ToHeight), request.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "pagination request: "+err.Error())
+	}
+
+	return &types.QueryContractRewardsHistoryResponse{
+		Rows:       rows,
+		Pagination: pageResp,
+	}, nil
+}
+
+// RewardsRecordByID implements the types.QueryServer interface.
+func (s *QueryServer) RewardsRecordByID(c context.Context, request *types.QueryRewardsRecordByIDRequest) (*types.QueryRewardsRecordByIDResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	record, err := s.keeper.RewardsRecords.Get(ctx, request.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "rewards record (%d): not found", request.Id)
+	}
+
+	return &types.QueryRewardsRecordByIDResponse{
+		Record: record,
+	}, nil
+}
+
+// ContractMetadataCount implements the types.QueryServer interface.
+func (s *QueryServer) ContractMetadataCount(c context.Context, request *types.QueryContractMetadataCountRequest) (*types.QueryContractMetadataCountResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryContractMetadataCountResponse{
+		Count: s.keeper.GetContractMetadataCount(ctx),
+	}, nil
+}
+
+// ContractsByCodeID implements the types.QueryServer interface.
+func (s *QueryServer) ContractsByCodeID(c context.Context, request *types.QueryContractsByCodeIDRequest) (*types.QueryContractsByCodeIDResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if request.CodeId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "code ID must be GT 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	contractAddrs, pageResp, err := s.keeper.GetContractsByCodeID(ctx, request.CodeId, request.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "pagination request: "+err.Error())
+	}
+
+	contractAddrsBech32 := make([]string, 0, len(contractAddrs))
+	for _, contractAddr := range contractAddrs {
+		contractAddrsBech32 = append(contractAddrsBech32, contractAddr.String())
+	}
+
+	return &types.QueryContractsByCodeIDResponse{
+		ContractAddresses: contractAddrsBech32,
+		Pagination:        pageResp,
+	}, nil
+}
+
+// MinConsensusFeeDebug implements the types.QueryServer interface.
+func (s *QueryServer) MinConsensusFeeDebug(c context.Context, request *types.QueryMinConsensusFeeDebugRequest) (*types.QueryMinConsensusFeeDebugResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	height := request.Height
+	if height == 0 {
+		height = ctx.BlockHeight()
+	}
+	if height < 0 {
+		return nil, status.Error(codes.InvalidArgument, "height must be GTE 0")
+	}
+
+	blockRewards, err := s.keeper.BlockRewards.Get(ctx, uint64(height))
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "block rewards (%d): not found", height)
+	}
+
+	txFeeRebateRatio := s.keeper.TxFeeRebateRatio(ctx)
+	// Ineligible inputs are reported with an empty computed fee (the stored one is not updated in this case)
+	computedFee, _ := computeMinConsensusFee(blockRewards.InflationRewards, blockRewards.MaxGas, txFeeRebateRatio)
+	storedFee, _ := s.keeper.GetMinConsensusFee(ctx, blockRewards.InflationRewards.Denom)
+
+	return &types.QueryMinConsensusFeeDebugResponse{
+		BlockRewards:     blockRewards,
+		TxFeeRebateRatio: txFeeRebateRatio,
+		ComputedFee:      computedFee,
+		StoredFee:        storedFee,
+	}, nil
+}
+
+// ProjectedMinConsensusFee implements the types.QueryServer interface.
+func (s *QueryServer) ProjectedMinConsensusFee(c context.Context, request *types.QueryProjectedMinConsensusFeeRequest) (*types.QueryProjectedMinConsensusFeeResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if request.Window == 0 || request.Window > types.MaxMinConsensusFeeProjectionWindow {
+		return nil, status.Errorf(codes.InvalidArgument, "window must be within the [1, %d] range", types.MaxMinConsensusFeeProjectionWindow)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	projectedPrice, blocksUsed := s.keeper.ProjectNextBlockPriceOfGas(ctx, request.Window)
+
+	return &types.QueryProjectedMinConsensusFeeResponse{
+		CurrentGasPrice:   s.keeper.ComputationalPriceOfGas(ctx),
+		ProjectedGasPrice: projectedPrice,
+		BlocksUsed:        blocksUsed,
+	}, nil
+}
+
+// ContractFlatFeeRevenue implements the types.QueryServer interface.
+func (s *QueryServer) ContractFlatFeeRevenue(c context.Context, request *types.QueryContractFlatFeeRevenueRequest) (*types.QueryContractFlatFeeRevenueResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(request.ContractAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid contract address: "+err.Error())
+	}
+	if request.Window == 0 || request.Window > types.ContractRewardsHistoryBlocks {
+		return nil, status.Errorf(codes.InvalidArgument, "window must be within the [1, %d] range", types.ContractRewardsHistoryBlocks)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	revenue, err := s.keeper.ContractFlatFeeRevenue(ctx, contractAddr, request.Window)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryContractFlatFeeRevenueResponse{
+		Revenue: revenue,
+	}, nil
+}
+
+// RewardsPoolSolvency implements the types.QueryServer interface.
+func (s *QueryServer) RewardsPoolSolvency(c context.Context, request *types.QueryRewardsPoolSolvencyRequest) (*types.QueryRewardsPoolSolvencyResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pool, liabilities, err := s.keeper.RewardsPoolSolvency(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRewardsPoolSolvencyResponse{
+		Solvent:     pool.IsAllGTE(liabilities),
+		PoolBalance: pool,
+		Liabilities: liabilities,
+	}, nil
+}
+
+// AcceptedFeeDenoms implements the types.QueryServer interface.
+func (s *QueryServer) AcceptedFeeDenoms(c context.Context, request *types.QueryAcceptedFeeDenomsRequest) (*types.QueryAcceptedFeeDenomsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryAcceptedFeeDenomsResponse{
+		MinGasPrices:     s.keeper.AcceptedFeeDenomMinGasPrices(ctx),
+		AnyDenomAccepted: len(s.keeper.AcceptedFeeDenoms(ctx)) == 0,
+	}, nil
+}
+
 // FlatFee implements the types.QueryServer interface.
 func (s *QueryServer) FlatFee(c context.Context, request *types.QueryFlatFeeRequest) (*types.QueryFlatFeeResponse, error) {
 	if request == nil {
@@ -206,3 +889,210 @@ func (s *QueryServer) FlatFee(c context.Context, request *types.QueryFlatFeeRequ
 		FlatFeeAmount: fee,
 	}, nil
 }
+
+// FlatFeeWithPendingChange implements the types.QueryServer interface.
+func (s *QueryServer) FlatFeeWithPendingChange(c context.Context, request *types.QueryFlatFeeWithPendingChangeRequest) (*types.QueryFlatFeeWithPendingChangeResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(request.ContractAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid contract address: "+err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	fee, feeFound := s.keeper.GetFlatFee(ctx, contractAddr)
+	schedule, scheduleFound := s.keeper.GetFlatFeeSchedule(ctx, contractAddr)
+	if !feeFound && !scheduleFound {
+		return nil, status.Errorf(codes.NotFound, "flat fee: not found")
+	}
+
+	res := &types.QueryFlatFeeWithPendingChangeResponse{
+		FlatFee: fee,
+	}
+	if !scheduleFound {
+		return res, nil
+	}
+
+	// A zero scheduled fee is reported as not found by GetFlatFee
+	res.FlatFee = schedule.FeeAt(ctx.BlockHeight())
+	if ctx.BlockHeight() < schedule.EndHeight {
+		res.PendingChange = &types.FlatFeePendingChange{
+			FlatFee:          schedule.EndFee,
+			ActivationHeight: schedule.EndHeight,
+			StartHeight:      schedule.StartHeight,
+		}
+	}
+
+	return res, nil
+}
+
+// FlatFees implements the types.QueryServer interface.
+func (s *QueryServer) FlatFees(c context.Context, request *types.QueryFlatFeesRequest) (*types.QueryFlatFeesResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if uint64(len(request.ContractAddresses)) > types.MaxFlatFeesQueryLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "too many contract addresses: %d > %d", len(request.ContractAddresses), types.MaxFlatFeesQueryLimit)
+	}
+
+	contractAddrs := make([]sdk.AccAddress, 0, len(request.ContractAddresses))
+	for _, addrRaw := range request.ContractAddresses {
+		contractAddr, err := sdk.AccAddressFromBech32(addrRaw)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid contract address: "+err.Error())
+		}
+		contractAddrs = append(contractAddrs, contractAddr)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	fees := s.keeper.GetFlatFees(ctx, contractAddrs)
+
+	flatFees := make([]types.ContractFlatFee, 0, len(fees))
+	for _, contractAddr := range contractAddrs {
+		addr := contractAddr.String()
+		fee, found := fees[addr]
+		if !found {
+			continue
+		}
+		// Duplicates are reported once
+		delete(fees, addr)
+
+		flatFees = append(flatFees, types.ContractFlatFee{
+			ContractAddress: addr,
+			FlatFee:         fee,
+		})
+	}
+
+	return &types.QueryFlatFeesResponse{
+		FlatFees: flatFees,
+	}, nil
+}
+
+// WithdrawMinBalance implements the types.QueryServer interface.
+func (s *QueryServer) WithdrawMinBalance(c context.Context, request *types.QueryWithdrawMinBalanceRequest) (*types.QueryWithdrawMinBalanceResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	rewardsAddr, err := sdk.AccAddressFromBech32(request.RewardsAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid rewards address: "+err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// Withdraw-all tx processes up to MaxWithdrawRecords records
+	records, _, err := s.keeper.GetRewardsRecordsByWithdrawAddressPaginated(ctx, rewardsAddr, &query.PageRequest{Limit: s.keeper.MaxWithdrawRecords(ctx)})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	recordsNum := uint64(len(records))
+
+	gasLimit := request.GasLimit
+	if gasLimit == 0 {
+		gasLimit = types.WithdrawTxGasLimit(recordsNum)
+	}
+
+	minBalance := s.keeper.EstimateWithdrawTxFee(ctx, gasLimit)
+
+	return &types.QueryWithdrawMinBalanceResponse{
+		RecordsNum: recordsNum,
+		GasLimit:   gasLimit,
+		MinBalance: minBalance,
+		Sufficient: s.keeper.bankKeeper.GetAllBalances(ctx, rewardsAddr).IsAllGTE(minBalance),
+	}, nil
+}
+
+// TxFeeDistribution implements the types.QueryServer interface.
+func (s *QueryServer) TxFeeDistribution(c context.Context, request *types.QueryTxFeeDistributionRequest) (*types.QueryTxFeeDistributionResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var distributions []types.TxFeeDistribution
+	var err error
+	switch {
+	case request.TxHash != "":
+		distributions, err = s.keeper.GetTxFeeDistributionsByTxHash(ctx, request.TxHash)
+	case request.Height > 0:
+		distributions, err = s.keeper.GetTxFeeDistributionsByBlock(ctx, request.Height)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "either tx hash or height must be set")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTxFeeDistributionResponse{
+		Distributions: distributions,
+	}, nil
+}
+
+// BlockPoolInflows implements the types.QueryServer interface.
+func (s *QueryServer) BlockPoolInflows(c context.Context, request *types.QueryBlockPoolInflowsRequest) (*types.QueryBlockPoolInflowsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if request.Height <= 0 || request.Height > ctx.BlockHeight() {
+		return nil, status.Errorf(codes.InvalidArgument, "height must be within the (0, %d] range", ctx.BlockHeight())
+	}
+	if request.Height <= ctx.BlockHeight()-types.BlockPoolInflowsHistoryBlocks {
+		return nil, status.Errorf(codes.InvalidArgument, "height is out of the recent %d blocks history", types.BlockPoolInflowsHistoryBlocks)
+	}
+
+	return &types.QueryBlockPoolInflowsResponse{
+		Inflows: s.keeper.GetBlockPoolInflows(ctx, request.Height),
+	}, nil
+}
+
+// BlockFlatFeeTxs implements the types.QueryServer interface.
+func (s *QueryServer) BlockFlatFeeTxs(c context.Context, request *types.QueryBlockFlatFeeTxsRequest) (*types.QueryBlockFlatFeeTxsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if request.Height <= 0 || request.Height > ctx.BlockHeight() {
+		return nil, status.Errorf(codes.InvalidArgument, "height must be within the (0, %d] range", ctx.BlockHeight())
+	}
+
+	txs, truncated, err := s.keeper.GetBlockFlatFeeTxs(ctx, request.Height, types.MaxBlockFlatFeeTxsQueryLimit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBlockFlatFeeTxsResponse{
+		Txs:       txs,
+		Truncated: truncated,
+	}, nil
+}
+
+// ReconcileRewards implements the types.QueryServer interface.
+func (s *QueryServer) ReconcileRewards(c context.Context, request *types.QueryReconcileRewardsRequest) (*types.QueryReconcileRewardsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	reconciliation, err := s.keeper.ReconcileRewards(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryReconcileRewardsResponse{
+		Reconciliation: reconciliation,
+		Balanced:       reconciliation.IsBalanced(),
+	}, nil
+}
@@ -1,5 +1,29 @@
 package keeper_test
 
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	wasmTypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	cmtProto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	e2eTesting "github.com/archway-network/archway/e2e/testing"
+	"github.com/archway-network/archway/pkg/testutils"
+	"github.com/archway-network/archway/x/rewards/ante"
+	"github.com/archway-network/archway/x/rewards/keeper"
+	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
+)
+
 // import (
 // 	"testing"
 
@@ -317,3 +341,1776 @@ package keeper_test
 // 		require.Equal(t, sdk.NewInt64Coin("token", 123), res.FlatFeeAmount)
 // 	})
 // }
+
+func TestGRPC_RewardsRecordByID(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	querySrvr := keeper.NewQueryServer(k)
+
+	rewardsAddr := testutils.AccAddress()
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	record, err := k.CreateRewardsRecord(ctx, rewardsAddr, contractAddr, rewards, ctx.BlockHeight(), ctx.BlockTime())
+	require.NoError(t, err)
+
+	t.Run("err: empty request", func(t *testing.T) {
+		_, err := querySrvr.RewardsRecordByID(ctx, nil)
+		require.Equal(t, status.Error(codes.InvalidArgument, "empty request"), err)
+	})
+
+	t.Run("ok: gets the record", func(t *testing.T) {
+		res, err := querySrvr.RewardsRecordByID(ctx, &rewardsTypes.QueryRewardsRecordByIDRequest{Id: record.Id})
+		require.NoError(t, err)
+		require.Equal(t, record.Id, res.Record.Id)
+		require.Equal(t, rewardsAddr.String(), res.Record.RewardsAddress)
+		require.Equal(t, contractAddr.String(), res.Record.ContractAddress)
+		require.Equal(t, rewards.String(), sdk.Coins(res.Record.Rewards).String())
+		require.Equal(t, ctx.BlockHeight(), res.Record.CalculatedHeight)
+	})
+
+	t.Run("err: record not found", func(t *testing.T) {
+		_, err := querySrvr.RewardsRecordByID(ctx, &rewardsTypes.QueryRewardsRecordByIDRequest{Id: record.Id + 1})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestGRPC_RewardsRecordsByAddressAndHeightRange(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	querySrvr := keeper.NewQueryServer(k)
+
+	rewardsAddr, otherRewardsAddr := testutils.AccAddress(), testutils.AccAddress()
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	var records []rewardsTypes.RewardsRecord
+	for _, height := range []int64{10, 20, 20, 30} {
+		record, err := k.CreateRewardsRecord(ctx, rewardsAddr, contractAddr, rewards, height, ctx.BlockTime())
+		require.NoError(t, err)
+		records = append(records, record)
+	}
+	_, err := k.CreateRewardsRecord(ctx, otherRewardsAddr, contractAddr, rewards, 20, ctx.BlockTime())
+	require.NoError(t, err)
+
+	t.Run("err: empty request", func(t *testing.T) {
+		_, err := querySrvr.RewardsRecordsByAddressAndHeightRange(ctx, nil)
+		require.Equal(t, status.Error(codes.InvalidArgument, "empty request"), err)
+	})
+
+	t.Run("err: invalid height range", func(t *testing.T) {
+		_, err := querySrvr.RewardsRecordsByAddressAndHeightRange(ctx, &rewardsTypes.QueryRewardsRecordsByAddressAndHeightRangeRequest{
+			RewardsAddress: rewardsAddr.String(),
+			FromHeight:     20,
+			ToHeight:       10,
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("err: page limit exceeded", func(t *testing.T) {
+		_, err := querySrvr.RewardsRecordsByAddressAndHeightRange(ctx, &rewardsTypes.QueryRewardsRecordsByAddressAndHeightRangeRequest{
+			RewardsAddress: rewardsAddr.String(),
+			FromHeight:     1,
+			ToHeight:       100,
+			Pagination:     &query.PageRequest{Limit: rewardsTypes.MaxRecordsQueryLimit + 1},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("ok: range with records", func(t *testing.T) {
+		res, err := querySrvr.RewardsRecordsByAddressAndHeightRange(ctx, &rewardsTypes.QueryRewardsRecordsByAddressAndHeightRangeRequest{
+			RewardsAddress: rewardsAddr.String(),
+			FromHeight:     15,
+			ToHeight:       30,
+		})
+		require.NoError(t, err)
+		require.Equal(t, records[1:], res.Records)
+		require.Empty(t, res.Pagination.NextKey)
+	})
+
+	t.Run("ok: range with records paginated", func(t *testing.T) {
+		req := &rewardsTypes.QueryRewardsRecordsByAddressAndHeightRangeRequest{
+			RewardsAddress: rewardsAddr.String(),
+			FromHeight:     15,
+			ToHeight:       25,
+			Pagination:     &query.PageRequest{Limit: 1},
+		}
+
+		res, err := querySrvr.RewardsRecordsByAddressAndHeightRange(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, records[1:2], res.Records)
+		require.NotEmpty(t, res.Pagination.NextKey)
+
+		req.Pagination.Key = res.Pagination.NextKey
+		res, err = querySrvr.RewardsRecordsByAddressAndHeightRange(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, records[2:3], res.Records)
+		require.Empty(t, res.Pagination.NextKey)
+	})
+
+	t.Run("ok: range without records", func(t *testing.T) {
+		res, err := querySrvr.RewardsRecordsByAddressAndHeightRange(ctx, &rewardsTypes.QueryRewardsRecordsByAddressAndHeightRangeRequest{
+			RewardsAddress: rewardsAddr.String(),
+			FromHeight:     11,
+			ToHeight:       19,
+		})
+		require.NoError(t, err)
+		require.Empty(t, res.Records)
+		require.Empty(t, res.Pagination.NextKey)
+	})
+
+	t.Run("ok: withdrawn records are not returned", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+
+		_, _, err := k.WithdrawRewardsByRecordIDs(ctx, rewardsAddr, []uint64{records[0].Id, records[2].Id})
+		require.NoError(t, err)
+
+		req := &rewardsTypes.QueryRewardsRecordsByAddressAndHeightRangeRequest{
+			RewardsAddress: rewardsAddr.String(),
+			FromHeight:     1,
+			ToHeight:       100,
+		}
+		res, err := querySrvr.RewardsRecordsByAddressAndHeightRange(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, []rewardsTypes.RewardsRecord{records[1], records[3]}, res.Records)
+
+		// Rebuilt indexes match the ones maintained on withdrawal
+		_, err = k.RebuildIndexes(ctx)
+		require.NoError(t, err)
+		res, err = querySrvr.RewardsRecordsByAddressAndHeightRange(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, []rewardsTypes.RewardsRecord{records[1], records[3]}, res.Records)
+	})
+}
+
+func TestGRPC_EstimateTxFeesForContracts(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	querySrvr := keeper.NewQueryServer(k)
+
+	contractAddrs := e2eTesting.GenContractAddresses(3)
+	require.NoError(t, k.FlatFees.Set(ctx, contractAddrs[0], sdk.NewInt64Coin("uarch", 50)))
+	require.NoError(t, k.FlatFees.Set(ctx, contractAddrs[1], sdk.NewInt64Coin("token", 10)))
+	// contractAddrs[2] has no flat fee
+
+	gasUnitPrice := k.ComputationalPriceOfGas(ctx)
+	gasFee := sdk.NewCoin(gasUnitPrice.Denom, gasUnitPrice.Amount.MulInt64(1000).RoundInt())
+
+	t.Run("err: empty request", func(t *testing.T) {
+		_, err := querySrvr.EstimateTxFeesForContracts(ctx, nil)
+		require.Equal(t, status.Error(codes.InvalidArgument, "empty request"), err)
+	})
+
+	t.Run("err: invalid contract address", func(t *testing.T) {
+		_, err := querySrvr.EstimateTxFeesForContracts(ctx, &rewardsTypes.QueryEstimateTxFeesForContractsRequest{
+			GasLimit:          1000,
+			ContractAddresses: []string{contractAddrs[0].String(), "invalid"},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("ok: no contracts", func(t *testing.T) {
+		res, err := querySrvr.EstimateTxFeesForContracts(ctx, &rewardsTypes.QueryEstimateTxFeesForContractsRequest{GasLimit: 1000})
+		require.NoError(t, err)
+		require.Equal(t, gasUnitPrice, res.GasUnitPrice)
+		require.Equal(t, sdk.NewCoins(gasFee).String(), sdk.Coins(res.EstimatedFee).String())
+		require.Empty(t, res.FlatFees)
+	})
+
+	t.Run("ok: batch of contracts including one without a flat fee", func(t *testing.T) {
+		res, err := querySrvr.EstimateTxFeesForContracts(ctx, &rewardsTypes.QueryEstimateTxFeesForContractsRequest{
+			GasLimit: 1000,
+			ContractAddresses: []string{
+				contractAddrs[0].String(),
+				contractAddrs[1].String(),
+				contractAddrs[2].String(),
+				contractAddrs[0].String(),
+			},
+		})
+		require.NoError(t, err)
+
+		flatFees := sdk.NewCoins(sdk.NewInt64Coin("uarch", 100), sdk.NewInt64Coin("token", 10))
+		require.Equal(t, flatFees.String(), sdk.Coins(res.FlatFees).String())
+		require.Equal(t, flatFees.Add(gasFee).String(), sdk.Coins(res.EstimatedFee).String())
+	})
+
+	t.Run("ok: flat fees disabled", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		params := k.GetParams(cacheCtx)
+		params.FlatFeesEnabled = false
+		require.NoError(t, k.Params.Set(cacheCtx, params))
+
+		res, err := querySrvr.EstimateTxFeesForContracts(cacheCtx, &rewardsTypes.QueryEstimateTxFeesForContractsRequest{
+			GasLimit:          1000,
+			ContractAddresses: []string{contractAddrs[0].String(), contractAddrs[1].String()},
+		})
+		require.NoError(t, err)
+		require.Empty(t, res.FlatFees)
+		require.Equal(t, sdk.NewCoins(gasFee).String(), sdk.Coins(res.EstimatedFee).String())
+	})
+}
+
+// TestGRPC_EstimateTxFeesForContractsTxSize checks the estimate components (gas fees, tx size surcharge and flat fees)
+// and that the estimated fee exactly matches the MinFeeDecorator min fee.
+func TestGRPC_EstimateTxFeesForContractsTxSize(t *testing.T) {
+	type testCase struct {
+		name       string
+		gasLimit   uint64
+		txSize     uint64
+		contracts  int // number of contracts executed (flat fees: 10stake and 50uarch)
+		gasFeesExp string
+		sizeFeeExp string
+		flatFeeExp string
+		feeExp     string
+	}
+
+	// Gas price is 0.15stake (truncated), the tx size surcharge is 2stake per byte
+	contractAddrs := e2eTesting.GenContractAddresses(2)
+	senderAddr := testutils.AccAddress()
+
+	testCases := []testCase{
+		{
+			name:       "OK: gas only",
+			gasLimit:   1001,
+			gasFeesExp: "150stake",
+			feeExp:     "150stake",
+		},
+		{
+			name:       "OK: gas and tx size",
+			gasLimit:   1001,
+			txSize:     100,
+			gasFeesExp: "150stake",
+			sizeFeeExp: "200stake",
+			feeExp:     "350stake",
+		},
+		{
+			name:       "OK: large tx size",
+			gasLimit:   1001,
+			txSize:     5000,
+			gasFeesExp: "150stake",
+			sizeFeeExp: "10000stake",
+			feeExp:     "10150stake",
+		},
+		{
+			name:       "OK: gas and flat fees",
+			gasLimit:   2000,
+			contracts:  2,
+			gasFeesExp: "300stake",
+			flatFeeExp: "10stake,50uarch",
+			feeExp:     "310stake,50uarch",
+		},
+		{
+			name:       "OK: gas, tx size and flat fees",
+			gasLimit:   2000,
+			txSize:     100,
+			contracts:  2,
+			gasFeesExp: "300stake",
+			sizeFeeExp: "200stake",
+			flatFeeExp: "10stake,50uarch",
+			feeExp:     "510stake,50uarch",
+		},
+		{
+			name:       "OK: tx size and flat fees without gas",
+			txSize:     10,
+			contracts:  1,
+			sizeFeeExp: "20stake",
+			flatFeeExp: "10stake",
+			feeExp:     "30stake",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx, _ := testutils.RewardsKeeper(t)
+			querySrvr := keeper.NewQueryServer(k)
+
+			params := k.GetParams(ctx)
+			params.TxSizeFeePerByte = 2
+			require.NoError(t, k.Params.Set(ctx, params))
+
+			minConsFee, err := sdk.ParseDecCoin("0.15stake")
+			require.NoError(t, err)
+			require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+
+			for i, flatFee := range []sdk.Coin{sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("uarch", 50)} {
+				require.NoError(t, k.ContractMetadata.Set(ctx, contractAddrs[i], rewardsTypes.ContractMetadata{
+					ContractAddress: contractAddrs[i].String(),
+					OwnerAddress:    senderAddr.String(),
+					RewardsAddress:  senderAddr.String(),
+				}))
+				require.NoError(t, k.FlatFees.Set(ctx, contractAddrs[i], flatFee))
+			}
+
+			req := &rewardsTypes.QueryEstimateTxFeesForContractsRequest{GasLimit: tc.gasLimit, TxSize: tc.txSize}
+			var msgs []sdk.Msg
+			for _, contractAddr := range contractAddrs[:tc.contracts] {
+				req.ContractAddresses = append(req.ContractAddresses, contractAddr.String())
+				msgs = append(msgs, &wasmTypes.MsgExecuteContract{Sender: senderAddr.String(), Contract: contractAddr.String()})
+			}
+
+			res, err := querySrvr.EstimateTxFeesForContracts(ctx, req)
+			require.NoError(t, err)
+			require.Equal(t, tc.gasFeesExp, sdk.Coins(res.GasFees).String())
+			require.Equal(t, tc.sizeFeeExp, sdk.Coins(res.TxSizeFees).String())
+			require.Equal(t, tc.flatFeeExp, sdk.Coins(res.FlatFees).String())
+			require.Equal(t, tc.feeExp, sdk.Coins(res.EstimatedFee).String())
+
+			// The decorator min fee must match: the estimate is accepted, a unit less of any denom is not
+			estimatedFee := sdk.Coins(res.EstimatedFee)
+			newTx := func(fees sdk.Coins) sdk.Tx {
+				return testutils.NewMockFeeTx(
+					testutils.WithMockFeeTxFees(fees),
+					testutils.WithMockFeeTxGas(tc.gasLimit),
+					testutils.WithMockFeeTxMsgs(msgs...),
+				)
+			}
+			txCtx := ctx.WithTxBytes(make([]byte, tc.txSize))
+			anteHandler := ante.NewMinFeeDecorator(codec.NewProtoCodec(codecTypes.NewInterfaceRegistry()), k)
+
+			for _, fee := range estimatedFee {
+				lowerFees := estimatedFee.Sub(sdk.NewInt64Coin(fee.Denom, 1))
+				cacheCtx, _ := txCtx.CacheContext()
+				_, err = anteHandler.AnteHandle(cacheCtx, newTx(lowerFees), false, testutils.NoopAnteHandler)
+				require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee, "fees: %s", lowerFees)
+			}
+
+			_, err = anteHandler.AnteHandle(txCtx, newTx(estimatedFee), false, testutils.NoopAnteHandler)
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("err: tx size exceeds the block max bytes", func(t *testing.T) {
+		k, ctx, _ := testutils.RewardsKeeper(t)
+		querySrvr := keeper.NewQueryServer(k)
+
+		ctx = ctx.WithConsensusParams(cmtProto.ConsensusParams{Block: &cmtProto.BlockParams{MaxBytes: 1000}})
+		_, err := querySrvr.EstimateTxFeesForContracts(ctx, &rewardsTypes.QueryEstimateTxFeesForContractsRequest{GasLimit: 1000, TxSize: 1001})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = querySrvr.EstimateTxFeesForContracts(ctx, &rewardsTypes.QueryEstimateTxFeesForContractsRequest{GasLimit: 1000, TxSize: 1000})
+		require.NoError(t, err)
+	})
+}
+
+func TestGRPC_EstimateTxFeesForContractsFlatFeeAbsorbed(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	querySrvr := keeper.NewQueryServer(k)
+
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	ownerAddr := testutils.AccAddress()
+
+	// Gas fees are 150stake, the tx size surcharge is 200stake, the flat fee is 250stake (absorbs all the gas fees
+	// and a half of the surcharge)
+	params := k.GetParams(ctx)
+	params.TxSizeFeePerByte = 2
+	params.FlatFeeAbsorbedInGasFee = true
+	require.NoError(t, k.Params.Set(ctx, params))
+
+	minConsFee, err := sdk.ParseDecCoin("0.15stake")
+	require.NoError(t, err)
+	require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+
+	require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+		ContractAddress: contractAddr.String(),
+		OwnerAddress:    ownerAddr.String(),
+		RewardsAddress:  ownerAddr.String(),
+	}))
+	require.NoError(t, k.FlatFees.Set(ctx, contractAddr, sdk.NewInt64Coin("stake", 250)))
+
+	res, err := querySrvr.EstimateTxFeesForContracts(ctx, &rewardsTypes.QueryEstimateTxFeesForContractsRequest{
+		GasLimit:          1001,
+		ContractAddresses: []string{contractAddr.String()},
+		TxSize:            100,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "", sdk.Coins(res.GasFees).String())
+	require.Equal(t, "100stake", sdk.Coins(res.TxSizeFees).String())
+	require.Equal(t, "250stake", sdk.Coins(res.FlatFees).String())
+	require.Equal(t, "350stake", sdk.Coins(res.EstimatedFee).String())
+}
+
+func TestGRPC_EstimateTxFeesForContractsFeePromotion(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	querySrvr := keeper.NewQueryServer(k)
+
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	ownerAddr := testutils.AccAddress()
+
+	// Undiscounted gas fees are 150stake, the tx size surcharge is 200stake, the flat fee is 50uarch
+	params := k.GetParams(ctx)
+	params.TxSizeFeePerByte = 2
+	params.FeePromotion = rewardsTypes.FeePromotion{Discount: 2000, StartHeight: 10, EndHeight: 20}
+	require.NoError(t, k.Params.Set(ctx, params))
+
+	minConsFee, err := sdk.ParseDecCoin("0.15stake")
+	require.NoError(t, err)
+	require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+
+	require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+		ContractAddress: contractAddr.String(),
+		OwnerAddress:    ownerAddr.String(),
+		RewardsAddress:  ownerAddr.String(),
+	}))
+	require.NoError(t, k.FlatFees.Set(ctx, contractAddr, sdk.NewInt64Coin("uarch", 50)))
+
+	estimate := func(height int64) *rewardsTypes.QueryEstimateTxFeesForContractsResponse {
+		res, err := querySrvr.EstimateTxFeesForContracts(ctx.WithBlockHeight(height), &rewardsTypes.QueryEstimateTxFeesForContractsRequest{
+			GasLimit:          1000,
+			ContractAddresses: []string{contractAddr.String()},
+			TxSize:            100,
+		})
+		require.NoError(t, err)
+		return res
+	}
+
+	t.Run("Out of the promotion window", func(t *testing.T) {
+		res := estimate(21)
+		require.Equal(t, "0.150000000000000000stake", res.GasUnitPrice.String())
+		require.Equal(t, "150stake", sdk.Coins(res.GasFees).String())
+		require.Equal(t, "200stake", sdk.Coins(res.TxSizeFees).String())
+		require.Equal(t, "50uarch", sdk.Coins(res.FlatFees).String())
+		require.Equal(t, "350stake,50uarch", sdk.Coins(res.EstimatedFee).String())
+	})
+
+	t.Run("Within the promotion window", func(t *testing.T) {
+		res := estimate(20)
+		require.Equal(t, "0.120000000000000000stake", res.GasUnitPrice.String())
+		require.Equal(t, "120stake", sdk.Coins(res.GasFees).String())
+		require.Equal(t, "160stake", sdk.Coins(res.TxSizeFees).String())
+		require.Equal(t, "40uarch", sdk.Coins(res.FlatFees).String())
+		require.Equal(t, "280stake,40uarch", sdk.Coins(res.EstimatedFee).String())
+	})
+}
+
+func TestGRPC_TxFeeEstimate(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	querySrvr := keeper.NewQueryServer(k)
+
+	contractAddrs := e2eTesting.GenContractAddresses(2)
+	ownerAddr := testutils.AccAddress()
+
+	params := k.GetParams(ctx)
+	params.TxSizeFeePerByte = 2
+	require.NoError(t, k.Params.Set(ctx, params))
+
+	minConsFee, err := sdk.ParseDecCoin("0.15stake")
+	require.NoError(t, err)
+	require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+
+	for i, flatFee := range []sdk.Coin{sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("uarch", 50)} {
+		require.NoError(t, k.ContractMetadata.Set(ctx, contractAddrs[i], rewardsTypes.ContractMetadata{
+			ContractAddress: contractAddrs[i].String(),
+			OwnerAddress:    ownerAddr.String(),
+			RewardsAddress:  ownerAddr.String(),
+		}))
+		require.NoError(t, k.FlatFees.Set(ctx, contractAddrs[i], flatFee))
+	}
+
+	t.Run("err: invalid requests", func(t *testing.T) {
+		_, err := querySrvr.TxFeeEstimate(ctx, nil)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = querySrvr.TxFeeEstimate(ctx, &rewardsTypes.QueryTxFeeEstimateRequest{GasLimit: 1000, ContractAddresses: []string{"invalid"}})
+		require.Error(t, err)
+	})
+
+	t.Run("OK: matches the EstimateTxFeesForContracts components", func(t *testing.T) {
+		contracts := []string{contractAddrs[0].String(), contractAddrs[1].String()}
+
+		res, err := querySrvr.TxFeeEstimate(ctx, &rewardsTypes.QueryTxFeeEstimateRequest{GasLimit: 2000, ContractAddresses: contracts, TxSize: 100})
+		require.NoError(t, err)
+		resLegacy, err := querySrvr.EstimateTxFeesForContracts(ctx, &rewardsTypes.QueryEstimateTxFeesForContractsRequest{GasLimit: 2000, ContractAddresses: contracts, TxSize: 100})
+		require.NoError(t, err)
+
+		require.Equal(t, rewardsTypes.TxFeeEstimateVersion, res.Version)
+		require.Equal(t, resLegacy.GasUnitPrice, res.GasUnitPrice)
+		require.Equal(t, "300stake", sdk.Coins(res.GasFees).String())
+		require.Equal(t, "200stake", sdk.Coins(res.TxSizeFees).String())
+		require.Equal(t, "10stake,50uarch", sdk.Coins(res.FlatFees).String())
+		require.Equal(t, "510stake,50uarch", sdk.Coins(res.EstimatedFee).String())
+		require.Equal(t, resLegacy.EstimatedFee, res.EstimatedFee)
+	})
+}
+
+func TestGRPC_EstimateTxFeesForSimulatedGas(t *testing.T) {
+	type testCase struct {
+		name          string
+		simulatedGas  uint64
+		gasAdjustment string // [math.LegacyDec], empty if not set
+		// Output expected
+		gasLimitExp     uint64
+		simulatedFeeExp string
+		adjustedFeeExp  string
+		errExpected     bool
+	}
+
+	// Gas price is 0.15stake (truncated)
+	testCases := []testCase{
+		{
+			name:            "OK: gas adjustment not set",
+			simulatedGas:    1000,
+			gasLimitExp:     1000,
+			simulatedFeeExp: "150stake",
+			adjustedFeeExp:  "150stake",
+		},
+		{
+			name:            "OK: gas adjustment 1.3",
+			simulatedGas:    1000,
+			gasAdjustment:   "1.3",
+			gasLimitExp:     1300,
+			simulatedFeeExp: "150stake",
+			adjustedFeeExp:  "195stake",
+		},
+		{
+			name:            "OK: gas adjustment 1.5 (gas limit rounded up)",
+			simulatedGas:    1001,
+			gasAdjustment:   "1.5",
+			gasLimitExp:     1502,
+			simulatedFeeExp: "150stake",
+			adjustedFeeExp:  "225stake",
+		},
+		{
+			name:            "OK: gas adjustment 2.0",
+			simulatedGas:    1000,
+			gasAdjustment:   "2.0",
+			gasLimitExp:     2000,
+			simulatedFeeExp: "150stake",
+			adjustedFeeExp:  "300stake",
+		},
+		{
+			name:          "Fail: gas adjustment LT 1.0",
+			simulatedGas:  1000,
+			gasAdjustment: "0.5",
+			errExpected:   true,
+		},
+		{
+			name:          "Fail: gas adjustment GT max",
+			simulatedGas:  1000,
+			gasAdjustment: "101",
+			errExpected:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx, _ := testutils.RewardsKeeper(t)
+			querySrvr := keeper.NewQueryServer(k)
+
+			minConsFee, err := sdk.ParseDecCoin("0.15stake")
+			require.NoError(t, err)
+			require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+
+			req := &rewardsTypes.QueryEstimateTxFeesForSimulatedGasRequest{SimulatedGas: tc.simulatedGas}
+			if tc.gasAdjustment != "" {
+				req.GasAdjustment = math.LegacyMustNewDecFromStr(tc.gasAdjustment)
+			}
+
+			res, err := querySrvr.EstimateTxFeesForSimulatedGas(ctx, req)
+			if tc.errExpected {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, k.ComputationalPriceOfGas(ctx), res.GasUnitPrice)
+			require.Equal(t, tc.gasLimitExp, res.AdjustedGasLimit)
+			require.Equal(t, tc.simulatedFeeExp, sdk.Coins(res.SimulatedGasFee).String())
+			require.Equal(t, tc.adjustedFeeExp, sdk.Coins(res.AdjustedGasFee).String())
+		})
+	}
+
+	t.Run("err: empty request", func(t *testing.T) {
+		k, ctx, _ := testutils.RewardsKeeper(t)
+		_, err := keeper.NewQueryServer(k).EstimateTxFeesForSimulatedGas(ctx, nil)
+		require.Equal(t, status.Error(codes.InvalidArgument, "empty request"), err)
+	})
+}
+
+func TestGRPC_FlatFeeBreakEven(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	querySrvr := keeper.NewQueryServer(k)
+
+	params := k.GetParams(ctx)
+	params.MinPriceOfGas = sdk.NewDecCoinFromDec("uarch", math.LegacyNewDecWithPrec(1, 2)) // 0.01uarch per gas unit
+	require.NoError(t, k.Params.Set(ctx, params))
+
+	contractAddrs := e2eTesting.GenContractAddresses(2)
+	require.NoError(t, k.FlatFees.Set(ctx, contractAddrs[0], sdk.NewInt64Coin("uarch", 1000)))
+	// contractAddrs[1] has no flat fee
+
+	t.Run("err: empty request", func(t *testing.T) {
+		_, err := querySrvr.FlatFeeBreakEven(ctx, nil)
+		require.Equal(t, status.Error(codes.InvalidArgument, "empty request"), err)
+	})
+
+	t.Run("err: invalid contract address", func(t *testing.T) {
+		_, err := querySrvr.FlatFeeBreakEven(ctx, &rewardsTypes.QueryFlatFeeBreakEvenRequest{ContractAddress: "invalid", GasLimit: 1000})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("ok: low gas limit (flat fee dominated)", func(t *testing.T) {
+		res, err := querySrvr.FlatFeeBreakEven(ctx, &rewardsTypes.QueryFlatFeeBreakEvenRequest{ContractAddress: contractAddrs[0].String(), GasLimit: 25_000})
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uarch", 1250)).String(), sdk.Coins(res.EstimatedFee).String())
+		require.Equal(t, sdk.NewInt64Coin("uarch", 1000), res.FlatFee)
+		require.Equal(t, math.LegacyNewDecWithPrec(8, 1), res.FlatFeeShare)
+		require.EqualValues(t, 100_000, res.BreakEvenGasLimit)
+	})
+
+	t.Run("ok: high gas limit (gas dominated)", func(t *testing.T) {
+		res, err := querySrvr.FlatFeeBreakEven(ctx, &rewardsTypes.QueryFlatFeeBreakEvenRequest{ContractAddress: contractAddrs[0].String(), GasLimit: 900_000})
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uarch", 10_000)).String(), sdk.Coins(res.EstimatedFee).String())
+		require.Equal(t, math.LegacyNewDecWithPrec(1, 1), res.FlatFeeShare)
+		require.EqualValues(t, 100_000, res.BreakEvenGasLimit)
+	})
+
+	t.Run("ok: no flat fee", func(t *testing.T) {
+		res, err := querySrvr.FlatFeeBreakEven(ctx, &rewardsTypes.QueryFlatFeeBreakEvenRequest{ContractAddress: contractAddrs[1].String(), GasLimit: 1000})
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uarch", 10)).String(), sdk.Coins(res.EstimatedFee).String())
+		require.True(t, res.FlatFeeShare.IsZero())
+		require.Zero(t, res.BreakEvenGasLimit)
+	})
+}
+
+func TestGRPC_FlatFees(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	querySrvr := keeper.NewQueryServer(k)
+
+	contractAddrs := e2eTesting.GenContractAddresses(3)
+	require.NoError(t, k.FlatFees.Set(ctx, contractAddrs[0], sdk.NewInt64Coin("uarch", 1000)))
+	require.NoError(t, k.FlatFees.Set(ctx, contractAddrs[2], sdk.NewInt64Coin("uarch", 500)))
+	// contractAddrs[1] has no flat fee
+
+	t.Run("err: empty request", func(t *testing.T) {
+		_, err := querySrvr.FlatFees(ctx, nil)
+		require.Equal(t, status.Error(codes.InvalidArgument, "empty request"), err)
+	})
+
+	t.Run("err: invalid contract address", func(t *testing.T) {
+		_, err := querySrvr.FlatFees(ctx, &rewardsTypes.QueryFlatFeesRequest{ContractAddresses: []string{contractAddrs[0].String(), "invalid"}})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("err: too many contract addresses", func(t *testing.T) {
+		addrs := make([]string, 0, rewardsTypes.MaxFlatFeesQueryLimit+1)
+		for _, addr := range e2eTesting.GenContractAddresses(uint(rewardsTypes.MaxFlatFeesQueryLimit + 1)) {
+			addrs = append(addrs, addr.String())
+		}
+
+		_, err := querySrvr.FlatFees(ctx, &rewardsTypes.QueryFlatFeesRequest{ContractAddresses: addrs})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		// Generated addresses are deterministic (include contractAddrs)
+		res, err := querySrvr.FlatFees(ctx, &rewardsTypes.QueryFlatFeesRequest{ContractAddresses: addrs[:rewardsTypes.MaxFlatFeesQueryLimit]})
+		require.NoError(t, err)
+		require.Len(t, res.FlatFees, 2)
+	})
+
+	t.Run("ok: contracts with and without flat fees", func(t *testing.T) {
+		res, err := querySrvr.FlatFees(ctx, &rewardsTypes.QueryFlatFeesRequest{
+			ContractAddresses: []string{
+				contractAddrs[2].String(),
+				contractAddrs[1].String(),
+				contractAddrs[0].String(),
+				contractAddrs[2].String(),
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []rewardsTypes.ContractFlatFee{
+			{ContractAddress: contractAddrs[2].String(), FlatFee: sdk.NewInt64Coin("uarch", 500)},
+			{ContractAddress: contractAddrs[0].String(), FlatFee: sdk.NewInt64Coin("uarch", 1000)},
+		}, res.FlatFees)
+	})
+
+	t.Run("ok: no contract addresses", func(t *testing.T) {
+		res, err := querySrvr.FlatFees(ctx, &rewardsTypes.QueryFlatFeesRequest{})
+		require.NoError(t, err)
+		require.Empty(t, res.FlatFees)
+	})
+}
+
+func TestGRPC_FlatFeeWithPendingChange(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	querySrvr := keeper.NewQueryServer(k)
+	ctx = ctx.WithBlockHeight(100)
+
+	contractAddrs := e2eTesting.GenContractAddresses(4)
+	// contractAddrs[0]: plain flat fee
+	require.NoError(t, k.FlatFees.Set(ctx, contractAddrs[0], sdk.NewInt64Coin("uarch", 1000)))
+	// contractAddrs[1]: schedule ramping from 100 to 200 within the [150, 250] range
+	schedule := rewardsTypes.FlatFeeSchedule{
+		StartHeight: 150,
+		EndHeight:   250,
+		StartFee:    sdk.NewInt64Coin("uarch", 100),
+		EndFee:      sdk.NewInt64Coin("uarch", 200),
+	}
+	require.NoError(t, k.FlatFees.Set(ctx, contractAddrs[1], schedule.EndFee))
+	require.NoError(t, k.FlatFeeSchedules.Set(ctx, contractAddrs[1], schedule))
+	// contractAddrs[2]: schedule starting from zero
+	zeroSchedule := rewardsTypes.FlatFeeSchedule{
+		StartHeight: 150,
+		EndHeight:   250,
+		StartFee:    sdk.NewInt64Coin("uarch", 0),
+		EndFee:      sdk.NewInt64Coin("uarch", 200),
+	}
+	require.NoError(t, k.FlatFees.Set(ctx, contractAddrs[2], zeroSchedule.EndFee))
+	require.NoError(t, k.FlatFeeSchedules.Set(ctx, contractAddrs[2], zeroSchedule))
+	// contractAddrs[3] has no flat fee
+
+	query := func(ctx sdk.Context, contractAddr sdk.AccAddress) (*rewardsTypes.QueryFlatFeeWithPendingChangeResponse, error) {
+		return querySrvr.FlatFeeWithPendingChange(ctx, &rewardsTypes.QueryFlatFeeWithPendingChangeRequest{
+			ContractAddress: contractAddr.String(),
+		})
+	}
+
+	t.Run("err: empty request", func(t *testing.T) {
+		_, err := querySrvr.FlatFeeWithPendingChange(ctx, nil)
+		require.Equal(t, status.Error(codes.InvalidArgument, "empty request"), err)
+	})
+
+	t.Run("err: invalid contract address", func(t *testing.T) {
+		_, err := querySrvr.FlatFeeWithPendingChange(ctx, &rewardsTypes.QueryFlatFeeWithPendingChangeRequest{ContractAddress: "invalid"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("err: flat fee not found", func(t *testing.T) {
+		_, err := query(ctx, contractAddrs[3])
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("ok: no pending change", func(t *testing.T) {
+		res, err := query(ctx, contractAddrs[0])
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewInt64Coin("uarch", 1000), res.FlatFee)
+		require.Nil(t, res.PendingChange)
+	})
+
+	t.Run("ok: pending change", func(t *testing.T) {
+		expectedChange := &rewardsTypes.FlatFeePendingChange{
+			FlatFee:          sdk.NewInt64Coin("uarch", 200),
+			ActivationHeight: 250,
+			StartHeight:      150,
+		}
+
+		// Before the schedule start
+		res, err := query(ctx, contractAddrs[1])
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewInt64Coin("uarch", 100), res.FlatFee)
+		require.Equal(t, expectedChange, res.PendingChange)
+
+		// Within the schedule range
+		res, err = query(ctx.WithBlockHeight(200), contractAddrs[1])
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewInt64Coin("uarch", 150), res.FlatFee)
+		require.Equal(t, expectedChange, res.PendingChange)
+	})
+
+	t.Run("ok: pending change from a zero fee", func(t *testing.T) {
+		res, err := query(ctx, contractAddrs[2])
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewInt64Coin("uarch", 0), res.FlatFee)
+		require.NotNil(t, res.PendingChange)
+		require.Equal(t, sdk.NewInt64Coin("uarch", 200), res.PendingChange.FlatFee)
+	})
+
+	t.Run("ok: schedule end is reached", func(t *testing.T) {
+		res, err := query(ctx.WithBlockHeight(250), contractAddrs[1])
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewInt64Coin("uarch", 200), res.FlatFee)
+		require.Nil(t, res.PendingChange)
+	})
+}
+
+func TestGRPC_WithdrawMinBalance(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	querySrvr := keeper.NewQueryServer(k)
+	rewardsAddr, noRecordsAddr := testutils.AccAddress(), testutils.AccAddress()
+
+	params := k.GetParams(ctx)
+	params.MaxWithdrawRecords = 2
+	require.NoError(t, k.Params.Set(ctx, params))
+
+	minConsFee, err := sdk.ParseDecCoin("0.01stake")
+	require.NoError(t, err)
+	require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+
+	require.NoError(t, SetupWithdrawTest(k, ctx, []withdrawTestRecordData{
+		{RecordID: 1, RewardsAddr: rewardsAddr, Rewards: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))},
+		{RecordID: 2, RewardsAddr: rewardsAddr, Rewards: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))},
+		{RecordID: 3, RewardsAddr: rewardsAddr, Rewards: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))},
+	}))
+
+	// checkAnte checks the min balance is the min fee the Ante handler accepts for the withdraw-all tx
+	checkAnte := func(t *testing.T, ctx sdk.Context, res *rewardsTypes.QueryWithdrawMinBalanceResponse) {
+		anteHandler := ante.NewMinFeeDecorator(codec.NewProtoCodec(codecTypes.NewInterfaceRegistry()), k)
+		newTx := func(fees sdk.Coins) sdk.Tx {
+			return testutils.NewMockFeeTx(
+				testutils.WithMockFeeTxFees(fees),
+				testutils.WithMockFeeTxGas(res.GasLimit),
+				testutils.WithMockFeeTxMsgs(rewardsTypes.NewMsgWithdrawRewardsByLimit(rewardsAddr, 0)),
+			)
+		}
+
+		_, err := anteHandler.AnteHandle(ctx, newTx(res.MinBalance), false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+
+		_, err = anteHandler.AnteHandle(ctx, newTx(sdk.Coins(res.MinBalance).Sub(sdk.NewInt64Coin("stake", 1))), false, testutils.NoopAnteHandler)
+		require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+	}
+
+	t.Run("err: empty request", func(t *testing.T) {
+		_, err := querySrvr.WithdrawMinBalance(ctx, nil)
+		require.Equal(t, status.Error(codes.InvalidArgument, "empty request"), err)
+	})
+
+	t.Run("err: invalid rewards address", func(t *testing.T) {
+		_, err := querySrvr.WithdrawMinBalance(ctx, &rewardsTypes.QueryWithdrawMinBalanceRequest{RewardsAddress: "invalid"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("ok: no records", func(t *testing.T) {
+		res, err := querySrvr.WithdrawMinBalance(ctx, &rewardsTypes.QueryWithdrawMinBalanceRequest{RewardsAddress: noRecordsAddr.String()})
+		require.NoError(t, err)
+		require.Zero(t, res.RecordsNum)
+		require.Equal(t, rewardsTypes.WithdrawTxBaseGas, res.GasLimit)
+		require.Equal(t, "1000stake", sdk.Coins(res.MinBalance).String())
+		require.False(t, res.Sufficient)
+	})
+
+	t.Run("ok: records limited by the MaxWithdrawRecords param", func(t *testing.T) {
+		res, err := querySrvr.WithdrawMinBalance(ctx, &rewardsTypes.QueryWithdrawMinBalanceRequest{RewardsAddress: rewardsAddr.String()})
+		require.NoError(t, err)
+		require.EqualValues(t, 2, res.RecordsNum)
+		require.Equal(t, rewardsTypes.WithdrawTxGasLimit(2), res.GasLimit)
+		require.Equal(t, "1200stake", sdk.Coins(res.MinBalance).String())
+		require.False(t, res.Sufficient)
+
+		checkAnte(t, ctx, res)
+	})
+
+	t.Run("ok: explicit gas limit", func(t *testing.T) {
+		res, err := querySrvr.WithdrawMinBalance(ctx, &rewardsTypes.QueryWithdrawMinBalanceRequest{RewardsAddress: rewardsAddr.String(), GasLimit: 50_000})
+		require.NoError(t, err)
+		require.EqualValues(t, 2, res.RecordsNum)
+		require.EqualValues(t, 50_000, res.GasLimit)
+		require.Equal(t, "500stake", sdk.Coins(res.MinBalance).String())
+
+		checkAnte(t, ctx, res)
+	})
+
+	t.Run("ok: fee promotion discount", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		params := k.GetParams(ctx)
+		params.FeePromotion = rewardsTypes.FeePromotion{Discount: 2_500, StartHeight: 1, EndHeight: ctx.BlockHeight() + 1}
+		require.NoError(t, k.Params.Set(ctx, params))
+
+		res, err := querySrvr.WithdrawMinBalance(ctx, &rewardsTypes.QueryWithdrawMinBalanceRequest{RewardsAddress: rewardsAddr.String()})
+		require.NoError(t, err)
+		require.Equal(t, "900stake", sdk.Coins(res.MinBalance).String())
+
+		checkAnte(t, ctx, res)
+	})
+
+	t.Run("ok: zero min fee is covered by any balance", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		require.NoError(t, k.MinConsFee.Remove(ctx))
+
+		res, err := querySrvr.WithdrawMinBalance(ctx, &rewardsTypes.QueryWithdrawMinBalanceRequest{RewardsAddress: rewardsAddr.String()})
+		require.NoError(t, err)
+		require.True(t, sdk.Coins(res.MinBalance).IsZero())
+		require.True(t, res.Sufficient)
+	})
+}
+
+func TestGRPC_MsgTypeFlatFee(t *testing.T) {
+	chain := e2eTesting.NewTestChain(t, 1)
+	k := chain.GetApp().Keepers.RewardsKeeper
+	ctx := chain.GetContext()
+	querySrvr := keeper.NewQueryServer(k)
+
+	contractAddrs := e2eTesting.GenContractAddresses(2)
+	require.NoError(t, k.FlatFees.Set(ctx, contractAddrs[0], sdk.NewInt64Coin("uarch", 1000)))
+	require.NoError(t, k.MethodFlatFees.Set(ctx, collections.Join(contractAddrs[0].Bytes(), "mint"), sdk.NewInt64Coin("uarch", 5000)))
+	// contractAddrs[1] has no flat fee
+
+	executeTypeURL := sdk.MsgTypeURL(&wasmTypes.MsgExecuteContract{})
+	newRequest := func(msgTypeURL string, contractAddr sdk.AccAddress, method string) *rewardsTypes.QueryMsgTypeFlatFeeRequest {
+		return &rewardsTypes.QueryMsgTypeFlatFeeRequest{
+			MsgTypeUrl:      msgTypeURL,
+			ContractAddress: contractAddr.String(),
+			Method:          method,
+		}
+	}
+
+	t.Run("err: empty request", func(t *testing.T) {
+		_, err := querySrvr.MsgTypeFlatFee(ctx, nil)
+		require.Equal(t, status.Error(codes.InvalidArgument, "empty request"), err)
+	})
+
+	t.Run("err: unknown msg type URL", func(t *testing.T) {
+		_, err := querySrvr.MsgTypeFlatFee(ctx, newRequest("/unknown.v1.MsgUnknown", contractAddrs[0], ""))
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("err: invalid contract address", func(t *testing.T) {
+		_, err := querySrvr.MsgTypeFlatFee(ctx, &rewardsTypes.QueryMsgTypeFlatFeeRequest{MsgTypeUrl: executeTypeURL, ContractAddress: "invalid"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("err: invalid method", func(t *testing.T) {
+		_, err := querySrvr.MsgTypeFlatFee(ctx, newRequest(executeTypeURL, contractAddrs[0], " mint"))
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("ok: contract-wide flat fee", func(t *testing.T) {
+		res, err := querySrvr.MsgTypeFlatFee(ctx, newRequest(executeTypeURL, contractAddrs[0], ""))
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewInt64Coin("uarch", 1000), res.FlatFee)
+		require.False(t, res.MethodFlatFee)
+	})
+
+	t.Run("ok: method flat fee", func(t *testing.T) {
+		res, err := querySrvr.MsgTypeFlatFee(ctx, newRequest(executeTypeURL, contractAddrs[0], "mint"))
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewInt64Coin("uarch", 5000), res.FlatFee)
+		require.True(t, res.MethodFlatFee)
+	})
+
+	t.Run("ok: method without flat fee falls back to the contract-wide one", func(t *testing.T) {
+		res, err := querySrvr.MsgTypeFlatFee(ctx, newRequest(executeTypeURL, contractAddrs[0], "burn"))
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewInt64Coin("uarch", 1000), res.FlatFee)
+		require.False(t, res.MethodFlatFee)
+	})
+
+	t.Run("ok: no flat fee configured", func(t *testing.T) {
+		res, err := querySrvr.MsgTypeFlatFee(ctx, newRequest(executeTypeURL, contractAddrs[1], "mint"))
+		require.NoError(t, err)
+		require.True(t, res.FlatFee.IsNil() || res.FlatFee.IsZero())
+		require.False(t, res.MethodFlatFee)
+	})
+
+	t.Run("ok: msg type not charged flat fees", func(t *testing.T) {
+		res, err := querySrvr.MsgTypeFlatFee(ctx, newRequest(sdk.MsgTypeURL(&wasmTypes.MsgMigrateContract{}), contractAddrs[0], ""))
+		require.NoError(t, err)
+		require.True(t, res.FlatFee.IsNil() || res.FlatFee.IsZero())
+	})
+}
+
+func TestGRPC_WouldAcceptFee(t *testing.T) {
+	type testCase struct {
+		name          string
+		txFees        string
+		withContract  bool
+		acceptedExp   bool
+		shortfallsExp string
+	}
+
+	// Min fee is 100stake (1000 gas * 0.1stake) + 50uarch (contract flat fee)
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	senderAddr := testutils.AccAddress()
+
+	testCases := []testCase{
+		{
+			name:         "OK: both denoms covered",
+			txFees:       "100stake,50uarch",
+			withContract: true,
+			acceptedExp:  true,
+		},
+		{
+			name:          "Fail: flat fee is not covered",
+			txFees:        "150stake,20uarch",
+			withContract:  true,
+			shortfallsExp: "30uarch",
+		},
+		{
+			name:          "Fail: both denoms are not covered",
+			txFees:        "99stake",
+			withContract:  true,
+			shortfallsExp: "1stake,50uarch",
+		},
+		{
+			name:          "Fail: flat fee denom is missing",
+			txFees:        "1000stake",
+			withContract:  true,
+			shortfallsExp: "50uarch",
+		},
+		{
+			name:          "Fail: no denom covered",
+			txFees:        "99stake,49uarch",
+			withContract:  true,
+			shortfallsExp: "1stake,1uarch",
+		},
+		{
+			name:        "OK: gas fees covered without contracts",
+			txFees:      "100stake",
+			acceptedExp: true,
+		},
+		{
+			name:          "Fail: gas fees are not covered without contracts",
+			txFees:        "",
+			shortfallsExp: "100stake",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx, _ := testutils.RewardsKeeper(t)
+			querySrvr := keeper.NewQueryServer(k)
+
+			minConsFee, err := sdk.ParseDecCoin("0.1stake")
+			require.NoError(t, err)
+			require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+			require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+				ContractAddress: contractAddr.String(),
+				OwnerAddress:    senderAddr.String(),
+				RewardsAddress:  senderAddr.String(),
+			}))
+			require.NoError(t, k.FlatFees.Set(ctx, contractAddr, sdk.NewInt64Coin("uarch", 50)))
+
+			txFees, err := sdk.ParseCoinsNormalized(tc.txFees)
+			require.NoError(t, err)
+
+			req := &rewardsTypes.QueryWouldAcceptFeeRequest{GasLimit: 1000, Fee: txFees}
+			txOpts := []testutils.MockFeeTxOption{
+				testutils.WithMockFeeTxFees(txFees),
+				testutils.WithMockFeeTxGas(1000),
+			}
+			if tc.withContract {
+				req.ContractAddresses = []string{contractAddr.String()}
+				txOpts = append(txOpts, testutils.WithMockFeeTxMsgs(&wasmTypes.MsgExecuteContract{
+					Sender:   senderAddr.String(),
+					Contract: contractAddr.String(),
+				}))
+			}
+
+			res, err := querySrvr.WouldAcceptFee(ctx, req)
+			require.NoError(t, err)
+			require.Equal(t, tc.acceptedExp, res.Accepted)
+			require.Equal(t, tc.shortfallsExp, sdk.Coins(res.Shortfall).String())
+
+			// The decorator decision must match
+			anteHandler := ante.NewMinFeeDecorator(codec.NewProtoCodec(codecTypes.NewInterfaceRegistry()), k)
+			_, err = anteHandler.AnteHandle(ctx, testutils.NewMockFeeTx(txOpts...), false, testutils.NoopAnteHandler)
+			if tc.acceptedExp {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+			}
+		})
+	}
+
+	t.Run("err: invalid contract address", func(t *testing.T) {
+		k, ctx, _ := testutils.RewardsKeeper(t)
+		querySrvr := keeper.NewQueryServer(k)
+
+		_, err := querySrvr.WouldAcceptFee(ctx, &rewardsTypes.QueryWouldAcceptFeeRequest{GasLimit: 1000, ContractAddresses: []string{"invalid"}})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = querySrvr.WouldAcceptFee(ctx, nil)
+		require.Equal(t, status.Error(codes.InvalidArgument, "empty request"), err)
+	})
+}
+
+func TestGRPC_TxFeeSplit(t *testing.T) {
+	type testCase struct {
+		name          string
+		txFees        string
+		withContracts bool
+		acceptedExp   bool
+		gasFeesExp    string
+		flatFeesExp   []string // per contract (contract1, contract2)
+		surplusExp    string
+	}
+
+	// Min fee is 100stake (1000 gas * 0.1stake) + 50uarch (contract1 flat fee) + 30stake (contract2 flat fee)
+	contractAddrs := e2eTesting.GenContractAddresses(3)
+	senderAddr := testutils.AccAddress()
+
+	testCases := []testCase{
+		{
+			name:          "OK: min fee covered with surplus",
+			txFees:        "150stake,60uarch",
+			withContracts: true,
+			acceptedExp:   true,
+			// Flat fees are taken first: 50uarch (contract1), 30stake (contract2), 120stake is left for the gas fees
+			gasFeesExp:  "100stake",
+			flatFeesExp: []string{"50uarch", "30stake"},
+			surplusExp:  "20stake,10uarch",
+		},
+		{
+			name:          "OK: min fee covered exactly",
+			txFees:        "130stake,50uarch",
+			withContracts: true,
+			acceptedExp:   true,
+			gasFeesExp:    "100stake",
+			flatFeesExp:   []string{"50uarch", "30stake"},
+			surplusExp:    "",
+		},
+		{
+			name:          "Fail: portions are covered partially",
+			txFees:        "110stake,40uarch",
+			withContracts: true,
+			// 40uarch (contract1), 30stake (contract2), 80stake is left for the gas fees
+			gasFeesExp:  "80stake",
+			flatFeesExp: []string{"40uarch", "30stake"},
+			surplusExp:  "",
+		},
+		{
+			name:        "OK: gas fees only",
+			txFees:      "150stake,10uarch",
+			acceptedExp: true,
+			gasFeesExp:  "100stake",
+			surplusExp:  "50stake,10uarch",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx, _ := testutils.RewardsKeeper(t)
+			querySrvr := keeper.NewQueryServer(k)
+
+			minConsFee, err := sdk.ParseDecCoin("0.1stake")
+			require.NoError(t, err)
+			require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(minConsFee)}))
+			for _, contractAddr := range contractAddrs {
+				require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+					ContractAddress: contractAddr.String(),
+					OwnerAddress:    senderAddr.String(),
+					RewardsAddress:  senderAddr.String(),
+				}))
+			}
+			require.NoError(t, k.FlatFees.Set(ctx, contractAddrs[0], sdk.NewInt64Coin("uarch", 50)))
+			require.NoError(t, k.FlatFees.Set(ctx, contractAddrs[1], sdk.NewInt64Coin("stake", 30)))
+
+			txFees, err := sdk.ParseCoinsNormalized(tc.txFees)
+			require.NoError(t, err)
+
+			req := &rewardsTypes.QueryTxFeeSplitRequest{GasLimit: 1000, Fee: txFees}
+			txOpts := []testutils.MockFeeTxOption{
+				testutils.WithMockFeeTxFees(txFees),
+				testutils.WithMockFeeTxGas(1000),
+			}
+			if tc.withContracts {
+				// Contract without a flat fee is skipped
+				for _, contractAddr := range contractAddrs {
+					req.ContractAddresses = append(req.ContractAddresses, contractAddr.String())
+				}
+				txOpts = append(txOpts, testutils.WithMockFeeTxMsgs(
+					&wasmTypes.MsgExecuteContract{Sender: senderAddr.String(), Contract: contractAddrs[0].String()},
+					&wasmTypes.MsgExecuteContract{Sender: senderAddr.String(), Contract: contractAddrs[1].String()},
+					&wasmTypes.MsgExecuteContract{Sender: senderAddr.String(), Contract: contractAddrs[2].String()},
+				))
+			}
+
+			res, err := querySrvr.TxFeeSplit(ctx, req)
+			require.NoError(t, err)
+			require.Equal(t, tc.acceptedExp, res.Accepted)
+			require.Equal(t, tc.gasFeesExp, sdk.Coins(res.GasFees).String())
+			require.Equal(t, tc.surplusExp, sdk.Coins(res.Surplus).String())
+
+			require.Len(t, res.FlatFees, len(tc.flatFeesExp))
+			splitTotal := sdk.Coins(res.GasFees).Add(res.Surplus...)
+			for i, flatFee := range res.FlatFees {
+				require.Equal(t, contractAddrs[i].String(), flatFee.ContractAddress)
+				require.Equal(t, tc.flatFeesExp[i], sdk.Coins(flatFee.Fee).String())
+				splitTotal = splitTotal.Add(flatFee.Fee...)
+			}
+			require.Equal(t, txFees.String(), splitTotal.String())
+
+			// The decorator decision must match
+			anteHandler := ante.NewMinFeeDecorator(codec.NewProtoCodec(codecTypes.NewInterfaceRegistry()), k)
+			_, err = anteHandler.AnteHandle(ctx, testutils.NewMockFeeTx(txOpts...), false, testutils.NoopAnteHandler)
+			if tc.acceptedExp {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, sdkErrors.ErrInsufficientFee)
+			}
+		})
+	}
+
+	t.Run("err: invalid request", func(t *testing.T) {
+		k, ctx, _ := testutils.RewardsKeeper(t)
+		querySrvr := keeper.NewQueryServer(k)
+
+		_, err := querySrvr.TxFeeSplit(ctx, &rewardsTypes.QueryTxFeeSplitRequest{GasLimit: 1000, Fee: []sdk.Coin{{Denom: "stake", Amount: math.NewInt(-1)}}})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = querySrvr.TxFeeSplit(ctx, &rewardsTypes.QueryTxFeeSplitRequest{GasLimit: 1000, ContractAddresses: []string{"invalid"}})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = querySrvr.TxFeeSplit(ctx, nil)
+		require.Equal(t, status.Error(codes.InvalidArgument, "empty request"), err)
+	})
+}
+
+func TestGRPC_ContractRewardsEligibility(t *testing.T) {
+	type testCase struct {
+		name string
+		// Inputs
+		metadata       *rewardsTypes.ContractMetadata // contract metadata (not set if nil)
+		inflationRatio string                         // InflationRewardsRatio param
+		feeRebateRatio string                         // TxFeeRebateRatio param
+		// Output expected
+		eligibleExp             bool
+		hasMetadataExp          bool
+		hasRewardsRecipientsExp bool
+		rewardsEnabledExp       bool
+	}
+
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	ownerAddr, rewardsAddr := testutils.AccAddress(), testutils.AccAddress()
+
+	noRecipientsMeta := &rewardsTypes.ContractMetadata{
+		ContractAddress: contractAddr.String(),
+		OwnerAddress:    ownerAddr.String(),
+	}
+	rewardsAddrMeta := &rewardsTypes.ContractMetadata{
+		ContractAddress: contractAddr.String(),
+		OwnerAddress:    ownerAddr.String(),
+		RewardsAddress:  rewardsAddr.String(),
+	}
+	splitsMeta := &rewardsTypes.ContractMetadata{
+		ContractAddress: contractAddr.String(),
+		OwnerAddress:    ownerAddr.String(),
+		RewardsSplits:   []rewardsTypes.RewardsSplit{{Address: rewardsAddr.String(), Weight: rewardsTypes.RewardsSplitWeightTotal}},
+	}
+
+	testCases := []testCase{
+		{
+			name:              "Not eligible: no metadata",
+			inflationRatio:    "0.2",
+			feeRebateRatio:    "0.5",
+			rewardsEnabledExp: true,
+		},
+		{
+			name:           "Not eligible: no metadata, rewards disabled",
+			inflationRatio: "0",
+			feeRebateRatio: "0",
+		},
+		{
+			name:              "Not eligible: no rewards recipients",
+			metadata:          noRecipientsMeta,
+			inflationRatio:    "0.2",
+			feeRebateRatio:    "0.5",
+			hasMetadataExp:    true,
+			rewardsEnabledExp: true,
+		},
+		{
+			name:           "Not eligible: no rewards recipients, rewards disabled",
+			metadata:       noRecipientsMeta,
+			inflationRatio: "0",
+			feeRebateRatio: "0",
+			hasMetadataExp: true,
+		},
+		{
+			name:                    "Not eligible: rewards disabled",
+			metadata:                rewardsAddrMeta,
+			inflationRatio:          "0",
+			feeRebateRatio:          "0",
+			hasMetadataExp:          true,
+			hasRewardsRecipientsExp: true,
+		},
+		{
+			name:                    "Eligible: rewards address",
+			metadata:                rewardsAddrMeta,
+			inflationRatio:          "0.2",
+			feeRebateRatio:          "0.5",
+			eligibleExp:             true,
+			hasMetadataExp:          true,
+			hasRewardsRecipientsExp: true,
+			rewardsEnabledExp:       true,
+		},
+		{
+			name:                    "Eligible: rewards splits",
+			metadata:                splitsMeta,
+			inflationRatio:          "0.2",
+			feeRebateRatio:          "0.5",
+			eligibleExp:             true,
+			hasMetadataExp:          true,
+			hasRewardsRecipientsExp: true,
+			rewardsEnabledExp:       true,
+		},
+		{
+			name:                    "Eligible: inflation rewards only",
+			metadata:                rewardsAddrMeta,
+			inflationRatio:          "0.2",
+			feeRebateRatio:          "0",
+			eligibleExp:             true,
+			hasMetadataExp:          true,
+			hasRewardsRecipientsExp: true,
+			rewardsEnabledExp:       true,
+		},
+		{
+			name:                    "Eligible: tx fee rebates only",
+			metadata:                rewardsAddrMeta,
+			inflationRatio:          "0",
+			feeRebateRatio:          "0.5",
+			eligibleExp:             true,
+			hasMetadataExp:          true,
+			hasRewardsRecipientsExp: true,
+			rewardsEnabledExp:       true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx, _ := testutils.RewardsKeeper(t)
+			querySrvr := keeper.NewQueryServer(k)
+
+			params := k.GetParams(ctx)
+			params.InflationRewardsRatio = math.LegacyMustNewDecFromStr(tc.inflationRatio)
+			params.TxFeeRebateRatio = math.LegacyMustNewDecFromStr(tc.feeRebateRatio)
+			require.NoError(t, k.Params.Set(ctx, params))
+			if tc.metadata != nil {
+				require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, *tc.metadata))
+			}
+
+			res, err := querySrvr.ContractRewardsEligibility(ctx, &rewardsTypes.QueryContractRewardsEligibilityRequest{
+				ContractAddress: contractAddr.String(),
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.eligibleExp, res.Eligible)
+			require.Equal(t, tc.hasMetadataExp, res.HasMetadata)
+			require.Equal(t, tc.hasRewardsRecipientsExp, res.HasRewardsRecipients)
+			require.Equal(t, tc.rewardsEnabledExp, res.RewardsEnabled)
+		})
+	}
+
+	t.Run("err: invalid request", func(t *testing.T) {
+		k, ctx, _ := testutils.RewardsKeeper(t)
+		querySrvr := keeper.NewQueryServer(k)
+
+		_, err := querySrvr.ContractRewardsEligibility(ctx, &rewardsTypes.QueryContractRewardsEligibilityRequest{ContractAddress: "invalid"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = querySrvr.ContractRewardsEligibility(ctx, nil)
+		require.Equal(t, status.Error(codes.InvalidArgument, "empty request"), err)
+	})
+}
+
+func TestGRPC_TopContractsByRewards(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	querySrvr := keeper.NewQueryServer(k)
+	ctx = ctx.WithBlockHeight(100)
+
+	contractAddrs := e2eTesting.GenContractAddresses(4)
+	setBlockRewards := func(height uint64, contractAddr sdk.AccAddress, rewards string) {
+		coins, err := sdk.ParseCoinsNormalized(rewards)
+		require.NoError(t, err)
+		require.NoError(t, k.ContractBlockRewards.Set(ctx, collections.Join(height, contractAddr.Bytes()), rewardsTypes.ContractRewards{
+			ContractAddress: contractAddr.String(),
+			Rewards:         coins,
+		}))
+	}
+
+	// Window of 10 blocks: 1st = 40stake, 2nd = 50stake, 3rd = 40stake, 4th = 0stake (other denom only)
+	// Window of 30 blocks: 3rd gets 100stake more
+	setBlockRewards(100, contractAddrs[0], "10stake")
+	setBlockRewards(100, contractAddrs[1], "50stake")
+	setBlockRewards(95, contractAddrs[0], "30stake")
+	setBlockRewards(95, contractAddrs[2], "40stake")
+	setBlockRewards(95, contractAddrs[3], "1000uarch")
+	setBlockRewards(80, contractAddrs[2], "100stake")
+
+	// Ties are ordered by the contract address
+	tiedFirst, tiedSecond := contractAddrs[0].String(), contractAddrs[2].String()
+	if tiedFirst > tiedSecond {
+		tiedFirst, tiedSecond = tiedSecond, tiedFirst
+	}
+
+	getRanking := func(res *rewardsTypes.QueryTopContractsByRewardsResponse) (contractAddrs, rewards []string) {
+		for _, contract := range res.Contracts {
+			contractAddrs = append(contractAddrs, contract.ContractAddress)
+			rewards = append(rewards, sdk.Coins(contract.Rewards).String())
+		}
+		return
+	}
+
+	t.Run("err: empty request", func(t *testing.T) {
+		_, err := querySrvr.TopContractsByRewards(ctx, nil)
+		require.Equal(t, status.Error(codes.InvalidArgument, "empty request"), err)
+	})
+
+	t.Run("err: invalid window", func(t *testing.T) {
+		_, err := querySrvr.TopContractsByRewards(ctx, &rewardsTypes.QueryTopContractsByRewardsRequest{Window: 0, Limit: 1})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = querySrvr.TopContractsByRewards(ctx, &rewardsTypes.QueryTopContractsByRewardsRequest{Window: rewardsTypes.ContractRewardsHistoryBlocks + 1, Limit: 1})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("err: invalid limit", func(t *testing.T) {
+		_, err := querySrvr.TopContractsByRewards(ctx, &rewardsTypes.QueryTopContractsByRewardsRequest{Window: 1, Limit: 0})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = querySrvr.TopContractsByRewards(ctx, &rewardsTypes.QueryTopContractsByRewardsRequest{Window: 1, Limit: rewardsTypes.MaxTopContractsLimit + 1})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("ok: current block only", func(t *testing.T) {
+		res, err := querySrvr.TopContractsByRewards(ctx, &rewardsTypes.QueryTopContractsByRewardsRequest{Window: 1, Limit: 10})
+		require.NoError(t, err)
+
+		addrs, rewards := getRanking(res)
+		require.Equal(t, []string{contractAddrs[1].String(), contractAddrs[0].String()}, addrs)
+		require.Equal(t, []string{"50stake", "10stake"}, rewards)
+	})
+
+	t.Run("ok: 10 blocks window", func(t *testing.T) {
+		res, err := querySrvr.TopContractsByRewards(ctx, &rewardsTypes.QueryTopContractsByRewardsRequest{Window: 10, Limit: 10})
+		require.NoError(t, err)
+
+		addrs, rewards := getRanking(res)
+		require.Equal(t, []string{contractAddrs[1].String(), tiedFirst, tiedSecond, contractAddrs[3].String()}, addrs)
+		require.Equal(t, []string{"50stake", "40stake", "40stake", "1000uarch"}, rewards)
+	})
+
+	t.Run("ok: 10 blocks window limited", func(t *testing.T) {
+		res, err := querySrvr.TopContractsByRewards(ctx, &rewardsTypes.QueryTopContractsByRewardsRequest{Window: 10, Limit: 2})
+		require.NoError(t, err)
+
+		addrs, _ := getRanking(res)
+		require.Equal(t, []string{contractAddrs[1].String(), tiedFirst}, addrs)
+	})
+
+	t.Run("ok: 30 blocks window", func(t *testing.T) {
+		res, err := querySrvr.TopContractsByRewards(ctx, &rewardsTypes.QueryTopContractsByRewardsRequest{Window: 30, Limit: 3})
+		require.NoError(t, err)
+
+		addrs, rewards := getRanking(res)
+		require.Equal(t, []string{contractAddrs[2].String(), contractAddrs[1].String(), contractAddrs[0].String()}, addrs)
+		require.Equal(t, []string{"140stake", "50stake", "40stake"}, rewards)
+	})
+
+	t.Run("ok: out of history blocks are pruned", func(t *testing.T) {
+		pruneCtx := ctx.WithBlockHeight(100 + rewardsTypes.ContractRewardsHistoryBlocks)
+		k.AllocateBlockRewards(pruneCtx, pruneCtx.BlockHeight()-1)
+
+		found, err := k.ContractBlockRewards.Has(ctx, collections.Join(uint64(100), contractAddrs[0].Bytes()))
+		require.NoError(t, err)
+		require.False(t, found)
+
+		found, err = k.ContractBlockRewards.Has(ctx, collections.Join(uint64(95), contractAddrs[0].Bytes()))
+		require.NoError(t, err)
+		require.True(t, found)
+	})
+}
+
+func TestGRPC_BlockRewardsTrackingRange(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	querySrvr := keeper.NewQueryServer(k)
+
+	// Tracking data for heights 2, 3 (inflation only) and 5 (inflation and tx rewards)
+	for _, height := range []int64{2, 3, 5} {
+		require.NoError(t, k.BlockRewards.Set(ctx, uint64(height), rewardsTypes.BlockRewards{
+			Height:           height,
+			InflationRewards: sdk.NewInt64Coin("stake", 100),
+			MaxGas:           1000,
+		}))
+	}
+	txRewards := rewardsTypes.TxRewards{
+		TxId:       1,
+		Height:     5,
+		FeeRewards: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+	}
+	require.NoError(t, k.TxRewards.Set(ctx, txRewards.TxId, txRewards))
+
+	t.Run("err: empty request", func(t *testing.T) {
+		_, err := querySrvr.BlockRewardsTrackingRange(ctx, nil)
+		require.Equal(t, status.Error(codes.InvalidArgument, "empty request"), err)
+	})
+
+	t.Run("err: invalid range", func(t *testing.T) {
+		_, err := querySrvr.BlockRewardsTrackingRange(ctx, &rewardsTypes.QueryBlockRewardsTrackingRangeRequest{FromHeight: 5, ToHeight: 2})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = querySrvr.BlockRewardsTrackingRange(ctx, &rewardsTypes.QueryBlockRewardsTrackingRangeRequest{FromHeight: 0, ToHeight: 2})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("err: range exceeds the limit", func(t *testing.T) {
+		limit := int64(rewardsTypes.MaxBlockTrackingRangeQueryLimit)
+
+		_, err := querySrvr.BlockRewardsTrackingRange(ctx, &rewardsTypes.QueryBlockRewardsTrackingRangeRequest{FromHeight: 1, ToHeight: limit + 1})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = querySrvr.BlockRewardsTrackingRange(ctx, &rewardsTypes.QueryBlockRewardsTrackingRangeRequest{FromHeight: 1, ToHeight: limit})
+		require.NoError(t, err)
+	})
+
+	t.Run("ok: gets block rewards tracking for the range", func(t *testing.T) {
+		res, err := querySrvr.BlockRewardsTrackingRange(ctx, &rewardsTypes.QueryBlockRewardsTrackingRangeRequest{FromHeight: 1, ToHeight: 5})
+		require.NoError(t, err)
+		require.Len(t, res.Blocks, 3)
+
+		require.EqualValues(t, 2, res.Blocks[0].InflationRewards.Height)
+		require.Empty(t, res.Blocks[0].TxRewards)
+		require.EqualValues(t, 3, res.Blocks[1].InflationRewards.Height)
+		require.Empty(t, res.Blocks[1].TxRewards)
+		require.EqualValues(t, 5, res.Blocks[2].InflationRewards.Height)
+		require.Equal(t, []rewardsTypes.TxRewards{txRewards}, res.Blocks[2].TxRewards)
+	})
+
+	t.Run("ok: empty range", func(t *testing.T) {
+		res, err := querySrvr.BlockRewardsTrackingRange(ctx, &rewardsTypes.QueryBlockRewardsTrackingRangeRequest{FromHeight: 6, ToHeight: 10})
+		require.NoError(t, err)
+		require.Empty(t, res.Blocks)
+	})
+}
+
+func TestGRPC_MinConsensusFeeDebug(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	querySrvr := keeper.NewQueryServer(k)
+
+	// Seed the fee inputs the same way the MintBankKeeper does
+	ctx = ctx.WithBlockHeight(10).WithBlockGasMeter(storetypes.NewGasMeter(1000))
+	inflationRewards := sdk.NewInt64Coin("stake", 100)
+	k.TrackInflationRewards(ctx, inflationRewards)
+	k.UpdateMinConsensusFee(ctx, inflationRewards)
+
+	// Block with an unlimited block gas (not eligible for the fee update)
+	require.NoError(t, k.BlockRewards.Set(ctx, 9, rewardsTypes.BlockRewards{
+		Height:           9,
+		InflationRewards: inflationRewards,
+	}))
+
+	storedFee, found := k.GetMinConsensusFee(ctx, "stake")
+	require.True(t, found)
+
+	t.Run("err: empty request", func(t *testing.T) {
+		_, err := querySrvr.MinConsensusFeeDebug(ctx, nil)
+		require.Equal(t, status.Error(codes.InvalidArgument, "empty request"), err)
+	})
+
+	t.Run("err: block rewards not found", func(t *testing.T) {
+		_, err := querySrvr.MinConsensusFeeDebug(ctx, &rewardsTypes.QueryMinConsensusFeeDebugRequest{Height: 5})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("ok: recomputed fee matches the stored one", func(t *testing.T) {
+		res, err := querySrvr.MinConsensusFeeDebug(ctx, &rewardsTypes.QueryMinConsensusFeeDebugRequest{})
+		require.NoError(t, err)
+
+		require.EqualValues(t, 10, res.BlockRewards.Height)
+		require.Equal(t, inflationRewards, res.BlockRewards.InflationRewards)
+		require.EqualValues(t, 1000, res.BlockRewards.MaxGas)
+		require.Equal(t, k.TxFeeRebateRatio(ctx), res.TxFeeRebateRatio)
+		require.Equal(t, storedFee, res.ComputedFee)
+		require.Equal(t, storedFee, res.StoredFee)
+	})
+
+	t.Run("ok: ineligible inputs", func(t *testing.T) {
+		res, err := querySrvr.MinConsensusFeeDebug(ctx, &rewardsTypes.QueryMinConsensusFeeDebugRequest{Height: 9})
+		require.NoError(t, err)
+		require.Empty(t, res.ComputedFee.Denom)
+		require.Equal(t, storedFee, res.StoredFee)
+	})
+}
+
+func TestGRPC_ProjectedMinConsensusFee(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	querySrvr := keeper.NewQueryServer(k)
+
+	ctx = ctx.WithBlockHeight(10).WithBlockGasMeter(storetypes.NewGasMeter(1000))
+
+	// seedTrend sets the synthetic block rewards for the recent blocks (the last one is the current block),
+	// the min consensus fee for a block is rewards / (1000 gas * (1 - 0.5 rebate ratio))
+	seedTrend := func(ctx sdk.Context, rewards ...int64) {
+		for i, amount := range rewards {
+			height := ctx.BlockHeight() - int64(len(rewards)) + int64(i) + 1
+			require.NoError(t, k.BlockRewards.Set(ctx, uint64(height), rewardsTypes.BlockRewards{
+				Height:           height,
+				InflationRewards: sdk.NewInt64Coin("stake", amount),
+				MaxGas:           1000,
+			}))
+		}
+		k.UpdateMinConsensusFee(ctx, sdk.NewInt64Coin("stake", rewards[len(rewards)-1]))
+	}
+
+	t.Run("err: empty request", func(t *testing.T) {
+		_, err := querySrvr.ProjectedMinConsensusFee(ctx, nil)
+		require.Equal(t, status.Error(codes.InvalidArgument, "empty request"), err)
+	})
+
+	t.Run("err: invalid window", func(t *testing.T) {
+		for _, window := range []uint64{0, rewardsTypes.MaxMinConsensusFeeProjectionWindow + 1} {
+			_, err := querySrvr.ProjectedMinConsensusFee(ctx, &rewardsTypes.QueryProjectedMinConsensusFeeRequest{Window: window})
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		}
+	})
+
+	t.Run("ok: rising trend is projected upwards", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		seedTrend(ctx, 100, 200, 300, 400, 500)
+
+		res, err := querySrvr.ProjectedMinConsensusFee(ctx, &rewardsTypes.QueryProjectedMinConsensusFeeRequest{Window: 5})
+		require.NoError(t, err)
+		require.Equal(t, "1.000000000000000000stake", res.CurrentGasPrice.String())
+		require.Equal(t, "1.200000000000000000stake", res.ProjectedGasPrice.String())
+		require.EqualValues(t, 5, res.BlocksUsed)
+	})
+
+	t.Run("ok: falling trend is projected downwards", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		seedTrend(ctx, 900, 800, 700, 600, 500)
+
+		res, err := querySrvr.ProjectedMinConsensusFee(ctx, &rewardsTypes.QueryProjectedMinConsensusFeeRequest{Window: 5})
+		require.NoError(t, err)
+		require.Equal(t, "1.000000000000000000stake", res.CurrentGasPrice.String())
+		require.Equal(t, "0.800000000000000000stake", res.ProjectedGasPrice.String())
+	})
+
+	t.Run("ok: projection is monotonic in the trend", func(t *testing.T) {
+		var prevProjected math.LegacyDec
+		for i, growth := range []int64{-100, -50, 0, 50, 100} {
+			ctx, _ := ctx.CacheContext()
+			seedTrend(ctx, 500-3*growth, 500-2*growth, 500-growth, 500)
+
+			res, err := querySrvr.ProjectedMinConsensusFee(ctx, &rewardsTypes.QueryProjectedMinConsensusFeeRequest{Window: 4})
+			require.NoError(t, err)
+
+			projected := res.ProjectedGasPrice.Amount
+			switch {
+			case growth > 0:
+				require.True(t, projected.GT(res.CurrentGasPrice.Amount))
+			case growth < 0:
+				require.True(t, projected.LT(res.CurrentGasPrice.Amount))
+			default:
+				require.True(t, projected.Equal(res.CurrentGasPrice.Amount))
+			}
+			if i > 0 {
+				require.True(t, projected.GT(prevProjected))
+			}
+			prevProjected = projected
+		}
+	})
+
+	t.Run("ok: projection is floored by the min price of gas", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		seedTrend(ctx, 1500, 1000, 500)
+
+		params := k.GetParams(ctx)
+		params.MinPriceOfGas = sdk.NewDecCoinFromDec("stake", math.LegacyNewDecWithPrec(5, 1))
+		require.NoError(t, k.Params.Set(ctx, params))
+
+		res, err := querySrvr.ProjectedMinConsensusFee(ctx, &rewardsTypes.QueryProjectedMinConsensusFeeRequest{Window: 3})
+		require.NoError(t, err)
+		require.Equal(t, "0.500000000000000000stake", res.ProjectedGasPrice.String())
+	})
+
+	t.Run("ok: not enough history", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		seedTrend(ctx, 100, 500)
+
+		res, err := querySrvr.ProjectedMinConsensusFee(ctx, &rewardsTypes.QueryProjectedMinConsensusFeeRequest{Window: 1})
+		require.NoError(t, err)
+		require.Equal(t, res.CurrentGasPrice, res.ProjectedGasPrice)
+		require.EqualValues(t, 1, res.BlocksUsed)
+	})
+}
+
+func TestGRPC_DistributionConfig(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	querySrvr := keeper.NewQueryServer(k)
+
+	t.Run("Fail: empty request", func(t *testing.T) {
+		_, err := querySrvr.DistributionConfig(ctx, nil)
+		require.Equal(t, status.Error(codes.InvalidArgument, "empty request"), err)
+	})
+
+	t.Run("OK: reflects the current params", func(t *testing.T) {
+		params := rewardsTypes.DefaultParams()
+		params.InflationRewardsRatio = math.LegacyNewDecWithPrec(1, 1)
+		params.TxFeeRebateRatio = math.LegacyNewDecWithPrec(3, 1)
+		params.MinPriceOfGas = sdk.NewDecCoinFromDec("uarch", math.LegacyNewDecWithPrec(5, 2))
+		params.MinFeeFloorEnabled = true
+		params.DynamicFeeEnabled = true
+		params.FlatFeeDeliverTxOnly = true
+		params.FlatFeeUpdateInterval = 10
+		params.MaxFlatFeeUpdateContracts = 20
+		require.NoError(t, k.Params.Set(ctx, params))
+
+		res, err := querySrvr.DistributionConfig(ctx, &rewardsTypes.QueryDistributionConfigRequest{})
+		require.NoError(t, err)
+		require.Equal(t, k.GetDistributionConfig(ctx), res.Config)
+		require.Equal(t, rewardsTypes.DistributionConfig{
+			InflationRewardsRatio:     math.LegacyNewDecWithPrec(1, 1),
+			TxFeeRebateRatio:          math.LegacyNewDecWithPrec(3, 1),
+			MinPriceOfGas:             sdk.NewDecCoinFromDec("uarch", math.LegacyNewDecWithPrec(5, 2)),
+			MinFeeFloorEnabled:        true,
+			DynamicFeeEnabled:         true,
+			FlatFeeDeliverTxOnly:      true,
+			FlatFeeUpdateInterval:     10,
+			MaxFlatFeeUpdateContracts: 20,
+			FlatFeesEnabled:           true,
+		}, res.Config)
+	})
+
+	t.Run("OK: follows the params update", func(t *testing.T) {
+		require.NoError(t, k.SetRewardsRatios(ctx, math.LegacyNewDecWithPrec(2, 1), math.LegacyNewDecWithPrec(4, 1)))
+
+		res, err := querySrvr.DistributionConfig(ctx, &rewardsTypes.QueryDistributionConfigRequest{})
+		require.NoError(t, err)
+		require.Equal(t, math.LegacyNewDecWithPrec(2, 1), res.Config.InflationRewardsRatio)
+		require.Equal(t, math.LegacyNewDecWithPrec(4, 1), res.Config.TxFeeRebateRatio)
+		require.EqualValues(t, 10, res.Config.FlatFeeUpdateInterval)
+	})
+}
+
+func TestGRPC_AcceptedFeeDenoms(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	querySrvr := keeper.NewQueryServer(k)
+
+	bondDenom := rewardsTypes.DefaultMinPriceOfGas.Denom
+	require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec(bondDenom, math.LegacyNewDec(10)),
+		sdk.NewDecCoinFromDec("uusdc", math.LegacyNewDecWithPrec(25, 3)),
+	)}))
+
+	setAcceptedFeeDenoms := func(denoms ...string) {
+		params := k.GetParams(ctx)
+		params.AcceptedFeeDenoms = denoms
+		require.NoError(t, k.Params.Set(ctx, params))
+	}
+
+	t.Run("Fail: empty request", func(t *testing.T) {
+		_, err := querySrvr.AcceptedFeeDenoms(ctx, nil)
+		require.Equal(t, status.Error(codes.InvalidArgument, "empty request"), err)
+	})
+
+	t.Run("OK: any denom accepted", func(t *testing.T) {
+		setAcceptedFeeDenoms()
+
+		res, err := querySrvr.AcceptedFeeDenoms(ctx, &rewardsTypes.QueryAcceptedFeeDenomsRequest{})
+		require.NoError(t, err)
+		require.True(t, res.AnyDenomAccepted)
+		require.Equal(t, []sdk.DecCoin{
+			sdk.NewDecCoinFromDec(bondDenom, math.LegacyNewDec(10)),
+			sdk.NewDecCoinFromDec("uusdc", math.LegacyNewDecWithPrec(25, 3)),
+		}, res.MinGasPrices)
+	})
+
+	t.Run("OK: accepted denoms follow the params", func(t *testing.T) {
+		setAcceptedFeeDenoms("uatom", bondDenom, "uusdc")
+
+		res, err := querySrvr.AcceptedFeeDenoms(ctx, &rewardsTypes.QueryAcceptedFeeDenomsRequest{})
+		require.NoError(t, err)
+		require.False(t, res.AnyDenomAccepted)
+		require.Equal(t, []sdk.DecCoin{
+			sdk.NewDecCoinFromDec(bondDenom, math.LegacyNewDec(10)),
+			sdk.NewDecCoinFromDec("uatom", math.LegacyZeroDec()),
+			sdk.NewDecCoinFromDec("uusdc", math.LegacyNewDecWithPrec(25, 3)),
+		}, res.MinGasPrices)
+	})
+
+	t.Run("OK: bond denom is priced by the computational price of gas", func(t *testing.T) {
+		params := k.GetParams(ctx)
+		params.MinPriceOfGas = sdk.NewDecCoinFromDec(bondDenom, math.LegacyNewDec(20))
+		require.NoError(t, k.Params.Set(ctx, params))
+
+		res, err := querySrvr.AcceptedFeeDenoms(ctx, &rewardsTypes.QueryAcceptedFeeDenomsRequest{})
+		require.NoError(t, err)
+		require.Equal(t, k.ComputationalPriceOfGas(ctx), res.MinGasPrices[0])
+		require.Equal(t, sdk.NewDecCoinFromDec(bondDenom, math.LegacyNewDec(20)), res.MinGasPrices[0])
+	})
+}
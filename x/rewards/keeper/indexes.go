@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/archway-network/archway/x/rewards/types"
+)
+
+// IndexesRebuildResult defines the number of primary state entries the secondary indexes were rebuilt for.
+type IndexesRebuildResult struct {
+	ContractsNum          uint64 // contracts with metadata (code ID index and the metadata counter)
+	RewardsRecordsNum     uint64 // rewards records (rewards address and address height indexes)
+	TxRewardsNum          uint64 // tx rewards (block index)
+	TxFeeDistributionsNum uint64 // tx fee distributions (block and tx hash indexes)
+}
+
+// RebuildIndexes regenerates all the module secondary indexes from the primary state:
+// existing index entries are removed (including the stale ones) and new entries are created for every primary object.
+// The ContractMetadataCount counter is reset to the number of ContractMetadata entries.
+// The operation is atomic as long as the context is (a failed tx / gov proposal reverts the store changes).
+func (k Keeper) RebuildIndexes(ctx sdk.Context) (res IndexesRebuildResult, err error) {
+	store := ctx.KVStore(k.storeKey)
+
+	if res.ContractsNum, err = rebuildIndexedMap(ctx, store, k.ContractCodeIDs, types.ContractCodeIDIndexPrefix); err != nil {
+		return res, fmt.Errorf("rebuilding contract code IDs indexes: %w", err)
+	}
+	if res.RewardsRecordsNum, err = rebuildIndexedMap(ctx, store, k.RewardsRecords, types.RewardsRecordAddressIndexPrefix, types.RewardsRecordAddressHeightIndexPrefix); err != nil {
+		return res, fmt.Errorf("rebuilding rewards records indexes: %w", err)
+	}
+	if res.TxRewardsNum, err = rebuildIndexedMap(ctx, store, k.TxRewards, types.TxRewardsHeightIndexPrefix); err != nil {
+		return res, fmt.Errorf("rebuilding tx rewards indexes: %w", err)
+	}
+	if res.TxFeeDistributionsNum, err = rebuildIndexedMap(ctx, store, k.TxFeeDistributions, types.TxFeeDistributionHeightIndexPrefix, types.TxFeeDistributionHashIndexPrefix); err != nil {
+		return res, fmt.Errorf("rebuilding tx fee distributions indexes: %w", err)
+	}
+
+	// Contract metadata has no secondary indexes, but the counter is derived from it as well
+	var metadataNum uint64
+	err = k.ContractMetadata.Walk(ctx, nil, func(_ []byte, _ types.ContractMetadata) (bool, error) {
+		metadataNum++
+		return false, nil
+	})
+	if err != nil {
+		return res, fmt.Errorf("counting contract metadata: %w", err)
+	}
+	if err := k.ContractMetadataCount.Set(ctx, metadataNum); err != nil {
+		return res, fmt.Errorf("setting contract metadata count: %w", err)
+	}
+
+	return res, nil
+}
+
+// rebuildIndexedMap removes all the entries stored under the given index prefixes and references every primary object
+// of the indexed map in all its indexes. Returns the number of primary objects.
+func rebuildIndexedMap[K, V any, I collections.Indexes[K, V]](ctx sdk.Context, store storetypes.KVStore, im *collections.IndexedMap[K, V, I], indexPrefixes ...collections.Prefix) (uint64, error) {
+	for _, indexPrefix := range indexPrefixes {
+		clearStorePrefix(store, indexPrefix.Bytes())
+	}
+
+	// Collect the primary objects first to avoid writing to the store while iterating over it
+	iter, err := im.Iterate(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return 0, err
+	}
+
+	noOldValue := func() (V, error) {
+		var v V
+		return v, collections.ErrNotFound
+	}
+	for _, kv := range kvs {
+		for _, index := range im.Indexes.IndexesList() {
+			if err := index.Reference(ctx, kv.Key, kv.Value, noOldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return uint64(len(kvs)), nil
+}
+
+// clearStorePrefix removes all the store entries with the given key prefix.
+func clearStorePrefix(store storetypes.KVStore, keyPrefix []byte) {
+	iter := storetypes.KVStorePrefixIterator(store, keyPrefix)
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -17,13 +17,7 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 // If that one fails, calculated and stored rewards records are not "supported" by real tokens.
 func ModuleAccountBalanceInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		poolCurrent := k.UndistributedRewardsPool(ctx)
-
-		poolExpected := sdk.NewCoins()
-		err := k.RewardsRecords.Walk(ctx, nil, func(key uint64, value types.RewardsRecord) (stop bool, err error) {
-			poolExpected = poolExpected.Add(value.Rewards...)
-			return false, nil
-		})
+		poolCurrent, poolExpected, err := k.RewardsPoolSolvency(ctx)
 		if err != nil {
 			return sdk.FormatInvariant(types.ModuleName, "module account and total rewards records coins",
 					"unable to compute rewards",
@@ -41,3 +35,18 @@ func ModuleAccountBalanceInvariant(k Keeper) sdk.Invariant {
 		), broken
 	}
 }
+
+// RewardsPoolSolvency returns the current rewards pool balance and the total rewards of the rewards records pending
+// withdrawal (the pool liabilities). The pool is solvent if the balance is GTE the liabilities.
+func (k Keeper) RewardsPoolSolvency(ctx sdk.Context) (pool, liabilities sdk.Coins, err error) {
+	liabilities = sdk.NewCoins()
+	err = k.RewardsRecords.Walk(ctx, nil, func(key uint64, value types.RewardsRecord) (stop bool, err error) {
+		liabilities = liabilities.Add(value.Rewards...)
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return k.UndistributedRewardsPool(ctx), liabilities, nil
+}
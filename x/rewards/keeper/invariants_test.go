@@ -9,6 +9,8 @@ import (
 	mintTypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	e2eTesting "github.com/archway-network/archway/e2e/testing"
 	"github.com/archway-network/archway/x/rewards/keeper"
@@ -172,3 +174,60 @@ func TestRewardsModuleAccountInvariant(t *testing.T) {
 		})
 	}
 }
+
+func TestGRPC_RewardsPoolSolvency(t *testing.T) {
+	chain := e2eTesting.NewTestChain(t, 1)
+	ctx := chain.GetContext()
+	keepers := chain.GetApp().Keepers
+	k := keepers.RewardsKeeper
+	querySrvr := keeper.NewQueryServer(k)
+
+	accAddr, _ := e2eTesting.GenAccounts(1)
+	mockTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// Seed a 100stake pool (not empty due to inflation rewards for previous blocks) and a 75stake record
+	poolInitial := k.UndistributedRewardsPool(ctx)
+	require.NoError(t, keepers.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ContractRewardCollector, mintTypes.ModuleName, poolInitial))
+
+	poolCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+	require.NoError(t, keepers.BankKeeper.MintCoins(ctx, mintTypes.ModuleName, poolCoins))
+	require.NoError(t, keepers.BankKeeper.SendCoinsFromModuleToModule(ctx, mintTypes.ModuleName, types.ContractRewardCollector, poolCoins))
+
+	setRecord := func(id uint64, rewards sdk.Coins) {
+		require.NoError(t, k.RewardsRecordID.Set(ctx, id))
+		require.NoError(t, k.RewardsRecords.Set(ctx, id, types.RewardsRecord{
+			Id:               id,
+			RewardsAddress:   accAddr[0].String(),
+			Rewards:          rewards,
+			CalculatedHeight: 1,
+			CalculatedTime:   mockTime,
+		}))
+	}
+	setRecord(1, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(75))))
+
+	t.Run("Fail: empty request", func(t *testing.T) {
+		_, err := querySrvr.RewardsPoolSolvency(ctx, nil)
+		require.Equal(t, status.Error(codes.InvalidArgument, "empty request"), err)
+	})
+
+	t.Run("OK: solvent", func(t *testing.T) {
+		res, err := querySrvr.RewardsPoolSolvency(ctx, &types.QueryRewardsPoolSolvencyRequest{})
+		require.NoError(t, err)
+		assert.True(t, res.Solvent)
+		assert.Equal(t, "100stake", sdk.Coins(res.PoolBalance).String())
+		assert.Equal(t, "75stake", sdk.Coins(res.Liabilities).String())
+	})
+
+	t.Run("OK: insolvent (doesn't panic)", func(t *testing.T) {
+		setRecord(2, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(50)), sdk.NewCoin("uarch", math.NewInt(1))))
+
+		res, err := querySrvr.RewardsPoolSolvency(ctx, &types.QueryRewardsPoolSolvencyRequest{})
+		require.NoError(t, err)
+		assert.False(t, res.Solvent)
+		assert.Equal(t, "100stake", sdk.Coins(res.PoolBalance).String())
+		assert.Equal(t, "125stake,1uarch", sdk.Coins(res.Liabilities).String())
+
+		_, broken := keeper.ModuleAccountBalanceInvariant(k)(ctx)
+		assert.True(t, broken)
+	})
+}
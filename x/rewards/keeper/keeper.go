@@ -2,19 +2,23 @@ package keeper
 
 import (
 	"context"
+	stdMath "math"
 	"time"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/collections/indexes"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	math "cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 	wasmTypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	cmtBytes "github.com/cometbft/cometbft/libs/bytes"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	ibcTransferTypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 
 	"github.com/archway-network/archway/internal/collcompat"
 
@@ -27,6 +31,11 @@ type ContractInfoReaderExpected interface {
 	GetContractInfo(ctx context.Context, contractAddress sdk.AccAddress) *wasmTypes.ContractInfo
 }
 
+// ContractSudoerExpected defines the interface for the x/wasmd module dependency used to invoke contracts.
+type ContractSudoerExpected interface {
+	Sudo(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+}
+
 // TrackingKeeperExpected defines the interface for the x/tracking module dependency.
 type TrackingKeeperExpected interface {
 	GetCurrentTxID(ctx sdk.Context) uint64
@@ -43,11 +52,18 @@ type AuthKeeperExpected interface {
 // BankKeeperExpected defines the interface for the x/bank module dependency.
 type BankKeeperExpected interface {
 	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	BlockedAddr(addr sdk.AccAddress) bool
 }
 
+// TransferKeeperExpected defines the interface for the IBC transfer module dependency.
+type TransferKeeperExpected interface {
+	GetDenomTrace(ctx sdk.Context, denomTraceHash cmtBytes.HexBytes) (ibcTransferTypes.DenomTrace, bool)
+	Transfer(ctx context.Context, msg *ibcTransferTypes.MsgTransfer) (*ibcTransferTypes.MsgTransferResponse, error)
+}
+
 func NewTxRewardsIndex(sb *collections.SchemaBuilder) TxRewardsIndex {
 	return TxRewardsIndex{
 		Block: indexes.NewMulti(sb, types.TxRewardsHeightIndexPrefix, "tx_rewards_by_block", collections.Uint64Key, collections.Uint64Key, func(_ uint64, value types.TxRewards) (uint64, error) {
@@ -65,13 +81,37 @@ func (t TxRewardsIndex) IndexesList() []collections.Index[uint64, types.TxReward
 	return []collections.Index[uint64, types.TxRewards]{t.Block}
 }
 
+func NewTxFeeDistributionsIndex(sb *collections.SchemaBuilder) TxFeeDistributionsIndex {
+	return TxFeeDistributionsIndex{
+		Block: indexes.NewMulti(sb, types.TxFeeDistributionHeightIndexPrefix, "tx_fee_distributions_by_block", collections.Uint64Key, collections.Uint64Key, func(_ uint64, value types.TxFeeDistribution) (uint64, error) {
+			return uint64(value.Height), nil
+		}),
+		TxHash: indexes.NewMulti(sb, types.TxFeeDistributionHashIndexPrefix, "tx_fee_distributions_by_tx_hash", collections.StringKey, collections.Uint64Key, func(_ uint64, value types.TxFeeDistribution) (string, error) {
+			return value.TxHash, nil
+		}),
+	}
+}
+
+type TxFeeDistributionsIndex struct {
+	// Block is the index that maps block height to the TxFeeDistribution for that block.
+	Block *indexes.Multi[uint64, uint64, types.TxFeeDistribution]
+	// TxHash is the index that maps tx hash to the TxFeeDistribution for that tx.
+	TxHash *indexes.Multi[string, uint64, types.TxFeeDistribution]
+}
+
+func (t TxFeeDistributionsIndex) IndexesList() []collections.Index[uint64, types.TxFeeDistribution] {
+	return []collections.Index[uint64, types.TxFeeDistribution]{t.Block, t.TxHash}
+}
+
 type RewardsRecordsIndex struct {
 	// Address maps the rewards record to the address of the recipient.
 	Address *indexes.Multi[[]byte, uint64, types.RewardsRecord]
+	// AddressHeight maps the rewards record to the address of the recipient and the height the record was created at.
+	AddressHeight *indexes.Multi[collections.Pair[[]byte, uint64], uint64, types.RewardsRecord]
 }
 
 func (t RewardsRecordsIndex) IndexesList() []collections.Index[uint64, types.RewardsRecord] {
-	return []collections.Index[uint64, types.RewardsRecord]{t.Address}
+	return []collections.Index[uint64, types.RewardsRecord]{t.Address, t.AddressHeight}
 }
 
 func NewRewardsRecordsIndex(sb *collections.SchemaBuilder) RewardsRecordsIndex {
@@ -79,6 +119,30 @@ func NewRewardsRecordsIndex(sb *collections.SchemaBuilder) RewardsRecordsIndex {
 		Address: indexes.NewMulti(sb, types.RewardsRecordAddressIndexPrefix, "rewards_records_by_address", collections.BytesKey, collections.Uint64Key, func(_ uint64, value types.RewardsRecord) ([]byte, error) {
 			return sdk.AccAddressFromBech32(value.RewardsAddress)
 		}),
+		AddressHeight: indexes.NewMulti(sb, types.RewardsRecordAddressHeightIndexPrefix, "rewards_records_by_address_height", collections.PairKeyCodec(collections.BytesKey, collections.Uint64Key), collections.Uint64Key, func(_ uint64, value types.RewardsRecord) (collections.Pair[[]byte, uint64], error) {
+			addr, err := sdk.AccAddressFromBech32(value.RewardsAddress)
+			if err != nil {
+				return collections.Pair[[]byte, uint64]{}, err
+			}
+			return collections.Join(addr.Bytes(), uint64(value.CalculatedHeight)), nil
+		}),
+	}
+}
+
+type ContractCodeIDsIndex struct {
+	// CodeID maps the contract to the code ID it was instantiated from.
+	CodeID *indexes.Multi[uint64, []byte, uint64]
+}
+
+func (t ContractCodeIDsIndex) IndexesList() []collections.Index[[]byte, uint64] {
+	return []collections.Index[[]byte, uint64]{t.CodeID}
+}
+
+func NewContractCodeIDsIndex(sb *collections.SchemaBuilder) ContractCodeIDsIndex {
+	return ContractCodeIDsIndex{
+		CodeID: indexes.NewMulti(sb, types.ContractCodeIDIndexPrefix, "contracts_by_code_id", collections.Uint64Key, collections.BytesKey, func(_ []byte, value uint64) (uint64, error) {
+			return value, nil
+		}),
 	}
 }
 
@@ -87,22 +151,76 @@ type Keeper struct {
 	cdc              codec.Codec
 	storeKey         storetypes.StoreKey
 	contractInfoView ContractInfoReaderExpected
+	contractSudoer   ContractSudoerExpected
 	trackingKeeper   TrackingKeeperExpected
 	authKeeper       AuthKeeperExpected
 	bankKeeper       BankKeeperExpected
+	transferKeeper   TransferKeeperExpected
 	authority        string // this should be the x/gov module account
 	logger           log.Logger
+	hooks            types.RewardsHooks
 
 	Schema collections.Schema
 
-	Params           collections.Item[types.Params]
-	MinConsFee       collections.Item[sdk.DecCoin]
-	ContractMetadata collections.Map[[]byte, types.ContractMetadata]
-	BlockRewards     collections.Map[uint64, types.BlockRewards]
-	FlatFees         collections.Map[[]byte, sdk.Coin]
+	Params     collections.Item[types.Params]
+	MinConsFee collections.Item[types.MinConsensusFees]
+	// MinConsFeeHistory tracks the minimum consensus fee computed per block (recent blocks only).
+	MinConsFeeHistory collections.Map[uint64, sdk.DecCoin]
+	ContractMetadata  collections.Map[[]byte, types.ContractMetadata]
+	// ContractMetadataCount tracks the number of ContractMetadata entries (to avoid full iteration).
+	ContractMetadataCount collections.Item[uint64]
+	// ContractCodeIDs tracks the code ID of each contract with metadata (indexed by code ID).
+	ContractCodeIDs *collections.IndexedMap[[]byte, uint64, ContractCodeIDsIndex]
+	BlockRewards    collections.Map[uint64, types.BlockRewards]
+	FlatFees        collections.Map[[]byte, sdk.Coin]
+	// FlatFeeUpdateHeights tracks the last flat fee update block height for each contract.
+	FlatFeeUpdateHeights collections.Map[[]byte, uint64]
+	// FlatFeeSchedules tracks the optional flat fee schedule for each contract (the flat fee is interpolated if set).
+	FlatFeeSchedules collections.Map[[]byte, types.FlatFeeSchedule]
 	TxRewards        *collections.IndexedMap[uint64, types.TxRewards, TxRewardsIndex]
 	RewardsRecordID  collections.Sequence
 	RewardsRecords   *collections.IndexedMap[uint64, types.RewardsRecord, RewardsRecordsIndex]
+	// TxFeeDistributions tracks how the fees were distributed for each tx.
+	TxFeeDistributions *collections.IndexedMap[uint64, types.TxFeeDistribution, TxFeeDistributionsIndex]
+	// ContractRewardsStats tracks the lifetime and recent rewards distributed for each contract.
+	ContractRewardsStats collections.Map[[]byte, types.ContractRewardsStats]
+	// ContractTxRewards tracks the rewards each contract earned attributable to a tx [key: height, txID, contract].
+	ContractTxRewards collections.Map[collections.Triple[uint64, uint64, []byte], types.ContractTxRewards]
+	// ContractBlockRewards tracks the rewards distributed for each contract per block (recent blocks only).
+	ContractBlockRewards collections.Map[collections.Pair[uint64, []byte], types.ContractRewards]
+	// ContractBlockFlatFees tracks the flat fees collected for each contract per block (recent blocks only).
+	ContractBlockFlatFees collections.Map[collections.Pair[uint64, []byte], types.ContractRewards]
+	// BlockPoolInflows tracks the tokens entered the rewards pool per block by source (recent blocks only).
+	BlockPoolInflows collections.Map[uint64, types.BlockPoolInflows]
+	// FreeTxsUsed tracks the number of fee-free transactions used by each account.
+	FreeTxsUsed collections.Map[[]byte, uint64]
+	// FlatFeePayouts tracks the flat fees collected within the current block to be paid out directly
+	// (key: contract address, rewards address).
+	FlatFeePayouts collections.Map[collections.Pair[[]byte, []byte], types.ContractRewards]
+	// MethodFlatFees tracks the flat fees charged for specific execute msg methods of a contract
+	// (key: contract address, method name).
+	MethodFlatFees collections.Map[collections.Pair[[]byte, string], sdk.Coin]
+	// FlatFeeBlockCharges tracks the hash of the transaction charged the contract flat fee within the current block
+	// (key: contract address).
+	FlatFeeBlockCharges collections.Map[[]byte, []byte]
+	// FlatFeeCredits tracks the number of prepaid contract executions left (key: contract address).
+	FlatFeeCredits collections.Map[[]byte, uint64]
+	// FlatFeeOverrides tracks the governance flat fee overrides (key: contract address).
+	FlatFeeOverrides collections.Map[[]byte, types.FlatFeeOverride]
+	// FlatFeeMultipliers tracks the optional minimum consensus fee multiplier the contract flat fee is charged
+	// relative to (key: contract address).
+	FlatFeeMultipliers collections.Map[[]byte, math.LegacyDec]
+	// CodeFlatFees tracks the governance default flat fee for contracts of a code ID (key: code ID).
+	CodeFlatFees collections.Map[uint64, sdk.Coin]
+	// RewardsRemainders tracks the sub-unit rewards carried over to the next distribution for each contract
+	// (key: contract address, denom).
+	RewardsRemainders collections.Map[collections.Pair[[]byte, string], math.LegacyDec]
+	// RewardsRemaindersTotal tracks the sum of all contracts rewards remainders (key: denom).
+	RewardsRemaindersTotal collections.Map[string, math.LegacyDec]
+	// ScheduledRewardsRatios tracks the governance rewards ratios changes queued (key: activation height).
+	ScheduledRewardsRatios collections.Map[int64, types.ScheduledRewardsRatios]
+	// DistributedHeights tracks the block heights the rewards are distributed for (recent blocks only).
+	DistributedHeights collections.KeySet[uint64]
 }
 
 // NewKeeper creates a new Keeper instance.
@@ -110,9 +228,11 @@ func NewKeeper(
 	cdc codec.Codec,
 	key storetypes.StoreKey,
 	contractInfoReader ContractInfoReaderExpected,
+	contractSudoer ContractSudoerExpected,
 	trackingKeeper TrackingKeeperExpected,
 	ak AuthKeeperExpected,
 	bk BankKeeperExpected,
+	tk TransferKeeperExpected,
 	authority string,
 	logger log.Logger,
 ) Keeper {
@@ -122,9 +242,11 @@ func NewKeeper(
 		storeKey:         key,
 		cdc:              cdc,
 		contractInfoView: contractInfoReader,
+		contractSudoer:   contractSudoer,
 		trackingKeeper:   trackingKeeper,
 		authKeeper:       ak,
 		bankKeeper:       bk,
+		transferKeeper:   tk,
 		authority:        authority,
 		logger:           logger.With("module", "x/"+types.ModuleName),
 		Params: collections.NewItem(
@@ -137,6 +259,13 @@ func NewKeeper(
 			schemaBuilder,
 			types.MinConsFeePrefix,
 			"min_consensus_fee",
+			collcompat.ProtoValue[types.MinConsensusFees](cdc),
+		),
+		MinConsFeeHistory: collections.NewMap(
+			schemaBuilder,
+			types.MinConsFeeHistoryPrefix,
+			"min_consensus_fee_history",
+			collections.Uint64Key,
 			collcompat.ProtoValue[sdk.DecCoin](cdc),
 		),
 		BlockRewards: collections.NewMap(
@@ -153,6 +282,20 @@ func NewKeeper(
 			collections.BytesKey,
 			collcompat.ProtoValue[types.ContractMetadata](cdc),
 		),
+		ContractMetadataCount: collections.NewItem(
+			schemaBuilder,
+			types.ContractMetadataCountPrefix,
+			"contract_metadata_count",
+			collections.Uint64Value,
+		),
+		ContractCodeIDs: collections.NewIndexedMap(
+			schemaBuilder,
+			types.ContractCodeIDPrefix,
+			"contract_code_ids",
+			collections.BytesKey,
+			collections.Uint64Value,
+			NewContractCodeIDsIndex(schemaBuilder),
+		),
 		FlatFees: collections.NewMap(
 			schemaBuilder,
 			types.FlatFeePrefix,
@@ -160,6 +303,27 @@ func NewKeeper(
 			collections.BytesKey,
 			collcompat.ProtoValue[sdk.Coin](cdc),
 		),
+		FlatFeeUpdateHeights: collections.NewMap(
+			schemaBuilder,
+			types.FlatFeeUpdateHeightPrefix,
+			"flat_fee_update_heights",
+			collections.BytesKey,
+			collections.Uint64Value,
+		),
+		FlatFeeSchedules: collections.NewMap(
+			schemaBuilder,
+			types.FlatFeeSchedulePrefix,
+			"flat_fee_schedules",
+			collections.BytesKey,
+			collcompat.ProtoValue[types.FlatFeeSchedule](cdc),
+		),
+		FlatFeePayouts: collections.NewMap(
+			schemaBuilder,
+			types.FlatFeePayoutPrefix,
+			"flat_fee_payouts",
+			collections.PairKeyCodec(collections.BytesKey, collections.BytesKey),
+			collcompat.ProtoValue[types.ContractRewards](cdc),
+		),
 		TxRewards: collections.NewIndexedMap(
 			schemaBuilder,
 			types.TxRewardsPrefix,
@@ -177,6 +341,125 @@ func NewKeeper(
 			collcompat.ProtoValue[types.RewardsRecord](cdc),
 			NewRewardsRecordsIndex(schemaBuilder),
 		),
+		TxFeeDistributions: collections.NewIndexedMap(
+			schemaBuilder,
+			types.TxFeeDistributionPrefix,
+			"tx_fee_distributions",
+			collections.Uint64Key,
+			collcompat.ProtoValue[types.TxFeeDistribution](cdc),
+			NewTxFeeDistributionsIndex(schemaBuilder),
+		),
+		ContractRewardsStats: collections.NewMap(
+			schemaBuilder,
+			types.ContractRewardsStatsPrefix,
+			"contract_rewards_stats",
+			collections.BytesKey,
+			collcompat.ProtoValue[types.ContractRewardsStats](cdc),
+		),
+		ContractTxRewards: collections.NewMap(
+			schemaBuilder,
+			types.ContractTxRewardsPrefix,
+			"contract_tx_rewards",
+			collections.TripleKeyCodec(collections.Uint64Key, collections.Uint64Key, collections.BytesKey),
+			collcompat.ProtoValue[types.ContractTxRewards](cdc),
+		),
+		ContractBlockRewards: collections.NewMap(
+			schemaBuilder,
+			types.ContractBlockRewardsPrefix,
+			"contract_block_rewards",
+			collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey),
+			collcompat.ProtoValue[types.ContractRewards](cdc),
+		),
+		ContractBlockFlatFees: collections.NewMap(
+			schemaBuilder,
+			types.ContractBlockFlatFeesPrefix,
+			"contract_block_flat_fees",
+			collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey),
+			collcompat.ProtoValue[types.ContractRewards](cdc),
+		),
+		BlockPoolInflows: collections.NewMap(
+			schemaBuilder,
+			types.BlockPoolInflowsPrefix,
+			"block_pool_inflows",
+			collections.Uint64Key,
+			collcompat.ProtoValue[types.BlockPoolInflows](cdc),
+		),
+		FreeTxsUsed: collections.NewMap(
+			schemaBuilder,
+			types.FreeTxsUsedPrefix,
+			"free_txs_used",
+			collections.BytesKey,
+			collections.Uint64Value,
+		),
+		MethodFlatFees: collections.NewMap(
+			schemaBuilder,
+			types.MethodFlatFeePrefix,
+			"method_flat_fees",
+			collections.PairKeyCodec(collections.BytesKey, collections.StringKey),
+			collcompat.ProtoValue[sdk.Coin](cdc),
+		),
+		FlatFeeBlockCharges: collections.NewMap(
+			schemaBuilder,
+			types.FlatFeeBlockChargePrefix,
+			"flat_fee_block_charges",
+			collections.BytesKey,
+			collections.BytesValue,
+		),
+		FlatFeeCredits: collections.NewMap(
+			schemaBuilder,
+			types.FlatFeeCreditPrefix,
+			"flat_fee_credits",
+			collections.BytesKey,
+			collections.Uint64Value,
+		),
+		FlatFeeOverrides: collections.NewMap(
+			schemaBuilder,
+			types.FlatFeeOverridePrefix,
+			"flat_fee_overrides",
+			collections.BytesKey,
+			collcompat.ProtoValue[types.FlatFeeOverride](cdc),
+		),
+		FlatFeeMultipliers: collections.NewMap(
+			schemaBuilder,
+			types.FlatFeeMultiplierPrefix,
+			"flat_fee_multipliers",
+			collections.BytesKey,
+			sdk.LegacyDecValue,
+		),
+		CodeFlatFees: collections.NewMap(
+			schemaBuilder,
+			types.CodeFlatFeePrefix,
+			"code_flat_fees",
+			collections.Uint64Key,
+			collcompat.ProtoValue[sdk.Coin](cdc),
+		),
+		RewardsRemainders: collections.NewMap(
+			schemaBuilder,
+			types.RewardsRemainderPrefix,
+			"rewards_remainders",
+			collections.PairKeyCodec(collections.BytesKey, collections.StringKey),
+			sdk.LegacyDecValue,
+		),
+		RewardsRemaindersTotal: collections.NewMap(
+			schemaBuilder,
+			types.RewardsRemainderTotalPrefix,
+			"rewards_remainders_total",
+			collections.StringKey,
+			sdk.LegacyDecValue,
+		),
+		ScheduledRewardsRatios: collections.NewMap(
+			schemaBuilder,
+			types.ScheduledRewardsRatiosPrefix,
+			"scheduled_rewards_ratios",
+			collections.Int64Key,
+			collcompat.ProtoValue[types.ScheduledRewardsRatios](cdc),
+		),
+		DistributedHeights: collections.NewKeySet(
+			schemaBuilder,
+			types.DistributedHeightsPrefix,
+			"distributed_heights",
+			collections.Uint64Key,
+		),
 	}
 
 	schema, err := schemaBuilder.Build()
@@ -194,6 +477,38 @@ func (k *Keeper) SetContractInfoViewer(viewer ContractInfoReaderExpected) {
 	k.contractInfoView = viewer
 }
 
+// SetContractSudoer sets the contract sudo call dependency.
+// Only for testing purposes.
+func (k *Keeper) SetContractSudoer(sudoer ContractSudoerExpected) {
+	k.contractSudoer = sudoer
+}
+
+// SetTransferKeeper sets the IBC transfer keeper dependency.
+// Only for testing purposes.
+func (k *Keeper) SetTransferKeeper(tk TransferKeeperExpected) {
+	k.transferKeeper = tk
+}
+
+// SetHooks sets the rewards hooks.
+// Hooks must be set right after the keeper is created, before the keeper is passed to other modules by value.
+func (k *Keeper) SetHooks(rh types.RewardsHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set rewards hooks twice")
+	}
+	k.hooks = rh
+
+	return k
+}
+
+// Hooks returns the rewards hooks (a no-op set if none were registered).
+func (k Keeper) Hooks() types.RewardsHooks {
+	if k.hooks == nil {
+		return types.MultiRewardsHooks{}
+	}
+
+	return k.hooks
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return k.logger
@@ -238,6 +553,7 @@ func (k Keeper) GetTxRewardsByBlock(ctx context.Context, height uint64) ([]types
 func (k Keeper) CreateRewardsRecord(
 	ctx context.Context,
 	withdrawAddr sdk.AccAddress,
+	contractAddr sdk.AccAddress,
 	rewards sdk.Coins,
 	calculatedHeight int64,
 	calculatedTime time.Time,
@@ -252,6 +568,7 @@ func (k Keeper) CreateRewardsRecord(
 		Rewards:          rewards,
 		CalculatedHeight: calculatedHeight,
 		CalculatedTime:   calculatedTime,
+		ContractAddress:  contractAddr.String(),
 	}
 	return obj, k.RewardsRecords.Set(ctx, obj.Id, obj)
 }
@@ -288,6 +605,107 @@ func (k Keeper) GetRewardsRecordsByWithdrawAddressPaginated(ctx sdk.Context, add
 	return objs, pageRes, nil
 }
 
+// GetRewardsRecordsByAddressAndHeightRange returns the rewards records for a given rewards address created within
+// the [fromHeight, toHeight] block range paginated (ordered by height).
+// Only the key based pagination is supported, the next key is the (height, ID) of the next record.
+// Query checks the page limit and uses the default limit if not provided.
+// CONTRACT: toHeight must be LT math.MaxUint64.
+func (k Keeper) GetRewardsRecordsByAddressAndHeightRange(ctx sdk.Context, rewardsAddr sdk.AccAddress, fromHeight, toHeight uint64, pageReq *query.PageRequest) ([]types.RewardsRecord, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset != 0 || pageReq.CountTotal || pageReq.Reverse {
+		return nil, nil, errorsmod.Wrap(types.ErrInvalidRequest, "only key based pagination is supported")
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = types.MaxRecordsQueryLimit
+	}
+	if limit > types.MaxRecordsQueryLimit {
+		return nil, nil, errorsmod.Wrapf(types.ErrInvalidRequest, "max records (%d) query limit exceeded", types.MaxRecordsQueryLimit)
+	}
+
+	startHeight, startID := fromHeight, uint64(0)
+	if len(pageReq.Key) != 0 {
+		if len(pageReq.Key) != 16 {
+			return nil, nil, errorsmod.Wrap(types.ErrInvalidRequest, "invalid pagination key")
+		}
+		startHeight, startID = sdk.BigEndianToUint64(pageReq.Key[:8]), sdk.BigEndianToUint64(pageReq.Key[8:])
+		if startHeight < fromHeight || startHeight > toHeight {
+			return nil, nil, errorsmod.Wrap(types.ErrInvalidRequest, "pagination key is out of the height range")
+		}
+	}
+
+	rng := new(collections.Range[collections.Pair[collections.Pair[[]byte, uint64], uint64]]).
+		StartInclusive(collections.Join(collections.Join(rewardsAddr.Bytes(), startHeight), startID)).
+		EndExclusive(collections.Join(collections.Join(rewardsAddr.Bytes(), toHeight+1), uint64(0)))
+	iter, err := k.RewardsRecords.Indexes.AddressHeight.Iterate(ctx, rng)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iter.Close()
+
+	var records []types.RewardsRecord
+	pageRes := &query.PageResponse{}
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.FullKey()
+		if err != nil {
+			return nil, nil, err
+		}
+		if uint64(len(records)) == limit {
+			pageRes.NextKey = append(sdk.Uint64ToBigEndian(key.K1().K2()), sdk.Uint64ToBigEndian(key.K2())...)
+			break
+		}
+
+		record, err := k.RewardsRecords.Get(ctx, key.K2())
+		if err != nil {
+			return nil, nil, err
+		}
+		records = append(records, record)
+	}
+
+	return records, pageRes, nil
+}
+
+// RewardsSince returns the rewards records for a given rewards address created at or after the given block height
+// paginated (ordered by height).
+// Pagination options and limits are the GetRewardsRecordsByAddressAndHeightRange ones.
+func (k Keeper) RewardsSince(ctx sdk.Context, rewardsAddr sdk.AccAddress, height uint64, pageReq *query.PageRequest) ([]types.RewardsRecord, *query.PageResponse, error) {
+	// Records height is int64, so the range covers all the records created since the height
+	return k.GetRewardsRecordsByAddressAndHeightRange(ctx, rewardsAddr, height, stdMath.MaxInt64, pageReq)
+}
+
+// MigrateRewardsRecords re-points the outstanding rewards records of the given contract from the previous rewards address to the new one.
+// Records created for other contracts (or before the record contract address was introduced) are kept as is.
+// Only up to MaxWithdrawRecords records of the previous rewards address (records of other contracts included) are iterated,
+// migration is rejected if there are more (those should be withdrawn first).
+// Returns the number of migrated records.
+func (k Keeper) MigrateRewardsRecords(ctx sdk.Context, contractAddr, prevRewardsAddr, newRewardsAddr sdk.AccAddress) (uint64, error) {
+	recordsLimit := k.MaxWithdrawRecords(ctx)
+	records, pageRes, err := k.GetRewardsRecordsByWithdrawAddressPaginated(ctx, prevRewardsAddr, &query.PageRequest{Limit: recordsLimit})
+	if err != nil {
+		return 0, err
+	}
+	if len(pageRes.NextKey) != 0 {
+		return 0, errorsmod.Wrapf(types.ErrInvalidRequest, "max migrate records (%d) exceeded: withdraw the previous rewards address records first", recordsLimit)
+	}
+
+	var migrated uint64
+	for _, record := range records {
+		if record.ContractAddress != contractAddr.String() {
+			continue
+		}
+
+		record.RewardsAddress = newRewardsAddr.String()
+		if err := k.RewardsRecords.Set(ctx, record.Id, record); err != nil {
+			return 0, err
+		}
+		migrated++
+	}
+
+	return migrated, nil
+}
+
 // GetAuthority returns the x/rewards module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -2,7 +2,9 @@ package keeper
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/archway-network/archway/x/rewards/types"
 )
@@ -25,6 +27,40 @@ func (k Keeper) SetContractMetadata(ctx sdk.Context, senderAddr, contractAddr sd
 		if k.isBlockedAddress(addr) {
 			return types.ErrInvalidRequest.Wrap("rewards address cannot be a blocked address")
 		}
+		if k.isModuleAccount(ctx, addr) {
+			return types.ErrInvalidRequest.Wrap("rewards address cannot be a module account")
+		}
+	}
+
+	for _, split := range metaUpdates.RewardsSplits {
+		addr, err := sdk.AccAddressFromBech32(split.Address)
+		if err != nil {
+			return err
+		}
+		if k.isBlockedAddress(addr) {
+			return types.ErrInvalidRequest.Wrap("rewards split address cannot be a blocked address")
+		}
+		if k.isModuleAccount(ctx, addr) {
+			return types.ErrInvalidRequest.Wrap("rewards split address cannot be a module account")
+		}
+	}
+
+	if metaUpdates.HasRewardsCallback() {
+		addr, err := sdk.AccAddressFromBech32(metaUpdates.RewardsCallbackAddress)
+		if err != nil {
+			return err
+		}
+		if k.contractInfoView.GetContractInfo(ctx, addr) == nil {
+			return types.ErrInvalidRequest.Wrap("rewards callback address must be a contract")
+		}
+	}
+
+	// Contract could only narrow the chain accepted fee denoms down
+	acceptedDenoms := k.AcceptedFeeDenoms(ctx)
+	for _, denom := range metaUpdates.FlatFeeAcceptedDenoms {
+		if !types.IsFeeDenomAccepted(acceptedDenoms, denom) {
+			return types.ErrInvalidRequest.Wrapf("flat fee accepted denom %s is not accepted by the chain (accepted denoms: %v)", denom, acceptedDenoms)
+		}
 	}
 
 	// Check ownership
@@ -40,8 +76,9 @@ func (k Keeper) SetContractMetadata(ctx sdk.Context, senderAddr, contractAddr sd
 	}
 
 	// Build the updated meta
+	isNew := err != nil
 	metaNew := metaOld
-	if err != nil {
+	if isNew {
 		metaNew.ContractAddress = contractAddr.String()
 		metaNew.OwnerAddress = senderAddr.String()
 	}
@@ -51,15 +88,51 @@ func (k Keeper) SetContractMetadata(ctx sdk.Context, senderAddr, contractAddr sd
 	if metaUpdates.HasRewardsAddress() {
 		metaNew.RewardsAddress = metaUpdates.RewardsAddress
 	}
+	if metaUpdates.HasFlatFeeExemptCallers() {
+		metaNew.FlatFeeExemptCallers = metaUpdates.FlatFeeExemptCallers
+	}
+	if metaUpdates.HasFlatFeeAcceptedDenoms() {
+		metaNew.FlatFeeAcceptedDenoms = metaUpdates.FlatFeeAcceptedDenoms
+	}
+	if metaUpdates.HasRewardsSplits() {
+		metaNew.RewardsSplits = metaUpdates.RewardsSplits
+	}
 	if metaUpdates.WithdrawToWallet != metaOld.WithdrawToWallet {
 		metaNew.WithdrawToWallet = metaUpdates.WithdrawToWallet
 	}
+	if metaUpdates.FlatFeeDirectPayout != metaOld.FlatFeeDirectPayout {
+		metaNew.FlatFeeDirectPayout = metaUpdates.FlatFeeDirectPayout
+	}
+	if metaUpdates.FlatFeeOnSuccess != metaOld.FlatFeeOnSuccess {
+		metaNew.FlatFeeOnSuccess = metaUpdates.FlatFeeOnSuccess
+	}
+	if metaUpdates.GasRebateMultiplier != 0 {
+		metaNew.GasRebateMultiplier = metaUpdates.GasRebateMultiplier
+	}
+	if metaUpdates.HasRewardsCallback() {
+		metaNew.RewardsCallbackAddress = metaUpdates.RewardsCallbackAddress
+	}
+	if metaUpdates.RewardsCallbackShare != 0 {
+		metaNew.RewardsCallbackShare = metaUpdates.RewardsCallbackShare
+	}
+	if metaNew.HasRewardsCallback() != (metaNew.RewardsCallbackShare > 0) {
+		return types.ErrInvalidRequest.Wrap("rewards callback address and share must be set together")
+	}
 
 	// Set
 	err = k.ContractMetadata.Set(ctx, contractAddr, metaNew)
 	if err != nil {
 		return err
 	}
+	if isNew {
+		if err := k.ContractMetadataCount.Set(ctx, k.GetContractMetadataCount(ctx)+1); err != nil {
+			return err
+		}
+	}
+	// Refresh the code ID index entry on every update (the contract could have been migrated to a new code)
+	if err := k.ContractCodeIDs.Set(ctx, contractAddr, contractInfo.CodeID); err != nil {
+		return err
+	}
 
 	// Emit event
 	types.EmitContractMetadataSetEvent(
@@ -81,6 +154,340 @@ func (k Keeper) GetContractMetadata(ctx sdk.Context, contractAddr sdk.AccAddress
 	return &meta
 }
 
+// IsContractEligibleForRewards returns whether the contract is credited the dApp rewards along with the underlying conditions:
+// the contract metadata with the rewards address or rewards splits is set (the rewards distribution filter) and
+// the module distributes rewards (the inflation rewards or the tx fee rebate ratio is non-zero).
+func (k Keeper) IsContractEligibleForRewards(ctx sdk.Context, contractAddr sdk.AccAddress) (bool, types.ContractRewardsEligibility) {
+	var eligibility types.ContractRewardsEligibility
+	if meta := k.GetContractMetadata(ctx, contractAddr); meta != nil {
+		eligibility.HasMetadata = true
+		eligibility.HasRewardsRecipients = meta.HasRewardsRecipients()
+	}
+	eligibility.RewardsEnabled = k.InflationRewardsRatio(ctx).IsPositive() || k.TxFeeRebateRatio(ctx).IsPositive()
+
+	return eligibility.IsEligible(), eligibility
+}
+
+// TransferContractOwnership updates the contract metadata owner and (if set) the rewards address at once verifying the ownership.
+// Other metadata fields are kept. If migrateRecords is set and the rewards address is changed, the outstanding contract
+// rewards records of the previous rewards address are re-pointed to the new one.
+// Returns the number of migrated records.
+func (k Keeper) TransferContractOwnership(ctx sdk.Context, senderAddr, contractAddr, newOwnerAddr, newRewardsAddr sdk.AccAddress, migrateRecords bool) (uint64, error) {
+	metaOld := k.GetContractMetadata(ctx, contractAddr)
+	if metaOld == nil {
+		return 0, types.ErrMetadataNotFound
+	}
+
+	// Boolean fields are always applied by SetContractMetadata, so the current values are passed
+	metaUpdates := types.ContractMetadata{
+		ContractAddress:     contractAddr.String(),
+		OwnerAddress:        newOwnerAddr.String(),
+		WithdrawToWallet:    metaOld.WithdrawToWallet,
+		FlatFeeDirectPayout: metaOld.FlatFeeDirectPayout,
+		FlatFeeOnSuccess:    metaOld.FlatFeeOnSuccess,
+	}
+	if !newRewardsAddr.Empty() {
+		metaUpdates.RewardsAddress = newRewardsAddr.String()
+	}
+
+	if err := k.SetContractMetadata(ctx, senderAddr, contractAddr, metaUpdates); err != nil {
+		return 0, err
+	}
+
+	if !migrateRecords || !metaOld.HasRewardsAddress() || !metaUpdates.HasRewardsAddress() || metaOld.RewardsAddress == metaUpdates.RewardsAddress {
+		return 0, nil
+	}
+
+	prevRewardsAddr, err := sdk.AccAddressFromBech32(metaOld.RewardsAddress)
+	if err != nil {
+		return 0, err // returning error "as is" since the stored address is expected to be valid
+	}
+
+	return k.MigrateRewardsRecords(ctx, contractAddr, prevRewardsAddr, newRewardsAddr)
+}
+
+// RemoveContractMetadata removes the contract metadata verifying the ownership.
+// Dependent state (flat fee, its schedule, rate-limit height, prepaid executions credits and the rewards remainder)
+// is removed as well.
+// If the sweepAddr is set, outstanding contract rewards (RewardsRecord objects created for this contract
+// credited to the metadata rewards address or rewards split recipients) are sent to that address.
+// Otherwise, the records are kept and could be withdrawn by their rewards addresses.
+func (k Keeper) RemoveContractMetadata(ctx sdk.Context, senderAddr, contractAddr sdk.AccAddress, sweepAddr sdk.AccAddress) (sdk.Coins, error) {
+	meta, err := k.ContractMetadata.Get(ctx, contractAddr)
+	if err != nil {
+		return nil, types.ErrMetadataNotFound
+	}
+	if meta.OwnerAddress != senderAddr.String() {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "metadata can only be removed by the contract owner")
+	}
+
+	sweptRewards := sdk.NewCoins()
+	if sweepAddr != nil {
+		if k.isBlockedAddress(sweepAddr) {
+			return nil, types.ErrInvalidRequest.Wrap("rewards sweep address cannot be a blocked address")
+		}
+
+		if sweptRewards, err = k.sweepContractRewards(ctx, contractAddr, meta, sweepAddr); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.ContractMetadata.Remove(ctx, contractAddr); err != nil {
+		return nil, err
+	}
+	if count := k.GetContractMetadataCount(ctx); count > 0 {
+		if err := k.ContractMetadataCount.Set(ctx, count-1); err != nil {
+			return nil, err
+		}
+	}
+	if err := k.ContractCodeIDs.Remove(ctx, contractAddr); err != nil {
+		return nil, err
+	}
+	if err := k.FlatFees.Remove(ctx, contractAddr); err != nil {
+		return nil, err
+	}
+	if err := k.FlatFeeUpdateHeights.Remove(ctx, contractAddr); err != nil {
+		return nil, err
+	}
+	if err := k.FlatFeeSchedules.Remove(ctx, contractAddr); err != nil {
+		return nil, err
+	}
+	if err := k.removeMethodFlatFees(ctx, contractAddr); err != nil {
+		return nil, err
+	}
+	if err := k.FlatFeeCredits.Remove(ctx, contractAddr); err != nil {
+		return nil, err
+	}
+	if err := k.FlatFeeMultipliers.Remove(ctx, contractAddr); err != nil {
+		return nil, err
+	}
+	if err := k.FlatFeeOverrides.Remove(ctx, contractAddr); err != nil {
+		return nil, err
+	}
+	if err := k.removeRewardsRemainder(ctx, contractAddr); err != nil {
+		return nil, err
+	}
+
+	types.EmitContractMetadataRemovedEvent(ctx, contractAddr, sweepAddr, sweptRewards)
+
+	return sweptRewards, nil
+}
+
+// RecoverContractRewards sends the contract outstanding rewards (RewardsRecord objects created for this contract
+// credited to the metadata rewards address or rewards split recipients) and the pending rewards (flat fees queued
+// for the direct payout within the current block) to the recovery address.
+// This is a governance operation: the contract ownership is not checked. The contract metadata is not changed,
+// so rewards distributed afterwards are still credited to the metadata recipients.
+func (k Keeper) RecoverContractRewards(ctx sdk.Context, contractAddr, recoveryAddr sdk.AccAddress) (sdk.Coins, error) {
+	meta, err := k.ContractMetadata.Get(ctx, contractAddr)
+	if err != nil {
+		return nil, types.ErrMetadataNotFound
+	}
+	if k.isBlockedAddress(recoveryAddr) {
+		return nil, types.ErrInvalidRequest.Wrap("rewards recovery address cannot be a blocked address")
+	}
+
+	recoveredRewards, err := k.sweepContractRewards(ctx, contractAddr, meta, recoveryAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	pendingRewards, err := k.sweepFlatFeePayouts(ctx, contractAddr, recoveryAddr)
+	if err != nil {
+		return nil, err
+	}
+	recoveredRewards = recoveredRewards.Add(pendingRewards...)
+
+	types.EmitContractRewardsRecoveredEvent(ctx, contractAddr, recoveryAddr, recoveredRewards)
+
+	return recoveredRewards, nil
+}
+
+// sweepContractRewards sends all the outstanding rewards credited by the contract to the given address and prunes the used records.
+func (k Keeper) sweepContractRewards(ctx sdk.Context, contractAddr sdk.AccAddress, meta types.ContractMetadata, sweepAddr sdk.AccAddress) (sdk.Coins, error) {
+	records, _, err := k.getContractRewardsRecords(ctx, contractAddr, meta, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	totalRewards := sdk.NewCoins()
+	for _, record := range records {
+		totalRewards = totalRewards.Add(record.Rewards...)
+	}
+
+	if !totalRewards.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ContractRewardCollector, sweepAddr, totalRewards); err != nil {
+			return nil, errorsmod.Wrapf(types.ErrInternal, "sending rewards (%s) to the sweep address (%s): %v", totalRewards, sweepAddr, err)
+		}
+	}
+
+	if err := fastRemoveRecords(ctx, k.storeKey, k.RewardsRecords, records...); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInternal, err.Error())
+	}
+
+	return totalRewards, nil
+}
+
+// GetContractRewardsRecords returns all the RewardsRecord objects created for the contract that are not withdrawn yet
+// (credited to the metadata rewards address or rewards split recipients).
+// Returns nil if the contract metadata is not set.
+func (k Keeper) GetContractRewardsRecords(ctx sdk.Context, contractAddr sdk.AccAddress) ([]types.RewardsRecord, error) {
+	meta, err := k.ContractMetadata.Get(ctx, contractAddr)
+	if err != nil {
+		return nil, nil
+	}
+
+	records, _, err := k.getContractRewardsRecords(ctx, contractAddr, meta, 0)
+	return records, err
+}
+
+// GetContractRewardsRecordsLimited is the GetContractRewardsRecords version that iterates over at most limit records
+// of the metadata recipients (records credited by other contracts are counted too).
+// Returns true if the limit was reached before all the recipients records are iterated (result is incomplete).
+func (k Keeper) GetContractRewardsRecordsLimited(ctx sdk.Context, contractAddr sdk.AccAddress, limit uint64) ([]types.RewardsRecord, bool, error) {
+	meta, err := k.ContractMetadata.Get(ctx, contractAddr)
+	if err != nil {
+		return nil, false, nil
+	}
+
+	return k.getContractRewardsRecords(ctx, contractAddr, meta, limit)
+}
+
+// getContractRewardsRecords returns the RewardsRecord objects created for the contract credited to the metadata recipients.
+// Iterates over at most limit recipients records (0 means no limit), returns true if the limit was reached.
+func (k Keeper) getContractRewardsRecords(ctx sdk.Context, contractAddr sdk.AccAddress, meta types.ContractMetadata, limit uint64) ([]types.RewardsRecord, bool, error) {
+	// Rewards address could also be one of the split recipients
+	recipients := make([]string, 0, len(meta.RewardsSplits)+1)
+	recipientSet := make(map[string]struct{}, len(meta.RewardsSplits)+1)
+	addRecipient := func(addr string) {
+		if _, ok := recipientSet[addr]; ok {
+			return
+		}
+		recipientSet[addr] = struct{}{}
+		recipients = append(recipients, addr)
+	}
+	if meta.HasRewardsAddress() {
+		addRecipient(meta.RewardsAddress)
+	}
+	for _, split := range meta.RewardsSplits {
+		addRecipient(split.Address)
+	}
+
+	contractAddrStr := contractAddr.String()
+	var records []types.RewardsRecord
+	var iterated uint64
+	collectRecipientRecords := func(recipient sdk.AccAddress) (bool, error) {
+		iter, err := k.RewardsRecords.Indexes.Address.MatchExact(ctx, recipient)
+		if err != nil {
+			return false, err
+		}
+		defer iter.Close()
+
+		for ; iter.Valid(); iter.Next() {
+			if limit != 0 && iterated == limit {
+				return true, nil
+			}
+			iterated++
+
+			id, err := iter.PrimaryKey()
+			if err != nil {
+				return false, err
+			}
+			record, err := k.RewardsRecords.Get(ctx, id)
+			if err != nil {
+				return false, err
+			}
+			if record.ContractAddress != contractAddrStr {
+				continue
+			}
+			records = append(records, record)
+		}
+
+		return false, nil
+	}
+
+	for _, recipient := range recipients {
+		limitReached, err := collectRecipientRecords(sdk.MustAccAddressFromBech32(recipient))
+		if err != nil {
+			return nil, false, errorsmod.Wrap(types.ErrInternal, err.Error())
+		}
+		if limitReached {
+			return records, true, nil
+		}
+	}
+
+	return records, false, nil
+}
+
+// GetContractMetadataCount returns the number of contracts with metadata set.
+func (k Keeper) GetContractMetadataCount(ctx sdk.Context) uint64 {
+	count, err := k.ContractMetadataCount.Get(ctx)
+	if err != nil {
+		return 0
+	}
+
+	return count
+}
+
+// GetContractsByCodeID returns the addresses of contracts with metadata set instantiated from the given code ID paginated.
+// Query checks the page limit and uses the default limit if not provided.
+// The code ID is the one the contract had on the latest metadata update.
+func (k Keeper) GetContractsByCodeID(ctx sdk.Context, codeID uint64, pageReq *query.PageRequest) ([]sdk.AccAddress, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{
+			Limit: types.MaxContractsByCodeIDQueryLimit,
+		}
+	}
+	if pageReq.Limit > types.MaxContractsByCodeIDQueryLimit {
+		return nil, nil, errorsmod.Wrapf(types.ErrInvalidRequest, "max contracts (%d) query limit exceeded", types.MaxContractsByCodeIDQueryLimit)
+	}
+
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		append(types.ContractCodeIDIndexPrefix.Bytes(), sdk.Uint64ToBigEndian(codeID)...),
+	)
+	var contractAddrs []sdk.AccAddress
+	pageRes, err := query.Paginate(store, pageReq, func(key, _ []byte) error {
+		contractAddrs = append(contractAddrs, sdk.AccAddress(key))
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return contractAddrs, pageRes, nil
+}
+
+// IterateContractsByCodeID iterates over the addresses of contracts with metadata set instantiated from the given code ID.
+// Iteration stops if the callback returns true.
+func (k Keeper) IterateContractsByCodeID(ctx sdk.Context, codeID uint64, cb func(contractAddr sdk.AccAddress) (stop bool)) error {
+	iter, err := k.ContractCodeIDs.Indexes.CodeID.MatchExact(ctx, codeID)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		contractAddr, err := iter.PrimaryKey()
+		if err != nil {
+			return err
+		}
+		if cb(contractAddr) {
+			break
+		}
+	}
+
+	return nil
+}
+
 func (k Keeper) isBlockedAddress(addr sdk.AccAddress) bool {
 	return k.bankKeeper.BlockedAddr(addr)
 }
+
+// isModuleAccount checks if the address belongs to an existing module account.
+// Not all module accounts are blocked from receiving funds (x/gov, for example), but paying rewards to one
+// creates accounting loops or locks the funds.
+func (k Keeper) isModuleAccount(ctx sdk.Context, addr sdk.AccAddress) bool {
+	_, ok := k.authKeeper.GetAccount(ctx, addr).(sdk.ModuleAccountI)
+	return ok
+}
@@ -4,11 +4,14 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/require"
 
 	e2eTesting "github.com/archway-network/archway/e2e/testing"
 	"github.com/archway-network/archway/pkg/testutils"
+	"github.com/archway-network/archway/x/rewards/keeper"
 	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
 )
 
@@ -61,6 +64,53 @@ func TestSetContractMetadata(t *testing.T) {
 		require.Equal(t, metaCurrent, *metaReceived)
 	})
 
+	t.Run("OK: set RewardsSplits", func(t *testing.T) {
+		metaCurrent.RewardsSplits = []rewardsTypes.RewardsSplit{
+			{Address: rewardAddr.String(), Weight: 2500},
+			{Address: otherAcc.String(), Weight: 7500},
+		}
+
+		err := k.SetContractMetadata(ctx, contractAdminAcc, contractAddr, metaCurrent)
+		require.NoError(t, err)
+
+		metaReceived := k.GetContractMetadata(ctx, contractAddr)
+		require.NotNil(t, metaReceived)
+		require.Equal(t, metaCurrent, *metaReceived)
+	})
+
+	t.Run("Fail: unable to set rewards split to a module account", func(t *testing.T) {
+		metaUpdates := metaCurrent
+		metaUpdates.RewardsSplits = []rewardsTypes.RewardsSplit{
+			{Address: authtypes.NewModuleAddress("distribution").String(), Weight: 10000},
+		}
+
+		err := k.SetContractMetadata(ctx, contractAdminAcc, contractAddr, metaUpdates)
+		require.ErrorIs(t, err, rewardsTypes.ErrInvalidRequest)
+	})
+
+	t.Run("OK: set FlatFeeAcceptedDenoms", func(t *testing.T) {
+		params := k.GetParams(ctx)
+		params.AcceptedFeeDenoms = []string{"stake", "uarch"}
+		require.NoError(t, k.Params.Set(ctx, params))
+
+		metaCurrent.FlatFeeAcceptedDenoms = []string{"uarch"}
+
+		err := k.SetContractMetadata(ctx, contractAdminAcc, contractAddr, metaCurrent)
+		require.NoError(t, err)
+
+		metaReceived := k.GetContractMetadata(ctx, contractAddr)
+		require.NotNil(t, metaReceived)
+		require.Equal(t, metaCurrent, *metaReceived)
+	})
+
+	t.Run("Fail: FlatFeeAcceptedDenoms denom is not accepted by the chain", func(t *testing.T) {
+		metaUpdates := metaCurrent
+		metaUpdates.FlatFeeAcceptedDenoms = []string{"uarch", "ibc/usdc"}
+
+		err := k.SetContractMetadata(ctx, contractAdminAcc, contractAddr, metaUpdates)
+		require.ErrorIs(t, err, rewardsTypes.ErrInvalidRequest)
+	})
+
 	t.Run("OK: update OwnerAddr (change ownership)", func(t *testing.T) {
 		metaCurrent.OwnerAddress = otherAcc.String()
 
@@ -84,3 +134,300 @@ func TestSetContractMetadata(t *testing.T) {
 		require.ErrorIs(t, err, rewardsTypes.ErrInvalidRequest)
 	})
 }
+
+func TestSetContractMetadataRewardsTarget(t *testing.T) {
+	chain := e2eTesting.NewTestChain(t, 1)
+	keepers := chain.GetApp().Keepers
+	k := keepers.RewardsKeeper
+	ctx := chain.GetContext()
+
+	wk := testutils.NewMockContractViewer()
+	k.SetContractInfoViewer(wk)
+	contractAdminAcc := chain.GetAccount(0).Address
+	contractAddrs := e2eTesting.GenContractAddresses(2)
+	contractAddr, otherContractAddr := contractAddrs[0], contractAddrs[1]
+	wk.AddContractAdmin(contractAddr.String(), contractAdminAcc.String())
+
+	// x/gov module account is not blocked from receiving funds
+	govAddr := keepers.AccountKeeper.GetModuleAddress(govtypes.ModuleName)
+	require.False(t, keepers.BankKeeper.BlockedAddr(govAddr))
+
+	type testCase struct {
+		name        string
+		rewardsAddr sdk.AccAddress
+		errExpected error
+	}
+
+	testCases := []testCase{
+		{
+			name:        "OK: account",
+			rewardsAddr: chain.GetAccount(0).Address,
+		},
+		{
+			name:        "OK: contract",
+			rewardsAddr: otherContractAddr,
+		},
+		{
+			name:        "Fail: blocked module account",
+			rewardsAddr: keepers.AccountKeeper.GetModuleAddress(rewardsTypes.ContractRewardCollector),
+			errExpected: rewardsTypes.ErrInvalidRequest,
+		},
+		{
+			name:        "Fail: non-blocked module account",
+			rewardsAddr: govAddr,
+			errExpected: rewardsTypes.ErrInvalidRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			meta := rewardsTypes.ContractMetadata{
+				ContractAddress: contractAddr.String(),
+				OwnerAddress:    contractAdminAcc.String(),
+				RewardsAddress:  tc.rewardsAddr.String(),
+			}
+			err := k.SetContractMetadata(ctx, contractAdminAcc, contractAddr, meta)
+			if tc.errExpected != nil {
+				require.ErrorIs(t, err, tc.errExpected)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.rewardsAddr.String(), k.GetContractMetadata(ctx, contractAddr).RewardsAddress)
+			}
+
+			meta.RewardsAddress = ""
+			meta.RewardsSplits = []rewardsTypes.RewardsSplit{{Address: tc.rewardsAddr.String(), Weight: 10000}}
+			err = k.SetContractMetadata(ctx, contractAdminAcc, contractAddr, meta)
+			if tc.errExpected != nil {
+				require.ErrorIs(t, err, tc.errExpected)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestContractMetadataCount(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	wk := testutils.NewMockContractViewer()
+	k.SetContractInfoViewer(wk)
+	contractAdminAcc := testutils.AccAddress()
+
+	contractAddrs := e2eTesting.GenContractAddresses(3)
+	for _, contractAddr := range contractAddrs {
+		wk.AddContractAdmin(contractAddr.String(), contractAdminAcc.String())
+	}
+	require.EqualValues(t, 0, k.GetContractMetadataCount(ctx))
+
+	t.Run("OK: new metadata entries are counted", func(t *testing.T) {
+		for i, contractAddr := range contractAddrs {
+			err := k.SetContractMetadata(ctx, contractAdminAcc, contractAddr, rewardsTypes.ContractMetadata{})
+			require.NoError(t, err)
+			require.EqualValues(t, i+1, k.GetContractMetadataCount(ctx))
+		}
+	})
+
+	t.Run("OK: metadata updates are not counted", func(t *testing.T) {
+		err := k.SetContractMetadata(ctx, contractAdminAcc, contractAddrs[0], rewardsTypes.ContractMetadata{
+			RewardsAddress: contractAdminAcc.String(),
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, 3, k.GetContractMetadataCount(ctx))
+	})
+
+	t.Run("OK: query", func(t *testing.T) {
+		res, err := keeper.NewQueryServer(k).ContractMetadataCount(ctx, &rewardsTypes.QueryContractMetadataCountRequest{})
+		require.NoError(t, err)
+		require.EqualValues(t, 3, res.Count)
+	})
+}
+
+func TestContractsByCodeID(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	wk := testutils.NewMockContractViewer()
+	k.SetContractInfoViewer(wk)
+	contractAdminAcc := testutils.AccAddress()
+	querySrv := keeper.NewQueryServer(k)
+
+	// Code ID 1: contracts 1, 2 and 3 (contract 3 has no metadata), code ID 2: contracts 4 and 5
+	contractAddrs := e2eTesting.GenContractAddresses(5)
+	for i, contractAddr := range contractAddrs {
+		codeID := uint64(1)
+		if i >= 3 {
+			codeID = 2
+		}
+		wk.AddContractAdmin(contractAddr.String(), contractAdminAcc.String())
+		wk.SetContractCodeID(contractAddr.String(), codeID)
+
+		if i == 2 {
+			continue
+		}
+		require.NoError(t, k.SetContractMetadata(ctx, contractAdminAcc, contractAddr, rewardsTypes.ContractMetadata{}))
+	}
+
+	queryContracts := func(t *testing.T, codeID uint64, pageReq *query.PageRequest) ([]string, *query.PageResponse) {
+		res, err := querySrv.ContractsByCodeID(ctx, &rewardsTypes.QueryContractsByCodeIDRequest{
+			CodeId:     codeID,
+			Pagination: pageReq,
+		})
+		require.NoError(t, err)
+		return res.ContractAddresses, res.Pagination
+	}
+
+	t.Run("OK: contracts are indexed by code ID", func(t *testing.T) {
+		contracts, _ := queryContracts(t, 1, nil)
+		require.ElementsMatch(t, []string{contractAddrs[0].String(), contractAddrs[1].String()}, contracts)
+
+		contracts, _ = queryContracts(t, 2, nil)
+		require.ElementsMatch(t, []string{contractAddrs[3].String(), contractAddrs[4].String()}, contracts)
+
+		contracts, _ = queryContracts(t, 3, nil)
+		require.Empty(t, contracts)
+	})
+
+	t.Run("OK: paginated", func(t *testing.T) {
+		contracts, pageResp := queryContracts(t, 2, &query.PageRequest{Limit: 1, CountTotal: true})
+		require.Len(t, contracts, 1)
+		require.EqualValues(t, 2, pageResp.Total)
+
+		contractsNext, pageResp := queryContracts(t, 2, &query.PageRequest{Key: pageResp.NextKey})
+		require.Len(t, contractsNext, 1)
+		require.Nil(t, pageResp.NextKey)
+		require.ElementsMatch(t, []string{contractAddrs[3].String(), contractAddrs[4].String()}, append(contracts, contractsNext...))
+	})
+
+	t.Run("OK: metadata update refreshes the code ID", func(t *testing.T) {
+		wk.SetContractCodeID(contractAddrs[1].String(), 2)
+		require.NoError(t, k.SetContractMetadata(ctx, contractAdminAcc, contractAddrs[1], rewardsTypes.ContractMetadata{
+			RewardsAddress: contractAdminAcc.String(),
+		}))
+
+		contracts, _ := queryContracts(t, 1, nil)
+		require.ElementsMatch(t, []string{contractAddrs[0].String()}, contracts)
+
+		contracts, _ = queryContracts(t, 2, nil)
+		require.ElementsMatch(t, []string{contractAddrs[1].String(), contractAddrs[3].String(), contractAddrs[4].String()}, contracts)
+	})
+
+	t.Run("OK: metadata removal removes the index entry", func(t *testing.T) {
+		_, err := k.RemoveContractMetadata(ctx, contractAdminAcc, contractAddrs[3], nil)
+		require.NoError(t, err)
+
+		contracts, _ := queryContracts(t, 2, nil)
+		require.ElementsMatch(t, []string{contractAddrs[1].String(), contractAddrs[4].String()}, contracts)
+	})
+
+	t.Run("Fail: invalid request", func(t *testing.T) {
+		_, err := querySrv.ContractsByCodeID(ctx, &rewardsTypes.QueryContractsByCodeIDRequest{CodeId: 0})
+		require.Error(t, err)
+
+		_, err = querySrv.ContractsByCodeID(ctx, &rewardsTypes.QueryContractsByCodeIDRequest{
+			CodeId:     1,
+			Pagination: &query.PageRequest{Limit: rewardsTypes.MaxContractsByCodeIDQueryLimit + 1},
+		})
+		require.Error(t, err)
+	})
+}
+
+func TestRemoveContractMetadata(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	wk := testutils.NewMockContractViewer()
+	k.SetContractInfoViewer(wk)
+	contractAdminAcc, otherAcc, sweepAcc := testutils.AccAddress(), testutils.AccAddress(), testutils.AccAddress()
+	rewardsAddrs, _ := e2eTesting.GenAccounts(2)
+
+	contractAddrs := e2eTesting.GenContractAddresses(2)
+	contractAddr, otherContractAddr := contractAddrs[0], contractAddrs[1]
+
+	setupContract := func(t *testing.T) {
+		wk.AddContractAdmin(contractAddr.String(), contractAdminAcc.String())
+		err := k.SetContractMetadata(ctx, contractAdminAcc, contractAddr, rewardsTypes.ContractMetadata{
+			RewardsAddress: rewardsAddrs[0].String(),
+			RewardsSplits: []rewardsTypes.RewardsSplit{
+				{Address: rewardsAddrs[0].String(), Weight: 5000},
+				{Address: rewardsAddrs[1].String(), Weight: 5000},
+			},
+		})
+		require.NoError(t, err)
+		require.NoError(t, k.SetFlatFee(ctx, contractAdminAcc, rewardsTypes.FlatFee{
+			ContractAddress: contractAddr.String(),
+			FlatFee:         sdk.NewInt64Coin("test", 10),
+		}))
+	}
+
+	createRecord := func(t *testing.T, rewardsAddr, contractAddr sdk.AccAddress, amount int64) rewardsTypes.RewardsRecord {
+		record, err := k.CreateRewardsRecord(ctx, rewardsAddr, contractAddr, sdk.NewCoins(sdk.NewInt64Coin("test", amount)), ctx.BlockHeight(), ctx.BlockTime())
+		require.NoError(t, err)
+		return record
+	}
+
+	t.Run("Fail: non-existing metadata", func(t *testing.T) {
+		_, err := k.RemoveContractMetadata(ctx, contractAdminAcc, contractAddr, nil)
+		require.ErrorIs(t, err, rewardsTypes.ErrMetadataNotFound)
+	})
+
+	setupContract(t)
+
+	t.Run("Fail: not the contract owner", func(t *testing.T) {
+		_, err := k.RemoveContractMetadata(ctx, otherAcc, contractAddr, nil)
+		require.ErrorIs(t, err, rewardsTypes.ErrUnauthorized)
+	})
+
+	t.Run("Fail: blocked sweep address", func(t *testing.T) {
+		_, err := k.RemoveContractMetadata(ctx, contractAdminAcc, contractAddr, authtypes.NewModuleAddress("distribution"))
+		require.ErrorIs(t, err, rewardsTypes.ErrInvalidRequest)
+	})
+
+	t.Run("OK: remove keeping the rewards records", func(t *testing.T) {
+		record := createRecord(t, rewardsAddrs[0], contractAddr, 100)
+
+		swept, err := k.RemoveContractMetadata(ctx, contractAdminAcc, contractAddr, nil)
+		require.NoError(t, err)
+		require.True(t, swept.IsZero())
+
+		require.Nil(t, k.GetContractMetadata(ctx, contractAddr))
+		_, found := k.GetFlatFee(ctx, contractAddr)
+		require.False(t, found)
+		hasHeight, err := k.FlatFeeUpdateHeights.Has(ctx, contractAddr)
+		require.NoError(t, err)
+		require.False(t, hasHeight)
+		require.EqualValues(t, 0, k.GetContractMetadataCount(ctx))
+
+		records, err := k.GetRewardsRecordsByWithdrawAddress(ctx, rewardsAddrs[0])
+		require.NoError(t, err)
+		require.Equal(t, []rewardsTypes.RewardsRecord{record}, records)
+
+		// Cleanup for the next case
+		k.WithdrawRewardsByRecordIDs(ctx, rewardsAddrs[0], []uint64{record.Id})
+	})
+
+	t.Run("OK: remove sweeping the outstanding contract rewards", func(t *testing.T) {
+		setupContract(t)
+		require.EqualValues(t, 1, k.GetContractMetadataCount(ctx))
+
+		createRecord(t, rewardsAddrs[0], contractAddr, 100)
+		createRecord(t, rewardsAddrs[1], contractAddr, 50)
+		otherRecord := createRecord(t, rewardsAddrs[0], otherContractAddr, 25)
+
+		swept, err := k.RemoveContractMetadata(ctx, contractAdminAcc, contractAddr, sweepAcc)
+		require.NoError(t, err)
+		require.Equal(t, "150test", swept.String())
+
+		require.Nil(t, k.GetContractMetadata(ctx, contractAddr))
+		_, found := k.GetFlatFee(ctx, contractAddr)
+		require.False(t, found)
+		require.EqualValues(t, 0, k.GetContractMetadataCount(ctx))
+
+		// Records of the removed contract are pruned (including the address index), other contract records are kept
+		records, err := k.GetRewardsRecordsByWithdrawAddress(ctx, rewardsAddrs[0])
+		require.NoError(t, err)
+		require.Equal(t, []rewardsTypes.RewardsRecord{otherRecord}, records)
+
+		records, err = k.GetRewardsRecordsByWithdrawAddress(ctx, rewardsAddrs[1])
+		require.NoError(t, err)
+		require.Empty(t, records)
+
+		pagedRecords, _, err := k.GetRewardsRecordsByWithdrawAddressPaginated(ctx, rewardsAddrs[1], nil)
+		require.NoError(t, err)
+		require.Empty(t, pagedRecords)
+	})
+}
@@ -1,5 +1,13 @@
 package keeper
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v3 "github.com/archway-network/archway/x/rewards/migrations/v3"
+	v4 "github.com/archway-network/archway/x/rewards/migrations/v4"
+	v5 "github.com/archway-network/archway/x/rewards/migrations/v5"
+)
+
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
 	keeper Keeper
@@ -19,3 +27,38 @@ func NewMigrator(keeper Keeper) Migrator {
 // func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 // 	return v2.MigrateStore(ctx, m.keeper.storeKey, m.keeper.paramStore, m.keeper.cdc)
 // }
+
+// Migrate2to3 migrates the x/rewards module state from the consensus
+// version 2 to version 3. Specifically, it converts the single denom minimum
+// consensus fee into the multi denom format.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate3to4 migrates the x/rewards module state from the consensus
+// version 3 to version 4. Specifically, it initializes the contract metadata
+// counter using the existing metadata entries.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.keeper.storeKey)
+}
+
+// Migrate4to5 migrates the x/rewards module state from the consensus
+// version 4 to version 5. Specifically, it builds the contracts by code ID
+// index for the existing metadata entries.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.MigrateStore(ctx, m.keeper.storeKey, m.keeper.contractInfoView, m.keeper.ContractCodeIDs.Set)
+}
+
+// Migrate5to6 migrates the x/rewards module state from the consensus
+// version 5 to version 6. Specifically, it rebuilds the secondary indexes
+// to build the rewards records by address and height index for the existing records
+// and enables the contract flat fees (the FlatFeesEnabled param is not set for the existing state).
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	if _, err := m.keeper.RebuildIndexes(ctx); err != nil {
+		return err
+	}
+
+	params := m.keeper.GetParams(ctx)
+	params.FlatFeesEnabled = true
+	return m.keeper.Params.Set(ctx, params)
+}
@@ -1,8 +1,10 @@
 package keeper
 
 import (
+	"fmt"
 	"math"
 
+	"cosmossdk.io/collections"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -12,68 +14,243 @@ import (
 
 // UpdateMinConsensusFee calculates and updates the minimum consensus fee if eligible emitting an event.
 func (k Keeper) UpdateMinConsensusFee(ctx sdk.Context, inflationRewards sdk.Coin) {
-	// Prepare and verify inputs
-	if inflationRewards.IsZero() {
-		k.Logger(ctx).Info("Minimum consensus fee update skipped: inflation rewards are zero")
-		return
-	}
-
-	inflationRewardsAmt := sdkmath.LegacyNewDecFromInt(inflationRewards.Amount)
-
-	blockGasLimit := ctx.BlockGasMeter().Limit()
-	if blockGasLimit == math.MaxUint64 { // Because thisss https://github.com/cosmos/cosmos-sdk/pull/9651
-		k.Logger(ctx).Info("Minimum consensus fee update skipped: block gas limit is not set")
+	feeCoin, err := computeMinConsensusFee(inflationRewards, ctx.BlockGasMeter().Limit(), k.TxFeeRebateRatio(ctx))
+	if err != nil {
+		k.Logger(ctx).Info("Minimum consensus fee update skipped: " + err.Error())
 		return
 	}
 
-	txFeeRebateRatio := k.TxFeeRebateRatio(ctx)
-
-	// Calculate
-	blockGasLimitAsDec := pkg.NewDecFromUint64(blockGasLimit)
-	feeAmt := calculateMinConsensusFeeAmt(inflationRewardsAmt, blockGasLimitAsDec, txFeeRebateRatio)
-	if feeAmt.IsZero() || feeAmt.IsNegative() {
-		k.Logger(ctx).Info("Minimum consensus fee update skipped: calculated amount is zero or bellow zero")
-		return
-	}
-	feeCoin := sdk.DecCoin{
-		Denom:  inflationRewards.Denom,
-		Amount: feeAmt,
+	// Replace the fee for the inflation rewards denom keeping other denoms untouched
+	var fees sdk.DecCoins
+	for _, fee := range k.GetMinConsensusFees(ctx) {
+		if fee.Denom != feeCoin.Denom {
+			fees = append(fees, fee)
+		}
 	}
+	fees = fees.Add(feeCoin)
 
 	// Set and emit event
-	err := k.MinConsFee.Set(ctx, feeCoin)
+	err = k.MinConsFee.Set(ctx, types.MinConsensusFees{Fees: fees})
 	if err != nil {
 		panic(err)
 	}
 	k.Logger(ctx).Info("Minimum consensus fee update", "fee", feeCoin)
 
+	k.trackMinConsensusFeeHistory(ctx, feeCoin)
+
 	types.EmitMinConsensusFeeSetEvent(ctx, feeCoin)
 }
 
-// GetMinConsensusFee returns the minimum consensus fee.
+// GetMinConsensusFees returns the minimum consensus fees for all the denoms.
 // Fee defines the minimum gas unit price for a transaction to be included in a block.
-func (k Keeper) GetMinConsensusFee(ctx sdk.Context) (sdk.DecCoin, bool) {
-	fee, err := k.MinConsFee.Get(ctx)
+func (k Keeper) GetMinConsensusFees(ctx sdk.Context) sdk.DecCoins {
+	fees, err := k.MinConsFee.Get(ctx)
 	if err != nil {
+		return nil
+	}
+
+	return fees.Fees
+}
+
+// GetMinConsensusFee returns the minimum consensus fee for the given denom.
+// If the MinConsensusFeeSmoothingWindow param is GT 1, the moving average of the fees computed for the recent blocks
+// (up to the window, including the current one) is returned instead of the instantaneous value.
+func (k Keeper) GetMinConsensusFee(ctx sdk.Context, denom string) (sdk.DecCoin, bool) {
+	for _, fee := range k.GetMinConsensusFees(ctx) {
+		if fee.Denom == denom {
+			if avgFee, ok := k.smoothedMinConsensusFee(ctx, denom); ok {
+				return avgFee, true
+			}
+			return fee, true
+		}
+	}
+
+	return sdk.DecCoin{}, false
+}
+
+// smoothedMinConsensusFee returns the average of the minimum consensus fees tracked for the given denom within the
+// MinConsensusFeeSmoothingWindow param recent blocks. Returns false if smoothing is disabled or there is no history.
+func (k Keeper) smoothedMinConsensusFee(ctx sdk.Context, denom string) (sdk.DecCoin, bool) {
+	window := k.MinConsensusFeeSmoothingWindow(ctx)
+	if window <= 1 {
 		return sdk.DecCoin{}, false
 	}
 
-	return fee, true
+	endHeight := uint64(ctx.BlockHeight())
+	startHeight := uint64(1)
+	if endHeight > window {
+		startHeight = endHeight - window + 1
+	}
+
+	rng := new(collections.Range[uint64]).StartInclusive(startHeight).EndInclusive(endHeight)
+	iter, err := k.MinConsFeeHistory.Iterate(ctx, rng)
+	if err != nil {
+		panic(err)
+	}
+	defer iter.Close()
+
+	sum, cnt := sdkmath.LegacyZeroDec(), int64(0)
+	for ; iter.Valid(); iter.Next() {
+		fee, err := iter.Value()
+		if err != nil {
+			panic(err)
+		}
+		if fee.Denom != denom {
+			continue
+		}
+		sum, cnt = sum.Add(fee.Amount), cnt+1
+	}
+	if cnt == 0 {
+		return sdk.DecCoin{}, false
+	}
+
+	return sdk.NewDecCoinFromDec(denom, sum.QuoInt64(cnt)), true
+}
+
+// trackMinConsensusFeeHistory stores the minimum consensus fee computed for the current block and prunes the entries
+// older than types.MinConsensusFeeHistoryBlocks.
+func (k Keeper) trackMinConsensusFeeHistory(ctx sdk.Context, fee sdk.DecCoin) {
+	height := uint64(ctx.BlockHeight())
+	if err := k.MinConsFeeHistory.Set(ctx, height, fee); err != nil {
+		panic(err)
+	}
+
+	if height <= types.MinConsensusFeeHistoryBlocks {
+		return
+	}
+	rng := new(collections.Range[uint64]).EndInclusive(height - types.MinConsensusFeeHistoryBlocks)
+	if err := k.MinConsFeeHistory.Clear(ctx, rng); err != nil {
+		panic(err)
+	}
 }
 
 // ComputationalPriceOfGas returns the minimum price of each unit of gas.
 func (k Keeper) ComputationalPriceOfGas(ctx sdk.Context) sdk.DecCoin {
 	minPoG := k.MinimumPriceOfGas(ctx)
-	antiDoSPoG, found := k.GetMinConsensusFee(ctx)
-	if !found {
+	antiDoSPoGs := k.GetMinConsensusFees(ctx)
+	if antiDoSPoGs.IsZero() {
 		return minPoG
 	}
-	if minPoG.Denom != antiDoSPoG.Denom {
-		panic("conflict between anti dos denom and min price of gas denom: %s != %s" + minPoG.Denom + antiDoSPoG.Denom)
+	antiDoSPoG, found := k.GetMinConsensusFee(ctx, minPoG.Denom)
+	if !found {
+		panic(fmt.Sprintf("conflict between anti dos denom and min price of gas denom: %s not in %s", minPoG.Denom, antiDoSPoGs))
 	}
 	return sdk.NewDecCoinFromDec(minPoG.Denom, sdkmath.LegacyMaxDec(minPoG.Amount, antiDoSPoG.Amount))
 }
 
+// EstimateWithdrawTxFee returns the min fee of a withdraw-only transaction (no contract flat fees) with the given gas
+// limit computed the same way the MinFeeDecorator Ante handler does. The tx size surcharge (TxSizeFeePerByte param)
+// is not included since the encoded tx size is not known in advance.
+// Could be used by contracts withdrawing their own rewards to ensure the balance covers the fee.
+func (k Keeper) EstimateWithdrawTxFee(ctx sdk.Context, gasLimit uint64) sdk.Coins {
+	gasPrice := types.DiscountGasPrice(k.ComputationalPriceOfGas(ctx), k.FeePromotionDiscount(ctx))
+
+	fees := types.MinGasFees(gasPrice, gasLimit)
+	if fees.IsZero() && k.MinFeeFloorEnabled(ctx) {
+		fees = types.MinFeeFloor(gasPrice.Denom)
+	}
+
+	return fees
+}
+
+// AcceptedFeeDenomMinGasPrices returns the accepted fee denoms with their current minimum gas prices: the computational
+// price of gas for the bond denom (listed first), the min consensus fee for the other denoms (zero if not set).
+// If the AcceptedFeeDenoms param is not set (any denom is accepted), the denoms with the min consensus fee are listed.
+func (k Keeper) AcceptedFeeDenomMinGasPrices(ctx sdk.Context) []sdk.DecCoin {
+	bondPrice := k.ComputationalPriceOfGas(ctx)
+	prices := []sdk.DecCoin{bondPrice}
+
+	denoms := k.AcceptedFeeDenoms(ctx)
+	if len(denoms) == 0 {
+		for _, fee := range k.GetMinConsensusFees(ctx) {
+			denoms = append(denoms, fee.Denom)
+		}
+	}
+
+	for _, denom := range denoms {
+		if denom == bondPrice.Denom {
+			continue
+		}
+
+		price, found := k.GetMinConsensusFee(ctx, denom)
+		if !found {
+			price = sdk.NewDecCoinFromDec(denom, sdkmath.LegacyZeroDec())
+		}
+		prices = append(prices, price)
+	}
+
+	return prices
+}
+
+// ProjectNextBlockPriceOfGas returns a best-effort projection of the minimum price of gas (ComputationalPriceOfGas)
+// for the next block. The minimum consensus fee computed for the tracked recent blocks (up to the window, including the
+// current one) is linearly extrapolated by the average per block change, the MinPriceOfGas param is used as a floor.
+// Returns the number of blocks the projection is based on, the current price is returned if it is less than 2.
+// CONTRACT: window must be within the [1, types.MaxMinConsensusFeeProjectionWindow] range.
+func (k Keeper) ProjectNextBlockPriceOfGas(ctx sdk.Context, window uint64) (sdk.DecCoin, uint64) {
+	currentPrice := k.ComputationalPriceOfGas(ctx)
+
+	endHeight := ctx.BlockHeight()
+	startHeight := endHeight - int64(window) + 1
+	if startHeight < 1 {
+		startHeight = 1
+	}
+
+	// Only the blocks eligible for the fee update are taken into account
+	txFeeRebateRatio := k.TxFeeRebateRatio(ctx)
+	var heights []int64
+	var fees []sdkmath.LegacyDec
+	for height := startHeight; height <= endHeight; height++ {
+		blockRewards, err := k.BlockRewards.Get(ctx, uint64(height))
+		if err != nil {
+			continue
+		}
+		fee, err := computeMinConsensusFee(blockRewards.InflationRewards, blockRewards.MaxGas, txFeeRebateRatio)
+		if err != nil || fee.Denom != currentPrice.Denom {
+			continue
+		}
+		heights, fees = append(heights, height), append(fees, fee.Amount)
+	}
+
+	blocksUsed := uint64(len(fees))
+	if blocksUsed < 2 {
+		return currentPrice, blocksUsed
+	}
+
+	first, last := fees[0], fees[len(fees)-1]
+	firstHeight, lastHeight := heights[0], heights[len(heights)-1]
+	perBlockChange := last.Sub(first).QuoInt64(lastHeight - firstHeight)
+	projectedFee := last.Add(perBlockChange.MulInt64(endHeight + 1 - lastHeight))
+
+	minPoG := k.MinimumPriceOfGas(ctx)
+	return sdk.NewDecCoinFromDec(minPoG.Denom, sdkmath.LegacyMaxDec(minPoG.Amount, projectedFee)), blocksUsed
+}
+
+// computeMinConsensusFee prepares and verifies the inputs and calculates the minimum consensus fee for the inflation rewards denom.
+// An error is returned if the inputs are not eligible for the fee update.
+func computeMinConsensusFee(inflationRewards sdk.Coin, blockGasLimit uint64, txFeeRebateRatio sdkmath.LegacyDec) (sdk.DecCoin, error) {
+	if inflationRewards.IsZero() {
+		return sdk.DecCoin{}, fmt.Errorf("inflation rewards are zero")
+	}
+	inflationRewardsAmt := sdkmath.LegacyNewDecFromInt(inflationRewards.Amount)
+
+	// Limit is tracked as 0 by the BlockRewards if not set
+	if blockGasLimit == 0 || blockGasLimit == math.MaxUint64 { // Because thisss https://github.com/cosmos/cosmos-sdk/pull/9651
+		return sdk.DecCoin{}, fmt.Errorf("block gas limit is not set")
+	}
+	blockGasLimitAsDec := pkg.NewDecFromUint64(blockGasLimit)
+
+	feeAmt := calculateMinConsensusFeeAmt(inflationRewardsAmt, blockGasLimitAsDec, txFeeRebateRatio)
+	if feeAmt.IsZero() || feeAmt.IsNegative() {
+		return sdk.DecCoin{}, fmt.Errorf("calculated amount is zero or bellow zero")
+	}
+
+	return sdk.DecCoin{
+		Denom:  inflationRewards.Denom,
+		Amount: feeAmt,
+	}, nil
+}
+
 // calculateMinConsensusFee calculates the minimum consensus fee amount using the formula:
 //
 //	[ -1 * ( BlockRewards / ( GasLimit * (TxFeeRatio - 1) ) ]
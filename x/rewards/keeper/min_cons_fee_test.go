@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/archway-network/archway/pkg/testutils"
+	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
+)
+
+func TestMinConsensusFeeSmoothing(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	ctx = ctx.WithBlockGasMeter(storetypes.NewGasMeter(1000))
+
+	// updateFees runs the fee update for the consecutive blocks starting from the startHeight,
+	// the min consensus fee for a block is rewards / (1000 gas * (1 - 0.5 rebate ratio))
+	updateFees := func(startHeight int64, rewards ...int64) sdk.Context {
+		for i, amount := range rewards {
+			ctx = ctx.WithBlockHeight(startHeight + int64(i))
+			k.UpdateMinConsensusFee(ctx, sdk.NewInt64Coin("stake", amount))
+		}
+		return ctx
+	}
+
+	setWindow := func(ctx sdk.Context, window uint64) {
+		params := k.GetParams(ctx)
+		params.MinConsensusFeeSmoothingWindow = window
+		require.NoError(t, k.Params.Set(ctx, params))
+	}
+
+	// Volatile series: 1, 5, 1, 5, 1, 5, 1
+	ctx = updateFees(1, 500, 2500, 500, 2500, 500, 2500, 500)
+
+	t.Run("ok: disabled smoothing returns the instantaneous fee", func(t *testing.T) {
+		for _, window := range []uint64{0, 1} {
+			ctx, _ := ctx.CacheContext()
+			setWindow(ctx, window)
+
+			fee, found := k.GetMinConsensusFee(ctx, "stake")
+			require.True(t, found)
+			require.Equal(t, "1.000000000000000000stake", fee.String())
+			require.Equal(t, "1.000000000000000000stake", k.ComputationalPriceOfGas(ctx).String())
+		}
+	})
+
+	t.Run("ok: moving average over the window", func(t *testing.T) {
+		testCases := []struct {
+			window      uint64
+			expectedFee string
+		}{
+			{window: 2, expectedFee: "3.000000000000000000stake"},  // (5 + 1) / 2
+			{window: 3, expectedFee: "2.333333333333333333stake"},  // (1 + 5 + 1) / 3
+			{window: 4, expectedFee: "3.000000000000000000stake"},  // (5 + 1 + 5 + 1) / 4
+			{window: 7, expectedFee: "2.714285714285714285stake"},  // (4 * 1 + 3 * 5) / 7
+			{window: 50, expectedFee: "2.714285714285714285stake"}, // the whole history only
+		}
+
+		for _, tc := range testCases {
+			ctx, _ := ctx.CacheContext()
+			setWindow(ctx, tc.window)
+
+			fee, found := k.GetMinConsensusFee(ctx, "stake")
+			require.True(t, found)
+			require.Equal(t, tc.expectedFee, fee.String(), "window %d", tc.window)
+			require.Equal(t, tc.expectedFee, k.ComputationalPriceOfGas(ctx).String(), "window %d", tc.window)
+		}
+	})
+
+	t.Run("ok: smoothed fee is less volatile", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		setWindow(ctx, 4)
+
+		// Next blocks keep the series going, the instantaneous fee swings between 1 and 5
+		for i, amount := range []int64{2500, 500, 2500, 500} {
+			ctx := ctx.WithBlockHeight(8 + int64(i))
+			k.UpdateMinConsensusFee(ctx, sdk.NewInt64Coin("stake", amount))
+
+			fee, found := k.GetMinConsensusFee(ctx, "stake")
+			require.True(t, found)
+			require.Equal(t, "3.000000000000000000stake", fee.String(), "height %d", ctx.BlockHeight())
+		}
+	})
+
+	t.Run("ok: other denoms are not smoothed", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		setWindow(ctx, 4)
+
+		fees := k.GetMinConsensusFees(ctx).Add(sdk.NewInt64DecCoin("uarch", 7))
+		require.NoError(t, k.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: fees}))
+
+		fee, found := k.GetMinConsensusFee(ctx, "uarch")
+		require.True(t, found)
+		require.Equal(t, "7.000000000000000000uarch", fee.String())
+	})
+
+	t.Run("ok: history is pruned", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+
+		ctx = ctx.WithBlockHeight(rewardsTypes.MinConsensusFeeHistoryBlocks + 3)
+		k.UpdateMinConsensusFee(ctx, sdk.NewInt64Coin("stake", 500))
+
+		for height := uint64(1); height <= 3; height++ {
+			found, err := k.MinConsFeeHistory.Has(ctx, height)
+			require.NoError(t, err)
+			require.False(t, found, "height %d", height)
+		}
+		for height := uint64(4); height <= 7; height++ {
+			found, err := k.MinConsFeeHistory.Has(ctx, height)
+			require.NoError(t, err)
+			require.True(t, found, "height %d", height)
+		}
+	})
+}
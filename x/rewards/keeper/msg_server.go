@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -43,11 +44,73 @@ func (s MsgServer) SetContractMetadata(c context.Context, request *types.MsgSetC
 		return nil, err // returning error "as is" since this should not happen due to the earlier ValidateBasic call
 	}
 
+	var prevRewardsAddr string
+	if meta := s.keeper.GetContractMetadata(ctx, contractAddr); meta != nil {
+		prevRewardsAddr = meta.RewardsAddress
+	}
+
 	if err := s.keeper.SetContractMetadata(ctx, senderAddr, contractAddr, request.Metadata); err != nil {
 		return nil, err
 	}
 
-	return &types.MsgSetContractMetadataResponse{}, nil
+	var migratedRecordsNum uint64
+	if request.MigrateRewardsRecords && prevRewardsAddr != "" && request.Metadata.HasRewardsAddress() && request.Metadata.RewardsAddress != prevRewardsAddr {
+		prevAddr, err := sdk.AccAddressFromBech32(prevRewardsAddr)
+		if err != nil {
+			return nil, err // returning error "as is" since the stored address is expected to be valid
+		}
+		newAddr, err := sdk.AccAddressFromBech32(request.Metadata.RewardsAddress)
+		if err != nil {
+			return nil, err // returning error "as is" since this should not happen due to the earlier ValidateBasic call
+		}
+
+		if migratedRecordsNum, err = s.keeper.MigrateRewardsRecords(ctx, contractAddr, prevAddr, newAddr); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.keeper.Hooks().AfterContractMetadataSet(ctx, contractAddr, *s.keeper.GetContractMetadata(ctx, contractAddr)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetContractMetadataResponse{
+		MigratedRecordsNum: migratedRecordsNum,
+	}, nil
+}
+
+// RemoveContractMetadata implements the types.MsgServer interface.
+func (s MsgServer) RemoveContractMetadata(c context.Context, request *types.MsgRemoveContractMetadata) (*types.MsgRemoveContractMetadataResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	senderAddr, err := sdk.AccAddressFromBech32(request.SenderAddress)
+	if err != nil {
+		return nil, err // returning error "as is" since this should not happen due to the earlier ValidateBasic call
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(request.ContractAddress)
+	if err != nil {
+		return nil, err // returning error "as is" since this should not happen due to the earlier ValidateBasic call
+	}
+
+	var sweepAddr sdk.AccAddress
+	if request.RewardsSweepAddress != "" {
+		if sweepAddr, err = sdk.AccAddressFromBech32(request.RewardsSweepAddress); err != nil {
+			return nil, err // returning error "as is" since this should not happen due to the earlier ValidateBasic call
+		}
+	}
+
+	sweptRewards, err := s.keeper.RemoveContractMetadata(ctx, senderAddr, contractAddr, sweepAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveContractMetadataResponse{
+		SweptRewards: sweptRewards,
+	}, nil
 }
 
 // WithdrawRewards implements the types.MsgServer interface.
@@ -68,9 +131,9 @@ func (s MsgServer) WithdrawRewards(c context.Context, request *types.MsgWithdraw
 
 	switch modeReq := request.Mode.(type) {
 	case *types.MsgWithdrawRewards_RecordsLimit_:
-		totalRewards, recordsUsed, err = s.keeper.WithdrawRewardsByRecordsLimit(ctx, rewardsAddr, modeReq.RecordsLimit.Limit)
+		totalRewards, recordsUsed, err = s.keeper.WithdrawRewardsByRecordsLimit(ctx, rewardsAddr, modeReq.RecordsLimit.Limit, request.Denoms...)
 	case *types.MsgWithdrawRewards_RecordIds:
-		totalRewards, recordsUsed, err = s.keeper.WithdrawRewardsByRecordIDs(ctx, rewardsAddr, modeReq.RecordIds.Ids)
+		totalRewards, recordsUsed, err = s.keeper.WithdrawRewardsByRecordIDs(ctx, rewardsAddr, modeReq.RecordIds.Ids, request.Denoms...)
 	default:
 		// Should never happen since the BasicValidate function checks this case
 		return nil, status.Error(codes.InvalidArgument, "invalid request mode")
@@ -80,9 +143,24 @@ func (s MsgServer) WithdrawRewards(c context.Context, request *types.MsgWithdraw
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	unwrappedRewards := sdk.NewCoins()
+	if request.IbcUnwrap != nil && !totalRewards.IsZero() {
+		timeout := time.Duration(request.IbcUnwrap.TimeoutSeconds) * time.Second
+		if unwrappedRewards, err = s.keeper.UnwrapIBCRewards(ctx, rewardsAddr, totalRewards, request.IbcUnwrap.Receiver, timeout); err != nil {
+			return nil, err
+		}
+	}
+
+	if !totalRewards.IsZero() {
+		if err := s.keeper.Hooks().AfterRewardsWithdrawn(ctx, rewardsAddr, totalRewards); err != nil {
+			return nil, err
+		}
+	}
+
 	return &types.MsgWithdrawRewardsResponse{
-		RecordsNum:   uint64(recordsUsed),
-		TotalRewards: totalRewards,
+		RecordsNum:       uint64(recordsUsed),
+		TotalRewards:     totalRewards,
+		UnwrappedRewards: unwrappedRewards,
 	}, nil
 }
 
@@ -107,6 +185,10 @@ func (s MsgServer) SetFlatFee(c context.Context, request *types.MsgSetFlatFee) (
 	if err := s.keeper.SetFlatFee(ctx, senderAddress, types.FlatFee{
 		ContractAddress: request.GetContractAddress(),
 		FlatFee:         request.GetFlatFeeAmount(),
+		Schedule:        request.GetSchedule(),
+		Method:          request.GetMethod(),
+
+		MinConsFeeMultiplier: request.MinConsFeeMultiplier,
 	}); err != nil {
 		return nil, err
 	}
@@ -143,3 +225,269 @@ func (s MsgServer) UpdateParams(c context.Context, request *types.MsgUpdateParam
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// SetRewardsRatios implements types.MsgServer.
+func (s MsgServer) SetRewardsRatios(c context.Context, request *types.MsgSetRewardsRatios) (*types.MsgSetRewardsRatiosResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	_, err := sdk.AccAddressFromBech32(request.Authority)
+	if err != nil {
+		return nil, err // returning error "as is" since this should not happen due to the earlier ValidateBasic call
+	}
+
+	if request.GetAuthority() != s.keeper.GetAuthority() {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "sender address is not authorized address to update rewards ratios")
+	}
+
+	if request.ActivationHeight != 0 {
+		if err := s.keeper.ScheduleRewardsRatios(ctx, request.ActivationHeight, request.InflationRewardsRatio, request.TxFeeRebateRatio); err != nil {
+			return nil, err
+		}
+		return &types.MsgSetRewardsRatiosResponse{}, nil
+	}
+
+	if err := s.keeper.SetRewardsRatios(ctx, request.InflationRewardsRatio, request.TxFeeRebateRatio); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetRewardsRatiosResponse{}, nil
+}
+
+// SetFlatFeeByCodeID implements types.MsgServer.
+func (s MsgServer) SetFlatFeeByCodeID(c context.Context, request *types.MsgSetFlatFeeByCodeID) (*types.MsgSetFlatFeeByCodeIDResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	_, err := sdk.AccAddressFromBech32(request.Authority)
+	if err != nil {
+		return nil, err // returning error "as is" since this should not happen due to the earlier ValidateBasic call
+	}
+
+	if request.GetAuthority() != s.keeper.GetAuthority() {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "sender address is not authorized address to update flat fees by code ID")
+	}
+
+	// need to explicitly validate as x/gov invokes this msg and it does not validate
+	if err := request.FlatFeeAmount.Validate(); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "invalid flat fee: %v", err)
+	}
+
+	updated, err := s.keeper.SetFlatFeeByCodeID(ctx, request.CodeId, request.FlatFeeAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetFlatFeeByCodeIDResponse{
+		ContractsUpdated: updated,
+	}, nil
+}
+
+// RebuildRewardsIndexes implements types.MsgServer.
+func (s MsgServer) RebuildRewardsIndexes(c context.Context, request *types.MsgRebuildRewardsIndexes) (*types.MsgRebuildRewardsIndexesResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	_, err := sdk.AccAddressFromBech32(request.Authority)
+	if err != nil {
+		return nil, err // returning error "as is" since this should not happen due to the earlier ValidateBasic call
+	}
+
+	if request.GetAuthority() != s.keeper.GetAuthority() {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "sender address is not authorized address to rebuild indexes")
+	}
+
+	res, err := s.keeper.RebuildIndexes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRebuildRewardsIndexesResponse{
+		ContractsNum:          res.ContractsNum,
+		RewardsRecordsNum:     res.RewardsRecordsNum,
+		TxRewardsNum:          res.TxRewardsNum,
+		TxFeeDistributionsNum: res.TxFeeDistributionsNum,
+	}, nil
+}
+
+// RecoverContractRewards implements types.MsgServer.
+func (s MsgServer) RecoverContractRewards(c context.Context, request *types.MsgRecoverContractRewards) (*types.MsgRecoverContractRewardsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	_, err := sdk.AccAddressFromBech32(request.Authority)
+	if err != nil {
+		return nil, err // returning error "as is" since this should not happen due to the earlier ValidateBasic call
+	}
+
+	if request.GetAuthority() != s.keeper.GetAuthority() {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "sender address is not authorized address to recover contract rewards")
+	}
+
+	// need to explicitly validate as x/gov invokes this msg and it does not validate
+	contractAddr, err := sdk.AccAddressFromBech32(request.ContractAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "invalid contract address: %v", err)
+	}
+	recoveryAddr, err := sdk.AccAddressFromBech32(request.RecoveryAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "invalid recovery address: %v", err)
+	}
+
+	recoveredRewards, err := s.keeper.RecoverContractRewards(ctx, contractAddr, recoveryAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRecoverContractRewardsResponse{
+		RecoveredRewards: recoveredRewards,
+	}, nil
+}
+
+// PrepayFlatFee implements the types.MsgServer interface.
+func (s MsgServer) PrepayFlatFee(c context.Context, request *types.MsgPrepayFlatFee) (*types.MsgPrepayFlatFeeResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	senderAddr, err := sdk.AccAddressFromBech32(request.SenderAddress)
+	if err != nil {
+		return nil, err // returning error "as is" since this should not happen due to the earlier ValidateBasic call
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(request.ContractAddress)
+	if err != nil {
+		return nil, err // returning error "as is" since this should not happen due to the earlier ValidateBasic call
+	}
+
+	paidFee, credits, err := s.keeper.PrepayFlatFee(ctx, senderAddr, contractAddr, request.Executions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPrepayFlatFeeResponse{
+		PaidFees: sdk.NewCoins(paidFee),
+		Credits:  credits,
+	}, nil
+}
+
+// SetFlatFeeOverride implements the types.MsgServer interface.
+func (s MsgServer) SetFlatFeeOverride(c context.Context, request *types.MsgSetFlatFeeOverride) (*types.MsgSetFlatFeeOverrideResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	_, err := sdk.AccAddressFromBech32(request.Authority)
+	if err != nil {
+		return nil, err // returning error "as is" since this should not happen due to the earlier ValidateBasic call
+	}
+
+	if request.GetAuthority() != s.keeper.GetAuthority() {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "sender address is not authorized address to set flat fee overrides")
+	}
+
+	// need to explicitly validate as x/gov invokes this msg and it does not validate
+	if err := request.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(request.ContractAddress)
+	if err != nil {
+		return nil, err // returning error "as is" since this should not happen due to the earlier ValidateBasic call
+	}
+
+	if err := s.keeper.SetFlatFeeOverride(ctx, contractAddr, request.FlatFeeAmount, request.ExpiryHeight); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetFlatFeeOverrideResponse{}, nil
+}
+
+// TransferContractOwnership implements the types.MsgServer interface.
+func (s MsgServer) TransferContractOwnership(c context.Context, request *types.MsgTransferContractOwnership) (*types.MsgTransferContractOwnershipResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	senderAddr, err := sdk.AccAddressFromBech32(request.SenderAddress)
+	if err != nil {
+		return nil, err // returning error "as is" since this should not happen due to the earlier ValidateBasic call
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(request.ContractAddress)
+	if err != nil {
+		return nil, err // returning error "as is" since this should not happen due to the earlier ValidateBasic call
+	}
+
+	newOwnerAddr, err := sdk.AccAddressFromBech32(request.NewOwnerAddress)
+	if err != nil {
+		return nil, err // returning error "as is" since this should not happen due to the earlier ValidateBasic call
+	}
+
+	var newRewardsAddr sdk.AccAddress
+	if request.NewRewardsAddress != "" {
+		if newRewardsAddr, err = sdk.AccAddressFromBech32(request.NewRewardsAddress); err != nil {
+			return nil, err // returning error "as is" since this should not happen due to the earlier ValidateBasic call
+		}
+	}
+
+	migratedRecordsNum, err := s.keeper.TransferContractOwnership(ctx, senderAddr, contractAddr, newOwnerAddr, newRewardsAddr, request.MigrateRewardsRecords)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.keeper.Hooks().AfterContractMetadataSet(ctx, contractAddr, *s.keeper.GetContractMetadata(ctx, contractAddr)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferContractOwnershipResponse{
+		MigratedRecordsNum: migratedRecordsNum,
+	}, nil
+}
+
+// ResetLifetimeRewards implements the types.MsgServer interface.
+func (s MsgServer) ResetLifetimeRewards(c context.Context, request *types.MsgResetLifetimeRewards) (*types.MsgResetLifetimeRewardsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	senderAddr, err := sdk.AccAddressFromBech32(request.SenderAddress)
+	if err != nil {
+		return nil, err // returning error "as is" since this should not happen due to the earlier ValidateBasic call
+	}
+
+	// need to explicitly validate as x/gov invokes this msg and it does not validate
+	contractAddr, err := sdk.AccAddressFromBech32(request.ContractAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "invalid contract address: %v", err)
+	}
+
+	resetRewards, err := s.keeper.ResetLifetimeRewards(ctx, senderAddr, contractAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResetLifetimeRewardsResponse{
+		ResetRewards: resetRewards,
+	}, nil
+}
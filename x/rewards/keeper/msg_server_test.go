@@ -1,15 +1,21 @@
 package keeper_test
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"testing"
+	"time"
 
+	"cosmossdk.io/collections"
 	math "cosmossdk.io/math"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	errorsmod "cosmossdk.io/errors"
+	cmtBytes "github.com/cometbft/cometbft/libs/bytes"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibcTransferTypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	"github.com/stretchr/testify/require"
 
 	e2eTesting "github.com/archway-network/archway/e2e/testing"
@@ -127,6 +133,139 @@ func TestMsgServer_SetContractMetadata(t *testing.T) {
 	}
 }
 
+func TestMsgServer_SetContractMetadataMigrateRewardsRecords(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	wk := testutils.NewMockContractViewer()
+	k.SetContractInfoViewer(wk)
+	ownerAcc, prevRewardsAcc, newRewardsAcc := testutils.AccAddress(), testutils.AccAddress(), testutils.AccAddress()
+	contractAddrs := e2eTesting.GenContractAddresses(2)
+
+	server := keeper.NewMsgServer(k)
+
+	for _, contractAddr := range contractAddrs {
+		wk.AddContractAdmin(contractAddr.String(), ownerAcc.String())
+		_, err := server.SetContractMetadata(ctx, &rewardstypes.MsgSetContractMetadata{
+			SenderAddress: ownerAcc.String(),
+			Metadata: rewardstypes.ContractMetadata{
+				ContractAddress: contractAddr.String(),
+				RewardsAddress:  prevRewardsAcc.String(),
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	// Records 1 and 2 are accrued by the first contract, record 3 by the other one
+	err := SetupWithdrawTest(k, ctx, []withdrawTestRecordData{
+		{
+			RecordID:     1,
+			RewardsAddr:  prevRewardsAcc,
+			Rewards:      sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)),
+			ContractAddr: contractAddrs[0],
+		},
+		{
+			RecordID:     2,
+			RewardsAddr:  prevRewardsAcc,
+			Rewards:      sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+			ContractAddr: contractAddrs[0],
+		},
+		{
+			RecordID:     3,
+			RewardsAddr:  prevRewardsAcc,
+			Rewards:      sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+			ContractAddr: contractAddrs[1],
+		},
+	})
+	require.NoError(t, err)
+
+	t.Run("OK: records are kept if the flag is not set", func(t *testing.T) {
+		res, err := server.SetContractMetadata(ctx, &rewardstypes.MsgSetContractMetadata{
+			SenderAddress: ownerAcc.String(),
+			Metadata: rewardstypes.ContractMetadata{
+				ContractAddress: contractAddrs[1].String(),
+				RewardsAddress:  newRewardsAcc.String(),
+			},
+		})
+		require.NoError(t, err)
+		require.Zero(t, res.MigratedRecordsNum)
+
+		records, err := k.GetRewardsRecordsByWithdrawAddress(ctx, newRewardsAcc)
+		require.NoError(t, err)
+		require.Empty(t, records)
+	})
+
+	t.Run("Fail: previous rewards address has more records than MaxWithdrawRecords", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		params := k.GetParams(cacheCtx)
+		params.MaxWithdrawRecords = 2
+		require.NoError(t, k.Params.Set(cacheCtx, params))
+
+		_, err := server.SetContractMetadata(cacheCtx, &rewardstypes.MsgSetContractMetadata{
+			SenderAddress: ownerAcc.String(),
+			Metadata: rewardstypes.ContractMetadata{
+				ContractAddress: contractAddrs[0].String(),
+				RewardsAddress:  newRewardsAcc.String(),
+			},
+			MigrateRewardsRecords: true,
+		})
+		require.ErrorIs(t, err, rewardstypes.ErrInvalidRequest)
+		require.ErrorContains(t, err, "max migrate records (2) exceeded")
+	})
+
+	t.Run("OK: contract records are re-pointed to the new rewards address", func(t *testing.T) {
+		res, err := server.SetContractMetadata(ctx, &rewardstypes.MsgSetContractMetadata{
+			SenderAddress: ownerAcc.String(),
+			Metadata: rewardstypes.ContractMetadata{
+				ContractAddress: contractAddrs[0].String(),
+				RewardsAddress:  newRewardsAcc.String(),
+			},
+			MigrateRewardsRecords: true,
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, 2, res.MigratedRecordsNum)
+
+		records, err := k.GetRewardsRecordsByWithdrawAddress(ctx, newRewardsAcc)
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		for _, record := range records {
+			require.Equal(t, newRewardsAcc.String(), record.RewardsAddress)
+			require.Equal(t, contractAddrs[0].String(), record.ContractAddress)
+		}
+
+		records, err = k.GetRewardsRecordsByWithdrawAddress(ctx, prevRewardsAcc)
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		require.EqualValues(t, 3, records[0].Id)
+	})
+
+	t.Run("Fail: migrated records are not withdrawable by the previous rewards address", func(t *testing.T) {
+		_, err := server.WithdrawRewards(ctx, &rewardstypes.MsgWithdrawRewards{
+			RewardsAddress: prevRewardsAcc.String(),
+			Mode: &rewardstypes.MsgWithdrawRewards_RecordIds{
+				RecordIds: &rewardstypes.MsgWithdrawRewards_RecordIDs{
+					Ids: []uint64{1},
+				},
+			},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.ErrorContains(t, err, "address mismatch")
+	})
+
+	t.Run("OK: migrated records are withdrawable by the new rewards address", func(t *testing.T) {
+		res, err := server.WithdrawRewards(ctx, &rewardstypes.MsgWithdrawRewards{
+			RewardsAddress: newRewardsAcc.String(),
+			Mode: &rewardstypes.MsgWithdrawRewards_RecordIds{
+				RecordIds: &rewardstypes.MsgWithdrawRewards_RecordIDs{
+					Ids: []uint64{1, 2},
+				},
+			},
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, 2, res.RecordsNum)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 150)).String(), sdk.Coins(res.TotalRewards).String())
+	})
+
+}
+
 func TestMsgServer_WithdrawRewards(t *testing.T) {
 	k, ctx, _ := testutils.RewardsKeeper(t)
 	acc := testutils.AccAddress()
@@ -231,6 +370,153 @@ func TestMsgServer_WithdrawRewards(t *testing.T) {
 	}
 }
 
+// mockRewardsHooks records the hook calls, the BeforeRewardsDistribution deduction is defined by the skimFn (if set).
+type mockRewardsHooks struct {
+	withdrawnAddrs    []sdk.AccAddress
+	withdrawnRewards  []sdk.Coins
+	metadataAddrs     []sdk.AccAddress
+	metadataSet       []rewardstypes.ContractMetadata
+	metadataSetErr    error
+	distributionPools []sdk.Coins
+	skimFn            func(ctx sdk.Context, totalPool sdk.Coins) sdk.Coins
+}
+
+func (h *mockRewardsHooks) AfterRewardsWithdrawn(_ sdk.Context, rewardsAddr sdk.AccAddress, rewards sdk.Coins) error {
+	h.withdrawnAddrs = append(h.withdrawnAddrs, rewardsAddr)
+	h.withdrawnRewards = append(h.withdrawnRewards, rewards)
+	return nil
+}
+
+func (h *mockRewardsHooks) AfterContractMetadataSet(_ sdk.Context, contractAddr sdk.AccAddress, metadata rewardstypes.ContractMetadata) error {
+	if h.metadataSetErr != nil {
+		return h.metadataSetErr
+	}
+	h.metadataAddrs = append(h.metadataAddrs, contractAddr)
+	h.metadataSet = append(h.metadataSet, metadata)
+	return nil
+}
+
+func (h *mockRewardsHooks) BeforeRewardsDistribution(ctx sdk.Context, totalPool sdk.Coins) sdk.Coins {
+	h.distributionPools = append(h.distributionPools, totalPool)
+	if h.skimFn == nil {
+		return nil
+	}
+	return h.skimFn(ctx, totalPool)
+}
+
+func TestMsgServer_WithdrawRewardsHooks(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	acc := testutils.AccAddress()
+
+	hook1, hook2 := &mockRewardsHooks{}, &mockRewardsHooks{}
+	k.SetHooks(rewardstypes.NewMultiRewardsHooks(hook1, hook2))
+
+	server := keeper.NewMsgServer(k)
+	withdrawReq := &rewardstypes.MsgWithdrawRewards{
+		RewardsAddress: acc.String(),
+		Mode: &rewardstypes.MsgWithdrawRewards_RecordsLimit_{
+			RecordsLimit: &rewardstypes.MsgWithdrawRewards_RecordsLimit{
+				Limit: 10,
+			},
+		},
+	}
+
+	t.Run("No rewards: hooks are not called", func(t *testing.T) {
+		_, err := server.WithdrawRewards(ctx, withdrawReq)
+		require.NoError(t, err)
+		require.Empty(t, hook1.withdrawnAddrs)
+		require.Empty(t, hook2.withdrawnAddrs)
+	})
+
+	t.Run("OK: all hooks are called with the withdrawn rewards", func(t *testing.T) {
+		err := SetupWithdrawTest(k, ctx, []withdrawTestRecordData{
+			{
+				RecordID:    1,
+				RewardsAddr: acc,
+				Rewards:     sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)),
+			},
+			{
+				RecordID:    2,
+				RewardsAddr: acc,
+				Rewards:     sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+			},
+		})
+		require.NoError(t, err)
+
+		res, err := server.WithdrawRewards(ctx, withdrawReq)
+		require.NoError(t, err)
+
+		expectedRewards := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 150))
+		require.Equal(t, expectedRewards.String(), sdk.Coins(res.TotalRewards).String())
+		for _, hook := range []*mockRewardsHooks{hook1, hook2} {
+			require.Equal(t, []sdk.AccAddress{acc}, hook.withdrawnAddrs)
+			require.Len(t, hook.withdrawnRewards, 1)
+			require.Equal(t, expectedRewards.String(), hook.withdrawnRewards[0].String())
+		}
+	})
+}
+
+func TestMsgServer_SetContractMetadataHooks(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	wk := testutils.NewMockContractViewer()
+	k.SetContractInfoViewer(wk)
+	ownerAcc, rewardsAcc := testutils.AccAddress(), testutils.AccAddress()
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	wk.AddContractAdmin(contractAddr.String(), ownerAcc.String())
+
+	hook1, hook2 := &mockRewardsHooks{}, &mockRewardsHooks{}
+	k.SetHooks(rewardstypes.NewMultiRewardsHooks(hook1, hook2))
+
+	server := keeper.NewMsgServer(k)
+
+	t.Run("OK: hooks are called on the initial set", func(t *testing.T) {
+		_, err := server.SetContractMetadata(ctx, &rewardstypes.MsgSetContractMetadata{
+			SenderAddress: ownerAcc.String(),
+			Metadata: rewardstypes.ContractMetadata{
+				ContractAddress: contractAddr.String(),
+			},
+		})
+		require.NoError(t, err)
+
+		for _, hook := range []*mockRewardsHooks{hook1, hook2} {
+			require.Equal(t, []sdk.AccAddress{contractAddr}, hook.metadataAddrs)
+			require.Len(t, hook.metadataSet, 1)
+			require.Equal(t, ownerAcc.String(), hook.metadataSet[0].OwnerAddress)
+			require.Empty(t, hook.metadataSet[0].RewardsAddress)
+		}
+	})
+
+	t.Run("OK: hooks are called on update with the stored metadata", func(t *testing.T) {
+		_, err := server.SetContractMetadata(ctx, &rewardstypes.MsgSetContractMetadata{
+			SenderAddress: ownerAcc.String(),
+			Metadata: rewardstypes.ContractMetadata{
+				ContractAddress: contractAddr.String(),
+				RewardsAddress:  rewardsAcc.String(),
+			},
+		})
+		require.NoError(t, err)
+
+		for _, hook := range []*mockRewardsHooks{hook1, hook2} {
+			require.Equal(t, []sdk.AccAddress{contractAddr, contractAddr}, hook.metadataAddrs)
+			require.Len(t, hook.metadataSet, 2)
+			require.Equal(t, ownerAcc.String(), hook.metadataSet[1].OwnerAddress)
+			require.Equal(t, rewardsAcc.String(), hook.metadataSet[1].RewardsAddress)
+		}
+	})
+
+	t.Run("Fail: hook error is returned", func(t *testing.T) {
+		hook2.metadataSetErr = errors.New("hook failed")
+		_, err := server.SetContractMetadata(ctx, &rewardstypes.MsgSetContractMetadata{
+			SenderAddress: ownerAcc.String(),
+			Metadata: rewardstypes.ContractMetadata{
+				ContractAddress: contractAddr.String(),
+				RewardsAddress:  ownerAcc.String(),
+			},
+		})
+		require.ErrorIs(t, err, hook2.metadataSetErr)
+	})
+}
+
 func TestMsgServer_SetFlatFee(t *testing.T) {
 	k, ctx, _ := testutils.RewardsKeeper(t)
 	wk := testutils.NewMockContractViewer()
@@ -275,9 +561,22 @@ func TestMsgServer_SetFlatFee(t *testing.T) {
 			expectError: true,
 			errorType:   fmt.Errorf("decoding bech32 failed: invalid bech32 string length 4"),
 		},
+		{
+			testCase: "err: contract not exist",
+			prepare: func() *rewardstypes.MsgSetFlatFee {
+				return &rewardstypes.MsgSetFlatFee{
+					SenderAddress:   contractAdminAcc.String(),
+					ContractAddress: contractAddr.String(),
+				}
+			},
+			expectError: true,
+			errorType:   rewardstypes.ErrFlatFeeNotContract,
+		},
 		{
 			testCase: "err: contract metadata not exist",
 			prepare: func() *rewardstypes.MsgSetFlatFee {
+				wk.AddContractAdmin(contractAddr.String(), contractAdminAcc.String())
+
 				return &rewardstypes.MsgSetFlatFee{
 					SenderAddress:   contractAdminAcc.String(),
 					ContractAddress: contractAddr.String(),
@@ -415,3 +714,723 @@ func TestMsgServer_UpdateParams(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgServer_SetRewardsRatios(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	account := testutils.AccAddress()
+
+	server := keeper.NewMsgServer(k)
+	querySrvr := keeper.NewQueryServer(k)
+
+	govAddress := "cosmos1a48wdtjn3egw7swhfkeshwdtjvs6hq9nlyrwut"
+
+	testCases := []struct {
+		testCase    string
+		prepare     func() *rewardstypes.MsgSetRewardsRatios
+		expectError bool
+	}{
+		{
+			testCase: "fail: negative inflation ratio",
+			prepare: func() *rewardstypes.MsgSetRewardsRatios {
+				return rewardstypes.NewMsgSetRewardsRatios(sdk.MustAccAddressFromBech32(govAddress), math.LegacyNewDecWithPrec(-2, 2), math.LegacyNewDecWithPrec(5, 1))
+			},
+			expectError: true,
+		},
+		{
+			testCase: "fail: fee rebate ratio out of range",
+			prepare: func() *rewardstypes.MsgSetRewardsRatios {
+				return rewardstypes.NewMsgSetRewardsRatios(sdk.MustAccAddressFromBech32(govAddress), math.LegacyNewDecWithPrec(2, 1), math.LegacyNewDecWithPrec(15, 1))
+			},
+			expectError: true,
+		},
+		{
+			testCase: "fail: ratios sum exceeds 1.0",
+			prepare: func() *rewardstypes.MsgSetRewardsRatios {
+				return rewardstypes.NewMsgSetRewardsRatios(sdk.MustAccAddressFromBech32(govAddress), math.LegacyNewDecWithPrec(6, 1), math.LegacyNewDecWithPrec(5, 1))
+			},
+			expectError: true,
+		},
+		{
+			testCase: "fail: invalid authority address",
+			prepare: func() *rewardstypes.MsgSetRewardsRatios {
+				return &rewardstypes.MsgSetRewardsRatios{
+					Authority:             "👻",
+					InflationRewardsRatio: math.LegacyNewDecWithPrec(2, 1),
+					TxFeeRebateRatio:      math.LegacyNewDecWithPrec(5, 1),
+				}
+			},
+			expectError: true,
+		},
+		{
+			testCase: "fail: authority address is not gov address",
+			prepare: func() *rewardstypes.MsgSetRewardsRatios {
+				return rewardstypes.NewMsgSetRewardsRatios(account, math.LegacyNewDecWithPrec(2, 1), math.LegacyNewDecWithPrec(5, 1))
+			},
+			expectError: true,
+		},
+		{
+			testCase: "ok: valid ratios with x/gov address",
+			prepare: func() *rewardstypes.MsgSetRewardsRatios {
+				return rewardstypes.NewMsgSetRewardsRatios(sdk.MustAccAddressFromBech32(govAddress), math.LegacyNewDecWithPrec(2, 1), math.LegacyNewDecWithPrec(5, 1))
+			},
+			expectError: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("Case: %s", tc.testCase), func(t *testing.T) {
+			paramsBefore := k.GetParams(ctx)
+
+			req := tc.prepare()
+			res, err := server.SetRewardsRatios(ctx, req)
+			if tc.expectError {
+				require.Error(t, err)
+				require.Equal(t, paramsBefore, k.GetParams(ctx))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, &rewardstypes.MsgSetRewardsRatiosResponse{}, res)
+
+			ratios, err := querySrvr.RewardsRatios(ctx, &rewardstypes.QueryRewardsRatiosRequest{})
+			require.NoError(t, err)
+			require.Equal(t, req.InflationRewardsRatio, ratios.InflationRewardsRatio)
+			require.Equal(t, req.TxFeeRebateRatio, ratios.TxFeeRebateRatio)
+
+			paramsAfter := k.GetParams(ctx)
+			require.Equal(t, paramsBefore.MaxWithdrawRecords, paramsAfter.MaxWithdrawRecords)
+			require.Equal(t, paramsBefore.MinPriceOfGas, paramsAfter.MinPriceOfGas)
+		})
+	}
+}
+
+func TestMsgServer_SetRewardsRatiosScheduled(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	ctx = ctx.WithBlockHeight(10)
+
+	server := keeper.NewMsgServer(k)
+
+	govAddr := sdk.MustAccAddressFromBech32("cosmos1a48wdtjn3egw7swhfkeshwdtjvs6hq9nlyrwut")
+	newMsg := func(inflationRatio, feeRebateRatio math.LegacyDec, activationHeight int64) *rewardstypes.MsgSetRewardsRatios {
+		msg := rewardstypes.NewMsgSetRewardsRatios(govAddr, inflationRatio, feeRebateRatio)
+		msg.ActivationHeight = activationHeight
+		return msg
+	}
+
+	paramsBefore := k.GetParams(ctx)
+
+	t.Run("Fail: activation height is not in the future", func(t *testing.T) {
+		_, err := server.SetRewardsRatios(ctx, newMsg(math.LegacyNewDecWithPrec(2, 1), math.LegacyNewDecWithPrec(5, 1), 10))
+		require.ErrorIs(t, err, rewardstypes.ErrInvalidRequest)
+	})
+
+	t.Run("Fail: ratios sum exceeds 1.0", func(t *testing.T) {
+		_, err := server.SetRewardsRatios(ctx, newMsg(math.LegacyNewDecWithPrec(6, 1), math.LegacyNewDecWithPrec(5, 1), 12))
+		require.ErrorIs(t, err, rewardstypes.ErrInvalidRequest)
+	})
+
+	t.Run("OK: change queued", func(t *testing.T) {
+		_, err := server.SetRewardsRatios(ctx, newMsg(math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(3, 1), 12))
+		require.NoError(t, err)
+		_, err = server.SetRewardsRatios(ctx, newMsg(math.LegacyNewDecWithPrec(2, 1), math.LegacyNewDecWithPrec(4, 1), 14))
+		require.NoError(t, err)
+
+		require.Equal(t, paramsBefore, k.GetParams(ctx))
+	})
+
+	t.Run("OK: change is not applied before the activation height", func(t *testing.T) {
+		ctx := ctx.WithBlockHeight(11)
+		k.ApplyScheduledRewardsRatios(ctx)
+
+		require.Equal(t, paramsBefore, k.GetParams(ctx))
+	})
+
+	t.Run("OK: change is applied at the activation height", func(t *testing.T) {
+		ctx := ctx.WithBlockHeight(12)
+		k.ApplyScheduledRewardsRatios(ctx)
+
+		params := k.GetParams(ctx)
+		require.Equal(t, math.LegacyNewDecWithPrec(1, 1), params.InflationRewardsRatio)
+		require.Equal(t, math.LegacyNewDecWithPrec(3, 1), params.TxFeeRebateRatio)
+		require.Equal(t, paramsBefore.MinPriceOfGas, params.MinPriceOfGas)
+
+		has, err := k.ScheduledRewardsRatios.Has(ctx, 12)
+		require.NoError(t, err)
+		require.False(t, has)
+		has, err = k.ScheduledRewardsRatios.Has(ctx, 14)
+		require.NoError(t, err)
+		require.True(t, has)
+	})
+
+	t.Run("OK: next change is applied at its activation height", func(t *testing.T) {
+		ctx := ctx.WithBlockHeight(14)
+		k.ApplyScheduledRewardsRatios(ctx)
+
+		params := k.GetParams(ctx)
+		require.Equal(t, math.LegacyNewDecWithPrec(2, 1), params.InflationRewardsRatio)
+		require.Equal(t, math.LegacyNewDecWithPrec(4, 1), params.TxFeeRebateRatio)
+
+		has, err := k.ScheduledRewardsRatios.Has(ctx, 14)
+		require.NoError(t, err)
+		require.False(t, has)
+	})
+}
+
+func TestMsgServer_SetFlatFeeByCodeID(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	wk := testutils.NewMockContractViewer()
+	k.SetContractInfoViewer(wk)
+	contractAdminAcc := testutils.AccAddress()
+
+	server := keeper.NewMsgServer(k)
+
+	govAddress := "cosmos1a48wdtjn3egw7swhfkeshwdtjvs6hq9nlyrwut"
+	fee := sdk.NewInt64Coin("test", 10)
+
+	contractAddrs := e2eTesting.GenContractAddresses(2)
+	for _, contractAddr := range contractAddrs {
+		wk.AddContractAdmin(contractAddr.String(), contractAdminAcc.String())
+		wk.SetContractCodeID(contractAddr.String(), 1)
+		require.NoError(t, k.SetContractMetadata(ctx, contractAdminAcc, contractAddr, rewardstypes.ContractMetadata{
+			ContractAddress: contractAddr.String(),
+			OwnerAddress:    contractAdminAcc.String(),
+			RewardsAddress:  contractAdminAcc.String(),
+		}))
+	}
+
+	t.Run("err: empty request", func(t *testing.T) {
+		_, err := server.SetFlatFeeByCodeID(ctx, nil)
+		require.Equal(t, status.Error(codes.InvalidArgument, "empty request"), err)
+	})
+
+	t.Run("err: authority address is not gov address", func(t *testing.T) {
+		_, err := server.SetFlatFeeByCodeID(ctx, rewardstypes.NewMsgSetFlatFeeByCodeID(contractAdminAcc, 1, fee))
+		require.ErrorIs(t, err, rewardstypes.ErrUnauthorized)
+	})
+
+	t.Run("err: invalid flat fee", func(t *testing.T) {
+		_, err := server.SetFlatFeeByCodeID(ctx, rewardstypes.NewMsgSetFlatFeeByCodeID(sdk.MustAccAddressFromBech32(govAddress), 1, sdk.Coin{Denom: "test", Amount: math.NewInt(-1)}))
+		require.ErrorIs(t, err, rewardstypes.ErrInvalidRequest)
+	})
+
+	t.Run("ok: flat fees set with x/gov address", func(t *testing.T) {
+		res, err := server.SetFlatFeeByCodeID(ctx, rewardstypes.NewMsgSetFlatFeeByCodeID(sdk.MustAccAddressFromBech32(govAddress), 1, fee))
+		require.NoError(t, err)
+		require.EqualValues(t, 2, res.ContractsUpdated)
+
+		for _, contractAddr := range contractAddrs {
+			flatFee, found := k.GetFlatFee(ctx, contractAddr)
+			require.True(t, found)
+			require.Equal(t, fee, flatFee)
+		}
+	})
+}
+
+func TestMsgServer_RebuildRewardsIndexes(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	wk := testutils.NewMockContractViewer()
+	k.SetContractInfoViewer(wk)
+	contractAdminAcc := testutils.AccAddress()
+	rewardsAddr, staleAddr := testutils.AccAddress(), testutils.AccAddress()
+
+	server := keeper.NewMsgServer(k)
+
+	govAddress := "cosmos1a48wdtjn3egw7swhfkeshwdtjvs6hq9nlyrwut"
+
+	contractAddrs := e2eTesting.GenContractAddresses(2)
+	for _, contractAddr := range contractAddrs {
+		wk.AddContractAdmin(contractAddr.String(), contractAdminAcc.String())
+		wk.SetContractCodeID(contractAddr.String(), 1)
+		require.NoError(t, k.SetContractMetadata(ctx, contractAdminAcc, contractAddr, rewardstypes.ContractMetadata{
+			ContractAddress: contractAddr.String(),
+			OwnerAddress:    contractAdminAcc.String(),
+			RewardsAddress:  rewardsAddr.String(),
+		}))
+	}
+
+	var records []rewardstypes.RewardsRecord
+	for _, contractAddr := range contractAddrs {
+		record, err := k.CreateRewardsRecord(ctx, rewardsAddr, contractAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), ctx.BlockHeight(), ctx.BlockTime())
+		require.NoError(t, err)
+		records = append(records, record)
+	}
+
+	getCodeIDContracts := func(codeID uint64) (contractAddrs []sdk.AccAddress) {
+		require.NoError(t, k.IterateContractsByCodeID(ctx, codeID, func(contractAddr sdk.AccAddress) bool {
+			contractAddrs = append(contractAddrs, contractAddr)
+			return false
+		}))
+		return contractAddrs
+	}
+	getAddressRecordIDs := func(addr sdk.AccAddress) (ids []uint64) {
+		addrRecords, err := k.GetRewardsRecordsByWithdrawAddress(ctx, addr)
+		require.NoError(t, err)
+		for _, record := range addrRecords {
+			ids = append(ids, record.Id)
+		}
+		return ids
+	}
+
+	// Corrupt the indexes: the first entries are dropped, the second ones point to stale keys
+	{
+		codeIDIndex := k.ContractCodeIDs.Indexes.CodeID
+		require.NoError(t, codeIDIndex.Unreference(ctx, contractAddrs[0], func() (uint64, error) { return 1, nil }))
+		require.NoError(t, codeIDIndex.Unreference(ctx, contractAddrs[1], func() (uint64, error) { return 1, nil }))
+		require.NoError(t, codeIDIndex.Reference(ctx, contractAddrs[1], 2, func() (uint64, error) { return 0, collections.ErrNotFound }))
+
+		addressIndex := k.RewardsRecords.Indexes.Address
+		staleRecord := records[1]
+		staleRecord.RewardsAddress = staleAddr.String()
+		require.NoError(t, addressIndex.Unreference(ctx, records[0].Id, func() (rewardstypes.RewardsRecord, error) { return records[0], nil }))
+		require.NoError(t, addressIndex.Unreference(ctx, records[1].Id, func() (rewardstypes.RewardsRecord, error) { return records[1], nil }))
+		require.NoError(t, addressIndex.Reference(ctx, staleRecord.Id, staleRecord, func() (rewardstypes.RewardsRecord, error) {
+			return rewardstypes.RewardsRecord{}, collections.ErrNotFound
+		}))
+
+		require.NoError(t, k.ContractMetadataCount.Set(ctx, 42))
+
+		require.Empty(t, getCodeIDContracts(1))
+		require.Equal(t, []sdk.AccAddress{contractAddrs[1]}, getCodeIDContracts(2))
+		require.Empty(t, getAddressRecordIDs(rewardsAddr))
+		require.Equal(t, []uint64{records[1].Id}, getAddressRecordIDs(staleAddr))
+	}
+
+	t.Run("err: empty request", func(t *testing.T) {
+		_, err := server.RebuildRewardsIndexes(ctx, nil)
+		require.Equal(t, status.Error(codes.InvalidArgument, "empty request"), err)
+	})
+
+	t.Run("err: authority address is not gov address", func(t *testing.T) {
+		_, err := server.RebuildRewardsIndexes(ctx, rewardstypes.NewMsgRebuildRewardsIndexes(contractAdminAcc))
+		require.ErrorIs(t, err, rewardstypes.ErrUnauthorized)
+	})
+
+	t.Run("ok: indexes rebuilt with x/gov address", func(t *testing.T) {
+		res, err := server.RebuildRewardsIndexes(ctx, rewardstypes.NewMsgRebuildRewardsIndexes(sdk.MustAccAddressFromBech32(govAddress)))
+		require.NoError(t, err)
+		require.EqualValues(t, 2, res.ContractsNum)
+		require.EqualValues(t, 2, res.RewardsRecordsNum)
+
+		require.ElementsMatch(t, contractAddrs, getCodeIDContracts(1))
+		require.Empty(t, getCodeIDContracts(2))
+		require.ElementsMatch(t, []uint64{records[0].Id, records[1].Id}, getAddressRecordIDs(rewardsAddr))
+		require.Empty(t, getAddressRecordIDs(staleAddr))
+
+		count, err := k.ContractMetadataCount.Get(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, count)
+	})
+
+	t.Run("ok: rebuild is idempotent", func(t *testing.T) {
+		res, err := server.RebuildRewardsIndexes(ctx, rewardstypes.NewMsgRebuildRewardsIndexes(sdk.MustAccAddressFromBech32(govAddress)))
+		require.NoError(t, err)
+		require.EqualValues(t, 2, res.ContractsNum)
+		require.EqualValues(t, 2, res.RewardsRecordsNum)
+
+		require.ElementsMatch(t, contractAddrs, getCodeIDContracts(1))
+		require.ElementsMatch(t, []uint64{records[0].Id, records[1].Id}, getAddressRecordIDs(rewardsAddr))
+	})
+}
+
+func TestMsgServer_RecoverContractRewards(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	wk := testutils.NewMockContractViewer()
+	k.SetContractInfoViewer(wk)
+	contractAdminAcc, rewardsAddr, recoveryAddr := testutils.AccAddress(), testutils.AccAddress(), testutils.AccAddress()
+
+	server := keeper.NewMsgServer(k)
+
+	govAddress := sdk.MustAccAddressFromBech32("cosmos1a48wdtjn3egw7swhfkeshwdtjvs6hq9nlyrwut")
+
+	contractAddrs := e2eTesting.GenContractAddresses(2)
+	contractAddr, otherContractAddr := contractAddrs[0], contractAddrs[1]
+	for _, addr := range contractAddrs {
+		wk.AddContractAdmin(addr.String(), contractAdminAcc.String())
+		require.NoError(t, k.SetContractMetadata(ctx, contractAdminAcc, addr, rewardstypes.ContractMetadata{
+			ContractAddress: addr.String(),
+			OwnerAddress:    contractAdminAcc.String(),
+			RewardsAddress:  rewardsAddr.String(),
+		}))
+	}
+
+	_, err := k.CreateRewardsRecord(ctx, rewardsAddr, contractAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), ctx.BlockHeight(), ctx.BlockTime())
+	require.NoError(t, err)
+	otherRecord, err := k.CreateRewardsRecord(ctx, rewardsAddr, otherContractAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 25)), ctx.BlockHeight(), ctx.BlockTime())
+	require.NoError(t, err)
+
+	// Pending flat fees queued for the direct payout within the current block
+	require.NoError(t, k.FlatFeePayouts.Set(ctx, collections.Join(contractAddr.Bytes(), rewardsAddr.Bytes()), rewardstypes.ContractRewards{
+		ContractAddress: contractAddr.String(),
+		Rewards:         sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+	}))
+	otherPayoutKey := collections.Join(otherContractAddr.Bytes(), rewardsAddr.Bytes())
+	require.NoError(t, k.FlatFeePayouts.Set(ctx, otherPayoutKey, rewardstypes.ContractRewards{
+		ContractAddress: otherContractAddr.String(),
+		Rewards:         sdk.NewCoins(sdk.NewInt64Coin("stake", 5)),
+	}))
+
+	t.Run("err: empty request", func(t *testing.T) {
+		_, err := server.RecoverContractRewards(ctx, nil)
+		require.Equal(t, status.Error(codes.InvalidArgument, "empty request"), err)
+	})
+
+	t.Run("err: authority address is not gov address", func(t *testing.T) {
+		_, err := server.RecoverContractRewards(ctx, rewardstypes.NewMsgRecoverContractRewards(contractAdminAcc, contractAddr, recoveryAddr))
+		require.ErrorIs(t, err, rewardstypes.ErrUnauthorized)
+
+		records, err := k.GetContractRewardsRecords(ctx, contractAddr)
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+	})
+
+	t.Run("err: non-existing contract metadata", func(t *testing.T) {
+		_, err := server.RecoverContractRewards(ctx, rewardstypes.NewMsgRecoverContractRewards(govAddress, e2eTesting.GenContractAddresses(3)[2], recoveryAddr))
+		require.ErrorIs(t, err, rewardstypes.ErrMetadataNotFound)
+	})
+
+	t.Run("ok: rewards recovered with x/gov address", func(t *testing.T) {
+		res, err := server.RecoverContractRewards(ctx, rewardstypes.NewMsgRecoverContractRewards(govAddress, contractAddr, recoveryAddr))
+		require.NoError(t, err)
+		require.Equal(t, "110stake", sdk.Coins(res.RecoveredRewards).String())
+
+		// Contract records and pending payouts are removed, other contract ones are kept
+		records, err := k.GetContractRewardsRecords(ctx, contractAddr)
+		require.NoError(t, err)
+		require.Empty(t, records)
+
+		records, err = k.GetRewardsRecordsByWithdrawAddress(ctx, rewardsAddr)
+		require.NoError(t, err)
+		require.Equal(t, []rewardstypes.RewardsRecord{otherRecord}, records)
+
+		has, err := k.FlatFeePayouts.Has(ctx, collections.Join(contractAddr.Bytes(), rewardsAddr.Bytes()))
+		require.NoError(t, err)
+		require.False(t, has)
+		has, err = k.FlatFeePayouts.Has(ctx, otherPayoutKey)
+		require.NoError(t, err)
+		require.True(t, has)
+
+		// Metadata is kept
+		require.NotNil(t, k.GetContractMetadata(ctx, contractAddr))
+	})
+
+	t.Run("ok: nothing to recover", func(t *testing.T) {
+		res, err := server.RecoverContractRewards(ctx, rewardstypes.NewMsgRecoverContractRewards(govAddress, contractAddr, recoveryAddr))
+		require.NoError(t, err)
+		require.True(t, sdk.Coins(res.RecoveredRewards).IsZero())
+	})
+}
+
+func TestMsgServer_SetFlatFeeOverride(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	ctx = ctx.WithBlockHeight(100)
+	wk := testutils.NewMockContractViewer()
+	k.SetContractInfoViewer(wk)
+	contractAdminAcc := testutils.AccAddress()
+
+	server := keeper.NewMsgServer(k)
+
+	govAddress := sdk.MustAccAddressFromBech32("cosmos1a48wdtjn3egw7swhfkeshwdtjvs6hq9nlyrwut")
+
+	contractAddrs := e2eTesting.GenContractAddresses(2)
+	contractAddr, noMetadataContractAddr := contractAddrs[0], contractAddrs[1]
+	wk.AddContractAdmin(contractAddr.String(), contractAdminAcc.String())
+	require.NoError(t, k.SetContractMetadata(ctx, contractAdminAcc, contractAddr, rewardstypes.ContractMetadata{
+		ContractAddress: contractAddr.String(),
+		OwnerAddress:    contractAdminAcc.String(),
+		RewardsAddress:  contractAdminAcc.String(),
+	}))
+	overrideFee := sdk.NewInt64Coin("stake", 10)
+
+	t.Run("err: empty request", func(t *testing.T) {
+		_, err := server.SetFlatFeeOverride(ctx, nil)
+		require.Equal(t, status.Error(codes.InvalidArgument, "empty request"), err)
+	})
+
+	t.Run("err: authority address is not gov address", func(t *testing.T) {
+		_, err := server.SetFlatFeeOverride(ctx, rewardstypes.NewMsgSetFlatFeeOverride(contractAdminAcc, contractAddr, overrideFee, 200))
+		require.ErrorIs(t, err, rewardstypes.ErrUnauthorized)
+
+		_, found := k.GetFlatFeeOverride(ctx, contractAddr)
+		require.False(t, found)
+	})
+
+	t.Run("err: non-existing contract metadata", func(t *testing.T) {
+		_, err := server.SetFlatFeeOverride(ctx, rewardstypes.NewMsgSetFlatFeeOverride(govAddress, noMetadataContractAddr, overrideFee, 200))
+		require.ErrorIs(t, err, rewardstypes.ErrMetadataNotFound)
+	})
+
+	t.Run("err: expiry height is not in the future", func(t *testing.T) {
+		_, err := server.SetFlatFeeOverride(ctx, rewardstypes.NewMsgSetFlatFeeOverride(govAddress, contractAddr, overrideFee, 100))
+		require.ErrorIs(t, err, rewardstypes.ErrInvalidRequest)
+	})
+
+	t.Run("ok: override set with x/gov address", func(t *testing.T) {
+		_, err := server.SetFlatFeeOverride(ctx, rewardstypes.NewMsgSetFlatFeeOverride(govAddress, contractAddr, overrideFee, 200))
+		require.NoError(t, err)
+
+		fee, found := k.GetFlatFeeOverride(ctx, contractAddr)
+		require.True(t, found)
+		require.Equal(t, overrideFee, fee)
+
+		_, found = k.GetFlatFeeOverride(ctx.WithBlockHeight(200), contractAddr)
+		require.False(t, found)
+	})
+}
+
+func TestMsgServer_TransferContractOwnership(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	wk := testutils.NewMockContractViewer()
+	k.SetContractInfoViewer(wk)
+	ownerAcc, newOwnerAcc, prevRewardsAcc, newRewardsAcc := testutils.AccAddress(), testutils.AccAddress(), testutils.AccAddress(), testutils.AccAddress()
+	contractAddrs := e2eTesting.GenContractAddresses(2)
+
+	server := keeper.NewMsgServer(k)
+
+	for _, contractAddr := range contractAddrs {
+		wk.AddContractAdmin(contractAddr.String(), ownerAcc.String())
+		_, err := server.SetContractMetadata(ctx, &rewardstypes.MsgSetContractMetadata{
+			SenderAddress: ownerAcc.String(),
+			Metadata: rewardstypes.ContractMetadata{
+				ContractAddress:  contractAddr.String(),
+				RewardsAddress:   prevRewardsAcc.String(),
+				WithdrawToWallet: true,
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	err := SetupWithdrawTest(k, ctx, []withdrawTestRecordData{
+		{
+			RecordID:     1,
+			RewardsAddr:  prevRewardsAcc,
+			Rewards:      sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)),
+			ContractAddr: contractAddrs[0],
+		},
+		{
+			RecordID:     2,
+			RewardsAddr:  prevRewardsAcc,
+			Rewards:      sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+			ContractAddr: contractAddrs[1],
+		},
+	})
+	require.NoError(t, err)
+
+	t.Run("Fail: empty request", func(t *testing.T) {
+		_, err := server.TransferContractOwnership(ctx, nil)
+		require.Equal(t, status.Error(codes.InvalidArgument, "empty request"), err)
+	})
+
+	t.Run("Fail: unauthorized sender", func(t *testing.T) {
+		msg := rewardstypes.NewMsgTransferContractOwnership(newOwnerAcc, contractAddrs[0], newOwnerAcc, &newRewardsAcc)
+		_, err := server.TransferContractOwnership(ctx, msg)
+		require.ErrorIs(t, err, rewardstypes.ErrUnauthorized)
+
+		meta := k.GetContractMetadata(ctx, contractAddrs[0])
+		require.Equal(t, ownerAcc.String(), meta.OwnerAddress)
+		require.Equal(t, prevRewardsAcc.String(), meta.RewardsAddress)
+	})
+
+	t.Run("Fail: non-existing contract metadata", func(t *testing.T) {
+		msg := rewardstypes.NewMsgTransferContractOwnership(ownerAcc, e2eTesting.GenContractAddresses(3)[2], newOwnerAcc, nil)
+		_, err := server.TransferContractOwnership(ctx, msg)
+		require.ErrorIs(t, err, rewardstypes.ErrMetadataNotFound)
+	})
+
+	t.Run("OK: full transfer", func(t *testing.T) {
+		msg := rewardstypes.NewMsgTransferContractOwnership(ownerAcc, contractAddrs[0], newOwnerAcc, &newRewardsAcc)
+		msg.MigrateRewardsRecords = true
+		res, err := server.TransferContractOwnership(ctx, msg)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, res.MigratedRecordsNum)
+
+		meta := k.GetContractMetadata(ctx, contractAddrs[0])
+		require.Equal(t, newOwnerAcc.String(), meta.OwnerAddress)
+		require.Equal(t, newRewardsAcc.String(), meta.RewardsAddress)
+		require.True(t, meta.WithdrawToWallet)
+
+		records, err := k.GetRewardsRecordsByWithdrawAddress(ctx, newRewardsAcc)
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		require.EqualValues(t, 1, records[0].Id)
+
+		// The previous owner is not authorized anymore
+		_, err = server.TransferContractOwnership(ctx, rewardstypes.NewMsgTransferContractOwnership(ownerAcc, contractAddrs[0], ownerAcc, nil))
+		require.ErrorIs(t, err, rewardstypes.ErrUnauthorized)
+	})
+
+	t.Run("OK: partial (owner only) transfer", func(t *testing.T) {
+		res, err := server.TransferContractOwnership(ctx, rewardstypes.NewMsgTransferContractOwnership(ownerAcc, contractAddrs[1], newOwnerAcc, nil))
+		require.NoError(t, err)
+		require.Zero(t, res.MigratedRecordsNum)
+
+		meta := k.GetContractMetadata(ctx, contractAddrs[1])
+		require.Equal(t, newOwnerAcc.String(), meta.OwnerAddress)
+		require.Equal(t, prevRewardsAcc.String(), meta.RewardsAddress)
+		require.True(t, meta.WithdrawToWallet)
+
+		records, err := k.GetRewardsRecordsByWithdrawAddress(ctx, prevRewardsAcc)
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		require.EqualValues(t, 2, records[0].Id)
+	})
+}
+
+func TestMsgServer_ResetLifetimeRewards(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	wk := testutils.NewMockContractViewer()
+	k.SetContractInfoViewer(wk)
+	ownerAcc, otherAcc := testutils.AccAddress(), testutils.AccAddress()
+	authorityAcc := sdk.MustAccAddressFromBech32(k.GetAuthority())
+	contractAddrs := e2eTesting.GenContractAddresses(2)
+
+	server := keeper.NewMsgServer(k)
+
+	now := ctx.BlockTime()
+	for _, contractAddr := range contractAddrs {
+		wk.AddContractAdmin(contractAddr.String(), ownerAcc.String())
+		_, err := server.SetContractMetadata(ctx, &rewardstypes.MsgSetContractMetadata{
+			SenderAddress: ownerAcc.String(),
+			Metadata: rewardstypes.ContractMetadata{
+				ContractAddress: contractAddr.String(),
+				RewardsAddress:  ownerAcc.String(),
+			},
+		})
+		require.NoError(t, err)
+
+		stats := rewardstypes.NewContractRewardsStats(contractAddr).AddRewards(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), now)
+		require.NoError(t, k.ContractRewardsStats.Set(ctx, contractAddr, stats))
+	}
+
+	t.Run("Fail: empty request", func(t *testing.T) {
+		_, err := server.ResetLifetimeRewards(ctx, nil)
+		require.Equal(t, status.Error(codes.InvalidArgument, "empty request"), err)
+	})
+
+	t.Run("Fail: unauthorized sender", func(t *testing.T) {
+		_, err := server.ResetLifetimeRewards(ctx, rewardstypes.NewMsgResetLifetimeRewards(otherAcc, contractAddrs[0]))
+		require.ErrorIs(t, err, rewardstypes.ErrUnauthorized)
+
+		stats, found := k.GetContractRewardsStats(ctx, contractAddrs[0])
+		require.True(t, found)
+		require.Equal(t, "100stake", sdk.Coins(stats.LifetimeRewards).String())
+	})
+
+	t.Run("Fail: non-existing contract metadata", func(t *testing.T) {
+		_, err := server.ResetLifetimeRewards(ctx, rewardstypes.NewMsgResetLifetimeRewards(ownerAcc, e2eTesting.GenContractAddresses(3)[2]))
+		require.ErrorIs(t, err, rewardstypes.ErrMetadataNotFound)
+	})
+
+	t.Run("OK: reset by the contract owner", func(t *testing.T) {
+		ctx := ctx.WithEventManager(sdk.NewEventManager())
+		res, err := server.ResetLifetimeRewards(ctx, rewardstypes.NewMsgResetLifetimeRewards(ownerAcc, contractAddrs[0]))
+		require.NoError(t, err)
+		require.Equal(t, "100stake", sdk.Coins(res.ResetRewards).String())
+
+		stats, found := k.GetContractRewardsStats(ctx, contractAddrs[0])
+		require.True(t, found)
+		require.Empty(t, stats.LifetimeRewards)
+		require.Equal(t, "100stake", sdk.Coins(stats.RecentRewards).String())
+
+		events := ctx.EventManager().Events()
+		require.Len(t, events, 1)
+		require.Equal(t, "archway.rewards.v1.ContractLifetimeRewardsResetEvent", events[0].Type)
+	})
+
+	t.Run("OK: reset by the module authority", func(t *testing.T) {
+		res, err := server.ResetLifetimeRewards(ctx, rewardstypes.NewMsgResetLifetimeRewards(authorityAcc, contractAddrs[1]))
+		require.NoError(t, err)
+		require.Equal(t, "100stake", sdk.Coins(res.ResetRewards).String())
+
+		stats, found := k.GetContractRewardsStats(ctx, contractAddrs[1])
+		require.True(t, found)
+		require.Empty(t, stats.LifetimeRewards)
+	})
+
+	t.Run("OK: reset by the module authority without contract metadata", func(t *testing.T) {
+		res, err := server.ResetLifetimeRewards(ctx, rewardstypes.NewMsgResetLifetimeRewards(authorityAcc, e2eTesting.GenContractAddresses(3)[2]))
+		require.NoError(t, err)
+		require.Empty(t, res.ResetRewards)
+	})
+}
+
+func TestMsgServer_WithdrawRewardsIBCUnwrap(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	rewardsAddr, receiver := testutils.AccAddress(), "cosmos1a48wdtjn3egw7swhfkeshwdtjvs6hq9nlyrwut"
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+
+	voucherTrace := ibcTransferTypes.DenomTrace{Path: "transfer/channel-0", BaseDenom: "uatom"}
+	multiHopTrace := ibcTransferTypes.DenomTrace{Path: "transfer/channel-1/transfer/channel-5", BaseDenom: "uosmo"}
+	voucherCoin := sdk.NewInt64Coin(voucherTrace.IBCDenom(), 100)
+	multiHopCoin := sdk.NewInt64Coin(multiHopTrace.IBCDenom(), 50)
+	nativeCoin := sdk.NewInt64Coin("stake", 10)
+
+	createRecord := func() {
+		_, err := k.CreateRewardsRecord(ctx, rewardsAddr, contractAddr, sdk.NewCoins(voucherCoin, multiHopCoin, nativeCoin), ctx.BlockHeight(), ctx.BlockTime())
+		require.NoError(t, err)
+	}
+
+	var transfers []*ibcTransferTypes.MsgTransfer
+	k.SetTransferKeeper(testutils.MockTransferKeeper{
+		GetDenomTraceFn: func(ctx sdk.Context, denomTraceHash cmtBytes.HexBytes) (ibcTransferTypes.DenomTrace, bool) {
+			for _, trace := range []ibcTransferTypes.DenomTrace{voucherTrace, multiHopTrace} {
+				if trace.Hash().String() == denomTraceHash.String() {
+					return trace, true
+				}
+			}
+			return ibcTransferTypes.DenomTrace{}, false
+		},
+		TransferFn: func(ctx context.Context, msg *ibcTransferTypes.MsgTransfer) (*ibcTransferTypes.MsgTransferResponse, error) {
+			transfers = append(transfers, msg)
+			return &ibcTransferTypes.MsgTransferResponse{}, nil
+		},
+	})
+	server := keeper.NewMsgServer(k)
+
+	t.Run("ok: voucher rewards withdrawn without unwrap", func(t *testing.T) {
+		createRecord()
+		transfers = nil
+
+		res, err := server.WithdrawRewards(ctx, rewardstypes.NewMsgWithdrawRewardsByLimit(rewardsAddr, 1))
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewCoins(voucherCoin, multiHopCoin, nativeCoin).String(), sdk.Coins(res.TotalRewards).String())
+		require.Empty(t, res.UnwrappedRewards)
+		require.Empty(t, transfers)
+	})
+
+	t.Run("ok: voucher rewards withdrawn with unwrap", func(t *testing.T) {
+		createRecord()
+		transfers = nil
+
+		msg := rewardstypes.NewMsgWithdrawRewardsByLimit(rewardsAddr, 1)
+		msg.IbcUnwrap = &rewardstypes.MsgWithdrawRewards_IBCUnwrap{Receiver: receiver, TimeoutSeconds: 60}
+		res, err := server.WithdrawRewards(ctx, msg)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewCoins(voucherCoin, multiHopCoin, nativeCoin).String(), sdk.Coins(res.TotalRewards).String())
+		require.Equal(t, sdk.NewCoins(voucherCoin).String(), sdk.Coins(res.UnwrappedRewards).String())
+
+		// Only the single-hop voucher is sent back over the channel it was received from
+		require.Len(t, transfers, 1)
+		require.Equal(t, "transfer", transfers[0].SourcePort)
+		require.Equal(t, "channel-0", transfers[0].SourceChannel)
+		require.Equal(t, voucherCoin, transfers[0].Token)
+		require.Equal(t, rewardsAddr.String(), transfers[0].Sender)
+		require.Equal(t, receiver, transfers[0].Receiver)
+		require.EqualValues(t, ctx.BlockTime().Add(60*time.Second).UnixNano(), transfers[0].TimeoutTimestamp)
+	})
+
+	t.Run("err: unwrap transfer failed", func(t *testing.T) {
+		createRecord()
+		k.SetTransferKeeper(testutils.MockTransferKeeper{
+			GetDenomTraceFn: func(ctx sdk.Context, denomTraceHash cmtBytes.HexBytes) (ibcTransferTypes.DenomTrace, bool) {
+				return voucherTrace, true
+			},
+			TransferFn: func(ctx context.Context, msg *ibcTransferTypes.MsgTransfer) (*ibcTransferTypes.MsgTransferResponse, error) {
+				return nil, errors.New("channel closed")
+			},
+		})
+
+		msg := rewardstypes.NewMsgWithdrawRewardsByLimit(rewardsAddr, 1)
+		msg.IbcUnwrap = &rewardstypes.MsgWithdrawRewards_IBCUnwrap{Receiver: receiver}
+		_, err := keeper.NewMsgServer(k).WithdrawRewards(ctx, msg)
+		require.ErrorContains(t, err, "channel closed")
+	})
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	math "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -26,8 +28,238 @@ func (k Keeper) MinimumPriceOfGas(ctx sdk.Context) sdk.DecCoin {
 	return k.GetParams(ctx).MinPriceOfGas
 }
 
+// DynamicFeeEnabled returns true if the EIP-1559 like dynamic fee mode is enabled.
+func (k Keeper) DynamicFeeEnabled(ctx sdk.Context) bool {
+	return k.GetParams(ctx).DynamicFeeEnabled
+}
+
+// FlatFeeUpdateInterval return the minimum number of blocks between two contract flat fee updates.
+func (k Keeper) FlatFeeUpdateInterval(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).FlatFeeUpdateInterval
+}
+
+// MaxFlatFeeUpdateContracts return the maximum number of contracts a single bulk flat fee update could affect.
+func (k Keeper) MaxFlatFeeUpdateContracts(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).MaxFlatFeeUpdateContracts
+}
+
+// FlatFeeDeliverTxOnly returns true if contract flat fees are not charged in CheckTx.
+func (k Keeper) FlatFeeDeliverTxOnly(ctx sdk.Context) bool {
+	return k.GetParams(ctx).FlatFeeDeliverTxOnly
+}
+
+// FlatFeeOncePerBlock returns true if contract flat fees are charged once per block (by the first transaction targeting the contract).
+func (k Keeper) FlatFeeOncePerBlock(ctx sdk.Context) bool {
+	return k.GetParams(ctx).FlatFeeOncePerBlock
+}
+
+// AcceptedFeeDenoms returns the denoms transaction fees could be paid in (any denom if empty).
+func (k Keeper) AcceptedFeeDenoms(ctx sdk.Context) []string {
+	return k.GetParams(ctx).AcceptedFeeDenoms
+}
+
+// TxSizeFeePerByte returns the min fee surcharge per encoded tx byte (zero if disabled).
+func (k Keeper) TxSizeFeePerByte(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).TxSizeFeePerByte
+}
+
+// SingleDenomFeesOnly returns true if transaction fees must be paid in a single denom.
+func (k Keeper) SingleDenomFeesOnly(ctx sdk.Context) bool {
+	return k.GetParams(ctx).SingleDenomFeesOnly
+}
+
+// FlatFeePayerMustSign returns true if the fee payer must sign the contract execute msgs charged the flat fees.
+func (k Keeper) FlatFeePayerMustSign(ctx sdk.Context) bool {
+	return k.GetParams(ctx).FlatFeePayerMustSign
+}
+
+// FlatFeesEnabled returns true if the contract flat fees are charged.
+func (k Keeper) FlatFeesEnabled(ctx sdk.Context) bool {
+	return k.GetParams(ctx).FlatFeesEnabled
+}
+
+// FeeDenomRoutes returns the per denom destinations of the fee collector fees (no routing if empty).
+func (k Keeper) FeeDenomRoutes(ctx sdk.Context) []types.FeeDenomRoute {
+	return k.GetParams(ctx).FeeDenomRoutes
+}
+
+// FlatFeeConversionRates returns the rates the contract flat fees are accepted in other tx fee denoms at
+// (no conversion if empty).
+func (k Keeper) FlatFeeConversionRates(ctx sdk.Context) []types.FlatFeeConversionRate {
+	return k.GetParams(ctx).FlatFeeConversionRates
+}
+
+// CheckTxMinFeeEventEnabled returns true if the min fee expected is reported by an event in the CheckTx response.
+func (k Keeper) CheckTxMinFeeEventEnabled(ctx sdk.Context) bool {
+	return k.GetParams(ctx).CheckTxMinFeeEventEnabled
+}
+
+// MaxContractBlockRewards returns the maximum rewards a single contract could be distributed within a block
+// (not limited if empty).
+func (k Keeper) MaxContractBlockRewards(ctx sdk.Context) sdk.Coins {
+	return k.GetParams(ctx).MaxContractBlockRewards
+}
+
+// FlatFeePrepayDiscount returns the prepaid contract executions flat fee discount (basis points).
+func (k Keeper) FlatFeePrepayDiscount(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).FlatFeePrepayDiscount
+}
+
+// MinFeeFloorEnabled returns true if a zero minimum transaction fee is floored to 1 unit of the gas price denom.
+func (k Keeper) MinFeeFloorEnabled(ctx sdk.Context) bool {
+	return k.GetParams(ctx).MinFeeFloorEnabled
+}
+
+// MinContractExecutionGas returns the minimum gas limit of a transaction containing contract executions (zero if disabled).
+func (k Keeper) MinContractExecutionGas(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).MinContractExecutionGas
+}
+
+// FreeTxBudget returns the number of fee-free transactions granted to every account (zero if disabled).
+func (k Keeper) FreeTxBudget(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).FreeTxBudget
+}
+
+// MaxGasRebateMultiplier returns the contract gas rebate multiplier upper bound (basis points).
+func (k Keeper) MaxGasRebateMultiplier(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).MaxGasRebateMultiplier
+}
+
+// MaxFlatFeeMsgsPerTx returns the maximum number of contract flat fee msgs per transaction (zero if disabled).
+func (k Keeper) MaxFlatFeeMsgsPerTx(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).MaxFlatFeeMsgsPerTx
+}
+
+// FlatFeeAbsorbedInGasFee returns true if the same denom contract flat fees are counted toward the gas min fee.
+func (k Keeper) FlatFeeAbsorbedInGasFee(ctx sdk.Context) bool {
+	return k.GetParams(ctx).FlatFeeAbsorbedInGasFee
+}
+
+// FlatFeeMigrationPolicy returns the contract flat fee reconciliation policy applied on a contract migration.
+func (k Keeper) FlatFeeMigrationPolicy(ctx sdk.Context) types.FlatFeeMigrationPolicy {
+	return k.GetParams(ctx).FlatFeeMigrationPolicy
+}
+
+// GetDistributionConfig returns the module parameters affecting the fees and rewards distribution.
+func (k Keeper) GetDistributionConfig(ctx sdk.Context) types.DistributionConfig {
+	params := k.GetParams(ctx)
+
+	return types.DistributionConfig{
+		InflationRewardsRatio:     params.InflationRewardsRatio,
+		TxFeeRebateRatio:          params.TxFeeRebateRatio,
+		MinPriceOfGas:             params.MinPriceOfGas,
+		MinFeeFloorEnabled:        params.MinFeeFloorEnabled,
+		DynamicFeeEnabled:         params.DynamicFeeEnabled,
+		FlatFeeDeliverTxOnly:      params.FlatFeeDeliverTxOnly,
+		FlatFeeUpdateInterval:     params.FlatFeeUpdateInterval,
+		MaxFlatFeeUpdateContracts: params.MaxFlatFeeUpdateContracts,
+		FlatFeeOncePerBlock:       params.FlatFeeOncePerBlock,
+		AcceptedFeeDenoms:         params.AcceptedFeeDenoms,
+		TxSizeFeePerByte:          params.TxSizeFeePerByte,
+		SingleDenomFeesOnly:       params.SingleDenomFeesOnly,
+		FlatFeePrepayDiscount:     params.FlatFeePrepayDiscount,
+		FlatFeePayerMustSign:      params.FlatFeePayerMustSign,
+		FlatFeesEnabled:           params.FlatFeesEnabled,
+		FeeDenomRoutes:            params.FeeDenomRoutes,
+		FlatFeeConversionRates:    params.FlatFeeConversionRates,
+	}
+}
+
+// SetRewardsRatios updates the inflation rewards and tx fee rebate ratios keeping the rest of the module params intact.
+// Resulting params are validated, so both ratios must be within the [0.0, 1.0) range.
+func (k Keeper) SetRewardsRatios(ctx sdk.Context, inflationRatio, feeRebateRatio math.LegacyDec) error {
+	if inflationRatio.IsNil() || feeRebateRatio.IsNil() {
+		return errorsmod.Wrap(types.ErrInvalidRequest, "ratios must be set")
+	}
+
+	params := k.GetParams(ctx)
+	params.InflationRewardsRatio = inflationRatio
+	params.TxFeeRebateRatio = feeRebateRatio
+
+	if err := params.Validate(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+
+	return k.Params.Set(ctx, params)
+}
+
+// ScheduleRewardsRatios queues the inflation rewards and tx fee rebate ratios update to be applied at the beginning
+// of the activation height block (a change already queued for that height is replaced).
+func (k Keeper) ScheduleRewardsRatios(ctx sdk.Context, activationHeight int64, inflationRatio, feeRebateRatio math.LegacyDec) error {
+	if activationHeight <= ctx.BlockHeight() {
+		return errorsmod.Wrapf(types.ErrInvalidRequest, "activation height (%d) must be GT the current block height (%d)", activationHeight, ctx.BlockHeight())
+	}
+
+	scheduled := types.ScheduledRewardsRatios{
+		ActivationHeight:      activationHeight,
+		InflationRewardsRatio: inflationRatio,
+		TxFeeRebateRatio:      feeRebateRatio,
+	}
+	if err := scheduled.Validate(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+
+	return k.ScheduledRewardsRatios.Set(ctx, activationHeight, scheduled)
+}
+
+// ApplyScheduledRewardsRatios applies the rewards ratios changes queued up to the current block height (in the
+// activation height order) and removes them from the queue.
+// A change that can't be applied is dropped.
+func (k Keeper) ApplyScheduledRewardsRatios(ctx sdk.Context) {
+	rng := new(collections.Range[int64]).EndInclusive(ctx.BlockHeight())
+
+	var dueChanges []types.ScheduledRewardsRatios
+	err := k.ScheduledRewardsRatios.Walk(ctx, rng, func(_ int64, scheduled types.ScheduledRewardsRatios) (bool, error) {
+		dueChanges = append(dueChanges, scheduled)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	for _, scheduled := range dueChanges {
+		if err := k.SetRewardsRatios(ctx, scheduled.InflationRewardsRatio, scheduled.TxFeeRebateRatio); err != nil {
+			k.Logger(ctx).Error("Scheduled rewards ratios change dropped", "activationHeight", scheduled.ActivationHeight, "error", err)
+		}
+		if err := k.ScheduledRewardsRatios.Remove(ctx, scheduled.ActivationHeight); err != nil {
+			panic(err)
+		}
+	}
+}
+
 // GetParams return all module parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	params, _ = k.Params.Get(ctx)
 	return
 }
+
+// FeePromotionDiscount returns the fee promotion discount (basis points) active at the current block height
+// (zero if there is no promotion running).
+func (k Keeper) FeePromotionDiscount(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).FeePromotion.ActiveDiscount(ctx.BlockHeight())
+}
+
+// FlatFeeRefundOnFailure returns true if the contract flat fees are refunded for failed tx msgs executions.
+func (k Keeper) FlatFeeRefundOnFailure(ctx sdk.Context) bool {
+	return k.GetParams(ctx).FlatFeeRefundOnFailure
+}
+
+// RewardsCallbackGasLimit returns the contract rewards callback gas limit (zero if callbacks are disabled).
+func (k Keeper) RewardsCallbackGasLimit(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).RewardsCallbackGasLimit
+}
+
+// RewardsCallbacksBlockGasLimit returns the contract rewards callbacks gas budget per block (zero if callbacks are disabled).
+func (k Keeper) RewardsCallbacksBlockGasLimit(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).RewardsCallbacksBlockGasLimit
+}
+
+// MinConsensusFeeSmoothingWindow returns the number of recent blocks the minimum consensus fee is averaged over.
+func (k Keeper) MinConsensusFeeSmoothingWindow(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).MinConsensusFeeSmoothingWindow
+}
+
+// CheckTxLocalMinGasPriceEnabled returns true if CheckTx uses the node local min gas price instead of the consensus one.
+func (k Keeper) CheckTxLocalMinGasPriceEnabled(ctx sdk.Context) bool {
+	return k.GetParams(ctx).CheckTxLocalMinGasPriceEnabled
+}
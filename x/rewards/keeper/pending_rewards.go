@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/archway-network/archway/x/rewards/types"
+)
+
+// GetTotalPendingRewards returns the total rewards the module owes to dApps: the outstanding rewards records,
+// the flat fees queued for the direct payout and the current block tracked rewards (inflation and fee rebate ones)
+// not distributed yet. The total is expected to be covered by the UndistributedRewardsPool.
+// Current block rewards leftovers are transferred to the treasury by the EndBlocker, so that part is an upper bound.
+func (k Keeper) GetTotalPendingRewards(ctx sdk.Context) (sdk.Coins, error) {
+	total := sdk.NewCoins()
+
+	err := k.RewardsRecords.Walk(ctx, nil, func(_ uint64, record types.RewardsRecord) (bool, error) {
+		total = total.Add(record.Rewards...)
+		return false, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("summing rewards records: %w", err)
+	}
+
+	err = k.FlatFeePayouts.Walk(ctx, nil, func(_ collections.Pair[[]byte, []byte], payout types.ContractRewards) (bool, error) {
+		total = total.Add(payout.Rewards...)
+		return false, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("summing flat fee payouts: %w", err)
+	}
+
+	height := ctx.BlockHeight()
+	blockRewards, err := k.BlockRewards.Get(ctx, uint64(height))
+	switch {
+	case err == nil:
+		if blockRewards.HasRewards() {
+			total = total.Add(blockRewards.InflationRewards)
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return nil, fmt.Errorf("getting block rewards: %w", err)
+	}
+
+	txRewards, err := k.GetTxRewardsByBlock(ctx, uint64(height))
+	if err != nil {
+		return nil, fmt.Errorf("getting tx rewards: %w", err)
+	}
+	for _, txReward := range txRewards {
+		total = total.Add(txReward.FeeRewards...)
+	}
+
+	return total, nil
+}
+
+// ReconcileRewards compares the rewards pool balance against the tokens the module expects to hold (the total pending
+// rewards and the rewards remainders reserve) and reports the per denom discrepancies. State is not modified.
+// A surplus is expected within a block (the current block leftovers are transferred to the treasury by the EndBlocker),
+// a deficit means the pending rewards are not backed by the pool.
+func (k Keeper) ReconcileRewards(ctx sdk.Context) (types.RewardsReconciliation, error) {
+	pendingRewards, err := k.GetTotalPendingRewards(ctx)
+	if err != nil {
+		return types.RewardsReconciliation{}, err
+	}
+	expected := pendingRewards.Add(k.rewardsRemaindersReserve(ctx)...)
+	pool := k.UndistributedRewardsPool(ctx)
+
+	surplus, deficit := sdk.NewCoins(), sdk.NewCoins()
+	for _, coin := range expected {
+		if diff := coin.Amount.Sub(pool.AmountOf(coin.Denom)); diff.IsPositive() {
+			deficit = deficit.Add(sdk.NewCoin(coin.Denom, diff))
+		}
+	}
+	for _, coin := range pool {
+		if diff := coin.Amount.Sub(expected.AmountOf(coin.Denom)); diff.IsPositive() {
+			surplus = surplus.Add(sdk.NewCoin(coin.Denom, diff))
+		}
+	}
+
+	return types.RewardsReconciliation{
+		Expected:    expected,
+		PoolBalance: pool,
+		Surplus:     surplus,
+		Deficit:     deficit,
+	}, nil
+}
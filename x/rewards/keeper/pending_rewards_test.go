@@ -0,0 +1,198 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	mintTypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	e2eTesting "github.com/archway-network/archway/e2e/testing"
+	"github.com/archway-network/archway/pkg/testutils"
+	"github.com/archway-network/archway/x/rewards/keeper"
+	"github.com/archway-network/archway/x/rewards/types"
+)
+
+func TestGetTotalPendingRewards(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	ctx = ctx.WithBlockHeight(100)
+	height := uint64(ctx.BlockHeight())
+
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	rewardsAddr := testutils.AccAddress()
+
+	t.Run("OK: nothing pending", func(t *testing.T) {
+		total, err := k.GetTotalPendingRewards(ctx)
+		require.NoError(t, err)
+		require.True(t, total.IsZero())
+	})
+
+	// Outstanding rewards records: 10stake + 20stake,5uarch
+	_, err := k.CreateRewardsRecord(ctx, rewardsAddr, contractAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), ctx.BlockHeight(), ctx.BlockTime())
+	require.NoError(t, err)
+	_, err = k.CreateRewardsRecord(ctx, rewardsAddr, contractAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 20), sdk.NewInt64Coin("uarch", 5)), ctx.BlockHeight(), ctx.BlockTime())
+	require.NoError(t, err)
+
+	// Flat fees queued for the direct payout: 3uarch
+	require.NoError(t, k.FlatFeePayouts.Set(ctx, collections.Join(contractAddr.Bytes(), rewardsAddr.Bytes()), types.ContractRewards{
+		ContractAddress: contractAddr.String(),
+		Rewards:         sdk.NewCoins(sdk.NewInt64Coin("uarch", 3)),
+	}))
+
+	// Current block tracked rewards: 100stake inflation + 7stake fee rebates (previous block ones are already distributed)
+	require.NoError(t, k.BlockRewards.Set(ctx, height, types.BlockRewards{Height: ctx.BlockHeight(), InflationRewards: sdk.NewInt64Coin("stake", 100)}))
+	require.NoError(t, k.BlockRewards.Set(ctx, height-1, types.BlockRewards{Height: ctx.BlockHeight() - 1, InflationRewards: sdk.NewInt64Coin("stake", 1000)}))
+	require.NoError(t, k.TxRewards.Set(ctx, 1, types.TxRewards{TxId: 1, Height: ctx.BlockHeight() - 1, FeeRewards: sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))}))
+	require.NoError(t, k.TxRewards.Set(ctx, 2, types.TxRewards{TxId: 2, Height: ctx.BlockHeight(), FeeRewards: sdk.NewCoins(sdk.NewInt64Coin("stake", 3))}))
+	require.NoError(t, k.TxRewards.Set(ctx, 3, types.TxRewards{TxId: 3, Height: ctx.BlockHeight(), FeeRewards: sdk.NewCoins(sdk.NewInt64Coin("stake", 4))}))
+
+	t.Run("OK: records, payouts and current block rewards", func(t *testing.T) {
+		total, err := k.GetTotalPendingRewards(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "137stake,8uarch", total.String())
+	})
+
+	t.Run("OK: query", func(t *testing.T) {
+		res, err := keeper.NewQueryServer(k).TotalPendingRewards(ctx, &types.QueryTotalPendingRewardsRequest{})
+		require.NoError(t, err)
+		require.Equal(t, "137stake,8uarch", sdk.Coins(res.PendingRewards).String())
+	})
+}
+
+func TestRewardsSince(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	rewardsAddr, otherRewardsAddr := testutils.AccAddress(), testutils.AccAddress()
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	var records []types.RewardsRecord
+	for _, height := range []int64{10, 20, 20, 30} {
+		record, err := k.CreateRewardsRecord(ctx, rewardsAddr, contractAddr, rewards, height, ctx.BlockTime())
+		require.NoError(t, err)
+		records = append(records, record)
+	}
+	_, err := k.CreateRewardsRecord(ctx, otherRewardsAddr, contractAddr, rewards, 25, ctx.BlockTime())
+	require.NoError(t, err)
+
+	t.Run("OK: all records after the cutoff", func(t *testing.T) {
+		since, _, err := k.RewardsSince(ctx, rewardsAddr, 0, nil)
+		require.NoError(t, err)
+		require.Equal(t, records, since)
+	})
+
+	t.Run("OK: records at the cutoff height are included", func(t *testing.T) {
+		since, _, err := k.RewardsSince(ctx, rewardsAddr, 20, nil)
+		require.NoError(t, err)
+		require.Equal(t, records[1:], since)
+	})
+
+	t.Run("OK: records before the cutoff height are excluded", func(t *testing.T) {
+		since, _, err := k.RewardsSince(ctx, rewardsAddr, 21, nil)
+		require.NoError(t, err)
+		require.Equal(t, records[3:], since)
+	})
+
+	t.Run("OK: no records after the cutoff", func(t *testing.T) {
+		since, _, err := k.RewardsSince(ctx, rewardsAddr, 31, nil)
+		require.NoError(t, err)
+		require.Empty(t, since)
+	})
+
+	t.Run("OK: paginated", func(t *testing.T) {
+		since, pageRes, err := k.RewardsSince(ctx, rewardsAddr, 20, &query.PageRequest{Limit: 2})
+		require.NoError(t, err)
+		require.Equal(t, records[1:3], since)
+		require.NotEmpty(t, pageRes.NextKey)
+
+		since, pageRes, err = k.RewardsSince(ctx, rewardsAddr, 20, &query.PageRequest{Key: pageRes.NextKey, Limit: 2})
+		require.NoError(t, err)
+		require.Equal(t, records[3:], since)
+		require.Empty(t, pageRes.NextKey)
+	})
+
+	t.Run("Fail: limit exceeded", func(t *testing.T) {
+		_, _, err := k.RewardsSince(ctx, rewardsAddr, 0, &query.PageRequest{Limit: types.MaxRecordsQueryLimit + 1})
+		require.ErrorIs(t, err, types.ErrInvalidRequest)
+	})
+
+	t.Run("OK: withdrawn records are excluded", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+
+		_, _, err := k.WithdrawRewardsByRecordIDs(ctx, rewardsAddr, []uint64{records[1].Id})
+		require.NoError(t, err)
+
+		since, _, err := k.RewardsSince(ctx, rewardsAddr, 0, nil)
+		require.NoError(t, err)
+		require.Equal(t, []types.RewardsRecord{records[0], records[2], records[3]}, since)
+	})
+}
+
+func TestReconcileRewards(t *testing.T) {
+	chain := e2eTesting.NewTestChain(t, 1)
+	ctx := chain.GetContext()
+	keepers := chain.GetApp().Keepers
+	k := keepers.RewardsKeeper
+	querySrvr := keeper.NewQueryServer(k)
+
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	rewardsAddr := testutils.AccAddress()
+
+	// Reset the pool (not empty due to inflation rewards for previous blocks)
+	poolInitial := k.UndistributedRewardsPool(ctx)
+	require.NoError(t, keepers.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ContractRewardCollector, mintTypes.ModuleName, poolInitial))
+
+	fundPool := func(coins sdk.Coins) {
+		require.NoError(t, keepers.BankKeeper.MintCoins(ctx, mintTypes.ModuleName, coins))
+		require.NoError(t, keepers.BankKeeper.SendCoinsFromModuleToModule(ctx, mintTypes.ModuleName, types.ContractRewardCollector, coins))
+	}
+
+	// Outstanding rewards record (75stake) and the current block tracked rewards (25stake) backed by the pool
+	_, err := k.CreateRewardsRecord(ctx, rewardsAddr, contractAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 75)), ctx.BlockHeight(), ctx.BlockTime())
+	require.NoError(t, err)
+	require.NoError(t, k.BlockRewards.Set(ctx, uint64(ctx.BlockHeight()), types.BlockRewards{Height: ctx.BlockHeight(), InflationRewards: sdk.NewInt64Coin("stake", 25)}))
+	fundPool(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+
+	t.Run("Fail: empty request", func(t *testing.T) {
+		_, err := querySrvr.ReconcileRewards(ctx, nil)
+		require.Equal(t, status.Error(codes.InvalidArgument, "empty request"), err)
+	})
+
+	t.Run("OK: balanced", func(t *testing.T) {
+		res, err := querySrvr.ReconcileRewards(ctx, &types.QueryReconcileRewardsRequest{})
+		require.NoError(t, err)
+		assert.True(t, res.Balanced)
+		assert.Equal(t, "100stake", sdk.Coins(res.Reconciliation.Expected).String())
+		assert.Equal(t, "100stake", sdk.Coins(res.Reconciliation.PoolBalance).String())
+		assert.Empty(t, res.Reconciliation.Surplus)
+		assert.Empty(t, res.Reconciliation.Deficit)
+	})
+
+	t.Run("OK: seeded discrepancy", func(t *testing.T) {
+		// Record not backed by the pool and the pool tokens not owed to anyone
+		_, err := k.CreateRewardsRecord(ctx, rewardsAddr, contractAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), ctx.BlockHeight(), ctx.BlockTime())
+		require.NoError(t, err)
+		fundPool(sdk.NewCoins(sdk.NewInt64Coin("uarch", 10)))
+
+		poolBefore := k.UndistributedRewardsPool(ctx)
+
+		reconciliation, err := k.ReconcileRewards(ctx)
+		require.NoError(t, err)
+		assert.False(t, reconciliation.IsBalanced())
+		assert.Equal(t, "150stake", sdk.Coins(reconciliation.Expected).String())
+		assert.Equal(t, "100stake,10uarch", sdk.Coins(reconciliation.PoolBalance).String())
+		assert.Equal(t, "10uarch", sdk.Coins(reconciliation.Surplus).String())
+		assert.Equal(t, "50stake", sdk.Coins(reconciliation.Deficit).String())
+
+		// State is not modified
+		assert.Equal(t, poolBefore.String(), k.UndistributedRewardsPool(ctx).String())
+		total, err := k.GetTotalPendingRewards(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "150stake", total.String())
+	})
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/archway-network/archway/pkg"
+	"github.com/archway-network/archway/x/rewards/types"
+)
+
+// executeRewardsCallback transfers the rewards callback share of the contract rewards to the callback contract and
+// sudo-invokes it (if the callback is set and enabled).
+// The callback is executed in a branched context limited by the RewardsCallbackGasLimit param: a failed callback
+// is reverted (the share is not transferred) and doesn't affect the distribution.
+// Callbacks share the RewardsCallbacksBlockGasLimit budget of the block (contracts are processed in the address order):
+// once the budget left can't cover the callback gas limit, callbacks are skipped.
+// Returns the callback share transferred and the rewards left to distribute.
+func (k Keeper) executeRewardsCallback(ctx sdk.Context, blockDistrState *blockRewardsDistributionState, contractAddr sdk.AccAddress, metadata *types.ContractMetadata, rewards sdk.Coins) (sdk.Coins, sdk.Coins) {
+	if !metadata.HasRewardsCallback() || k.contractSudoer == nil {
+		return sdk.NewCoins(), rewards
+	}
+
+	gasLimit := k.RewardsCallbackGasLimit(ctx)
+	if gasLimit == 0 {
+		return sdk.NewCoins(), rewards
+	}
+
+	share := metadata.RewardsCallbackRewards(rewards)
+	if share.IsZero() {
+		return sdk.NewCoins(), rewards
+	}
+
+	callbackAddr := metadata.MustGetRewardsCallbackAddress()
+	if blockDistrState.CallbacksGasLeft < gasLimit {
+		k.Logger(ctx).Info(
+			"Contract rewards callbacks block gas budget is exhausted (skip)",
+			"contract", contractAddr,
+			"callback", callbackAddr,
+			"gas_left", blockDistrState.CallbacksGasLeft,
+		)
+		types.EmitContractRewardsCallbackEvent(ctx, contractAddr, callbackAddr, share, 0, types.ErrRewardsCallbacksBudgetExhausted.Error())
+
+		return sdk.NewCoins(), rewards
+	}
+
+	gasUsed, err := pkg.ExecuteWithGasLimit(ctx, gasLimit, func(ctx sdk.Context) error {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ContractRewardCollector, callbackAddr, share); err != nil {
+			return err
+		}
+
+		_, err := k.contractSudoer.Sudo(ctx, callbackAddr, types.NewRewardsCallbackMsg(contractAddr, share).Bytes())
+		return err
+	})
+	// Gas used could exceed the limit (out of gas)
+	blockDistrState.CallbacksGasLeft -= min(gasUsed, blockDistrState.CallbacksGasLeft)
+	if err != nil {
+		k.Logger(ctx).Error(
+			"Contract rewards callback failed (skip)",
+			"contract", contractAddr,
+			"callback", callbackAddr,
+			"rewards", share,
+			"gas_used", gasUsed,
+			"error", err,
+		)
+		types.EmitContractRewardsCallbackEvent(ctx, contractAddr, callbackAddr, share, gasUsed, err.Error())
+
+		return sdk.NewCoins(), rewards
+	}
+
+	types.EmitContractRewardsCallbackEvent(ctx, contractAddr, callbackAddr, share, gasUsed, "")
+
+	return share, rewards.Sub(share...)
+}
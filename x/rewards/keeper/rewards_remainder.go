@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/archway-network/archway/x/rewards/types"
+)
+
+// GetRewardsRemainder returns the contract sub-unit rewards carried over to the next distribution.
+func (k Keeper) GetRewardsRemainder(ctx sdk.Context, contractAddr sdk.AccAddress) sdk.DecCoins {
+	remainder := sdk.NewDecCoins()
+	rng := collections.NewPrefixedPairRange[[]byte, string](contractAddr)
+	err := k.RewardsRemainders.Walk(ctx, rng, func(key collections.Pair[[]byte, string], amount math.LegacyDec) (bool, error) {
+		remainder = remainder.Add(sdk.NewDecCoinFromDec(key.K2(), amount))
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return remainder
+}
+
+// GetRewardsRemaindersTotal returns the sum of all contracts rewards remainders.
+func (k Keeper) GetRewardsRemaindersTotal(ctx sdk.Context) sdk.DecCoins {
+	total := sdk.NewDecCoins()
+	err := k.RewardsRemaindersTotal.Walk(ctx, nil, func(denom string, amount math.LegacyDec) (bool, error) {
+		total = total.Add(sdk.NewDecCoinFromDec(denom, amount))
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return total
+}
+
+// carryOverRewardsRemainder adds the contract rewards remainder to the given untruncated rewards and returns
+// the integer part to be distributed. The fractional part is kept as the new remainder for the next distribution.
+func (k Keeper) carryOverRewardsRemainder(ctx sdk.Context, contractAddr sdk.AccAddress, exactRewards sdk.DecCoins) sdk.Coins {
+	rewards, remainder := exactRewards.Add(k.GetRewardsRemainder(ctx, contractAddr)...).TruncateDecimal()
+	if err := k.setRewardsRemainder(ctx, contractAddr, remainder); err != nil {
+		panic(fmt.Errorf("failed to set rewards remainder for contract (%s): %w", contractAddr, err))
+	}
+
+	return rewards
+}
+
+// removeRewardsRemainder removes the contract rewards remainder and transfers the pool tokens reserved for it
+// (if any) to the treasury pool.
+func (k Keeper) removeRewardsRemainder(ctx sdk.Context, contractAddr sdk.AccAddress) error {
+	reserveBefore := k.rewardsRemaindersReserve(ctx)
+	if err := k.setRewardsRemainder(ctx, contractAddr, nil); err != nil {
+		return err
+	}
+
+	released := reserveBefore.Sub(k.rewardsRemaindersReserve(ctx)...)
+	if released.Empty() {
+		return nil
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ContractRewardCollector, types.TreasuryCollector, released)
+}
+
+// rewardsRemaindersReserve returns the rewards pool tokens reserved for the contracts rewards remainders:
+// the remainders total rounded up per denom.
+func (k Keeper) rewardsRemaindersReserve(ctx sdk.Context) sdk.Coins {
+	reserve := sdk.NewCoins()
+	for _, coin := range k.GetRewardsRemaindersTotal(ctx) {
+		reserve = reserve.Add(sdk.NewCoin(coin.Denom, coin.Amount.Ceil().TruncateInt()))
+	}
+
+	return reserve
+}
+
+// setRewardsRemainder replaces the contract rewards remainder updating the remainders total.
+func (k Keeper) setRewardsRemainder(ctx sdk.Context, contractAddr sdk.AccAddress, remainder sdk.DecCoins) error {
+	prevRemainder := k.GetRewardsRemainder(ctx, contractAddr)
+	if prevRemainder.Empty() && remainder.Empty() {
+		return nil
+	}
+
+	for _, coin := range prevRemainder {
+		if err := k.RewardsRemainders.Remove(ctx, collections.Join(contractAddr.Bytes(), coin.Denom)); err != nil {
+			return err
+		}
+	}
+	for _, coin := range remainder {
+		if err := k.RewardsRemainders.Set(ctx, collections.Join(contractAddr.Bytes(), coin.Denom), coin.Amount); err != nil {
+			return err
+		}
+	}
+
+	total := k.GetRewardsRemaindersTotal(ctx).Add(remainder...).Sub(prevRemainder)
+	for _, coin := range prevRemainder.Add(remainder...) {
+		amount := total.AmountOf(coin.Denom)
+		if amount.IsZero() {
+			if err := k.RewardsRemaindersTotal.Remove(ctx, coin.Denom); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := k.RewardsRemaindersTotal.Set(ctx, coin.Denom, amount); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
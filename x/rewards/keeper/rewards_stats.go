@@ -0,0 +1,349 @@
+package keeper
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/archway-network/archway/dmap"
+	"github.com/archway-network/archway/x/rewards/types"
+)
+
+// secondsPerYear is used to annualize the rewards rate.
+const secondsPerYear = 365 * 24 * 60 * 60
+
+// GetContractRewardsStats returns the contract rewards stats if found.
+func (k Keeper) GetContractRewardsStats(ctx sdk.Context, contractAddr sdk.AccAddress) (types.ContractRewardsStats, bool) {
+	stats, err := k.ContractRewardsStats.Get(ctx, contractAddr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.ContractRewardsStats{}, false
+		}
+		panic(err)
+	}
+
+	return stats, true
+}
+
+// ResetLifetimeRewards zeroes the contract lifetime rewards counter returning the rewards it had before the reset.
+// Operation is authorized to the contract owner and the module authority (the contract metadata might be already
+// removed for the latter). The recent rewards history (used for the APR estimation) is not affected.
+func (k Keeper) ResetLifetimeRewards(ctx sdk.Context, senderAddr, contractAddr sdk.AccAddress) (sdk.Coins, error) {
+	if senderAddr.String() != k.GetAuthority() {
+		meta, err := k.ContractMetadata.Get(ctx, contractAddr)
+		if err != nil {
+			return nil, types.ErrMetadataNotFound
+		}
+		if meta.OwnerAddress != senderAddr.String() {
+			return nil, errorsmod.Wrap(types.ErrUnauthorized, "lifetime rewards can only be reset by the contract owner or the module authority")
+		}
+	}
+
+	resetRewards := sdk.NewCoins()
+	if stats, found := k.GetContractRewardsStats(ctx, contractAddr); found {
+		resetRewards = stats.LifetimeRewards
+		stats.LifetimeRewards = nil
+		if err := k.ContractRewardsStats.Set(ctx, contractAddr, stats); err != nil {
+			return nil, err
+		}
+	}
+
+	types.EmitContractLifetimeRewardsResetEvent(ctx, contractAddr, senderAddr, resetRewards)
+
+	return resetRewards, nil
+}
+
+// EstimateContractAPR estimates the contract rewards annual percentage rate.
+// The rewards rate is taken from the recent rewards history (up to two ContractRewardsStatsWindow windows)
+// and annualized, the locked value is the contract balance. Both are taken in the MinPriceOfGas denom.
+func (k Keeper) EstimateContractAPR(ctx sdk.Context, contractAddr sdk.AccAddress) (math.LegacyDec, error) {
+	stats, found := k.GetContractRewardsStats(ctx, contractAddr)
+	if !found {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrInvalidRequest, "no rewards history for contract (%s)", contractAddr)
+	}
+
+	rewards, startTime := stats.HistoryRewards()
+	elapsedSec := int64(ctx.BlockTime().Sub(startTime) / time.Second)
+	if elapsedSec <= 0 {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrInvalidRequest, "not enough rewards history for contract (%s)", contractAddr)
+	}
+
+	denom := k.MinimumPriceOfGas(ctx).Denom
+	lockedValue := k.bankKeeper.GetAllBalances(ctx, contractAddr).AmountOf(denom)
+	if !lockedValue.IsPositive() {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrInvalidRequest, "no locked value (%s) for contract (%s)", denom, contractAddr)
+	}
+
+	return math.LegacyNewDecFromInt(rewards.AmountOf(denom)).
+		MulInt64(secondsPerYear).
+		QuoInt64(elapsedSec).
+		QuoInt(lockedValue), nil
+}
+
+// TopContractsByRewards returns up to the limit contracts with the highest rewards distributed within the given
+// number of recent blocks (including the current one).
+// Contracts are ranked by the rewards amount in the MinPriceOfGas denom (ties are ordered by the contract address).
+// CONTRACT: window must be within the [1, types.ContractRewardsHistoryBlocks] range.
+func (k Keeper) TopContractsByRewards(ctx sdk.Context, window, limit uint64) ([]types.ContractRewards, error) {
+	endHeight := uint64(ctx.BlockHeight())
+	startHeight := uint64(0)
+	if endHeight >= window {
+		startHeight = endHeight - window + 1
+	}
+
+	rng := new(collections.Range[collections.Pair[uint64, []byte]]).
+		StartInclusive(collections.PairPrefix[uint64, []byte](startHeight)).
+		EndExclusive(collections.PairPrefix[uint64, []byte](endHeight + 1))
+
+	contractsRewards := make(map[string]sdk.Coins)
+	err := k.ContractBlockRewards.Walk(ctx, rng, func(_ collections.Pair[uint64, []byte], blockRewards types.ContractRewards) (bool, error) {
+		contractsRewards[blockRewards.ContractAddress] = contractsRewards[blockRewards.ContractAddress].Add(blockRewards.Rewards...)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	top := make([]types.ContractRewards, 0, len(contractsRewards))
+	for _, contractAddr := range dmap.SortedKeys(contractsRewards) {
+		top = append(top, types.ContractRewards{
+			ContractAddress: contractAddr,
+			Rewards:         contractsRewards[contractAddr],
+		})
+	}
+
+	denom := k.MinimumPriceOfGas(ctx).Denom
+	sort.SliceStable(top, func(i, j int) bool {
+		return sdk.Coins(top[i].Rewards).AmountOf(denom).GT(sdk.Coins(top[j].Rewards).AmountOf(denom))
+	})
+	if uint64(len(top)) > limit {
+		top = top[:limit]
+	}
+
+	return top, nil
+}
+
+// ContractFlatFeeRevenue returns the contract flat fees collected within the given number of recent blocks
+// (including the current one).
+// CONTRACT: window must be within the [1, types.ContractRewardsHistoryBlocks] range.
+func (k Keeper) ContractFlatFeeRevenue(ctx sdk.Context, contractAddr sdk.AccAddress, window uint64) (sdk.Coins, error) {
+	endHeight := uint64(ctx.BlockHeight())
+	startHeight := uint64(0)
+	if endHeight >= window {
+		startHeight = endHeight - window + 1
+	}
+
+	rng := new(collections.Range[collections.Pair[uint64, []byte]]).
+		StartInclusive(collections.PairPrefix[uint64, []byte](startHeight)).
+		EndExclusive(collections.PairPrefix[uint64, []byte](endHeight + 1))
+
+	revenue := sdk.NewCoins()
+	err := k.ContractBlockFlatFees.Walk(ctx, rng, func(key collections.Pair[uint64, []byte], blockFlatFees types.ContractRewards) (bool, error) {
+		if bytes.Equal(key.K2(), contractAddr) {
+			revenue = revenue.Add(blockFlatFees.Rewards...)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return revenue, nil
+}
+
+// GetContractRewardsHistory returns the rewards and flat fees distributed for the contract within the given block
+// height range, one row per block with any of them distributed (ordered by height).
+// Only the recent blocks are tracked (refer to types.ContractRewardsHistoryBlocks).
+// Only the key based pagination is supported (the key is the next row height).
+func (k Keeper) GetContractRewardsHistory(ctx sdk.Context, contractAddr sdk.AccAddress, fromHeight, toHeight uint64, pageReq *query.PageRequest) ([]types.ContractRewardsHistoryRow, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset != 0 || pageReq.CountTotal || pageReq.Reverse {
+		return nil, nil, errorsmod.Wrap(types.ErrInvalidRequest, "only key based pagination is supported")
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = types.MaxRecordsQueryLimit
+	}
+	if limit > types.MaxRecordsQueryLimit {
+		return nil, nil, errorsmod.Wrapf(types.ErrInvalidRequest, "max records (%d) query limit exceeded", types.MaxRecordsQueryLimit)
+	}
+
+	startHeight := fromHeight
+	if len(pageReq.Key) != 0 {
+		if len(pageReq.Key) != 8 {
+			return nil, nil, errorsmod.Wrap(types.ErrInvalidRequest, "invalid pagination key")
+		}
+		startHeight = sdk.BigEndianToUint64(pageReq.Key)
+		if startHeight < fromHeight || startHeight > toHeight {
+			return nil, nil, errorsmod.Wrap(types.ErrInvalidRequest, "pagination key is out of the height range")
+		}
+	}
+
+	rng := new(collections.Range[collections.Pair[uint64, []byte]]).
+		StartInclusive(collections.PairPrefix[uint64, []byte](startHeight)).
+		EndExclusive(collections.PairPrefix[uint64, []byte](toHeight + 1))
+
+	// Both sources are ordered by height, so the first (limit + 1) heights of each cover the page and the next key
+	collectByHeight := func(blockDistributions collections.Map[collections.Pair[uint64, []byte], types.ContractRewards]) (map[uint64]sdk.Coins, error) {
+		res := make(map[uint64]sdk.Coins)
+		err := blockDistributions.Walk(ctx, rng, func(key collections.Pair[uint64, []byte], distribution types.ContractRewards) (bool, error) {
+			if !bytes.Equal(key.K2(), contractAddr) {
+				return false, nil
+			}
+			res[key.K1()] = distribution.Rewards
+			return uint64(len(res)) > limit, nil
+		})
+		return res, err
+	}
+
+	blockRewards, err := collectByHeight(k.ContractBlockRewards)
+	if err != nil {
+		return nil, nil, err
+	}
+	blockFlatFees, err := collectByHeight(k.ContractBlockFlatFees)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	heightsSet := make(map[uint64]struct{}, len(blockRewards)+len(blockFlatFees))
+	for height := range blockRewards {
+		heightsSet[height] = struct{}{}
+	}
+	for height := range blockFlatFees {
+		heightsSet[height] = struct{}{}
+	}
+	heights := dmap.SortedKeys(heightsSet)
+
+	pageRes := &query.PageResponse{}
+	if uint64(len(heights)) > limit {
+		pageRes.NextKey = sdk.Uint64ToBigEndian(heights[limit])
+		heights = heights[:limit]
+	}
+
+	rows := make([]types.ContractRewardsHistoryRow, 0, len(heights))
+	for _, height := range heights {
+		rows = append(rows, types.ContractRewardsHistoryRow{
+			Height:          int64(height),
+			ContractAddress: contractAddr.String(),
+			Rewards:         blockRewards[height].String(),
+			FlatFees:        blockFlatFees[height].String(),
+		})
+	}
+
+	return rows, pageRes, nil
+}
+
+// trackContractRewardsStats updates the contract rewards stats and the current block contract rewards
+// with the rewards distributed at the given block.
+func (k Keeper) trackContractRewardsStats(ctx sdk.Context, contractAddr sdk.AccAddress, rewards sdk.Coins, blockHeight int64, blockTime time.Time) {
+	stats, found := k.GetContractRewardsStats(ctx, contractAddr)
+	if !found {
+		stats = types.NewContractRewardsStats(contractAddr)
+	}
+
+	if err := k.ContractRewardsStats.Set(ctx, contractAddr, stats.AddRewards(rewards, blockTime)); err != nil {
+		panic(err)
+	}
+
+	blockRewards := types.ContractRewards{
+		ContractAddress: contractAddr.String(),
+		Rewards:         rewards,
+	}
+	if err := k.ContractBlockRewards.Set(ctx, collections.Join(uint64(blockHeight), contractAddr.Bytes()), blockRewards); err != nil {
+		panic(err)
+	}
+}
+
+// pruneContractBlockRewards removes the contract block rewards falling out of the history for the given block height.
+func (k Keeper) pruneContractBlockRewards(ctx sdk.Context, height int64) {
+	heightToPrune := height - types.ContractRewardsHistoryBlocks
+	if heightToPrune <= 0 {
+		return
+	}
+
+	if err := k.ContractBlockRewards.Clear(ctx, collections.NewPrefixedPairRange[uint64, []byte](uint64(heightToPrune))); err != nil {
+		panic(fmt.Errorf("failed to prune contract block rewards for height %d: %w", heightToPrune, err))
+	}
+}
+
+// trackContractBlockFlatFees adds the flat fees collected for the contract to the current block contract flat fees.
+func (k Keeper) trackContractBlockFlatFees(ctx sdk.Context, contractAddr sdk.AccAddress, flatFees sdk.Coins) {
+	key := collections.Join(uint64(ctx.BlockHeight()), contractAddr.Bytes())
+
+	blockFlatFees, err := k.ContractBlockFlatFees.Get(ctx, key)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		blockFlatFees = types.ContractRewards{ContractAddress: contractAddr.String()}
+	case err != nil:
+		panic(err)
+	}
+	blockFlatFees.Rewards = sdk.Coins(blockFlatFees.Rewards).Add(flatFees...)
+
+	if err := k.ContractBlockFlatFees.Set(ctx, key, blockFlatFees); err != nil {
+		panic(err)
+	}
+}
+
+// pruneContractBlockFlatFees removes the contract block flat fees falling out of the history for the given block height.
+func (k Keeper) pruneContractBlockFlatFees(ctx sdk.Context, height int64) {
+	heightToPrune := height - types.ContractRewardsHistoryBlocks
+	if heightToPrune <= 0 {
+		return
+	}
+
+	if err := k.ContractBlockFlatFees.Clear(ctx, collections.NewPrefixedPairRange[uint64, []byte](uint64(heightToPrune))); err != nil {
+		panic(fmt.Errorf("failed to prune contract block flat fees for height %d: %w", heightToPrune, err))
+	}
+}
+
+// GetBlockPoolInflows returns the tokens entered the rewards pool within the given block by source
+// (empty if nothing has been tracked for the block).
+func (k Keeper) GetBlockPoolInflows(ctx sdk.Context, height int64) types.BlockPoolInflows {
+	inflows, err := k.BlockPoolInflows.Get(ctx, uint64(height))
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return types.BlockPoolInflows{Height: height}
+	case err != nil:
+		panic(err)
+	}
+
+	return inflows
+}
+
+// trackBlockPoolInflows adds the tokens entered the rewards pool to the current block inflows.
+func (k Keeper) trackBlockPoolInflows(ctx sdk.Context, feeRebates, inflation, flatFees sdk.Coins) {
+	if feeRebates.IsZero() && inflation.IsZero() && flatFees.IsZero() {
+		return
+	}
+
+	inflows := k.GetBlockPoolInflows(ctx, ctx.BlockHeight())
+	inflows.FeeRebates = sdk.Coins(inflows.FeeRebates).Add(feeRebates...)
+	inflows.Inflation = sdk.Coins(inflows.Inflation).Add(inflation...)
+	inflows.FlatFees = sdk.Coins(inflows.FlatFees).Add(flatFees...)
+
+	if err := k.BlockPoolInflows.Set(ctx, uint64(ctx.BlockHeight()), inflows); err != nil {
+		panic(err)
+	}
+}
+
+// pruneBlockPoolInflows removes the rewards pool inflows falling out of the history for the given block height.
+func (k Keeper) pruneBlockPoolInflows(ctx sdk.Context, height int64) {
+	heightToPrune := height - types.BlockPoolInflowsHistoryBlocks
+	if heightToPrune <= 0 {
+		return
+	}
+
+	if err := k.BlockPoolInflows.Remove(ctx, uint64(heightToPrune)); err != nil {
+		panic(fmt.Errorf("failed to prune block pool inflows for height %d: %w", heightToPrune, err))
+	}
+}
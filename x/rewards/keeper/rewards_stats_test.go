@@ -0,0 +1,340 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	mintTypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	e2eTesting "github.com/archway-network/archway/e2e/testing"
+	"github.com/archway-network/archway/pkg/testutils"
+	"github.com/archway-network/archway/x/rewards/keeper"
+	"github.com/archway-network/archway/x/rewards/types"
+)
+
+func TestEstimateContractAPR(t *testing.T) {
+	chain := e2eTesting.NewTestChain(t, 1)
+	keepers := chain.GetApp().Keepers
+	k := keepers.RewardsKeeper
+	ctx := chain.GetContext().WithBlockTime(chain.GetBlockTime())
+
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	denom := k.MinimumPriceOfGas(ctx).Denom
+	now := ctx.BlockTime()
+
+	t.Run("Fail: no rewards history", func(t *testing.T) {
+		_, err := k.EstimateContractAPR(ctx, contractAddr)
+		require.ErrorIs(t, err, types.ErrInvalidRequest)
+	})
+
+	// Synthetic history: 10stake per day over the last 10 days (two windows)
+	stats := types.NewContractRewardsStats(contractAddr)
+	historyStart := now.Add(-10 * 24 * time.Hour)
+	for day := 0; day < 10; day++ {
+		stats = stats.AddRewards(sdk.NewCoins(sdk.NewInt64Coin(denom, 10)), historyStart.Add(time.Duration(day)*24*time.Hour))
+	}
+	require.NoError(t, k.ContractRewardsStats.Set(ctx, contractAddr, stats))
+
+	t.Run("Fail: no locked value", func(t *testing.T) {
+		_, err := k.EstimateContractAPR(ctx, contractAddr)
+		require.ErrorIs(t, err, types.ErrInvalidRequest)
+	})
+
+	// Lock 36500stake: 3650stake annual rewards (10stake per day) result in a 10% APR
+	lockedValue := sdk.NewCoins(sdk.NewInt64Coin(denom, 36500))
+	require.NoError(t, keepers.BankKeeper.MintCoins(ctx, mintTypes.ModuleName, lockedValue))
+	require.NoError(t, keepers.BankKeeper.SendCoinsFromModuleToAccount(ctx, mintTypes.ModuleName, contractAddr, lockedValue))
+
+	t.Run("OK: APR estimated from the history", func(t *testing.T) {
+		apr, err := k.EstimateContractAPR(ctx, contractAddr)
+		require.NoError(t, err)
+		assert.Equal(t, math.LegacyNewDecWithPrec(1, 1).String(), apr.String())
+	})
+
+	t.Run("Fail: history started at the current block", func(t *testing.T) {
+		require.NoError(t, k.ContractRewardsStats.Set(ctx, contractAddr, types.NewContractRewardsStats(contractAddr).AddRewards(sdk.NewCoins(sdk.NewInt64Coin(denom, 10)), now)))
+
+		_, err := k.EstimateContractAPR(ctx, contractAddr)
+		require.ErrorIs(t, err, types.ErrInvalidRequest)
+	})
+}
+
+func TestContractFlatFeeRevenue(t *testing.T) {
+	chain := e2eTesting.NewTestChain(t, 1)
+	keepers := chain.GetApp().Keepers
+	k := keepers.RewardsKeeper
+	ctx := chain.GetContext().WithBlockTime(chain.GetBlockTime())
+	querySrvr := keeper.NewQueryServer(k)
+
+	contractAddrs := e2eTesting.GenContractAddresses(2)
+	for _, contractAddr := range contractAddrs {
+		rewardsAddr := testutils.AccAddress()
+		meta := types.ContractMetadata{
+			ContractAddress: contractAddr.String(),
+			OwnerAddress:    rewardsAddr.String(),
+			RewardsAddress:  rewardsAddr.String(),
+		}
+		require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, meta))
+	}
+
+	// Emulate the flat fees collected by the DeductFeeDecorator at the given block
+	startHeight := ctx.BlockHeight()
+	collectFlatFee := func(height int64, contractAddr sdk.AccAddress, flatFee string) {
+		coins, err := sdk.ParseCoinsNormalized(flatFee)
+		require.NoError(t, err)
+
+		blockCtx := ctx.WithBlockHeight(height)
+		require.NoError(t, keepers.BankKeeper.MintCoins(blockCtx, mintTypes.ModuleName, coins))
+		require.NoError(t, keepers.BankKeeper.SendCoinsFromModuleToModule(blockCtx, mintTypes.ModuleName, types.ContractRewardCollector, coins))
+		k.CreateFlatFeeRewardsRecords(blockCtx, contractAddr, coins)
+	}
+
+	collectFlatFee(startHeight, contractAddrs[0], "10stake")
+	collectFlatFee(startHeight+5, contractAddrs[0], "20stake")
+	collectFlatFee(startHeight+9, contractAddrs[0], "30stake")
+	collectFlatFee(startHeight+9, contractAddrs[0], "5uarch")
+	collectFlatFee(startHeight+9, contractAddrs[1], "100stake")
+
+	queryCtx := ctx.WithBlockHeight(startHeight + 9)
+	getRevenue := func(contractAddr sdk.AccAddress, window uint64) string {
+		res, err := querySrvr.ContractFlatFeeRevenue(queryCtx, &types.QueryContractFlatFeeRevenueRequest{
+			ContractAddress: contractAddr.String(),
+			Window:          window,
+		})
+		require.NoError(t, err)
+		return sdk.Coins(res.Revenue).String()
+	}
+
+	t.Run("Fail: invalid request", func(t *testing.T) {
+		_, err := querySrvr.ContractFlatFeeRevenue(queryCtx, nil)
+		require.Equal(t, status.Error(codes.InvalidArgument, "empty request"), err)
+
+		_, err = querySrvr.ContractFlatFeeRevenue(queryCtx, &types.QueryContractFlatFeeRevenueRequest{ContractAddress: "invalid", Window: 1})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = querySrvr.ContractFlatFeeRevenue(queryCtx, &types.QueryContractFlatFeeRevenueRequest{ContractAddress: contractAddrs[0].String(), Window: 0})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = querySrvr.ContractFlatFeeRevenue(queryCtx, &types.QueryContractFlatFeeRevenueRequest{ContractAddress: contractAddrs[0].String(), Window: types.ContractRewardsHistoryBlocks + 1})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("OK: revenue summed over the window", func(t *testing.T) {
+		assert.Equal(t, "30stake,5uarch", getRevenue(contractAddrs[0], 1))
+		assert.Equal(t, "50stake,5uarch", getRevenue(contractAddrs[0], 5))
+		assert.Equal(t, "60stake,5uarch", getRevenue(contractAddrs[0], 10))
+		assert.Equal(t, "100stake", getRevenue(contractAddrs[1], 10))
+	})
+
+	t.Run("OK: no revenue", func(t *testing.T) {
+		assert.Equal(t, "", getRevenue(e2eTesting.GenContractAddresses(3)[2], 10))
+	})
+
+	t.Run("OK: out of history blocks are pruned", func(t *testing.T) {
+		pruneHeight := startHeight + types.ContractRewardsHistoryBlocks
+		k.AllocateBlockRewards(ctx.WithBlockHeight(pruneHeight), pruneHeight-1)
+
+		revenue, err := k.ContractFlatFeeRevenue(ctx.WithBlockHeight(startHeight+9), contractAddrs[0], 10)
+		require.NoError(t, err)
+		assert.Equal(t, "50stake,5uarch", revenue.String())
+	})
+}
+
+func TestContractRewardsHistory(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	querySrvr := keeper.NewQueryServer(k)
+
+	contractAddrs := e2eTesting.GenContractAddresses(2)
+	contractAddr, otherContractAddr := contractAddrs[0], contractAddrs[1]
+
+	// Emulate the tracked block distributions (stored out of the height order)
+	setBlockDistribution := func(blockDistributions collections.Map[collections.Pair[uint64, []byte], types.ContractRewards], height uint64, contractAddr sdk.AccAddress, amount int64) {
+		require.NoError(t, blockDistributions.Set(ctx, collections.Join(height, contractAddr.Bytes()), types.ContractRewards{
+			ContractAddress: contractAddr.String(),
+			Rewards:         sdk.NewCoins(sdk.NewInt64Coin("stake", amount)),
+		}))
+	}
+	setBlockDistribution(k.ContractBlockRewards, 30, contractAddr, 300)
+	setBlockDistribution(k.ContractBlockRewards, 10, contractAddr, 100)
+	setBlockDistribution(k.ContractBlockRewards, 20, contractAddr, 200)
+	setBlockDistribution(k.ContractBlockFlatFees, 25, contractAddr, 25)
+	setBlockDistribution(k.ContractBlockFlatFees, 20, contractAddr, 20)
+	setBlockDistribution(k.ContractBlockRewards, 15, otherContractAddr, 1500)
+	setBlockDistribution(k.ContractBlockFlatFees, 20, otherContractAddr, 2000)
+
+	newRow := func(height int64, rewards, flatFees string) types.ContractRewardsHistoryRow {
+		return types.ContractRewardsHistoryRow{
+			Height:          height,
+			ContractAddress: contractAddr.String(),
+			Rewards:         rewards,
+			FlatFees:        flatFees,
+		}
+	}
+	rowsExpected := []types.ContractRewardsHistoryRow{
+		newRow(10, "100stake", ""),
+		newRow(20, "200stake", "20stake"),
+		newRow(25, "", "25stake"),
+		newRow(30, "300stake", ""),
+	}
+
+	newRequest := func(fromHeight, toHeight int64, pageReq *query.PageRequest) *types.QueryContractRewardsHistoryRequest {
+		return &types.QueryContractRewardsHistoryRequest{
+			ContractAddress: contractAddr.String(),
+			FromHeight:      fromHeight,
+			ToHeight:        toHeight,
+			Pagination:      pageReq,
+		}
+	}
+
+	t.Run("Fail: invalid request", func(t *testing.T) {
+		_, err := querySrvr.ContractRewardsHistory(ctx, nil)
+		require.Equal(t, status.Error(codes.InvalidArgument, "empty request"), err)
+
+		_, err = querySrvr.ContractRewardsHistory(ctx, &types.QueryContractRewardsHistoryRequest{ContractAddress: "invalid", FromHeight: 1, ToHeight: 100})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = querySrvr.ContractRewardsHistory(ctx, newRequest(20, 10, nil))
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = querySrvr.ContractRewardsHistory(ctx, newRequest(1, 100, &query.PageRequest{Limit: types.MaxRecordsQueryLimit + 1}))
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = querySrvr.ContractRewardsHistory(ctx, newRequest(1, 100, &query.PageRequest{Offset: 1}))
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = querySrvr.ContractRewardsHistory(ctx, newRequest(15, 100, &query.PageRequest{Key: sdk.Uint64ToBigEndian(10)}))
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("OK: rows ordered by height", func(t *testing.T) {
+		res, err := querySrvr.ContractRewardsHistory(ctx, newRequest(1, 100, nil))
+		require.NoError(t, err)
+		assert.Equal(t, rowsExpected, res.Rows)
+		assert.Empty(t, res.Pagination.NextKey)
+	})
+
+	t.Run("OK: height range is inclusive", func(t *testing.T) {
+		res, err := querySrvr.ContractRewardsHistory(ctx, newRequest(20, 25, nil))
+		require.NoError(t, err)
+		assert.Equal(t, rowsExpected[1:3], res.Rows)
+		assert.Empty(t, res.Pagination.NextKey)
+	})
+
+	t.Run("OK: range without distributions", func(t *testing.T) {
+		res, err := querySrvr.ContractRewardsHistory(ctx, newRequest(11, 19, nil))
+		require.NoError(t, err)
+		assert.Empty(t, res.Rows)
+		assert.Empty(t, res.Pagination.NextKey)
+	})
+
+	t.Run("OK: paginated", func(t *testing.T) {
+		req := newRequest(1, 100, &query.PageRequest{Limit: 2})
+
+		res, err := querySrvr.ContractRewardsHistory(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, rowsExpected[:2], res.Rows)
+		require.Equal(t, sdk.Uint64ToBigEndian(25), res.Pagination.NextKey)
+
+		req.Pagination.Key = res.Pagination.NextKey
+		res, err = querySrvr.ContractRewardsHistory(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, rowsExpected[2:], res.Rows)
+		assert.Empty(t, res.Pagination.NextKey)
+	})
+
+	t.Run("OK: paginated row by row", func(t *testing.T) {
+		req := newRequest(1, 100, &query.PageRequest{Limit: 1})
+
+		var rows []types.ContractRewardsHistoryRow
+		for {
+			res, err := querySrvr.ContractRewardsHistory(ctx, req)
+			require.NoError(t, err)
+			require.Len(t, res.Rows, 1)
+			rows = append(rows, res.Rows...)
+
+			if len(res.Pagination.NextKey) == 0 {
+				break
+			}
+			req.Pagination.Key = res.Pagination.NextKey
+		}
+		assert.Equal(t, rowsExpected, rows)
+	})
+}
+
+func TestBlockPoolInflows(t *testing.T) {
+	chain := e2eTesting.NewTestChain(t, 1)
+	keepers := chain.GetApp().Keepers
+	k := keepers.RewardsKeeper
+	querySrvr := keeper.NewQueryServer(k)
+
+	// Use the next block to skip the inflows tracked by the chain itself
+	blockHeight := chain.GetContext().BlockHeight() + 1
+	ctx := chain.GetContext().WithBlockHeight(blockHeight)
+
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	rewardsAddr := testutils.AccAddress()
+	require.NoError(t, k.ContractMetadata.Set(ctx, contractAddr, types.ContractMetadata{
+		ContractAddress: contractAddr.String(),
+		OwnerAddress:    rewardsAddr.String(),
+		RewardsAddress:  rewardsAddr.String(),
+	}))
+
+	// Emulate the block inflows: the mint BeginBlocker, two transactions fee rebates and a flat fee collected
+	k.TrackInflationRewards(ctx, sdk.NewInt64Coin("stake", 1000))
+	k.TrackFeeRebatesRewards(ctx, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	k.TrackFeeRebatesRewards(ctx, sdk.NewCoins(sdk.NewInt64Coin("stake", 50), sdk.NewInt64Coin("uarch", 5)))
+
+	flatFees := sdk.NewCoins(sdk.NewInt64Coin("stake", 20))
+	require.NoError(t, keepers.BankKeeper.MintCoins(ctx, mintTypes.ModuleName, flatFees))
+	require.NoError(t, keepers.BankKeeper.SendCoinsFromModuleToModule(ctx, mintTypes.ModuleName, types.ContractRewardCollector, flatFees))
+	k.CreateFlatFeeRewardsRecords(ctx, contractAddr, flatFees)
+
+	t.Run("Fail: invalid request", func(t *testing.T) {
+		_, err := querySrvr.BlockPoolInflows(ctx, nil)
+		require.Equal(t, status.Error(codes.InvalidArgument, "empty request"), err)
+
+		_, err = querySrvr.BlockPoolInflows(ctx, &types.QueryBlockPoolInflowsRequest{Height: 0})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = querySrvr.BlockPoolInflows(ctx, &types.QueryBlockPoolInflowsRequest{Height: blockHeight + 1})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = querySrvr.BlockPoolInflows(ctx.WithBlockHeight(blockHeight+types.BlockPoolInflowsHistoryBlocks), &types.QueryBlockPoolInflowsRequest{Height: blockHeight})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("OK: inflows breakdown", func(t *testing.T) {
+		res, err := querySrvr.BlockPoolInflows(ctx, &types.QueryBlockPoolInflowsRequest{Height: blockHeight})
+		require.NoError(t, err)
+
+		assert.Equal(t, blockHeight, res.Inflows.Height)
+		assert.Equal(t, "150stake,5uarch", sdk.Coins(res.Inflows.FeeRebates).String())
+		assert.Equal(t, "1000stake", sdk.Coins(res.Inflows.Inflation).String())
+		assert.Equal(t, "20stake", sdk.Coins(res.Inflows.FlatFees).String())
+	})
+
+	t.Run("OK: no inflows", func(t *testing.T) {
+		res, err := querySrvr.BlockPoolInflows(ctx.WithBlockHeight(blockHeight+1), &types.QueryBlockPoolInflowsRequest{Height: blockHeight + 1})
+		require.NoError(t, err)
+
+		assert.Equal(t, blockHeight+1, res.Inflows.Height)
+		assert.Empty(t, res.Inflows.FeeRebates)
+		assert.Empty(t, res.Inflows.Inflation)
+		assert.Empty(t, res.Inflows.FlatFees)
+	})
+
+	t.Run("OK: out of history blocks are pruned", func(t *testing.T) {
+		pruneHeight := blockHeight + types.BlockPoolInflowsHistoryBlocks
+		k.AllocateBlockRewards(ctx.WithBlockHeight(pruneHeight), pruneHeight-1)
+
+		assert.Empty(t, k.GetBlockPoolInflows(ctx, blockHeight).Inflation)
+	})
+}
@@ -1,18 +1,30 @@
 package keeper
 
 import (
+	"fmt"
 	"math"
+	"strings"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	cmtTypes "github.com/cometbft/cometbft/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
 )
 
 // TrackFeeRebatesRewards creates a new transaction fee rebate reward record for the current transaction.
+// If the record already exists (dynamic fee gas fees are settled by the Post handler), rewards are added to it.
 // Unique transaction ID is taken from the tracking module.
 // CONTRACT: tracking Ante handler must be called before this module's Ante handler (tracking provides the primary key).
 func (k Keeper) TrackFeeRebatesRewards(ctx sdk.Context, rewards sdk.Coins) {
+	k.trackBlockPoolInflows(ctx, rewards, nil, nil)
+
 	txID := k.trackingKeeper.GetCurrentTxID(ctx)
+	if existing, err := k.TxRewards.Get(ctx, txID); err == nil {
+		rewards = rewards.Add(existing.FeeRewards...)
+	}
+
 	err := k.TxRewards.Set(ctx, txID, rewardsTypes.TxRewards{
 		TxId:       txID,
 		Height:     ctx.BlockHeight(),
@@ -23,6 +35,116 @@ func (k Keeper) TrackFeeRebatesRewards(ctx sdk.Context, rewards sdk.Coins) {
 	}
 }
 
+// TrackTxFeeDistribution creates a new transaction fee distribution entry for the current transaction.
+// If the entry already exists (dynamic fee gas fees are settled by the Post handler), fees are added to it.
+// Unique transaction ID is taken from the tracking module, transaction hash is estimated using the context tx bytes.
+// CONTRACT: tracking Ante handler must be called before this module's Ante handler (tracking provides the primary key).
+func (k Keeper) TrackTxFeeDistribution(ctx sdk.Context, feeCollectorFees, burntFees, rewardsFees, flatFees, routedFees sdk.Coins) {
+	var txHash string
+	if txBytes := ctx.TxBytes(); len(txBytes) > 0 {
+		txHash = fmt.Sprintf("%X", cmtTypes.Tx(txBytes).Hash())
+	}
+
+	txID := k.trackingKeeper.GetCurrentTxID(ctx)
+	if existing, err := k.TxFeeDistributions.Get(ctx, txID); err == nil {
+		feeCollectorFees = feeCollectorFees.Add(existing.FeeCollectorFees...)
+		burntFees = burntFees.Add(existing.BurntFees...)
+		rewardsFees = rewardsFees.Add(existing.RewardsFees...)
+		flatFees = flatFees.Add(existing.FlatFees...)
+		routedFees = routedFees.Add(existing.RoutedFees...)
+	}
+
+	err := k.TxFeeDistributions.Set(ctx, txID, rewardsTypes.TxFeeDistribution{
+		TxId:             txID,
+		Height:           ctx.BlockHeight(),
+		TxHash:           txHash,
+		FeeCollectorFees: feeCollectorFees,
+		BurntFees:        burntFees,
+		RewardsFees:      rewardsFees,
+		FlatFees:         flatFees,
+		RoutedFees:       routedFees,
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// GetTxFeeDistributionsByBlock returns all the transaction fee distributions for the given block height.
+func (k Keeper) GetTxFeeDistributionsByBlock(ctx sdk.Context, height int64) ([]rewardsTypes.TxFeeDistribution, error) {
+	iter, err := k.TxFeeDistributions.Indexes.Block.MatchExact(ctx, uint64(height))
+	if err != nil {
+		return nil, err
+	}
+
+	return indexes.CollectValues(ctx, k.TxFeeDistributions, iter)
+}
+
+// GetTxFeeDistributionsByTxHash returns the transaction fee distributions for the given tx hash.
+func (k Keeper) GetTxFeeDistributionsByTxHash(ctx sdk.Context, txHash string) ([]rewardsTypes.TxFeeDistribution, error) {
+	iter, err := k.TxFeeDistributions.Indexes.TxHash.MatchExact(ctx, strings.ToUpper(txHash))
+	if err != nil {
+		return nil, err
+	}
+
+	return indexes.CollectValues(ctx, k.TxFeeDistributions, iter)
+}
+
+// GetBlockFlatFeeTxs returns the transactions which paid the contract flat fees within the given block (up to the limit)
+// and the flag indicating whether the result is truncated.
+// Transactions are taken from the fee distribution entries, so the data is available for the recent blocks only
+// (refer to the BlockRewards pruning).
+func (k Keeper) GetBlockFlatFeeTxs(ctx sdk.Context, height int64, limit uint64) ([]rewardsTypes.BlockFlatFeeTx, bool, error) {
+	distributions, err := k.GetTxFeeDistributionsByBlock(ctx, height)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var txs []rewardsTypes.BlockFlatFeeTx
+	for _, distribution := range distributions {
+		if sdk.Coins(distribution.FlatFees).IsZero() {
+			continue
+		}
+		if uint64(len(txs)) == limit {
+			return txs, true, nil
+		}
+
+		txs = append(txs, rewardsTypes.BlockFlatFeeTx{
+			TxId:     distribution.TxId,
+			TxHash:   distribution.TxHash,
+			FlatFees: distribution.FlatFees,
+		})
+	}
+
+	return txs, false, nil
+}
+
+// ContractRewardsFromTx returns the rewards every contract earned attributable to the given tx hash gas usage
+// (the tx fee rebate share and the block inflation rewards share by the gas used within the tx).
+// Transaction is resolved via its fee distribution entry, so the data is available for the recent blocks only
+// (refer to the BlockRewards pruning) and fee-less transactions could not be found.
+func (k Keeper) ContractRewardsFromTx(ctx sdk.Context, txHash string) ([]rewardsTypes.ContractTxRewards, error) {
+	distributions, err := k.GetTxFeeDistributionsByTxHash(ctx, txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []rewardsTypes.ContractTxRewards
+	for _, distribution := range distributions {
+		rng := collections.NewSuperPrefixedTripleRange[uint64, uint64, []byte](uint64(distribution.Height), distribution.TxId)
+		iter, err := k.ContractTxRewards.Iterate(ctx, rng)
+		if err != nil {
+			return nil, err
+		}
+		txRewards, err := iter.Values()
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, txRewards...)
+	}
+
+	return res, nil
+}
+
 // TrackInflationRewards creates a new inflation reward record for the current block.
 func (k Keeper) TrackInflationRewards(ctx sdk.Context, rewards sdk.Coin) {
 	blockGasLimit := ctx.BlockGasMeter().Limit()
@@ -38,4 +160,6 @@ func (k Keeper) TrackInflationRewards(ctx sdk.Context, rewards sdk.Coin) {
 	if err != nil {
 		panic(err)
 	}
+
+	k.trackBlockPoolInflows(ctx, nil, sdk.NewCoins(rewards), nil)
 }
@@ -2,18 +2,24 @@ package keeper
 
 import (
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	ibcTransferTypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ibcClientTypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 
 	"github.com/archway-network/archway/x/rewards/types"
 )
 
 // WithdrawRewardsByRecordsLimit performs the rewards distribution for the given rewards address and the number of record to use.
-func (k Keeper) WithdrawRewardsByRecordsLimit(ctx sdk.Context, rewardsAddr sdk.AccAddress, recordsLimit uint64) (sdk.Coins, int, error) {
+// If denoms are provided, only the rewards in these denoms are withdrawn (refer to withdrawRewardsByRecords).
+func (k Keeper) WithdrawRewardsByRecordsLimit(ctx sdk.Context, rewardsAddr sdk.AccAddress, recordsLimit uint64, denoms ...string) (sdk.Coins, int, error) {
 	recordsLimitMax := k.MaxWithdrawRecords(ctx)
 
 	// Use the default limit if not specified
@@ -33,11 +39,14 @@ func (k Keeper) WithdrawRewardsByRecordsLimit(ctx sdk.Context, rewardsAddr sdk.A
 		return nil, 0, errorsmod.Wrap(types.ErrInternal, err.Error())
 	}
 
-	return k.withdrawRewardsByRecords(ctx, rewardsAddr, records), len(records), nil
+	totalRewards, recordsUsed := k.withdrawRewardsByRecords(ctx, rewardsAddr, records, denoms)
+
+	return totalRewards, recordsUsed, nil
 }
 
 // WithdrawRewardsByRecordIDs performs the rewards distribution for the given rewards address and record IDs.
-func (k Keeper) WithdrawRewardsByRecordIDs(ctx sdk.Context, rewardsAddr sdk.AccAddress, recordIDs []uint64) (sdk.Coins, int, error) {
+// If denoms are provided, only the rewards in these denoms are withdrawn (refer to withdrawRewardsByRecords).
+func (k Keeper) WithdrawRewardsByRecordIDs(ctx sdk.Context, rewardsAddr sdk.AccAddress, recordIDs []uint64, denoms ...string) (sdk.Coins, int, error) {
 	// Msg post-validateBasic check
 	if maxRecords := k.MaxWithdrawRecords(ctx); uint64(len(recordIDs)) > maxRecords {
 		return nil, 0, errorsmod.Wrapf(types.ErrInvalidRequest, "max withdraw records (%d) exceeded", maxRecords)
@@ -59,16 +68,84 @@ func (k Keeper) WithdrawRewardsByRecordIDs(ctx sdk.Context, rewardsAddr sdk.AccA
 		records = append(records, record)
 	}
 
-	return k.withdrawRewardsByRecords(ctx, rewardsAddr, records), len(records), nil
+	totalRewards, recordsUsed := k.withdrawRewardsByRecords(ctx, rewardsAddr, records, denoms)
+
+	return totalRewards, recordsUsed, nil
+}
+
+// UnwrapIBCRewards transfers the withdrawn rewards in single-hop IBC voucher denoms (received directly from the source
+// chain) from the rewards address back to the source chain over the channel they were received from.
+// Other denoms (native and multi-hop vouchers) are kept by the rewards address. The default IBC transfer timeout is used
+// if the timeout is zero. Returns the rewards transferred.
+func (k Keeper) UnwrapIBCRewards(ctx sdk.Context, rewardsAddr sdk.AccAddress, rewards sdk.Coins, receiver string, timeout time.Duration) (sdk.Coins, error) {
+	if k.transferKeeper == nil {
+		return nil, errorsmod.Wrap(types.ErrInternal, "IBC transfer keeper is not set")
+	}
+
+	if timeout == 0 {
+		timeout = time.Duration(ibcTransferTypes.DefaultRelativePacketTimeoutTimestamp)
+	}
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(timeout).UnixNano())
+
+	unwrapped := sdk.NewCoins()
+	for _, coin := range rewards {
+		sourcePort, sourceChannel, ok := k.getVoucherSourceChannel(ctx, coin.Denom)
+		if !ok {
+			continue
+		}
+
+		msg := ibcTransferTypes.NewMsgTransfer(sourcePort, sourceChannel, coin, rewardsAddr.String(), receiver, ibcClientTypes.ZeroHeight(), timeoutTimestamp, "")
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "unwrapping rewards (%s): %v", coin, err)
+		}
+		if _, err := k.transferKeeper.Transfer(ctx, msg); err != nil {
+			return nil, errorsmod.Wrapf(err, "unwrapping rewards (%s)", coin)
+		}
+		unwrapped = unwrapped.Add(coin)
+	}
+
+	return unwrapped, nil
+}
+
+// getVoucherSourceChannel returns the port and channel the single-hop IBC voucher denom was received over.
+// Returns false for non-voucher denoms, unknown denom traces and multi-hop vouchers.
+func (k Keeper) getVoucherSourceChannel(ctx sdk.Context, denom string) (string, string, bool) {
+	hash, found := strings.CutPrefix(denom, ibcTransferTypes.DenomPrefix+"/")
+	if !found {
+		return "", "", false
+	}
+	hashBz, err := ibcTransferTypes.ParseHexHash(hash)
+	if err != nil {
+		return "", "", false
+	}
+	trace, found := k.transferKeeper.GetDenomTrace(ctx, hashBz)
+	if !found {
+		return "", "", false
+	}
+
+	hops := strings.Split(trace.Path, "/")
+	if len(hops) != 2 {
+		return "", "", false
+	}
+
+	return hops[0], hops[1], true
 }
 
 // withdrawRewardsByRecords performs the rewards distribution for the given rewards address and records.
 // Handler emits the distribution event and prunes the used records.
-func (k Keeper) withdrawRewardsByRecords(ctx sdk.Context, rewardsAddr sdk.AccAddress, records []types.RewardsRecord) sdk.Coins {
+// If denoms are provided, only the rewards in these denoms are withdrawn: a record holding other denoms as well
+// is split (the record is kept with the rest of rewards pending), records holding none of them are not used.
+// Returns the total rewards transferred and the number of records used.
+func (k Keeper) withdrawRewardsByRecords(ctx sdk.Context, rewardsAddr sdk.AccAddress, records []types.RewardsRecord, denoms []string) (sdk.Coins, int) {
 	// Aggregate total rewards to distribute
 	totalRewards := sdk.NewCoins()
-	for _, record := range records {
-		totalRewards = totalRewards.Add(record.Rewards...)
+	recordsToPrune, recordsUsed := records, len(records)
+	if len(denoms) > 0 {
+		totalRewards, recordsToPrune, recordsUsed = k.splitRecordsByDenoms(ctx, records, denoms)
+	} else {
+		for _, record := range records {
+			totalRewards = totalRewards.Add(record.Rewards...)
+		}
 	}
 
 	// Transfer rewards and emit distribution event
@@ -81,11 +158,46 @@ func (k Keeper) withdrawRewardsByRecords(ctx sdk.Context, rewardsAddr sdk.AccAdd
 	}
 
 	// Clean up (safe if there were no rewards)
-	err := fastRemoveRecords(ctx, k.storeKey, k.RewardsRecords, records...)
+	err := fastRemoveRecords(ctx, k.storeKey, k.RewardsRecords, recordsToPrune...)
 	if err != nil {
 		panic(fmt.Errorf("removing rewards records: %w", err))
 	}
-	return totalRewards
+	return totalRewards, recordsUsed
+}
+
+// splitRecordsByDenoms withdraws the rewards in the given denoms from the records.
+// Records holding rewards in other denoms are updated to keep only those.
+// Returns the withdrawn rewards, records to be pruned (fully withdrawn) and the number of records used.
+func (k Keeper) splitRecordsByDenoms(ctx sdk.Context, records []types.RewardsRecord, denoms []string) (sdk.Coins, []types.RewardsRecord, int) {
+	totalRewards := sdk.NewCoins()
+	recordsToPrune := make([]types.RewardsRecord, 0, len(records))
+	recordsUsed := 0
+	for _, record := range records {
+		withdrawn, kept := sdk.NewCoins(), sdk.NewCoins()
+		for _, coin := range record.Rewards {
+			if slices.Contains(denoms, coin.Denom) {
+				withdrawn = withdrawn.Add(coin)
+			} else {
+				kept = kept.Add(coin)
+			}
+		}
+		if withdrawn.IsZero() {
+			continue
+		}
+
+		if kept.IsZero() {
+			recordsToPrune = append(recordsToPrune, record)
+		} else {
+			record.Rewards = kept
+			if err := k.RewardsRecords.Set(ctx, record.Id, record); err != nil {
+				panic(fmt.Errorf("splitting rewards record (%d): %w", record.Id, err))
+			}
+		}
+		totalRewards = totalRewards.Add(withdrawn...)
+		recordsUsed++
+	}
+
+	return totalRewards, recordsToPrune, recordsUsed
 }
 
 // fastRemoveRecords is used to remove rewards records without going through the indexed map
@@ -94,6 +206,7 @@ func fastRemoveRecords(ctx sdk.Context, storeKey storetypes.StoreKey, im *collec
 	store := ctx.KVStore(storeKey)
 	primaryKeyCodec := im.KeyCodec()
 	secondaryKeyCodec := im.Indexes.Address.KeyCodec()
+	addressHeightKeyCodec := im.Indexes.AddressHeight.KeyCodec()
 
 	for _, record := range records {
 		primaryKeyBytes, err := collections.EncodeKeyWithPrefix(types.RewardsRecordStatePrefix, primaryKeyCodec, record.Id)
@@ -110,8 +223,15 @@ func fastRemoveRecords(ctx sdk.Context, storeKey storetypes.StoreKey, im *collec
 			return err
 		}
 
+		addressHeightKey := collections.Join(collections.Join([]byte(rewardAddr), uint64(record.CalculatedHeight)), record.Id)
+		addressHeightKeyBytes, err := collections.EncodeKeyWithPrefix(types.RewardsRecordAddressHeightIndexPrefix, addressHeightKeyCodec, addressHeightKey)
+		if err != nil {
+			return err
+		}
+
 		store.Delete(primaryKeyBytes)
 		store.Delete(secondaryKeyBytes)
+		store.Delete(addressHeightKeyBytes)
 	}
 
 	return nil
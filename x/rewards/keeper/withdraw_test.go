@@ -171,3 +171,65 @@ func TestWithdrawRewardsByIDs(t *testing.T) {
 		)
 	})
 }
+
+// TestWithdrawRewardsByDenoms tests the partial withdraw operation (a subset of rewards denoms).
+func TestWithdrawRewardsByDenoms(t *testing.T) {
+	k, ctx, _ := testutils.RewardsKeeper(t)
+	accAddr := testutils.AccAddress()
+
+	coin1, coin2 := sdk.NewInt64Coin("uarch", 50), sdk.NewInt64Coin("ustake", 100)
+	testData := []withdrawTestRecordData{
+		{
+			RecordID:    1,
+			RewardsAddr: accAddr,
+			Rewards:     sdk.NewCoins(coin1, coin2),
+		},
+		{
+			RecordID:    2,
+			RewardsAddr: accAddr,
+			Rewards:     sdk.NewCoins(coin1),
+		},
+	}
+
+	// Setup environment
+	err := SetupWithdrawTest(k, ctx, testData)
+	require.NoError(t, err)
+
+	t.Run("OK: withdraw records without the denom", func(t *testing.T) {
+		totalRewardsReceived, recordsUsedReceived, err := k.WithdrawRewardsByRecordIDs(ctx, accAddr, []uint64{2}, coin2.Denom)
+		require.NoError(t, err)
+		require.Empty(t, totalRewardsReceived)
+		require.Empty(t, recordsUsedReceived)
+
+		_, err = k.RewardsRecords.Get(ctx, 2)
+		require.NoError(t, err)
+	})
+
+	t.Run("OK: withdraw one of two denoms", func(t *testing.T) {
+		totalRewardsReceived, recordsUsedReceived, err := k.WithdrawRewardsByRecordIDs(ctx, accAddr, []uint64{1}, coin2.Denom)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewCoins(coin2).String(), totalRewardsReceived.String())
+		require.EqualValues(t, 1, recordsUsedReceived)
+
+		record, err := k.RewardsRecords.Get(ctx, 1)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewCoins(coin1).String(), sdk.Coins(record.Rewards).String())
+
+		records, err := k.GetRewardsRecordsByWithdrawAddress(ctx, accAddr)
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+	})
+
+	t.Run("OK: withdraw the remaining denom", func(t *testing.T) {
+		remainingData := []withdrawTestRecordData{
+			{RecordID: 1, RewardsAddr: accAddr, Rewards: sdk.NewCoins(coin1)},
+			testData[1],
+		}
+		CheckWithdrawResults(t, k, ctx,
+			accAddr, remainingData,
+			func() (sdk.Coins, int, error) {
+				return k.WithdrawRewardsByRecordsLimit(ctx, accAddr, 0, coin1.Denom)
+			},
+		)
+	})
+}
@@ -0,0 +1,40 @@
+package v3
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/archway-network/archway/x/rewards/types"
+)
+
+// MigrateStore migrates the x/rewards module state from the consensus version 2 to
+// version 3. Specifically, it takes the single denom minimum consensus fee that is
+// currently stored as sdk.DecCoin and stores it as types.MinConsensusFees preserving
+// the existing value.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	bz := store.Get(types.MinConsFeePrefix)
+	if bz == nil {
+		return nil
+	}
+
+	var oldFee sdk.DecCoin
+	if err := cdc.Unmarshal(bz, &oldFee); err != nil {
+		return err
+	}
+
+	newFees := types.MinConsensusFees{
+		Fees: sdk.NewDecCoins(oldFee),
+	}
+
+	bz, err := cdc.Marshal(&newFees)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.MinConsFeePrefix, bz)
+
+	return nil
+}
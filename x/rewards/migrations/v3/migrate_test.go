@@ -0,0 +1,50 @@
+package v3_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	v3 "github.com/archway-network/archway/x/rewards/migrations/v3"
+	"github.com/archway-network/archway/x/rewards/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	t.Run("OK: min consensus fee is converted", func(t *testing.T) {
+		storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+		tKey := storetypes.NewTransientStoreKey("transient_test")
+		ctx := testutil.DefaultContext(storeKey, tKey)
+		store := ctx.KVStore(storeKey)
+
+		// Seed the old format state
+		oldFee, err := sdk.ParseDecCoin("0.01uarch")
+		require.NoError(t, err)
+		bz, err := cdc.Marshal(&oldFee)
+		require.NoError(t, err)
+		store.Set(types.MinConsFeePrefix, bz)
+
+		require.NoError(t, v3.MigrateStore(ctx, storeKey, cdc))
+
+		var res types.MinConsensusFees
+		require.NoError(t, cdc.Unmarshal(store.Get(types.MinConsFeePrefix), &res))
+		require.Len(t, res.Fees, 1)
+		require.Equal(t, oldFee.String(), res.Fees[0].String())
+	})
+
+	t.Run("OK: min consensus fee is not set", func(t *testing.T) {
+		storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+		tKey := storetypes.NewTransientStoreKey("transient_test")
+		ctx := testutil.DefaultContext(storeKey, tKey)
+		store := ctx.KVStore(storeKey)
+
+		require.NoError(t, v3.MigrateStore(ctx, storeKey, cdc))
+		require.Nil(t, store.Get(types.MinConsFeePrefix))
+	})
+}
@@ -0,0 +1,34 @@
+package v4
+
+import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/archway-network/archway/x/rewards/types"
+)
+
+// MigrateStore migrates the x/rewards module state from the consensus version 3 to
+// version 4. Specifically, it counts the existing contract metadata entries and
+// initializes the contract metadata counter with that value.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+
+	metadataStore := prefix.NewStore(store, types.ContractMetadataPrefix)
+	iterator := metadataStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	count := uint64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+
+	bz, err := collections.Uint64Value.Encode(count)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ContractMetadataCountPrefix, bz)
+
+	return nil
+}
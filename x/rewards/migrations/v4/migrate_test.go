@@ -0,0 +1,48 @@
+package v4_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/stretchr/testify/require"
+
+	v4 "github.com/archway-network/archway/x/rewards/migrations/v4"
+	"github.com/archway-network/archway/x/rewards/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	readCount := func(t *testing.T, bz []byte) uint64 {
+		count, err := collections.Uint64Value.Decode(bz)
+		require.NoError(t, err)
+		return count
+	}
+
+	t.Run("OK: existing metadata entries are counted", func(t *testing.T) {
+		storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+		tKey := storetypes.NewTransientStoreKey("transient_test")
+		ctx := testutil.DefaultContext(storeKey, tKey)
+		store := ctx.KVStore(storeKey)
+
+		// Seed the metadata entries (values are not decoded by the migration)
+		for _, key := range []string{"contract1", "contract2", "contract3"} {
+			store.Set(append(types.ContractMetadataPrefix.Bytes(), []byte(key)...), []byte("meta"))
+		}
+		// Entries under a different prefix are not counted
+		store.Set(append(types.FlatFeePrefix.Bytes(), []byte("contract1")...), []byte("fee"))
+
+		require.NoError(t, v4.MigrateStore(ctx, storeKey))
+		require.EqualValues(t, 3, readCount(t, store.Get(types.ContractMetadataCountPrefix)))
+	})
+
+	t.Run("OK: no metadata entries", func(t *testing.T) {
+		storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+		tKey := storetypes.NewTransientStoreKey("transient_test")
+		ctx := testutil.DefaultContext(storeKey, tKey)
+		store := ctx.KVStore(storeKey)
+
+		require.NoError(t, v4.MigrateStore(ctx, storeKey))
+		require.EqualValues(t, 0, readCount(t, store.Get(types.ContractMetadataCountPrefix)))
+	})
+}
@@ -0,0 +1,49 @@
+package v5
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	wasmTypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/archway-network/archway/x/rewards/types"
+)
+
+// ContractInfoReaderExpected defines the interface for the x/wasmd module dependency.
+type ContractInfoReaderExpected interface {
+	GetContractInfo(ctx context.Context, contractAddress sdk.AccAddress) *wasmTypes.ContractInfo
+}
+
+// SetContractCodeIDFn defines the contracts by code ID index update function.
+type SetContractCodeIDFn func(ctx context.Context, contractAddr []byte, codeID uint64) error
+
+// MigrateStore migrates the x/rewards module state from the consensus version 4 to
+// version 5. Specifically, it builds the contracts by code ID index for the existing
+// contract metadata entries using the x/wasmd contract info.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, contractInfoView ContractInfoReaderExpected, setCodeID SetContractCodeIDFn) error {
+	metadataStore := prefix.NewStore(ctx.KVStore(storeKey), types.ContractMetadataPrefix)
+
+	// Collect keys first to avoid writing while iterating
+	var contractAddrs []sdk.AccAddress
+	iterator := metadataStore.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		contractAddrs = append(contractAddrs, sdk.AccAddress(iterator.Key()))
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for _, contractAddr := range contractAddrs {
+		contractInfo := contractInfoView.GetContractInfo(ctx, contractAddr)
+		if contractInfo == nil {
+			continue
+		}
+		if err := setCodeID(ctx, contractAddr, contractInfo.CodeID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
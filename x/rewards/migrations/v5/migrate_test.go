@@ -0,0 +1,51 @@
+package v5_test
+
+import (
+	"context"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	e2eTesting "github.com/archway-network/archway/e2e/testing"
+	"github.com/archway-network/archway/pkg/testutils"
+	v5 "github.com/archway-network/archway/x/rewards/migrations/v5"
+	"github.com/archway-network/archway/x/rewards/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	wk := testutils.NewMockContractViewer()
+	adminAddr := testutils.AccAddress()
+
+	// Contracts 1, 2 and 3 have metadata set (contract 3 has no contract info), contract 4 has no metadata
+	contractAddrs := e2eTesting.GenContractAddresses(4)
+	for i, contractAddr := range contractAddrs {
+		if i != 2 {
+			wk.AddContractAdmin(contractAddr.String(), adminAddr.String())
+			wk.SetContractCodeID(contractAddr.String(), uint64(i+1))
+		}
+		if i != 3 {
+			// Values are not decoded by the migration
+			store.Set(append(types.ContractMetadataPrefix.Bytes(), contractAddr...), []byte("meta"))
+		}
+	}
+
+	codeIDs := make(map[string]uint64)
+	setCodeID := func(_ context.Context, contractAddr []byte, codeID uint64) error {
+		codeIDs[sdk.AccAddress(contractAddr).String()] = codeID
+		return nil
+	}
+
+	require.NoError(t, v5.MigrateStore(ctx, storeKey, wk, setCodeID))
+	require.Equal(t, map[string]uint64{
+		contractAddrs[0].String(): 1,
+		contractAddrs[1].String(): 2,
+	}, codeIDs)
+}
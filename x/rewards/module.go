@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"cosmossdk.io/core/appmodule"
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -26,14 +27,15 @@ import (
 )
 
 var (
-	_ module.AppModuleBasic  = AppModuleBasic{}
-	_ module.AppModule       = AppModule{}
-	_ module.HasABCIEndBlock = AppModule{}
-	_ module.HasGenesis      = AppModule{}
+	_ module.AppModuleBasic     = AppModuleBasic{}
+	_ module.AppModule          = AppModule{}
+	_ module.HasABCIEndBlock    = AppModule{}
+	_ appmodule.HasBeginBlocker = AppModule{}
+	_ module.HasGenesis         = AppModule{}
 )
 
 // ConsensusVersion defines the current x/rewards module consensus version.
-const ConsensusVersion = 2
+const ConsensusVersion = 6
 
 // AppModuleBasic defines the basic application module for this module.
 type AppModuleBasic struct {
@@ -114,10 +116,22 @@ func (a AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServer(a.keeper))
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServer(a.keeper))
 
-	// m := keeper.NewMigrator(a.keeper)
+	m := keeper.NewMigrator(a.keeper)
 	// if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
 	// 	panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
 	// }
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 3 to 4: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 4 to 5: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 5, m.Migrate5to6); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 5 to 6: %v", types.ModuleName, err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the module. It returns no validator updates.
@@ -139,6 +153,11 @@ func (a AppModule) ConsensusVersion() uint64 {
 	return ConsensusVersion
 }
 
+// BeginBlock returns the begin blocker for the module.
+func (a AppModule) BeginBlock(ctx context.Context) error {
+	return BeginBlocker(sdk.UnwrapSDKContext(ctx), a.keeper)
+}
+
 // EndBlock returns the end blocker for the module. It returns no validator updates.
 func (a AppModule) EndBlock(ctx context.Context) ([]abci.ValidatorUpdate, error) {
 	return EndBlocker(sdk.UnwrapSDKContext(ctx), a.keeper)
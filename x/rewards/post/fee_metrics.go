@@ -0,0 +1,54 @@
+package post
+
+import (
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hashicorp/go-metrics"
+
+	"github.com/archway-network/archway/dmap"
+	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
+)
+
+var _ sdk.PostDecorator = FeeMetricsDecorator{}
+
+// FeeMetricsDecorator reports the tx fees to min fee ratio (per min fee denom) as a telemetry sample.
+// The min fee (gas fees and contract flat fees) is set by the MinFeeDecorator Ante handler once the tx fees cover it,
+// so fee-free and zero min fee txs are not reported.
+// Only DeliverTx is reported: CheckTx and simulations would count the same tx multiple times.
+type FeeMetricsDecorator struct{}
+
+// NewFeeMetricsDecorator returns a new FeeMetricsDecorator instance.
+func NewFeeMetricsDecorator() FeeMetricsDecorator {
+	return FeeMetricsDecorator{}
+}
+
+// PostHandle implements the sdk.PostDecorator interface.
+func (fmd FeeMetricsDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (newCtx sdk.Context, err error) {
+	if simulate || ctx.IsCheckTx() || !telemetry.IsTelemetryEnabled() {
+		return next(ctx, tx, simulate, success)
+	}
+
+	minFees, found := rewardsTypes.GetTxMinFee(ctx)
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !found || !ok {
+		return next(ctx, tx, simulate, success)
+	}
+
+	ratios := rewardsTypes.FeeOverpaymentRatios(feeTx.GetFee(), minFees)
+	for _, denom := range dmap.SortedKeys(ratios) {
+		ratio, err := ratios[denom].Float64()
+		if err != nil {
+			continue
+		}
+		metrics.AddSampleWithLabels(
+			[]string{rewardsTypes.MetricKeyTxFeeOverpayment},
+			float32(ratio),
+			[]metrics.Label{
+				telemetry.NewLabel(telemetry.MetricLabelNameModule, rewardsTypes.ModuleName),
+				telemetry.NewLabel(rewardsTypes.MetricLabelNameDenom, denom),
+			},
+		)
+	}
+
+	return next(ctx, tx, simulate, success)
+}
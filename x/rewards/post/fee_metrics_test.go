@@ -0,0 +1,91 @@
+package post_test
+
+import (
+	"testing"
+	"time"
+
+	sdkMath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hashicorp/go-metrics"
+	"github.com/stretchr/testify/require"
+
+	e2eTesting "github.com/archway-network/archway/e2e/testing"
+	"github.com/archway-network/archway/pkg/testutils"
+	"github.com/archway-network/archway/x/rewards/ante"
+	"github.com/archway-network/archway/x/rewards/post"
+	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
+)
+
+// TestRewardsFeeMetricsPostHandler checks the tx fees to min fee ratio telemetry reported by the post handler.
+func TestRewardsFeeMetricsPostHandler(t *testing.T) {
+	// Enable telemetry with an in-memory sink
+	_, err := telemetry.New(telemetry.Config{Enabled: true})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = telemetry.New(telemetry.Config{Enabled: false})
+	})
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	metricsCfg := metrics.DefaultConfig("")
+	metricsCfg.EnableHostname = false
+	metricsCfg.EnableRuntimeMetrics = false
+	_, err = metrics.NewGlobal(metricsCfg, sink)
+	require.NoError(t, err)
+
+	getSample := func(denom string) (metrics.SampledValue, bool) {
+		intervals := sink.Data()
+		sample, found := intervals[len(intervals)-1].Samples[rewardsTypes.MetricKeyTxFeeOverpayment+";module="+rewardsTypes.ModuleName+";denom="+denom]
+		return sample, found
+	}
+
+	chain := e2eTesting.NewTestChain(t, 1)
+	acc := chain.GetAccount(0)
+	keepers := chain.GetApp().Keepers
+
+	// Min gas price is 0.01stake (1000stake for 100000 gas)
+	gasPrice := sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdkMath.LegacyMustNewDecFromStr("0.01"))
+	ctx := chain.GetContext()
+	params := keepers.RewardsKeeper.GetParams(ctx)
+	params.MinPriceOfGas = gasPrice
+	require.NoError(t, keepers.RewardsKeeper.Params.Set(ctx, params))
+	require.NoError(t, keepers.RewardsKeeper.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(gasPrice)}))
+
+	newTx := func(fees sdk.Coins) sdk.Tx {
+		return testutils.NewMockFeeTx(
+			testutils.WithMockFeeTxFees(fees),
+			testutils.WithMockFeeTxGas(100_000),
+			testutils.WithMockFeeTxPayer(acc.Address),
+		)
+	}
+	anteHandler := sdk.ChainAnteDecorators(ante.NewMinFeeDecorator(chain.GetAppCodec(), keepers.RewardsKeeper))
+	postHandler := sdk.ChainPostDecorators(post.NewFeeMetricsDecorator())
+
+	t.Run("CheckTx is not reported", func(t *testing.T) {
+		tx := newTx(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 2000)))
+		checkCtx, err := anteHandler(ctx.WithIsCheckTx(true), tx, false)
+		require.NoError(t, err)
+		_, err = postHandler(checkCtx, tx, false, true)
+		require.NoError(t, err)
+
+		_, found := getSample(sdk.DefaultBondDenom)
+		require.False(t, found)
+	})
+
+	t.Run("DeliverTx is reported", func(t *testing.T) {
+		tx := newTx(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1500)))
+		deliverCtx, err := anteHandler(ctx.WithIsCheckTx(false), tx, false)
+		require.NoError(t, err)
+
+		minFees, found := rewardsTypes.GetTxMinFee(deliverCtx)
+		require.True(t, found)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)).String(), minFees.String())
+
+		_, err = postHandler(deliverCtx, tx, false, true)
+		require.NoError(t, err)
+
+		sample, found := getSample(sdk.DefaultBondDenom)
+		require.True(t, found)
+		require.Equal(t, 1, sample.Count)
+		require.InDelta(t, 1.5, sample.Sum, 1e-6)
+	})
+}
@@ -0,0 +1,112 @@
+package post
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/archway-network/archway/pkg"
+	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
+)
+
+var _ sdk.PostDecorator = FeeRefundDecorator{}
+
+// BankKeeper defines the expected interface for the x/bank keeper.
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+}
+
+// RewardsKeeperExpected defines the expected interface for the x/rewards keeper.
+type RewardsKeeperExpected interface {
+	TxFeeRebateRatio(ctx sdk.Context) math.LegacyDec
+	TrackFeeRebatesRewards(ctx sdk.Context, rewards sdk.Coins)
+	RouteFeeCollectorFees(ctx sdk.Context, fees sdk.Coins) (sdk.Coins, error)
+	TrackTxFeeDistribution(ctx sdk.Context, feeCollectorFees, burntFees, rewardsFees, flatFees, routedFees sdk.Coins)
+	CreateFlatFeeRewardsRecords(ctx sdk.Context, contractAddress sdk.AccAddress, flatfees sdk.Coins) bool
+}
+
+// FeeRefundDecorator settles the dynamic fee gas fees withheld by the rewards Ante handlers.
+// Gas fees for the actual gas usage are charged using the effective gas price and distributed the same way
+// the DeductFeeDecorator does, the rest (gas price surplus and unused gas) is sent back to the fee payer.
+// Contract flat fees are charged by the Ante handler and are never refunded.
+// Failed txs are not refunded: the post handler is called with success set to false, but its state changes would be
+// discarded along with the msgs ones anyway (runTx only writes the msgs cache on success), so the gas fees withheld on
+// the fee collector account are kept by the fee collector.
+type FeeRefundDecorator struct {
+	bankKeeper    BankKeeper
+	rewardsKeeper RewardsKeeperExpected
+}
+
+// NewFeeRefundDecorator returns a new FeeRefundDecorator instance.
+func NewFeeRefundDecorator(bk BankKeeper, rk RewardsKeeperExpected) FeeRefundDecorator {
+	return FeeRefundDecorator{
+		bankKeeper:    bk,
+		rewardsKeeper: rk,
+	}
+}
+
+// PostHandle implements the sdk.PostDecorator interface.
+func (frd FeeRefundDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (newCtx sdk.Context, err error) {
+	refund, found := rewardsTypes.GetDynamicFeeRefund(ctx)
+	if !success || !found || refund.GasFees.IsZero() || refund.FeePayer.Empty() {
+		return next(ctx, tx, simulate, success)
+	}
+
+	chargedFees, refundFees := refund.Settle(ctx.GasMeter().GasConsumed())
+
+	if !refundFees.IsZero() {
+		if err := frd.bankKeeper.SendCoinsFromModuleToAccount(ctx, authTypes.FeeCollectorName, refund.FeePayer, refundFees); err != nil {
+			return ctx, errorsmod.Wrapf(sdkErrors.ErrInsufficientFunds, "refunding dynamic fee surplus: %v", err)
+		}
+		rewardsTypes.EmitDynamicFeeRefundEvent(ctx, refund.FeePayer, refundFees)
+	}
+
+	if err := frd.distributeChargedFees(ctx, refund.FeeRebateEligible, chargedFees); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate, success)
+}
+
+// distributeChargedFees splits the charged gas fees the same way the DeductFeeDecorator does.
+// Fees are kept by the fee collector if the tx is not eligible for the fee rebates.
+func (frd FeeRefundDecorator) distributeChargedFees(ctx sdk.Context, rebateEligible bool, fees sdk.Coins) error {
+	if fees.IsZero() {
+		return nil
+	}
+
+	if !rebateEligible {
+		routedFees, err := frd.rewardsKeeper.RouteFeeCollectorFees(ctx, fees)
+		if err != nil {
+			return err
+		}
+		frd.rewardsKeeper.TrackTxFeeDistribution(ctx, fees.Sub(routedFees...), nil, nil, nil, routedFees)
+		return nil
+	}
+
+	rewardsFees, authFees := pkg.SplitCoins(fees, frd.rewardsKeeper.TxFeeRebateRatio(ctx))
+
+	if !authFees.Empty() {
+		if err := frd.bankKeeper.BurnCoins(ctx, authTypes.FeeCollectorName, authFees); err != nil {
+			return errorsmod.Wrapf(sdkErrors.ErrInsufficientFunds, "burning dynamic fee gas fees: %v", err)
+		}
+	}
+
+	if !rewardsFees.Empty() {
+		if err := frd.bankKeeper.SendCoinsFromModuleToModule(ctx, authTypes.FeeCollectorName, rewardsTypes.ContractRewardCollector, rewardsFees); err != nil {
+			return errorsmod.Wrapf(sdkErrors.ErrInsufficientFunds, "sending dynamic fee gas fees rebate: %v", err)
+		}
+	}
+
+	frd.rewardsKeeper.TrackFeeRebatesRewards(ctx, rewardsFees)
+	frd.rewardsKeeper.TrackTxFeeDistribution(ctx, nil, authFees, rewardsFees, nil, nil)
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package post_test
+
+import (
+	"testing"
+
+	sdkMath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	wasmTypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	mintTypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+
+	e2eTesting "github.com/archway-network/archway/e2e/testing"
+	"github.com/archway-network/archway/pkg/testutils"
+	"github.com/archway-network/archway/x/rewards/ante"
+	"github.com/archway-network/archway/x/rewards/post"
+	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
+)
+
+func noopPostHandler(ctx sdk.Context, tx sdk.Tx, simulate, success bool) (sdk.Context, error) {
+	return ctx, nil
+}
+
+func TestRewardsFeeRefundPostHandler(t *testing.T) {
+	chain := e2eTesting.NewTestChain(t, 1)
+	acc := chain.GetAccount(0)
+	ctx := chain.GetContext().WithEventManager(sdk.NewEventManager())
+	keepers := chain.GetApp().Keepers
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	rewardsAddr := testutils.AccAddress()
+
+	// Base gas price is 0.01stake, contract flat fee is 50stake
+	basePrice := sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdkMath.LegacyMustNewDecFromStr("0.01"))
+	params := keepers.RewardsKeeper.GetParams(ctx)
+	params.DynamicFeeEnabled = true
+	params.MinPriceOfGas = basePrice
+	require.NoError(t, keepers.RewardsKeeper.Params.Set(ctx, params))
+	require.NoError(t, keepers.RewardsKeeper.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(basePrice)}))
+	require.NoError(t, keepers.RewardsKeeper.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+		ContractAddress: contractAddr.String(),
+		OwnerAddress:    rewardsAddr.String(),
+		RewardsAddress:  rewardsAddr.String(),
+	}))
+	require.NoError(t, keepers.RewardsKeeper.FlatFees.Set(ctx, contractAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)))
+
+	// Max gas price is 0.05stake (5000stake for 100000 gas), effective gas price is 0.015stake
+	feeCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5050))
+	require.NoError(t, keepers.BankKeeper.MintCoins(ctx, mintTypes.ModuleName, feeCoins))
+	require.NoError(t, keepers.BankKeeper.SendCoinsFromModuleToAccount(ctx, mintTypes.ModuleName, acc.Address, feeCoins))
+
+	priorityOpt, err := codecTypes.NewAnyWithValue(&rewardsTypes.ExtensionOptionDynamicFee{
+		MaxPriorityPrice: sdkMath.LegacyMustNewDecFromStr("0.005"),
+	})
+	require.NoError(t, err)
+
+	tx := testutils.NewMockFeeTx(
+		testutils.WithMockFeeTxFees(feeCoins),
+		testutils.WithMockFeeTxGas(100_000),
+		testutils.WithMockFeeTxPayer(acc.Address),
+		testutils.WithMockFeeTxExtensionOptions(priorityOpt),
+		testutils.WithMockFeeTxMsgs(&wasmTypes.MsgExecuteContract{
+			Sender:   acc.Address.String(),
+			Contract: contractAddr.String(),
+		}),
+	)
+	feeCollectorAddr := keepers.AccountKeeper.GetModuleAddress(authTypes.FeeCollectorName)
+	rewardsCollectorAddr := keepers.AccountKeeper.GetModuleAddress(rewardsTypes.ContractRewardCollector)
+	balanceBefore := keepers.BankKeeper.GetBalance(ctx, acc.Address, sdk.DefaultBondDenom)
+	feeCollectorBalanceBefore := keepers.BankKeeper.GetBalance(ctx, feeCollectorAddr, sdk.DefaultBondDenom)
+	rewardsCollectorBalanceBefore := keepers.BankKeeper.GetBalance(ctx, rewardsCollectorAddr, sdk.DefaultBondDenom)
+
+	keepers.TrackingKeeper.TrackNewTx(ctx) // tracking Ante handler provides a unique tx ID
+	anteHandler := sdk.ChainAnteDecorators(
+		ante.NewMinFeeDecorator(chain.GetAppCodec(), keepers.RewardsKeeper),
+		ante.NewDeductFeeDecorator(chain.GetAppCodec(), keepers.AccountKeeper, keepers.BankKeeper, keepers.FeeGrantKeeper, keepers.RewardsKeeper, keepers.CWFeesKeeper),
+	)
+	ctx, err = anteHandler(ctx, tx, false)
+	require.NoError(t, err)
+
+	refund, found := rewardsTypes.GetDynamicFeeRefund(ctx)
+	require.True(t, found)
+	require.Equal(t, acc.Address, refund.FeePayer)
+	require.True(t, refund.FeeRebateEligible)
+	require.Equal(t, "5000stake", refund.GasFees.String())
+
+	// Full fees are deducted, the flat fee is charged and the gas fees are withheld by the fee collector
+	require.Equal(t, balanceBefore.SubAmount(feeCoins.AmountOf(sdk.DefaultBondDenom)), keepers.BankKeeper.GetBalance(ctx, acc.Address, sdk.DefaultBondDenom))
+	require.Equal(t, "50stake", keepers.BankKeeper.GetBalance(ctx, rewardsCollectorAddr, sdk.DefaultBondDenom).Sub(rewardsCollectorBalanceBefore).String())
+
+	postHandler := post.NewFeeRefundDecorator(keepers.BankKeeper, keepers.RewardsKeeper)
+
+	t.Run("OK: failed tx is not refunded, gas fees are kept by the fee collector", func(t *testing.T) {
+		postCtx := ctx.WithGasMeter(storetypes.NewGasMeter(100_000)).WithEventManager(sdk.NewEventManager())
+		postCtx.GasMeter().ConsumeGas(10_000, "test")
+
+		_, err := postHandler.PostHandle(postCtx, tx, false, false, noopPostHandler)
+		require.NoError(t, err)
+
+		require.Equal(t, balanceBefore.SubAmount(feeCoins.AmountOf(sdk.DefaultBondDenom)), keepers.BankKeeper.GetBalance(ctx, acc.Address, sdk.DefaultBondDenom))
+		require.Equal(t, "5000stake", keepers.BankKeeper.GetBalance(ctx, feeCollectorAddr, sdk.DefaultBondDenom).Sub(feeCollectorBalanceBefore).String())
+		require.Empty(t, postCtx.EventManager().Events())
+	})
+
+	t.Run("OK: unused gas and gas price surplus are refunded, flat fee is retained", func(t *testing.T) {
+		// Small actual gas usage compared to the gas limit
+		postCtx := ctx.WithGasMeter(storetypes.NewGasMeter(100_000))
+		postCtx.GasMeter().ConsumeGas(10_000, "test")
+
+		_, err := postHandler.PostHandle(postCtx, tx, false, true, noopPostHandler)
+		require.NoError(t, err)
+
+		// Charged: 50stake flat fee + 150stake for 10000 gas at 0.015stake
+		require.Equal(t, "200stake", balanceBefore.Sub(keepers.BankKeeper.GetBalance(ctx, acc.Address, sdk.DefaultBondDenom)).String())
+		// Charged gas fees are split between the burnt fees and the dApp rewards
+		require.Equal(t, feeCollectorBalanceBefore, keepers.BankKeeper.GetBalance(ctx, feeCollectorAddr, sdk.DefaultBondDenom))
+		require.Equal(t, "125stake", keepers.BankKeeper.GetBalance(ctx, rewardsCollectorAddr, sdk.DefaultBondDenom).Sub(rewardsCollectorBalanceBefore).String())
+
+		txID := keepers.TrackingKeeper.GetCurrentTxID(ctx)
+		txRewards, err := keepers.RewardsKeeper.TxRewards.Get(ctx, txID)
+		require.NoError(t, err)
+		require.Equal(t, "75stake", sdk.Coins(txRewards.FeeRewards).String())
+
+		feeDistr, err := keepers.RewardsKeeper.TxFeeDistributions.Get(ctx, txID)
+		require.NoError(t, err)
+		require.Equal(t, "50stake", sdk.Coins(feeDistr.FlatFees).String())
+		require.Equal(t, "75stake", sdk.Coins(feeDistr.BurntFees).String())
+		require.Equal(t, "75stake", sdk.Coins(feeDistr.RewardsFees).String())
+
+		var refundEvent *rewardsTypes.DynamicFeeRefundEvent
+		for _, event := range postCtx.EventManager().Events() {
+			msg, err := sdk.ParseTypedEvent(abci.Event(event))
+			if err != nil {
+				continue
+			}
+			if e, ok := msg.(*rewardsTypes.DynamicFeeRefundEvent); ok {
+				refundEvent = e
+			}
+		}
+		require.NotNil(t, refundEvent)
+		require.Equal(t, acc.Address.String(), refundEvent.FeePayer)
+		require.Equal(t, "4850stake", sdk.Coins(refundEvent.Refund).String())
+	})
+
+	t.Run("OK: no-op without a refund", func(t *testing.T) {
+		balanceBefore := keepers.BankKeeper.GetBalance(ctx, acc.Address, sdk.DefaultBondDenom)
+
+		_, err := postHandler.PostHandle(chain.GetContext(), tx, false, true, noopPostHandler)
+		require.NoError(t, err)
+		require.Equal(t, balanceBefore, keepers.BankKeeper.GetBalance(ctx, acc.Address, sdk.DefaultBondDenom))
+	})
+}
@@ -0,0 +1,58 @@
+package post
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
+)
+
+var _ sdk.PostDecorator = DeferredFlatFeeDecorator{}
+
+// DeferredFlatFeeDecorator charges the contract flat fees deferred by the rewards Ante handlers (contracts with the
+// flat_fee_on_success metadata flag set or every contract if the FlatFeeRefundOnFailure param is set). Fees are charged from the fee payer and distributed the same way the Ante
+// handler charged flat fees are.
+// The post handler state changes are discarded if the tx msgs have failed, so deferred flat fees are never charged for
+// failed executions (the tx fees are still required to cover them).
+type DeferredFlatFeeDecorator struct {
+	bankKeeper    BankKeeper
+	rewardsKeeper RewardsKeeperExpected
+}
+
+// NewDeferredFlatFeeDecorator returns a new DeferredFlatFeeDecorator instance.
+func NewDeferredFlatFeeDecorator(bk BankKeeper, rk RewardsKeeperExpected) DeferredFlatFeeDecorator {
+	return DeferredFlatFeeDecorator{
+		bankKeeper:    bk,
+		rewardsKeeper: rk,
+	}
+}
+
+// PostHandle implements the sdk.PostDecorator interface.
+func (dfd DeferredFlatFeeDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (newCtx sdk.Context, err error) {
+	deferred, found := rewardsTypes.GetDeferredFlatFees(ctx)
+	if !success || !found || deferred.FeePayer.Empty() {
+		return next(ctx, tx, simulate, success)
+	}
+
+	// Contracts which metadata was removed by the tx msgs can't be attributed the flat fee (those are not charged).
+	// Records are created before the charge, the tx fails (state is reverted) if the fee payer can't cover the total.
+	flatFees := sdk.NewCoins()
+	for _, fee := range deferred.Fees {
+		if !dfd.rewardsKeeper.CreateFlatFeeRewardsRecords(ctx, fee.ContractAddress, fee.FlatFees) {
+			continue
+		}
+		rewardsTypes.EmitContractFlatFeeChargedEvent(ctx, fee.MsgIndex, fee.ContractAddress, fee.FlatFees)
+		flatFees = flatFees.Add(fee.FlatFees...)
+	}
+	if flatFees.IsZero() {
+		return next(ctx, tx, simulate, success)
+	}
+
+	if err := dfd.bankKeeper.SendCoinsFromAccountToModule(ctx, deferred.FeePayer, rewardsTypes.ContractRewardCollector, flatFees); err != nil {
+		return ctx, errorsmod.Wrapf(sdkErrors.ErrInsufficientFunds, "charging deferred flat fees: %v", err)
+	}
+	dfd.rewardsKeeper.TrackTxFeeDistribution(ctx, nil, nil, nil, flatFees, nil)
+
+	return next(ctx, tx, simulate, success)
+}
@@ -0,0 +1,247 @@
+package post_test
+
+import (
+	"testing"
+
+	sdkMath "cosmossdk.io/math"
+	wasmTypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	mintTypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+
+	e2eTesting "github.com/archway-network/archway/e2e/testing"
+	"github.com/archway-network/archway/pkg/testutils"
+	"github.com/archway-network/archway/x/rewards/ante"
+	"github.com/archway-network/archway/x/rewards/post"
+	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
+)
+
+func TestRewardsDeferredFlatFeePostHandler(t *testing.T) {
+	chain := e2eTesting.NewTestChain(t, 1)
+	acc := chain.GetAccount(0)
+	ctx := chain.GetContext().WithEventManager(sdk.NewEventManager())
+	keepers := chain.GetApp().Keepers
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	rewardsAddr := testutils.AccAddress()
+
+	// Gas price is 0.01stake (1000stake for 100000 gas), contract flat fee is 50stake charged on success only
+	gasPrice := sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdkMath.LegacyMustNewDecFromStr("0.01"))
+	params := keepers.RewardsKeeper.GetParams(ctx)
+	params.MinPriceOfGas = gasPrice
+	require.NoError(t, keepers.RewardsKeeper.Params.Set(ctx, params))
+	require.NoError(t, keepers.RewardsKeeper.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(gasPrice)}))
+	require.NoError(t, keepers.RewardsKeeper.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+		ContractAddress:  contractAddr.String(),
+		OwnerAddress:     rewardsAddr.String(),
+		RewardsAddress:   rewardsAddr.String(),
+		FlatFeeOnSuccess: true,
+	}))
+	require.NoError(t, keepers.RewardsKeeper.FlatFees.Set(ctx, contractAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)))
+
+	feeCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1050))
+	require.NoError(t, keepers.BankKeeper.MintCoins(ctx, mintTypes.ModuleName, feeCoins))
+	require.NoError(t, keepers.BankKeeper.SendCoinsFromModuleToAccount(ctx, mintTypes.ModuleName, acc.Address, feeCoins))
+
+	tx := testutils.NewMockFeeTx(
+		testutils.WithMockFeeTxFees(feeCoins),
+		testutils.WithMockFeeTxGas(100_000),
+		testutils.WithMockFeeTxPayer(acc.Address),
+		testutils.WithMockFeeTxMsgs(&wasmTypes.MsgExecuteContract{
+			Sender:   acc.Address.String(),
+			Contract: contractAddr.String(),
+		}),
+	)
+	rewardsCollectorAddr := keepers.AccountKeeper.GetModuleAddress(rewardsTypes.ContractRewardCollector)
+	balanceBefore := keepers.BankKeeper.GetBalance(ctx, acc.Address, sdk.DefaultBondDenom)
+	rewardsCollectorBalanceBefore := keepers.BankKeeper.GetBalance(ctx, rewardsCollectorAddr, sdk.DefaultBondDenom)
+
+	keepers.TrackingKeeper.TrackNewTx(ctx) // tracking Ante handler provides a unique tx ID
+	anteHandler := sdk.ChainAnteDecorators(
+		ante.NewMinFeeDecorator(chain.GetAppCodec(), keepers.RewardsKeeper),
+		ante.NewDeductFeeDecorator(chain.GetAppCodec(), keepers.AccountKeeper, keepers.BankKeeper, keepers.FeeGrantKeeper, keepers.RewardsKeeper, keepers.CWFeesKeeper),
+	)
+	ctx, err := anteHandler(ctx, tx, false)
+	require.NoError(t, err)
+
+	deferred, found := rewardsTypes.GetDeferredFlatFees(ctx)
+	require.True(t, found)
+	require.Equal(t, acc.Address, deferred.FeePayer)
+	require.Equal(t, "50stake", deferred.Total().String())
+
+	// Gas fees are deducted, the flat fee is left on the fee payer account
+	require.Equal(t, "1000stake", balanceBefore.Sub(keepers.BankKeeper.GetBalance(ctx, acc.Address, sdk.DefaultBondDenom)).String())
+	rewardsCollectorBalanceAnte := keepers.BankKeeper.GetBalance(ctx, rewardsCollectorAddr, sdk.DefaultBondDenom)
+	require.Equal(t, "500stake", rewardsCollectorBalanceAnte.Sub(rewardsCollectorBalanceBefore).String()) // gas fees rebate only
+
+	postHandler := post.NewDeferredFlatFeeDecorator(keepers.BankKeeper, keepers.RewardsKeeper)
+
+	t.Run("OK: flat fee is charged for a succeeded execution", func(t *testing.T) {
+		postCtx, _ := ctx.CacheContext()
+		postCtx = postCtx.WithEventManager(sdk.NewEventManager())
+
+		_, err := postHandler.PostHandle(postCtx, tx, false, true, noopPostHandler)
+		require.NoError(t, err)
+
+		require.Equal(t, "1050stake", balanceBefore.Sub(keepers.BankKeeper.GetBalance(postCtx, acc.Address, sdk.DefaultBondDenom)).String())
+		require.Equal(t, "50stake", keepers.BankKeeper.GetBalance(postCtx, rewardsCollectorAddr, sdk.DefaultBondDenom).Sub(rewardsCollectorBalanceAnte).String())
+
+		records, err := keepers.RewardsKeeper.GetRewardsRecordsByWithdrawAddress(postCtx, rewardsAddr)
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		require.Equal(t, "50stake", sdk.Coins(records[0].Rewards).String())
+
+		feeDistr, err := keepers.RewardsKeeper.TxFeeDistributions.Get(postCtx, keepers.TrackingKeeper.GetCurrentTxID(postCtx))
+		require.NoError(t, err)
+		require.Equal(t, "50stake", sdk.Coins(feeDistr.FlatFees).String())
+
+		var chargedEvent *rewardsTypes.ContractFlatFeeChargedEvent
+		for _, event := range postCtx.EventManager().Events() {
+			msg, err := sdk.ParseTypedEvent(abci.Event(event))
+			if err != nil {
+				continue
+			}
+			if e, ok := msg.(*rewardsTypes.ContractFlatFeeChargedEvent); ok {
+				chargedEvent = e
+			}
+		}
+		require.NotNil(t, chargedEvent)
+		require.Equal(t, contractAddr.String(), chargedEvent.ContractAddress)
+		require.Equal(t, "50stake", sdk.Coins(chargedEvent.FlatFees).String())
+	})
+
+	t.Run("OK: flat fee is not charged for a failed execution", func(t *testing.T) {
+		postCtx, _ := ctx.CacheContext()
+
+		_, err := postHandler.PostHandle(postCtx, tx, false, false, noopPostHandler)
+		require.NoError(t, err)
+
+		require.Equal(t, "1000stake", balanceBefore.Sub(keepers.BankKeeper.GetBalance(postCtx, acc.Address, sdk.DefaultBondDenom)).String())
+		require.Equal(t, rewardsCollectorBalanceAnte, keepers.BankKeeper.GetBalance(postCtx, rewardsCollectorAddr, sdk.DefaultBondDenom))
+
+		records, err := keepers.RewardsKeeper.GetRewardsRecordsByWithdrawAddress(postCtx, rewardsAddr)
+		require.NoError(t, err)
+		require.Empty(t, records)
+	})
+
+	t.Run("OK: flat fee is not charged if the contract metadata was removed by the tx", func(t *testing.T) {
+		postCtx, _ := ctx.CacheContext()
+		postCtx = postCtx.WithEventManager(sdk.NewEventManager())
+		require.NoError(t, keepers.RewardsKeeper.ContractMetadata.Remove(postCtx, contractAddr))
+
+		_, err := postHandler.PostHandle(postCtx, tx, false, true, noopPostHandler)
+		require.NoError(t, err)
+
+		require.Equal(t, "1000stake", balanceBefore.Sub(keepers.BankKeeper.GetBalance(postCtx, acc.Address, sdk.DefaultBondDenom)).String())
+		require.Equal(t, rewardsCollectorBalanceAnte, keepers.BankKeeper.GetBalance(postCtx, rewardsCollectorAddr, sdk.DefaultBondDenom))
+		require.Empty(t, postCtx.EventManager().Events())
+
+		records, err := keepers.RewardsKeeper.GetRewardsRecordsByWithdrawAddress(postCtx, rewardsAddr)
+		require.NoError(t, err)
+		require.Empty(t, records)
+	})
+
+	t.Run("Fail: fee payer can not cover the flat fee", func(t *testing.T) {
+		postCtx, _ := ctx.CacheContext()
+		require.NoError(t, keepers.BankKeeper.SendCoins(postCtx, acc.Address, rewardsAddr, sdk.NewCoins(keepers.BankKeeper.GetBalance(postCtx, acc.Address, sdk.DefaultBondDenom))))
+
+		_, err := postHandler.PostHandle(postCtx, tx, false, true, noopPostHandler)
+		require.Error(t, err)
+	})
+
+	t.Run("OK: no-op without deferred flat fees", func(t *testing.T) {
+		balanceBefore := keepers.BankKeeper.GetBalance(ctx, acc.Address, sdk.DefaultBondDenom)
+
+		_, err := postHandler.PostHandle(chain.GetContext(), tx, false, true, noopPostHandler)
+		require.NoError(t, err)
+		require.Equal(t, balanceBefore, keepers.BankKeeper.GetBalance(ctx, acc.Address, sdk.DefaultBondDenom))
+	})
+}
+
+func TestRewardsFlatFeeRefundOnFailure(t *testing.T) {
+	chain := e2eTesting.NewTestChain(t, 1)
+	acc := chain.GetAccount(0)
+	keepers := chain.GetApp().Keepers
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	rewardsAddr := testutils.AccAddress()
+
+	// Gas price is 0.01stake (1000stake for 100000 gas), contract flat fee is 50stake (the contract is not opted in for
+	// the on-success flat fees)
+	ctx := chain.GetContext()
+	gasPrice := sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdkMath.LegacyMustNewDecFromStr("0.01"))
+	params := keepers.RewardsKeeper.GetParams(ctx)
+	params.MinPriceOfGas = gasPrice
+	require.NoError(t, keepers.RewardsKeeper.Params.Set(ctx, params))
+	require.NoError(t, keepers.RewardsKeeper.MinConsFee.Set(ctx, rewardsTypes.MinConsensusFees{Fees: sdk.NewDecCoins(gasPrice)}))
+	require.NoError(t, keepers.RewardsKeeper.ContractMetadata.Set(ctx, contractAddr, rewardsTypes.ContractMetadata{
+		ContractAddress: contractAddr.String(),
+		OwnerAddress:    rewardsAddr.String(),
+		RewardsAddress:  rewardsAddr.String(),
+	}))
+	require.NoError(t, keepers.RewardsKeeper.FlatFees.Set(ctx, contractAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)))
+
+	feeCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1050))
+	require.NoError(t, keepers.BankKeeper.MintCoins(ctx, mintTypes.ModuleName, feeCoins))
+	require.NoError(t, keepers.BankKeeper.SendCoinsFromModuleToAccount(ctx, mintTypes.ModuleName, acc.Address, feeCoins))
+
+	tx := testutils.NewMockFeeTx(
+		testutils.WithMockFeeTxFees(feeCoins),
+		testutils.WithMockFeeTxGas(100_000),
+		testutils.WithMockFeeTxPayer(acc.Address),
+		testutils.WithMockFeeTxMsgs(&wasmTypes.MsgExecuteContract{
+			Sender:   acc.Address.String(),
+			Contract: contractAddr.String(),
+		}),
+	)
+	balanceBefore := keepers.BankKeeper.GetBalance(ctx, acc.Address, sdk.DefaultBondDenom)
+
+	anteHandler := sdk.ChainAnteDecorators(
+		ante.NewMinFeeDecorator(chain.GetAppCodec(), keepers.RewardsKeeper),
+		ante.NewDeductFeeDecorator(chain.GetAppCodec(), keepers.AccountKeeper, keepers.BankKeeper, keepers.FeeGrantKeeper, keepers.RewardsKeeper, keepers.CWFeesKeeper),
+	)
+	postHandler := post.NewDeferredFlatFeeDecorator(keepers.BankKeeper, keepers.RewardsKeeper)
+
+	// runTx runs the tx through the Ante and post handlers returning the fee payer total charge and the contract rewards
+	runTx := func(t *testing.T, refundOnFailure, success bool) (string, string) {
+		ctx, _ := ctx.CacheContext()
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		params := keepers.RewardsKeeper.GetParams(ctx)
+		params.FlatFeeRefundOnFailure = refundOnFailure
+		require.NoError(t, keepers.RewardsKeeper.Params.Set(ctx, params))
+
+		keepers.TrackingKeeper.TrackNewTx(ctx) // tracking Ante handler provides a unique tx ID
+		ctx, err := anteHandler(ctx, tx, false)
+		require.NoError(t, err)
+
+		_, err = postHandler.PostHandle(ctx, tx, false, success, noopPostHandler)
+		require.NoError(t, err)
+
+		rewards := sdk.NewCoins()
+		records, err := keepers.RewardsKeeper.GetRewardsRecordsByWithdrawAddress(ctx, rewardsAddr)
+		require.NoError(t, err)
+		for _, record := range records {
+			rewards = rewards.Add(record.Rewards...)
+		}
+
+		return balanceBefore.Sub(keepers.BankKeeper.GetBalance(ctx, acc.Address, sdk.DefaultBondDenom)).String(), rewards.String()
+	}
+
+	t.Run("Disabled: flat fee is kept for a reverted execution", func(t *testing.T) {
+		charged, rewards := runTx(t, false, false)
+		require.Equal(t, "1050stake", charged)
+		require.Equal(t, "50stake", rewards)
+	})
+
+	t.Run("Enabled: flat fee is refunded for a reverted execution", func(t *testing.T) {
+		charged, rewards := runTx(t, true, false)
+		require.Equal(t, "1000stake", charged)
+		require.Equal(t, "", rewards)
+	})
+
+	t.Run("Enabled: flat fee is charged for a succeeded execution", func(t *testing.T) {
+		charged, rewards := runTx(t, true, true)
+		require.Equal(t, "1050stake", charged)
+		require.Equal(t, "50stake", rewards)
+	})
+}
@@ -7,6 +7,7 @@ import (
 	cryptoCodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 )
 
 // RegisterLegacyAminoCodec registers the necessary interfaces and concrete types on the provided LegacyAmino codec.
@@ -16,6 +17,15 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgWithdrawRewards{}, "rewards/MsgWithdrawRewards", nil)
 	cdc.RegisterConcrete(&MsgSetFlatFee{}, "rewards/MsgSetFlatFee", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "rewards/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgSetRewardsRatios{}, "rewards/MsgSetRewardsRatios", nil)
+	cdc.RegisterConcrete(&MsgRemoveContractMetadata{}, "rewards/MsgRemoveContractMetadata", nil)
+	cdc.RegisterConcrete(&MsgSetFlatFeeByCodeID{}, "rewards/MsgSetFlatFeeByCodeID", nil)
+	cdc.RegisterConcrete(&MsgRebuildRewardsIndexes{}, "rewards/MsgRebuildRewardsIndexes", nil)
+	cdc.RegisterConcrete(&MsgRecoverContractRewards{}, "rewards/MsgRecoverContractRewards", nil)
+	cdc.RegisterConcrete(&MsgPrepayFlatFee{}, "rewards/MsgPrepayFlatFee", nil)
+	cdc.RegisterConcrete(&MsgSetFlatFeeOverride{}, "rewards/MsgSetFlatFeeOverride", nil)
+	cdc.RegisterConcrete(&MsgTransferContractOwnership{}, "rewards/MsgTransferContractOwnership", nil)
+	cdc.RegisterConcrete(&MsgResetLifetimeRewards{}, "rewards/MsgResetLifetimeRewards", nil)
 }
 
 // RegisterInterfaces registers interfaces types with the interface registry.
@@ -25,6 +35,20 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgWithdrawRewards{},
 		&MsgSetFlatFee{},
 		&MsgUpdateParams{},
+		&MsgSetRewardsRatios{},
+		&MsgRemoveContractMetadata{},
+		&MsgSetFlatFeeByCodeID{},
+		&MsgRebuildRewardsIndexes{},
+		&MsgRecoverContractRewards{},
+		&MsgPrepayFlatFee{},
+		&MsgSetFlatFeeOverride{},
+		&MsgTransferContractOwnership{},
+		&MsgResetLifetimeRewards{},
+	)
+
+	registry.RegisterImplementations((*tx.TxExtensionOptionI)(nil),
+		&ExtensionOptionDynamicFee{},
+		&ExtensionOptionFlatFeeTip{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,55 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/archway-network/archway/pkg"
+)
+
+type dynamicFeeRefundCtxKey struct{}
+
+// DynamicFeeRefund defines the transaction gas fees withheld until the end of the tx execution (dynamic fee mode only).
+// The effective gas price is estimated by the Ante handler, gas fees are charged and the surplus is refunded by the Post handler.
+// Contract flat fees are a fixed charge: they are never withheld and never refunded.
+type DynamicFeeRefund struct {
+	// FeePayer is an account the refund is sent to (set once fees are deducted).
+	FeePayer sdk.AccAddress
+	// FeeRebateEligible is true if the charged gas fees are split between the dApp rewards and burnt fees (set once fees are deducted).
+	FeeRebateEligible bool
+	// GasLimit is the tx gas limit.
+	GasLimit uint64
+	// GasPrice is the effective gas price gas usage is charged with.
+	GasPrice sdk.DecCoin
+	// GasFees is the withheld gas fees amount (tx fees excluding contract flat fees).
+	GasFees sdk.Coins
+}
+
+// Settle returns the gas fees to charge for the given gas usage and the rest of the withheld gas fees to be refunded.
+// Gas usage is capped by the tx gas limit, charged fees are capped by the withheld gas fees.
+func (r DynamicFeeRefund) Settle(gasUsed uint64) (charged, refund sdk.Coins) {
+	if gasUsed > r.GasLimit {
+		gasUsed = r.GasLimit
+	}
+
+	denom := r.GasPrice.Denom
+	chargedAmt := r.GasPrice.Amount.Mul(pkg.NewDecFromUint64(gasUsed)).Ceil().TruncateInt()
+	if withheldAmt := r.GasFees.AmountOf(denom); chargedAmt.GT(withheldAmt) {
+		chargedAmt = withheldAmt
+	}
+
+	charged = sdk.NewCoins(sdk.NewCoin(denom, chargedAmt))
+	refund = r.GasFees.Sub(charged...)
+
+	return charged, refund
+}
+
+// WithDynamicFeeRefund returns a new context with the dynamic fee refund set.
+func WithDynamicFeeRefund(ctx sdk.Context, refund DynamicFeeRefund) sdk.Context {
+	return ctx.WithValue(dynamicFeeRefundCtxKey{}, refund)
+}
+
+// GetDynamicFeeRefund returns the dynamic fee refund from the context if set.
+func GetDynamicFeeRefund(ctx sdk.Context) (DynamicFeeRefund, bool) {
+	refund, ok := ctx.Value(dynamicFeeRefundCtxKey{}).(DynamicFeeRefund)
+	return refund, ok
+}
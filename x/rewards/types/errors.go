@@ -3,11 +3,16 @@ package types
 import errorsmod "cosmossdk.io/errors"
 
 var (
-	DefaultCodespace           = ModuleName
-	ErrInternal                = errorsmod.Register(DefaultCodespace, 2, "internal error")         // internal error
-	ErrContractNotFound        = errorsmod.Register(DefaultCodespace, 3, "contract not found")     // contract info not found
-	ErrMetadataNotFound        = errorsmod.Register(DefaultCodespace, 4, "metadata not found")     // contract metadata not found
-	ErrUnauthorized            = errorsmod.Register(DefaultCodespace, 5, "unauthorized operation") // contract ownership issue
-	ErrInvalidRequest          = errorsmod.Register(DefaultCodespace, 6, "invalid request")        // request parsing issue
-	ErrContractFlatFeeNotFound = errorsmod.Register(DefaultCodespace, 7, "flatfee not found")      // contract flatfee not found
+	DefaultCodespace                   = ModuleName
+	ErrInternal                        = errorsmod.Register(DefaultCodespace, 2, "internal error")                                // internal error
+	ErrContractNotFound                = errorsmod.Register(DefaultCodespace, 3, "contract not found")                            // contract info not found
+	ErrMetadataNotFound                = errorsmod.Register(DefaultCodespace, 4, "metadata not found")                            // contract metadata not found
+	ErrUnauthorized                    = errorsmod.Register(DefaultCodespace, 5, "unauthorized operation")                        // contract ownership issue
+	ErrInvalidRequest                  = errorsmod.Register(DefaultCodespace, 6, "invalid request")                               // request parsing issue
+	ErrContractFlatFeeNotFound         = errorsmod.Register(DefaultCodespace, 7, "flatfee not found")                             // contract flatfee not found
+	ErrFlatFeeUpdateTooSoon            = errorsmod.Register(DefaultCodespace, 8, "flatfee update too soon")                       // contract flatfee rate-limit
+	ErrMinConsFeeNotFound              = errorsmod.Register(DefaultCodespace, 9, "min consensus fee not found")                   // min consensus fee not set for the denom
+	ErrFlatFeeNotContract              = errorsmod.Register(DefaultCodespace, 10, "flatfee address is not a contract")            // flatfee target is not a known wasm contract
+	ErrIrrelevantFeeDenom              = errorsmod.Register(DefaultCodespace, 11, "irrelevant fee denom")                         // tx fee denoms match none of the min fee denoms
+	ErrRewardsCallbacksBudgetExhausted = errorsmod.Register(DefaultCodespace, 12, "rewards callbacks block gas budget exhausted") // block rewards callbacks gas budget is used up
 )
@@ -48,12 +48,138 @@ func EmitMinConsensusFeeSetEvent(ctx sdk.Context, fee sdk.DecCoin) {
 	}
 }
 
-func EmitContractFlatFeeSetEvent(ctx sdk.Context, contractAddress sdk.AccAddress, fee sdk.Coin) {
+func EmitContractFlatFeeSetEvent(ctx sdk.Context, contractAddress sdk.AccAddress, fee sdk.Coin, method string) {
 	err := ctx.EventManager().EmitTypedEvent(&ContractFlatFeeSetEvent{
 		ContractAddress: contractAddress.String(),
 		FlatFee:         fee,
+		Method:          method,
 	})
 	if err != nil {
 		panic(fmt.Errorf("sending ContractFlatFeeSetEvent event: %w", err))
 	}
 }
+
+func EmitTxFeesEstimateEvent(ctx sdk.Context, gasFees, flatFees sdk.Coins) {
+	err := ctx.EventManager().EmitTypedEvent(&TxFeesEstimateEvent{
+		GasFees:  gasFees,
+		FlatFees: flatFees,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending TxFeesEstimateEvent event: %w", err))
+	}
+}
+
+func EmitDynamicFeeRefundEvent(ctx sdk.Context, feePayer sdk.AccAddress, refund sdk.Coins) {
+	err := ctx.EventManager().EmitTypedEvent(&DynamicFeeRefundEvent{
+		FeePayer: feePayer.String(),
+		Refund:   refund,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending DynamicFeeRefundEvent event: %w", err))
+	}
+}
+
+func EmitContractMetadataRemovedEvent(ctx sdk.Context, contractAddr, sweepAddr sdk.AccAddress, sweptRewards sdk.Coins) {
+	event := &ContractMetadataRemovedEvent{
+		ContractAddress: contractAddr.String(),
+		SweptRewards:    sweptRewards,
+	}
+	if sweepAddr != nil {
+		event.RewardsSweepAddress = sweepAddr.String()
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		panic(fmt.Errorf("sending ContractMetadataRemovedEvent event: %w", err))
+	}
+}
+
+func EmitContractFlatFeeChargedEvent(ctx sdk.Context, msgIndex int, contractAddress sdk.AccAddress, flatFees sdk.Coins) {
+	err := ctx.EventManager().EmitTypedEvent(&ContractFlatFeeChargedEvent{
+		MsgIndex:        uint32(msgIndex),
+		ContractAddress: contractAddress.String(),
+		FlatFees:        flatFees,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending ContractFlatFeeChargedEvent event: %w", err))
+	}
+}
+
+func EmitContractFlatFeeTipEvent(ctx sdk.Context, contractAddress, feePayer sdk.AccAddress, tip sdk.Coins) {
+	err := ctx.EventManager().EmitTypedEvent(&ContractFlatFeeTipEvent{
+		ContractAddress: contractAddress.String(),
+		FeePayer:        feePayer.String(),
+		Tip:             tip,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending ContractFlatFeeTipEvent event: %w", err))
+	}
+}
+
+func EmitRewardsDistributionScaledEvent(ctx sdk.Context, height int64, plannedRewards sdk.DecCoins, availableRewards sdk.Coins) {
+	err := ctx.EventManager().EmitTypedEvent(&RewardsDistributionScaledEvent{
+		Height:           height,
+		PlannedRewards:   plannedRewards,
+		AvailableRewards: availableRewards,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending RewardsDistributionScaledEvent event: %w", err))
+	}
+}
+
+func EmitContractRewardsRecoveredEvent(ctx sdk.Context, contractAddr, recoveryAddr sdk.AccAddress, recoveredRewards sdk.Coins) {
+	err := ctx.EventManager().EmitTypedEvent(&ContractRewardsRecoveredEvent{
+		ContractAddress:  contractAddr.String(),
+		RecoveryAddress:  recoveryAddr.String(),
+		RecoveredRewards: recoveredRewards,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending ContractRewardsRecoveredEvent event: %w", err))
+	}
+}
+
+func EmitContractFlatFeePrepaidEvent(ctx sdk.Context, contractAddr sdk.AccAddress, executions uint64, paidFees sdk.Coins, credits uint64) {
+	err := ctx.EventManager().EmitTypedEvent(&ContractFlatFeePrepaidEvent{
+		ContractAddress: contractAddr.String(),
+		Executions:      executions,
+		PaidFees:        paidFees,
+		Credits:         credits,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending ContractFlatFeePrepaidEvent event: %w", err))
+	}
+}
+
+func EmitContractFlatFeeOverrideSetEvent(ctx sdk.Context, contractAddr sdk.AccAddress, flatFee sdk.Coin, expiryHeight int64) {
+	err := ctx.EventManager().EmitTypedEvent(&ContractFlatFeeOverrideSetEvent{
+		ContractAddress: contractAddr.String(),
+		FlatFee:         flatFee,
+		ExpiryHeight:    expiryHeight,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending ContractFlatFeeOverrideSetEvent event: %w", err))
+	}
+}
+
+func EmitContractLifetimeRewardsResetEvent(ctx sdk.Context, contractAddr, senderAddr sdk.AccAddress, resetRewards sdk.Coins) {
+	err := ctx.EventManager().EmitTypedEvent(&ContractLifetimeRewardsResetEvent{
+		ContractAddress: contractAddr.String(),
+		SenderAddress:   senderAddr.String(),
+		ResetRewards:    resetRewards,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending ContractLifetimeRewardsResetEvent event: %w", err))
+	}
+}
+
+func EmitContractRewardsCallbackEvent(ctx sdk.Context, contractAddr, callbackAddr sdk.AccAddress, rewards sdk.Coins, gasUsed uint64, errMsg string) {
+	err := ctx.EventManager().EmitTypedEvent(&ContractRewardsCallbackEvent{
+		ContractAddress: contractAddr.String(),
+		CallbackAddress: callbackAddr.String(),
+		Rewards:         rewards,
+		GasUsed:         gasUsed,
+		Error:           errMsg,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending ContractRewardsCallbackEvent event: %w", err))
+	}
+}
@@ -276,6 +276,9 @@ type ContractFlatFeeSetEvent struct {
 	// flat_fee defines the amount that has been set as the minimum fee for the
 	// contract
 	FlatFee types.Coin `protobuf:"bytes,2,opt,name=flat_fee,json=flatFee,proto3" json:"flat_fee"`
+	// method defines the execute msg method name the flat fee is set for (empty
+	// for the contract-wide flat fee)
+	Method string `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
 }
 
 func (m *ContractFlatFeeSetEvent) Reset()         { *m = ContractFlatFeeSetEvent{} }
@@ -325,385 +328,3630 @@ func (m *ContractFlatFeeSetEvent) GetFlatFee() types.Coin {
 	return types.Coin{}
 }
 
-func init() {
-	proto.RegisterType((*ContractMetadataSetEvent)(nil), "archway.rewards.v1.ContractMetadataSetEvent")
-	proto.RegisterType((*ContractRewardCalculationEvent)(nil), "archway.rewards.v1.ContractRewardCalculationEvent")
-	proto.RegisterType((*RewardsWithdrawEvent)(nil), "archway.rewards.v1.RewardsWithdrawEvent")
-	proto.RegisterType((*MinConsensusFeeSetEvent)(nil), "archway.rewards.v1.MinConsensusFeeSetEvent")
-	proto.RegisterType((*ContractFlatFeeSetEvent)(nil), "archway.rewards.v1.ContractFlatFeeSetEvent")
+func (m *ContractFlatFeeSetEvent) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("archway/rewards/v1/events.proto", fileDescriptor_54ce1d144a852005) }
-
-var fileDescriptor_54ce1d144a852005 = []byte{
-	// 489 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcf, 0x6f, 0xd3, 0x30,
-	0x14, 0x6e, 0xda, 0xc2, 0x86, 0xcb, 0x8f, 0x11, 0x4d, 0x5a, 0x99, 0x50, 0x56, 0x2a, 0x90, 0xc6,
-	0x01, 0x5b, 0x2d, 0x5c, 0xe0, 0x04, 0x2b, 0xf4, 0xb4, 0x0a, 0x29, 0x1c, 0x90, 0xb8, 0x44, 0x8e,
-	0xf3, 0x92, 0x46, 0xb4, 0xf6, 0x64, 0xbb, 0xed, 0x76, 0xe3, 0x1f, 0x40, 0xe2, 0xcf, 0xda, 0x05,
-	0x69, 0x47, 0x4e, 0x08, 0xb5, 0xff, 0x08, 0x4a, 0x6c, 0x47, 0xd5, 0xb6, 0x43, 0x7b, 0x4b, 0x9e,
-	0xbf, 0xf7, 0xbd, 0xef, 0xfb, 0x9e, 0x8d, 0x8e, 0xa8, 0x64, 0xe3, 0x05, 0xbd, 0x20, 0x12, 0x16,
-	0x54, 0x26, 0x8a, 0xcc, 0x7b, 0x04, 0xe6, 0xc0, 0xb5, 0xc2, 0x67, 0x52, 0x68, 0xe1, 0xfb, 0x16,
-	0x80, 0x2d, 0x00, 0xcf, 0x7b, 0x87, 0xfb, 0x99, 0xc8, 0x44, 0x79, 0x4c, 0x8a, 0x2f, 0x83, 0x3c,
-	0x0c, 0x98, 0x50, 0x53, 0xa1, 0x48, 0x4c, 0x15, 0x90, 0x79, 0x2f, 0x06, 0x4d, 0x7b, 0x84, 0x89,
-	0x9c, 0xdb, 0xf3, 0xce, 0x2d, 0xa3, 0x1c, 0x69, 0x89, 0xe8, 0xfe, 0xf4, 0x50, 0x7b, 0x20, 0xb8,
-	0x96, 0x94, 0xe9, 0x11, 0x68, 0x9a, 0x50, 0x4d, 0xbf, 0x80, 0xfe, 0x54, 0xe8, 0xf1, 0x5f, 0xa2,
-	0x3d, 0x66, 0xcf, 0x22, 0x9a, 0x24, 0x12, 0x94, 0x6a, 0x7b, 0x1d, 0xef, 0xf8, 0x5e, 0xf8, 0xc8,
-	0xd5, 0x3f, 0x98, 0xb2, 0x3f, 0x44, 0xbb, 0x53, 0xdb, 0xde, 0xae, 0x77, 0xbc, 0xe3, 0x56, 0xff,
-	0x39, 0xbe, 0x69, 0x03, 0x5f, 0x1f, 0x75, 0xd2, 0xbc, 0xfc, 0x7b, 0x54, 0x0b, 0xab, 0xde, 0xee,
-	0xef, 0x3a, 0x0a, 0x1c, 0x28, 0x2c, 0xfb, 0x06, 0x74, 0xc2, 0x66, 0x13, 0xaa, 0x73, 0xc1, 0xb7,
-	0x56, 0xf5, 0x0c, 0xdd, 0xcf, 0xa8, 0x8a, 0x98, 0xe0, 0x6a, 0x36, 0x85, 0xa4, 0x54, 0xd6, 0x0c,
-	0x5b, 0x19, 0x55, 0x03, 0x5b, 0xf2, 0x4f, 0xd1, 0xe3, 0x9c, 0xa7, 0x86, 0x3f, 0xb2, 0x4a, 0xdb,
-	0x8d, 0xd2, 0xc1, 0x13, 0x6c, 0xe2, 0xc5, 0x45, 0xbc, 0xd8, 0xc6, 0x8b, 0x07, 0x22, 0xe7, 0x56,
-	0xf6, 0x5e, 0xd5, 0x69, 0xa4, 0x2a, 0x7f, 0x84, 0xfc, 0x14, 0x20, 0x92, 0x10, 0x53, 0x0d, 0x15,
-	0x5d, 0xb3, 0xd3, 0xd8, 0x88, 0x2e, 0x05, 0x08, 0xcb, 0x4e, 0x47, 0xf7, 0x7e, 0x2d, 0xd5, 0x3b,
-	0x9b, 0xa7, 0xba, 0x96, 0xe7, 0x39, 0xda, 0xb7, 0x64, 0x5f, 0x73, 0x3d, 0x4e, 0x24, 0x5d, 0x98,
-	0x10, 0x5f, 0xa0, 0x87, 0x86, 0xe0, 0x5a, 0x84, 0x0f, 0x4c, 0xd5, 0x05, 0xf8, 0x16, 0xed, 0x38,
-	0x13, 0xf5, 0xcd, 0x4c, 0x38, 0x7c, 0xf7, 0x33, 0x3a, 0x18, 0xe5, 0xbc, 0xc8, 0x19, 0xb8, 0x9a,
-	0xa9, 0x21, 0x40, 0x75, 0xaf, 0xde, 0xa0, 0x46, 0x0a, 0x50, 0x4e, 0x6c, 0xf5, 0x9f, 0xde, 0xca,
-	0xf8, 0x11, 0xd8, 0x1a, 0x69, 0x01, 0xef, 0xfe, 0xf0, 0xd0, 0x81, 0x73, 0x3a, 0x9c, 0x50, 0xbd,
-	0xce, 0xb8, 0xc5, 0x9d, 0x78, 0x87, 0x76, 0x8b, 0xa5, 0x45, 0x85, 0x82, 0xfa, 0x66, 0x7b, 0xde,
-	0x49, 0xcd, 0xb8, 0x93, 0xd3, 0xcb, 0x65, 0xe0, 0x5d, 0x2d, 0x03, 0xef, 0xdf, 0x32, 0xf0, 0x7e,
-	0xad, 0x82, 0xda, 0xd5, 0x2a, 0xa8, 0xfd, 0x59, 0x05, 0xb5, 0x6f, 0xfd, 0x2c, 0xd7, 0xe3, 0x59,
-	0x8c, 0x99, 0x98, 0x12, 0xbb, 0xa1, 0x57, 0x1c, 0xf4, 0x42, 0xc8, 0xef, 0xee, 0x9f, 0x9c, 0x57,
-	0xcf, 0x50, 0x5f, 0x9c, 0x81, 0x8a, 0xef, 0x96, 0x4f, 0xf0, 0xf5, 0xff, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xe5, 0xe9, 0xfa, 0x0d, 0x11, 0x04, 0x00, 0x00,
+// TxFeesEstimateEvent is emitted by the MinFeeDecorator in the simulation mode
+// (and in CheckTx if enabled) to report the minimum fees required for the
+// transaction.
+type TxFeesEstimateEvent struct {
+	// gas_fees defines the minimum fees based on the transaction gas limit.
+	GasFees []types.Coin `protobuf:"bytes,1,rep,name=gas_fees,json=gasFees,proto3" json:"gas_fees"`
+	// flat_fees defines the total contract flat fees required by the
+	// transaction messages.
+	FlatFees []types.Coin `protobuf:"bytes,2,rep,name=flat_fees,json=flatFees,proto3" json:"flat_fees"`
 }
 
-func (m *ContractMetadataSetEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *TxFeesEstimateEvent) Reset()         { *m = TxFeesEstimateEvent{} }
+func (m *TxFeesEstimateEvent) String() string { return proto.CompactTextString(m) }
+func (*TxFeesEstimateEvent) ProtoMessage()    {}
+func (*TxFeesEstimateEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54ce1d144a852005, []int{5}
 }
-
-func (m *ContractMetadataSetEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TxFeesEstimateEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *ContractMetadataSetEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+func (m *TxFeesEstimateEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxFeesEstimateEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *TxFeesEstimateEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxFeesEstimateEvent.Merge(m, src)
+}
+func (m *TxFeesEstimateEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxFeesEstimateEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxFeesEstimateEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxFeesEstimateEvent proto.InternalMessageInfo
+
+func (m *TxFeesEstimateEvent) GetGasFees() []types.Coin {
+	if m != nil {
+		return m.GasFees
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ContractRewardCalculationEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TxFeesEstimateEvent) GetFlatFees() []types.Coin {
+	if m != nil {
+		return m.FlatFees
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ContractRewardCalculationEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DynamicFeeRefundEvent is emitted when the dynamic fee surplus is refunded to
+// the fee payer after the transaction execution.
+type DynamicFeeRefundEvent struct {
+	// fee_payer is the address the refund is sent to (bech32 encoded).
+	FeePayer string `protobuf:"bytes,1,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	// refund defines the refunded fees.
+	Refund []types.Coin `protobuf:"bytes,2,rep,name=refund,proto3" json:"refund"`
 }
 
-func (m *ContractRewardCalculationEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.FeeRebateRewards) > 0 {
-		for iNdEx := len(m.FeeRebateRewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FeeRebateRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	{
-		size, err := m.InflationRewards.MarshalToSizedBuffer(dAtA[:i])
+func (m *DynamicFeeRefundEvent) Reset()         { *m = DynamicFeeRefundEvent{} }
+func (m *DynamicFeeRefundEvent) String() string { return proto.CompactTextString(m) }
+func (*DynamicFeeRefundEvent) ProtoMessage()    {}
+func (*DynamicFeeRefundEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54ce1d144a852005, []int{6}
+}
+func (m *DynamicFeeRefundEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicFeeRefundEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicFeeRefundEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.GasConsumed != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.GasConsumed))
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *DynamicFeeRefundEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicFeeRefundEvent.Merge(m, src)
+}
+func (m *DynamicFeeRefundEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicFeeRefundEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicFeeRefundEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicFeeRefundEvent proto.InternalMessageInfo
+
+func (m *DynamicFeeRefundEvent) GetFeePayer() string {
+	if m != nil {
+		return m.FeePayer
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *RewardsWithdrawEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DynamicFeeRefundEvent) GetRefund() []types.Coin {
+	if m != nil {
+		return m.Refund
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *RewardsWithdrawEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ContractMetadataRemovedEvent is emitted when the contract metadata is
+// removed.
+type ContractMetadataRemovedEvent struct {
+	// contract_address defines the contract address.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// rewards_sweep_address defines the address outstanding rewards are sent to
+	// (empty if rewards are not swept).
+	RewardsSweepAddress string `protobuf:"bytes,2,opt,name=rewards_sweep_address,json=rewardsSweepAddress,proto3" json:"rewards_sweep_address,omitempty"`
+	// swept_rewards defines the outstanding rewards transferred.
+	SweptRewards []types.Coin `protobuf:"bytes,3,rep,name=swept_rewards,json=sweptRewards,proto3" json:"swept_rewards"`
 }
 
-func (m *RewardsWithdrawEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *ContractMetadataRemovedEvent) Reset()         { *m = ContractMetadataRemovedEvent{} }
+func (m *ContractMetadataRemovedEvent) String() string { return proto.CompactTextString(m) }
+func (*ContractMetadataRemovedEvent) ProtoMessage()    {}
+func (*ContractMetadataRemovedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54ce1d144a852005, []int{7}
+}
+func (m *ContractMetadataRemovedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractMetadataRemovedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractMetadataRemovedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.RewardAddress) > 0 {
-		i -= len(m.RewardAddress)
-		copy(dAtA[i:], m.RewardAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.RewardAddress)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ContractMetadataRemovedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractMetadataRemovedEvent.Merge(m, src)
+}
+func (m *ContractMetadataRemovedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractMetadataRemovedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractMetadataRemovedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractMetadataRemovedEvent proto.InternalMessageInfo
+
+func (m *ContractMetadataRemovedEvent) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MinConsensusFeeSetEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ContractMetadataRemovedEvent) GetRewardsSweepAddress() string {
+	if m != nil {
+		return m.RewardsSweepAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MinConsensusFeeSetEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ContractMetadataRemovedEvent) GetSweptRewards() []types.Coin {
+	if m != nil {
+		return m.SweptRewards
+	}
+	return nil
 }
 
-func (m *MinConsensusFeeSetEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+// ContractFlatFeeChargedEvent is emitted by the MinFeeDecorator for every
+// transaction message charged a contract flat fee (per-message attribution).
+type ContractFlatFeeChargedEvent struct {
+	// msg_index defines the index of the transaction message the flat fee is
+	// charged for (authz.MsgExec wrapped messages share the MsgExec index).
+	MsgIndex uint32 `protobuf:"varint,1,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty"`
+	// contract_address defines the bech32 address of the contract the flat fee
+	// is charged for.
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// flat_fees defines the charged contract flat fees.
+	FlatFees []types.Coin `protobuf:"bytes,3,rep,name=flat_fees,json=flatFees,proto3" json:"flat_fees"`
+}
+
+func (m *ContractFlatFeeChargedEvent) Reset()         { *m = ContractFlatFeeChargedEvent{} }
+func (m *ContractFlatFeeChargedEvent) String() string { return proto.CompactTextString(m) }
+func (*ContractFlatFeeChargedEvent) ProtoMessage()    {}
+func (*ContractFlatFeeChargedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54ce1d144a852005, []int{8}
+}
+func (m *ContractFlatFeeChargedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractFlatFeeChargedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractFlatFeeChargedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *ContractFlatFeeSetEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ContractFlatFeeChargedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractFlatFeeChargedEvent.Merge(m, src)
+}
+func (m *ContractFlatFeeChargedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractFlatFeeChargedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractFlatFeeChargedEvent.DiscardUnknown(m)
 }
 
-func (m *ContractFlatFeeSetEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ContractFlatFeeChargedEvent proto.InternalMessageInfo
+
+func (m *ContractFlatFeeChargedEvent) GetMsgIndex() uint32 {
+	if m != nil {
+		return m.MsgIndex
+	}
+	return 0
 }
 
-func (m *ContractFlatFeeSetEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.FlatFee.MarshalToSizedBuffer(dAtA[:i])
+func (m *ContractFlatFeeChargedEvent) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *ContractFlatFeeChargedEvent) GetFlatFees() []types.Coin {
+	if m != nil {
+		return m.FlatFees
+	}
+	return nil
+}
+
+// ContractFlatFeeTipEvent is emitted when a transaction pays a contract on top
+// of its flat fee (the ExtensionOptionFlatFeeTip tx extension option).
+type ContractFlatFeeTipEvent struct {
+	// contract_address defines the bech32 address of the tipped contract.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// fee_payer defines the bech32 address of the transaction fee payer.
+	FeePayer string `protobuf:"bytes,2,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	// tip defines the paid tip.
+	Tip []types.Coin `protobuf:"bytes,3,rep,name=tip,proto3" json:"tip"`
+}
+
+func (m *ContractFlatFeeTipEvent) Reset()         { *m = ContractFlatFeeTipEvent{} }
+func (m *ContractFlatFeeTipEvent) String() string { return proto.CompactTextString(m) }
+func (*ContractFlatFeeTipEvent) ProtoMessage()    {}
+func (*ContractFlatFeeTipEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54ce1d144a852005, []int{9}
+}
+func (m *ContractFlatFeeTipEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractFlatFeeTipEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractFlatFeeTipEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ContractFlatFeeTipEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractFlatFeeTipEvent.Merge(m, src)
+}
+func (m *ContractFlatFeeTipEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractFlatFeeTipEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractFlatFeeTipEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractFlatFeeTipEvent proto.InternalMessageInfo
+
+func (m *ContractFlatFeeTipEvent) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ContractFlatFeeTipEvent) GetFeePayer() string {
+	if m != nil {
+		return m.FeePayer
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *ContractMetadataSetEvent) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *ContractFlatFeeTipEvent) GetTip() []types.Coin {
+	if m != nil {
+		return m.Tip
 	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return nil
+}
+
+// ContractRewardsRecoveredEvent is emitted when the contract outstanding
+// rewards are recovered by governance.
+type ContractRewardsRecoveredEvent struct {
+	// contract_address defines the contract address.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// recovery_address defines the address the rewards are sent to.
+	RecoveryAddress string `protobuf:"bytes,2,opt,name=recovery_address,json=recoveryAddress,proto3" json:"recovery_address,omitempty"`
+	// recovered_rewards defines the outstanding and pending rewards transferred.
+	RecoveredRewards []types.Coin `protobuf:"bytes,3,rep,name=recovered_rewards,json=recoveredRewards,proto3" json:"recovered_rewards"`
+}
+
+func (m *ContractRewardsRecoveredEvent) Reset()         { *m = ContractRewardsRecoveredEvent{} }
+func (m *ContractRewardsRecoveredEvent) String() string { return proto.CompactTextString(m) }
+func (*ContractRewardsRecoveredEvent) ProtoMessage()    {}
+func (*ContractRewardsRecoveredEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54ce1d144a852005, []int{10}
+}
+func (m *ContractRewardsRecoveredEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractRewardsRecoveredEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractRewardsRecoveredEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Metadata.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+}
+func (m *ContractRewardsRecoveredEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractRewardsRecoveredEvent.Merge(m, src)
+}
+func (m *ContractRewardsRecoveredEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractRewardsRecoveredEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractRewardsRecoveredEvent.DiscardUnknown(m)
 }
 
-func (m *ContractRewardCalculationEvent) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_ContractRewardsRecoveredEvent proto.InternalMessageInfo
+
+func (m *ContractRewardsRecoveredEvent) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return ""
+}
+
+func (m *ContractRewardsRecoveredEvent) GetRecoveryAddress() string {
+	if m != nil {
+		return m.RecoveryAddress
 	}
-	if m.GasConsumed != 0 {
-		n += 1 + sovEvents(uint64(m.GasConsumed))
+	return ""
+}
+
+func (m *ContractRewardsRecoveredEvent) GetRecoveredRewards() []types.Coin {
+	if m != nil {
+		return m.RecoveredRewards
 	}
-	l = m.InflationRewards.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if len(m.FeeRebateRewards) > 0 {
-		for _, e := range m.FeeRebateRewards {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	return nil
+}
+
+// ContractFlatFeePrepaidEvent is emitted when the contract owner prepays the
+// contract executions.
+type ContractFlatFeePrepaidEvent struct {
+	// contract_address defines the contract address.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// executions defines the number of executions prepaid.
+	Executions uint64 `protobuf:"varint,2,opt,name=executions,proto3" json:"executions,omitempty"`
+	// paid_fees defines the discounted flat fees paid for the executions.
+	PaidFees []types.Coin `protobuf:"bytes,3,rep,name=paid_fees,json=paidFees,proto3" json:"paid_fees"`
+	// credits defines the total number of prepaid executions left.
+	Credits uint64 `protobuf:"varint,4,opt,name=credits,proto3" json:"credits,omitempty"`
+}
+
+func (m *ContractFlatFeePrepaidEvent) Reset()         { *m = ContractFlatFeePrepaidEvent{} }
+func (m *ContractFlatFeePrepaidEvent) String() string { return proto.CompactTextString(m) }
+func (*ContractFlatFeePrepaidEvent) ProtoMessage()    {}
+func (*ContractFlatFeePrepaidEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54ce1d144a852005, []int{11}
+}
+func (m *ContractFlatFeePrepaidEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractFlatFeePrepaidEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractFlatFeePrepaidEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractFlatFeePrepaidEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractFlatFeePrepaidEvent.Merge(m, src)
+}
+func (m *ContractFlatFeePrepaidEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractFlatFeePrepaidEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractFlatFeePrepaidEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractFlatFeePrepaidEvent proto.InternalMessageInfo
+
+func (m *ContractFlatFeePrepaidEvent) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *ContractFlatFeePrepaidEvent) GetExecutions() uint64 {
+	if m != nil {
+		return m.Executions
+	}
+	return 0
+}
+
+func (m *ContractFlatFeePrepaidEvent) GetPaidFees() []types.Coin {
+	if m != nil {
+		return m.PaidFees
+	}
+	return nil
+}
+
+func (m *ContractFlatFeePrepaidEvent) GetCredits() uint64 {
+	if m != nil {
+		return m.Credits
+	}
+	return 0
+}
+
+// ContractFlatFeeOverrideSetEvent is emitted when the contract flat fee
+// override is set by governance.
+type ContractFlatFeeOverrideSetEvent struct {
+	// contract_address defines the contract address.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// flat_fee defines the flat fee charged instead of the contract owner flat
+	// fees.
+	FlatFee types.Coin `protobuf:"bytes,2,opt,name=flat_fee,json=flatFee,proto3" json:"flat_fee"`
+	// expiry_height defines the block height the override stops applying at.
+	ExpiryHeight int64 `protobuf:"varint,3,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (m *ContractFlatFeeOverrideSetEvent) Reset()         { *m = ContractFlatFeeOverrideSetEvent{} }
+func (m *ContractFlatFeeOverrideSetEvent) String() string { return proto.CompactTextString(m) }
+func (*ContractFlatFeeOverrideSetEvent) ProtoMessage()    {}
+func (*ContractFlatFeeOverrideSetEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54ce1d144a852005, []int{12}
+}
+func (m *ContractFlatFeeOverrideSetEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractFlatFeeOverrideSetEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractFlatFeeOverrideSetEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractFlatFeeOverrideSetEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractFlatFeeOverrideSetEvent.Merge(m, src)
+}
+func (m *ContractFlatFeeOverrideSetEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractFlatFeeOverrideSetEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractFlatFeeOverrideSetEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractFlatFeeOverrideSetEvent proto.InternalMessageInfo
+
+func (m *ContractFlatFeeOverrideSetEvent) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *ContractFlatFeeOverrideSetEvent) GetFlatFee() types.Coin {
+	if m != nil {
+		return m.FlatFee
+	}
+	return types.Coin{}
+}
+
+func (m *ContractFlatFeeOverrideSetEvent) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+// RewardsDistributionScaledEvent is emitted when the block rewards
+// distribution is scaled down since the rewards pool can not cover it.
+type RewardsDistributionScaledEvent struct {
+	// height defines the block height the rewards are distributed for.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// planned_rewards defines the contract rewards planned for the
+	// distribution (the scaled denoms only).
+	PlannedRewards []types.DecCoin `protobuf:"bytes,2,rep,name=planned_rewards,json=plannedRewards,proto3" json:"planned_rewards"`
+	// available_rewards defines the rewards pool tokens available for the
+	// distribution (the scaled denoms only).
+	AvailableRewards []types.Coin `protobuf:"bytes,3,rep,name=available_rewards,json=availableRewards,proto3" json:"available_rewards"`
+}
+
+func (m *RewardsDistributionScaledEvent) Reset()         { *m = RewardsDistributionScaledEvent{} }
+func (m *RewardsDistributionScaledEvent) String() string { return proto.CompactTextString(m) }
+func (*RewardsDistributionScaledEvent) ProtoMessage()    {}
+func (*RewardsDistributionScaledEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54ce1d144a852005, []int{13}
+}
+func (m *RewardsDistributionScaledEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardsDistributionScaledEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardsDistributionScaledEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardsDistributionScaledEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardsDistributionScaledEvent.Merge(m, src)
+}
+func (m *RewardsDistributionScaledEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardsDistributionScaledEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardsDistributionScaledEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardsDistributionScaledEvent proto.InternalMessageInfo
+
+func (m *RewardsDistributionScaledEvent) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *RewardsDistributionScaledEvent) GetPlannedRewards() []types.DecCoin {
+	if m != nil {
+		return m.PlannedRewards
+	}
+	return nil
+}
+
+func (m *RewardsDistributionScaledEvent) GetAvailableRewards() []types.Coin {
+	if m != nil {
+		return m.AvailableRewards
+	}
+	return nil
+}
+
+// ContractLifetimeRewardsResetEvent is emitted when the contract lifetime
+// rewards counter is reset.
+type ContractLifetimeRewardsResetEvent struct {
+	// contract_address defines the contract address.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// sender_address defines the address which reset the counter (the contract
+	// owner or the module authority).
+	SenderAddress string `protobuf:"bytes,2,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	// reset_rewards defines the lifetime rewards before the reset.
+	ResetRewards []types.Coin `protobuf:"bytes,3,rep,name=reset_rewards,json=resetRewards,proto3" json:"reset_rewards"`
+}
+
+func (m *ContractLifetimeRewardsResetEvent) Reset()         { *m = ContractLifetimeRewardsResetEvent{} }
+func (m *ContractLifetimeRewardsResetEvent) String() string { return proto.CompactTextString(m) }
+func (*ContractLifetimeRewardsResetEvent) ProtoMessage()    {}
+func (*ContractLifetimeRewardsResetEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54ce1d144a852005, []int{14}
+}
+func (m *ContractLifetimeRewardsResetEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractLifetimeRewardsResetEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractLifetimeRewardsResetEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractLifetimeRewardsResetEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractLifetimeRewardsResetEvent.Merge(m, src)
+}
+func (m *ContractLifetimeRewardsResetEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractLifetimeRewardsResetEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractLifetimeRewardsResetEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractLifetimeRewardsResetEvent proto.InternalMessageInfo
+
+func (m *ContractLifetimeRewardsResetEvent) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *ContractLifetimeRewardsResetEvent) GetSenderAddress() string {
+	if m != nil {
+		return m.SenderAddress
+	}
+	return ""
+}
+
+func (m *ContractLifetimeRewardsResetEvent) GetResetRewards() []types.Coin {
+	if m != nil {
+		return m.ResetRewards
+	}
+	return nil
+}
+
+// ContractRewardsCallbackEvent is emitted when the contract rewards callback
+// contract is invoked with its rewards share.
+type ContractRewardsCallbackEvent struct {
+	// contract_address defines the contract address the rewards are distributed
+	// for.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// callback_address defines the rewards callback contract address.
+	CallbackAddress string `protobuf:"bytes,2,opt,name=callback_address,json=callbackAddress,proto3" json:"callback_address,omitempty"`
+	// rewards defines the rewards share the callback is invoked with.
+	Rewards []types.Coin `protobuf:"bytes,3,rep,name=rewards,proto3" json:"rewards"`
+	// gas_used defines the gas consumed by the callback.
+	GasUsed uint64 `protobuf:"varint,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// error defines the callback failure reason (empty on success). The rewards
+	// share is distributed as usual on failure.
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ContractRewardsCallbackEvent) Reset()         { *m = ContractRewardsCallbackEvent{} }
+func (m *ContractRewardsCallbackEvent) String() string { return proto.CompactTextString(m) }
+func (*ContractRewardsCallbackEvent) ProtoMessage()    {}
+func (*ContractRewardsCallbackEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54ce1d144a852005, []int{15}
+}
+func (m *ContractRewardsCallbackEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractRewardsCallbackEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractRewardsCallbackEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractRewardsCallbackEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractRewardsCallbackEvent.Merge(m, src)
+}
+func (m *ContractRewardsCallbackEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractRewardsCallbackEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractRewardsCallbackEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractRewardsCallbackEvent proto.InternalMessageInfo
+
+func (m *ContractRewardsCallbackEvent) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *ContractRewardsCallbackEvent) GetCallbackAddress() string {
+	if m != nil {
+		return m.CallbackAddress
+	}
+	return ""
+}
+
+func (m *ContractRewardsCallbackEvent) GetRewards() []types.Coin {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *ContractRewardsCallbackEvent) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *ContractRewardsCallbackEvent) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ContractMetadataSetEvent)(nil), "archway.rewards.v1.ContractMetadataSetEvent")
+	proto.RegisterType((*ContractRewardCalculationEvent)(nil), "archway.rewards.v1.ContractRewardCalculationEvent")
+	proto.RegisterType((*RewardsWithdrawEvent)(nil), "archway.rewards.v1.RewardsWithdrawEvent")
+	proto.RegisterType((*MinConsensusFeeSetEvent)(nil), "archway.rewards.v1.MinConsensusFeeSetEvent")
+	proto.RegisterType((*ContractFlatFeeSetEvent)(nil), "archway.rewards.v1.ContractFlatFeeSetEvent")
+	proto.RegisterType((*TxFeesEstimateEvent)(nil), "archway.rewards.v1.TxFeesEstimateEvent")
+	proto.RegisterType((*DynamicFeeRefundEvent)(nil), "archway.rewards.v1.DynamicFeeRefundEvent")
+	proto.RegisterType((*ContractMetadataRemovedEvent)(nil), "archway.rewards.v1.ContractMetadataRemovedEvent")
+	proto.RegisterType((*ContractFlatFeeChargedEvent)(nil), "archway.rewards.v1.ContractFlatFeeChargedEvent")
+	proto.RegisterType((*ContractFlatFeeTipEvent)(nil), "archway.rewards.v1.ContractFlatFeeTipEvent")
+	proto.RegisterType((*ContractRewardsRecoveredEvent)(nil), "archway.rewards.v1.ContractRewardsRecoveredEvent")
+	proto.RegisterType((*ContractFlatFeePrepaidEvent)(nil), "archway.rewards.v1.ContractFlatFeePrepaidEvent")
+	proto.RegisterType((*ContractFlatFeeOverrideSetEvent)(nil), "archway.rewards.v1.ContractFlatFeeOverrideSetEvent")
+	proto.RegisterType((*RewardsDistributionScaledEvent)(nil), "archway.rewards.v1.RewardsDistributionScaledEvent")
+	proto.RegisterType((*ContractLifetimeRewardsResetEvent)(nil), "archway.rewards.v1.ContractLifetimeRewardsResetEvent")
+	proto.RegisterType((*ContractRewardsCallbackEvent)(nil), "archway.rewards.v1.ContractRewardsCallbackEvent")
+}
+
+func init() { proto.RegisterFile("archway/rewards/v1/events.proto", fileDescriptor_54ce1d144a852005) }
+
+var fileDescriptor_54ce1d144a852005 = []byte{
+	// 1001 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4b, 0x6f, 0x1c, 0x45,
+	0x10, 0xf6, 0xec, 0x26, 0xf6, 0xba, 0xfc, 0x88, 0x3d, 0x71, 0x12, 0xe7, 0xc1, 0xd8, 0x19, 0x88,
+	0x94, 0x1c, 0x98, 0x95, 0x0d, 0x12, 0x22, 0xe2, 0x00, 0x59, 0x67, 0x05, 0x62, 0xad, 0x44, 0xe3,
+	0x20, 0x24, 0x2e, 0xab, 0xde, 0x99, 0xda, 0xd9, 0x56, 0x76, 0x1e, 0xea, 0xee, 0x7d, 0xfd, 0x08,
+	0x04, 0x07, 0x0e, 0xdc, 0x39, 0xf3, 0x0b, 0xb8, 0x20, 0x71, 0xc9, 0x05, 0x29, 0x12, 0x17, 0x4e,
+	0x28, 0xb2, 0xff, 0x08, 0xea, 0x9e, 0xee, 0x65, 0xbd, 0xbb, 0x87, 0x19, 0x1f, 0xb8, 0x4d, 0xd7,
+	0x54, 0x55, 0x7f, 0xf5, 0xd5, 0xd7, 0xd5, 0x0d, 0x07, 0x84, 0x05, 0xbd, 0x11, 0x99, 0xd4, 0x19,
+	0x8e, 0x08, 0x0b, 0x79, 0x7d, 0x78, 0x54, 0xc7, 0x21, 0x26, 0x82, 0x7b, 0x19, 0x4b, 0x45, 0x6a,
+	0xdb, 0xda, 0xc1, 0xd3, 0x0e, 0xde, 0xf0, 0xe8, 0xde, 0x5e, 0x94, 0x46, 0xa9, 0xfa, 0x5d, 0x97,
+	0x5f, 0xb9, 0xe7, 0x3d, 0x27, 0x48, 0x79, 0x9c, 0xf2, 0x7a, 0x87, 0x70, 0xac, 0x0f, 0x8f, 0x3a,
+	0x28, 0xc8, 0x51, 0x3d, 0x48, 0x69, 0xa2, 0xff, 0x1f, 0x2e, 0xd9, 0xca, 0x24, 0x55, 0x1e, 0xee,
+	0xf7, 0x16, 0xec, 0x37, 0xd2, 0x44, 0x30, 0x12, 0x88, 0x53, 0x14, 0x24, 0x24, 0x82, 0x9c, 0xa1,
+	0x78, 0x2e, 0xf1, 0xd8, 0x4f, 0x60, 0x27, 0xd0, 0xff, 0xda, 0x24, 0x0c, 0x19, 0x72, 0xbe, 0x6f,
+	0x1d, 0x5a, 0x8f, 0xd7, 0xfd, 0x1b, 0xc6, 0xfe, 0x45, 0x6e, 0xb6, 0x9b, 0x50, 0x8b, 0x75, 0xf8,
+	0x7e, 0xe5, 0xd0, 0x7a, 0xbc, 0x71, 0xfc, 0x81, 0xb7, 0x58, 0x86, 0x37, 0xbf, 0xd5, 0xb3, 0x6b,
+	0x6f, 0xfe, 0x39, 0x58, 0xf1, 0xa7, 0xb1, 0xee, 0x9f, 0x15, 0x70, 0x8c, 0x93, 0xaf, 0xe2, 0x1a,
+	0xa4, 0x1f, 0x0c, 0xfa, 0x44, 0xd0, 0x34, 0x29, 0x8d, 0xea, 0x21, 0x6c, 0x46, 0x84, 0xb7, 0x83,
+	0x34, 0xe1, 0x83, 0x18, 0x43, 0x85, 0xec, 0x9a, 0xbf, 0x11, 0x11, 0xde, 0xd0, 0x26, 0xbb, 0x05,
+	0xbb, 0x34, 0xe9, 0xe6, 0xf9, 0xdb, 0x1a, 0xe9, 0x7e, 0x55, 0x55, 0x70, 0xd7, 0xcb, 0xe9, 0xf5,
+	0x24, 0xbd, 0x9e, 0xa6, 0xd7, 0x6b, 0xa4, 0x34, 0xd1, 0xb0, 0x77, 0xa6, 0x91, 0x39, 0x54, 0x6e,
+	0x9f, 0x82, 0xdd, 0x45, 0x6c, 0x33, 0xec, 0x10, 0x81, 0xd3, 0x74, 0xd7, 0x0e, 0xab, 0x85, 0xd2,
+	0x75, 0x11, 0x7d, 0x15, 0x69, 0xd2, 0x7d, 0x3e, 0xc3, 0xea, 0xf5, 0xe2, 0xac, 0xce, 0xf0, 0x39,
+	0x86, 0x3d, 0x9d, 0xec, 0x5b, 0x2a, 0x7a, 0x21, 0x23, 0xa3, 0x9c, 0xc4, 0x47, 0xb0, 0x9d, 0x27,
+	0x98, 0xa3, 0x70, 0x2b, 0xb7, 0x1a, 0x02, 0x3f, 0x85, 0x35, 0x53, 0x44, 0xa5, 0x58, 0x11, 0xc6,
+	0xdf, 0x7d, 0x01, 0x77, 0x4e, 0x69, 0x22, 0x79, 0xc6, 0x84, 0x0f, 0x78, 0x13, 0x71, 0xaa, 0xab,
+	0x8f, 0xa1, 0xda, 0x45, 0x54, 0x3b, 0x6e, 0x1c, 0x3f, 0x58, 0x9a, 0xf1, 0x04, 0x83, 0x99, 0xa4,
+	0xd2, 0xdd, 0xfd, 0xd9, 0x82, 0x3b, 0xa6, 0xd2, 0x66, 0x9f, 0x88, 0xd9, 0x8c, 0x25, 0x34, 0xf1,
+	0x14, 0x6a, 0xb2, 0x69, 0x6d, 0x89, 0xa0, 0x52, 0xac, 0xcf, 0x6b, 0xdd, 0x7c, 0x3b, 0xfb, 0x36,
+	0xac, 0xc6, 0x28, 0x7a, 0x69, 0xa8, 0x14, 0xb2, 0xee, 0xeb, 0x95, 0xfb, 0x83, 0x05, 0x37, 0x5f,
+	0x8d, 0x9b, 0x88, 0xfc, 0x39, 0x17, 0x34, 0x26, 0x02, 0x73, 0x58, 0x4f, 0xa1, 0x26, 0xf5, 0xd7,
+	0x45, 0x94, 0x70, 0x8a, 0xf1, 0x17, 0x11, 0xc9, 0x15, 0xb7, 0x3f, 0x83, 0x75, 0x83, 0xb3, 0x30,
+	0xf9, 0x35, 0x0d, 0x94, 0xbb, 0x31, 0xdc, 0x3a, 0x99, 0x24, 0x24, 0xa6, 0x41, 0x13, 0xd1, 0xc7,
+	0xee, 0x20, 0x09, 0x73, 0x48, 0xf7, 0x61, 0x5d, 0x2a, 0x34, 0x23, 0x13, 0x64, 0x9a, 0xa2, 0x5a,
+	0x17, 0xf1, 0xa5, 0x5c, 0xdb, 0x9f, 0xc0, 0x2a, 0x53, 0xbe, 0x45, 0x37, 0xd4, 0xee, 0xee, 0x1f,
+	0x16, 0x3c, 0x58, 0x50, 0x21, 0xc6, 0xe9, 0x10, 0xc3, 0xd2, 0x0d, 0x3a, 0x86, 0x5b, 0x5a, 0x43,
+	0x6d, 0x3e, 0x42, 0xcc, 0xa6, 0xfe, 0x15, 0xe5, 0x7f, 0x53, 0xff, 0x3c, 0x93, 0xff, 0x4c, 0xcc,
+	0x09, 0x6c, 0xf1, 0x11, 0x66, 0x62, 0xe6, 0x04, 0x17, 0xc2, 0xbf, 0xa9, 0xa2, 0xf4, 0x09, 0x71,
+	0x7f, 0xb1, 0xe0, 0xfe, 0x9c, 0xc2, 0x1a, 0x3d, 0xc2, 0x22, 0xfc, 0x8f, 0xbb, 0x98, 0x47, 0x6d,
+	0x9a, 0x84, 0x38, 0x56, 0xe8, 0xb7, 0xfc, 0x5a, 0xcc, 0xa3, 0xaf, 0xe4, 0x7a, 0x69, 0x85, 0x95,
+	0xe5, 0x15, 0x5e, 0x6a, 0x6d, 0xb5, 0x6c, 0x6b, 0x7f, 0x5a, 0x3c, 0x07, 0xaf, 0x68, 0x56, 0x9a,
+	0xe6, 0x4b, 0x42, 0xa8, 0xcc, 0x09, 0xe1, 0x08, 0xaa, 0x82, 0x66, 0x45, 0xb1, 0x49, 0x5f, 0x29,
+	0x81, 0xf7, 0x2e, 0x4f, 0x6e, 0xee, 0x63, 0x90, 0x0e, 0x91, 0x5d, 0x41, 0x03, 0x4f, 0x60, 0x87,
+	0xe5, 0xc1, 0x93, 0x79, 0x32, 0x8d, 0xdd, 0xb8, 0xb6, 0x60, 0x97, 0x99, 0x7d, 0xca, 0xb6, 0x7f,
+	0x67, 0x1a, 0x69, 0x24, 0xf0, 0xfb, 0xa2, 0x04, 0x5e, 0x32, 0xcc, 0x08, 0x2d, 0x5f, 0x83, 0x03,
+	0x80, 0x63, 0x0c, 0x06, 0xf2, 0x7e, 0xe0, 0xfa, 0xea, 0x99, 0xb1, 0x48, 0x15, 0xc8, 0xbc, 0xe5,
+	0x54, 0x20, 0x23, 0xd4, 0x78, 0xd8, 0x87, 0xb5, 0x80, 0x61, 0x48, 0x85, 0xbc, 0x5e, 0x64, 0x6a,
+	0xb3, 0x74, 0x7f, 0xb5, 0xe0, 0x60, 0xae, 0x84, 0x17, 0x43, 0x64, 0x8c, 0x86, 0xff, 0xfb, 0xbc,
+	0x7c, 0x1f, 0xb6, 0x70, 0x9c, 0x51, 0x36, 0x69, 0xf7, 0x90, 0x46, 0x3d, 0xa1, 0xc6, 0x66, 0xd5,
+	0xdf, 0xcc, 0x8d, 0x5f, 0x2a, 0x9b, 0xfb, 0x97, 0x05, 0x8e, 0xa6, 0xff, 0x84, 0x72, 0xc1, 0x68,
+	0x47, 0x11, 0x74, 0x16, 0x90, 0xbe, 0x51, 0xce, 0x6d, 0x58, 0xd5, 0x09, 0x2c, 0x95, 0x40, 0xaf,
+	0xec, 0xaf, 0xe1, 0x46, 0xd6, 0x27, 0x49, 0x32, 0xd3, 0xf9, 0x7c, 0x70, 0x15, 0xb9, 0x54, 0xb6,
+	0x75, 0xa8, 0xb9, 0x6c, 0x5b, 0xb0, 0x4b, 0x86, 0x84, 0xf6, 0x49, 0xa7, 0x8f, 0xa5, 0x85, 0x34,
+	0x8d, 0x34, 0x42, 0xfa, 0xcd, 0x82, 0x87, 0xa6, 0x0b, 0x2d, 0xda, 0x45, 0x41, 0x63, 0x9c, 0x1e,
+	0x0b, 0x7e, 0x85, 0x3e, 0x3c, 0x82, 0x6d, 0x8e, 0x49, 0x88, 0x6c, 0xee, 0x40, 0x6c, 0xe5, 0xd6,
+	0x99, 0x49, 0xc8, 0x64, 0xfe, 0xd2, 0x93, 0x50, 0x45, 0x19, 0xf4, 0xef, 0x66, 0xe6, 0xb9, 0xb6,
+	0x35, 0x48, 0xbf, 0xdf, 0x21, 0xc1, 0xeb, 0xab, 0x9c, 0xe5, 0x40, 0xc7, 0x2e, 0x0c, 0x46, 0x6d,
+	0x5f, 0xf2, 0xdc, 0xa8, 0x96, 0x7b, 0x6e, 0xd8, 0x77, 0xf3, 0xab, 0x76, 0xc0, 0x31, 0x34, 0x07,
+	0x22, 0x22, 0xfc, 0x1b, 0x8e, 0xa1, 0xbd, 0x07, 0xd7, 0x91, 0xb1, 0x94, 0xa9, 0x27, 0xd4, 0xba,
+	0x9f, 0x2f, 0x9e, 0xb5, 0xde, 0x9c, 0x3b, 0xd6, 0xdb, 0x73, 0xc7, 0x7a, 0x77, 0xee, 0x58, 0x3f,
+	0x5e, 0x38, 0x2b, 0x6f, 0x2f, 0x9c, 0x95, 0xbf, 0x2f, 0x9c, 0x95, 0xef, 0x8e, 0x23, 0x2a, 0x7a,
+	0x83, 0x8e, 0x17, 0xa4, 0x71, 0x5d, 0xbf, 0xb6, 0x3e, 0x4c, 0x50, 0x8c, 0x52, 0xf6, 0xda, 0xac,
+	0xeb, 0xe3, 0xe9, 0x93, 0x5a, 0x4c, 0x32, 0xe4, 0x9d, 0x55, 0xf5, 0x9c, 0xfe, 0xe8, 0xdf, 0x01,
+	0x00, 0xe1, 0xb9, 0xee, 0x17, 0xdd, 0x0b, 0x00, 0x00,
+}
+
+func (m *ContractMetadataSetEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractMetadataSetEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractMetadataSetEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractRewardCalculationEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractRewardCalculationEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractRewardCalculationEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FeeRebateRewards) > 0 {
+		for iNdEx := len(m.FeeRebateRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeRebateRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.InflationRewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.GasConsumed != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GasConsumed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardsWithdrawEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardsWithdrawEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardsWithdrawEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RewardAddress) > 0 {
+		i -= len(m.RewardAddress)
+		copy(dAtA[i:], m.RewardAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RewardAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MinConsensusFeeSetEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MinConsensusFeeSetEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinConsensusFeeSetEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractFlatFeeSetEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractFlatFeeSetEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractFlatFeeSetEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.FlatFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxFeesEstimateEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxFeesEstimateEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxFeesEstimateEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FlatFees) > 0 {
+		for iNdEx := len(m.FlatFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FlatFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.GasFees) > 0 {
+		for iNdEx := len(m.GasFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DynamicFeeRefundEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicFeeRefundEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicFeeRefundEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Refund) > 0 {
+		for iNdEx := len(m.Refund) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refund[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractMetadataRemovedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractMetadataRemovedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractMetadataRemovedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SweptRewards) > 0 {
+		for iNdEx := len(m.SweptRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SweptRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RewardsSweepAddress) > 0 {
+		i -= len(m.RewardsSweepAddress)
+		copy(dAtA[i:], m.RewardsSweepAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RewardsSweepAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractFlatFeeChargedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractFlatFeeChargedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractFlatFeeChargedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FlatFees) > 0 {
+		for iNdEx := len(m.FlatFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FlatFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MsgIndex != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractFlatFeeTipEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractFlatFeeTipEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractFlatFeeTipEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tip) > 0 {
+		for iNdEx := len(m.Tip) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tip[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractRewardsRecoveredEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractRewardsRecoveredEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractRewardsRecoveredEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecoveredRewards) > 0 {
+		for iNdEx := len(m.RecoveredRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecoveredRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RecoveryAddress) > 0 {
+		i -= len(m.RecoveryAddress)
+		copy(dAtA[i:], m.RecoveryAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecoveryAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractFlatFeePrepaidEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractFlatFeePrepaidEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractFlatFeePrepaidEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Credits != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Credits))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PaidFees) > 0 {
+		for iNdEx := len(m.PaidFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PaidFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Executions != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Executions))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractFlatFeeOverrideSetEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractFlatFeeOverrideSetEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractFlatFeeOverrideSetEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.FlatFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardsDistributionScaledEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardsDistributionScaledEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardsDistributionScaledEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AvailableRewards) > 0 {
+		for iNdEx := len(m.AvailableRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AvailableRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PlannedRewards) > 0 {
+		for iNdEx := len(m.PlannedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PlannedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractLifetimeRewardsResetEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractLifetimeRewardsResetEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractLifetimeRewardsResetEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ResetRewards) > 0 {
+		for iNdEx := len(m.ResetRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ResetRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractRewardsCallbackEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractRewardsCallbackEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractRewardsCallbackEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CallbackAddress) > 0 {
+		i -= len(m.CallbackAddress)
+		copy(dAtA[i:], m.CallbackAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CallbackAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ContractMetadataSetEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *ContractRewardCalculationEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.GasConsumed != 0 {
+		n += 1 + sovEvents(uint64(m.GasConsumed))
+	}
+	l = m.InflationRewards.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if len(m.FeeRebateRewards) > 0 {
+		for _, e := range m.FeeRebateRewards {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *RewardsWithdrawEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RewardAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MinConsensusFeeSetEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fee.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *ContractFlatFeeSetEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.FlatFee.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *TxFeesEstimateEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GasFees) > 0 {
+		for _, e := range m.GasFees {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.FlatFees) > 0 {
+		for _, e := range m.FlatFees {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DynamicFeeRefundEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Refund) > 0 {
+		for _, e := range m.Refund {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractMetadataRemovedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RewardsSweepAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.SweptRewards) > 0 {
+		for _, e := range m.SweptRewards {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractFlatFeeChargedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgIndex != 0 {
+		n += 1 + sovEvents(uint64(m.MsgIndex))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.FlatFees) > 0 {
+		for _, e := range m.FlatFees {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractFlatFeeTipEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Tip) > 0 {
+		for _, e := range m.Tip {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractRewardsRecoveredEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RecoveryAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.RecoveredRewards) > 0 {
+		for _, e := range m.RecoveredRewards {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractFlatFeePrepaidEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Executions != 0 {
+		n += 1 + sovEvents(uint64(m.Executions))
+	}
+	if len(m.PaidFees) > 0 {
+		for _, e := range m.PaidFees {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.Credits != 0 {
+		n += 1 + sovEvents(uint64(m.Credits))
+	}
+	return n
+}
+
+func (m *ContractFlatFeeOverrideSetEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.FlatFee.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovEvents(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *RewardsDistributionScaledEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovEvents(uint64(m.Height))
+	}
+	if len(m.PlannedRewards) > 0 {
+		for _, e := range m.PlannedRewards {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.AvailableRewards) > 0 {
+		for _, e := range m.AvailableRewards {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractLifetimeRewardsResetEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.ResetRewards) > 0 {
+		for _, e := range m.ResetRewards {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractRewardsCallbackEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.CallbackAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovEvents(uint64(m.GasUsed))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ContractMetadataSetEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractMetadataSetEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractMetadataSetEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractRewardCalculationEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractRewardCalculationEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractRewardCalculationEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasConsumed", wireType)
+			}
+			m.GasConsumed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasConsumed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRebateRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeRebateRewards = append(m.FeeRebateRewards, types.Coin{})
+			if err := m.FeeRebateRewards[len(m.FeeRebateRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &ContractMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardsWithdrawEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardsWithdrawEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardsWithdrawEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MinConsensusFeeSetEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinConsensusFeeSetEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinConsensusFeeSetEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractFlatFeeSetEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractFlatFeeSetEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractFlatFeeSetEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FlatFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxFeesEstimateEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxFeesEstimateEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxFeesEstimateEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasFees = append(m.GasFees, types.Coin{})
+			if err := m.GasFees[len(m.GasFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FlatFees = append(m.FlatFees, types.Coin{})
+			if err := m.FlatFees[len(m.FlatFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DynamicFeeRefundEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicFeeRefundEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicFeeRefundEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refund = append(m.Refund, types.Coin{})
+			if err := m.Refund[len(m.Refund)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractMetadataRemovedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractMetadataRemovedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractMetadataRemovedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsSweepAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsSweepAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SweptRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SweptRewards = append(m.SweptRewards, types.Coin{})
+			if err := m.SweptRewards[len(m.SweptRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractFlatFeeChargedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractFlatFeeChargedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractFlatFeeChargedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FlatFees = append(m.FlatFees, types.Coin{})
+			if err := m.FlatFees[len(m.FlatFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractFlatFeeTipEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractFlatFeeTipEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractFlatFeeTipEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tip", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tip = append(m.Tip, types.Coin{})
+			if err := m.Tip[len(m.Tip)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractRewardsRecoveredEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractRewardsRecoveredEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractRewardsRecoveredEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecoveryAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveredRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecoveredRewards = append(m.RecoveredRewards, types.Coin{})
+			if err := m.RecoveredRewards[len(m.RecoveredRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
-}
 
-func (m *RewardsWithdrawEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RewardAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *MinConsensusFeeSetEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ContractFlatFeePrepaidEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractFlatFeePrepaidEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractFlatFeePrepaidEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
+			}
+			m.Executions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Executions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaidFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaidFees = append(m.PaidFees, types.Coin{})
+			if err := m.PaidFees[len(m.PaidFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credits", wireType)
+			}
+			m.Credits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Credits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.Fee.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
-}
 
-func (m *ContractFlatFeeSetEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.FlatFee.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ContractMetadataSetEvent) Unmarshal(dAtA []byte) error {
+func (m *ContractFlatFeeOverrideSetEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -726,10 +3974,10 @@ func (m *ContractMetadataSetEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractMetadataSetEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractFlatFeeOverrideSetEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractMetadataSetEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractFlatFeeOverrideSetEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -766,7 +4014,7 @@ func (m *ContractMetadataSetEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -783,20 +4031,39 @@ func (m *ContractMetadataSetEvent) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FlatFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -818,7 +4085,7 @@ func (m *ContractMetadataSetEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractRewardCalculationEvent) Unmarshal(dAtA []byte) error {
+func (m *RewardsDistributionScaledEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -841,68 +4108,17 @@ func (m *ContractRewardCalculationEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractRewardCalculationEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardsDistributionScaledEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractRewardCalculationEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardsDistributionScaledEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasConsumed", wireType)
-			}
-			m.GasConsumed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GasConsumed |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -912,28 +4128,14 @@ func (m *ContractRewardCalculationEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.InflationRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeRebateRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlannedRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -960,14 +4162,14 @@ func (m *ContractRewardCalculationEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeRebateRewards = append(m.FeeRebateRewards, types.Coin{})
-			if err := m.FeeRebateRewards[len(m.FeeRebateRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PlannedRewards = append(m.PlannedRewards, types.DecCoin{})
+			if err := m.PlannedRewards[len(m.PlannedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailableRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -994,10 +4196,8 @@ func (m *ContractRewardCalculationEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &ContractMetadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AvailableRewards = append(m.AvailableRewards, types.Coin{})
+			if err := m.AvailableRewards[len(m.AvailableRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1022,7 +4222,7 @@ func (m *ContractRewardCalculationEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RewardsWithdrawEvent) Unmarshal(dAtA []byte) error {
+func (m *ContractLifetimeRewardsResetEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1045,15 +4245,15 @@ func (m *RewardsWithdrawEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RewardsWithdrawEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractLifetimeRewardsResetEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RewardsWithdrawEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractLifetimeRewardsResetEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1081,13 +4281,13 @@ func (m *RewardsWithdrawEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardAddress = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1097,79 +4297,27 @@ func (m *RewardsWithdrawEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, types.Coin{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MinConsensusFeeSetEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MinConsensusFeeSetEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MinConsensusFeeSetEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1196,7 +4344,8 @@ func (m *MinConsensusFeeSetEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ResetRewards = append(m.ResetRewards, types.Coin{})
+			if err := m.ResetRewards[len(m.ResetRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1221,7 +4370,7 @@ func (m *MinConsensusFeeSetEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractFlatFeeSetEvent) Unmarshal(dAtA []byte) error {
+func (m *ContractRewardsCallbackEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1244,10 +4393,10 @@ func (m *ContractFlatFeeSetEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractFlatFeeSetEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractRewardsCallbackEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractFlatFeeSetEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractRewardsCallbackEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1284,7 +4433,39 @@ func (m *ContractFlatFeeSetEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FlatFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1311,10 +4492,62 @@ func (m *ContractFlatFeeSetEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FlatFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -33,14 +33,19 @@ func NewGenesisState(
 // DefaultGenesisState returns a default genesis state.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:              DefaultParams(),
-		ContractsMetadata:   []ContractMetadata{},
-		BlockRewards:        []BlockRewards{},
-		TxRewards:           []TxRewards{},
-		MinConsensusFee:     sdk.DecCoin{},
-		RewardsRecordLastId: 0,
-		RewardsRecords:      []RewardsRecord{},
-		FlatFees:            []FlatFee{},
+		Params:                 DefaultParams(),
+		ContractsMetadata:      []ContractMetadata{},
+		BlockRewards:           []BlockRewards{},
+		TxRewards:              []TxRewards{},
+		MinConsensusFee:        sdk.DecCoin{},
+		RewardsRecordLastId:    0,
+		RewardsRecords:         []RewardsRecord{},
+		FlatFees:               []FlatFee{},
+		ContractCodeIds:        []ContractCodeID{},
+		FlatFeeCredits:         []FlatFeeCredit{},
+		FlatFeeOverrides:       []FlatFeeOverride{},
+		ScheduledRewardsRatios: []ScheduledRewardsRatios{},
+		CodeFlatFees:           []CodeFlatFee{},
 	}
 }
 
@@ -123,10 +128,78 @@ func (m GenesisState) Validate() error {
 			return fmt.Errorf("flatFee [%d]: %w", i, err)
 		}
 
-		if _, ok := flatFeeSet[fee.ContractAddress]; ok {
+		flatFeeKey := fee.ContractAddress + "/" + fee.Method
+		if _, ok := flatFeeSet[flatFeeKey]; ok {
+			if fee.Method != "" {
+				return fmt.Errorf("flatFee [%d]: duplicated contract address (%s) method: %s", i, fee.ContractAddress, fee.Method)
+			}
 			return fmt.Errorf("flatFee [%d]: duplicated contract address: %s", i, fee.ContractAddress)
 		}
-		flatFeeSet[fee.ContractAddress] = struct{}{}
+		flatFeeSet[flatFeeKey] = struct{}{}
+	}
+
+	contractCodeIDSet := make(map[string]struct{})
+	for i, contractCodeID := range m.ContractCodeIds {
+		if err := contractCodeID.Validate(); err != nil {
+			return fmt.Errorf("contractCodeIds [%d]: %w", i, err)
+		}
+		if _, ok := contractAddrSet[contractCodeID.ContractAddress]; !ok {
+			return fmt.Errorf("contractCodeIds [%d]: contract metadata not found: %s", i, contractCodeID.ContractAddress)
+		}
+		if _, ok := contractCodeIDSet[contractCodeID.ContractAddress]; ok {
+			return fmt.Errorf("contractCodeIds [%d]: duplicated contract address: %s", i, contractCodeID.ContractAddress)
+		}
+		contractCodeIDSet[contractCodeID.ContractAddress] = struct{}{}
+	}
+
+	flatFeeCreditSet := make(map[string]struct{})
+	for i, credit := range m.FlatFeeCredits {
+		if err := credit.Validate(); err != nil {
+			return fmt.Errorf("flatFeeCredits [%d]: %w", i, err)
+		}
+		if _, ok := contractAddrSet[credit.ContractAddress]; !ok {
+			return fmt.Errorf("flatFeeCredits [%d]: contract metadata not found: %s", i, credit.ContractAddress)
+		}
+		if _, ok := flatFeeCreditSet[credit.ContractAddress]; ok {
+			return fmt.Errorf("flatFeeCredits [%d]: duplicated contract address: %s", i, credit.ContractAddress)
+		}
+		flatFeeCreditSet[credit.ContractAddress] = struct{}{}
+	}
+
+	flatFeeOverrideSet := make(map[string]struct{})
+	for i, override := range m.FlatFeeOverrides {
+		if err := override.Validate(); err != nil {
+			return fmt.Errorf("flatFeeOverrides [%d]: %w", i, err)
+		}
+		if _, ok := contractAddrSet[override.ContractAddress]; !ok {
+			return fmt.Errorf("flatFeeOverrides [%d]: contract metadata not found: %s", i, override.ContractAddress)
+		}
+		if _, ok := flatFeeOverrideSet[override.ContractAddress]; ok {
+			return fmt.Errorf("flatFeeOverrides [%d]: duplicated contract address: %s", i, override.ContractAddress)
+		}
+		flatFeeOverrideSet[override.ContractAddress] = struct{}{}
+	}
+
+	scheduledRatiosSet := make(map[int64]struct{})
+	for i, scheduled := range m.ScheduledRewardsRatios {
+		if err := scheduled.Validate(); err != nil {
+			return fmt.Errorf("scheduledRewardsRatios [%d]: %w", i, err)
+		}
+		if _, ok := scheduledRatiosSet[scheduled.ActivationHeight]; ok {
+			return fmt.Errorf("scheduledRewardsRatios [%d]: duplicated activation height: %d", i, scheduled.ActivationHeight)
+		}
+		scheduledRatiosSet[scheduled.ActivationHeight] = struct{}{}
+	}
+
+	codeFlatFeeSet := make(map[uint64]struct{})
+	for i, codeFlatFee := range m.CodeFlatFees {
+		if err := codeFlatFee.Validate(); err != nil {
+			return fmt.Errorf("codeFlatFees [%d]: %w", i, err)
+		}
+		if _, ok := codeFlatFeeSet[codeFlatFee.CodeId]; ok {
+			return fmt.Errorf("codeFlatFees [%d]: duplicated code ID: %d", i, codeFlatFee.CodeId)
+		}
+		codeFlatFeeSet[codeFlatFee.CodeId] = struct{}{}
 	}
 
 	return nil
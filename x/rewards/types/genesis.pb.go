@@ -44,6 +44,18 @@ type GenesisState struct {
 	RewardsRecords []RewardsRecord `protobuf:"bytes,7,rep,name=rewards_records,json=rewardsRecords,proto3" json:"rewards_records"`
 	// flat_fees defines a list of contract flat fee.
 	FlatFees []FlatFee `protobuf:"bytes,8,rep,name=flat_fees,json=flatFees,proto3" json:"flat_fees"`
+	// contract_code_ids defines a list of contract code IDs (contracts by code ID
+	// index entries).
+	ContractCodeIds []ContractCodeID `protobuf:"bytes,9,rep,name=contract_code_ids,json=contractCodeIds,proto3" json:"contract_code_ids"`
+	// flat_fee_credits defines a list of contract prepaid executions credits.
+	FlatFeeCredits []FlatFeeCredit `protobuf:"bytes,10,rep,name=flat_fee_credits,json=flatFeeCredits,proto3" json:"flat_fee_credits"`
+	// flat_fee_overrides defines a list of governance contract flat fee
+	// overrides.
+	FlatFeeOverrides []FlatFeeOverride `protobuf:"bytes,11,rep,name=flat_fee_overrides,json=flatFeeOverrides,proto3" json:"flat_fee_overrides"`
+	// scheduled_rewards_ratios defines a list of queued rewards ratios changes.
+	ScheduledRewardsRatios []ScheduledRewardsRatios `protobuf:"bytes,12,rep,name=scheduled_rewards_ratios,json=scheduledRewardsRatios,proto3" json:"scheduled_rewards_ratios"`
+	// code_flat_fees defines a list of code ID default flat fees.
+	CodeFlatFees []CodeFlatFee `protobuf:"bytes,13,rep,name=code_flat_fees,json=codeFlatFees,proto3" json:"code_flat_fees"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -135,6 +147,41 @@ func (m *GenesisState) GetFlatFees() []FlatFee {
 	return nil
 }
 
+func (m *GenesisState) GetContractCodeIds() []ContractCodeID {
+	if m != nil {
+		return m.ContractCodeIds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFlatFeeCredits() []FlatFeeCredit {
+	if m != nil {
+		return m.FlatFeeCredits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFlatFeeOverrides() []FlatFeeOverride {
+	if m != nil {
+		return m.FlatFeeOverrides
+	}
+	return nil
+}
+
+func (m *GenesisState) GetScheduledRewardsRatios() []ScheduledRewardsRatios {
+	if m != nil {
+		return m.ScheduledRewardsRatios
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCodeFlatFees() []CodeFlatFee {
+	if m != nil {
+		return m.CodeFlatFees
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "archway.rewards.v1.GenesisState")
 }
@@ -142,36 +189,44 @@ func init() {
 func init() { proto.RegisterFile("archway/rewards/v1/genesis.proto", fileDescriptor_72bec9f2849af09f) }
 
 var fileDescriptor_72bec9f2849af09f = []byte{
-	// 453 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0x80, 0x1b, 0x56, 0xca, 0xe6, 0x0d, 0xa6, 0x19, 0x84, 0xa2, 0x02, 0x21, 0x20, 0x0e, 0xbd,
-	0xe0, 0xa8, 0xdd, 0x85, 0x13, 0x87, 0x16, 0x15, 0x21, 0x06, 0x9a, 0x02, 0x17, 0xb8, 0x44, 0x8e,
-	0xf3, 0xda, 0x45, 0x6b, 0xec, 0xca, 0xcf, 0x6b, 0xbb, 0x7f, 0xc1, 0xcf, 0x9a, 0xc4, 0x65, 0x47,
-	0x4e, 0x08, 0xb5, 0x7f, 0x04, 0xd5, 0x71, 0xaa, 0x56, 0xcb, 0x2d, 0xcf, 0xef, 0x7b, 0xdf, 0xf3,
-	0xcb, 0x33, 0x09, 0xb9, 0x16, 0x17, 0x73, 0x7e, 0x1d, 0x69, 0x98, 0x73, 0x9d, 0x61, 0x34, 0xeb,
-	0x46, 0x63, 0x90, 0x80, 0x39, 0xb2, 0xa9, 0x56, 0x46, 0x51, 0xea, 0x08, 0xe6, 0x08, 0x36, 0xeb,
-	0xb6, 0x9f, 0x8c, 0xd5, 0x58, 0xd9, 0x74, 0xb4, 0xfe, 0x2a, 0xc9, 0x76, 0x20, 0x14, 0x16, 0x0a,
-	0xa3, 0x94, 0x23, 0x44, 0xb3, 0x6e, 0x0a, 0x86, 0x77, 0x23, 0xa1, 0x72, 0xe9, 0xf2, 0x75, 0xbd,
-	0x2a, 0xa9, 0x25, 0x5e, 0xff, 0x6e, 0x92, 0xa3, 0x8f, 0x65, 0xf7, 0x6f, 0x86, 0x1b, 0xa0, 0xef,
-	0x48, 0x6b, 0xca, 0x35, 0x2f, 0xd0, 0xf7, 0x42, 0xaf, 0x73, 0xd8, 0x6b, 0xb3, 0xbb, 0xb7, 0x61,
-	0xe7, 0x96, 0xe8, 0x37, 0x6f, 0xfe, 0xbe, 0x6c, 0xc4, 0x8e, 0xa7, 0x3f, 0x08, 0x15, 0x4a, 0x1a,
-	0xcd, 0x85, 0xc1, 0xa4, 0x00, 0xc3, 0x33, 0x6e, 0xb8, 0x7f, 0x2f, 0xdc, 0xeb, 0x1c, 0xf6, 0xde,
-	0xd4, 0x59, 0x06, 0x8e, 0xfe, 0xe2, 0x58, 0xe7, 0x3b, 0xd9, 0x58, 0xaa, 0x04, 0xfd, 0x4c, 0x1e,
-	0xa6, 0x13, 0x25, 0x2e, 0x13, 0x57, 0xed, 0xef, 0x59, 0x6b, 0x58, 0x67, 0xed, 0xaf, 0xc1, 0xb8,
-	0x8c, 0x9d, 0xf1, 0x28, 0xdd, 0x3a, 0xa3, 0x7d, 0x42, 0xcc, 0x62, 0x63, 0x6a, 0x5a, 0xd3, 0x8b,
-	0x3a, 0xd3, 0xf7, 0xc5, 0xae, 0xe6, 0xc0, 0x54, 0x07, 0xf4, 0x2b, 0x39, 0x29, 0x72, 0x99, 0x08,
-	0x25, 0x11, 0x24, 0x5e, 0x61, 0x32, 0x02, 0xf0, 0xef, 0xdb, 0x1f, 0xf6, 0x9c, 0x95, 0x4b, 0x61,
-	0xeb, 0xa5, 0x30, 0xb7, 0x14, 0xf6, 0x01, 0xc4, 0x40, 0xe5, 0xd2, 0x99, 0x8e, 0x8b, 0x5c, 0x0e,
-	0xaa, 0xda, 0x21, 0x00, 0x3d, 0x25, 0x4f, 0x5d, 0xe3, 0x44, 0x83, 0x50, 0x3a, 0x4b, 0x26, 0x1c,
-	0x4d, 0x92, 0x67, 0x7e, 0x2b, 0xf4, 0x3a, 0xcd, 0xf8, 0xb1, 0xcb, 0xc6, 0x36, 0x79, 0xc6, 0xd1,
-	0x7c, 0xca, 0xe8, 0x39, 0x39, 0xde, 0x2d, 0x42, 0xff, 0x81, 0x9d, 0xe6, 0x55, 0xdd, 0x34, 0xf1,
-	0xb6, 0xc1, 0xdd, 0xe3, 0xd1, 0x8e, 0x16, 0xe9, 0x7b, 0x72, 0x30, 0x9a, 0x70, 0xb3, 0x9e, 0x06,
-	0xfd, 0x7d, 0xeb, 0x7a, 0x56, 0xe7, 0x1a, 0x4e, 0xb8, 0x19, 0x02, 0x38, 0xcb, 0xfe, 0xa8, 0x0c,
-	0xb1, 0x7f, 0x76, 0xb3, 0x0c, 0xbc, 0xdb, 0x65, 0xe0, 0xfd, 0x5b, 0x06, 0xde, 0xaf, 0x55, 0xd0,
-	0xb8, 0x5d, 0x05, 0x8d, 0x3f, 0xab, 0xa0, 0xf1, 0xb3, 0x37, 0xce, 0xcd, 0xc5, 0x55, 0xca, 0x84,
-	0x2a, 0x22, 0x27, 0x7c, 0x2b, 0xc1, 0xcc, 0x95, 0xbe, 0xac, 0xe2, 0x68, 0xb1, 0x79, 0xa6, 0xe6,
-	0x7a, 0x0a, 0x98, 0xb6, 0xec, 0x13, 0x3d, 0xfd, 0x1f, 0x00, 0x00, 0xff, 0xff, 0xc4, 0x4b, 0xea,
-	0x4e, 0x32, 0x03, 0x00, 0x00,
+	// 588 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xcf, 0x4f, 0x13, 0x41,
+	0x14, 0xc7, 0xbb, 0x82, 0x08, 0x43, 0xf9, 0x35, 0x1a, 0x32, 0x41, 0x5d, 0x2a, 0x7a, 0x20, 0x26,
+	0xee, 0xa6, 0x70, 0xf1, 0xe4, 0xa1, 0x25, 0x18, 0x02, 0x2a, 0x16, 0x12, 0xa3, 0x97, 0xcd, 0xec,
+	0xcc, 0x6b, 0x59, 0xe9, 0xee, 0x90, 0x79, 0x43, 0x29, 0xff, 0x80, 0x67, 0xff, 0x2c, 0x8e, 0x1c,
+	0x3d, 0x19, 0xd3, 0xfe, 0x23, 0xa6, 0xd3, 0xd9, 0xa5, 0x8d, 0x2b, 0xb7, 0xce, 0x7b, 0xdf, 0xf7,
+	0x79, 0x3f, 0xbe, 0xcd, 0x92, 0x1a, 0xd7, 0xe2, 0xec, 0x8a, 0x5f, 0x87, 0x1a, 0xae, 0xb8, 0x96,
+	0x18, 0xf6, 0xea, 0x61, 0x07, 0x32, 0xc0, 0x04, 0x83, 0x0b, 0xad, 0x8c, 0xa2, 0xd4, 0x29, 0x02,
+	0xa7, 0x08, 0x7a, 0xf5, 0x8d, 0x27, 0x1d, 0xd5, 0x51, 0x36, 0x1d, 0x8e, 0x7e, 0x8d, 0x95, 0x1b,
+	0xbe, 0x50, 0x98, 0x2a, 0x0c, 0x63, 0x8e, 0x10, 0xf6, 0xea, 0x31, 0x18, 0x5e, 0x0f, 0x85, 0x4a,
+	0x32, 0x97, 0x2f, 0xeb, 0x95, 0x43, 0xad, 0x62, 0xeb, 0xc7, 0x3c, 0xa9, 0xbe, 0x1f, 0x77, 0x3f,
+	0x31, 0xdc, 0x00, 0x7d, 0x4b, 0xe6, 0x2e, 0xb8, 0xe6, 0x29, 0x32, 0xaf, 0xe6, 0x6d, 0x2f, 0xee,
+	0x6c, 0x04, 0xff, 0x4e, 0x13, 0x1c, 0x5b, 0x45, 0x63, 0xf6, 0xe6, 0xf7, 0x66, 0xa5, 0xe5, 0xf4,
+	0xf4, 0x2b, 0xa1, 0x42, 0x65, 0x46, 0x73, 0x61, 0x30, 0x4a, 0xc1, 0x70, 0xc9, 0x0d, 0x67, 0x0f,
+	0x6a, 0x33, 0xdb, 0x8b, 0x3b, 0xaf, 0xca, 0x28, 0x4d, 0xa7, 0xfe, 0xe0, 0xb4, 0x8e, 0xb7, 0x56,
+	0x50, 0xf2, 0x04, 0x3d, 0x24, 0x4b, 0x71, 0x57, 0x89, 0xf3, 0xc8, 0x55, 0xb3, 0x19, 0x4b, 0xad,
+	0x95, 0x51, 0x1b, 0x23, 0x61, 0x6b, 0xfc, 0x76, 0xc4, 0x6a, 0x3c, 0x11, 0xa3, 0x0d, 0x42, 0x4c,
+	0xbf, 0x20, 0xcd, 0x5a, 0xd2, 0xf3, 0x32, 0xd2, 0x69, 0x7f, 0x1a, 0xb3, 0x60, 0xf2, 0x00, 0xfd,
+	0x48, 0xd6, 0xd2, 0x24, 0x8b, 0x84, 0xca, 0x10, 0x32, 0xbc, 0xc4, 0xa8, 0x0d, 0xc0, 0x1e, 0xda,
+	0x83, 0x3d, 0x0b, 0xc6, 0xa6, 0x04, 0x23, 0x53, 0x02, 0x67, 0x4a, 0xb0, 0x07, 0xa2, 0xa9, 0x92,
+	0xcc, 0x91, 0x56, 0xd2, 0x24, 0x6b, 0xe6, 0xb5, 0xfb, 0x00, 0x74, 0x97, 0xac, 0xbb, 0xc6, 0x91,
+	0x06, 0xa1, 0xb4, 0x8c, 0xba, 0x1c, 0x4d, 0x94, 0x48, 0x36, 0x57, 0xf3, 0xb6, 0x67, 0x5b, 0x8f,
+	0x5d, 0xb6, 0x65, 0x93, 0x47, 0x1c, 0xcd, 0x81, 0xa4, 0xc7, 0x64, 0x65, 0xba, 0x08, 0xd9, 0x23,
+	0xbb, 0xcd, 0x8b, 0xb2, 0x6d, 0x5a, 0x93, 0x04, 0x37, 0xc7, 0xf2, 0x14, 0x16, 0xe9, 0x3b, 0xb2,
+	0xd0, 0xee, 0x72, 0x33, 0xda, 0x06, 0xd9, 0xbc, 0x65, 0x3d, 0x2d, 0x63, 0xed, 0x77, 0xb9, 0xd9,
+	0x07, 0x70, 0x94, 0xf9, 0xf6, 0xf8, 0x89, 0xf4, 0x94, 0x14, 0xe6, 0x45, 0x42, 0x49, 0x88, 0x12,
+	0x89, 0x6c, 0xc1, 0x72, 0xb6, 0xee, 0xfb, 0x07, 0x34, 0x95, 0x84, 0x83, 0xbd, 0xfc, 0x38, 0x62,
+	0x32, 0x2a, 0x91, 0x7e, 0x26, 0xab, 0xf9, 0x54, 0x91, 0xd0, 0x20, 0x13, 0x83, 0x8c, 0xfc, 0x7f,
+	0x51, 0x37, 0x5c, 0xd3, 0x2a, 0xf3, 0x45, 0xdb, 0x93, 0x41, 0xa4, 0x5f, 0x08, 0x2d, 0x90, 0xaa,
+	0x07, 0x5a, 0x27, 0x12, 0x90, 0x2d, 0x5a, 0xe8, 0xcb, 0x7b, 0xa0, 0x9f, 0x9c, 0xd6, 0x61, 0x57,
+	0xdb, 0xd3, 0x61, 0xa4, 0xdf, 0x09, 0x43, 0x71, 0x06, 0xf2, 0xb2, 0x0b, 0x32, 0x2a, 0xdc, 0xe1,
+	0x26, 0x51, 0xc8, 0xaa, 0x16, 0xff, 0xba, 0x0c, 0x7f, 0x92, 0xd7, 0xe4, 0x2e, 0xd9, 0x0a, 0xd7,
+	0x65, 0x1d, 0x4b, 0xb3, 0xf4, 0x90, 0x2c, 0xdb, 0x23, 0xdf, 0x59, 0xb6, 0x64, 0x3b, 0x6c, 0x96,
+	0x9f, 0x5a, 0xc2, 0xb4, 0x6d, 0x55, 0x71, 0x17, 0xc2, 0xc6, 0xd1, 0xcd, 0xc0, 0xf7, 0x6e, 0x07,
+	0xbe, 0xf7, 0x67, 0xe0, 0x7b, 0x3f, 0x87, 0x7e, 0xe5, 0x76, 0xe8, 0x57, 0x7e, 0x0d, 0xfd, 0xca,
+	0xb7, 0x9d, 0x4e, 0x62, 0xce, 0x2e, 0xe3, 0x40, 0xa8, 0x34, 0x74, 0xe0, 0x37, 0x19, 0x98, 0x2b,
+	0xa5, 0xcf, 0xf3, 0x77, 0xd8, 0x2f, 0xbe, 0x30, 0xe6, 0xfa, 0x02, 0x30, 0x9e, 0xb3, 0x5f, 0x97,
+	0xdd, 0xbf, 0x03, 0x00, 0xeb, 0x9f, 0xc4, 0x5b, 0xed, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -194,6 +249,76 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CodeFlatFees) > 0 {
+		for iNdEx := len(m.CodeFlatFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CodeFlatFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.ScheduledRewardsRatios) > 0 {
+		for iNdEx := len(m.ScheduledRewardsRatios) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledRewardsRatios[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.FlatFeeOverrides) > 0 {
+		for iNdEx := len(m.FlatFeeOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FlatFeeOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.FlatFeeCredits) > 0 {
+		for iNdEx := len(m.FlatFeeCredits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FlatFeeCredits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ContractCodeIds) > 0 {
+		for iNdEx := len(m.ContractCodeIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractCodeIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.FlatFees) > 0 {
 		for iNdEx := len(m.FlatFees) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -346,6 +471,36 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ContractCodeIds) > 0 {
+		for _, e := range m.ContractCodeIds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FlatFeeCredits) > 0 {
+		for _, e := range m.FlatFeeCredits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FlatFeeOverrides) > 0 {
+		for _, e := range m.FlatFeeOverrides {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScheduledRewardsRatios) > 0 {
+		for _, e := range m.ScheduledRewardsRatios {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CodeFlatFees) > 0 {
+		for _, e := range m.CodeFlatFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -639,6 +794,176 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractCodeIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractCodeIds = append(m.ContractCodeIds, ContractCodeID{})
+			if err := m.ContractCodeIds[len(m.ContractCodeIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFeeCredits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FlatFeeCredits = append(m.FlatFeeCredits, FlatFeeCredit{})
+			if err := m.FlatFeeCredits[len(m.FlatFeeCredits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFeeOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FlatFeeOverrides = append(m.FlatFeeOverrides, FlatFeeOverride{})
+			if err := m.FlatFeeOverrides[len(m.FlatFeeOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledRewardsRatios", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledRewardsRatios = append(m.ScheduledRewardsRatios, ScheduledRewardsRatios{})
+			if err := m.ScheduledRewardsRatios[len(m.ScheduledRewardsRatios)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeFlatFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeFlatFees = append(m.CodeFlatFees, CodeFlatFee{})
+			if err := m.CodeFlatFees[len(m.CodeFlatFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -301,6 +301,236 @@ func TestRewardsGenesisStateValidate(t *testing.T) {
 			},
 			errExpected: true,
 		},
+		{
+			name: "OK: Contract code IDs",
+			genesisState: rewardsTypes.GenesisState{
+				Params: rewardsTypes.DefaultParams(),
+				ContractsMetadata: []rewardsTypes.ContractMetadata{
+					{ContractAddress: contractAddrs[0].String(), OwnerAddress: accAddrs[0].String()},
+					{ContractAddress: contractAddrs[1].String(), OwnerAddress: accAddrs[1].String()},
+				},
+				ContractCodeIds: []rewardsTypes.ContractCodeID{
+					{ContractAddress: contractAddrs[0].String(), CodeId: 1},
+					{ContractAddress: contractAddrs[1].String(), CodeId: 1},
+				},
+			},
+		},
+		{
+			name: "Fail: invalid ContractCodeIds: metadata not found for corresponding contract",
+			genesisState: rewardsTypes.GenesisState{
+				Params: rewardsTypes.DefaultParams(),
+				ContractsMetadata: []rewardsTypes.ContractMetadata{
+					{ContractAddress: contractAddrs[0].String(), OwnerAddress: accAddrs[0].String()},
+				},
+				ContractCodeIds: []rewardsTypes.ContractCodeID{
+					{ContractAddress: contractAddrs[1].String(), CodeId: 1},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid ContractCodeIds: zero code ID",
+			genesisState: rewardsTypes.GenesisState{
+				Params: rewardsTypes.DefaultParams(),
+				ContractsMetadata: []rewardsTypes.ContractMetadata{
+					{ContractAddress: contractAddrs[0].String(), OwnerAddress: accAddrs[0].String()},
+				},
+				ContractCodeIds: []rewardsTypes.ContractCodeID{
+					{ContractAddress: contractAddrs[0].String(), CodeId: 0},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid ContractCodeIds: duplicates",
+			genesisState: rewardsTypes.GenesisState{
+				Params: rewardsTypes.DefaultParams(),
+				ContractsMetadata: []rewardsTypes.ContractMetadata{
+					{ContractAddress: contractAddrs[0].String(), OwnerAddress: accAddrs[0].String()},
+				},
+				ContractCodeIds: []rewardsTypes.ContractCodeID{
+					{ContractAddress: contractAddrs[0].String(), CodeId: 1},
+					{ContractAddress: contractAddrs[0].String(), CodeId: 2},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "OK: Flat fee credits",
+			genesisState: rewardsTypes.GenesisState{
+				Params: rewardsTypes.DefaultParams(),
+				ContractsMetadata: []rewardsTypes.ContractMetadata{
+					{ContractAddress: contractAddrs[0].String(), OwnerAddress: accAddrs[0].String()},
+				},
+				FlatFeeCredits: []rewardsTypes.FlatFeeCredit{
+					{ContractAddress: contractAddrs[0].String(), Executions: 10},
+				},
+			},
+		},
+		{
+			name: "Fail: invalid FlatFeeCredits: metadata not found for corresponding contract",
+			genesisState: rewardsTypes.GenesisState{
+				Params: rewardsTypes.DefaultParams(),
+				ContractsMetadata: []rewardsTypes.ContractMetadata{
+					{ContractAddress: contractAddrs[0].String(), OwnerAddress: accAddrs[0].String()},
+				},
+				FlatFeeCredits: []rewardsTypes.FlatFeeCredit{
+					{ContractAddress: contractAddrs[1].String(), Executions: 10},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid FlatFeeCredits: zero executions",
+			genesisState: rewardsTypes.GenesisState{
+				Params: rewardsTypes.DefaultParams(),
+				ContractsMetadata: []rewardsTypes.ContractMetadata{
+					{ContractAddress: contractAddrs[0].String(), OwnerAddress: accAddrs[0].String()},
+				},
+				FlatFeeCredits: []rewardsTypes.FlatFeeCredit{
+					{ContractAddress: contractAddrs[0].String(), Executions: 0},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid FlatFeeCredits: duplicates",
+			genesisState: rewardsTypes.GenesisState{
+				Params: rewardsTypes.DefaultParams(),
+				ContractsMetadata: []rewardsTypes.ContractMetadata{
+					{ContractAddress: contractAddrs[0].String(), OwnerAddress: accAddrs[0].String()},
+				},
+				FlatFeeCredits: []rewardsTypes.FlatFeeCredit{
+					{ContractAddress: contractAddrs[0].String(), Executions: 1},
+					{ContractAddress: contractAddrs[0].String(), Executions: 2},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "OK: Flat fee overrides",
+			genesisState: rewardsTypes.GenesisState{
+				Params: rewardsTypes.DefaultParams(),
+				ContractsMetadata: []rewardsTypes.ContractMetadata{
+					{ContractAddress: contractAddrs[0].String(), OwnerAddress: accAddrs[0].String()},
+				},
+				FlatFeeOverrides: []rewardsTypes.FlatFeeOverride{
+					{ContractAddress: contractAddrs[0].String(), FlatFee: sdk.NewInt64Coin("stake", 0), ExpiryHeight: 100},
+				},
+			},
+		},
+		{
+			name: "Fail: invalid FlatFeeOverrides: metadata not found for corresponding contract",
+			genesisState: rewardsTypes.GenesisState{
+				Params: rewardsTypes.DefaultParams(),
+				ContractsMetadata: []rewardsTypes.ContractMetadata{
+					{ContractAddress: contractAddrs[0].String(), OwnerAddress: accAddrs[0].String()},
+				},
+				FlatFeeOverrides: []rewardsTypes.FlatFeeOverride{
+					{ContractAddress: contractAddrs[1].String(), FlatFee: sdk.NewInt64Coin("stake", 1), ExpiryHeight: 100},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid FlatFeeOverrides: zero expiry height",
+			genesisState: rewardsTypes.GenesisState{
+				Params: rewardsTypes.DefaultParams(),
+				ContractsMetadata: []rewardsTypes.ContractMetadata{
+					{ContractAddress: contractAddrs[0].String(), OwnerAddress: accAddrs[0].String()},
+				},
+				FlatFeeOverrides: []rewardsTypes.FlatFeeOverride{
+					{ContractAddress: contractAddrs[0].String(), FlatFee: sdk.NewInt64Coin("stake", 1)},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid FlatFeeOverrides: duplicates",
+			genesisState: rewardsTypes.GenesisState{
+				Params: rewardsTypes.DefaultParams(),
+				ContractsMetadata: []rewardsTypes.ContractMetadata{
+					{ContractAddress: contractAddrs[0].String(), OwnerAddress: accAddrs[0].String()},
+				},
+				FlatFeeOverrides: []rewardsTypes.FlatFeeOverride{
+					{ContractAddress: contractAddrs[0].String(), FlatFee: sdk.NewInt64Coin("stake", 1), ExpiryHeight: 100},
+					{ContractAddress: contractAddrs[0].String(), FlatFee: sdk.NewInt64Coin("stake", 2), ExpiryHeight: 200},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "OK: ScheduledRewardsRatios",
+			genesisState: rewardsTypes.GenesisState{
+				Params: rewardsTypes.DefaultParams(),
+				ScheduledRewardsRatios: []rewardsTypes.ScheduledRewardsRatios{
+					{ActivationHeight: 100, InflationRewardsRatio: math.LegacyNewDecWithPrec(1, 1), TxFeeRebateRatio: math.LegacyNewDecWithPrec(2, 1)},
+					{ActivationHeight: 200, InflationRewardsRatio: math.LegacyNewDecWithPrec(2, 1), TxFeeRebateRatio: math.LegacyNewDecWithPrec(3, 1)},
+				},
+			},
+		},
+		{
+			name: "Fail: invalid ScheduledRewardsRatios: zero activation height",
+			genesisState: rewardsTypes.GenesisState{
+				Params: rewardsTypes.DefaultParams(),
+				ScheduledRewardsRatios: []rewardsTypes.ScheduledRewardsRatios{
+					{InflationRewardsRatio: math.LegacyNewDecWithPrec(1, 1), TxFeeRebateRatio: math.LegacyNewDecWithPrec(2, 1)},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid ScheduledRewardsRatios: ratios sum exceeds 1.0",
+			genesisState: rewardsTypes.GenesisState{
+				Params: rewardsTypes.DefaultParams(),
+				ScheduledRewardsRatios: []rewardsTypes.ScheduledRewardsRatios{
+					{ActivationHeight: 100, InflationRewardsRatio: math.LegacyNewDecWithPrec(6, 1), TxFeeRebateRatio: math.LegacyNewDecWithPrec(5, 1)},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "OK: CodeFlatFees",
+			genesisState: rewardsTypes.GenesisState{
+				Params: rewardsTypes.DefaultParams(),
+				CodeFlatFees: []rewardsTypes.CodeFlatFee{
+					{CodeId: 1, FlatFee: sdk.NewInt64Coin("stake", 1)},
+					{CodeId: 2, FlatFee: sdk.NewInt64Coin("stake", 2)},
+				},
+			},
+		},
+		{
+			name: "Fail: invalid CodeFlatFees: zero flat fee",
+			genesisState: rewardsTypes.GenesisState{
+				Params: rewardsTypes.DefaultParams(),
+				CodeFlatFees: []rewardsTypes.CodeFlatFee{
+					{CodeId: 1, FlatFee: sdk.NewInt64Coin("stake", 0)},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid CodeFlatFees: duplicates",
+			genesisState: rewardsTypes.GenesisState{
+				Params: rewardsTypes.DefaultParams(),
+				CodeFlatFees: []rewardsTypes.CodeFlatFee{
+					{CodeId: 1, FlatFee: sdk.NewInt64Coin("stake", 1)},
+					{CodeId: 1, FlatFee: sdk.NewInt64Coin("stake", 2)},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid ScheduledRewardsRatios: duplicates",
+			genesisState: rewardsTypes.GenesisState{
+				Params: rewardsTypes.DefaultParams(),
+				ScheduledRewardsRatios: []rewardsTypes.ScheduledRewardsRatios{
+					{ActivationHeight: 100, InflationRewardsRatio: math.LegacyNewDecWithPrec(1, 1), TxFeeRebateRatio: math.LegacyNewDecWithPrec(2, 1)},
+					{ActivationHeight: 100, InflationRewardsRatio: math.LegacyNewDecWithPrec(2, 1), TxFeeRebateRatio: math.LegacyNewDecWithPrec(3, 1)},
+				},
+			},
+			errExpected: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,63 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RewardsHooks defines the interface for other modules to subscribe to x/rewards events.
+type RewardsHooks interface {
+	// AfterRewardsWithdrawn is called after rewards have been withdrawn and sent to the rewards address.
+	AfterRewardsWithdrawn(ctx sdk.Context, rewardsAddr sdk.AccAddress, rewards sdk.Coins) error
+	// AfterContractMetadataSet is called after the contract metadata has been created or updated (the stored metadata is passed).
+	AfterContractMetadataSet(ctx sdk.Context, contractAddr sdk.AccAddress, metadata ContractMetadata) error
+	// BeforeRewardsDistribution is called before the block rewards pool is distributed to contracts and returns the pool
+	// part deducted by the hook (could be empty). The hook must not move the deducted tokens itself: x/rewards transfers
+	// them from the ContractRewardCollector to the TreasuryCollector module account, the rest of the pool is distributed to contracts.
+	BeforeRewardsDistribution(ctx sdk.Context, totalPool sdk.Coins) sdk.Coins
+}
+
+var _ RewardsHooks = MultiRewardsHooks{}
+
+// MultiRewardsHooks combines multiple RewardsHooks, all hook functions are run in array sequence.
+type MultiRewardsHooks []RewardsHooks
+
+// NewMultiRewardsHooks creates a new MultiRewardsHooks instance.
+func NewMultiRewardsHooks(hooks ...RewardsHooks) MultiRewardsHooks {
+	return hooks
+}
+
+// AfterRewardsWithdrawn implements the RewardsHooks interface.
+func (h MultiRewardsHooks) AfterRewardsWithdrawn(ctx sdk.Context, rewardsAddr sdk.AccAddress, rewards sdk.Coins) error {
+	for _, hook := range h {
+		if err := hook.AfterRewardsWithdrawn(ctx, rewardsAddr, rewards); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AfterContractMetadataSet implements the RewardsHooks interface.
+func (h MultiRewardsHooks) AfterContractMetadataSet(ctx sdk.Context, contractAddr sdk.AccAddress, metadata ContractMetadata) error {
+	for _, hook := range h {
+		if err := hook.AfterContractMetadataSet(ctx, contractAddr, metadata); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// BeforeRewardsDistribution implements the RewardsHooks interface.
+// Deductions are composed: every hook gets the pool left after the previous hooks deductions, the total deducted
+// (limited by the pool) is returned.
+func (h MultiRewardsHooks) BeforeRewardsDistribution(ctx sdk.Context, totalPool sdk.Coins) sdk.Coins {
+	remainingPool, deducted := totalPool, sdk.NewCoins()
+	for _, hook := range h {
+		hookDeducted := remainingPool.Min(hook.BeforeRewardsDistribution(ctx, remainingPool))
+		remainingPool = remainingPool.Sub(hookDeducted...)
+		deducted = deducted.Add(hookDeducted...)
+	}
+
+	return deducted
+}
@@ -23,6 +23,12 @@ const (
 var (
 	// ContractMetadataPrefix defines the prefix for storing contract metadata.
 	ContractMetadataPrefix = collections.NewPrefix([]byte{0x00, 0x00})
+	// ContractMetadataCountPrefix defines the prefix for storing the number of contracts with metadata.
+	ContractMetadataCountPrefix = collections.NewPrefix([]byte{0x00, 0x01})
+	// ContractCodeIDPrefix defines the prefix for storing the code ID of contracts with metadata.
+	ContractCodeIDPrefix = collections.NewPrefix([]byte{0x00, 0x02})
+	// ContractCodeIDIndexPrefix defines the prefix for storing the contracts by code ID index.
+	ContractCodeIDIndexPrefix = collections.NewPrefix([]byte{0x00, 0x03})
 	// BlockRewardsPrefix defines the prefix for storing BlockRewards objects.
 	BlockRewardsPrefix = collections.NewPrefix([]byte{0x01, 0x00})
 	// TxRewardsPrefix defines the prefix for storing TxRewards objects.
@@ -31,14 +37,74 @@ var (
 	TxRewardsHeightIndexPrefix = collections.NewPrefix([]byte{0x02, 0x01})
 	// MinConsFeePrefix defines the prefix for storing minimum consensus fee.
 	MinConsFeePrefix = collections.NewPrefix([]byte{0x03, 0x00})
+	// MinConsFeeHistoryPrefix defines the prefix for storing the minimum consensus fee computed per block.
+	MinConsFeeHistoryPrefix = collections.NewPrefix([]byte{0x03, 0x01})
 	// RewardsRecordsIDPrefix defines the prefix for storing RewardsRecord last ID.
 	RewardsRecordsIDPrefix = collections.NewPrefix([]byte{0x04, 0x00})
 	// RewwardsRecordStatePrefix defines the prefix for storing RewardsRecord state.
 	RewardsRecordStatePrefix = collections.NewPrefix([]byte{0x04, 0x01})
 	// RewardsRecordAddressIndexPrefix defines the prefix for storing RewardsRecord's rewards address index.
 	RewardsRecordAddressIndexPrefix = collections.NewPrefix([]byte{0x04, 0x02})
+	// RewardsRecordAddressHeightIndexPrefix defines the prefix for storing RewardsRecord's rewards address and calculated height index.
+	RewardsRecordAddressHeightIndexPrefix = collections.NewPrefix([]byte{0x04, 0x03})
 	// FlatFeePrefix defines the prefix for storing flat fees.
 	FlatFeePrefix = collections.NewPrefix([]byte{0x05, 0x00})
+	// FlatFeeUpdateHeightPrefix defines the prefix for storing the last flat fee update height.
+	FlatFeeUpdateHeightPrefix = collections.NewPrefix([]byte{0x05, 0x01})
+	// FlatFeeSchedulePrefix defines the prefix for storing flat fee schedules.
+	FlatFeeSchedulePrefix = collections.NewPrefix([]byte{0x05, 0x02})
+	// FlatFeePayoutPrefix defines the prefix for storing the flat fees to be paid out directly at the block end.
+	FlatFeePayoutPrefix = collections.NewPrefix([]byte{0x05, 0x03})
+	// MethodFlatFeePrefix defines the prefix for storing flat fees per contract execute msg method.
+	MethodFlatFeePrefix = collections.NewPrefix([]byte{0x05, 0x04})
+	// FlatFeeBlockChargePrefix defines the prefix for storing the current block transactions charged the contract flat fees.
+	FlatFeeBlockChargePrefix = collections.NewPrefix([]byte{0x05, 0x05})
+	// FlatFeeCreditPrefix defines the prefix for storing the contract prepaid executions credits.
+	FlatFeeCreditPrefix = collections.NewPrefix([]byte{0x05, 0x06})
+	// FlatFeeOverridePrefix defines the prefix for storing the governance contract flat fee overrides.
+	FlatFeeOverridePrefix = collections.NewPrefix([]byte{0x05, 0x07})
+	// FlatFeeMultiplierPrefix defines the prefix for storing the contract flat fee minimum consensus fee multipliers.
+	FlatFeeMultiplierPrefix = collections.NewPrefix([]byte{0x05, 0x08})
+	// CodeFlatFeePrefix defines the prefix for storing the code ID default flat fees.
+	CodeFlatFeePrefix = collections.NewPrefix([]byte{0x05, 0x09})
 	// ParamsPrefix defines the prefix for storing params.
 	ParamsPrefix = collections.NewPrefix([]byte{0x06})
+	// TxFeeDistributionPrefix defines the prefix for storing TxFeeDistribution objects.
+	TxFeeDistributionPrefix = collections.NewPrefix([]byte{0x07, 0x00})
+	// TxFeeDistributionHeightIndexPrefix defines the prefix for storing TxFeeDistribution's height index.
+	TxFeeDistributionHeightIndexPrefix = collections.NewPrefix([]byte{0x07, 0x01})
+	// TxFeeDistributionHashIndexPrefix defines the prefix for storing TxFeeDistribution's tx hash index.
+	TxFeeDistributionHashIndexPrefix = collections.NewPrefix([]byte{0x07, 0x02})
+	// ContractTxRewardsPrefix defines the prefix for storing the contract rewards attributable to a transaction.
+	ContractTxRewardsPrefix = collections.NewPrefix([]byte{0x07, 0x03})
+	// ContractRewardsStatsPrefix defines the prefix for storing contract rewards stats.
+	ContractRewardsStatsPrefix = collections.NewPrefix([]byte{0x08, 0x00})
+	// ContractBlockRewardsPrefix defines the prefix for storing contract rewards per block.
+	ContractBlockRewardsPrefix = collections.NewPrefix([]byte{0x08, 0x01})
+	// ContractBlockFlatFeesPrefix defines the prefix for storing contract flat fees collected per block.
+	ContractBlockFlatFeesPrefix = collections.NewPrefix([]byte{0x08, 0x02})
+	// BlockPoolInflowsPrefix defines the prefix for storing the rewards pool inflows per block.
+	BlockPoolInflowsPrefix = collections.NewPrefix([]byte{0x08, 0x03})
+	// FreeTxsUsedPrefix defines the prefix for storing the number of fee-free transactions used per account.
+	FreeTxsUsedPrefix = collections.NewPrefix([]byte{0x09, 0x00})
+	// RewardsRemainderPrefix defines the prefix for storing the contract rewards remainders carried over to the next distribution.
+	RewardsRemainderPrefix = collections.NewPrefix([]byte{0x0A, 0x00})
+	// RewardsRemainderTotalPrefix defines the prefix for storing the total rewards remainders per denom.
+	RewardsRemainderTotalPrefix = collections.NewPrefix([]byte{0x0A, 0x01})
+	// ScheduledRewardsRatiosPrefix defines the prefix for storing the queued rewards ratios changes.
+	ScheduledRewardsRatiosPrefix = collections.NewPrefix([]byte{0x0B, 0x00})
+	// DistributedHeightsPrefix defines the prefix for storing the block heights the rewards are distributed for.
+	DistributedHeightsPrefix = collections.NewPrefix([]byte{0x0C, 0x00})
+)
+
+// Telemetry metric keys
+const (
+	// MetricKeyPrunedRecords is the number of tracking objects (BlockRewards, TxRewards, TxFeeDistribution) pruned per block.
+	MetricKeyPrunedRecords = "pruned_records"
+	// MetricKeyPrunedRewards is the total rewards amount (per denom) tracked by the pruned tracking objects.
+	MetricKeyPrunedRewards = "pruned_rewards"
+	// MetricKeyTxFeeOverpayment is the tx fees to min fee (gas fees and contract flat fees) ratio (per denom) sampled per tx.
+	MetricKeyTxFeeOverpayment = "tx_fee_overpayment"
+	// MetricLabelNameDenom is the metric label name for coin denoms.
+	MetricLabelNameDenom = "denom"
 )
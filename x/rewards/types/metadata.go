@@ -4,10 +4,20 @@ import (
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+const (
+	// RewardsSplitWeightTotal defines the fixed denominator for RewardsSplit weights (basis points).
+	RewardsSplitWeightTotal uint64 = 10_000
+	// GasRebateMultiplierBase defines the fixed denominator for the contract gas rebate multiplier (basis points, 1.0x).
+	GasRebateMultiplierBase uint64 = 10_000
+	// RewardsCallbackShareTotal defines the fixed denominator for the contract rewards callback share (basis points).
+	RewardsCallbackShareTotal uint64 = 10_000
+)
+
 // HasOwnerAddress returns true if the rewards address is set.
 func (m ContractMetadata) HasOwnerAddress() bool {
 	return m.OwnerAddress != ""
@@ -18,6 +28,89 @@ func (m ContractMetadata) HasRewardsAddress() bool {
 	return m.RewardsAddress != ""
 }
 
+// HasFlatFeeExemptCallers returns true if the flat fee exempt callers list is set.
+func (m ContractMetadata) HasFlatFeeExemptCallers() bool {
+	return len(m.FlatFeeExemptCallers) > 0
+}
+
+// IsFlatFeeExemptCaller returns true if the given caller address is not charged the contract flat fee.
+func (m ContractMetadata) IsFlatFeeExemptCaller(callerAddr string) bool {
+	for _, addr := range m.FlatFeeExemptCallers {
+		if addr == callerAddr {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasFlatFeeAcceptedDenoms returns true if the flat fee accepted denoms list is set.
+func (m ContractMetadata) HasFlatFeeAcceptedDenoms() bool {
+	return len(m.FlatFeeAcceptedDenoms) > 0
+}
+
+// IsFlatFeeDenomAccepted returns true if the contract flat fee could be paid in the given denom
+// (any denom is accepted if the list is empty).
+func (m ContractMetadata) IsFlatFeeDenomAccepted(denom string) bool {
+	return IsFeeDenomAccepted(m.FlatFeeAcceptedDenoms, denom)
+}
+
+// HasRewardsSplits returns true if the rewards splits list is set.
+func (m ContractMetadata) HasRewardsSplits() bool {
+	return len(m.RewardsSplits) > 0
+}
+
+// HasRewardsRecipients returns true if the rewards address or the rewards splits are set (rewards could be credited).
+func (m ContractMetadata) HasRewardsRecipients() bool {
+	return m.HasRewardsAddress() || m.HasRewardsSplits()
+}
+
+// SplitRewards splits the given rewards between RewardsSplits recipients proportionally to their weights.
+// Result is aligned with the RewardsSplits slice, each share is truncated, so leftovers might not be distributed.
+func (m ContractMetadata) SplitRewards(rewards sdk.Coins) []sdk.Coins {
+	shares := make([]sdk.Coins, 0, len(m.RewardsSplits))
+	for _, split := range m.RewardsSplits {
+		share := sdk.NewCoins()
+		for _, coin := range rewards {
+			amount := coin.Amount.Mul(math.NewIntFromUint64(split.Weight)).Quo(math.NewIntFromUint64(RewardsSplitWeightTotal))
+			share = share.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+		shares = append(shares, share)
+	}
+
+	return shares
+}
+
+// HasRewardsCallback returns true if the rewards callback contract is set.
+func (m ContractMetadata) HasRewardsCallback() bool {
+	return m.RewardsCallbackAddress != ""
+}
+
+// RewardsCallbackRewards returns the rewards callback share of the given rewards (truncated).
+func (m ContractMetadata) RewardsCallbackRewards(rewards sdk.Coins) sdk.Coins {
+	share := sdk.NewCoins()
+	for _, coin := range rewards {
+		amount := coin.Amount.Mul(math.NewIntFromUint64(m.RewardsCallbackShare)).Quo(math.NewIntFromUint64(RewardsCallbackShareTotal))
+		share = share.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+
+	return share
+}
+
+// EffectiveGasRebateMultiplier returns the contract gas rebate multiplier (basis points) clamped to the
+// [GasRebateMultiplierBase, maxMultiplier] range. Unset multiplier defaults to GasRebateMultiplierBase (1.0x),
+// maxMultiplier not exceeding GasRebateMultiplierBase disables custom multipliers.
+func (m ContractMetadata) EffectiveGasRebateMultiplier(maxMultiplier uint64) uint64 {
+	if m.GasRebateMultiplier <= GasRebateMultiplierBase || maxMultiplier <= GasRebateMultiplierBase {
+		return GasRebateMultiplierBase
+	}
+	if m.GasRebateMultiplier > maxMultiplier {
+		return maxMultiplier
+	}
+
+	return m.GasRebateMultiplier
+}
+
 // MustGetContractAddress returns the contract address.
 // CONTRACT: panics in case of an error.
 func (m ContractMetadata) MustGetContractAddress() sdk.AccAddress {
@@ -40,6 +133,17 @@ func (m ContractMetadata) MustGetRewardsAddress() sdk.AccAddress {
 	return addr
 }
 
+// MustGetRewardsCallbackAddress returns the rewards callback contract address.
+// CONTRACT: panics in case of an error.
+func (m ContractMetadata) MustGetRewardsCallbackAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.RewardsCallbackAddress)
+	if err != nil {
+		panic(fmt.Errorf("parsing rewards callback address (%s): %s", m.RewardsCallbackAddress, err))
+	}
+
+	return addr
+}
+
 // Validate performs object fields validation.
 // genesisValidation flag perform strict validation of the genesis state (some field must be set).
 func (m ContractMetadata) Validate(genesisValidation bool) error {
@@ -59,5 +163,93 @@ func (m ContractMetadata) Validate(genesisValidation bool) error {
 		}
 	}
 
+	for i, callerAddr := range m.FlatFeeExemptCallers {
+		if _, err := sdk.AccAddressFromBech32(callerAddr); err != nil {
+			return errorsmod.Wrapf(sdkErrors.ErrInvalidAddress, "invalid flat fee exempt caller address [%d]: %v", i, err)
+		}
+	}
+
+	denomsSet := make(map[string]struct{}, len(m.FlatFeeAcceptedDenoms))
+	for i, denom := range m.FlatFeeAcceptedDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return errorsmod.Wrapf(sdkErrors.ErrInvalidCoins, "invalid flat fee accepted denom [%d]: %v", i, err)
+		}
+		if _, ok := denomsSet[denom]; ok {
+			return errorsmod.Wrapf(sdkErrors.ErrInvalidCoins, "duplicated flat fee accepted denom [%d]: %s", i, denom)
+		}
+		denomsSet[denom] = struct{}{}
+	}
+
+	if m.HasRewardsSplits() {
+		if err := validateRewardsSplits(m.RewardsSplits); err != nil {
+			return err
+		}
+	}
+
+	if m.GasRebateMultiplier != 0 && m.GasRebateMultiplier < GasRebateMultiplierBase {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidRequest, "invalid gas rebate multiplier: must be GTE %d (if set)", GasRebateMultiplierBase)
+	}
+
+	if m.RewardsCallbackAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(m.RewardsCallbackAddress); err != nil {
+			return errorsmod.Wrapf(sdkErrors.ErrInvalidAddress, "invalid rewards callback address: %v", err)
+		}
+	}
+	if m.RewardsCallbackShare > RewardsCallbackShareTotal {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidRequest, "invalid rewards callback share: must be LTE %d", RewardsCallbackShareTotal)
+	}
+	if genesisValidation && m.HasRewardsCallback() != (m.RewardsCallbackShare > 0) {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidRequest, "rewards callback address and share must be set together")
+	}
+
 	return nil
 }
+
+// MustGetAddress returns the recipient address.
+// CONTRACT: panics in case of an error.
+func (m RewardsSplit) MustGetAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Address)
+	if err != nil {
+		panic(fmt.Errorf("parsing rewards split address (%s): %s", m.Address, err))
+	}
+
+	return addr
+}
+
+// validateRewardsSplits checks recipients are unique and weights sum up to RewardsSplitWeightTotal.
+func validateRewardsSplits(splits []RewardsSplit) error {
+	weightTotal := uint64(0)
+	addrSet := make(map[string]struct{}, len(splits))
+	for i, split := range splits {
+		if _, err := sdk.AccAddressFromBech32(split.Address); err != nil {
+			return errorsmod.Wrapf(sdkErrors.ErrInvalidAddress, "invalid rewards split address [%d]: %v", i, err)
+		}
+		if _, ok := addrSet[split.Address]; ok {
+			return errorsmod.Wrapf(sdkErrors.ErrInvalidRequest, "duplicated rewards split address [%d]: %s", i, split.Address)
+		}
+		addrSet[split.Address] = struct{}{}
+
+		if split.Weight == 0 || split.Weight > RewardsSplitWeightTotal {
+			return errorsmod.Wrapf(sdkErrors.ErrInvalidRequest, "invalid rewards split weight [%d]: must be in the (0, %d] range", i, RewardsSplitWeightTotal)
+		}
+		weightTotal += split.Weight
+	}
+
+	if weightTotal != RewardsSplitWeightTotal {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidRequest, "rewards split weights must sum up to %d: got %d", RewardsSplitWeightTotal, weightTotal)
+	}
+
+	return nil
+}
+
+// ContractRewardsEligibility defines the conditions for a contract to be credited the dApp rewards.
+type ContractRewardsEligibility struct {
+	HasMetadata          bool // contract metadata is set
+	HasRewardsRecipients bool // metadata rewards address or rewards splits are set
+	RewardsEnabled       bool // inflation rewards or tx fee rebate ratio is non-zero
+}
+
+// IsEligible returns true if all the conditions are met.
+func (e ContractRewardsEligibility) IsEligible() bool {
+	return e.HasMetadata && e.HasRewardsRecipients && e.RewardsEnabled
+}
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 
 	e2eTesting "github.com/archway-network/archway/e2e/testing"
@@ -17,7 +18,7 @@ func TestContractMetadataValidate(t *testing.T) {
 		errExpected         bool
 	}
 
-	accAddrs, _ := e2eTesting.GenAccounts(1)
+	accAddrs, _ := e2eTesting.GenAccounts(2)
 	accAddr := accAddrs[0]
 
 	contractAddr := e2eTesting.GenContractAddresses(1)[0]
@@ -50,6 +51,97 @@ func TestContractMetadataValidate(t *testing.T) {
 			},
 			errExpected: true,
 		},
+		{
+			name: "OK: with FlatFeeExemptCallers",
+			meta: rewardsTypes.ContractMetadata{
+				ContractAddress:      contractAddr.String(),
+				FlatFeeExemptCallers: []string{accAddr.String()},
+			},
+		},
+		{
+			name: "Fail: invalid FlatFeeExemptCallers",
+			meta: rewardsTypes.ContractMetadata{
+				ContractAddress:      contractAddr.String(),
+				FlatFeeExemptCallers: []string{accAddr.String(), "invalid"},
+			},
+			errExpected: true,
+		},
+		{
+			name: "OK: with FlatFeeAcceptedDenoms",
+			meta: rewardsTypes.ContractMetadata{
+				ContractAddress:       contractAddr.String(),
+				FlatFeeAcceptedDenoms: []string{"uarch", "ibc/usdc"},
+			},
+		},
+		{
+			name: "Fail: invalid FlatFeeAcceptedDenoms",
+			meta: rewardsTypes.ContractMetadata{
+				ContractAddress:       contractAddr.String(),
+				FlatFeeAcceptedDenoms: []string{"uarch", "1"},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: duplicated FlatFeeAcceptedDenoms",
+			meta: rewardsTypes.ContractMetadata{
+				ContractAddress:       contractAddr.String(),
+				FlatFeeAcceptedDenoms: []string{"uarch", "uarch"},
+			},
+			errExpected: true,
+		},
+		{
+			name: "OK: with RewardsSplits",
+			meta: rewardsTypes.ContractMetadata{
+				ContractAddress: contractAddr.String(),
+				RewardsSplits: []rewardsTypes.RewardsSplit{
+					{Address: accAddrs[0].String(), Weight: 4000},
+					{Address: accAddrs[1].String(), Weight: 6000},
+				},
+			},
+		},
+		{
+			name: "Fail: RewardsSplits weights sum mismatch",
+			meta: rewardsTypes.ContractMetadata{
+				ContractAddress: contractAddr.String(),
+				RewardsSplits: []rewardsTypes.RewardsSplit{
+					{Address: accAddrs[0].String(), Weight: 4000},
+					{Address: accAddrs[1].String(), Weight: 5000},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: RewardsSplits zero weight",
+			meta: rewardsTypes.ContractMetadata{
+				ContractAddress: contractAddr.String(),
+				RewardsSplits: []rewardsTypes.RewardsSplit{
+					{Address: accAddrs[0].String(), Weight: 10000},
+					{Address: accAddrs[1].String(), Weight: 0},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: RewardsSplits duplicated address",
+			meta: rewardsTypes.ContractMetadata{
+				ContractAddress: contractAddr.String(),
+				RewardsSplits: []rewardsTypes.RewardsSplit{
+					{Address: accAddrs[0].String(), Weight: 5000},
+					{Address: accAddrs[0].String(), Weight: 5000},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: RewardsSplits invalid address",
+			meta: rewardsTypes.ContractMetadata{
+				ContractAddress: contractAddr.String(),
+				RewardsSplits: []rewardsTypes.RewardsSplit{
+					{Address: "invalid", Weight: 10000},
+				},
+			},
+			errExpected: true,
+		},
 		{
 			name: "Fail: invalid RewardsAddress",
 			meta: rewardsTypes.ContractMetadata{
@@ -66,6 +158,58 @@ func TestContractMetadataValidate(t *testing.T) {
 			isGenesisValidation: true,
 			errExpected:         true,
 		},
+		{
+			name: "OK: GasRebateMultiplier",
+			meta: rewardsTypes.ContractMetadata{
+				ContractAddress:     contractAddr.String(),
+				GasRebateMultiplier: 15000,
+			},
+		},
+		{
+			name: "Fail: GasRebateMultiplier is LT 1.0x",
+			meta: rewardsTypes.ContractMetadata{
+				ContractAddress:     contractAddr.String(),
+				GasRebateMultiplier: 9999,
+			},
+			errExpected: true,
+		},
+		{
+			name: "OK: RewardsCallback",
+			meta: rewardsTypes.ContractMetadata{
+				ContractAddress:        contractAddr.String(),
+				OwnerAddress:           contractAddr.String(),
+				RewardsCallbackAddress: contractAddr.String(),
+				RewardsCallbackShare:   2500,
+			},
+			isGenesisValidation: true,
+		},
+		{
+			name: "Fail: invalid RewardsCallbackAddress",
+			meta: rewardsTypes.ContractMetadata{
+				ContractAddress:        contractAddr.String(),
+				RewardsCallbackAddress: "invalid",
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: RewardsCallbackShare is GT 100%",
+			meta: rewardsTypes.ContractMetadata{
+				ContractAddress:        contractAddr.String(),
+				RewardsCallbackAddress: contractAddr.String(),
+				RewardsCallbackShare:   10001,
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: RewardsCallbackShare without the address with genesis validation",
+			meta: rewardsTypes.ContractMetadata{
+				ContractAddress:      contractAddr.String(),
+				OwnerAddress:         contractAddr.String(),
+				RewardsCallbackShare: 2500,
+			},
+			isGenesisValidation: true,
+			errExpected:         true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -79,3 +223,108 @@ func TestContractMetadataValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestContractMetadataSplitRewards(t *testing.T) {
+	accAddrs, _ := e2eTesting.GenAccounts(3)
+
+	type testCase struct {
+		name           string
+		splits         []rewardsTypes.RewardsSplit
+		rewards        string
+		sharesExpected []string
+	}
+
+	testCases := []testCase{
+		{
+			name: "Even split",
+			splits: []rewardsTypes.RewardsSplit{
+				{Address: accAddrs[0].String(), Weight: 5000},
+				{Address: accAddrs[1].String(), Weight: 5000},
+			},
+			rewards:        "100stake,50uarch",
+			sharesExpected: []string{"50stake,25uarch", "50stake,25uarch"},
+		},
+		{
+			name: "Weighted split",
+			splits: []rewardsTypes.RewardsSplit{
+				{Address: accAddrs[0].String(), Weight: 1000},
+				{Address: accAddrs[1].String(), Weight: 2500},
+				{Address: accAddrs[2].String(), Weight: 6500},
+			},
+			rewards:        "1000stake",
+			sharesExpected: []string{"100stake", "250stake", "650stake"},
+		},
+		{
+			name: "Remainders are truncated",
+			splits: []rewardsTypes.RewardsSplit{
+				{Address: accAddrs[0].String(), Weight: 3333},
+				{Address: accAddrs[1].String(), Weight: 3333},
+				{Address: accAddrs[2].String(), Weight: 3334},
+			},
+			rewards:        "10stake,1uarch",
+			sharesExpected: []string{"3stake", "3stake", "3stake"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			meta := rewardsTypes.ContractMetadata{RewardsSplits: tc.splits}
+
+			rewards, err := sdk.ParseCoinsNormalized(tc.rewards)
+			assert.NoError(t, err)
+
+			shares := meta.SplitRewards(rewards)
+			assert.Len(t, shares, len(tc.sharesExpected))
+
+			sharesTotal := sdk.NewCoins()
+			for i, share := range shares {
+				assert.Equal(t, tc.sharesExpected[i], share.String())
+				sharesTotal = sharesTotal.Add(share...)
+			}
+			assert.True(t, rewards.IsAllGTE(sharesTotal))
+		})
+	}
+}
+
+func TestContractMetadataEffectiveGasRebateMultiplier(t *testing.T) {
+	type testCase struct {
+		name               string
+		multiplier         uint64
+		maxMultiplier      uint64
+		multiplierExpected uint64
+	}
+
+	testCases := []testCase{
+		{
+			name:               "Default multiplier",
+			multiplier:         0,
+			maxMultiplier:      20000,
+			multiplierExpected: rewardsTypes.GasRebateMultiplierBase,
+		},
+		{
+			name:               "Custom multiplier",
+			multiplier:         15000,
+			maxMultiplier:      20000,
+			multiplierExpected: 15000,
+		},
+		{
+			name:               "Custom multiplier is clamped",
+			multiplier:         30000,
+			maxMultiplier:      20000,
+			multiplierExpected: 20000,
+		},
+		{
+			name:               "Custom multipliers are disabled",
+			multiplier:         15000,
+			maxMultiplier:      0,
+			multiplierExpected: rewardsTypes.GasRebateMultiplierBase,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			meta := rewardsTypes.ContractMetadata{GasRebateMultiplier: tc.multiplier}
+			assert.Equal(t, tc.multiplierExpected, meta.EffectiveGasRebateMultiplier(tc.maxMultiplier))
+		})
+	}
+}
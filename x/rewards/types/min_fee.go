@@ -0,0 +1,480 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/archway-network/archway/pkg"
+)
+
+// MinGasFees returns the minimum gas fees for the given gas unit price and tx gas limit (contract flat fees excluded).
+func MinGasFees(gasPrice sdk.DecCoin, txGas uint64) sdk.Coins {
+	return sdk.NewCoins(
+		sdk.NewCoin(
+			gasPrice.Denom,
+			gasPrice.Amount.Mul(pkg.NewDecFromUint64(txGas)).TruncateInt(),
+		),
+	)
+}
+
+// MaxGasAdjustment defines the max gas adjustment factor accepted by AdjustedGasLimit.
+const MaxGasAdjustment = 100
+
+// MaxMinConsensusFeeProjectionWindow defines the max number of recent blocks the projected min consensus fee trend
+// is taken from (block rewards tracking entries are kept for the last 10 blocks only).
+const MaxMinConsensusFeeProjectionWindow = 10
+
+// MinConsensusFeeHistoryBlocks defines the number of recent blocks the minimum consensus fee is kept for (the max
+// MinConsensusFeeSmoothingWindow param value).
+const MinConsensusFeeHistoryBlocks = 100
+
+// TxFeeEstimateVersion defines the current QueryTxFeeEstimateResponse shape version.
+// Bumped every time a new fee component is added to the response.
+const TxFeeEstimateVersion uint32 = 1
+
+// WithdrawTxBaseGas and WithdrawRecordGas define the withdraw-all transaction gas model used to estimate the withdraw
+// min fee if the gas limit is not known: the tx overhead (signatures verification, fee deduction) plus the gas of
+// a single RewardsRecord processing (read, prune and the rewards transfer share). Values are upper bounds taken with
+// a margin, the tx simulation should be used for a precise gas limit.
+const (
+	WithdrawTxBaseGas uint64 = 100_000
+	WithdrawRecordGas uint64 = 10_000
+)
+
+// WithdrawTxGasLimit returns the estimated gas limit of a withdraw transaction processing the given number of records.
+func WithdrawTxGasLimit(recordsNum uint64) uint64 {
+	return WithdrawTxBaseGas + recordsNum*WithdrawRecordGas
+}
+
+// AdjustedGasLimit returns the tx gas limit for the simulated gas multiplied by the client gas adjustment factor
+// (rounded up, so the min fee estimated for it is never lower than the one for the gas limit set by the client).
+// An error is returned if the factor is not within the [1.0, MaxGasAdjustment] range (the tx would run out of gas below 1.0)
+// or the result overflows uint64.
+func AdjustedGasLimit(simulatedGas uint64, gasAdjustment math.LegacyDec) (uint64, error) {
+	if gasAdjustment.IsNil() || gasAdjustment.LT(math.LegacyOneDec()) || gasAdjustment.GT(math.LegacyNewDec(MaxGasAdjustment)) {
+		return 0, errorsmod.Wrapf(ErrInvalidRequest, "gas adjustment (%s) must be within the [1.0, %d] range", gasAdjustment, MaxGasAdjustment)
+	}
+
+	gasLimit := pkg.NewDecFromUint64(simulatedGas).Mul(gasAdjustment).Ceil().TruncateInt()
+	if !gasLimit.IsUint64() {
+		return 0, errorsmod.Wrapf(ErrInvalidRequest, "adjusted gas limit (%s) overflows uint64", gasLimit)
+	}
+
+	return gasLimit.Uint64(), nil
+}
+
+// TxSizeFees returns the min fee surcharge for the given encoded tx size (empty if the fee per byte is zero).
+func TxSizeFees(denom string, txSize int, feePerByte uint64) sdk.Coins {
+	return sdk.NewCoins(
+		sdk.NewCoin(
+			denom,
+			math.NewIntFromUint64(feePerByte).MulRaw(int64(txSize)),
+		),
+	)
+}
+
+// MinTxFees returns the tx min fee combining the gas fees and the contract flat fees.
+// Every denom is handled independently: the gas fees are rounded (truncated) in the gas price denom before being
+// combined and the flat fees are whole amounts, so no denom is ever rounded against another one.
+// Same-denom amounts are summed. An error is returned if any of the inputs is not a valid coins set.
+func MinTxFees(gasFees, flatFees sdk.Coins) (sdk.Coins, error) {
+	if err := gasFees.Validate(); err != nil {
+		return nil, errorsmod.Wrapf(ErrInternal, "invalid gas fees (%s): %v", gasFees, err)
+	}
+	if err := flatFees.Validate(); err != nil {
+		return nil, errorsmod.Wrapf(ErrInternal, "invalid flat fees (%s): %v", flatFees, err)
+	}
+
+	return gasFees.Add(flatFees...), nil
+}
+
+// AbsorbFlatFees returns the gas fees left after the same denom flat fees are counted toward them (floored at zero).
+// Combined with the flat fees (MinTxFees), the min fee for a shared denom is the max of the gas and flat fees instead of
+// their sum. Flat fees in other denoms are not affected.
+func AbsorbFlatFees(gasFees, flatFees sdk.Coins) sdk.Coins {
+	res := sdk.NewCoins()
+	for _, gasFee := range gasFees {
+		if amount := gasFee.Amount.Sub(flatFees.AmountOf(gasFee.Denom)); amount.IsPositive() {
+			res = res.Add(sdk.NewCoin(gasFee.Denom, amount))
+		}
+	}
+
+	return res
+}
+
+// SplitTxGas splits the tx gas limit across the tx msgs proportionally to the msgs declared gas (truncated).
+// The rounding remainder is assigned to the last msg, so the shares always sum up to the tx gas limit.
+// The gas is split evenly if no msg gas is declared.
+func SplitTxGas(txGas uint64, msgsGas []uint64) []uint64 {
+	if len(msgsGas) == 0 {
+		return nil
+	}
+
+	totalMsgsGas := math.ZeroInt()
+	for _, msgGas := range msgsGas {
+		totalMsgsGas = totalMsgsGas.Add(math.NewIntFromUint64(msgGas))
+	}
+
+	shares, sharesTotal := make([]uint64, len(msgsGas)), uint64(0)
+	for i := 0; i < len(msgsGas)-1; i++ {
+		if totalMsgsGas.IsZero() {
+			shares[i] = txGas / uint64(len(msgsGas))
+		} else {
+			shares[i] = math.NewIntFromUint64(txGas).Mul(math.NewIntFromUint64(msgsGas[i])).Quo(totalMsgsGas).Uint64()
+		}
+		sharesTotal += shares[i]
+	}
+	shares[len(shares)-1] = txGas - sharesTotal
+
+	return shares
+}
+
+// AbsorbMsgFlatFees returns the gas fees left after every msg flat fees are counted toward the gas fees of the msg gas
+// share (floored at zero). Unlike AbsorbFlatFees, a msg flat fee can only absorb the gas fees of its own msg, so
+// the min fee reflects the gas the other msgs of a batch consume. Only the gas price denom flat fees are absorbed.
+// CONTRACT: msgsGas and msgsFlatFees have the same length.
+func AbsorbMsgFlatFees(gasFees sdk.Coins, gasPrice sdk.DecCoin, msgsGas []uint64, msgsFlatFees []sdk.Coins) sdk.Coins {
+	absorbed := math.ZeroInt()
+	for i, msgFlatFees := range msgsFlatFees {
+		flatFeeAmt := msgFlatFees.AmountOf(gasPrice.Denom)
+		if flatFeeAmt.IsZero() {
+			continue
+		}
+		absorbed = absorbed.Add(math.MinInt(flatFeeAmt, MinGasFees(gasPrice, msgsGas[i]).AmountOf(gasPrice.Denom)))
+	}
+
+	return AbsorbFlatFees(gasFees, sdk.NewCoins(sdk.NewCoin(gasPrice.Denom, absorbed)))
+}
+
+// ActiveDiscount returns the fee promotion discount (basis points) applied at the given block height (zero if the
+// promotion is disabled or the height is out of the [StartHeight, EndHeight] window).
+func (m FeePromotion) ActiveDiscount(height int64) uint64 {
+	if m.Discount == 0 || height < m.StartHeight || height > m.EndHeight {
+		return 0
+	}
+
+	return m.Discount
+}
+
+// DiscountGasPrice returns the gas price with the discount (basis points) applied.
+func DiscountGasPrice(gasPrice sdk.DecCoin, discount uint64) sdk.DecCoin {
+	if discount == 0 {
+		return gasPrice
+	}
+
+	return sdk.NewDecCoinFromDec(
+		gasPrice.Denom,
+		gasPrice.Amount.MulInt64(int64(FeePromotionDiscountBase-discount)).QuoInt64(int64(FeePromotionDiscountBase)),
+	)
+}
+
+// ApplyFeeDiscount returns the fees with the discount (basis points) applied (see DiscountFee).
+func ApplyFeeDiscount(fees sdk.Coins, discount uint64) sdk.Coins {
+	if discount == 0 || fees.IsZero() {
+		return fees
+	}
+
+	res := sdk.NewCoins()
+	for _, fee := range fees {
+		res = res.Add(DiscountFee(fee, discount))
+	}
+
+	return res
+}
+
+// DiscountFee returns the fee with the discount (basis points) applied. The amount is rounded up, so a non-zero fee
+// stays non-zero for any discount LT 100%.
+func DiscountFee(fee sdk.Coin, discount uint64) sdk.Coin {
+	if discount == 0 {
+		return fee
+	}
+
+	amount := math.LegacyNewDecFromInt(fee.Amount).
+		MulInt64(int64(FeePromotionDiscountBase - discount)).
+		QuoInt64(int64(FeePromotionDiscountBase))
+
+	return sdk.NewCoin(fee.Denom, amount.Ceil().TruncateInt())
+}
+
+// ConvertFlatFee returns the contract flat fee to be charged for a tx paying the given fees.
+// If the tx fees have no flat fee denom, the flat fee is converted to the first tx fee denom (in the sdk.Coins order)
+// with a configured conversion rate (the converted amount is rounded up). Otherwise, the flat fee is returned as is.
+func ConvertFlatFee(flatFee sdk.Coin, txFees sdk.Coins, rates []FlatFeeConversionRate) sdk.Coin {
+	if len(rates) == 0 || txFees.AmountOf(flatFee.Denom).IsPositive() {
+		return flatFee
+	}
+
+	for _, txFee := range txFees {
+		for _, rate := range rates {
+			if rate.Denom != flatFee.Denom || rate.FeeDenom != txFee.Denom {
+				continue
+			}
+
+			amount := math.LegacyNewDecFromInt(flatFee.Amount).Mul(rate.Rate).Ceil().TruncateInt()
+			return sdk.NewCoin(txFee.Denom, amount)
+		}
+	}
+
+	return flatFee
+}
+
+// MinFeeFloor returns the minimum fee of 1 unit of the given denom used instead of a zero minimum fee (if enabled).
+func MinFeeFloor(denom string) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(denom, math.OneInt()))
+}
+
+// IsFeeSufficient checks whether the tx fees cover the expected fees.
+// Zero expected fees are always covered.
+// Every expected denom is matched explicitly against the same denom amount within the tx fees and must be covered:
+// other tx fee denoms are never considered (a single-denom minimum fee is only covered by the amount of that denom).
+func IsFeeSufficient(txFees, expectedFees sdk.Coins) bool {
+	for _, expectedFee := range expectedFees {
+		if !isDenomFeeCovered(txFees, expectedFee) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsFeeDenomAccepted checks if tx fees could be paid in the given denom (any denom is accepted if the list is empty).
+func IsFeeDenomAccepted(acceptedDenoms []string, denom string) bool {
+	if len(acceptedDenoms) == 0 {
+		return true
+	}
+
+	for _, acceptedDenom := range acceptedDenoms {
+		if acceptedDenom == denom {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsTxFeeSufficient checks whether the tx fees cover both the gas fees and the contract flat fees.
+// Flat fees are paid to contracts, so every flat fee denom must be covered independently. The tx fees left after
+// the flat fees are taken (per denom) must cover the gas fees. If the gas and flat fees share a denom, the tx fees
+// must cover their sum for that denom.
+func IsTxFeeSufficient(txFees, gasFees, flatFees sdk.Coins) bool {
+	for _, flatFee := range flatFees {
+		if !isDenomFeeCovered(txFees, flatFee) {
+			return false
+		}
+	}
+
+	gasTxFees := sdk.NewCoins()
+	for _, txFee := range txFees {
+		gasTxFees = gasTxFees.Add(sdk.NewCoin(txFee.Denom, txFee.Amount.Sub(flatFees.AmountOf(txFee.Denom))))
+	}
+
+	return IsFeeSufficient(gasTxFees, gasFees)
+}
+
+// TxFeeSplit defines the tx fees split between the min fee portions (refer to SplitTxFee).
+type TxFeeSplit struct {
+	// GasFees is the tx fees amount covering the gas fees.
+	GasFees sdk.Coins
+	// FlatFees is the tx fees amount covering every contract flat fee (aligned with the flat fees input).
+	FlatFees []sdk.Coins
+	// Surplus is the tx fees amount left once all the portions are covered.
+	Surplus sdk.Coins
+}
+
+// SplitTxFee splits the tx fees the same way IsTxFeeSufficient matches them: contract flat fees are taken first
+// (in the given order, per denom), the tx fees left cover the gas fees (per denom), the rest is the surplus.
+// A portion not covered by the tx fees is filled partially.
+func SplitTxFee(txFees, gasFees sdk.Coins, flatFees []sdk.Coins) TxFeeSplit {
+	split := TxFeeSplit{
+		FlatFees: make([]sdk.Coins, 0, len(flatFees)),
+	}
+
+	txFeesLeft := txFees
+	for _, flatFee := range flatFees {
+		covered := coveredFees(txFeesLeft, flatFee)
+		split.FlatFees = append(split.FlatFees, covered)
+		txFeesLeft = txFeesLeft.Sub(covered...)
+	}
+	split.GasFees = coveredFees(txFeesLeft, gasFees)
+	split.Surplus = txFeesLeft.Sub(split.GasFees...)
+
+	return split
+}
+
+// coveredFees returns the expected fees amount (per denom) covered by the tx fees.
+func coveredFees(txFees, expectedFees sdk.Coins) sdk.Coins {
+	covered := sdk.NewCoins()
+	for _, expectedFee := range expectedFees {
+		covered = covered.Add(sdk.NewCoin(expectedFee.Denom, math.MinInt(txFees.AmountOf(expectedFee.Denom), expectedFee.Amount)))
+	}
+
+	return covered
+}
+
+// isDenomFeeCovered checks whether the tx fees amount of the expected fee denom covers the expected fee.
+func isDenomFeeCovered(txFees sdk.Coins, expectedFee sdk.Coin) bool {
+	return txFees.AmountOf(expectedFee.Denom).GTE(expectedFee.Amount)
+}
+
+// FeeShortfall returns the expected fees amount (per denom) not covered by the tx fees.
+func FeeShortfall(txFees, expectedFees sdk.Coins) sdk.Coins {
+	shortfall := sdk.NewCoins()
+	for _, expectedFee := range expectedFees {
+		if !isDenomFeeCovered(txFees, expectedFee) {
+			shortfall = shortfall.Add(sdk.NewCoin(expectedFee.Denom, expectedFee.Amount.Sub(txFees.AmountOf(expectedFee.Denom))))
+		}
+	}
+
+	return shortfall
+}
+
+// recommendedFeeLogKey defines the InsufficientFeeError message key the recommended fee is reported with.
+const recommendedFeeLogKey = "recommended_fee="
+
+var _ error = (*InsufficientFeeError)(nil)
+
+// InsufficientFeeError is returned by the MinFeeDecorator if the tx fee does not cover the min fee.
+// The error is the sdkErrors.ErrInsufficientFee (ABCI code and errors.Is) carrying the fee to resubmit the tx with.
+// The recommended fee is also reported within the error message (ABCI log), refer to ParseRecommendedFee.
+type InsufficientFeeError struct {
+	// TxFees are the fees provided by the tx (could be empty).
+	TxFees sdk.Coins
+	// RecommendedFees are the fees the tx should be resubmitted with: the full min fee (gas fees and contract flat fees).
+	RecommendedFees sdk.Coins
+}
+
+// NewInsufficientFeeError creates a new InsufficientFeeError instance.
+func NewInsufficientFeeError(txFees, minFees sdk.Coins) *InsufficientFeeError {
+	return &InsufficientFeeError{
+		TxFees:          txFees,
+		RecommendedFees: minFees,
+	}
+}
+
+// Error implements the error interface.
+func (e *InsufficientFeeError) Error() string {
+	txFees := sdk.Coins(e.TxFees).String()
+	if txFees == "" {
+		txFees = "<empty>"
+	}
+
+	return fmt.Sprintf("tx fee %s is less than min fee: %s (%s%s): %s",
+		txFees, e.RecommendedFees, recommendedFeeLogKey, e.RecommendedFees, sdkErrors.ErrInsufficientFee.Error(),
+	)
+}
+
+// Cause returns the registered error (used by the errorsmod ABCI code and codespace lookup).
+func (e *InsufficientFeeError) Cause() error {
+	return sdkErrors.ErrInsufficientFee
+}
+
+// Unwrap returns the registered error (used by errors.Is).
+func (e *InsufficientFeeError) Unwrap() error {
+	return sdkErrors.ErrInsufficientFee
+}
+
+// ParseRecommendedFee parses the recommended fee from the InsufficientFeeError message (the tx ABCI log).
+// Returns false if the message has no valid recommended fee.
+func ParseRecommendedFee(log string) (sdk.Coins, bool) {
+	_, feeStr, found := strings.Cut(log, recommendedFeeLogKey)
+	if !found {
+		return nil, false
+	}
+	if feeStr, _, found = strings.Cut(feeStr, ")"); !found {
+		return nil, false
+	}
+
+	fees, err := sdk.ParseCoinsNormalized(feeStr)
+	if err != nil || fees.IsZero() {
+		return nil, false
+	}
+
+	return fees, true
+}
+
+type txMinFeeCtxKey struct{}
+
+// WithTxMinFee returns a new context with the tx min fee (gas fees and contract flat fees) covered by the tx fees set.
+func WithTxMinFee(ctx sdk.Context, minFees sdk.Coins) sdk.Context {
+	return ctx.WithValue(txMinFeeCtxKey{}, minFees)
+}
+
+// GetTxMinFee returns the tx min fee from the context if set.
+func GetTxMinFee(ctx sdk.Context) (sdk.Coins, bool) {
+	minFees, ok := ctx.Value(txMinFeeCtxKey{}).(sdk.Coins)
+	return minFees, ok
+}
+
+type txFlatFeesCtxKey struct{}
+
+// WithTxFlatFees returns a new context with the contract flat fees charged by the tx set
+// (executions covered by the prepaid credits are not included).
+func WithTxFlatFees(ctx sdk.Context, flatFees sdk.Coins) sdk.Context {
+	return ctx.WithValue(txFlatFeesCtxKey{}, flatFees)
+}
+
+// GetTxFlatFees returns the contract flat fees charged by the tx from the context if set.
+func GetTxFlatFees(ctx sdk.Context) (sdk.Coins, bool) {
+	flatFees, ok := ctx.Value(txFlatFeesCtxKey{}).(sdk.Coins)
+	return flatFees, ok
+}
+
+// DeferredFlatFee defines a contract flat fee charged once the tx msgs are executed successfully.
+type DeferredFlatFee struct {
+	// MsgIndex is the index of the tx msg the flat fee is charged for.
+	MsgIndex int
+	// ContractAddress is the contract the flat fee is charged for.
+	ContractAddress sdk.AccAddress
+	// FlatFees is the flat fee amount.
+	FlatFees sdk.Coins
+}
+
+// DeferredFlatFees defines the contract flat fees the MinFeeDecorator Ante handler defers to the post handler
+// (contracts with the flat_fee_on_success metadata flag set).
+type DeferredFlatFees struct {
+	// FeePayer is the account the deferred flat fees are charged from (set by the DeductFeeDecorator).
+	FeePayer sdk.AccAddress
+	// Fees are the deferred flat fees (in the tx msgs order).
+	Fees []DeferredFlatFee
+}
+
+// Total returns the total amount of the deferred flat fees.
+func (f DeferredFlatFees) Total() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, fee := range f.Fees {
+		total = total.Add(fee.FlatFees...)
+	}
+
+	return total
+}
+
+type deferredFlatFeesCtxKey struct{}
+
+// WithDeferredFlatFees returns a new context with the deferred contract flat fees set.
+func WithDeferredFlatFees(ctx sdk.Context, fees DeferredFlatFees) sdk.Context {
+	return ctx.WithValue(deferredFlatFeesCtxKey{}, fees)
+}
+
+// GetDeferredFlatFees returns the deferred contract flat fees from the context if set.
+func GetDeferredFlatFees(ctx sdk.Context) (DeferredFlatFees, bool) {
+	fees, ok := ctx.Value(deferredFlatFeesCtxKey{}).(DeferredFlatFees)
+	return fees, ok
+}
+
+// FeeOverpaymentRatios returns the tx fees to min fees ratio per min fee denom (zero min fee denoms are skipped).
+func FeeOverpaymentRatios(txFees, minFees sdk.Coins) map[string]math.LegacyDec {
+	ratios := make(map[string]math.LegacyDec, len(minFees))
+	for _, minFee := range minFees {
+		if !minFee.Amount.IsPositive() {
+			continue
+		}
+		ratios[minFee.Denom] = math.LegacyNewDecFromInt(txFees.AmountOf(minFee.Denom)).QuoInt(minFee.Amount)
+	}
+
+	return ratios
+}
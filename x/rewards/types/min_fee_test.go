@@ -0,0 +1,644 @@
+package types_test
+
+import (
+	"encoding/hex"
+	"math"
+	"testing"
+
+	sdkMath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
+)
+
+func TestIsFeeSufficient(t *testing.T) {
+	type testCase struct {
+		name         string
+		txFees       string // [sdk.Coins]
+		expectedFees string // [sdk.Coins]
+		// Output expected
+		sufficient bool
+		shortfall  string // [sdk.Coins]
+	}
+
+	testCases := []testCase{
+		{
+			name:         "Single denom: OK: only the min fee denom is covered",
+			txFees:       "1uarch,100stake",
+			expectedFees: "100stake",
+			sufficient:   true,
+		},
+		{
+			name:         "Single denom: Fail: other denoms do not count",
+			txFees:       "1000000uarch,99stake",
+			expectedFees: "100stake",
+			shortfall:    "1stake",
+		},
+		{
+			name:         "Single denom: Fail: other denoms do not count (multiple)",
+			txFees:       "1000000aaa,1000000uarch,99stake",
+			expectedFees: "100stake",
+			shortfall:    "1stake",
+		},
+		{
+			name:         "Single denom: Fail: min fee denom is missing",
+			txFees:       "1000000aaa,1000000uarch",
+			expectedFees: "100stake",
+			shortfall:    "100stake",
+		},
+		{
+			name:         "Multi denom: OK: every denom covered",
+			txFees:       "100stake,50uarch,1zzz",
+			expectedFees: "100stake,50uarch",
+			sufficient:   true,
+		},
+		{
+			name:         "Multi denom: Fail: one denom not covered",
+			txFees:       "1000stake,49uarch",
+			expectedFees: "100stake,50uarch",
+			shortfall:    "1uarch",
+		},
+		{
+			name:         "Multi denom: Fail: a single denom covered",
+			txFees:       "99stake,50uarch",
+			expectedFees: "100stake,50uarch",
+			shortfall:    "1stake",
+		},
+		{
+			name:         "Multi denom: Fail: no denom covered",
+			txFees:       "99stake,49uarch,1000zzz",
+			expectedFees: "100stake,50uarch",
+			shortfall:    "1stake,1uarch",
+		},
+		{
+			name:         "Zero expected fees: OK",
+			txFees:       "",
+			expectedFees: "",
+			sufficient:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			txFees, err := sdk.ParseCoinsNormalized(tc.txFees)
+			require.NoError(t, err)
+			expectedFees, err := sdk.ParseCoinsNormalized(tc.expectedFees)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.sufficient, rewardsTypes.IsFeeSufficient(txFees, expectedFees))
+			assert.Equal(t, tc.shortfall, rewardsTypes.FeeShortfall(txFees, expectedFees).String())
+		})
+	}
+}
+
+func TestIsTxFeeSufficient(t *testing.T) {
+	type testCase struct {
+		name     string
+		txFees   string // [sdk.Coins]
+		gasFees  string // [sdk.Coins]
+		flatFees string // [sdk.Coins]
+		// Output expected
+		sufficient bool
+	}
+
+	testCases := []testCase{
+		{
+			name:       "Same denom: OK: the sum is covered",
+			txFees:     "150stake",
+			gasFees:    "100stake",
+			flatFees:   "50stake",
+			sufficient: true,
+		},
+		{
+			name:     "Same denom: Fail: only the flat fee is covered",
+			txFees:   "149stake",
+			gasFees:  "100stake",
+			flatFees: "50stake",
+		},
+		{
+			name:     "Same denom: Fail: other denoms do not cover the gas fee",
+			txFees:   "100stake,1000uarch",
+			gasFees:  "100stake",
+			flatFees: "50stake",
+		},
+		{
+			name:       "Cross denom: OK: each denom is covered",
+			txFees:     "100stake,50uarch",
+			gasFees:    "100stake",
+			flatFees:   "50uarch",
+			sufficient: true,
+		},
+		{
+			name:     "Cross denom: Fail: only the gas fee denom is covered",
+			txFees:   "1000stake",
+			gasFees:  "100stake",
+			flatFees: "50uarch",
+		},
+		{
+			name:     "Cross denom: Fail: only the flat fee denom is covered",
+			txFees:   "99stake,1000uarch",
+			gasFees:  "100stake",
+			flatFees: "50uarch",
+		},
+		{
+			name:       "Mixed denoms: OK: the shared denom sum and the other flat fee denom are covered",
+			txFees:     "130stake,50uarch",
+			gasFees:    "100stake",
+			flatFees:   "30stake,50uarch",
+			sufficient: true,
+		},
+		{
+			name:     "Mixed denoms: Fail: the shared denom sum is not covered",
+			txFees:   "129stake,50uarch",
+			gasFees:  "100stake",
+			flatFees: "30stake,50uarch",
+		},
+		{
+			name:       "Fee abstraction: OK: exact coverage of the gas and flat fee denoms",
+			txFees:     "10aaa,5bbb",
+			gasFees:    "10aaa",
+			flatFees:   "5bbb",
+			sufficient: true,
+		},
+		{
+			name:     "Fee abstraction: Fail: the gas fee denom is short",
+			txFees:   "9aaa,5bbb",
+			gasFees:  "10aaa",
+			flatFees: "5bbb",
+		},
+		{
+			name:     "Fee abstraction: Fail: the flat fee denom is short (the gas fee denom excess does not cover it)",
+			txFees:   "100aaa,4bbb",
+			gasFees:  "10aaa",
+			flatFees: "5bbb",
+		},
+		{
+			name:     "Fee abstraction: Fail: the gas fee denom is short (the flat fee denom excess does not cover it)",
+			txFees:   "9aaa,100bbb",
+			gasFees:  "10aaa",
+			flatFees: "5bbb",
+		},
+		{
+			name:       "Fee abstraction: OK: overpayment in both denoms",
+			txFees:     "11aaa,6bbb",
+			gasFees:    "10aaa",
+			flatFees:   "5bbb",
+			sufficient: true,
+		},
+		{
+			name:       "No flat fees: OK: gas fees covered",
+			txFees:     "100stake",
+			gasFees:    "100stake",
+			flatFees:   "",
+			sufficient: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			txFees, err := sdk.ParseCoinsNormalized(tc.txFees)
+			require.NoError(t, err)
+			gasFees, err := sdk.ParseCoinsNormalized(tc.gasFees)
+			require.NoError(t, err)
+			flatFees, err := sdk.ParseCoinsNormalized(tc.flatFees)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.sufficient, rewardsTypes.IsTxFeeSufficient(txFees, gasFees, flatFees))
+		})
+	}
+}
+
+func TestMinTxFees(t *testing.T) {
+	type testCase struct {
+		name     string
+		gasFees  sdk.Coins
+		flatFees sdk.Coins
+		// Output expected
+		minFeesExpected string // [sdk.Coins]
+		errExpected     bool
+	}
+
+	testCases := []testCase{
+		{
+			name:            "OK: gas fees in denom A, flat fees in denoms B and C",
+			gasFees:         sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			flatFees:        sdk.NewCoins(sdk.NewInt64Coin("uarch", 50), sdk.NewInt64Coin("ubtc", 30)),
+			minFeesExpected: "100stake,50uarch,30ubtc",
+		},
+		{
+			name:            "OK: gas fees denom sorted after the flat fees denoms",
+			gasFees:         sdk.NewCoins(sdk.NewInt64Coin("zstake", 100)),
+			flatFees:        sdk.NewCoins(sdk.NewInt64Coin("uarch", 50), sdk.NewInt64Coin("ubtc", 30)),
+			minFeesExpected: "50uarch,30ubtc,100zstake",
+		},
+		{
+			name:            "OK: shared denom is summed",
+			gasFees:         sdk.NewCoins(sdk.NewInt64Coin("uarch", 100)),
+			flatFees:        sdk.NewCoins(sdk.NewInt64Coin("uarch", 50), sdk.NewInt64Coin("ubtc", 30)),
+			minFeesExpected: "150uarch,30ubtc",
+		},
+		{
+			name:            "OK: empty gas fees",
+			flatFees:        sdk.NewCoins(sdk.NewInt64Coin("uarch", 50), sdk.NewInt64Coin("ubtc", 30)),
+			minFeesExpected: "50uarch,30ubtc",
+		},
+		{
+			name:        "Fail: unsorted flat fees",
+			gasFees:     sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			flatFees:    sdk.Coins{sdk.NewInt64Coin("ubtc", 30), sdk.NewInt64Coin("uarch", 50)},
+			errExpected: true,
+		},
+		{
+			name:        "Fail: duplicated flat fees denom",
+			gasFees:     sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			flatFees:    sdk.Coins{sdk.NewInt64Coin("uarch", 30), sdk.NewInt64Coin("uarch", 50)},
+			errExpected: true,
+		},
+		{
+			name:        "Fail: zero gas fees coin",
+			gasFees:     sdk.Coins{sdk.NewInt64Coin("stake", 0)},
+			flatFees:    sdk.NewCoins(sdk.NewInt64Coin("uarch", 50)),
+			errExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			minFees, err := rewardsTypes.MinTxFees(tc.gasFees, tc.flatFees)
+			if tc.errExpected {
+				assert.ErrorIs(t, err, rewardsTypes.ErrInternal)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.NoError(t, minFees.Validate())
+			assert.Equal(t, tc.minFeesExpected, minFees.String())
+		})
+	}
+}
+
+func TestAbsorbFlatFees(t *testing.T) {
+	type testCase struct {
+		name     string
+		gasFees  string // [sdk.Coins]
+		flatFees string // [sdk.Coins]
+		// Output expected
+		gasFeesLeft string // [sdk.Coins]
+		minFee      string // [sdk.Coins] MinTxFees combined
+	}
+
+	testCases := []testCase{
+		{
+			name:        "No flat fees",
+			gasFees:     "100stake",
+			gasFeesLeft: "100stake",
+			minFee:      "100stake",
+		},
+		{
+			name:        "Same denom: flat fee is lower",
+			gasFees:     "100stake",
+			flatFees:    "30stake",
+			gasFeesLeft: "70stake",
+			minFee:      "100stake",
+		},
+		{
+			name:        "Same denom: flat fee is greater",
+			gasFees:     "100stake",
+			flatFees:    "130stake",
+			gasFeesLeft: "",
+			minFee:      "130stake",
+		},
+		{
+			name:        "Other denom: not absorbed",
+			gasFees:     "100stake",
+			flatFees:    "30uarch",
+			gasFeesLeft: "100stake",
+			minFee:      "100stake,30uarch",
+		},
+		{
+			name:        "Mixed denoms",
+			gasFees:     "100stake",
+			flatFees:    "30stake,30uarch",
+			gasFeesLeft: "70stake",
+			minFee:      "100stake,30uarch",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gasFees, err := sdk.ParseCoinsNormalized(tc.gasFees)
+			require.NoError(t, err)
+			flatFees, err := sdk.ParseCoinsNormalized(tc.flatFees)
+			require.NoError(t, err)
+
+			gasFeesLeft := rewardsTypes.AbsorbFlatFees(gasFees, flatFees)
+			assert.Equal(t, tc.gasFeesLeft, gasFeesLeft.String())
+
+			minFee, err := rewardsTypes.MinTxFees(gasFeesLeft, flatFees)
+			require.NoError(t, err)
+			assert.Equal(t, tc.minFee, minFee.String())
+		})
+	}
+}
+
+func TestSplitTxGas(t *testing.T) {
+	type testCase struct {
+		name           string
+		txGas          uint64
+		msgsGas        []uint64
+		sharesExpected []uint64
+	}
+
+	testCases := []testCase{
+		{
+			name:           "No msgs",
+			txGas:          1000,
+			sharesExpected: nil,
+		},
+		{
+			name:           "Declared gas matches the tx gas",
+			txGas:          1000,
+			msgsGas:        []uint64{200, 800},
+			sharesExpected: []uint64{200, 800},
+		},
+		{
+			name:           "Declared gas is scaled to the tx gas",
+			txGas:          2000,
+			msgsGas:        []uint64{100, 300, 600},
+			sharesExpected: []uint64{200, 600, 1200},
+		},
+		{
+			name:           "Rounding remainder goes to the last msg",
+			txGas:          1000,
+			msgsGas:        []uint64{1, 1, 1},
+			sharesExpected: []uint64{333, 333, 334},
+		},
+		{
+			name:           "No gas declared: even split",
+			txGas:          1000,
+			msgsGas:        []uint64{0, 0, 0},
+			sharesExpected: []uint64{333, 333, 334},
+		},
+		{
+			name:           "Large values do not overflow",
+			txGas:          math.MaxUint64,
+			msgsGas:        []uint64{math.MaxUint64, math.MaxUint64},
+			sharesExpected: []uint64{math.MaxUint64 / 2, math.MaxUint64/2 + 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.sharesExpected, rewardsTypes.SplitTxGas(tc.txGas, tc.msgsGas))
+		})
+	}
+}
+
+func TestAbsorbMsgFlatFees(t *testing.T) {
+	gasPrice := sdk.NewDecCoinFromDec("stake", sdkMath.LegacyNewDecWithPrec(15, 2)) // 0.15stake
+	gasFees := sdk.NewCoins(sdk.NewInt64Coin("stake", 150))                         // 1000 gas
+
+	type testCase struct {
+		name         string
+		msgsGas      []uint64
+		msgsFlatFees []sdk.Coins
+		gasFeesLeft  string // [sdk.Coins]
+	}
+
+	testCases := []testCase{
+		{
+			name:         "No flat fees",
+			msgsGas:      []uint64{500, 500},
+			msgsFlatFees: []sdk.Coins{nil, nil},
+			gasFeesLeft:  "150stake",
+		},
+		{
+			name:         "Flat fee msg with a light gas share",
+			msgsGas:      []uint64{200, 800},
+			msgsFlatFees: []sdk.Coins{sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), nil},
+			gasFeesLeft:  "120stake",
+		},
+		{
+			name:         "Flat fee msg with a heavy gas share",
+			msgsGas:      []uint64{800, 200},
+			msgsFlatFees: []sdk.Coins{sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), nil},
+			gasFeesLeft:  "50stake",
+		},
+		{
+			name:         "Every msg absorbs its own share",
+			msgsGas:      []uint64{200, 800},
+			msgsFlatFees: []sdk.Coins{sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), sdk.NewCoins(sdk.NewInt64Coin("stake", 100))},
+			gasFeesLeft:  "20stake",
+		},
+		{
+			name:         "Other denom flat fee is not absorbed",
+			msgsGas:      []uint64{800, 200},
+			msgsFlatFees: []sdk.Coins{sdk.NewCoins(sdk.NewInt64Coin("uarch", 100)), nil},
+			gasFeesLeft:  "150stake",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gasFeesLeft := rewardsTypes.AbsorbMsgFlatFees(gasFees, gasPrice, tc.msgsGas, tc.msgsFlatFees)
+			assert.Equal(t, tc.gasFeesLeft, gasFeesLeft.String())
+		})
+	}
+}
+
+func TestApplyFeeDiscount(t *testing.T) {
+	type testCase struct {
+		name     string
+		fees     string // [sdk.Coins]
+		discount uint64
+		// Output expected
+		feesDiscounted string // [sdk.Coins]
+	}
+
+	testCases := []testCase{
+		{
+			name:           "No discount",
+			fees:           "100stake,50uarch",
+			feesDiscounted: "100stake,50uarch",
+		},
+		{
+			name:           "No fees",
+			discount:       5000,
+			feesDiscounted: "",
+		},
+		{
+			name:           "50%",
+			fees:           "100stake,50uarch",
+			discount:       5000,
+			feesDiscounted: "50stake,25uarch",
+		},
+		{
+			name:           "33.33%: rounded up",
+			fees:           "100stake,50uarch",
+			discount:       3333,
+			feesDiscounted: "67stake,34uarch",
+		},
+		{
+			name:           "99.99%: non-zero fees stay non-zero",
+			fees:           "100stake,1uarch",
+			discount:       9999,
+			feesDiscounted: "1stake,1uarch",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fees, err := sdk.ParseCoinsNormalized(tc.fees)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.feesDiscounted, rewardsTypes.ApplyFeeDiscount(fees, tc.discount).String())
+		})
+	}
+}
+
+func TestAdjustedGasLimit(t *testing.T) {
+	type testCase struct {
+		name          string
+		simulatedGas  uint64
+		gasAdjustment string // [math.LegacyDec]
+		// Output expected
+		gasLimitExpected uint64
+		errExpected      bool
+	}
+
+	testCases := []testCase{
+		{
+			name:             "OK: factor 1.0",
+			simulatedGas:     100000,
+			gasAdjustment:    "1.0",
+			gasLimitExpected: 100000,
+		},
+		{
+			name:             "OK: factor 1.3",
+			simulatedGas:     100000,
+			gasAdjustment:    "1.3",
+			gasLimitExpected: 130000,
+		},
+		{
+			name:             "OK: factor 1.5 rounded up",
+			simulatedGas:     100001,
+			gasAdjustment:    "1.5",
+			gasLimitExpected: 150002,
+		},
+		{
+			name:             "OK: factor 2.0",
+			simulatedGas:     75000,
+			gasAdjustment:    "2.0",
+			gasLimitExpected: 150000,
+		},
+		{
+			name:             "OK: max factor",
+			simulatedGas:     1000,
+			gasAdjustment:    "100.0",
+			gasLimitExpected: 100000,
+		},
+		{
+			name:          "Fail: factor LT 1.0",
+			simulatedGas:  100000,
+			gasAdjustment: "0.9",
+			errExpected:   true,
+		},
+		{
+			name:          "Fail: factor GT max",
+			simulatedGas:  100000,
+			gasAdjustment: "100.1",
+			errExpected:   true,
+		},
+		{
+			name:          "Fail: uint64 overflow",
+			simulatedGas:  math.MaxUint64,
+			gasAdjustment: "1.1",
+			errExpected:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gasLimit, err := rewardsTypes.AdjustedGasLimit(tc.simulatedGas, sdkMath.LegacyMustNewDecFromStr(tc.gasAdjustment))
+			if tc.errExpected {
+				assert.ErrorIs(t, err, rewardsTypes.ErrInvalidRequest)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.gasLimitExpected, gasLimit)
+		})
+	}
+}
+
+func TestParseRecommendedFee(t *testing.T) {
+	type testCase struct {
+		name   string
+		log    string
+		feeExp string // expected fee [sdk.Coins] (not found if empty)
+	}
+
+	testCases := []testCase{
+		{
+			name:   "OK: insufficient fee error",
+			log:    rewardsTypes.NewInsufficientFeeError(nil, sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("uarch", 50))).Error(),
+			feeExp: "100stake,50uarch",
+		},
+		{
+			name:   "OK: wrapped error",
+			log:    "failed to execute message; message index: 0: " + rewardsTypes.NewInsufficientFeeError(sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), sdk.NewCoins(sdk.NewInt64Coin("stake", 100))).Error(),
+			feeExp: "100stake",
+		},
+		{
+			name: "Fail: other error",
+			log:  "tx fee 1stake is less than min fee: 100stake: insufficient fee",
+		},
+		{
+			name: "Fail: malformed fee",
+			log:  "(recommended_fee=100): insufficient fee",
+		},
+		{
+			name: "Fail: unterminated fee",
+			log:  "recommended_fee=100stake",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fee, found := rewardsTypes.ParseRecommendedFee(tc.log)
+			if tc.feeExp == "" {
+				assert.False(t, found)
+				return
+			}
+			require.True(t, found)
+			assert.Equal(t, tc.feeExp, fee.String())
+		})
+	}
+}
+
+// TestQueryTxFeeEstimateResponseSerialization checks the versioned estimate response wire format is stable (field
+// numbers must never change, so the encoded bytes are compared against the fixed value).
+func TestQueryTxFeeEstimateResponseSerialization(t *testing.T) {
+	res := rewardsTypes.QueryTxFeeEstimateResponse{
+		Version:      rewardsTypes.TxFeeEstimateVersion,
+		GasUnitPrice: sdk.NewDecCoinFromDec("stake", sdkMath.LegacyNewDecWithPrec(15, 2)),
+		EstimatedFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 510), sdk.NewInt64Coin("uarch", 50)),
+		GasFees:      sdk.NewCoins(sdk.NewInt64Coin("stake", 300)),
+		TxSizeFees:   sdk.NewCoins(sdk.NewInt64Coin("stake", 200)),
+		FlatFees:     sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("uarch", 50)),
+	}
+
+	bz, err := res.Marshal()
+	require.NoError(t, err)
+	assert.Equal(t, "0801121b0a057374616b6512123135303030303030303030303030303030301a0c0a057374616b6512033531301a0b0a05756172636812023530220c0a057374616b6512033330302a0c0a057374616b651203323030320b0a057374616b6512023130320b0a05756172636812023530", hex.EncodeToString(bz))
+
+	var resDecoded rewardsTypes.QueryTxFeeEstimateResponse
+	require.NoError(t, resDecoded.Unmarshal(bz))
+	assert.Equal(t, res, resDecoded)
+
+	bzDecoded, err := resDecoded.Marshal()
+	require.NoError(t, err)
+	assert.Equal(t, bz, bzDecoded)
+}
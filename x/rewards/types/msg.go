@@ -2,24 +2,47 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 const (
-	TypeMsgSetContractMetadata = "set-contract-metadata"
-	TypeMsgWithdrawRewards     = "withdraw-rewards"
-	TypeMsgFlatFee             = "flat-fee"
-	TypeMsgUpdateParams        = "update-params"
+	TypeMsgSetContractMetadata       = "set-contract-metadata"
+	TypeMsgWithdrawRewards           = "withdraw-rewards"
+	TypeMsgFlatFee                   = "flat-fee"
+	TypeMsgUpdateParams              = "update-params"
+	TypeMsgSetRewardsRatios          = "set-rewards-ratios"
+	TypeMsgRemoveContractMetadata    = "remove-contract-metadata"
+	TypeMsgSetFlatFeeByCodeID        = "set-flat-fee-by-code-id"
+	TypeMsgRebuildRewardsIndexes     = "rebuild-rewards-indexes"
+	TypeMsgRecoverContractRewards    = "recover-contract-rewards"
+	TypeMsgPrepayFlatFee             = "prepay-flat-fee"
+	TypeMsgSetFlatFeeOverride        = "set-flat-fee-override"
+	TypeMsgTransferContractOwnership = "transfer-contract-ownership"
+	TypeMsgResetLifetimeRewards      = "reset-lifetime-rewards"
 )
 
+// MaxIBCUnwrapTimeoutSeconds defines the max MsgWithdrawRewards IBC unwrap transfer timeout.
+const MaxIBCUnwrapTimeoutSeconds = 7 * 24 * 60 * 60
+
 var (
 	_ sdk.Msg = &MsgSetContractMetadata{}
 	_ sdk.Msg = &MsgWithdrawRewards{}
 	_ sdk.Msg = &MsgSetFlatFee{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSetRewardsRatios{}
+	_ sdk.Msg = &MsgRemoveContractMetadata{}
+	_ sdk.Msg = &MsgSetFlatFeeByCodeID{}
+	_ sdk.Msg = &MsgRebuildRewardsIndexes{}
+	_ sdk.Msg = &MsgRecoverContractRewards{}
+	_ sdk.Msg = &MsgPrepayFlatFee{}
+	_ sdk.Msg = &MsgSetFlatFeeOverride{}
+	_ sdk.Msg = &MsgTransferContractOwnership{}
+	_ sdk.Msg = &MsgResetLifetimeRewards{}
 )
 
 // NewMsgSetContractMetadata creates a new MsgSetContractMetadata instance.
@@ -167,6 +190,27 @@ func (m MsgWithdrawRewards) ValidateBasic() error {
 		return errorsmod.Wrapf(sdkErrors.ErrUnknownRequest, "unknown withdraw rewards mode: %T", m.Mode)
 	}
 
+	denomsSet := make(map[string]struct{})
+	for _, denom := range m.Denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return errorsmod.Wrapf(sdkErrors.ErrInvalidRequest, "invalid denoms: %v", err)
+		}
+
+		if _, ok := denomsSet[denom]; ok {
+			return errorsmod.Wrapf(sdkErrors.ErrInvalidRequest, "invalid denoms: duplicate denom (%s)", denom)
+		}
+		denomsSet[denom] = struct{}{}
+	}
+
+	if m.IbcUnwrap != nil {
+		if strings.TrimSpace(m.IbcUnwrap.Receiver) == "" {
+			return errorsmod.Wrap(sdkErrors.ErrInvalidRequest, "invalid IBC unwrap: empty receiver")
+		}
+		if m.IbcUnwrap.TimeoutSeconds > MaxIBCUnwrapTimeoutSeconds {
+			return errorsmod.Wrapf(sdkErrors.ErrInvalidRequest, "invalid IBC unwrap: timeout must be LTE %d seconds", MaxIBCUnwrapTimeoutSeconds)
+		}
+	}
+
 	return nil
 }
 
@@ -200,7 +244,25 @@ func (m MsgSetFlatFee) ValidateBasic() error {
 		return errorsmod.Wrapf(sdkErrors.ErrInvalidAddress, "invalid contract address: %v", err)
 	}
 
-	return nil
+	if m.Schedule != nil {
+		if err := m.Schedule.Validate(); err != nil {
+			return errorsmod.Wrapf(ErrInvalidRequest, "invalid flat fee schedule: %v", err)
+		}
+		if !m.Schedule.EndFee.Equal(m.FlatFeeAmount) {
+			return errorsmod.Wrapf(ErrInvalidRequest, "flat fee amount (%s) must match the schedule end fee (%s)", m.FlatFeeAmount, m.Schedule.EndFee)
+		}
+	}
+
+	if m.Method != "" {
+		if err := ValidateFlatFeeMethod(m.Method); err != nil {
+			return err
+		}
+		if m.Schedule != nil {
+			return errorsmod.Wrap(ErrInvalidRequest, "flat fee schedule can not be set for a method flat fee")
+		}
+	}
+
+	return ValidateFlatFeeMultiplier(m.MinConsFeeMultiplier, m.Schedule != nil, m.Method != "")
 }
 
 // NewMsgUpdateParams creates a new MsgUpdateParams instance.
@@ -243,3 +305,454 @@ func (m MsgUpdateParams) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgSetRewardsRatios creates a new MsgSetRewardsRatios instance.
+func NewMsgSetRewardsRatios(senderAddr sdk.AccAddress, inflationRatio, feeRebateRatio math.LegacyDec) *MsgSetRewardsRatios {
+	msg := &MsgSetRewardsRatios{
+		Authority:             senderAddr.String(),
+		InflationRewardsRatio: inflationRatio,
+		TxFeeRebateRatio:      feeRebateRatio,
+	}
+
+	return msg
+}
+
+// Route implements the sdk.Msg interface.
+func (m MsgSetRewardsRatios) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (m MsgSetRewardsRatios) Type() string { return TypeMsgSetRewardsRatios }
+
+// GetSigners implements the sdk.Msg interface.
+func (m MsgSetRewardsRatios) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(fmt.Errorf("parsing sender address (%s): %w", m.Authority, err))
+	}
+
+	return []sdk.AccAddress{senderAddr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (m MsgSetRewardsRatios) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (m MsgSetRewardsRatios) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidAddress, "invalid sender address: %v", err)
+	}
+
+	if m.InflationRewardsRatio.IsNil() || m.TxFeeRebateRatio.IsNil() {
+		return errorsmod.Wrap(sdkErrors.ErrInvalidRequest, "ratios must be set")
+	}
+
+	if err := validateInflationRewardsRatio(m.InflationRewardsRatio); err != nil {
+		return errorsmod.Wrap(sdkErrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := validateTxFeeRebateRatio(m.TxFeeRebateRatio); err != nil {
+		return errorsmod.Wrap(sdkErrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := validateRewardsRatiosSum(m.InflationRewardsRatio, m.TxFeeRebateRatio); err != nil {
+		return errorsmod.Wrap(sdkErrors.ErrInvalidRequest, err.Error())
+	}
+
+	if m.ActivationHeight < 0 {
+		return errorsmod.Wrap(sdkErrors.ErrInvalidRequest, "activationHeight: must be GTE 0")
+	}
+
+	return nil
+}
+
+// NewMsgRemoveContractMetadata creates a new MsgRemoveContractMetadata instance.
+func NewMsgRemoveContractMetadata(senderAddr, contractAddr sdk.AccAddress, sweepAddr *sdk.AccAddress) *MsgRemoveContractMetadata {
+	msg := &MsgRemoveContractMetadata{
+		SenderAddress:   senderAddr.String(),
+		ContractAddress: contractAddr.String(),
+	}
+
+	if sweepAddr != nil {
+		msg.RewardsSweepAddress = sweepAddr.String()
+	}
+
+	return msg
+}
+
+// Route implements the sdk.Msg interface.
+func (m MsgRemoveContractMetadata) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (m MsgRemoveContractMetadata) Type() string { return TypeMsgRemoveContractMetadata }
+
+// GetSigners implements the sdk.Msg interface.
+func (m MsgRemoveContractMetadata) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(m.SenderAddress)
+	if err != nil {
+		panic(fmt.Errorf("parsing sender address (%s): %w", m.SenderAddress, err))
+	}
+
+	return []sdk.AccAddress{senderAddr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (m MsgRemoveContractMetadata) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (m MsgRemoveContractMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.SenderAddress); err != nil {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidAddress, "invalid sender address: %v", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.ContractAddress); err != nil {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidAddress, "invalid contract address: %v", err)
+	}
+	if m.RewardsSweepAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(m.RewardsSweepAddress); err != nil {
+			return errorsmod.Wrapf(sdkErrors.ErrInvalidAddress, "invalid rewards sweep address: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// NewMsgSetFlatFeeByCodeID creates a new MsgSetFlatFeeByCodeID instance.
+func NewMsgSetFlatFeeByCodeID(senderAddr sdk.AccAddress, codeID uint64, fee sdk.Coin) *MsgSetFlatFeeByCodeID {
+	msg := &MsgSetFlatFeeByCodeID{
+		Authority:     senderAddr.String(),
+		CodeId:        codeID,
+		FlatFeeAmount: fee,
+	}
+
+	return msg
+}
+
+// Route implements the sdk.Msg interface.
+func (m MsgSetFlatFeeByCodeID) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (m MsgSetFlatFeeByCodeID) Type() string { return TypeMsgSetFlatFeeByCodeID }
+
+// GetSigners implements the sdk.Msg interface.
+func (m MsgSetFlatFeeByCodeID) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(fmt.Errorf("parsing sender address (%s): %w", m.Authority, err))
+	}
+
+	return []sdk.AccAddress{senderAddr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (m MsgSetFlatFeeByCodeID) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (m MsgSetFlatFeeByCodeID) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidAddress, "invalid sender address: %v", err)
+	}
+	if m.CodeId == 0 {
+		return errorsmod.Wrap(sdkErrors.ErrInvalidRequest, "code ID must be GT 0")
+	}
+	if err := m.FlatFeeAmount.Validate(); err != nil {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidCoins, "invalid flat fee: %v", err)
+	}
+
+	return nil
+}
+
+// NewMsgRebuildRewardsIndexes creates a new MsgRebuildRewardsIndexes instance.
+func NewMsgRebuildRewardsIndexes(senderAddr sdk.AccAddress) *MsgRebuildRewardsIndexes {
+	msg := &MsgRebuildRewardsIndexes{
+		Authority: senderAddr.String(),
+	}
+
+	return msg
+}
+
+// Route implements the sdk.Msg interface.
+func (m MsgRebuildRewardsIndexes) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (m MsgRebuildRewardsIndexes) Type() string { return TypeMsgRebuildRewardsIndexes }
+
+// GetSigners implements the sdk.Msg interface.
+func (m MsgRebuildRewardsIndexes) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(fmt.Errorf("parsing sender address (%s): %w", m.Authority, err))
+	}
+
+	return []sdk.AccAddress{senderAddr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (m MsgRebuildRewardsIndexes) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (m MsgRebuildRewardsIndexes) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidAddress, "invalid sender address: %v", err)
+	}
+
+	return nil
+}
+
+// NewMsgRecoverContractRewards creates a new MsgRecoverContractRewards instance.
+func NewMsgRecoverContractRewards(senderAddr, contractAddr, recoveryAddr sdk.AccAddress) *MsgRecoverContractRewards {
+	msg := &MsgRecoverContractRewards{
+		Authority:       senderAddr.String(),
+		ContractAddress: contractAddr.String(),
+		RecoveryAddress: recoveryAddr.String(),
+	}
+
+	return msg
+}
+
+// Route implements the sdk.Msg interface.
+func (m MsgRecoverContractRewards) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (m MsgRecoverContractRewards) Type() string { return TypeMsgRecoverContractRewards }
+
+// GetSigners implements the sdk.Msg interface.
+func (m MsgRecoverContractRewards) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(fmt.Errorf("parsing sender address (%s): %w", m.Authority, err))
+	}
+
+	return []sdk.AccAddress{senderAddr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (m MsgRecoverContractRewards) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (m MsgRecoverContractRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidAddress, "invalid sender address: %v", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.ContractAddress); err != nil {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidAddress, "invalid contract address: %v", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.RecoveryAddress); err != nil {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidAddress, "invalid recovery address: %v", err)
+	}
+
+	return nil
+}
+
+// NewMsgPrepayFlatFee creates a new MsgPrepayFlatFee instance.
+func NewMsgPrepayFlatFee(senderAddr, contractAddr sdk.AccAddress, executions uint64) *MsgPrepayFlatFee {
+	msg := &MsgPrepayFlatFee{
+		SenderAddress:   senderAddr.String(),
+		ContractAddress: contractAddr.String(),
+		Executions:      executions,
+	}
+
+	return msg
+}
+
+// Route implements the sdk.Msg interface.
+func (m MsgPrepayFlatFee) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (m MsgPrepayFlatFee) Type() string { return TypeMsgPrepayFlatFee }
+
+// GetSigners implements the sdk.Msg interface.
+func (m MsgPrepayFlatFee) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(m.SenderAddress)
+	if err != nil {
+		panic(fmt.Errorf("parsing sender address (%s): %w", m.SenderAddress, err))
+	}
+
+	return []sdk.AccAddress{senderAddr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (m MsgPrepayFlatFee) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (m MsgPrepayFlatFee) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.SenderAddress); err != nil {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidAddress, "invalid sender address: %v", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.ContractAddress); err != nil {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidAddress, "invalid contract address: %v", err)
+	}
+	if m.Executions == 0 || m.Executions > MaxFlatFeePrepayExecutions {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidRequest, "invalid executions: must be in the [1, %d] range", MaxFlatFeePrepayExecutions)
+	}
+
+	return nil
+}
+
+// NewMsgSetFlatFeeOverride creates a new MsgSetFlatFeeOverride instance.
+func NewMsgSetFlatFeeOverride(senderAddr, contractAddr sdk.AccAddress, flatFee sdk.Coin, expiryHeight int64) *MsgSetFlatFeeOverride {
+	msg := &MsgSetFlatFeeOverride{
+		Authority:       senderAddr.String(),
+		ContractAddress: contractAddr.String(),
+		FlatFeeAmount:   flatFee,
+		ExpiryHeight:    expiryHeight,
+	}
+
+	return msg
+}
+
+// Route implements the sdk.Msg interface.
+func (m MsgSetFlatFeeOverride) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (m MsgSetFlatFeeOverride) Type() string { return TypeMsgSetFlatFeeOverride }
+
+// GetSigners implements the sdk.Msg interface.
+func (m MsgSetFlatFeeOverride) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(fmt.Errorf("parsing sender address (%s): %w", m.Authority, err))
+	}
+
+	return []sdk.AccAddress{senderAddr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (m MsgSetFlatFeeOverride) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (m MsgSetFlatFeeOverride) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidAddress, "invalid sender address: %v", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.ContractAddress); err != nil {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidAddress, "invalid contract address: %v", err)
+	}
+	if err := m.FlatFeeAmount.Validate(); err != nil {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidCoins, "invalid flat fee amount: %v", err)
+	}
+	if m.ExpiryHeight <= 0 {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidRequest, "invalid expiry height: must be GT 0")
+	}
+
+	return nil
+}
+
+// NewMsgTransferContractOwnership creates a new MsgTransferContractOwnership instance.
+// The rewards address is kept if the newRewardsAddr is not set.
+func NewMsgTransferContractOwnership(senderAddr, contractAddr, newOwnerAddr sdk.AccAddress, newRewardsAddr *sdk.AccAddress) *MsgTransferContractOwnership {
+	msg := &MsgTransferContractOwnership{
+		SenderAddress:   senderAddr.String(),
+		ContractAddress: contractAddr.String(),
+		NewOwnerAddress: newOwnerAddr.String(),
+	}
+
+	if newRewardsAddr != nil {
+		msg.NewRewardsAddress = newRewardsAddr.String()
+	}
+
+	return msg
+}
+
+// Route implements the sdk.Msg interface.
+func (m MsgTransferContractOwnership) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (m MsgTransferContractOwnership) Type() string { return TypeMsgTransferContractOwnership }
+
+// GetSigners implements the sdk.Msg interface.
+func (m MsgTransferContractOwnership) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(m.SenderAddress)
+	if err != nil {
+		panic(fmt.Errorf("parsing sender address (%s): %w", m.SenderAddress, err))
+	}
+
+	return []sdk.AccAddress{senderAddr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (m MsgTransferContractOwnership) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (m MsgTransferContractOwnership) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.SenderAddress); err != nil {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidAddress, "invalid sender address: %v", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.ContractAddress); err != nil {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidAddress, "invalid contract address: %v", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.NewOwnerAddress); err != nil {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidAddress, "invalid new owner address: %v", err)
+	}
+	if m.NewRewardsAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(m.NewRewardsAddress); err != nil {
+			return errorsmod.Wrapf(sdkErrors.ErrInvalidAddress, "invalid new rewards address: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// NewMsgResetLifetimeRewards creates a new MsgResetLifetimeRewards instance.
+func NewMsgResetLifetimeRewards(senderAddr, contractAddr sdk.AccAddress) *MsgResetLifetimeRewards {
+	return &MsgResetLifetimeRewards{
+		SenderAddress:   senderAddr.String(),
+		ContractAddress: contractAddr.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (m MsgResetLifetimeRewards) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (m MsgResetLifetimeRewards) Type() string { return TypeMsgResetLifetimeRewards }
+
+// GetSigners implements the sdk.Msg interface.
+func (m MsgResetLifetimeRewards) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(m.SenderAddress)
+	if err != nil {
+		panic(fmt.Errorf("parsing sender address (%s): %w", m.SenderAddress, err))
+	}
+
+	return []sdk.AccAddress{senderAddr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (m MsgResetLifetimeRewards) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (m MsgResetLifetimeRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.SenderAddress); err != nil {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidAddress, "invalid sender address: %v", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.ContractAddress); err != nil {
+		return errorsmod.Wrapf(sdkErrors.ErrInvalidAddress, "invalid contract address: %v", err)
+	}
+
+	return nil
+}
@@ -3,6 +3,8 @@ package types_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 
 	e2eTesting "github.com/archway-network/archway/e2e/testing"
@@ -178,6 +180,88 @@ func TestMsgWithdrawRewardsValidateBasic(t *testing.T) {
 			},
 			errExpected: true,
 		},
+		{
+			name: "OK: denoms",
+			msg: rewardsTypes.MsgWithdrawRewards{
+				RewardsAddress: accAddr.String(),
+				Mode: &rewardsTypes.MsgWithdrawRewards_RecordsLimit_{
+					RecordsLimit: &rewardsTypes.MsgWithdrawRewards_RecordsLimit{
+						Limit: 1,
+					},
+				},
+				Denoms: []string{"uarch", "ustake"},
+			},
+		},
+		{
+			name: "OK: IBC unwrap",
+			msg: rewardsTypes.MsgWithdrawRewards{
+				RewardsAddress: accAddr.String(),
+				Mode: &rewardsTypes.MsgWithdrawRewards_RecordsLimit_{
+					RecordsLimit: &rewardsTypes.MsgWithdrawRewards_RecordsLimit{
+						Limit: 1,
+					},
+				},
+				IbcUnwrap: &rewardsTypes.MsgWithdrawRewards_IBCUnwrap{
+					Receiver:       "cosmos1receiver",
+					TimeoutSeconds: rewardsTypes.MaxIBCUnwrapTimeoutSeconds,
+				},
+			},
+		},
+		{
+			name: "Fail: IBC unwrap: empty receiver",
+			msg: rewardsTypes.MsgWithdrawRewards{
+				RewardsAddress: accAddr.String(),
+				Mode: &rewardsTypes.MsgWithdrawRewards_RecordsLimit_{
+					RecordsLimit: &rewardsTypes.MsgWithdrawRewards_RecordsLimit{
+						Limit: 1,
+					},
+				},
+				IbcUnwrap: &rewardsTypes.MsgWithdrawRewards_IBCUnwrap{},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: IBC unwrap: timeout too long",
+			msg: rewardsTypes.MsgWithdrawRewards{
+				RewardsAddress: accAddr.String(),
+				Mode: &rewardsTypes.MsgWithdrawRewards_RecordsLimit_{
+					RecordsLimit: &rewardsTypes.MsgWithdrawRewards_RecordsLimit{
+						Limit: 1,
+					},
+				},
+				IbcUnwrap: &rewardsTypes.MsgWithdrawRewards_IBCUnwrap{
+					Receiver:       "cosmos1receiver",
+					TimeoutSeconds: rewardsTypes.MaxIBCUnwrapTimeoutSeconds + 1,
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid denom",
+			msg: rewardsTypes.MsgWithdrawRewards{
+				RewardsAddress: accAddr.String(),
+				Mode: &rewardsTypes.MsgWithdrawRewards_RecordsLimit_{
+					RecordsLimit: &rewardsTypes.MsgWithdrawRewards_RecordsLimit{
+						Limit: 1,
+					},
+				},
+				Denoms: []string{"1"},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: duplicated denom",
+			msg: rewardsTypes.MsgWithdrawRewards{
+				RewardsAddress: accAddr.String(),
+				Mode: &rewardsTypes.MsgWithdrawRewards_RecordsLimit_{
+					RecordsLimit: &rewardsTypes.MsgWithdrawRewards_RecordsLimit{
+						Limit: 1,
+					},
+				},
+				Denoms: []string{"uarch", "uarch"},
+			},
+			errExpected: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -228,6 +312,639 @@ func TestMsgSetFlatFeeValidateBasic(t *testing.T) {
 			},
 			errExpected: true,
 		},
+		{
+			name: "OK: with schedule",
+			msg: rewardsTypes.MsgSetFlatFee{
+				SenderAddress:   accAddr.String(),
+				ContractAddress: contractAddr.String(),
+				FlatFeeAmount:   sdk.NewInt64Coin("uarch", 10),
+				Schedule: &rewardsTypes.FlatFeeSchedule{
+					StartHeight: 1,
+					EndHeight:   2,
+					StartFee:    sdk.NewInt64Coin("uarch", 100),
+					EndFee:      sdk.NewInt64Coin("uarch", 10),
+				},
+			},
+		},
+		{
+			name: "Fail: schedule end fee mismatch",
+			msg: rewardsTypes.MsgSetFlatFee{
+				SenderAddress:   accAddr.String(),
+				ContractAddress: contractAddr.String(),
+				FlatFeeAmount:   sdk.NewInt64Coin("uarch", 10),
+				Schedule: &rewardsTypes.FlatFeeSchedule{
+					StartHeight: 1,
+					EndHeight:   2,
+					StartFee:    sdk.NewInt64Coin("uarch", 100),
+					EndFee:      sdk.NewInt64Coin("uarch", 20),
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "OK: with method",
+			msg: rewardsTypes.MsgSetFlatFee{
+				SenderAddress:   accAddr.String(),
+				ContractAddress: contractAddr.String(),
+				FlatFeeAmount:   sdk.NewInt64Coin("uarch", 10),
+				Method:          "mint",
+			},
+		},
+		{
+			name: "Fail: method with spaces",
+			msg: rewardsTypes.MsgSetFlatFee{
+				SenderAddress:   accAddr.String(),
+				ContractAddress: contractAddr.String(),
+				FlatFeeAmount:   sdk.NewInt64Coin("uarch", 10),
+				Method:          "mint ",
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: method too long",
+			msg: rewardsTypes.MsgSetFlatFee{
+				SenderAddress:   accAddr.String(),
+				ContractAddress: contractAddr.String(),
+				FlatFeeAmount:   sdk.NewInt64Coin("uarch", 10),
+				Method:          string(make([]byte, rewardsTypes.MaxFlatFeeMethodLength+1)),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: method with schedule",
+			msg: rewardsTypes.MsgSetFlatFee{
+				SenderAddress:   accAddr.String(),
+				ContractAddress: contractAddr.String(),
+				FlatFeeAmount:   sdk.NewInt64Coin("uarch", 10),
+				Method:          "mint",
+				Schedule: &rewardsTypes.FlatFeeSchedule{
+					StartHeight: 1,
+					EndHeight:   2,
+					StartFee:    sdk.NewInt64Coin("uarch", 100),
+					EndFee:      sdk.NewInt64Coin("uarch", 10),
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "OK: with min consensus fee multiplier",
+			msg: rewardsTypes.MsgSetFlatFee{
+				SenderAddress:        accAddr.String(),
+				ContractAddress:      contractAddr.String(),
+				FlatFeeAmount:        sdk.NewInt64Coin("uarch", 10),
+				MinConsFeeMultiplier: math.LegacyNewDec(1000),
+			},
+		},
+		{
+			name: "Fail: negative min consensus fee multiplier",
+			msg: rewardsTypes.MsgSetFlatFee{
+				SenderAddress:        accAddr.String(),
+				ContractAddress:      contractAddr.String(),
+				FlatFeeAmount:        sdk.NewInt64Coin("uarch", 10),
+				MinConsFeeMultiplier: math.LegacyNewDec(-1),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: method with min consensus fee multiplier",
+			msg: rewardsTypes.MsgSetFlatFee{
+				SenderAddress:        accAddr.String(),
+				ContractAddress:      contractAddr.String(),
+				FlatFeeAmount:        sdk.NewInt64Coin("uarch", 10),
+				Method:               "mint",
+				MinConsFeeMultiplier: math.LegacyNewDec(1000),
+			},
+			errExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.errExpected {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSetRewardsRatiosValidateBasic(t *testing.T) {
+	type testCase struct {
+		name        string
+		msg         rewardsTypes.MsgSetRewardsRatios
+		errExpected bool
+	}
+
+	accAddrs, _ := e2eTesting.GenAccounts(1)
+	accAddr := accAddrs[0]
+
+	testCases := []testCase{
+		{
+			name: "OK",
+			msg: rewardsTypes.MsgSetRewardsRatios{
+				Authority:             accAddr.String(),
+				InflationRewardsRatio: math.LegacyNewDecWithPrec(2, 1),
+				TxFeeRebateRatio:      math.LegacyZeroDec(),
+			},
+		},
+		{
+			name: "Fail: invalid Authority",
+			msg: rewardsTypes.MsgSetRewardsRatios{
+				Authority:             "👻",
+				InflationRewardsRatio: math.LegacyNewDecWithPrec(2, 1),
+				TxFeeRebateRatio:      math.LegacyNewDecWithPrec(5, 1),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: ratios not set",
+			msg: rewardsTypes.MsgSetRewardsRatios{
+				Authority: accAddr.String(),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: negative InflationRewardsRatio",
+			msg: rewardsTypes.MsgSetRewardsRatios{
+				Authority:             accAddr.String(),
+				InflationRewardsRatio: math.LegacyNewDecWithPrec(-1, 1),
+				TxFeeRebateRatio:      math.LegacyNewDecWithPrec(5, 1),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: TxFeeRebateRatio out of range",
+			msg: rewardsTypes.MsgSetRewardsRatios{
+				Authority:             accAddr.String(),
+				InflationRewardsRatio: math.LegacyNewDecWithPrec(2, 1),
+				TxFeeRebateRatio:      math.LegacyNewDecWithPrec(11, 1),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: ratios sum exceeds 1.0",
+			msg: rewardsTypes.MsgSetRewardsRatios{
+				Authority:             accAddr.String(),
+				InflationRewardsRatio: math.LegacyNewDecWithPrec(6, 1),
+				TxFeeRebateRatio:      math.LegacyNewDecWithPrec(5, 1),
+			},
+			errExpected: true,
+		},
+		{
+			name: "OK: with activation height",
+			msg: rewardsTypes.MsgSetRewardsRatios{
+				Authority:             accAddr.String(),
+				InflationRewardsRatio: math.LegacyNewDecWithPrec(2, 1),
+				TxFeeRebateRatio:      math.LegacyNewDecWithPrec(5, 1),
+				ActivationHeight:      100,
+			},
+		},
+		{
+			name: "Fail: negative activation height",
+			msg: rewardsTypes.MsgSetRewardsRatios{
+				Authority:             accAddr.String(),
+				InflationRewardsRatio: math.LegacyNewDecWithPrec(2, 1),
+				TxFeeRebateRatio:      math.LegacyNewDecWithPrec(5, 1),
+				ActivationHeight:      -1,
+			},
+			errExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.errExpected {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgRemoveContractMetadataValidateBasic(t *testing.T) {
+	type testCase struct {
+		name        string
+		msg         rewardsTypes.MsgRemoveContractMetadata
+		errExpected bool
+	}
+
+	accAddrs, _ := e2eTesting.GenAccounts(2)
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+
+	testCases := []testCase{
+		{
+			name: "OK",
+			msg: rewardsTypes.MsgRemoveContractMetadata{
+				SenderAddress:   accAddrs[0].String(),
+				ContractAddress: contractAddr.String(),
+			},
+		},
+		{
+			name: "OK: with sweep address",
+			msg: rewardsTypes.MsgRemoveContractMetadata{
+				SenderAddress:       accAddrs[0].String(),
+				ContractAddress:     contractAddr.String(),
+				RewardsSweepAddress: accAddrs[1].String(),
+			},
+		},
+		{
+			name: "Fail: invalid SenderAddress",
+			msg: rewardsTypes.MsgRemoveContractMetadata{
+				SenderAddress:   "👻",
+				ContractAddress: contractAddr.String(),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid ContractAddress",
+			msg: rewardsTypes.MsgRemoveContractMetadata{
+				SenderAddress:   accAddrs[0].String(),
+				ContractAddress: "👻",
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid RewardsSweepAddress",
+			msg: rewardsTypes.MsgRemoveContractMetadata{
+				SenderAddress:       accAddrs[0].String(),
+				ContractAddress:     contractAddr.String(),
+				RewardsSweepAddress: "👻",
+			},
+			errExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.errExpected {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSetFlatFeeByCodeIDValidateBasic(t *testing.T) {
+	type testCase struct {
+		name        string
+		msg         rewardsTypes.MsgSetFlatFeeByCodeID
+		errExpected bool
+	}
+
+	accAddr, _ := e2eTesting.GenAccounts(1)
+
+	testCases := []testCase{
+		{
+			name: "OK",
+			msg: rewardsTypes.MsgSetFlatFeeByCodeID{
+				Authority:     accAddr[0].String(),
+				CodeId:        1,
+				FlatFeeAmount: sdk.NewInt64Coin("test", 10),
+			},
+		},
+		{
+			name: "OK: zero flat fee",
+			msg: rewardsTypes.MsgSetFlatFeeByCodeID{
+				Authority:     accAddr[0].String(),
+				CodeId:        1,
+				FlatFeeAmount: sdk.NewInt64Coin("test", 0),
+			},
+		},
+		{
+			name: "Fail: invalid Authority",
+			msg: rewardsTypes.MsgSetFlatFeeByCodeID{
+				Authority:     "👻",
+				CodeId:        1,
+				FlatFeeAmount: sdk.NewInt64Coin("test", 10),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: zero CodeId",
+			msg: rewardsTypes.MsgSetFlatFeeByCodeID{
+				Authority:     accAddr[0].String(),
+				FlatFeeAmount: sdk.NewInt64Coin("test", 10),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid FlatFeeAmount",
+			msg: rewardsTypes.MsgSetFlatFeeByCodeID{
+				Authority:     accAddr[0].String(),
+				CodeId:        1,
+				FlatFeeAmount: sdk.Coin{Denom: "test", Amount: math.NewInt(-1)},
+			},
+			errExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.errExpected {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgRebuildRewardsIndexesValidateBasic(t *testing.T) {
+	type testCase struct {
+		name        string
+		msg         rewardsTypes.MsgRebuildRewardsIndexes
+		errExpected bool
+	}
+
+	accAddr, _ := e2eTesting.GenAccounts(1)
+
+	testCases := []testCase{
+		{
+			name: "OK",
+			msg: rewardsTypes.MsgRebuildRewardsIndexes{
+				Authority: accAddr[0].String(),
+			},
+		},
+		{
+			name: "Fail: invalid Authority",
+			msg: rewardsTypes.MsgRebuildRewardsIndexes{
+				Authority: "👻",
+			},
+			errExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.errExpected {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgRecoverContractRewardsValidateBasic(t *testing.T) {
+	type testCase struct {
+		name        string
+		msg         rewardsTypes.MsgRecoverContractRewards
+		errExpected bool
+	}
+
+	accAddrs, _ := e2eTesting.GenAccounts(1)
+	accAddr, contractAddr := accAddrs[0], e2eTesting.GenContractAddresses(1)[0]
+
+	testCases := []testCase{
+		{
+			name: "OK",
+			msg: rewardsTypes.MsgRecoverContractRewards{
+				Authority:       accAddr.String(),
+				ContractAddress: contractAddr.String(),
+				RecoveryAddress: accAddr.String(),
+			},
+		},
+		{
+			name: "Fail: invalid Authority",
+			msg: rewardsTypes.MsgRecoverContractRewards{
+				Authority:       "👻",
+				ContractAddress: contractAddr.String(),
+				RecoveryAddress: accAddr.String(),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid ContractAddress",
+			msg: rewardsTypes.MsgRecoverContractRewards{
+				Authority:       accAddr.String(),
+				ContractAddress: "👻",
+				RecoveryAddress: accAddr.String(),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid RecoveryAddress",
+			msg: rewardsTypes.MsgRecoverContractRewards{
+				Authority:       accAddr.String(),
+				ContractAddress: contractAddr.String(),
+			},
+			errExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.errExpected {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSetFlatFeeOverrideValidateBasic(t *testing.T) {
+	type testCase struct {
+		name        string
+		msg         rewardsTypes.MsgSetFlatFeeOverride
+		errExpected bool
+	}
+
+	accAddrs, _ := e2eTesting.GenAccounts(1)
+	accAddr, contractAddr := accAddrs[0], e2eTesting.GenContractAddresses(1)[0]
+
+	testCases := []testCase{
+		{
+			name: "OK",
+			msg: rewardsTypes.MsgSetFlatFeeOverride{
+				Authority:       accAddr.String(),
+				ContractAddress: contractAddr.String(),
+				FlatFeeAmount:   sdk.NewInt64Coin("stake", 10),
+				ExpiryHeight:    100,
+			},
+		},
+		{
+			name: "OK: zero flat fee",
+			msg: rewardsTypes.MsgSetFlatFeeOverride{
+				Authority:       accAddr.String(),
+				ContractAddress: contractAddr.String(),
+				FlatFeeAmount:   sdk.NewInt64Coin("stake", 0),
+				ExpiryHeight:    100,
+			},
+		},
+		{
+			name: "Fail: invalid Authority",
+			msg: rewardsTypes.MsgSetFlatFeeOverride{
+				Authority:       "👻",
+				ContractAddress: contractAddr.String(),
+				FlatFeeAmount:   sdk.NewInt64Coin("stake", 10),
+				ExpiryHeight:    100,
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid ContractAddress",
+			msg: rewardsTypes.MsgSetFlatFeeOverride{
+				Authority:       accAddr.String(),
+				ContractAddress: "👻",
+				FlatFeeAmount:   sdk.NewInt64Coin("stake", 10),
+				ExpiryHeight:    100,
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid FlatFeeAmount",
+			msg: rewardsTypes.MsgSetFlatFeeOverride{
+				Authority:       accAddr.String(),
+				ContractAddress: contractAddr.String(),
+				ExpiryHeight:    100,
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid ExpiryHeight",
+			msg: rewardsTypes.MsgSetFlatFeeOverride{
+				Authority:       accAddr.String(),
+				ContractAddress: contractAddr.String(),
+				FlatFeeAmount:   sdk.NewInt64Coin("stake", 10),
+			},
+			errExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.errExpected {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgTransferContractOwnershipValidateBasic(t *testing.T) {
+	type testCase struct {
+		name        string
+		msg         rewardsTypes.MsgTransferContractOwnership
+		errExpected bool
+	}
+
+	accAddrs, _ := e2eTesting.GenAccounts(2)
+	accAddr, newAccAddr, contractAddr := accAddrs[0], accAddrs[1], e2eTesting.GenContractAddresses(1)[0]
+
+	testCases := []testCase{
+		{
+			name: "OK",
+			msg: rewardsTypes.MsgTransferContractOwnership{
+				SenderAddress:     accAddr.String(),
+				ContractAddress:   contractAddr.String(),
+				NewOwnerAddress:   newAccAddr.String(),
+				NewRewardsAddress: newAccAddr.String(),
+			},
+		},
+		{
+			name: "OK: owner only",
+			msg: rewardsTypes.MsgTransferContractOwnership{
+				SenderAddress:   accAddr.String(),
+				ContractAddress: contractAddr.String(),
+				NewOwnerAddress: newAccAddr.String(),
+			},
+		},
+		{
+			name: "Fail: invalid SenderAddress",
+			msg: rewardsTypes.MsgTransferContractOwnership{
+				SenderAddress:   "👻",
+				ContractAddress: contractAddr.String(),
+				NewOwnerAddress: newAccAddr.String(),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid ContractAddress",
+			msg: rewardsTypes.MsgTransferContractOwnership{
+				SenderAddress:   accAddr.String(),
+				ContractAddress: "👻",
+				NewOwnerAddress: newAccAddr.String(),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid NewOwnerAddress",
+			msg: rewardsTypes.MsgTransferContractOwnership{
+				SenderAddress:   accAddr.String(),
+				ContractAddress: contractAddr.String(),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid NewRewardsAddress",
+			msg: rewardsTypes.MsgTransferContractOwnership{
+				SenderAddress:     accAddr.String(),
+				ContractAddress:   contractAddr.String(),
+				NewOwnerAddress:   newAccAddr.String(),
+				NewRewardsAddress: "👻",
+			},
+			errExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.errExpected {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgResetLifetimeRewardsValidateBasic(t *testing.T) {
+	type testCase struct {
+		name        string
+		msg         rewardsTypes.MsgResetLifetimeRewards
+		errExpected bool
+	}
+
+	accAddrs, _ := e2eTesting.GenAccounts(1)
+	accAddr, contractAddr := accAddrs[0], e2eTesting.GenContractAddresses(1)[0]
+
+	testCases := []testCase{
+		{
+			name: "OK",
+			msg: rewardsTypes.MsgResetLifetimeRewards{
+				SenderAddress:   accAddr.String(),
+				ContractAddress: contractAddr.String(),
+			},
+		},
+		{
+			name: "Fail: invalid SenderAddress",
+			msg: rewardsTypes.MsgResetLifetimeRewards{
+				SenderAddress:   "👻",
+				ContractAddress: contractAddr.String(),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid ContractAddress",
+			msg: rewardsTypes.MsgResetLifetimeRewards{
+				SenderAddress:   accAddr.String(),
+				ContractAddress: "👻",
+			},
+			errExpected: true,
+		},
 	}
 
 	for _, tc := range testCases {
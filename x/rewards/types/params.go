@@ -2,9 +2,11 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	math "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramTypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -23,6 +25,22 @@ var (
 	// MaxRecordsQueryLimit defines the page limit for querying RewardsRecords.
 	// Limit is defined by the TestRewardsRecordsQueryLimit E2E test.
 	MaxRecordsQueryLimit = uint64(7500)
+	// MaxBlockTrackingRangeQueryLimit defines the max number of block heights for querying BlockRewardsTrackingRange.
+	MaxBlockTrackingRangeQueryLimit = uint64(100)
+	// MaxContractsByCodeIDQueryLimit defines the page limit for querying ContractsByCodeID.
+	MaxContractsByCodeIDQueryLimit = uint64(1000)
+	// MaxBlockFlatFeeTxsQueryLimit defines the max number of transactions returned by the BlockFlatFeeTxs query.
+	MaxBlockFlatFeeTxsQueryLimit = uint64(1000)
+	// MaxFlatFeesQueryLimit defines the max number of contract addresses for querying FlatFees.
+	MaxFlatFeesQueryLimit = uint64(100)
+	// MaxGasRebateMultiplierParamLimit defines the MaxGasRebateMultiplier max value (basis points, 10.0x).
+	MaxGasRebateMultiplierParamLimit = uint64(100_000)
+	// MaxTxSizeFeePerByteParamLimit defines the TxSizeFeePerByte max value (0.001 of an 18 decimals token per byte).
+	MaxTxSizeFeePerByteParamLimit = uint64(1_000_000_000_000_000)
+	// MaxRewardsCallbackGasLimitParamLimit defines the RewardsCallbackGasLimit max value.
+	MaxRewardsCallbackGasLimitParamLimit = uint64(10_000_000)
+	// MaxRewardsCallbacksBlockGasLimitParamLimit defines the RewardsCallbacksBlockGasLimit max value.
+	MaxRewardsCallbacksBlockGasLimitParamLimit = uint64(100_000_000)
 )
 
 var (
@@ -30,6 +48,62 @@ var (
 	DefaultTxFeeRebateRatio   = math.LegacyMustNewDecFromStr("0.50") // 50%
 	DefaultMaxWithdrawRecords = MaxWithdrawRecordsParamLimit
 	DefaultMinPriceOfGas      = sdk.NewDecCoin("stake", math.ZeroInt())
+	DefaultDynamicFeeEnabled  = false
+	// DefaultFlatFeeUpdateInterval disables the flat fee updates rate-limiting.
+	DefaultFlatFeeUpdateInterval = uint64(0)
+	// DefaultMaxFlatFeeUpdateContracts defines the contracts limit for a single bulk flat fee update.
+	DefaultMaxFlatFeeUpdateContracts = uint64(100)
+	// DefaultFlatFeeDeliverTxOnly enables the flat fees charging in CheckTx as well.
+	DefaultFlatFeeDeliverTxOnly = false
+	// DefaultMinFeeFloorEnabled allows zero-fee transactions if the minimum fee is zero.
+	DefaultMinFeeFloorEnabled = false
+	// DefaultMinContractExecutionGas disables the contract execution gas limit check.
+	DefaultMinContractExecutionGas = uint64(0)
+	// DefaultFreeTxBudget disables the fee-free transactions budget.
+	DefaultFreeTxBudget = uint64(0)
+	// DefaultMaxGasRebateMultiplier disables the contract gas rebate multipliers.
+	DefaultMaxGasRebateMultiplier = uint64(0)
+	// DefaultFlatFeeOncePerBlock charges the contract flat fees for every transaction.
+	DefaultFlatFeeOncePerBlock = false
+	// DefaultAcceptedFeeDenoms accepts fees in any denom.
+	DefaultAcceptedFeeDenoms []string
+	// DefaultTxSizeFeePerByte disables the tx size min fee surcharge.
+	DefaultTxSizeFeePerByte = uint64(0)
+	// DefaultSingleDenomFeesOnly allows paying fees in multiple denoms.
+	DefaultSingleDenomFeesOnly = false
+	// DefaultFlatFeePrepayDiscount disables the prepaid contract executions discount.
+	DefaultFlatFeePrepayDiscount = uint64(0)
+	// DefaultFlatFeePayerMustSign allows flat fees to be charged for msgs not signed by the fee payer.
+	DefaultFlatFeePayerMustSign = false
+	// DefaultFlatFeesEnabled enables the contract flat fees charging.
+	DefaultFlatFeesEnabled = true
+	// DefaultFeeDenomRoutes keeps all the fees on the fee collector.
+	DefaultFeeDenomRoutes []FeeDenomRoute
+	// DefaultFlatFeeConversionRates accepts the contract flat fees in the configured denoms only.
+	DefaultFlatFeeConversionRates []FlatFeeConversionRate
+	// DefaultCheckTxMinFeeEventEnabled keeps the CheckTx response events free of the min fee estimation.
+	DefaultCheckTxMinFeeEventEnabled = false
+	// DefaultMaxContractBlockRewards doesn't limit the contract block rewards.
+	DefaultMaxContractBlockRewards []sdk.Coin
+	// DefaultFlatFeeMigrationPolicy keeps the contract flat fees on migration.
+	DefaultFlatFeeMigrationPolicy = FlatFeeMigrationPolicy_FLAT_FEE_MIGRATION_POLICY_KEEP
+	// DefaultMaxFlatFeeMsgsPerTx doesn't limit the number of flat fee msgs per transaction.
+	DefaultMaxFlatFeeMsgsPerTx = uint64(0)
+	// DefaultFlatFeeAbsorbedInGasFee stacks the same denom contract flat fees on top of the gas min fee.
+	DefaultFlatFeeAbsorbedInGasFee = false
+	// DefaultFeePromotion disables the fee promotion discount.
+	DefaultFeePromotion = FeePromotion{}
+	// DefaultFlatFeeRefundOnFailure keeps the contract flat fees charged for failed executions.
+	DefaultFlatFeeRefundOnFailure = false
+	// DefaultCheckTxLocalMinGasPriceEnabled uses the consensus min gas price in CheckTx.
+	DefaultCheckTxLocalMinGasPriceEnabled = false
+	// DefaultMinConsensusFeeSmoothingWindow uses the instantaneous minimum consensus fee.
+	DefaultMinConsensusFeeSmoothingWindow = uint64(0)
+	// DefaultRewardsCallbackGasLimit defines the default contract rewards callback gas limit.
+	DefaultRewardsCallbackGasLimit = uint64(200_000)
+	// DefaultRewardsCallbacksBlockGasLimit defines the default contract rewards callbacks gas budget per block
+	// (10 callbacks with the default gas limit).
+	DefaultRewardsCallbacksBlockGasLimit = uint64(2_000_000)
 )
 
 var _ paramTypes.ParamSet = (*Params)(nil)
@@ -51,12 +125,42 @@ func NewParams(inflationRewardsRatio, txFeeRebateRatio math.LegacyDec, maxwithdr
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams(
+	params := NewParams(
 		DefaultInflationRatio,
 		DefaultTxFeeRebateRatio,
 		DefaultMaxWithdrawRecords,
 		DefaultMinPriceOfGas,
 	)
+	params.DynamicFeeEnabled = DefaultDynamicFeeEnabled
+	params.FlatFeeUpdateInterval = DefaultFlatFeeUpdateInterval
+	params.MaxFlatFeeUpdateContracts = DefaultMaxFlatFeeUpdateContracts
+	params.FlatFeeDeliverTxOnly = DefaultFlatFeeDeliverTxOnly
+	params.MinFeeFloorEnabled = DefaultMinFeeFloorEnabled
+	params.MinContractExecutionGas = DefaultMinContractExecutionGas
+	params.FreeTxBudget = DefaultFreeTxBudget
+	params.MaxGasRebateMultiplier = DefaultMaxGasRebateMultiplier
+	params.FlatFeeOncePerBlock = DefaultFlatFeeOncePerBlock
+	params.AcceptedFeeDenoms = DefaultAcceptedFeeDenoms
+	params.TxSizeFeePerByte = DefaultTxSizeFeePerByte
+	params.SingleDenomFeesOnly = DefaultSingleDenomFeesOnly
+	params.FlatFeePrepayDiscount = DefaultFlatFeePrepayDiscount
+	params.FlatFeePayerMustSign = DefaultFlatFeePayerMustSign
+	params.FlatFeesEnabled = DefaultFlatFeesEnabled
+	params.FeeDenomRoutes = DefaultFeeDenomRoutes
+	params.FlatFeeConversionRates = DefaultFlatFeeConversionRates
+	params.CheckTxMinFeeEventEnabled = DefaultCheckTxMinFeeEventEnabled
+	params.MaxContractBlockRewards = DefaultMaxContractBlockRewards
+	params.FlatFeeMigrationPolicy = DefaultFlatFeeMigrationPolicy
+	params.MaxFlatFeeMsgsPerTx = DefaultMaxFlatFeeMsgsPerTx
+	params.FlatFeeAbsorbedInGasFee = DefaultFlatFeeAbsorbedInGasFee
+	params.FeePromotion = DefaultFeePromotion
+	params.FlatFeeRefundOnFailure = DefaultFlatFeeRefundOnFailure
+	params.CheckTxLocalMinGasPriceEnabled = DefaultCheckTxLocalMinGasPriceEnabled
+	params.MinConsensusFeeSmoothingWindow = DefaultMinConsensusFeeSmoothingWindow
+	params.RewardsCallbackGasLimit = DefaultRewardsCallbackGasLimit
+	params.RewardsCallbacksBlockGasLimit = DefaultRewardsCallbacksBlockGasLimit
+
+	return params
 }
 
 // ParamSetPairs Implements the paramTypes.ParamSet interface.
@@ -77,12 +181,48 @@ func (m Params) Validate() error {
 	if err := validateTxFeeRebateRatio(m.TxFeeRebateRatio); err != nil {
 		return err
 	}
+	if err := validateRewardsRatiosSum(m.InflationRewardsRatio, m.TxFeeRebateRatio); err != nil {
+		return err
+	}
 	if err := validateMaxWithdrawRecords(m.MaxWithdrawRecords); err != nil {
 		return err
 	}
 	if err := validateMinPriceOfGas(m.MinPriceOfGas); err != nil {
 		return err
 	}
+	if err := validateAcceptedFeeDenoms(m.AcceptedFeeDenoms, m.MinPriceOfGas.Denom); err != nil {
+		return err
+	}
+	if err := validateMaxGasRebateMultiplier(m.MaxGasRebateMultiplier); err != nil {
+		return err
+	}
+	if err := validateTxSizeFeePerByte(m.TxSizeFeePerByte); err != nil {
+		return err
+	}
+	if err := validateFlatFeePrepayDiscount(m.FlatFeePrepayDiscount); err != nil {
+		return err
+	}
+	if err := validateFeeDenomRoutes(m.FeeDenomRoutes); err != nil {
+		return err
+	}
+	if err := validateFlatFeeConversionRates(m.FlatFeeConversionRates); err != nil {
+		return err
+	}
+	if err := validateMaxContractBlockRewards(m.MaxContractBlockRewards); err != nil {
+		return err
+	}
+	if err := validateFlatFeeMigrationPolicy(m.FlatFeeMigrationPolicy); err != nil {
+		return err
+	}
+	if err := validateFeePromotion(m.FeePromotion); err != nil {
+		return err
+	}
+	if err := validateMinConsensusFeeSmoothingWindow(m.MinConsensusFeeSmoothingWindow); err != nil {
+		return err
+	}
+	if err := validateRewardsCallbackGasLimits(m.RewardsCallbackGasLimit, m.RewardsCallbacksBlockGasLimit); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -128,6 +268,15 @@ func validateRatio(v math.LegacyDec) error {
 	return nil
 }
 
+// validateRewardsRatiosSum checks that the dApp rewards ratios combined do not exceed the 100% budget.
+func validateRewardsRatiosSum(inflationRewardsRatio, txFeeRebateRatio math.LegacyDec) error {
+	if inflationRewardsRatio.Add(txFeeRebateRatio).GT(math.LegacyOneDec()) {
+		return fmt.Errorf("inflationRewardsRatio and txFeeRebateRatio params: sum must be LTE 1.0")
+	}
+
+	return nil
+}
+
 func validateMaxWithdrawRecords(v interface{}) (retErr error) {
 	defer func() {
 		if retErr != nil {
@@ -164,3 +313,239 @@ func validateMinPriceOfGas(v interface{}) (retErr error) {
 
 	return p.Validate()
 }
+
+func validateFlatFeeMigrationPolicy(v interface{}) (retErr error) {
+	defer func() {
+		if retErr != nil {
+			retErr = fmt.Errorf("flatFeeMigrationPolicy param: %w", retErr)
+		}
+	}()
+
+	p, ok := v.(FlatFeeMigrationPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if _, ok := FlatFeeMigrationPolicy_name[int32(p)]; !ok {
+		return fmt.Errorf("unknown value: %d", p)
+	}
+
+	return nil
+}
+
+// validateAcceptedFeeDenoms checks the accepted fee denoms list (if set) contains the bond denom (the gas price denom),
+// otherwise transactions could not pay the gas fees.
+func validateAcceptedFeeDenoms(denoms []string, bondDenom string) (retErr error) {
+	defer func() {
+		if retErr != nil {
+			retErr = fmt.Errorf("acceptedFeeDenoms param: %w", retErr)
+		}
+	}()
+
+	if len(denoms) == 0 {
+		return nil
+	}
+
+	denomsSet := make(map[string]struct{}, len(denoms))
+	for i, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("denom [%d]: %w", i, err)
+		}
+		if _, ok := denomsSet[denom]; ok {
+			return fmt.Errorf("denom [%d]: duplicated (%s)", i, denom)
+		}
+		denomsSet[denom] = struct{}{}
+	}
+
+	if _, ok := denomsSet[bondDenom]; !ok {
+		return fmt.Errorf("bond denom (%s) must be accepted", bondDenom)
+	}
+
+	return nil
+}
+
+// validateFeeDenomRoutes checks the fee denom routes have unique valid denoms and non-empty module accounts
+// (other than the fee collector itself).
+func validateFeeDenomRoutes(routes []FeeDenomRoute) (retErr error) {
+	defer func() {
+		if retErr != nil {
+			retErr = fmt.Errorf("feeDenomRoutes param: %w", retErr)
+		}
+	}()
+
+	denomsSet := make(map[string]struct{}, len(routes))
+	for i, route := range routes {
+		if err := sdk.ValidateDenom(route.Denom); err != nil {
+			return fmt.Errorf("route [%d]: denom: %w", i, err)
+		}
+		if _, ok := denomsSet[route.Denom]; ok {
+			return fmt.Errorf("route [%d]: duplicated denom (%s)", i, route.Denom)
+		}
+		denomsSet[route.Denom] = struct{}{}
+
+		if strings.TrimSpace(route.ModuleAccount) == "" {
+			return fmt.Errorf("route [%d]: empty module account", i)
+		}
+		if route.ModuleAccount == authTypes.FeeCollectorName {
+			return fmt.Errorf("route [%d]: module account can not be the fee collector", i)
+		}
+	}
+
+	return nil
+}
+
+// validateFlatFeeConversionRates checks the flat fee conversion rates have valid distinct denoms, positive rates
+// and unique denom pairs.
+func validateFlatFeeConversionRates(rates []FlatFeeConversionRate) (retErr error) {
+	defer func() {
+		if retErr != nil {
+			retErr = fmt.Errorf("flatFeeConversionRates param: %w", retErr)
+		}
+	}()
+
+	pairsSet := make(map[[2]string]struct{}, len(rates))
+	for i, rate := range rates {
+		if err := sdk.ValidateDenom(rate.Denom); err != nil {
+			return fmt.Errorf("rate [%d]: denom: %w", i, err)
+		}
+		if err := sdk.ValidateDenom(rate.FeeDenom); err != nil {
+			return fmt.Errorf("rate [%d]: feeDenom: %w", i, err)
+		}
+		if rate.Denom == rate.FeeDenom {
+			return fmt.Errorf("rate [%d]: denom and feeDenom must differ (%s)", i, rate.Denom)
+		}
+
+		pair := [2]string{rate.Denom, rate.FeeDenom}
+		if _, ok := pairsSet[pair]; ok {
+			return fmt.Errorf("rate [%d]: duplicated denoms pair (%s, %s)", i, rate.Denom, rate.FeeDenom)
+		}
+		pairsSet[pair] = struct{}{}
+
+		if rate.Rate.IsNil() || !rate.Rate.IsPositive() {
+			return fmt.Errorf("rate [%d]: rate must be positive", i)
+		}
+	}
+
+	return nil
+}
+
+// validateMaxContractBlockRewards checks the contract block rewards cap is a valid coins set (sorted, unique, positive).
+func validateMaxContractBlockRewards(maxRewards []sdk.Coin) error {
+	if err := sdk.Coins(maxRewards).Validate(); err != nil {
+		return fmt.Errorf("maxContractBlockRewards param: %w", err)
+	}
+
+	return nil
+}
+
+// validateMaxGasRebateMultiplier checks the multiplier is either zero (disabled) or within the [1.0x, MaxGasRebateMultiplierParamLimit] range.
+func validateMaxGasRebateMultiplier(multiplier uint64) (retErr error) {
+	defer func() {
+		if retErr != nil {
+			retErr = fmt.Errorf("maxGasRebateMultiplier param: %w", retErr)
+		}
+	}()
+
+	if multiplier == 0 {
+		return nil
+	}
+
+	if multiplier < GasRebateMultiplierBase {
+		return fmt.Errorf("must be GTE %d (if set)", GasRebateMultiplierBase)
+	}
+	if multiplier > MaxGasRebateMultiplierParamLimit {
+		return fmt.Errorf("must be LTE %d", MaxGasRebateMultiplierParamLimit)
+	}
+
+	return nil
+}
+
+// validateTxSizeFeePerByte checks the tx size surcharge doesn't exceed the MaxTxSizeFeePerByteParamLimit value.
+func validateTxSizeFeePerByte(feePerByte uint64) (retErr error) {
+	defer func() {
+		if retErr != nil {
+			retErr = fmt.Errorf("txSizeFeePerByte param: %w", retErr)
+		}
+	}()
+
+	if feePerByte > MaxTxSizeFeePerByteParamLimit {
+		return fmt.Errorf("must be LTE %d", MaxTxSizeFeePerByteParamLimit)
+	}
+
+	return nil
+}
+
+// validateRewardsCallbackGasLimits checks the callback gas limits are within their ranges and a single callback fits
+// into the block budget (unless one of them is zero which disables callbacks).
+func validateRewardsCallbackGasLimits(callbackGasLimit, blockGasLimit uint64) error {
+	if callbackGasLimit > MaxRewardsCallbackGasLimitParamLimit {
+		return fmt.Errorf("rewardsCallbackGasLimit param: must be LTE %d", MaxRewardsCallbackGasLimitParamLimit)
+	}
+	if blockGasLimit > MaxRewardsCallbacksBlockGasLimitParamLimit {
+		return fmt.Errorf("rewardsCallbacksBlockGasLimit param: must be LTE %d", MaxRewardsCallbacksBlockGasLimitParamLimit)
+	}
+	if callbackGasLimit != 0 && blockGasLimit != 0 && callbackGasLimit > blockGasLimit {
+		return fmt.Errorf("rewardsCallbackGasLimit and rewardsCallbacksBlockGasLimit params: callback limit must be LTE the block limit")
+	}
+
+	return nil
+}
+
+func validateFlatFeePrepayDiscount(v interface{}) (retErr error) {
+	defer func() {
+		if retErr != nil {
+			retErr = fmt.Errorf("flatFeePrepayDiscount param: %w", retErr)
+		}
+	}()
+
+	p, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if p >= FlatFeePrepayDiscountBase {
+		return fmt.Errorf("must be LT %d", FlatFeePrepayDiscountBase)
+	}
+
+	return nil
+}
+
+// validateMinConsensusFeeSmoothingWindow checks the window is covered by the tracked minimum consensus fee history.
+func validateMinConsensusFeeSmoothingWindow(window uint64) (retErr error) {
+	defer func() {
+		if retErr != nil {
+			retErr = fmt.Errorf("minConsensusFeeSmoothingWindow param: %w", retErr)
+		}
+	}()
+
+	if window > MinConsensusFeeHistoryBlocks {
+		return fmt.Errorf("must be LTE %d", MinConsensusFeeHistoryBlocks)
+	}
+
+	return nil
+}
+
+// validateFeePromotion checks the fee promotion discount is LT 100% and the height window is set for an enabled promotion.
+func validateFeePromotion(promotion FeePromotion) (retErr error) {
+	defer func() {
+		if retErr != nil {
+			retErr = fmt.Errorf("feePromotion param: %w", retErr)
+		}
+	}()
+
+	if promotion.Discount == 0 {
+		return nil
+	}
+
+	if promotion.Discount >= FeePromotionDiscountBase {
+		return fmt.Errorf("discount: must be LT %d", FeePromotionDiscountBase)
+	}
+	if promotion.StartHeight <= 0 {
+		return fmt.Errorf("startHeight: must be GT 0")
+	}
+	if promotion.EndHeight < promotion.StartHeight {
+		return fmt.Errorf("endHeight: must be GTE startHeight (%d)", promotion.StartHeight)
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package types
+
+import (
+	"sort"
+	"strconv"
+)
+
+// Param value types reported by ParamsMetadata.
+const (
+	ParamTypeUint64                 = "uint64"
+	ParamTypeBool                   = "bool"
+	ParamTypeDec                    = "dec"
+	ParamTypeDecCoin                = "dec_coin"
+	ParamTypeCoins                  = "coins"
+	ParamTypeEnum                   = "enum"
+	ParamTypeStringList             = "string_list"
+	ParamTypeFeeDenomRoutes         = "fee_denom_routes"
+	ParamTypeFlatFeeConversionRates = "flat_fee_conversion_rates"
+	ParamTypeFeePromotion           = "fee_promotion"
+)
+
+// ParamsMetadata returns the validation metadata of every module parameter (in the Params fields order).
+// The metadata mirrors the Params.Validate rules (limits are taken from the same values the validators use),
+// so it must be updated along with them.
+func ParamsMetadata() []ParamMetadata {
+	return []ParamMetadata{
+		{
+			Name:         "inflation_rewards_ratio",
+			Type:         ParamTypeDec,
+			Min:          "0",
+			Max:          "1",
+			MaxExclusive: true,
+			Constraints:  "sum with tx_fee_rebate_ratio must be LTE 1.0",
+		},
+		{
+			Name:         "tx_fee_rebate_ratio",
+			Type:         ParamTypeDec,
+			Min:          "0",
+			Max:          "1",
+			MaxExclusive: true,
+			Constraints:  "sum with inflation_rewards_ratio must be LTE 1.0",
+		},
+		{
+			Name: "max_withdraw_records",
+			Type: ParamTypeUint64,
+			Min:  "1",
+			Max:  strconv.FormatUint(MaxWithdrawRecordsParamLimit, 10),
+		},
+		{
+			Name:        "min_price_of_gas",
+			Type:        ParamTypeDecCoin,
+			Min:         "0",
+			Constraints: "valid denom",
+		},
+		{Name: "dynamic_fee_enabled", Type: ParamTypeBool},
+		{Name: "flat_fee_update_interval", Type: ParamTypeUint64},
+		{Name: "max_flat_fee_update_contracts", Type: ParamTypeUint64},
+		{Name: "flat_fee_deliver_tx_only", Type: ParamTypeBool},
+		{Name: "min_fee_floor_enabled", Type: ParamTypeBool},
+		{Name: "min_contract_execution_gas", Type: ParamTypeUint64},
+		{Name: "free_tx_budget", Type: ParamTypeUint64},
+		{Name: "max_gas_rebate_multiplier", Type: ParamTypeUint64},
+		{Name: "flat_fee_once_per_block", Type: ParamTypeBool},
+		{
+			Name:        "accepted_fee_denoms",
+			Type:        ParamTypeStringList,
+			Constraints: "unique valid denoms; if not empty, must include the min_price_of_gas denom",
+		},
+		{Name: "tx_size_fee_per_byte", Type: ParamTypeUint64},
+		{Name: "single_denom_fees_only", Type: ParamTypeBool},
+		{
+			Name:         "flat_fee_prepay_discount",
+			Type:         ParamTypeUint64,
+			Max:          strconv.FormatUint(FlatFeePrepayDiscountBase, 10),
+			MaxExclusive: true,
+		},
+		{Name: "flat_fee_payer_must_sign", Type: ParamTypeBool},
+		{Name: "flat_fees_enabled", Type: ParamTypeBool},
+		{
+			Name:        "fee_denom_routes",
+			Type:        ParamTypeFeeDenomRoutes,
+			Constraints: "unique valid denoms; module account must be set and must not be the fee collector",
+		},
+		{
+			Name:        "flat_fee_conversion_rates",
+			Type:        ParamTypeFlatFeeConversionRates,
+			Constraints: "valid distinct denom and fee denom; unique denoms pairs; positive rate",
+		},
+		{Name: "check_tx_min_fee_event_enabled", Type: ParamTypeBool},
+		{
+			Name:        "max_contract_block_rewards",
+			Type:        ParamTypeCoins,
+			Constraints: "valid coins set (sorted, unique denoms, positive amounts)",
+		},
+		{
+			Name:          "flat_fee_migration_policy",
+			Type:          ParamTypeEnum,
+			AllowedValues: enumValueNames(FlatFeeMigrationPolicy_name),
+		},
+		{Name: "max_flat_fee_msgs_per_tx", Type: ParamTypeUint64},
+		{Name: "flat_fee_absorbed_in_gas_fee", Type: ParamTypeBool},
+		{
+			Name:        "fee_promotion",
+			Type:        ParamTypeFeePromotion,
+			Constraints: "discount (basis points) LT " + strconv.FormatUint(FeePromotionDiscountBase, 10) + "; if discount is set, start_height must be GT 0 and LTE end_height",
+		},
+		{Name: "flat_fee_refund_on_failure", Type: ParamTypeBool},
+		{Name: "check_tx_local_min_gas_price_enabled", Type: ParamTypeBool},
+		{
+			Name: "min_consensus_fee_smoothing_window",
+			Type: ParamTypeUint64,
+			Max:  strconv.FormatUint(MinConsensusFeeHistoryBlocks, 10),
+		},
+		{Name: "rewards_callback_gas_limit", Type: ParamTypeUint64},
+		{Name: "rewards_callbacks_block_gas_limit", Type: ParamTypeUint64},
+	}
+}
+
+// enumValueNames returns the proto enum value names sorted by the enum value (the validators accept any known value).
+func enumValueNames(names map[int32]string) []string {
+	values := make([]int32, 0, len(names))
+	for value := range names {
+		values = append(values, value)
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+
+	res := make([]string, 0, len(values))
+	for _, value := range values {
+		res = append(res, names[value])
+	}
+
+	return res
+}
@@ -1,11 +1,15 @@
 package types_test
 
 import (
+	"reflect"
+	"strconv"
+	"strings"
 	"testing"
 
 	math "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
 )
@@ -67,6 +71,25 @@ func TestRewardsParamsValidate(t *testing.T) {
 			},
 			errExpected: true,
 		},
+		{
+			name: "OK: ratios sum: equal to 1.0",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio: math.LegacyNewDecWithPrec(5, 1),
+				TxFeeRebateRatio:      math.LegacyNewDecWithPrec(5, 1),
+				MaxWithdrawRecords:    1,
+				MinPriceOfGas:         rewardsTypes.DefaultMinPriceOfGas,
+			},
+		},
+		{
+			name: "Fail: ratios sum: GT 1.0",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio: math.LegacyNewDecWithPrec(6, 1),
+				TxFeeRebateRatio:      math.LegacyNewDecWithPrec(5, 1),
+				MaxWithdrawRecords:    1,
+				MinPriceOfGas:         rewardsTypes.DefaultMinPriceOfGas,
+			},
+			errExpected: true,
+		},
 		{
 			name: "Fail: MaxWithdrawRecords: empty",
 			params: rewardsTypes.Params{
@@ -96,6 +119,455 @@ func TestRewardsParamsValidate(t *testing.T) {
 			},
 			errExpected: true,
 		},
+		{
+			name: "OK: FlatFeeMigrationPolicy: INHERIT_CODE_ID",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:  math.LegacyNewDecWithPrec(2, 2),
+				TxFeeRebateRatio:       math.LegacyNewDecWithPrec(5, 2),
+				MaxWithdrawRecords:     1,
+				MinPriceOfGas:          rewardsTypes.DefaultMinPriceOfGas,
+				FlatFeeMigrationPolicy: rewardsTypes.FlatFeeMigrationPolicy_FLAT_FEE_MIGRATION_POLICY_INHERIT_CODE_ID,
+			},
+		},
+		{
+			name: "Fail: FlatFeeMigrationPolicy: unknown",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:  math.LegacyNewDecWithPrec(2, 2),
+				TxFeeRebateRatio:       math.LegacyNewDecWithPrec(5, 2),
+				MaxWithdrawRecords:     1,
+				MinPriceOfGas:          rewardsTypes.DefaultMinPriceOfGas,
+				FlatFeeMigrationPolicy: rewardsTypes.FlatFeeMigrationPolicy(100),
+			},
+			errExpected: true,
+		},
+		{
+			name: "OK: AcceptedFeeDenoms: bond denom accepted",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio: math.LegacyNewDecWithPrec(2, 2),
+				TxFeeRebateRatio:      math.LegacyNewDecWithPrec(5, 2),
+				MaxWithdrawRecords:    1,
+				MinPriceOfGas:         rewardsTypes.DefaultMinPriceOfGas,
+				AcceptedFeeDenoms:     []string{"uarch", rewardsTypes.DefaultMinPriceOfGas.Denom},
+			},
+		},
+		{
+			name: "Fail: AcceptedFeeDenoms: bond denom dropped",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio: math.LegacyNewDecWithPrec(2, 2),
+				TxFeeRebateRatio:      math.LegacyNewDecWithPrec(5, 2),
+				MaxWithdrawRecords:    1,
+				MinPriceOfGas:         rewardsTypes.DefaultMinPriceOfGas,
+				AcceptedFeeDenoms:     []string{"uarch"},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: AcceptedFeeDenoms: invalid denom",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio: math.LegacyNewDecWithPrec(2, 2),
+				TxFeeRebateRatio:      math.LegacyNewDecWithPrec(5, 2),
+				MaxWithdrawRecords:    1,
+				MinPriceOfGas:         rewardsTypes.DefaultMinPriceOfGas,
+				AcceptedFeeDenoms:     []string{rewardsTypes.DefaultMinPriceOfGas.Denom, "1"},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: AcceptedFeeDenoms: duplicated denom",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio: math.LegacyNewDecWithPrec(2, 2),
+				TxFeeRebateRatio:      math.LegacyNewDecWithPrec(5, 2),
+				MaxWithdrawRecords:    1,
+				MinPriceOfGas:         rewardsTypes.DefaultMinPriceOfGas,
+				AcceptedFeeDenoms:     []string{rewardsTypes.DefaultMinPriceOfGas.Denom, rewardsTypes.DefaultMinPriceOfGas.Denom},
+			},
+			errExpected: true,
+		},
+		{
+			name: "OK: FeeDenomRoutes: two denoms",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio: math.LegacyNewDecWithPrec(2, 2),
+				TxFeeRebateRatio:      math.LegacyNewDecWithPrec(5, 2),
+				MaxWithdrawRecords:    1,
+				MinPriceOfGas:         rewardsTypes.DefaultMinPriceOfGas,
+				FeeDenomRoutes: []rewardsTypes.FeeDenomRoute{
+					{Denom: "uusdc", ModuleAccount: "distribution"},
+					{Denom: "stake", ModuleAccount: "treasury"},
+				},
+			},
+		},
+		{
+			name: "Fail: FeeDenomRoutes: invalid denom",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio: math.LegacyNewDecWithPrec(2, 2),
+				TxFeeRebateRatio:      math.LegacyNewDecWithPrec(5, 2),
+				MaxWithdrawRecords:    1,
+				MinPriceOfGas:         rewardsTypes.DefaultMinPriceOfGas,
+				FeeDenomRoutes: []rewardsTypes.FeeDenomRoute{
+					{Denom: "1", ModuleAccount: "distribution"},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: FeeDenomRoutes: duplicated denom",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio: math.LegacyNewDecWithPrec(2, 2),
+				TxFeeRebateRatio:      math.LegacyNewDecWithPrec(5, 2),
+				MaxWithdrawRecords:    1,
+				MinPriceOfGas:         rewardsTypes.DefaultMinPriceOfGas,
+				FeeDenomRoutes: []rewardsTypes.FeeDenomRoute{
+					{Denom: "uusdc", ModuleAccount: "distribution"},
+					{Denom: "uusdc", ModuleAccount: "treasury"},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: FeeDenomRoutes: empty module account",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio: math.LegacyNewDecWithPrec(2, 2),
+				TxFeeRebateRatio:      math.LegacyNewDecWithPrec(5, 2),
+				MaxWithdrawRecords:    1,
+				MinPriceOfGas:         rewardsTypes.DefaultMinPriceOfGas,
+				FeeDenomRoutes: []rewardsTypes.FeeDenomRoute{
+					{Denom: "uusdc", ModuleAccount: " "},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: FeeDenomRoutes: fee collector module account",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio: math.LegacyNewDecWithPrec(2, 2),
+				TxFeeRebateRatio:      math.LegacyNewDecWithPrec(5, 2),
+				MaxWithdrawRecords:    1,
+				MinPriceOfGas:         rewardsTypes.DefaultMinPriceOfGas,
+				FeeDenomRoutes: []rewardsTypes.FeeDenomRoute{
+					{Denom: "uusdc", ModuleAccount: "fee_collector"},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "OK: FlatFeeConversionRates: two pairs",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio: math.LegacyNewDecWithPrec(2, 2),
+				TxFeeRebateRatio:      math.LegacyNewDecWithPrec(5, 2),
+				MaxWithdrawRecords:    1,
+				MinPriceOfGas:         rewardsTypes.DefaultMinPriceOfGas,
+				FlatFeeConversionRates: []rewardsTypes.FlatFeeConversionRate{
+					{Denom: "uarch", FeeDenom: "stake", Rate: math.LegacyNewDecWithPrec(25, 1)},
+					{Denom: "uarch", FeeDenom: "uusdc", Rate: math.LegacyNewDecWithPrec(1, 3)},
+				},
+			},
+		},
+		{
+			name: "Fail: FlatFeeConversionRates: invalid denom",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio: math.LegacyNewDecWithPrec(2, 2),
+				TxFeeRebateRatio:      math.LegacyNewDecWithPrec(5, 2),
+				MaxWithdrawRecords:    1,
+				MinPriceOfGas:         rewardsTypes.DefaultMinPriceOfGas,
+				FlatFeeConversionRates: []rewardsTypes.FlatFeeConversionRate{
+					{Denom: "1", FeeDenom: "stake", Rate: math.LegacyOneDec()},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: FlatFeeConversionRates: invalid fee denom",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio: math.LegacyNewDecWithPrec(2, 2),
+				TxFeeRebateRatio:      math.LegacyNewDecWithPrec(5, 2),
+				MaxWithdrawRecords:    1,
+				MinPriceOfGas:         rewardsTypes.DefaultMinPriceOfGas,
+				FlatFeeConversionRates: []rewardsTypes.FlatFeeConversionRate{
+					{Denom: "uarch", FeeDenom: "1", Rate: math.LegacyOneDec()},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: FlatFeeConversionRates: same denoms",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio: math.LegacyNewDecWithPrec(2, 2),
+				TxFeeRebateRatio:      math.LegacyNewDecWithPrec(5, 2),
+				MaxWithdrawRecords:    1,
+				MinPriceOfGas:         rewardsTypes.DefaultMinPriceOfGas,
+				FlatFeeConversionRates: []rewardsTypes.FlatFeeConversionRate{
+					{Denom: "uarch", FeeDenom: "uarch", Rate: math.LegacyOneDec()},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: FlatFeeConversionRates: duplicated pair",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio: math.LegacyNewDecWithPrec(2, 2),
+				TxFeeRebateRatio:      math.LegacyNewDecWithPrec(5, 2),
+				MaxWithdrawRecords:    1,
+				MinPriceOfGas:         rewardsTypes.DefaultMinPriceOfGas,
+				FlatFeeConversionRates: []rewardsTypes.FlatFeeConversionRate{
+					{Denom: "uarch", FeeDenom: "stake", Rate: math.LegacyOneDec()},
+					{Denom: "uarch", FeeDenom: "stake", Rate: math.LegacyNewDec(2)},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: FlatFeeConversionRates: zero rate",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio: math.LegacyNewDecWithPrec(2, 2),
+				TxFeeRebateRatio:      math.LegacyNewDecWithPrec(5, 2),
+				MaxWithdrawRecords:    1,
+				MinPriceOfGas:         rewardsTypes.DefaultMinPriceOfGas,
+				FlatFeeConversionRates: []rewardsTypes.FlatFeeConversionRate{
+					{Denom: "uarch", FeeDenom: "stake", Rate: math.LegacyZeroDec()},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: FlatFeeConversionRates: nil rate",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio: math.LegacyNewDecWithPrec(2, 2),
+				TxFeeRebateRatio:      math.LegacyNewDecWithPrec(5, 2),
+				MaxWithdrawRecords:    1,
+				MinPriceOfGas:         rewardsTypes.DefaultMinPriceOfGas,
+				FlatFeeConversionRates: []rewardsTypes.FlatFeeConversionRate{
+					{Denom: "uarch", FeeDenom: "stake"},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "OK: MaxContractBlockRewards: two denoms",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:   math.LegacyNewDecWithPrec(2, 2),
+				TxFeeRebateRatio:        math.LegacyNewDecWithPrec(5, 2),
+				MaxWithdrawRecords:      1,
+				MinPriceOfGas:           rewardsTypes.DefaultMinPriceOfGas,
+				MaxContractBlockRewards: []sdk.Coin{sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("uarch", 10)},
+			},
+		},
+		{
+			name: "Fail: MaxContractBlockRewards: zero amount",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:   math.LegacyNewDecWithPrec(2, 2),
+				TxFeeRebateRatio:        math.LegacyNewDecWithPrec(5, 2),
+				MaxWithdrawRecords:      1,
+				MinPriceOfGas:           rewardsTypes.DefaultMinPriceOfGas,
+				MaxContractBlockRewards: []sdk.Coin{sdk.NewInt64Coin("stake", 0)},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: MaxContractBlockRewards: unsorted denoms",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:   math.LegacyNewDecWithPrec(2, 2),
+				TxFeeRebateRatio:        math.LegacyNewDecWithPrec(5, 2),
+				MaxWithdrawRecords:      1,
+				MinPriceOfGas:           rewardsTypes.DefaultMinPriceOfGas,
+				MaxContractBlockRewards: []sdk.Coin{sdk.NewInt64Coin("uarch", 10), sdk.NewInt64Coin("stake", 100)},
+			},
+			errExpected: true,
+		},
+		{
+			name: "OK: FeePromotion: single block window",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio: math.LegacyNewDecWithPrec(2, 2),
+				TxFeeRebateRatio:      math.LegacyNewDecWithPrec(5, 2),
+				MaxWithdrawRecords:    1,
+				MinPriceOfGas:         rewardsTypes.DefaultMinPriceOfGas,
+				FeePromotion:          rewardsTypes.FeePromotion{Discount: 2500, StartHeight: 10, EndHeight: 10},
+			},
+		},
+		{
+			name: "OK: FeePromotion: disabled with no window",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio: math.LegacyNewDecWithPrec(2, 2),
+				TxFeeRebateRatio:      math.LegacyNewDecWithPrec(5, 2),
+				MaxWithdrawRecords:    1,
+				MinPriceOfGas:         rewardsTypes.DefaultMinPriceOfGas,
+				FeePromotion:          rewardsTypes.FeePromotion{},
+			},
+		},
+		{
+			name: "Fail: FeePromotion: 100% discount",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio: math.LegacyNewDecWithPrec(2, 2),
+				TxFeeRebateRatio:      math.LegacyNewDecWithPrec(5, 2),
+				MaxWithdrawRecords:    1,
+				MinPriceOfGas:         rewardsTypes.DefaultMinPriceOfGas,
+				FeePromotion:          rewardsTypes.FeePromotion{Discount: rewardsTypes.FeePromotionDiscountBase, StartHeight: 10, EndHeight: 20},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: FeePromotion: zero start height",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio: math.LegacyNewDecWithPrec(2, 2),
+				TxFeeRebateRatio:      math.LegacyNewDecWithPrec(5, 2),
+				MaxWithdrawRecords:    1,
+				MinPriceOfGas:         rewardsTypes.DefaultMinPriceOfGas,
+				FeePromotion:          rewardsTypes.FeePromotion{Discount: 2500, EndHeight: 20},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: FeePromotion: end height LT start height",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio: math.LegacyNewDecWithPrec(2, 2),
+				TxFeeRebateRatio:      math.LegacyNewDecWithPrec(5, 2),
+				MaxWithdrawRecords:    1,
+				MinPriceOfGas:         rewardsTypes.DefaultMinPriceOfGas,
+				FeePromotion:          rewardsTypes.FeePromotion{Discount: 2500, StartHeight: 20, EndHeight: 10},
+			},
+			errExpected: true,
+		},
+		{
+			name: "OK: MaxGasRebateMultiplier: disabled",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:  math.LegacyNewDecWithPrec(2, 2),
+				TxFeeRebateRatio:       math.LegacyNewDecWithPrec(5, 2),
+				MaxWithdrawRecords:     1,
+				MinPriceOfGas:          rewardsTypes.DefaultMinPriceOfGas,
+				MaxGasRebateMultiplier: 0,
+			},
+		},
+		{
+			name: "OK: MaxGasRebateMultiplier: 1.0x",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:  math.LegacyNewDecWithPrec(2, 2),
+				TxFeeRebateRatio:       math.LegacyNewDecWithPrec(5, 2),
+				MaxWithdrawRecords:     1,
+				MinPriceOfGas:          rewardsTypes.DefaultMinPriceOfGas,
+				MaxGasRebateMultiplier: rewardsTypes.GasRebateMultiplierBase,
+			},
+		},
+		{
+			name: "OK: MaxGasRebateMultiplier: limit",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:  math.LegacyNewDecWithPrec(2, 2),
+				TxFeeRebateRatio:       math.LegacyNewDecWithPrec(5, 2),
+				MaxWithdrawRecords:     1,
+				MinPriceOfGas:          rewardsTypes.DefaultMinPriceOfGas,
+				MaxGasRebateMultiplier: rewardsTypes.MaxGasRebateMultiplierParamLimit,
+			},
+		},
+		{
+			name: "Fail: MaxGasRebateMultiplier: LT 1.0x",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:  math.LegacyNewDecWithPrec(2, 2),
+				TxFeeRebateRatio:       math.LegacyNewDecWithPrec(5, 2),
+				MaxWithdrawRecords:     1,
+				MinPriceOfGas:          rewardsTypes.DefaultMinPriceOfGas,
+				MaxGasRebateMultiplier: rewardsTypes.GasRebateMultiplierBase - 1,
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: MaxGasRebateMultiplier: limit exceeded",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:  math.LegacyNewDecWithPrec(2, 2),
+				TxFeeRebateRatio:       math.LegacyNewDecWithPrec(5, 2),
+				MaxWithdrawRecords:     1,
+				MinPriceOfGas:          rewardsTypes.DefaultMinPriceOfGas,
+				MaxGasRebateMultiplier: rewardsTypes.MaxGasRebateMultiplierParamLimit + 1,
+			},
+			errExpected: true,
+		},
+		{
+			name: "OK: TxSizeFeePerByte: limit",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio: math.LegacyNewDecWithPrec(2, 2),
+				TxFeeRebateRatio:      math.LegacyNewDecWithPrec(5, 2),
+				MaxWithdrawRecords:    1,
+				MinPriceOfGas:         rewardsTypes.DefaultMinPriceOfGas,
+				TxSizeFeePerByte:      rewardsTypes.MaxTxSizeFeePerByteParamLimit,
+			},
+		},
+		{
+			name: "Fail: TxSizeFeePerByte: limit exceeded",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio: math.LegacyNewDecWithPrec(2, 2),
+				TxFeeRebateRatio:      math.LegacyNewDecWithPrec(5, 2),
+				MaxWithdrawRecords:    1,
+				MinPriceOfGas:         rewardsTypes.DefaultMinPriceOfGas,
+				TxSizeFeePerByte:      rewardsTypes.MaxTxSizeFeePerByteParamLimit + 1,
+			},
+			errExpected: true,
+		},
+		{
+			name: "OK: RewardsCallbackGasLimit: equals the block limit",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:         math.LegacyNewDecWithPrec(2, 2),
+				TxFeeRebateRatio:              math.LegacyNewDecWithPrec(5, 2),
+				MaxWithdrawRecords:            1,
+				MinPriceOfGas:                 rewardsTypes.DefaultMinPriceOfGas,
+				RewardsCallbackGasLimit:       1_000_000,
+				RewardsCallbacksBlockGasLimit: 1_000_000,
+			},
+		},
+		{
+			name: "OK: RewardsCallbackGasLimit: GT the disabled block limit",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:         math.LegacyNewDecWithPrec(2, 2),
+				TxFeeRebateRatio:              math.LegacyNewDecWithPrec(5, 2),
+				MaxWithdrawRecords:            1,
+				MinPriceOfGas:                 rewardsTypes.DefaultMinPriceOfGas,
+				RewardsCallbackGasLimit:       rewardsTypes.DefaultRewardsCallbackGasLimit,
+				RewardsCallbacksBlockGasLimit: 0,
+			},
+		},
+		{
+			name: "OK: RewardsCallbacksBlockGasLimit: limit",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:         math.LegacyNewDecWithPrec(2, 2),
+				TxFeeRebateRatio:              math.LegacyNewDecWithPrec(5, 2),
+				MaxWithdrawRecords:            1,
+				MinPriceOfGas:                 rewardsTypes.DefaultMinPriceOfGas,
+				RewardsCallbackGasLimit:       rewardsTypes.MaxRewardsCallbackGasLimitParamLimit,
+				RewardsCallbacksBlockGasLimit: rewardsTypes.MaxRewardsCallbacksBlockGasLimitParamLimit,
+			},
+		},
+		{
+			name: "Fail: RewardsCallbackGasLimit: GT the block limit",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:         math.LegacyNewDecWithPrec(2, 2),
+				TxFeeRebateRatio:              math.LegacyNewDecWithPrec(5, 2),
+				MaxWithdrawRecords:            1,
+				MinPriceOfGas:                 rewardsTypes.DefaultMinPriceOfGas,
+				RewardsCallbackGasLimit:       1_000_001,
+				RewardsCallbacksBlockGasLimit: 1_000_000,
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: RewardsCallbackGasLimit: GT limit",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:         math.LegacyNewDecWithPrec(2, 2),
+				TxFeeRebateRatio:              math.LegacyNewDecWithPrec(5, 2),
+				MaxWithdrawRecords:            1,
+				MinPriceOfGas:                 rewardsTypes.DefaultMinPriceOfGas,
+				RewardsCallbackGasLimit:       rewardsTypes.MaxRewardsCallbackGasLimitParamLimit + 1,
+				RewardsCallbacksBlockGasLimit: rewardsTypes.MaxRewardsCallbacksBlockGasLimitParamLimit,
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: RewardsCallbacksBlockGasLimit: GT limit",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:         math.LegacyNewDecWithPrec(2, 2),
+				TxFeeRebateRatio:              math.LegacyNewDecWithPrec(5, 2),
+				MaxWithdrawRecords:            1,
+				MinPriceOfGas:                 rewardsTypes.DefaultMinPriceOfGas,
+				RewardsCallbackGasLimit:       rewardsTypes.DefaultRewardsCallbackGasLimit,
+				RewardsCallbacksBlockGasLimit: rewardsTypes.MaxRewardsCallbacksBlockGasLimitParamLimit + 1,
+			},
+			errExpected: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -109,3 +581,124 @@ func TestRewardsParamsValidate(t *testing.T) {
 		})
 	}
 }
+
+// TestRewardsParamsMetadataFields checks every Params field has the metadata entry (in the fields order).
+func TestRewardsParamsMetadataFields(t *testing.T) {
+	var fieldNames []string
+	paramsType := reflect.TypeOf(rewardsTypes.Params{})
+	for i := 0; i < paramsType.NumField(); i++ {
+		for _, tagPart := range strings.Split(paramsType.Field(i).Tag.Get("protobuf"), ",") {
+			if name, found := strings.CutPrefix(tagPart, "name="); found {
+				fieldNames = append(fieldNames, name)
+			}
+		}
+	}
+
+	var metadataNames []string
+	for _, metadata := range rewardsTypes.ParamsMetadata() {
+		metadataNames = append(metadataNames, metadata.Name)
+	}
+
+	assert.Equal(t, fieldNames, metadataNames)
+}
+
+// TestRewardsParamsMetadataConstraints checks the metadata limits match the Params.Validate constraints: the bound
+// values are accepted (unless exclusive) and the values beyond the bounds are rejected.
+func TestRewardsParamsMetadataConstraints(t *testing.T) {
+	metadataSet := make(map[string]rewardsTypes.ParamMetadata)
+	for _, metadata := range rewardsTypes.ParamsMetadata() {
+		metadataSet[metadata.Name] = metadata
+	}
+
+	validateParams := func(setValue func(params *rewardsTypes.Params)) error {
+		params := rewardsTypes.DefaultParams()
+		params.TxFeeRebateRatio = math.LegacyZeroDec() // ratios sum is checked separately
+		setValue(&params)
+		return params.Validate()
+	}
+
+	t.Run("max_withdraw_records", func(t *testing.T) {
+		metadata := metadataSet["max_withdraw_records"]
+		require.Equal(t, rewardsTypes.ParamTypeUint64, metadata.Type)
+		minValue, err := strconv.ParseUint(metadata.Min, 10, 64)
+		require.NoError(t, err)
+		maxValue, err := strconv.ParseUint(metadata.Max, 10, 64)
+		require.NoError(t, err)
+		require.False(t, metadata.MaxExclusive)
+
+		setValue := func(v uint64) func(params *rewardsTypes.Params) {
+			return func(params *rewardsTypes.Params) { params.MaxWithdrawRecords = v }
+		}
+		assert.Error(t, validateParams(setValue(minValue-1)))
+		assert.NoError(t, validateParams(setValue(minValue)))
+		assert.NoError(t, validateParams(setValue(maxValue)))
+		assert.Error(t, validateParams(setValue(maxValue+1)))
+	})
+
+	t.Run("flat_fee_prepay_discount", func(t *testing.T) {
+		metadata := metadataSet["flat_fee_prepay_discount"]
+		require.Equal(t, rewardsTypes.ParamTypeUint64, metadata.Type)
+		require.Empty(t, metadata.Min)
+		maxValue, err := strconv.ParseUint(metadata.Max, 10, 64)
+		require.NoError(t, err)
+		require.True(t, metadata.MaxExclusive)
+
+		setValue := func(v uint64) func(params *rewardsTypes.Params) {
+			return func(params *rewardsTypes.Params) { params.FlatFeePrepayDiscount = v }
+		}
+		assert.NoError(t, validateParams(setValue(0)))
+		assert.NoError(t, validateParams(setValue(maxValue-1)))
+		assert.Error(t, validateParams(setValue(maxValue)))
+	})
+
+	t.Run("min_consensus_fee_smoothing_window", func(t *testing.T) {
+		metadata := metadataSet["min_consensus_fee_smoothing_window"]
+		require.Equal(t, rewardsTypes.ParamTypeUint64, metadata.Type)
+		require.Empty(t, metadata.Min)
+		maxValue, err := strconv.ParseUint(metadata.Max, 10, 64)
+		require.NoError(t, err)
+		require.False(t, metadata.MaxExclusive)
+
+		setValue := func(v uint64) func(params *rewardsTypes.Params) {
+			return func(params *rewardsTypes.Params) { params.MinConsensusFeeSmoothingWindow = v }
+		}
+		assert.NoError(t, validateParams(setValue(0)))
+		assert.NoError(t, validateParams(setValue(maxValue)))
+		assert.Error(t, validateParams(setValue(maxValue+1)))
+	})
+
+	t.Run("inflation_rewards_ratio", func(t *testing.T) {
+		metadata := metadataSet["inflation_rewards_ratio"]
+		require.Equal(t, rewardsTypes.ParamTypeDec, metadata.Type)
+		minValue, err := math.LegacyNewDecFromStr(metadata.Min)
+		require.NoError(t, err)
+		maxValue, err := math.LegacyNewDecFromStr(metadata.Max)
+		require.NoError(t, err)
+		require.True(t, metadata.MaxExclusive)
+
+		step := math.LegacyNewDecWithPrec(1, math.LegacyPrecision)
+		setValue := func(v math.LegacyDec) func(params *rewardsTypes.Params) {
+			return func(params *rewardsTypes.Params) { params.InflationRewardsRatio = v }
+		}
+		assert.Error(t, validateParams(setValue(minValue.Sub(step))))
+		assert.NoError(t, validateParams(setValue(minValue)))
+		assert.NoError(t, validateParams(setValue(maxValue.Sub(step))))
+		assert.Error(t, validateParams(setValue(maxValue)))
+	})
+
+	t.Run("flat_fee_migration_policy", func(t *testing.T) {
+		metadata := metadataSet["flat_fee_migration_policy"]
+		require.Equal(t, rewardsTypes.ParamTypeEnum, metadata.Type)
+		require.NotEmpty(t, metadata.AllowedValues)
+
+		setValue := func(v rewardsTypes.FlatFeeMigrationPolicy) func(params *rewardsTypes.Params) {
+			return func(params *rewardsTypes.Params) { params.FlatFeeMigrationPolicy = v }
+		}
+		for _, name := range metadata.AllowedValues {
+			value, found := rewardsTypes.FlatFeeMigrationPolicy_value[name]
+			require.True(t, found, name)
+			assert.NoError(t, validateParams(setValue(rewardsTypes.FlatFeeMigrationPolicy(value))), name)
+		}
+		assert.Error(t, validateParams(setValue(rewardsTypes.FlatFeeMigrationPolicy(len(metadata.AllowedValues)))))
+	})
+}
@@ -6,7 +6,9 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -114,6 +116,201 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryParamsMetadataRequest is the request for Query.ParamsMetadata.
+type QueryParamsMetadataRequest struct {
+}
+
+func (m *QueryParamsMetadataRequest) Reset()         { *m = QueryParamsMetadataRequest{} }
+func (m *QueryParamsMetadataRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsMetadataRequest) ProtoMessage()    {}
+func (*QueryParamsMetadataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{2}
+}
+func (m *QueryParamsMetadataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsMetadataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsMetadataRequest.Merge(m, src)
+}
+func (m *QueryParamsMetadataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsMetadataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsMetadataRequest proto.InternalMessageInfo
+
+// QueryParamsMetadataResponse is the response for Query.ParamsMetadata.
+type QueryParamsMetadataResponse struct {
+	// params is the current module parameters.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// metadata is the validation metadata of every parameter (in the Params
+	// fields order).
+	Metadata []ParamMetadata `protobuf:"bytes,2,rep,name=metadata,proto3" json:"metadata"`
+}
+
+func (m *QueryParamsMetadataResponse) Reset()         { *m = QueryParamsMetadataResponse{} }
+func (m *QueryParamsMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsMetadataResponse) ProtoMessage()    {}
+func (*QueryParamsMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{3}
+}
+func (m *QueryParamsMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsMetadataResponse.Merge(m, src)
+}
+func (m *QueryParamsMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsMetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsMetadataResponse proto.InternalMessageInfo
+
+func (m *QueryParamsMetadataResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *QueryParamsMetadataResponse) GetMetadata() []ParamMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+// ParamMetadata defines the validation constraints of a module parameter.
+type ParamMetadata struct {
+	// name is the parameter name (the Params proto field name).
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// type is the parameter value type (uint64, bool, dec, dec_coin, coins,
+	// enum, string_list, fee_denom_routes, flat_fee_conversion_rates).
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// min is the min allowed value (inclusive, empty if not limited).
+	Min string `protobuf:"bytes,3,opt,name=min,proto3" json:"min,omitempty"`
+	// max is the max allowed value (empty if not limited).
+	Max string `protobuf:"bytes,4,opt,name=max,proto3" json:"max,omitempty"`
+	// max_exclusive is true if the max value itself is not allowed.
+	MaxExclusive bool `protobuf:"varint,5,opt,name=max_exclusive,json=maxExclusive,proto3" json:"max_exclusive,omitempty"`
+	// allowed_values is the list of allowed enum values (empty for non-enum
+	// params).
+	AllowedValues []string `protobuf:"bytes,6,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"`
+	// constraints describes the other validation rules (empty if none).
+	Constraints string `protobuf:"bytes,7,opt,name=constraints,proto3" json:"constraints,omitempty"`
+}
+
+func (m *ParamMetadata) Reset()         { *m = ParamMetadata{} }
+func (m *ParamMetadata) String() string { return proto.CompactTextString(m) }
+func (*ParamMetadata) ProtoMessage()    {}
+func (*ParamMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{4}
+}
+func (m *ParamMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamMetadata.Merge(m, src)
+}
+func (m *ParamMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamMetadata proto.InternalMessageInfo
+
+func (m *ParamMetadata) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ParamMetadata) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ParamMetadata) GetMin() string {
+	if m != nil {
+		return m.Min
+	}
+	return ""
+}
+
+func (m *ParamMetadata) GetMax() string {
+	if m != nil {
+		return m.Max
+	}
+	return ""
+}
+
+func (m *ParamMetadata) GetMaxExclusive() bool {
+	if m != nil {
+		return m.MaxExclusive
+	}
+	return false
+}
+
+func (m *ParamMetadata) GetAllowedValues() []string {
+	if m != nil {
+		return m.AllowedValues
+	}
+	return nil
+}
+
+func (m *ParamMetadata) GetConstraints() string {
+	if m != nil {
+		return m.Constraints
+	}
+	return ""
+}
+
 // QueryContractMetadataRequest is the request for Query.ContractMetadata.
 type QueryContractMetadataRequest struct {
 	// contract_address is the contract address (bech32 encoded).
@@ -124,7 +321,7 @@ func (m *QueryContractMetadataRequest) Reset()         { *m = QueryContractMetad
 func (m *QueryContractMetadataRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryContractMetadataRequest) ProtoMessage()    {}
 func (*QueryContractMetadataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5094c979ac5beea0, []int{2}
+	return fileDescriptor_5094c979ac5beea0, []int{5}
 }
 func (m *QueryContractMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -169,7 +366,7 @@ func (m *QueryContractMetadataResponse) Reset()         { *m = QueryContractMeta
 func (m *QueryContractMetadataResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryContractMetadataResponse) ProtoMessage()    {}
 func (*QueryContractMetadataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5094c979ac5beea0, []int{3}
+	return fileDescriptor_5094c979ac5beea0, []int{6}
 }
 func (m *QueryContractMetadataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -214,7 +411,7 @@ func (m *QueryBlockRewardsTrackingRequest) Reset()         { *m = QueryBlockRewa
 func (m *QueryBlockRewardsTrackingRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBlockRewardsTrackingRequest) ProtoMessage()    {}
 func (*QueryBlockRewardsTrackingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5094c979ac5beea0, []int{4}
+	return fileDescriptor_5094c979ac5beea0, []int{7}
 }
 func (m *QueryBlockRewardsTrackingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -253,7 +450,7 @@ func (m *QueryBlockRewardsTrackingResponse) Reset()         { *m = QueryBlockRew
 func (m *QueryBlockRewardsTrackingResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBlockRewardsTrackingResponse) ProtoMessage()    {}
 func (*QueryBlockRewardsTrackingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5094c979ac5beea0, []int{5}
+	return fileDescriptor_5094c979ac5beea0, []int{8}
 }
 func (m *QueryBlockRewardsTrackingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -289,6 +486,112 @@ func (m *QueryBlockRewardsTrackingResponse) GetBlock() BlockTracking {
 	return BlockTracking{}
 }
 
+// QueryBlockRewardsTrackingRangeRequest is the request for
+// Query.BlockRewardsTrackingRange.
+type QueryBlockRewardsTrackingRangeRequest struct {
+	// from_height defines the first block height of the range (inclusive).
+	FromHeight int64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// to_height defines the last block height of the range (inclusive).
+	ToHeight int64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+}
+
+func (m *QueryBlockRewardsTrackingRangeRequest) Reset()         { *m = QueryBlockRewardsTrackingRangeRequest{} }
+func (m *QueryBlockRewardsTrackingRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockRewardsTrackingRangeRequest) ProtoMessage()    {}
+func (*QueryBlockRewardsTrackingRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{9}
+}
+func (m *QueryBlockRewardsTrackingRangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockRewardsTrackingRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockRewardsTrackingRangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockRewardsTrackingRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockRewardsTrackingRangeRequest.Merge(m, src)
+}
+func (m *QueryBlockRewardsTrackingRangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockRewardsTrackingRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockRewardsTrackingRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockRewardsTrackingRangeRequest proto.InternalMessageInfo
+
+func (m *QueryBlockRewardsTrackingRangeRequest) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *QueryBlockRewardsTrackingRangeRequest) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+// QueryBlockRewardsTrackingRangeResponse is the response for
+// Query.BlockRewardsTrackingRange.
+type QueryBlockRewardsTrackingRangeResponse struct {
+	// blocks defines the tracking information for the blocks found within the
+	// range (ordered by height).
+	Blocks []BlockTracking `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks"`
+}
+
+func (m *QueryBlockRewardsTrackingRangeResponse) Reset() {
+	*m = QueryBlockRewardsTrackingRangeResponse{}
+}
+func (m *QueryBlockRewardsTrackingRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockRewardsTrackingRangeResponse) ProtoMessage()    {}
+func (*QueryBlockRewardsTrackingRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{10}
+}
+func (m *QueryBlockRewardsTrackingRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockRewardsTrackingRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockRewardsTrackingRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockRewardsTrackingRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockRewardsTrackingRangeResponse.Merge(m, src)
+}
+func (m *QueryBlockRewardsTrackingRangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockRewardsTrackingRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockRewardsTrackingRangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockRewardsTrackingRangeResponse proto.InternalMessageInfo
+
+func (m *QueryBlockRewardsTrackingRangeResponse) GetBlocks() []BlockTracking {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
 // QueryRewardsPoolRequest is the request for Query.RewardsPool.
 type QueryRewardsPoolRequest struct {
 }
@@ -297,7 +600,7 @@ func (m *QueryRewardsPoolRequest) Reset()         { *m = QueryRewardsPoolRequest
 func (m *QueryRewardsPoolRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRewardsPoolRequest) ProtoMessage()    {}
 func (*QueryRewardsPoolRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5094c979ac5beea0, []int{6}
+	return fileDescriptor_5094c979ac5beea0, []int{11}
 }
 func (m *QueryRewardsPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -340,7 +643,7 @@ func (m *QueryRewardsPoolResponse) Reset()         { *m = QueryRewardsPoolRespon
 func (m *QueryRewardsPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRewardsPoolResponse) ProtoMessage()    {}
 func (*QueryRewardsPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5094c979ac5beea0, []int{7}
+	return fileDescriptor_5094c979ac5beea0, []int{12}
 }
 func (m *QueryRewardsPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -395,7 +698,7 @@ func (m *QueryEstimateTxFeesRequest) Reset()         { *m = QueryEstimateTxFeesR
 func (m *QueryEstimateTxFeesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryEstimateTxFeesRequest) ProtoMessage()    {}
 func (*QueryEstimateTxFeesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5094c979ac5beea0, []int{8}
+	return fileDescriptor_5094c979ac5beea0, []int{13}
 }
 func (m *QueryEstimateTxFeesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -450,7 +753,7 @@ func (m *QueryEstimateTxFeesResponse) Reset()         { *m = QueryEstimateTxFees
 func (m *QueryEstimateTxFeesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryEstimateTxFeesResponse) ProtoMessage()    {}
 func (*QueryEstimateTxFeesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5094c979ac5beea0, []int{9}
+	return fileDescriptor_5094c979ac5beea0, []int{14}
 }
 func (m *QueryEstimateTxFeesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -493,26 +796,34 @@ func (m *QueryEstimateTxFeesResponse) GetEstimatedFee() []types.Coin {
 	return nil
 }
 
-// BlockTracking is the tracking information for a block.
-type BlockTracking struct {
-	// inflation_rewards defines the inflation rewards for the block.
-	InflationRewards BlockRewards `protobuf:"bytes,1,opt,name=inflation_rewards,json=inflationRewards,proto3" json:"inflation_rewards"`
-	// tx_rewards defines the transaction rewards for the block.
-	TxRewards []TxRewards `protobuf:"bytes,2,rep,name=tx_rewards,json=txRewards,proto3" json:"tx_rewards"`
+// QueryEstimateTxFeesForContractsRequest is the request for
+// Query.EstimateTxFeesForContracts.
+type QueryEstimateTxFeesForContractsRequest struct {
+	// gas_limit is the transaction gas limit.
+	GasLimit uint64 `protobuf:"varint,1,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// contract_addresses whose flat fees are considered when estimating tx fees
+	// (a flat fee is charged per contract execution, so duplicates are counted
+	// every time).
+	ContractAddresses []string `protobuf:"bytes,2,rep,name=contract_addresses,json=contractAddresses,proto3" json:"contract_addresses,omitempty"`
+	// tx_size is the encoded transaction size in bytes the tx size surcharge is
+	// estimated for (optional).
+	TxSize uint64 `protobuf:"varint,3,opt,name=tx_size,json=txSize,proto3" json:"tx_size,omitempty"`
 }
 
-func (m *BlockTracking) Reset()         { *m = BlockTracking{} }
-func (m *BlockTracking) String() string { return proto.CompactTextString(m) }
-func (*BlockTracking) ProtoMessage()    {}
-func (*BlockTracking) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5094c979ac5beea0, []int{10}
+func (m *QueryEstimateTxFeesForContractsRequest) Reset() {
+	*m = QueryEstimateTxFeesForContractsRequest{}
 }
-func (m *BlockTracking) XXX_Unmarshal(b []byte) error {
+func (m *QueryEstimateTxFeesForContractsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateTxFeesForContractsRequest) ProtoMessage()    {}
+func (*QueryEstimateTxFeesForContractsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{15}
+}
+func (m *QueryEstimateTxFeesForContractsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BlockTracking) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEstimateTxFeesForContractsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BlockTracking.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEstimateTxFeesForContractsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -522,53 +833,70 @@ func (m *BlockTracking) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *BlockTracking) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BlockTracking.Merge(m, src)
+func (m *QueryEstimateTxFeesForContractsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateTxFeesForContractsRequest.Merge(m, src)
 }
-func (m *BlockTracking) XXX_Size() int {
+func (m *QueryEstimateTxFeesForContractsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *BlockTracking) XXX_DiscardUnknown() {
-	xxx_messageInfo_BlockTracking.DiscardUnknown(m)
+func (m *QueryEstimateTxFeesForContractsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateTxFeesForContractsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BlockTracking proto.InternalMessageInfo
+var xxx_messageInfo_QueryEstimateTxFeesForContractsRequest proto.InternalMessageInfo
 
-func (m *BlockTracking) GetInflationRewards() BlockRewards {
+func (m *QueryEstimateTxFeesForContractsRequest) GetGasLimit() uint64 {
 	if m != nil {
-		return m.InflationRewards
+		return m.GasLimit
 	}
-	return BlockRewards{}
+	return 0
 }
 
-func (m *BlockTracking) GetTxRewards() []TxRewards {
+func (m *QueryEstimateTxFeesForContractsRequest) GetContractAddresses() []string {
 	if m != nil {
-		return m.TxRewards
+		return m.ContractAddresses
 	}
 	return nil
 }
 
-// QueryRewardsRecordsRequest is the request for Query.RewardsRecords.
-type QueryRewardsRecordsRequest struct {
-	// rewards_address is the target address to query records for (bech32
-	// encoded).
-	RewardsAddress string `protobuf:"bytes,1,opt,name=rewards_address,json=rewardsAddress,proto3" json:"rewards_address,omitempty"`
-	// pagination is an optional pagination options for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryEstimateTxFeesForContractsRequest) GetTxSize() uint64 {
+	if m != nil {
+		return m.TxSize
+	}
+	return 0
 }
 
-func (m *QueryRewardsRecordsRequest) Reset()         { *m = QueryRewardsRecordsRequest{} }
-func (m *QueryRewardsRecordsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryRewardsRecordsRequest) ProtoMessage()    {}
-func (*QueryRewardsRecordsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5094c979ac5beea0, []int{11}
+// QueryEstimateTxFeesForContractsResponse is the response for
+// Query.EstimateTxFeesForContracts.
+type QueryEstimateTxFeesForContractsResponse struct {
+	// gas_unit_price defines the minimum transaction fee per gas unit.
+	GasUnitPrice types.DecCoin `protobuf:"bytes,1,opt,name=gas_unit_price,json=gasUnitPrice,proto3" json:"gas_unit_price"`
+	// estimated_fee is the estimated transaction fee for a given gas limit
+	// including the contract flat fees.
+	EstimatedFee []types.Coin `protobuf:"bytes,2,rep,name=estimated_fee,json=estimatedFee,proto3" json:"estimated_fee"`
+	// flat_fees is the combined flat fee of the given contracts.
+	FlatFees []types.Coin `protobuf:"bytes,3,rep,name=flat_fees,json=flatFees,proto3" json:"flat_fees"`
+	// gas_fees is the gas limit fee (the min fee floor if the min fee is zero
+	// otherwise).
+	GasFees []types.Coin `protobuf:"bytes,4,rep,name=gas_fees,json=gasFees,proto3" json:"gas_fees"`
+	// tx_size_fees is the tx size surcharge for the given tx size.
+	TxSizeFees []types.Coin `protobuf:"bytes,5,rep,name=tx_size_fees,json=txSizeFees,proto3" json:"tx_size_fees"`
 }
-func (m *QueryRewardsRecordsRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryEstimateTxFeesForContractsResponse) Reset() {
+	*m = QueryEstimateTxFeesForContractsResponse{}
+}
+func (m *QueryEstimateTxFeesForContractsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateTxFeesForContractsResponse) ProtoMessage()    {}
+func (*QueryEstimateTxFeesForContractsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{16}
+}
+func (m *QueryEstimateTxFeesForContractsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryRewardsRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEstimateTxFeesForContractsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryRewardsRecordsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEstimateTxFeesForContractsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -578,52 +906,73 @@ func (m *QueryRewardsRecordsRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryRewardsRecordsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryRewardsRecordsRequest.Merge(m, src)
+func (m *QueryEstimateTxFeesForContractsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateTxFeesForContractsResponse.Merge(m, src)
 }
-func (m *QueryRewardsRecordsRequest) XXX_Size() int {
+func (m *QueryEstimateTxFeesForContractsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryRewardsRecordsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryRewardsRecordsRequest.DiscardUnknown(m)
+func (m *QueryEstimateTxFeesForContractsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateTxFeesForContractsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryRewardsRecordsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryEstimateTxFeesForContractsResponse proto.InternalMessageInfo
 
-func (m *QueryRewardsRecordsRequest) GetRewardsAddress() string {
+func (m *QueryEstimateTxFeesForContractsResponse) GetGasUnitPrice() types.DecCoin {
 	if m != nil {
-		return m.RewardsAddress
+		return m.GasUnitPrice
 	}
-	return ""
+	return types.DecCoin{}
 }
 
-func (m *QueryRewardsRecordsRequest) GetPagination() *query.PageRequest {
+func (m *QueryEstimateTxFeesForContractsResponse) GetEstimatedFee() []types.Coin {
 	if m != nil {
-		return m.Pagination
+		return m.EstimatedFee
 	}
 	return nil
 }
 
-// QueryRewardsRecordsResponse is the response for Query.RewardsRecords.
-type QueryRewardsRecordsResponse struct {
-	// records is the list of rewards records.
-	Records []RewardsRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
-	// pagination is the pagination details in the response.
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryEstimateTxFeesForContractsResponse) GetFlatFees() []types.Coin {
+	if m != nil {
+		return m.FlatFees
+	}
+	return nil
 }
 
-func (m *QueryRewardsRecordsResponse) Reset()         { *m = QueryRewardsRecordsResponse{} }
-func (m *QueryRewardsRecordsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryRewardsRecordsResponse) ProtoMessage()    {}
-func (*QueryRewardsRecordsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5094c979ac5beea0, []int{12}
+func (m *QueryEstimateTxFeesForContractsResponse) GetGasFees() []types.Coin {
+	if m != nil {
+		return m.GasFees
+	}
+	return nil
 }
-func (m *QueryRewardsRecordsResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryEstimateTxFeesForContractsResponse) GetTxSizeFees() []types.Coin {
+	if m != nil {
+		return m.TxSizeFees
+	}
+	return nil
+}
+
+// QueryFlatFeeBreakEvenRequest is the request for Query.FlatFeeBreakEven.
+type QueryFlatFeeBreakEvenRequest struct {
+	// contract_address is the contract address (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// gas_limit is the transaction gas limit.
+	GasLimit uint64 `protobuf:"varint,2,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *QueryFlatFeeBreakEvenRequest) Reset()         { *m = QueryFlatFeeBreakEvenRequest{} }
+func (m *QueryFlatFeeBreakEvenRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFlatFeeBreakEvenRequest) ProtoMessage()    {}
+func (*QueryFlatFeeBreakEvenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{17}
+}
+func (m *QueryFlatFeeBreakEvenRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryRewardsRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryFlatFeeBreakEvenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryRewardsRecordsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryFlatFeeBreakEvenRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -633,51 +982,63 @@ func (m *QueryRewardsRecordsResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryRewardsRecordsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryRewardsRecordsResponse.Merge(m, src)
+func (m *QueryFlatFeeBreakEvenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFlatFeeBreakEvenRequest.Merge(m, src)
 }
-func (m *QueryRewardsRecordsResponse) XXX_Size() int {
+func (m *QueryFlatFeeBreakEvenRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryRewardsRecordsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryRewardsRecordsResponse.DiscardUnknown(m)
+func (m *QueryFlatFeeBreakEvenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFlatFeeBreakEvenRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryRewardsRecordsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryFlatFeeBreakEvenRequest proto.InternalMessageInfo
 
-func (m *QueryRewardsRecordsResponse) GetRecords() []RewardsRecord {
+func (m *QueryFlatFeeBreakEvenRequest) GetContractAddress() string {
 	if m != nil {
-		return m.Records
+		return m.ContractAddress
 	}
-	return nil
+	return ""
 }
 
-func (m *QueryRewardsRecordsResponse) GetPagination() *query.PageResponse {
+func (m *QueryFlatFeeBreakEvenRequest) GetGasLimit() uint64 {
 	if m != nil {
-		return m.Pagination
+		return m.GasLimit
 	}
-	return nil
+	return 0
 }
 
-// QueryOutstandingRewardsRequest is the request for Query.OutstandingRewards.
-type QueryOutstandingRewardsRequest struct {
-	// rewards_address is the target address to query calculated rewards for
-	// (bech32 encoded).
-	RewardsAddress string `protobuf:"bytes,1,opt,name=rewards_address,json=rewardsAddress,proto3" json:"rewards_address,omitempty"`
+// QueryFlatFeeBreakEvenResponse is the response for Query.FlatFeeBreakEven.
+type QueryFlatFeeBreakEvenResponse struct {
+	// gas_unit_price defines the minimum transaction fee per gas unit.
+	GasUnitPrice types.DecCoin `protobuf:"bytes,1,opt,name=gas_unit_price,json=gasUnitPrice,proto3" json:"gas_unit_price"`
+	// estimated_fee is the estimated transaction fee for a given gas limit
+	// including the contract flat fee.
+	EstimatedFee []types.Coin `protobuf:"bytes,2,rep,name=estimated_fee,json=estimatedFee,proto3" json:"estimated_fee"`
+	// flat_fee is the contract flat fee (not set if the contract has no flat
+	// fee).
+	FlatFee types.Coin `protobuf:"bytes,3,opt,name=flat_fee,json=flatFee,proto3" json:"flat_fee"`
+	// flat_fee_share is the share of the estimated fee attributable to the flat
+	// fee in the [0.0, 1.0] range (estimated fee in the flat fee denom only).
+	FlatFeeShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=flat_fee_share,json=flatFeeShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"flat_fee_share"`
+	// break_even_gas_limit is the gas limit the gas fees match the flat fee at
+	// (zero if the flat fee is not set or the flat fee denom differs from the
+	// gas unit price one).
+	BreakEvenGasLimit uint64 `protobuf:"varint,5,opt,name=break_even_gas_limit,json=breakEvenGasLimit,proto3" json:"break_even_gas_limit,omitempty"`
 }
 
-func (m *QueryOutstandingRewardsRequest) Reset()         { *m = QueryOutstandingRewardsRequest{} }
-func (m *QueryOutstandingRewardsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryOutstandingRewardsRequest) ProtoMessage()    {}
-func (*QueryOutstandingRewardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5094c979ac5beea0, []int{13}
+func (m *QueryFlatFeeBreakEvenResponse) Reset()         { *m = QueryFlatFeeBreakEvenResponse{} }
+func (m *QueryFlatFeeBreakEvenResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFlatFeeBreakEvenResponse) ProtoMessage()    {}
+func (*QueryFlatFeeBreakEvenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{18}
 }
-func (m *QueryOutstandingRewardsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryFlatFeeBreakEvenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryOutstandingRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryFlatFeeBreakEvenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryOutstandingRewardsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryFlatFeeBreakEvenResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -687,46 +1048,69 @@ func (m *QueryOutstandingRewardsRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryOutstandingRewardsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryOutstandingRewardsRequest.Merge(m, src)
+func (m *QueryFlatFeeBreakEvenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFlatFeeBreakEvenResponse.Merge(m, src)
 }
-func (m *QueryOutstandingRewardsRequest) XXX_Size() int {
+func (m *QueryFlatFeeBreakEvenResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryOutstandingRewardsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryOutstandingRewardsRequest.DiscardUnknown(m)
+func (m *QueryFlatFeeBreakEvenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFlatFeeBreakEvenResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryOutstandingRewardsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryFlatFeeBreakEvenResponse proto.InternalMessageInfo
 
-func (m *QueryOutstandingRewardsRequest) GetRewardsAddress() string {
+func (m *QueryFlatFeeBreakEvenResponse) GetGasUnitPrice() types.DecCoin {
 	if m != nil {
-		return m.RewardsAddress
+		return m.GasUnitPrice
 	}
-	return ""
+	return types.DecCoin{}
 }
 
-// QueryOutstandingRewardsResponse is the response for Query.OutstandingRewards.
-type QueryOutstandingRewardsResponse struct {
-	// total_rewards is the total rewards credited to the rewards_address.
-	TotalRewards []types.Coin `protobuf:"bytes,1,rep,name=total_rewards,json=totalRewards,proto3" json:"total_rewards"`
-	// records_num is the total number of RewardsRecord objects stored for the
-	// rewards_address.
-	RecordsNum uint64 `protobuf:"varint,2,opt,name=records_num,json=recordsNum,proto3" json:"records_num,omitempty"`
+func (m *QueryFlatFeeBreakEvenResponse) GetEstimatedFee() []types.Coin {
+	if m != nil {
+		return m.EstimatedFee
+	}
+	return nil
 }
 
-func (m *QueryOutstandingRewardsResponse) Reset()         { *m = QueryOutstandingRewardsResponse{} }
-func (m *QueryOutstandingRewardsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryOutstandingRewardsResponse) ProtoMessage()    {}
-func (*QueryOutstandingRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5094c979ac5beea0, []int{14}
+func (m *QueryFlatFeeBreakEvenResponse) GetFlatFee() types.Coin {
+	if m != nil {
+		return m.FlatFee
+	}
+	return types.Coin{}
 }
-func (m *QueryOutstandingRewardsResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryFlatFeeBreakEvenResponse) GetBreakEvenGasLimit() uint64 {
+	if m != nil {
+		return m.BreakEvenGasLimit
+	}
+	return 0
+}
+
+// QueryWouldAcceptFeeRequest is the request for Query.WouldAcceptFee.
+type QueryWouldAcceptFeeRequest struct {
+	// gas_limit is the transaction gas limit.
+	GasLimit uint64 `protobuf:"varint,1,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// fee is the transaction fee to check.
+	Fee []types.Coin `protobuf:"bytes,2,rep,name=fee,proto3" json:"fee"`
+	// contract_addresses whose flat fees are expected to be paid (a flat fee is
+	// charged per contract execution, so duplicates are counted every time).
+	ContractAddresses []string `protobuf:"bytes,3,rep,name=contract_addresses,json=contractAddresses,proto3" json:"contract_addresses,omitempty"`
+}
+
+func (m *QueryWouldAcceptFeeRequest) Reset()         { *m = QueryWouldAcceptFeeRequest{} }
+func (m *QueryWouldAcceptFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWouldAcceptFeeRequest) ProtoMessage()    {}
+func (*QueryWouldAcceptFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{19}
+}
+func (m *QueryWouldAcceptFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryOutstandingRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryWouldAcceptFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryOutstandingRewardsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryWouldAcceptFeeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -736,50 +1120,59 @@ func (m *QueryOutstandingRewardsResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryOutstandingRewardsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryOutstandingRewardsResponse.Merge(m, src)
+func (m *QueryWouldAcceptFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWouldAcceptFeeRequest.Merge(m, src)
 }
-func (m *QueryOutstandingRewardsResponse) XXX_Size() int {
+func (m *QueryWouldAcceptFeeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryOutstandingRewardsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryOutstandingRewardsResponse.DiscardUnknown(m)
+func (m *QueryWouldAcceptFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWouldAcceptFeeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryOutstandingRewardsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryWouldAcceptFeeRequest proto.InternalMessageInfo
 
-func (m *QueryOutstandingRewardsResponse) GetTotalRewards() []types.Coin {
+func (m *QueryWouldAcceptFeeRequest) GetGasLimit() uint64 {
 	if m != nil {
-		return m.TotalRewards
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *QueryWouldAcceptFeeRequest) GetFee() []types.Coin {
+	if m != nil {
+		return m.Fee
 	}
 	return nil
 }
 
-func (m *QueryOutstandingRewardsResponse) GetRecordsNum() uint64 {
+func (m *QueryWouldAcceptFeeRequest) GetContractAddresses() []string {
 	if m != nil {
-		return m.RecordsNum
+		return m.ContractAddresses
 	}
-	return 0
+	return nil
 }
 
-// QueryFlatFeeRequest is the request for Query.FlatFeet
-type QueryFlatFeeRequest struct {
-	// contract_address is the contract address (bech32 encoded).
-	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+// QueryWouldAcceptFeeResponse is the response for Query.WouldAcceptFee.
+type QueryWouldAcceptFeeResponse struct {
+	// accepted defines whether the fee covers the minimum fee.
+	Accepted bool `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	// shortfall is the minimum fee amount (per denom) not covered by the fee.
+	Shortfall []types.Coin `protobuf:"bytes,2,rep,name=shortfall,proto3" json:"shortfall"`
 }
 
-func (m *QueryFlatFeeRequest) Reset()         { *m = QueryFlatFeeRequest{} }
-func (m *QueryFlatFeeRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryFlatFeeRequest) ProtoMessage()    {}
-func (*QueryFlatFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5094c979ac5beea0, []int{15}
+func (m *QueryWouldAcceptFeeResponse) Reset()         { *m = QueryWouldAcceptFeeResponse{} }
+func (m *QueryWouldAcceptFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWouldAcceptFeeResponse) ProtoMessage()    {}
+func (*QueryWouldAcceptFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{20}
 }
-func (m *QueryFlatFeeRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryWouldAcceptFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryFlatFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryWouldAcceptFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryFlatFeeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryWouldAcceptFeeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -789,44 +1182,55 @@ func (m *QueryFlatFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryFlatFeeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryFlatFeeRequest.Merge(m, src)
+func (m *QueryWouldAcceptFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWouldAcceptFeeResponse.Merge(m, src)
 }
-func (m *QueryFlatFeeRequest) XXX_Size() int {
+func (m *QueryWouldAcceptFeeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryFlatFeeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryFlatFeeRequest.DiscardUnknown(m)
+func (m *QueryWouldAcceptFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWouldAcceptFeeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryFlatFeeRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryWouldAcceptFeeResponse proto.InternalMessageInfo
 
-func (m *QueryFlatFeeRequest) GetContractAddress() string {
+func (m *QueryWouldAcceptFeeResponse) GetAccepted() bool {
 	if m != nil {
-		return m.ContractAddress
+		return m.Accepted
 	}
-	return ""
+	return false
 }
 
-// QueryFlatFeeResponse is the response for Query.FlatFee
-type QueryFlatFeeResponse struct {
-	// flat_fee_amount defines the minimum flat fee set by the contract_owner per
-	// contract execution.
-	FlatFeeAmount types.Coin `protobuf:"bytes,1,opt,name=flat_fee_amount,json=flatFeeAmount,proto3" json:"flat_fee_amount"`
+func (m *QueryWouldAcceptFeeResponse) GetShortfall() []types.Coin {
+	if m != nil {
+		return m.Shortfall
+	}
+	return nil
 }
 
-func (m *QueryFlatFeeResponse) Reset()         { *m = QueryFlatFeeResponse{} }
-func (m *QueryFlatFeeResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryFlatFeeResponse) ProtoMessage()    {}
-func (*QueryFlatFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5094c979ac5beea0, []int{16}
+// QueryTxFeeSplitRequest is the request for Query.TxFeeSplit.
+type QueryTxFeeSplitRequest struct {
+	// gas_limit is the transaction gas limit.
+	GasLimit uint64 `protobuf:"varint,1,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// fee is the transaction fee to split.
+	Fee []types.Coin `protobuf:"bytes,2,rep,name=fee,proto3" json:"fee"`
+	// contract_addresses whose flat fees are expected to be paid (a flat fee is
+	// charged per contract execution, so duplicates are counted every time).
+	ContractAddresses []string `protobuf:"bytes,3,rep,name=contract_addresses,json=contractAddresses,proto3" json:"contract_addresses,omitempty"`
 }
-func (m *QueryFlatFeeResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryTxFeeSplitRequest) Reset()         { *m = QueryTxFeeSplitRequest{} }
+func (m *QueryTxFeeSplitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTxFeeSplitRequest) ProtoMessage()    {}
+func (*QueryTxFeeSplitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{21}
+}
+func (m *QueryTxFeeSplitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryFlatFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTxFeeSplitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryFlatFeeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTxFeeSplitRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -836,1335 +1240,17571 @@ func (m *QueryFlatFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *QueryFlatFeeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryFlatFeeResponse.Merge(m, src)
+func (m *QueryTxFeeSplitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxFeeSplitRequest.Merge(m, src)
 }
-func (m *QueryFlatFeeResponse) XXX_Size() int {
+func (m *QueryTxFeeSplitRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryFlatFeeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryFlatFeeResponse.DiscardUnknown(m)
+func (m *QueryTxFeeSplitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxFeeSplitRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryFlatFeeResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryTxFeeSplitRequest proto.InternalMessageInfo
 
-func (m *QueryFlatFeeResponse) GetFlatFeeAmount() types.Coin {
+func (m *QueryTxFeeSplitRequest) GetGasLimit() uint64 {
 	if m != nil {
-		return m.FlatFeeAmount
+		return m.GasLimit
 	}
-	return types.Coin{}
-}
-
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "archway.rewards.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "archway.rewards.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryContractMetadataRequest)(nil), "archway.rewards.v1.QueryContractMetadataRequest")
-	proto.RegisterType((*QueryContractMetadataResponse)(nil), "archway.rewards.v1.QueryContractMetadataResponse")
-	proto.RegisterType((*QueryBlockRewardsTrackingRequest)(nil), "archway.rewards.v1.QueryBlockRewardsTrackingRequest")
-	proto.RegisterType((*QueryBlockRewardsTrackingResponse)(nil), "archway.rewards.v1.QueryBlockRewardsTrackingResponse")
-	proto.RegisterType((*QueryRewardsPoolRequest)(nil), "archway.rewards.v1.QueryRewardsPoolRequest")
-	proto.RegisterType((*QueryRewardsPoolResponse)(nil), "archway.rewards.v1.QueryRewardsPoolResponse")
-	proto.RegisterType((*QueryEstimateTxFeesRequest)(nil), "archway.rewards.v1.QueryEstimateTxFeesRequest")
-	proto.RegisterType((*QueryEstimateTxFeesResponse)(nil), "archway.rewards.v1.QueryEstimateTxFeesResponse")
-	proto.RegisterType((*BlockTracking)(nil), "archway.rewards.v1.BlockTracking")
-	proto.RegisterType((*QueryRewardsRecordsRequest)(nil), "archway.rewards.v1.QueryRewardsRecordsRequest")
-	proto.RegisterType((*QueryRewardsRecordsResponse)(nil), "archway.rewards.v1.QueryRewardsRecordsResponse")
-	proto.RegisterType((*QueryOutstandingRewardsRequest)(nil), "archway.rewards.v1.QueryOutstandingRewardsRequest")
-	proto.RegisterType((*QueryOutstandingRewardsResponse)(nil), "archway.rewards.v1.QueryOutstandingRewardsResponse")
-	proto.RegisterType((*QueryFlatFeeRequest)(nil), "archway.rewards.v1.QueryFlatFeeRequest")
-	proto.RegisterType((*QueryFlatFeeResponse)(nil), "archway.rewards.v1.QueryFlatFeeResponse")
+	return 0
 }
 
-func init() { proto.RegisterFile("archway/rewards/v1/query.proto", fileDescriptor_5094c979ac5beea0) }
-
-var fileDescriptor_5094c979ac5beea0 = []byte{
-	// 1094 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x97, 0xcf, 0x6f, 0xdc, 0x44,
-	0x14, 0xc7, 0xe3, 0x90, 0xa6, 0xcd, 0x4b, 0xf3, 0x83, 0x69, 0x24, 0x5a, 0x37, 0x75, 0x52, 0x93,
-	0x26, 0x69, 0x9b, 0xda, 0x64, 0x0b, 0x12, 0x17, 0x24, 0x92, 0x86, 0x2d, 0x95, 0x0a, 0x0d, 0x4b,
-	0xb8, 0x70, 0xb1, 0x66, 0xed, 0x89, 0x6b, 0x65, 0xd7, 0xb3, 0xb5, 0xc7, 0xf9, 0x71, 0xe0, 0xd2,
-	0x13, 0x17, 0x24, 0x04, 0x77, 0xb8, 0x21, 0x38, 0xc0, 0x09, 0x89, 0x7f, 0xa1, 0xc7, 0x4a, 0x5c,
-	0x38, 0x21, 0x94, 0xf0, 0x3f, 0x70, 0x45, 0x1e, 0xbf, 0xd9, 0xae, 0xb3, 0xe3, 0xcd, 0xa6, 0xa7,
-	0x64, 0xdf, 0xcc, 0x7b, 0xdf, 0xcf, 0xbc, 0x79, 0xf3, 0xde, 0x2e, 0x58, 0x34, 0xf1, 0x9f, 0x1e,
-	0xd0, 0x23, 0x37, 0x61, 0x07, 0x34, 0x09, 0x52, 0x77, 0x7f, 0xdd, 0x7d, 0x96, 0xb1, 0xe4, 0xc8,
-	0xe9, 0x24, 0x5c, 0x70, 0x42, 0x70, 0xdd, 0xc1, 0x75, 0x67, 0x7f, 0xdd, 0x9c, 0x0b, 0x79, 0xc8,
-	0xe5, 0xb2, 0x9b, 0xff, 0x57, 0xec, 0x34, 0xe7, 0x43, 0xce, 0xc3, 0x16, 0x73, 0x69, 0x27, 0x72,
-	0x69, 0x1c, 0x73, 0x41, 0x45, 0xc4, 0xe3, 0x14, 0x57, 0x2d, 0x9f, 0xa7, 0x6d, 0x9e, 0xba, 0x4d,
-	0x9a, 0x32, 0x77, 0x7f, 0xbd, 0xc9, 0x04, 0x5d, 0x77, 0x7d, 0x1e, 0xc5, 0xb8, 0x7e, 0xa7, 0x77,
-	0x5d, 0x02, 0x74, 0x77, 0x75, 0x68, 0x18, 0xc5, 0x32, 0x18, 0xee, 0x5d, 0xd4, 0x30, 0x2b, 0x3c,
-	0xb9, 0xc3, 0x9e, 0x03, 0xf2, 0x59, 0x1e, 0x63, 0x9b, 0x26, 0xb4, 0x9d, 0x36, 0xd8, 0xb3, 0x8c,
-	0xa5, 0xc2, 0x7e, 0x02, 0x57, 0x4a, 0xd6, 0xb4, 0xc3, 0xe3, 0x94, 0x91, 0xf7, 0x61, 0xbc, 0x23,
-	0x2d, 0x57, 0x8d, 0x45, 0x63, 0x75, 0xb2, 0x66, 0x3a, 0xfd, 0x67, 0x76, 0x0a, 0x9f, 0xcd, 0xb1,
-	0x17, 0x7f, 0x2f, 0x8c, 0x34, 0x70, 0xbf, 0xfd, 0x08, 0xe6, 0x65, 0xc0, 0x07, 0x3c, 0x16, 0x09,
-	0xf5, 0xc5, 0x27, 0x4c, 0xd0, 0x80, 0x0a, 0x8a, 0x82, 0xe4, 0x36, 0xcc, 0xfa, 0xb8, 0xe4, 0xd1,
-	0x20, 0x48, 0x58, 0x5a, 0x68, 0x4c, 0x34, 0x66, 0x94, 0x7d, 0xa3, 0x30, 0xdb, 0x21, 0xdc, 0xa8,
-	0x08, 0x85, 0x94, 0x75, 0xb8, 0xd4, 0x46, 0x1b, 0x72, 0x2e, 0xe9, 0x38, 0x4f, 0xfb, 0x23, 0x71,
-	0xd7, 0xd7, 0xb6, 0x61, 0x51, 0x0a, 0x6d, 0xb6, 0xb8, 0xbf, 0xd7, 0x28, 0x1c, 0x77, 0x12, 0xea,
-	0xef, 0x45, 0x71, 0xa8, 0x12, 0xd5, 0x84, 0x9b, 0x03, 0xf6, 0x20, 0xd0, 0x07, 0x70, 0xa1, 0x99,
-	0xaf, 0x23, 0xcd, 0x4d, 0x1d, 0x8d, 0x0c, 0xa0, 0x3c, 0x11, 0xa5, 0xf0, 0xb2, 0xaf, 0xc1, 0x5b,
-	0x52, 0x03, 0xc3, 0x6f, 0x73, 0xde, 0x52, 0xf2, 0xbf, 0x1b, 0x70, 0xb5, 0x7f, 0x0d, 0x65, 0xb7,
-	0xe1, 0x4a, 0x16, 0x07, 0x51, 0x2a, 0x92, 0xa8, 0x99, 0x09, 0x16, 0x78, 0xbb, 0x59, 0x1c, 0xe4,
-	0x69, 0x7d, 0x63, 0x75, 0xb2, 0x76, 0xcd, 0x29, 0xca, 0xc8, 0xc9, 0xcb, 0xc8, 0xc1, 0x02, 0x72,
-	0x1e, 0xf0, 0x28, 0x46, 0x71, 0x52, 0xf2, 0xad, 0xe7, 0xae, 0xa4, 0x0e, 0xd3, 0x22, 0x61, 0x34,
-	0xcd, 0x92, 0x23, 0x0c, 0x36, 0x3a, 0x5c, 0xb0, 0x29, 0xe5, 0x26, 0xe3, 0xd8, 0x01, 0x98, 0x92,
-	0xfa, 0xa3, 0x54, 0x44, 0x6d, 0x2a, 0xd8, 0xce, 0x61, 0x9d, 0x31, 0x55, 0x7c, 0xe4, 0x3a, 0x4c,
-	0x84, 0x34, 0xf5, 0x5a, 0x51, 0x3b, 0x12, 0x32, 0x65, 0x63, 0x8d, 0x4b, 0x21, 0x4d, 0x1f, 0xe7,
-	0x9f, 0xb5, 0x85, 0x32, 0xaa, 0x2f, 0x94, 0x5f, 0x0d, 0xb8, 0xae, 0x95, 0xc1, 0xfc, 0x7c, 0x0c,
-	0xd3, 0xb9, 0x4e, 0x16, 0x47, 0xc2, 0xeb, 0x24, 0x91, 0xcf, 0xf0, 0x7e, 0xe6, 0xb5, 0xa7, 0xd9,
-	0x62, 0x7e, 0xcf, 0x81, 0x2e, 0x87, 0x34, 0xfd, 0x22, 0x8e, 0xc4, 0x76, 0xee, 0x47, 0xb6, 0x60,
-	0x8a, 0xa1, 0x46, 0xe0, 0xed, 0x32, 0x36, 0x6c, 0x5a, 0x2e, 0x77, 0xbd, 0xea, 0x8c, 0xd9, 0x3f,
-	0x1b, 0x30, 0x55, 0x2a, 0x03, 0xf2, 0x39, 0xbc, 0x19, 0xc5, 0xbb, 0x2d, 0xf9, 0xa2, 0x3d, 0x2c,
-	0x16, 0x84, 0x5c, 0xac, 0x2c, 0x22, 0x2c, 0x05, 0x94, 0x98, 0xed, 0x06, 0x40, 0x3b, 0xd9, 0x04,
-	0x10, 0x87, 0xdd, 0x68, 0x05, 0xe9, 0x0d, 0x5d, 0xb4, 0x9d, 0xc3, 0x72, 0xa8, 0x09, 0xa1, 0x0c,
-	0xf6, 0x37, 0x06, 0xde, 0x20, 0x1a, 0x1a, 0xcc, 0xe7, 0xf2, 0x4f, 0x71, 0x83, 0x2b, 0x30, 0x83,
-	0x71, 0x4e, 0x3d, 0xe6, 0x69, 0x34, 0xe3, 0x15, 0x91, 0x3a, 0xc0, 0xab, 0x9e, 0x25, 0xef, 0x71,
-	0xb2, 0xb6, 0x5c, 0xca, 0x5a, 0xd1, 0x61, 0x55, 0xee, 0xb6, 0x69, 0xc8, 0x50, 0xa4, 0xd1, 0xe3,
-	0x69, 0xff, 0xa2, 0xae, 0xfa, 0x34, 0x0f, 0x5e, 0xf5, 0x06, 0x5c, 0x4c, 0x0a, 0x13, 0x96, 0xbf,
-	0xf6, 0x0d, 0x96, 0x9c, 0xf1, 0xd0, 0xca, 0x8f, 0x3c, 0xd4, 0xa0, 0xae, 0x9c, 0x89, 0x5a, 0xe8,
-	0x97, 0x58, 0x1f, 0x81, 0x25, 0x51, 0x9f, 0x64, 0x22, 0x15, 0x34, 0x0e, 0x64, 0xa7, 0x40, 0xe1,
-	0xf3, 0xa5, 0xcf, 0xfe, 0xda, 0x80, 0x85, 0xca, 0x58, 0x78, 0xf4, 0x2d, 0x98, 0x12, 0x5c, 0xd0,
-	0x56, 0x4f, 0xfd, 0x0c, 0x57, 0x9b, 0xd2, 0x4b, 0x15, 0xcd, 0x02, 0x4c, 0x62, 0x22, 0xbc, 0x38,
-	0x6b, 0xcb, 0xe3, 0x8f, 0x35, 0x00, 0x4d, 0x9f, 0x66, 0x6d, 0xfb, 0x43, 0x9c, 0x18, 0xf5, 0x16,
-	0x15, 0x75, 0xc6, 0x5e, 0xa3, 0xaf, 0x7b, 0x30, 0x57, 0x8e, 0x80, 0x07, 0x78, 0x08, 0x33, 0x79,
-	0x05, 0xe7, 0xef, 0xca, 0xa3, 0x6d, 0x9e, 0xc5, 0x02, 0x9f, 0xc0, 0xd9, 0x5d, 0x67, 0xb7, 0x08,
-	0xb5, 0x21, 0xbd, 0x6a, 0xff, 0x4d, 0xc0, 0x05, 0xa9, 0x40, 0xbe, 0x82, 0xf1, 0x62, 0x4a, 0x91,
-	0x65, 0x5d, 0x1d, 0xf4, 0x0f, 0x44, 0x73, 0xe5, 0xcc, 0x7d, 0x05, 0xad, 0x6d, 0x3f, 0xff, 0xf3,
-	0xdf, 0xef, 0x47, 0xe7, 0x89, 0xe9, 0x6a, 0x46, 0x6f, 0x31, 0x0c, 0xc9, 0x4f, 0x06, 0xcc, 0x9e,
-	0x9e, 0x3e, 0xe4, 0x9d, 0x4a, 0x85, 0x8a, 0x99, 0x69, 0xae, 0x9f, 0xc3, 0x03, 0xe9, 0xee, 0x49,
-	0xba, 0x15, 0x72, 0x4b, 0x47, 0xd7, 0xbd, 0x28, 0x35, 0x01, 0xc9, 0x1f, 0x06, 0xcc, 0xe9, 0x26,
-	0x1b, 0x79, 0xb7, 0x52, 0x7a, 0xc0, 0xb0, 0x34, 0xdf, 0x3b, 0xa7, 0x17, 0x42, 0xd7, 0x24, 0xf4,
-	0x1a, 0xb9, 0xa3, 0x83, 0x96, 0x23, 0x52, 0xd5, 0xb6, 0x27, 0x14, 0xe0, 0x77, 0x06, 0x4c, 0xf6,
-	0xcc, 0x44, 0x72, 0xb7, 0x52, 0xba, 0x7f, 0xaa, 0x9a, 0x6b, 0xc3, 0x6d, 0x46, 0xbc, 0x55, 0x89,
-	0x67, 0x93, 0x45, 0xb7, 0xfa, 0xcb, 0x96, 0xd7, 0xc9, 0x21, 0x7e, 0x34, 0x60, 0xba, 0x3c, 0x8b,
-	0x88, 0x53, 0x29, 0xa5, 0x9d, 0x8d, 0xa6, 0x3b, 0xf4, 0x7e, 0xa4, 0x5b, 0x93, 0x74, 0xcb, 0x64,
-	0x49, 0x47, 0xa7, 0xc6, 0x8f, 0x27, 0x0e, 0xf3, 0xe7, 0x95, 0x92, 0x1f, 0x0c, 0x98, 0x2e, 0xb7,
-	0xd0, 0x01, 0x84, 0xda, 0xde, 0x3f, 0x80, 0x50, 0xdf, 0x9b, 0xed, 0xbb, 0x92, 0xf0, 0x16, 0x79,
-	0x7b, 0x50, 0xfe, 0x54, 0x17, 0xfe, 0xcd, 0x00, 0xd2, 0xdf, 0xec, 0x48, 0xad, 0x52, 0xb4, 0xb2,
-	0xcb, 0x9a, 0xf7, 0xcf, 0xe5, 0x83, 0xb0, 0xae, 0x84, 0xbd, 0x4d, 0x56, 0x74, 0xb0, 0xfc, 0x95,
-	0x9f, 0xaa, 0x48, 0xf2, 0xdc, 0x80, 0x8b, 0xd8, 0xd1, 0x48, 0x75, 0x13, 0x29, 0x77, 0x4d, 0x73,
-	0xf5, 0xec, 0x8d, 0xc8, 0xb3, 0x24, 0x79, 0x2c, 0x32, 0xaf, 0xe3, 0x51, 0x6d, 0x73, 0xf3, 0xf1,
-	0x8b, 0x63, 0xcb, 0x78, 0x79, 0x6c, 0x19, 0xff, 0x1c, 0x5b, 0xc6, 0xb7, 0x27, 0xd6, 0xc8, 0xcb,
-	0x13, 0x6b, 0xe4, 0xaf, 0x13, 0x6b, 0xe4, 0xcb, 0x5a, 0x18, 0x89, 0xa7, 0x59, 0xd3, 0xf1, 0x79,
-	0x5b, 0x45, 0xb8, 0x17, 0x33, 0x71, 0xc0, 0x93, 0xbd, 0x6e, 0xc4, 0xc3, 0x6e, 0x4c, 0x71, 0xd4,
-	0x61, 0x69, 0x73, 0x5c, 0xfe, 0x72, 0xb8, 0xff, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x04, 0xb5,
-	0xff, 0xe4, 0x11, 0x0d, 0x00, 0x00,
+func (m *QueryTxFeeSplitRequest) GetFee() []types.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params returns module parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ContractMetadata returns the contract rewards parameters (metadata).
-	ContractMetadata(ctx context.Context, in *QueryContractMetadataRequest, opts ...grpc.CallOption) (*QueryContractMetadataResponse, error)
-	// BlockRewardsTracking returns block rewards tracking for the current block.
-	BlockRewardsTracking(ctx context.Context, in *QueryBlockRewardsTrackingRequest, opts ...grpc.CallOption) (*QueryBlockRewardsTrackingResponse, error)
-	// RewardsPool returns the current undistributed rewards pool funds.
-	RewardsPool(ctx context.Context, in *QueryRewardsPoolRequest, opts ...grpc.CallOption) (*QueryRewardsPoolResponse, error)
-	// EstimateTxFees returns the estimated transaction fees for the given
-	// transaction gas limit using the minimum consensus fee value for the current
-	// block.
-	EstimateTxFees(ctx context.Context, in *QueryEstimateTxFeesRequest, opts ...grpc.CallOption) (*QueryEstimateTxFeesResponse, error)
-	// RewardsRecords returns the paginated list of RewardsRecord objects stored
-	// for the provided rewards_address.
-	RewardsRecords(ctx context.Context, in *QueryRewardsRecordsRequest, opts ...grpc.CallOption) (*QueryRewardsRecordsResponse, error)
-	// OutstandingRewards returns total rewards credited from different contracts
-	// for the provided rewards_address.
-	OutstandingRewards(ctx context.Context, in *QueryOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryOutstandingRewardsResponse, error)
-	// FlatFee returns the flat fee set by the contract owner for the provided
-	// contract_address
-	FlatFee(ctx context.Context, in *QueryFlatFeeRequest, opts ...grpc.CallOption) (*QueryFlatFeeResponse, error)
+func (m *QueryTxFeeSplitRequest) GetContractAddresses() []string {
+	if m != nil {
+		return m.ContractAddresses
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryTxFeeSplitResponse is the response for Query.TxFeeSplit.
+type QueryTxFeeSplitResponse struct {
+	// accepted defines whether the fee covers the minimum fee.
+	Accepted bool `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	// gas_fees is the fee amount covering the minimum gas fees.
+	GasFees []types.Coin `protobuf:"bytes,2,rep,name=gas_fees,json=gasFees,proto3" json:"gas_fees"`
+	// flat_fees is the fee amount covering every contract flat fee (in the
+	// request contract_addresses order, contracts without a flat fee are
+	// skipped).
+	FlatFees []ContractFlatFeeSplit `protobuf:"bytes,3,rep,name=flat_fees,json=flatFees,proto3" json:"flat_fees"`
+	// surplus is the fee amount left once the minimum fee is covered.
+	Surplus []types.Coin `protobuf:"bytes,4,rep,name=surplus,proto3" json:"surplus"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryTxFeeSplitResponse) Reset()         { *m = QueryTxFeeSplitResponse{} }
+func (m *QueryTxFeeSplitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTxFeeSplitResponse) ProtoMessage()    {}
+func (*QueryTxFeeSplitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{22}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryTxFeeSplitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) ContractMetadata(ctx context.Context, in *QueryContractMetadataRequest, opts ...grpc.CallOption) (*QueryContractMetadataResponse, error) {
-	out := new(QueryContractMetadataResponse)
-	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/ContractMetadata", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryTxFeeSplitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxFeeSplitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryTxFeeSplitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxFeeSplitResponse.Merge(m, src)
+}
+func (m *QueryTxFeeSplitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxFeeSplitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxFeeSplitResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) BlockRewardsTracking(ctx context.Context, in *QueryBlockRewardsTrackingRequest, opts ...grpc.CallOption) (*QueryBlockRewardsTrackingResponse, error) {
-	out := new(QueryBlockRewardsTrackingResponse)
-	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/BlockRewardsTracking", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTxFeeSplitResponse proto.InternalMessageInfo
+
+func (m *QueryTxFeeSplitResponse) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) RewardsPool(ctx context.Context, in *QueryRewardsPoolRequest, opts ...grpc.CallOption) (*QueryRewardsPoolResponse, error) {
-	out := new(QueryRewardsPoolResponse)
-	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/RewardsPool", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryTxFeeSplitResponse) GetGasFees() []types.Coin {
+	if m != nil {
+		return m.GasFees
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) EstimateTxFees(ctx context.Context, in *QueryEstimateTxFeesRequest, opts ...grpc.CallOption) (*QueryEstimateTxFeesResponse, error) {
-	out := new(QueryEstimateTxFeesResponse)
-	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/EstimateTxFees", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryTxFeeSplitResponse) GetFlatFees() []ContractFlatFeeSplit {
+	if m != nil {
+		return m.FlatFees
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) RewardsRecords(ctx context.Context, in *QueryRewardsRecordsRequest, opts ...grpc.CallOption) (*QueryRewardsRecordsResponse, error) {
-	out := new(QueryRewardsRecordsResponse)
-	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/RewardsRecords", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryTxFeeSplitResponse) GetSurplus() []types.Coin {
+	if m != nil {
+		return m.Surplus
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) OutstandingRewards(ctx context.Context, in *QueryOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryOutstandingRewardsResponse, error) {
-	out := new(QueryOutstandingRewardsResponse)
-	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/OutstandingRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
+// ContractFlatFeeSplit defines the transaction fee amount covering a contract
+// flat fee.
+type ContractFlatFeeSplit struct {
+	// contract_address is the contract address (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// fee is the fee amount covering the contract flat fee.
+	Fee []types.Coin `protobuf:"bytes,2,rep,name=fee,proto3" json:"fee"`
+}
+
+func (m *ContractFlatFeeSplit) Reset()         { *m = ContractFlatFeeSplit{} }
+func (m *ContractFlatFeeSplit) String() string { return proto.CompactTextString(m) }
+func (*ContractFlatFeeSplit) ProtoMessage()    {}
+func (*ContractFlatFeeSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{23}
+}
+func (m *ContractFlatFeeSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractFlatFeeSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractFlatFeeSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ContractFlatFeeSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractFlatFeeSplit.Merge(m, src)
+}
+func (m *ContractFlatFeeSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractFlatFeeSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractFlatFeeSplit.DiscardUnknown(m)
 }
 
-func (c *queryClient) FlatFee(ctx context.Context, in *QueryFlatFeeRequest, opts ...grpc.CallOption) (*QueryFlatFeeResponse, error) {
-	out := new(QueryFlatFeeResponse)
-	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/FlatFee", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ContractFlatFeeSplit proto.InternalMessageInfo
+
+func (m *ContractFlatFeeSplit) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params returns module parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ContractMetadata returns the contract rewards parameters (metadata).
-	ContractMetadata(context.Context, *QueryContractMetadataRequest) (*QueryContractMetadataResponse, error)
-	// BlockRewardsTracking returns block rewards tracking for the current block.
-	BlockRewardsTracking(context.Context, *QueryBlockRewardsTrackingRequest) (*QueryBlockRewardsTrackingResponse, error)
-	// RewardsPool returns the current undistributed rewards pool funds.
-	RewardsPool(context.Context, *QueryRewardsPoolRequest) (*QueryRewardsPoolResponse, error)
-	// EstimateTxFees returns the estimated transaction fees for the given
-	// transaction gas limit using the minimum consensus fee value for the current
-	// block.
-	EstimateTxFees(context.Context, *QueryEstimateTxFeesRequest) (*QueryEstimateTxFeesResponse, error)
-	// RewardsRecords returns the paginated list of RewardsRecord objects stored
-	// for the provided rewards_address.
-	RewardsRecords(context.Context, *QueryRewardsRecordsRequest) (*QueryRewardsRecordsResponse, error)
-	// OutstandingRewards returns total rewards credited from different contracts
-	// for the provided rewards_address.
-	OutstandingRewards(context.Context, *QueryOutstandingRewardsRequest) (*QueryOutstandingRewardsResponse, error)
-	// FlatFee returns the flat fee set by the contract owner for the provided
-	// contract_address
-	FlatFee(context.Context, *QueryFlatFeeRequest) (*QueryFlatFeeResponse, error)
+func (m *ContractFlatFeeSplit) GetFee() []types.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// BlockTracking is the tracking information for a block.
+type BlockTracking struct {
+	// inflation_rewards defines the inflation rewards for the block.
+	InflationRewards BlockRewards `protobuf:"bytes,1,opt,name=inflation_rewards,json=inflationRewards,proto3" json:"inflation_rewards"`
+	// tx_rewards defines the transaction rewards for the block.
+	TxRewards []TxRewards `protobuf:"bytes,2,rep,name=tx_rewards,json=txRewards,proto3" json:"tx_rewards"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *BlockTracking) Reset()         { *m = BlockTracking{} }
+func (m *BlockTracking) String() string { return proto.CompactTextString(m) }
+func (*BlockTracking) ProtoMessage()    {}
+func (*BlockTracking) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{24}
 }
-func (*UnimplementedQueryServer) ContractMetadata(ctx context.Context, req *QueryContractMetadataRequest) (*QueryContractMetadataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ContractMetadata not implemented")
+func (m *BlockTracking) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) BlockRewardsTracking(ctx context.Context, req *QueryBlockRewardsTrackingRequest) (*QueryBlockRewardsTrackingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BlockRewardsTracking not implemented")
+func (m *BlockTracking) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockTracking.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) RewardsPool(ctx context.Context, req *QueryRewardsPoolRequest) (*QueryRewardsPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RewardsPool not implemented")
+func (m *BlockTracking) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockTracking.Merge(m, src)
 }
-func (*UnimplementedQueryServer) EstimateTxFees(ctx context.Context, req *QueryEstimateTxFeesRequest) (*QueryEstimateTxFeesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateTxFees not implemented")
+func (m *BlockTracking) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) RewardsRecords(ctx context.Context, req *QueryRewardsRecordsRequest) (*QueryRewardsRecordsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RewardsRecords not implemented")
+func (m *BlockTracking) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockTracking.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) OutstandingRewards(ctx context.Context, req *QueryOutstandingRewardsRequest) (*QueryOutstandingRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OutstandingRewards not implemented")
+
+var xxx_messageInfo_BlockTracking proto.InternalMessageInfo
+
+func (m *BlockTracking) GetInflationRewards() BlockRewards {
+	if m != nil {
+		return m.InflationRewards
+	}
+	return BlockRewards{}
 }
-func (*UnimplementedQueryServer) FlatFee(ctx context.Context, req *QueryFlatFeeRequest) (*QueryFlatFeeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FlatFee not implemented")
+
+func (m *BlockTracking) GetTxRewards() []TxRewards {
+	if m != nil {
+		return m.TxRewards
+	}
+	return nil
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+// QueryRewardsRecordsRequest is the request for Query.RewardsRecords.
+type QueryRewardsRecordsRequest struct {
+	// rewards_address is the target address to query records for (bech32
+	// encoded).
+	RewardsAddress string `protobuf:"bytes,1,opt,name=rewards_address,json=rewardsAddress,proto3" json:"rewards_address,omitempty"`
+	// pagination is an optional pagination options for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/archway.rewards.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryRewardsRecordsRequest) Reset()         { *m = QueryRewardsRecordsRequest{} }
+func (m *QueryRewardsRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardsRecordsRequest) ProtoMessage()    {}
+func (*QueryRewardsRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{25}
+}
+func (m *QueryRewardsRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardsRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardsRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryRewardsRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsRecordsRequest.Merge(m, src)
+}
+func (m *QueryRewardsRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardsRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsRecordsRequest.DiscardUnknown(m)
 }
 
-func _Query_ContractMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryContractMetadataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ContractMetadata(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/archway.rewards.v1.Query/ContractMetadata",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ContractMetadata(ctx, req.(*QueryContractMetadataRequest))
+var xxx_messageInfo_QueryRewardsRecordsRequest proto.InternalMessageInfo
+
+func (m *QueryRewardsRecordsRequest) GetRewardsAddress() string {
+	if m != nil {
+		return m.RewardsAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_BlockRewardsTracking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBlockRewardsTrackingRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BlockRewardsTracking(ctx, in)
+func (m *QueryRewardsRecordsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/archway.rewards.v1.Query/BlockRewardsTracking",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BlockRewardsTracking(ctx, req.(*QueryBlockRewardsTrackingRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_RewardsPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRewardsPoolRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RewardsPool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/archway.rewards.v1.Query/RewardsPool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RewardsPool(ctx, req.(*QueryRewardsPoolRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryRewardsRecordsResponse is the response for Query.RewardsRecords.
+type QueryRewardsRecordsResponse struct {
+	// records is the list of rewards records.
+	Records []RewardsRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	// pagination is the pagination details in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_EstimateTxFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEstimateTxFeesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimateTxFees(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/archway.rewards.v1.Query/EstimateTxFees",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateTxFees(ctx, req.(*QueryEstimateTxFeesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryRewardsRecordsResponse) Reset()         { *m = QueryRewardsRecordsResponse{} }
+func (m *QueryRewardsRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardsRecordsResponse) ProtoMessage()    {}
+func (*QueryRewardsRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{26}
 }
-
-func _Query_RewardsRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRewardsRecordsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RewardsRecords(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/archway.rewards.v1.Query/RewardsRecords",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RewardsRecords(ctx, req.(*QueryRewardsRecordsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryRewardsRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Query_OutstandingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOutstandingRewardsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OutstandingRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/archway.rewards.v1.Query/OutstandingRewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OutstandingRewards(ctx, req.(*QueryOutstandingRewardsRequest))
+func (m *QueryRewardsRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardsRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Query_FlatFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFlatFeeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FlatFee(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/archway.rewards.v1.Query/FlatFee",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FlatFee(ctx, req.(*QueryFlatFeeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryRewardsRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsRecordsResponse.Merge(m, src)
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "archway.rewards.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ContractMetadata",
-			Handler:    _Query_ContractMetadata_Handler,
-		},
-		{
-			MethodName: "BlockRewardsTracking",
-			Handler:    _Query_BlockRewardsTracking_Handler,
-		},
-		{
-			MethodName: "RewardsPool",
-			Handler:    _Query_RewardsPool_Handler,
-		},
-		{
-			MethodName: "EstimateTxFees",
-			Handler:    _Query_EstimateTxFees_Handler,
-		},
-		{
-			MethodName: "RewardsRecords",
-			Handler:    _Query_RewardsRecords_Handler,
-		},
-		{
-			MethodName: "OutstandingRewards",
-			Handler:    _Query_OutstandingRewards_Handler,
-		},
-		{
-			MethodName: "FlatFee",
-			Handler:    _Query_FlatFee_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "archway/rewards/v1/query.proto",
+func (m *QueryRewardsRecordsResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryRewardsRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsRecordsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_QueryRewardsRecordsResponse proto.InternalMessageInfo
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryRewardsRecordsResponse) GetRecords() []RewardsRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRewardsRecordsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryOutstandingRewardsRequest is the request for Query.OutstandingRewards.
+type QueryOutstandingRewardsRequest struct {
+	// rewards_address is the target address to query calculated rewards for
+	// (bech32 encoded).
+	RewardsAddress string `protobuf:"bytes,1,opt,name=rewards_address,json=rewardsAddress,proto3" json:"rewards_address,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryOutstandingRewardsRequest) Reset()         { *m = QueryOutstandingRewardsRequest{} }
+func (m *QueryOutstandingRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOutstandingRewardsRequest) ProtoMessage()    {}
+func (*QueryOutstandingRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{27}
+}
+func (m *QueryOutstandingRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOutstandingRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOutstandingRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryOutstandingRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOutstandingRewardsRequest.Merge(m, src)
+}
+func (m *QueryOutstandingRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOutstandingRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOutstandingRewardsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryContractMetadataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOutstandingRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryOutstandingRewardsRequest) GetRewardsAddress() string {
+	if m != nil {
+		return m.RewardsAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryContractMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryOutstandingRewardsResponse is the response for Query.OutstandingRewards.
+type QueryOutstandingRewardsResponse struct {
+	// total_rewards is the total rewards credited to the rewards_address.
+	TotalRewards []types.Coin `protobuf:"bytes,1,rep,name=total_rewards,json=totalRewards,proto3" json:"total_rewards"`
+	// records_num is the total number of RewardsRecord objects stored for the
+	// rewards_address.
+	RecordsNum uint64 `protobuf:"varint,2,opt,name=records_num,json=recordsNum,proto3" json:"records_num,omitempty"`
 }
 
-func (m *QueryContractMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryOutstandingRewardsResponse) Reset()         { *m = QueryOutstandingRewardsResponse{} }
+func (m *QueryOutstandingRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOutstandingRewardsResponse) ProtoMessage()    {}
+func (*QueryOutstandingRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{28}
+}
+func (m *QueryOutstandingRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOutstandingRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOutstandingRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryOutstandingRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOutstandingRewardsResponse.Merge(m, src)
+}
+func (m *QueryOutstandingRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOutstandingRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOutstandingRewardsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryContractMetadataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOutstandingRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryOutstandingRewardsResponse) GetTotalRewards() []types.Coin {
+	if m != nil {
+		return m.TotalRewards
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryContractMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryOutstandingRewardsResponse) GetRecordsNum() uint64 {
+	if m != nil {
+		return m.RecordsNum
+	}
+	return 0
 }
 
-func (m *QueryContractMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+// QueryFlatFeeRequest is the request for Query.FlatFeet
+type QueryFlatFeeRequest struct {
+	// contract_address is the contract address (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (m *QueryFlatFeeRequest) Reset()         { *m = QueryFlatFeeRequest{} }
+func (m *QueryFlatFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFlatFeeRequest) ProtoMessage()    {}
+func (*QueryFlatFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{29}
+}
+func (m *QueryFlatFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFlatFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFlatFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryFlatFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFlatFeeRequest.Merge(m, src)
+}
+func (m *QueryFlatFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFlatFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFlatFeeRequest.DiscardUnknown(m)
 }
 
-func (m *QueryBlockRewardsTrackingRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFlatFeeRequest proto.InternalMessageInfo
+
+func (m *QueryFlatFeeRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryBlockRewardsTrackingRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFlatFeeResponse is the response for Query.FlatFee
+type QueryFlatFeeResponse struct {
+	// flat_fee_amount defines the minimum flat fee set by the contract_owner per
+	// contract execution.
+	FlatFeeAmount types.Coin `protobuf:"bytes,1,opt,name=flat_fee_amount,json=flatFeeAmount,proto3" json:"flat_fee_amount"`
 }
 
-func (m *QueryBlockRewardsTrackingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryFlatFeeResponse) Reset()         { *m = QueryFlatFeeResponse{} }
+func (m *QueryFlatFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFlatFeeResponse) ProtoMessage()    {}
+func (*QueryFlatFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{30}
+}
+func (m *QueryFlatFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFlatFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFlatFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFlatFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFlatFeeResponse.Merge(m, src)
+}
+func (m *QueryFlatFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFlatFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFlatFeeResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBlockRewardsTrackingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFlatFeeResponse proto.InternalMessageInfo
+
+func (m *QueryFlatFeeResponse) GetFlatFeeAmount() types.Coin {
+	if m != nil {
+		return m.FlatFeeAmount
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *QueryBlockRewardsTrackingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTxFeeDistributionRequest is the request for Query.TxFeeDistribution.
+type QueryTxFeeDistributionRequest struct {
+	// height defines the block height to get the distributions for (used if
+	// tx_hash is not set).
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// tx_hash defines the hex encoded transaction hash to get the distribution
+	// for.
+	TxHash string `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
 }
 
-func (m *QueryBlockRewardsTrackingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryTxFeeDistributionRequest) Reset()         { *m = QueryTxFeeDistributionRequest{} }
+func (m *QueryTxFeeDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTxFeeDistributionRequest) ProtoMessage()    {}
+func (*QueryTxFeeDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{31}
+}
+func (m *QueryTxFeeDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxFeeDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxFeeDistributionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryTxFeeDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxFeeDistributionRequest.Merge(m, src)
+}
+func (m *QueryTxFeeDistributionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxFeeDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxFeeDistributionRequest.DiscardUnknown(m)
 }
 
-func (m *QueryRewardsPoolRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTxFeeDistributionRequest proto.InternalMessageInfo
+
+func (m *QueryTxFeeDistributionRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryRewardsPoolRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTxFeeDistributionRequest) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
 }
 
-func (m *QueryRewardsPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryTxFeeDistributionResponse is the response for Query.TxFeeDistribution.
+type QueryTxFeeDistributionResponse struct {
+	// distributions defines the transaction fee distributions found.
+	Distributions []TxFeeDistribution `protobuf:"bytes,1,rep,name=distributions,proto3" json:"distributions"`
 }
 
-func (m *QueryRewardsPoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTxFeeDistributionResponse) Reset()         { *m = QueryTxFeeDistributionResponse{} }
+func (m *QueryTxFeeDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTxFeeDistributionResponse) ProtoMessage()    {}
+func (*QueryTxFeeDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{32}
+}
+func (m *QueryTxFeeDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxFeeDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxFeeDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryRewardsPoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTxFeeDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxFeeDistributionResponse.Merge(m, src)
+}
+func (m *QueryTxFeeDistributionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxFeeDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxFeeDistributionResponse.DiscardUnknown(m)
 }
 
-func (m *QueryRewardsPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TreasuryFunds) > 0 {
-		for iNdEx := len(m.TreasuryFunds) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TreasuryFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+var xxx_messageInfo_QueryTxFeeDistributionResponse proto.InternalMessageInfo
+
+func (m *QueryTxFeeDistributionResponse) GetDistributions() []TxFeeDistribution {
+	if m != nil {
+		return m.Distributions
 	}
-	if len(m.UndistributedFunds) > 0 {
-		for iNdEx := len(m.UndistributedFunds) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UndistributedFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	return nil
+}
+
+// QueryRewardsRatiosRequest is the request for Query.RewardsRatios.
+type QueryRewardsRatiosRequest struct {
+}
+
+func (m *QueryRewardsRatiosRequest) Reset()         { *m = QueryRewardsRatiosRequest{} }
+func (m *QueryRewardsRatiosRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardsRatiosRequest) ProtoMessage()    {}
+func (*QueryRewardsRatiosRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{33}
+}
+func (m *QueryRewardsRatiosRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardsRatiosRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardsRatiosRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRewardsRatiosRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsRatiosRequest.Merge(m, src)
+}
+func (m *QueryRewardsRatiosRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardsRatiosRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsRatiosRequest.DiscardUnknown(m)
 }
 
-func (m *QueryEstimateTxFeesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRewardsRatiosRequest proto.InternalMessageInfo
+
+// QueryRewardsRatiosResponse is the response for Query.RewardsRatios.
+type QueryRewardsRatiosResponse struct {
+	// inflation_rewards_ratio defines the percentage of minted inflation tokens
+	// that are used for dApp rewards.
+	InflationRewardsRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=inflation_rewards_ratio,json=inflationRewardsRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation_rewards_ratio"`
+	// tx_fee_rebate_ratio defines the percentage of tx fees that are used for
+	// dApp rewards.
+	TxFeeRebateRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=tx_fee_rebate_ratio,json=txFeeRebateRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"tx_fee_rebate_ratio"`
+}
+
+func (m *QueryRewardsRatiosResponse) Reset()         { *m = QueryRewardsRatiosResponse{} }
+func (m *QueryRewardsRatiosResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardsRatiosResponse) ProtoMessage()    {}
+func (*QueryRewardsRatiosResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{34}
+}
+func (m *QueryRewardsRatiosResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardsRatiosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardsRatiosResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryRewardsRatiosResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsRatiosResponse.Merge(m, src)
+}
+func (m *QueryRewardsRatiosResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardsRatiosResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsRatiosResponse.DiscardUnknown(m)
 }
 
-func (m *QueryEstimateTxFeesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryRewardsRatiosResponse proto.InternalMessageInfo
+
+// QueryDistributionConfigRequest is the request for Query.DistributionConfig.
+type QueryDistributionConfigRequest struct {
 }
 
-func (m *QueryEstimateTxFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0x12
+func (m *QueryDistributionConfigRequest) Reset()         { *m = QueryDistributionConfigRequest{} }
+func (m *QueryDistributionConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributionConfigRequest) ProtoMessage()    {}
+func (*QueryDistributionConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{35}
+}
+func (m *QueryDistributionConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDistributionConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributionConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.GasLimit != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.GasLimit))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *QueryDistributionConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributionConfigRequest.Merge(m, src)
+}
+func (m *QueryDistributionConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDistributionConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributionConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDistributionConfigRequest proto.InternalMessageInfo
+
+// QueryDistributionConfigResponse is the response for Query.DistributionConfig.
+type QueryDistributionConfigResponse struct {
+	Config DistributionConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config"`
+}
+
+func (m *QueryDistributionConfigResponse) Reset()         { *m = QueryDistributionConfigResponse{} }
+func (m *QueryDistributionConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributionConfigResponse) ProtoMessage()    {}
+func (*QueryDistributionConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{36}
+}
+func (m *QueryDistributionConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDistributionConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributionConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDistributionConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributionConfigResponse.Merge(m, src)
+}
+func (m *QueryDistributionConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDistributionConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributionConfigResponse.DiscardUnknown(m)
 }
 
-func (m *QueryEstimateTxFeesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDistributionConfigResponse proto.InternalMessageInfo
+
+func (m *QueryDistributionConfigResponse) GetConfig() DistributionConfig {
+	if m != nil {
+		return m.Config
 	}
-	return dAtA[:n], nil
+	return DistributionConfig{}
 }
 
-func (m *QueryEstimateTxFeesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRewardsRecordByIDRequest is the request for Query.RewardsRecordByID.
+type QueryRewardsRecordByIDRequest struct {
+	// id is the unique ID of the record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryEstimateTxFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EstimatedFee) > 0 {
-		for iNdEx := len(m.EstimatedFee) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.EstimatedFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.GasUnitPrice.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryRewardsRecordByIDRequest) Reset()         { *m = QueryRewardsRecordByIDRequest{} }
+func (m *QueryRewardsRecordByIDRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardsRecordByIDRequest) ProtoMessage()    {}
+func (*QueryRewardsRecordByIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{37}
+}
+func (m *QueryRewardsRecordByIDRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardsRecordByIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardsRecordByIDRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryRewardsRecordByIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsRecordByIDRequest.Merge(m, src)
+}
+func (m *QueryRewardsRecordByIDRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardsRecordByIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsRecordByIDRequest.DiscardUnknown(m)
 }
 
-func (m *BlockTracking) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRewardsRecordByIDRequest proto.InternalMessageInfo
+
+func (m *QueryRewardsRecordByIDRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *BlockTracking) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRewardsRecordByIDResponse is the response for Query.RewardsRecordByID.
+type QueryRewardsRecordByIDResponse struct {
+	// record is the rewards record found.
+	Record RewardsRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record"`
 }
 
-func (m *BlockTracking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TxRewards) > 0 {
-		for iNdEx := len(m.TxRewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TxRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.InflationRewards.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryRewardsRecordByIDResponse) Reset()         { *m = QueryRewardsRecordByIDResponse{} }
+func (m *QueryRewardsRecordByIDResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardsRecordByIDResponse) ProtoMessage()    {}
+func (*QueryRewardsRecordByIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{38}
+}
+func (m *QueryRewardsRecordByIDResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardsRecordByIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardsRecordByIDResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryRewardsRecordByIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsRecordByIDResponse.Merge(m, src)
+}
+func (m *QueryRewardsRecordByIDResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardsRecordByIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsRecordByIDResponse.DiscardUnknown(m)
 }
 
-func (m *QueryRewardsRecordsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRewardsRecordByIDResponse proto.InternalMessageInfo
+
+func (m *QueryRewardsRecordByIDResponse) GetRecord() RewardsRecord {
+	if m != nil {
+		return m.Record
 	}
-	return dAtA[:n], nil
+	return RewardsRecord{}
 }
 
-func (m *QueryRewardsRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryContractMetadataCountRequest is the request for
+// Query.ContractMetadataCount.
+type QueryContractMetadataCountRequest struct {
 }
 
-func (m *QueryRewardsRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryContractMetadataCountRequest) Reset()         { *m = QueryContractMetadataCountRequest{} }
+func (m *QueryContractMetadataCountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractMetadataCountRequest) ProtoMessage()    {}
+func (*QueryContractMetadataCountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{39}
+}
+func (m *QueryContractMetadataCountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractMetadataCountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractMetadataCountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.RewardsAddress) > 0 {
-		i -= len(m.RewardsAddress)
-		copy(dAtA[i:], m.RewardsAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RewardsAddress)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryRewardsRecordsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryContractMetadataCountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractMetadataCountRequest.Merge(m, src)
+}
+func (m *QueryContractMetadataCountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractMetadataCountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractMetadataCountRequest.DiscardUnknown(m)
 }
 
-func (m *QueryRewardsRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryContractMetadataCountRequest proto.InternalMessageInfo
+
+// QueryContractMetadataCountResponse is the response for
+// Query.ContractMetadataCount.
+type QueryContractMetadataCountResponse struct {
+	// count is the number of contracts with metadata set.
+	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (m *QueryRewardsRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Records) > 0 {
-		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryContractMetadataCountResponse) Reset()         { *m = QueryContractMetadataCountResponse{} }
+func (m *QueryContractMetadataCountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractMetadataCountResponse) ProtoMessage()    {}
+func (*QueryContractMetadataCountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{40}
+}
+func (m *QueryContractMetadataCountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractMetadataCountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractMetadataCountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryOutstandingRewardsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryContractMetadataCountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractMetadataCountResponse.Merge(m, src)
 }
-
-func (m *QueryOutstandingRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryContractMetadataCountResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryOutstandingRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RewardsAddress) > 0 {
-		i -= len(m.RewardsAddress)
-		copy(dAtA[i:], m.RewardsAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RewardsAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryContractMetadataCountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractMetadataCountResponse.DiscardUnknown(m)
 }
 
-func (m *QueryOutstandingRewardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryContractMetadataCountResponse proto.InternalMessageInfo
+
+func (m *QueryContractMetadataCountResponse) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryOutstandingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryContractsByCodeIDRequest is the request for Query.ContractsByCodeID.
+type QueryContractsByCodeIDRequest struct {
+	// code_id is the contract code ID.
+	CodeId uint64 `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// pagination is an optional pagination options for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryOutstandingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RecordsNum != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.RecordsNum))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.TotalRewards) > 0 {
-		for iNdEx := len(m.TotalRewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryContractsByCodeIDRequest) Reset()         { *m = QueryContractsByCodeIDRequest{} }
+func (m *QueryContractsByCodeIDRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractsByCodeIDRequest) ProtoMessage()    {}
+func (*QueryContractsByCodeIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{41}
+}
+func (m *QueryContractsByCodeIDRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractsByCodeIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractsByCodeIDRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryFlatFeeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryContractsByCodeIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractsByCodeIDRequest.Merge(m, src)
 }
-
-func (m *QueryFlatFeeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryContractsByCodeIDRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractsByCodeIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractsByCodeIDRequest.DiscardUnknown(m)
 }
 
-func (m *QueryFlatFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryContractsByCodeIDRequest proto.InternalMessageInfo
+
+func (m *QueryContractsByCodeIDRequest) GetCodeId() uint64 {
+	if m != nil {
+		return m.CodeId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryFlatFeeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryContractsByCodeIDRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryFlatFeeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryContractsByCodeIDResponse is the response for Query.ContractsByCodeID.
+type QueryContractsByCodeIDResponse struct {
+	// contract_addresses is the list of contract addresses (bech32 encoded).
+	ContractAddresses []string `protobuf:"bytes,1,rep,name=contract_addresses,json=contractAddresses,proto3" json:"contract_addresses,omitempty"`
+	// pagination is the pagination details in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryFlatFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.FlatFeeAmount.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryContractsByCodeIDResponse) Reset()         { *m = QueryContractsByCodeIDResponse{} }
+func (m *QueryContractsByCodeIDResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractsByCodeIDResponse) ProtoMessage()    {}
+func (*QueryContractsByCodeIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{42}
+}
+func (m *QueryContractsByCodeIDResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractsByCodeIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractsByCodeIDResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryContractsByCodeIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractsByCodeIDResponse.Merge(m, src)
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryContractsByCodeIDResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractsByCodeIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractsByCodeIDResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryContractsByCodeIDResponse proto.InternalMessageInfo
+
+func (m *QueryContractsByCodeIDResponse) GetContractAddresses() []string {
+	if m != nil {
+		return m.ContractAddresses
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryContractMetadataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryContractsByCodeIDResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryContractMetadataResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Metadata.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// QueryMinConsensusFeeDebugRequest is the request for
+// Query.MinConsensusFeeDebug.
+type QueryMinConsensusFeeDebugRequest struct {
+	// height is the block height to get the inputs for (current height if not
+	// set).
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *QueryBlockRewardsTrackingRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMinConsensusFeeDebugRequest) Reset()         { *m = QueryMinConsensusFeeDebugRequest{} }
+func (m *QueryMinConsensusFeeDebugRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMinConsensusFeeDebugRequest) ProtoMessage()    {}
+func (*QueryMinConsensusFeeDebugRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{43}
+}
+func (m *QueryMinConsensusFeeDebugRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinConsensusFeeDebugRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinConsensusFeeDebugRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryMinConsensusFeeDebugRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinConsensusFeeDebugRequest.Merge(m, src)
+}
+func (m *QueryMinConsensusFeeDebugRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinConsensusFeeDebugRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinConsensusFeeDebugRequest.DiscardUnknown(m)
 }
 
-func (m *QueryBlockRewardsTrackingResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryMinConsensusFeeDebugRequest proto.InternalMessageInfo
+
+func (m *QueryMinConsensusFeeDebugRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	var l int
-	_ = l
-	l = m.Block.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryRewardsPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// QueryMinConsensusFeeDebugResponse is the response for
+// Query.MinConsensusFeeDebug.
+type QueryMinConsensusFeeDebugResponse struct {
+	// block_rewards is the tracked block inflation rewards and the block gas
+	// limit (fee calculation inputs).
+	BlockRewards BlockRewards `protobuf:"bytes,1,opt,name=block_rewards,json=blockRewards,proto3" json:"block_rewards"`
+	// tx_fee_rebate_ratio is the current TxFeeRebateRatio param value (fee
+	// calculation input).
+	TxFeeRebateRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=tx_fee_rebate_ratio,json=txFeeRebateRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"tx_fee_rebate_ratio"`
+	// computed_fee is the minimum consensus fee recomputed from the inputs (not
+	// set if the inputs are not eligible for the fee update).
+	ComputedFee types.DecCoin `protobuf:"bytes,3,opt,name=computed_fee,json=computedFee,proto3" json:"computed_fee"`
+	// stored_fee is the stored minimum consensus fee for the inflation rewards
+	// denom.
+	StoredFee types.DecCoin `protobuf:"bytes,4,opt,name=stored_fee,json=storedFee,proto3" json:"stored_fee"`
 }
 
-func (m *QueryRewardsPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.UndistributedFunds) > 0 {
-		for _, e := range m.UndistributedFunds {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.TreasuryFunds) > 0 {
-		for _, e := range m.TreasuryFunds {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryMinConsensusFeeDebugResponse) Reset()         { *m = QueryMinConsensusFeeDebugResponse{} }
+func (m *QueryMinConsensusFeeDebugResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMinConsensusFeeDebugResponse) ProtoMessage()    {}
+func (*QueryMinConsensusFeeDebugResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{44}
+}
+func (m *QueryMinConsensusFeeDebugResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinConsensusFeeDebugResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinConsensusFeeDebugResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryMinConsensusFeeDebugResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinConsensusFeeDebugResponse.Merge(m, src)
+}
+func (m *QueryMinConsensusFeeDebugResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinConsensusFeeDebugResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinConsensusFeeDebugResponse.DiscardUnknown(m)
 }
 
-func (m *QueryEstimateTxFeesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GasLimit != 0 {
-		n += 1 + sovQuery(uint64(m.GasLimit))
-	}
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryMinConsensusFeeDebugResponse proto.InternalMessageInfo
+
+func (m *QueryMinConsensusFeeDebugResponse) GetBlockRewards() BlockRewards {
+	if m != nil {
+		return m.BlockRewards
 	}
-	return n
+	return BlockRewards{}
 }
 
-func (m *QueryEstimateTxFeesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.GasUnitPrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if len(m.EstimatedFee) > 0 {
-		for _, e := range m.EstimatedFee {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *QueryMinConsensusFeeDebugResponse) GetComputedFee() types.DecCoin {
+	if m != nil {
+		return m.ComputedFee
 	}
-	return n
+	return types.DecCoin{}
 }
 
-func (m *BlockTracking) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.InflationRewards.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if len(m.TxRewards) > 0 {
-		for _, e := range m.TxRewards {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *QueryMinConsensusFeeDebugResponse) GetStoredFee() types.DecCoin {
+	if m != nil {
+		return m.StoredFee
 	}
-	return n
+	return types.DecCoin{}
 }
 
-func (m *QueryRewardsRecordsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RewardsAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryTopContractsByRewardsRequest is the request for
+// Query.TopContractsByRewards.
+type QueryTopContractsByRewardsRequest struct {
+	// window is the number of recent blocks (including the current one) to sum
+	// the contract rewards over.
+	Window uint64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	// limit is the max number of contracts to return.
+	Limit uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (m *QueryRewardsRecordsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Records) > 0 {
-		for _, e := range m.Records {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryTopContractsByRewardsRequest) Reset()         { *m = QueryTopContractsByRewardsRequest{} }
+func (m *QueryTopContractsByRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTopContractsByRewardsRequest) ProtoMessage()    {}
+func (*QueryTopContractsByRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{45}
+}
+func (m *QueryTopContractsByRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTopContractsByRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTopContractsByRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryTopContractsByRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopContractsByRewardsRequest.Merge(m, src)
+}
+func (m *QueryTopContractsByRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTopContractsByRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopContractsByRewardsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryOutstandingRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RewardsAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryTopContractsByRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryTopContractsByRewardsRequest) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
 	}
-	return n
+	return 0
 }
 
-func (m *QueryOutstandingRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTopContractsByRewardsRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
 	}
-	var l int
-	_ = l
-	if len(m.TotalRewards) > 0 {
-		for _, e := range m.TotalRewards {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+// QueryTopContractsByRewardsResponse is the response for
+// Query.TopContractsByRewards.
+type QueryTopContractsByRewardsResponse struct {
+	// contracts are the contracts rewards sorted by the rewards amount (in the
+	// MinPriceOfGas denom) in descending order.
+	Contracts []ContractRewards `protobuf:"bytes,1,rep,name=contracts,proto3" json:"contracts"`
+}
+
+func (m *QueryTopContractsByRewardsResponse) Reset()         { *m = QueryTopContractsByRewardsResponse{} }
+func (m *QueryTopContractsByRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTopContractsByRewardsResponse) ProtoMessage()    {}
+func (*QueryTopContractsByRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{46}
+}
+func (m *QueryTopContractsByRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTopContractsByRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTopContractsByRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.RecordsNum != 0 {
-		n += 1 + sovQuery(uint64(m.RecordsNum))
-	}
-	return n
+}
+func (m *QueryTopContractsByRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopContractsByRewardsResponse.Merge(m, src)
+}
+func (m *QueryTopContractsByRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTopContractsByRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopContractsByRewardsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryFlatFeeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryTopContractsByRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryTopContractsByRewardsResponse) GetContracts() []ContractRewards {
+	if m != nil {
+		return m.Contracts
 	}
-	return n
+	return nil
 }
 
-func (m *QueryFlatFeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.FlatFeeAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// QueryContractRewardsEligibilityRequest is the request for
+// Query.ContractRewardsEligibility.
+type QueryContractRewardsEligibilityRequest struct {
+	// contract_address is the contract address (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryContractRewardsEligibilityRequest) Reset() {
+	*m = QueryContractRewardsEligibilityRequest{}
 }
-func sozQuery(x uint64) (n int) {
+func (m *QueryContractRewardsEligibilityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractRewardsEligibilityRequest) ProtoMessage()    {}
+func (*QueryContractRewardsEligibilityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{47}
+}
+func (m *QueryContractRewardsEligibilityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractRewardsEligibilityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractRewardsEligibilityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractRewardsEligibilityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractRewardsEligibilityRequest.Merge(m, src)
+}
+func (m *QueryContractRewardsEligibilityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractRewardsEligibilityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractRewardsEligibilityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractRewardsEligibilityRequest proto.InternalMessageInfo
+
+func (m *QueryContractRewardsEligibilityRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+// QueryContractRewardsEligibilityResponse is the response for
+// Query.ContractRewardsEligibility.
+type QueryContractRewardsEligibilityResponse struct {
+	// eligible defines whether the contract is credited the dApp rewards (all
+	// the conditions below are met).
+	Eligible bool `protobuf:"varint,1,opt,name=eligible,proto3" json:"eligible,omitempty"`
+	// has_metadata defines whether the contract metadata is set.
+	HasMetadata bool `protobuf:"varint,2,opt,name=has_metadata,json=hasMetadata,proto3" json:"has_metadata,omitempty"`
+	// has_rewards_recipients defines whether the contract metadata rewards
+	// address or rewards splits are set.
+	HasRewardsRecipients bool `protobuf:"varint,3,opt,name=has_rewards_recipients,json=hasRewardsRecipients,proto3" json:"has_rewards_recipients,omitempty"`
+	// rewards_enabled defines whether the module distributes any dApp rewards
+	// (the inflation rewards or the tx fee rebate ratio is non-zero).
+	RewardsEnabled bool `protobuf:"varint,4,opt,name=rewards_enabled,json=rewardsEnabled,proto3" json:"rewards_enabled,omitempty"`
+}
+
+func (m *QueryContractRewardsEligibilityResponse) Reset() {
+	*m = QueryContractRewardsEligibilityResponse{}
+}
+func (m *QueryContractRewardsEligibilityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractRewardsEligibilityResponse) ProtoMessage()    {}
+func (*QueryContractRewardsEligibilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{48}
+}
+func (m *QueryContractRewardsEligibilityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractRewardsEligibilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractRewardsEligibilityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractRewardsEligibilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractRewardsEligibilityResponse.Merge(m, src)
+}
+func (m *QueryContractRewardsEligibilityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractRewardsEligibilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractRewardsEligibilityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractRewardsEligibilityResponse proto.InternalMessageInfo
+
+func (m *QueryContractRewardsEligibilityResponse) GetEligible() bool {
+	if m != nil {
+		return m.Eligible
+	}
+	return false
+}
+
+func (m *QueryContractRewardsEligibilityResponse) GetHasMetadata() bool {
+	if m != nil {
+		return m.HasMetadata
+	}
+	return false
+}
+
+func (m *QueryContractRewardsEligibilityResponse) GetHasRewardsRecipients() bool {
+	if m != nil {
+		return m.HasRewardsRecipients
+	}
+	return false
+}
+
+func (m *QueryContractRewardsEligibilityResponse) GetRewardsEnabled() bool {
+	if m != nil {
+		return m.RewardsEnabled
+	}
+	return false
+}
+
+// QueryMsgTypeFlatFeeRequest is the request for Query.MsgTypeFlatFee.
+type QueryMsgTypeFlatFeeRequest struct {
+	// msg_type_url is the msg type URL (e.g.
+	// /cosmwasm.wasm.v1.MsgExecuteContract).
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// contract_address is the target contract address (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// method is the contract execute msg method (optional). If set, the method
+	// flat fee is looked up first.
+	Method string `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+}
+
+func (m *QueryMsgTypeFlatFeeRequest) Reset()         { *m = QueryMsgTypeFlatFeeRequest{} }
+func (m *QueryMsgTypeFlatFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMsgTypeFlatFeeRequest) ProtoMessage()    {}
+func (*QueryMsgTypeFlatFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{49}
+}
+func (m *QueryMsgTypeFlatFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMsgTypeFlatFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMsgTypeFlatFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMsgTypeFlatFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMsgTypeFlatFeeRequest.Merge(m, src)
+}
+func (m *QueryMsgTypeFlatFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMsgTypeFlatFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMsgTypeFlatFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMsgTypeFlatFeeRequest proto.InternalMessageInfo
+
+func (m *QueryMsgTypeFlatFeeRequest) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *QueryMsgTypeFlatFeeRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *QueryMsgTypeFlatFeeRequest) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+// QueryMsgTypeFlatFeeResponse is the response for Query.MsgTypeFlatFee.
+type QueryMsgTypeFlatFeeResponse struct {
+	// flat_fee is the contract flat fee charged for the msg (zero if none
+	// applies).
+	FlatFee types.Coin `protobuf:"bytes,1,opt,name=flat_fee,json=flatFee,proto3" json:"flat_fee"`
+	// method_flat_fee defines whether the flat fee is the execute msg method
+	// one (the contract-wide flat fee otherwise).
+	MethodFlatFee bool `protobuf:"varint,2,opt,name=method_flat_fee,json=methodFlatFee,proto3" json:"method_flat_fee,omitempty"`
+}
+
+func (m *QueryMsgTypeFlatFeeResponse) Reset()         { *m = QueryMsgTypeFlatFeeResponse{} }
+func (m *QueryMsgTypeFlatFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMsgTypeFlatFeeResponse) ProtoMessage()    {}
+func (*QueryMsgTypeFlatFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{50}
+}
+func (m *QueryMsgTypeFlatFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMsgTypeFlatFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMsgTypeFlatFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMsgTypeFlatFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMsgTypeFlatFeeResponse.Merge(m, src)
+}
+func (m *QueryMsgTypeFlatFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMsgTypeFlatFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMsgTypeFlatFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMsgTypeFlatFeeResponse proto.InternalMessageInfo
+
+func (m *QueryMsgTypeFlatFeeResponse) GetFlatFee() types.Coin {
+	if m != nil {
+		return m.FlatFee
+	}
+	return types.Coin{}
+}
+
+func (m *QueryMsgTypeFlatFeeResponse) GetMethodFlatFee() bool {
+	if m != nil {
+		return m.MethodFlatFee
+	}
+	return false
+}
+
+// QueryTotalPendingRewardsRequest is the request for Query.TotalPendingRewards.
+type QueryTotalPendingRewardsRequest struct {
+}
+
+func (m *QueryTotalPendingRewardsRequest) Reset()         { *m = QueryTotalPendingRewardsRequest{} }
+func (m *QueryTotalPendingRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalPendingRewardsRequest) ProtoMessage()    {}
+func (*QueryTotalPendingRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{51}
+}
+func (m *QueryTotalPendingRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalPendingRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalPendingRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalPendingRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalPendingRewardsRequest.Merge(m, src)
+}
+func (m *QueryTotalPendingRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalPendingRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalPendingRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalPendingRewardsRequest proto.InternalMessageInfo
+
+// QueryTotalPendingRewardsResponse is the response for
+// Query.TotalPendingRewards.
+type QueryTotalPendingRewardsResponse struct {
+	// pending_rewards are the outstanding rewards records, the flat fees queued
+	// for the direct payout and the current block rewards not distributed yet.
+	PendingRewards []types.Coin `protobuf:"bytes,1,rep,name=pending_rewards,json=pendingRewards,proto3" json:"pending_rewards"`
+	// undistributed_funds are the rewards pool tokens (pending rewards must not
+	// exceed them).
+	UndistributedFunds []types.Coin `protobuf:"bytes,2,rep,name=undistributed_funds,json=undistributedFunds,proto3" json:"undistributed_funds"`
+}
+
+func (m *QueryTotalPendingRewardsResponse) Reset()         { *m = QueryTotalPendingRewardsResponse{} }
+func (m *QueryTotalPendingRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalPendingRewardsResponse) ProtoMessage()    {}
+func (*QueryTotalPendingRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{52}
+}
+func (m *QueryTotalPendingRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalPendingRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalPendingRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalPendingRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalPendingRewardsResponse.Merge(m, src)
+}
+func (m *QueryTotalPendingRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalPendingRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalPendingRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalPendingRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryTotalPendingRewardsResponse) GetPendingRewards() []types.Coin {
+	if m != nil {
+		return m.PendingRewards
+	}
+	return nil
+}
+
+func (m *QueryTotalPendingRewardsResponse) GetUndistributedFunds() []types.Coin {
+	if m != nil {
+		return m.UndistributedFunds
+	}
+	return nil
+}
+
+// QueryRewardsRecordsByAddressAndHeightRangeRequest is the request for
+// Query.RewardsRecordsByAddressAndHeightRange.
+type QueryRewardsRecordsByAddressAndHeightRangeRequest struct {
+	// rewards_address is the target address to query records for (bech32
+	// encoded).
+	RewardsAddress string `protobuf:"bytes,1,opt,name=rewards_address,json=rewardsAddress,proto3" json:"rewards_address,omitempty"`
+	// from_height defines the first block height of the range (inclusive).
+	FromHeight int64 `protobuf:"varint,2,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// to_height defines the last block height of the range (inclusive).
+	ToHeight int64 `protobuf:"varint,3,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	// pagination is an optional pagination options for the request (only the
+	// key based pagination is supported).
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRewardsRecordsByAddressAndHeightRangeRequest) Reset() {
+	*m = QueryRewardsRecordsByAddressAndHeightRangeRequest{}
+}
+func (m *QueryRewardsRecordsByAddressAndHeightRangeRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryRewardsRecordsByAddressAndHeightRangeRequest) ProtoMessage() {}
+func (*QueryRewardsRecordsByAddressAndHeightRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{53}
+}
+func (m *QueryRewardsRecordsByAddressAndHeightRangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardsRecordsByAddressAndHeightRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardsRecordsByAddressAndHeightRangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRewardsRecordsByAddressAndHeightRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsRecordsByAddressAndHeightRangeRequest.Merge(m, src)
+}
+func (m *QueryRewardsRecordsByAddressAndHeightRangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardsRecordsByAddressAndHeightRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsRecordsByAddressAndHeightRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardsRecordsByAddressAndHeightRangeRequest proto.InternalMessageInfo
+
+func (m *QueryRewardsRecordsByAddressAndHeightRangeRequest) GetRewardsAddress() string {
+	if m != nil {
+		return m.RewardsAddress
+	}
+	return ""
+}
+
+func (m *QueryRewardsRecordsByAddressAndHeightRangeRequest) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *QueryRewardsRecordsByAddressAndHeightRangeRequest) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *QueryRewardsRecordsByAddressAndHeightRangeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRewardsRecordsByAddressAndHeightRangeResponse is the response for
+// Query.RewardsRecordsByAddressAndHeightRange.
+type QueryRewardsRecordsByAddressAndHeightRangeResponse struct {
+	// records is the list of rewards records (ordered by the calculated height).
+	Records []RewardsRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	// pagination is the pagination details in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRewardsRecordsByAddressAndHeightRangeResponse) Reset() {
+	*m = QueryRewardsRecordsByAddressAndHeightRangeResponse{}
+}
+func (m *QueryRewardsRecordsByAddressAndHeightRangeResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryRewardsRecordsByAddressAndHeightRangeResponse) ProtoMessage() {}
+func (*QueryRewardsRecordsByAddressAndHeightRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{54}
+}
+func (m *QueryRewardsRecordsByAddressAndHeightRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardsRecordsByAddressAndHeightRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardsRecordsByAddressAndHeightRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRewardsRecordsByAddressAndHeightRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsRecordsByAddressAndHeightRangeResponse.Merge(m, src)
+}
+func (m *QueryRewardsRecordsByAddressAndHeightRangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardsRecordsByAddressAndHeightRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsRecordsByAddressAndHeightRangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardsRecordsByAddressAndHeightRangeResponse proto.InternalMessageInfo
+
+func (m *QueryRewardsRecordsByAddressAndHeightRangeResponse) GetRecords() []RewardsRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *QueryRewardsRecordsByAddressAndHeightRangeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryEstimateTxFeesForSimulatedGasRequest is the request for
+// Query.EstimateTxFeesForSimulatedGas.
+type QueryEstimateTxFeesForSimulatedGasRequest struct {
+	// simulated_gas is the gas used reported by the transaction simulation.
+	SimulatedGas uint64 `protobuf:"varint,1,opt,name=simulated_gas,json=simulatedGas,proto3" json:"simulated_gas,omitempty"`
+	// gas_adjustment is the factor the simulated gas is multiplied by to get the
+	// transaction gas limit (optional, 1.0 if not set, must be GTE 1.0).
+	GasAdjustment cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=gas_adjustment,json=gasAdjustment,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"gas_adjustment"`
+}
+
+func (m *QueryEstimateTxFeesForSimulatedGasRequest) Reset() {
+	*m = QueryEstimateTxFeesForSimulatedGasRequest{}
+}
+func (m *QueryEstimateTxFeesForSimulatedGasRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryEstimateTxFeesForSimulatedGasRequest) ProtoMessage() {}
+func (*QueryEstimateTxFeesForSimulatedGasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{55}
+}
+func (m *QueryEstimateTxFeesForSimulatedGasRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateTxFeesForSimulatedGasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateTxFeesForSimulatedGasRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateTxFeesForSimulatedGasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateTxFeesForSimulatedGasRequest.Merge(m, src)
+}
+func (m *QueryEstimateTxFeesForSimulatedGasRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateTxFeesForSimulatedGasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateTxFeesForSimulatedGasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateTxFeesForSimulatedGasRequest proto.InternalMessageInfo
+
+func (m *QueryEstimateTxFeesForSimulatedGasRequest) GetSimulatedGas() uint64 {
+	if m != nil {
+		return m.SimulatedGas
+	}
+	return 0
+}
+
+// QueryEstimateTxFeesForSimulatedGasResponse is the response for
+// Query.EstimateTxFeesForSimulatedGas.
+type QueryEstimateTxFeesForSimulatedGasResponse struct {
+	// gas_unit_price defines the minimum transaction fee per gas unit.
+	GasUnitPrice types.DecCoin `protobuf:"bytes,1,opt,name=gas_unit_price,json=gasUnitPrice,proto3" json:"gas_unit_price"`
+	// adjusted_gas_limit is the simulated gas multiplied by the gas adjustment
+	// (rounded up).
+	AdjustedGasLimit uint64 `protobuf:"varint,2,opt,name=adjusted_gas_limit,json=adjustedGasLimit,proto3" json:"adjusted_gas_limit,omitempty"`
+	// simulated_gas_fee is the minimum transaction fee for the simulated gas
+	// (contract flat fees excluded).
+	SimulatedGasFee []types.Coin `protobuf:"bytes,3,rep,name=simulated_gas_fee,json=simulatedGasFee,proto3" json:"simulated_gas_fee"`
+	// adjusted_gas_fee is the minimum transaction fee for the adjusted gas limit
+	// (contract flat fees excluded).
+	AdjustedGasFee []types.Coin `protobuf:"bytes,4,rep,name=adjusted_gas_fee,json=adjustedGasFee,proto3" json:"adjusted_gas_fee"`
+}
+
+func (m *QueryEstimateTxFeesForSimulatedGasResponse) Reset() {
+	*m = QueryEstimateTxFeesForSimulatedGasResponse{}
+}
+func (m *QueryEstimateTxFeesForSimulatedGasResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryEstimateTxFeesForSimulatedGasResponse) ProtoMessage() {}
+func (*QueryEstimateTxFeesForSimulatedGasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{56}
+}
+func (m *QueryEstimateTxFeesForSimulatedGasResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateTxFeesForSimulatedGasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateTxFeesForSimulatedGasResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateTxFeesForSimulatedGasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateTxFeesForSimulatedGasResponse.Merge(m, src)
+}
+func (m *QueryEstimateTxFeesForSimulatedGasResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateTxFeesForSimulatedGasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateTxFeesForSimulatedGasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateTxFeesForSimulatedGasResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateTxFeesForSimulatedGasResponse) GetGasUnitPrice() types.DecCoin {
+	if m != nil {
+		return m.GasUnitPrice
+	}
+	return types.DecCoin{}
+}
+
+func (m *QueryEstimateTxFeesForSimulatedGasResponse) GetAdjustedGasLimit() uint64 {
+	if m != nil {
+		return m.AdjustedGasLimit
+	}
+	return 0
+}
+
+func (m *QueryEstimateTxFeesForSimulatedGasResponse) GetSimulatedGasFee() []types.Coin {
+	if m != nil {
+		return m.SimulatedGasFee
+	}
+	return nil
+}
+
+func (m *QueryEstimateTxFeesForSimulatedGasResponse) GetAdjustedGasFee() []types.Coin {
+	if m != nil {
+		return m.AdjustedGasFee
+	}
+	return nil
+}
+
+// QueryProjectedMinConsensusFeeRequest is the request for
+// Query.ProjectedMinConsensusFee.
+type QueryProjectedMinConsensusFeeRequest struct {
+	// window is the number of recent blocks (including the current one) the
+	// trend is taken from.
+	Window uint64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (m *QueryProjectedMinConsensusFeeRequest) Reset()         { *m = QueryProjectedMinConsensusFeeRequest{} }
+func (m *QueryProjectedMinConsensusFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedMinConsensusFeeRequest) ProtoMessage()    {}
+func (*QueryProjectedMinConsensusFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{57}
+}
+func (m *QueryProjectedMinConsensusFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedMinConsensusFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedMinConsensusFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedMinConsensusFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedMinConsensusFeeRequest.Merge(m, src)
+}
+func (m *QueryProjectedMinConsensusFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedMinConsensusFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedMinConsensusFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedMinConsensusFeeRequest proto.InternalMessageInfo
+
+func (m *QueryProjectedMinConsensusFeeRequest) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+// QueryProjectedMinConsensusFeeResponse is the response for
+// Query.ProjectedMinConsensusFee.
+type QueryProjectedMinConsensusFeeResponse struct {
+	// current_gas_price is the current minimum transaction fee per gas unit.
+	CurrentGasPrice types.DecCoin `protobuf:"bytes,1,opt,name=current_gas_price,json=currentGasPrice,proto3" json:"current_gas_price"`
+	// projected_gas_price is the projected minimum transaction fee per gas unit
+	// for the next block. This is a best-effort estimation: the actual fee
+	// depends on the next block inflation rewards and could differ.
+	ProjectedGasPrice types.DecCoin `protobuf:"bytes,2,opt,name=projected_gas_price,json=projectedGasPrice,proto3" json:"projected_gas_price"`
+	// blocks_used is the number of recent blocks the projection is based on
+	// (the current gas price is projected if it is less than 2).
+	BlocksUsed uint64 `protobuf:"varint,3,opt,name=blocks_used,json=blocksUsed,proto3" json:"blocks_used,omitempty"`
+}
+
+func (m *QueryProjectedMinConsensusFeeResponse) Reset()         { *m = QueryProjectedMinConsensusFeeResponse{} }
+func (m *QueryProjectedMinConsensusFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedMinConsensusFeeResponse) ProtoMessage()    {}
+func (*QueryProjectedMinConsensusFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{58}
+}
+func (m *QueryProjectedMinConsensusFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedMinConsensusFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedMinConsensusFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedMinConsensusFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedMinConsensusFeeResponse.Merge(m, src)
+}
+func (m *QueryProjectedMinConsensusFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedMinConsensusFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedMinConsensusFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedMinConsensusFeeResponse proto.InternalMessageInfo
+
+func (m *QueryProjectedMinConsensusFeeResponse) GetCurrentGasPrice() types.DecCoin {
+	if m != nil {
+		return m.CurrentGasPrice
+	}
+	return types.DecCoin{}
+}
+
+func (m *QueryProjectedMinConsensusFeeResponse) GetProjectedGasPrice() types.DecCoin {
+	if m != nil {
+		return m.ProjectedGasPrice
+	}
+	return types.DecCoin{}
+}
+
+func (m *QueryProjectedMinConsensusFeeResponse) GetBlocksUsed() uint64 {
+	if m != nil {
+		return m.BlocksUsed
+	}
+	return 0
+}
+
+// QueryContractFlatFeeRevenueRequest is the request for
+// Query.ContractFlatFeeRevenue.
+type QueryContractFlatFeeRevenueRequest struct {
+	// contract_address is the contract address (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// window is the number of recent blocks (including the current one) to sum
+	// the contract flat fees over.
+	Window uint64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (m *QueryContractFlatFeeRevenueRequest) Reset()         { *m = QueryContractFlatFeeRevenueRequest{} }
+func (m *QueryContractFlatFeeRevenueRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractFlatFeeRevenueRequest) ProtoMessage()    {}
+func (*QueryContractFlatFeeRevenueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{59}
+}
+func (m *QueryContractFlatFeeRevenueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractFlatFeeRevenueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractFlatFeeRevenueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractFlatFeeRevenueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractFlatFeeRevenueRequest.Merge(m, src)
+}
+func (m *QueryContractFlatFeeRevenueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractFlatFeeRevenueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractFlatFeeRevenueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractFlatFeeRevenueRequest proto.InternalMessageInfo
+
+func (m *QueryContractFlatFeeRevenueRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *QueryContractFlatFeeRevenueRequest) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+// QueryContractFlatFeeRevenueResponse is the response for
+// Query.ContractFlatFeeRevenue.
+type QueryContractFlatFeeRevenueResponse struct {
+	// revenue is the total flat fees collected for the contract within the
+	// window (charged and prepaid flat fees).
+	Revenue []types.Coin `protobuf:"bytes,1,rep,name=revenue,proto3" json:"revenue"`
+}
+
+func (m *QueryContractFlatFeeRevenueResponse) Reset()         { *m = QueryContractFlatFeeRevenueResponse{} }
+func (m *QueryContractFlatFeeRevenueResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractFlatFeeRevenueResponse) ProtoMessage()    {}
+func (*QueryContractFlatFeeRevenueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{60}
+}
+func (m *QueryContractFlatFeeRevenueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractFlatFeeRevenueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractFlatFeeRevenueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractFlatFeeRevenueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractFlatFeeRevenueResponse.Merge(m, src)
+}
+func (m *QueryContractFlatFeeRevenueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractFlatFeeRevenueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractFlatFeeRevenueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractFlatFeeRevenueResponse proto.InternalMessageInfo
+
+func (m *QueryContractFlatFeeRevenueResponse) GetRevenue() []types.Coin {
+	if m != nil {
+		return m.Revenue
+	}
+	return nil
+}
+
+// QueryRewardsPoolSolvencyRequest is the request for Query.RewardsPoolSolvency.
+type QueryRewardsPoolSolvencyRequest struct {
+}
+
+func (m *QueryRewardsPoolSolvencyRequest) Reset()         { *m = QueryRewardsPoolSolvencyRequest{} }
+func (m *QueryRewardsPoolSolvencyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardsPoolSolvencyRequest) ProtoMessage()    {}
+func (*QueryRewardsPoolSolvencyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{61}
+}
+func (m *QueryRewardsPoolSolvencyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardsPoolSolvencyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardsPoolSolvencyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRewardsPoolSolvencyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsPoolSolvencyRequest.Merge(m, src)
+}
+func (m *QueryRewardsPoolSolvencyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardsPoolSolvencyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsPoolSolvencyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardsPoolSolvencyRequest proto.InternalMessageInfo
+
+// QueryRewardsPoolSolvencyResponse is the response for
+// Query.RewardsPoolSolvency.
+type QueryRewardsPoolSolvencyResponse struct {
+	// solvent is true if the pool balance is GTE the liabilities (per denom).
+	Solvent bool `protobuf:"varint,1,opt,name=solvent,proto3" json:"solvent,omitempty"`
+	// pool_balance is the current rewards pool (ContractRewardCollector) balance.
+	PoolBalance []types.Coin `protobuf:"bytes,2,rep,name=pool_balance,json=poolBalance,proto3" json:"pool_balance"`
+	// liabilities is the total rewards of the RewardsRecord objects pending
+	// withdrawal.
+	Liabilities []types.Coin `protobuf:"bytes,3,rep,name=liabilities,proto3" json:"liabilities"`
+}
+
+func (m *QueryRewardsPoolSolvencyResponse) Reset()         { *m = QueryRewardsPoolSolvencyResponse{} }
+func (m *QueryRewardsPoolSolvencyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardsPoolSolvencyResponse) ProtoMessage()    {}
+func (*QueryRewardsPoolSolvencyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{62}
+}
+func (m *QueryRewardsPoolSolvencyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardsPoolSolvencyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardsPoolSolvencyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRewardsPoolSolvencyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsPoolSolvencyResponse.Merge(m, src)
+}
+func (m *QueryRewardsPoolSolvencyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardsPoolSolvencyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsPoolSolvencyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardsPoolSolvencyResponse proto.InternalMessageInfo
+
+func (m *QueryRewardsPoolSolvencyResponse) GetSolvent() bool {
+	if m != nil {
+		return m.Solvent
+	}
+	return false
+}
+
+func (m *QueryRewardsPoolSolvencyResponse) GetPoolBalance() []types.Coin {
+	if m != nil {
+		return m.PoolBalance
+	}
+	return nil
+}
+
+func (m *QueryRewardsPoolSolvencyResponse) GetLiabilities() []types.Coin {
+	if m != nil {
+		return m.Liabilities
+	}
+	return nil
+}
+
+// QueryAcceptedFeeDenomsRequest is the request for Query.AcceptedFeeDenoms.
+type QueryAcceptedFeeDenomsRequest struct {
+}
+
+func (m *QueryAcceptedFeeDenomsRequest) Reset()         { *m = QueryAcceptedFeeDenomsRequest{} }
+func (m *QueryAcceptedFeeDenomsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAcceptedFeeDenomsRequest) ProtoMessage()    {}
+func (*QueryAcceptedFeeDenomsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{63}
+}
+func (m *QueryAcceptedFeeDenomsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAcceptedFeeDenomsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAcceptedFeeDenomsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAcceptedFeeDenomsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAcceptedFeeDenomsRequest.Merge(m, src)
+}
+func (m *QueryAcceptedFeeDenomsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAcceptedFeeDenomsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAcceptedFeeDenomsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAcceptedFeeDenomsRequest proto.InternalMessageInfo
+
+// QueryAcceptedFeeDenomsResponse is the response for Query.AcceptedFeeDenoms.
+type QueryAcceptedFeeDenomsResponse struct {
+	// min_gas_prices are the accepted fee denoms with their current minimum gas
+	// prices (the bond denom goes first). A zero price is reported for denoms
+	// without the minimum consensus fee set.
+	MinGasPrices []types.DecCoin `protobuf:"bytes,1,rep,name=min_gas_prices,json=minGasPrices,proto3" json:"min_gas_prices"`
+	// any_denom_accepted is true if the AcceptedFeeDenoms param is not set (fees
+	// in any denom are accepted, min_gas_prices lists the denoms with a price).
+	AnyDenomAccepted bool `protobuf:"varint,2,opt,name=any_denom_accepted,json=anyDenomAccepted,proto3" json:"any_denom_accepted,omitempty"`
+}
+
+func (m *QueryAcceptedFeeDenomsResponse) Reset()         { *m = QueryAcceptedFeeDenomsResponse{} }
+func (m *QueryAcceptedFeeDenomsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAcceptedFeeDenomsResponse) ProtoMessage()    {}
+func (*QueryAcceptedFeeDenomsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{64}
+}
+func (m *QueryAcceptedFeeDenomsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAcceptedFeeDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAcceptedFeeDenomsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAcceptedFeeDenomsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAcceptedFeeDenomsResponse.Merge(m, src)
+}
+func (m *QueryAcceptedFeeDenomsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAcceptedFeeDenomsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAcceptedFeeDenomsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAcceptedFeeDenomsResponse proto.InternalMessageInfo
+
+func (m *QueryAcceptedFeeDenomsResponse) GetMinGasPrices() []types.DecCoin {
+	if m != nil {
+		return m.MinGasPrices
+	}
+	return nil
+}
+
+func (m *QueryAcceptedFeeDenomsResponse) GetAnyDenomAccepted() bool {
+	if m != nil {
+		return m.AnyDenomAccepted
+	}
+	return false
+}
+
+// QueryBlockPoolInflowsRequest is the request for Query.BlockPoolInflows.
+type QueryBlockPoolInflowsRequest struct {
+	// height is the block height (within the recent blocks history).
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryBlockPoolInflowsRequest) Reset()         { *m = QueryBlockPoolInflowsRequest{} }
+func (m *QueryBlockPoolInflowsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockPoolInflowsRequest) ProtoMessage()    {}
+func (*QueryBlockPoolInflowsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{65}
+}
+func (m *QueryBlockPoolInflowsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockPoolInflowsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockPoolInflowsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockPoolInflowsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockPoolInflowsRequest.Merge(m, src)
+}
+func (m *QueryBlockPoolInflowsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockPoolInflowsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockPoolInflowsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockPoolInflowsRequest proto.InternalMessageInfo
+
+func (m *QueryBlockPoolInflowsRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryBlockPoolInflowsResponse is the response for Query.BlockPoolInflows.
+type QueryBlockPoolInflowsResponse struct {
+	// inflows is the block rewards pool inflows breakdown (empty if nothing
+	// entered the pool within the block).
+	Inflows BlockPoolInflows `protobuf:"bytes,1,opt,name=inflows,proto3" json:"inflows"`
+}
+
+func (m *QueryBlockPoolInflowsResponse) Reset()         { *m = QueryBlockPoolInflowsResponse{} }
+func (m *QueryBlockPoolInflowsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockPoolInflowsResponse) ProtoMessage()    {}
+func (*QueryBlockPoolInflowsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{66}
+}
+func (m *QueryBlockPoolInflowsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockPoolInflowsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockPoolInflowsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockPoolInflowsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockPoolInflowsResponse.Merge(m, src)
+}
+func (m *QueryBlockPoolInflowsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockPoolInflowsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockPoolInflowsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockPoolInflowsResponse proto.InternalMessageInfo
+
+func (m *QueryBlockPoolInflowsResponse) GetInflows() BlockPoolInflows {
+	if m != nil {
+		return m.Inflows
+	}
+	return BlockPoolInflows{}
+}
+
+// QueryReconcileRewardsRequest is the request for Query.ReconcileRewards.
+type QueryReconcileRewardsRequest struct {
+}
+
+func (m *QueryReconcileRewardsRequest) Reset()         { *m = QueryReconcileRewardsRequest{} }
+func (m *QueryReconcileRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReconcileRewardsRequest) ProtoMessage()    {}
+func (*QueryReconcileRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{67}
+}
+func (m *QueryReconcileRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReconcileRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReconcileRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReconcileRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReconcileRewardsRequest.Merge(m, src)
+}
+func (m *QueryReconcileRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReconcileRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReconcileRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReconcileRewardsRequest proto.InternalMessageInfo
+
+// QueryReconcileRewardsResponse is the response for Query.ReconcileRewards.
+type QueryReconcileRewardsResponse struct {
+	// reconciliation is the rewards pool balance compared against the expected
+	// tokens.
+	Reconciliation RewardsReconciliation `protobuf:"bytes,1,opt,name=reconciliation,proto3" json:"reconciliation"`
+	// balanced is true if there is neither surplus nor deficit.
+	Balanced bool `protobuf:"varint,2,opt,name=balanced,proto3" json:"balanced,omitempty"`
+}
+
+func (m *QueryReconcileRewardsResponse) Reset()         { *m = QueryReconcileRewardsResponse{} }
+func (m *QueryReconcileRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReconcileRewardsResponse) ProtoMessage()    {}
+func (*QueryReconcileRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{68}
+}
+func (m *QueryReconcileRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReconcileRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReconcileRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReconcileRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReconcileRewardsResponse.Merge(m, src)
+}
+func (m *QueryReconcileRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReconcileRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReconcileRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReconcileRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryReconcileRewardsResponse) GetReconciliation() RewardsReconciliation {
+	if m != nil {
+		return m.Reconciliation
+	}
+	return RewardsReconciliation{}
+}
+
+func (m *QueryReconcileRewardsResponse) GetBalanced() bool {
+	if m != nil {
+		return m.Balanced
+	}
+	return false
+}
+
+// QueryTxFeeEstimateRequest is the request for Query.TxFeeEstimate.
+type QueryTxFeeEstimateRequest struct {
+	// gas_limit is the transaction gas limit.
+	GasLimit uint64 `protobuf:"varint,1,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// contract_addresses whose flat fees are considered when estimating tx fees
+	// (duplicates are counted every time).
+	ContractAddresses []string `protobuf:"bytes,2,rep,name=contract_addresses,json=contractAddresses,proto3" json:"contract_addresses,omitempty"`
+	// tx_size is the encoded transaction size in bytes the tx size surcharge is
+	// estimated for (optional).
+	TxSize uint64 `protobuf:"varint,3,opt,name=tx_size,json=txSize,proto3" json:"tx_size,omitempty"`
+}
+
+func (m *QueryTxFeeEstimateRequest) Reset()         { *m = QueryTxFeeEstimateRequest{} }
+func (m *QueryTxFeeEstimateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTxFeeEstimateRequest) ProtoMessage()    {}
+func (*QueryTxFeeEstimateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{69}
+}
+func (m *QueryTxFeeEstimateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxFeeEstimateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxFeeEstimateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTxFeeEstimateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxFeeEstimateRequest.Merge(m, src)
+}
+func (m *QueryTxFeeEstimateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxFeeEstimateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxFeeEstimateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxFeeEstimateRequest proto.InternalMessageInfo
+
+func (m *QueryTxFeeEstimateRequest) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *QueryTxFeeEstimateRequest) GetContractAddresses() []string {
+	if m != nil {
+		return m.ContractAddresses
+	}
+	return nil
+}
+
+func (m *QueryTxFeeEstimateRequest) GetTxSize() uint64 {
+	if m != nil {
+		return m.TxSize
+	}
+	return 0
+}
+
+// QueryTxFeeEstimateResponse is the response for Query.TxFeeEstimate.
+// New fee components are added as new fields (bumping the version), existing
+// fields are never renumbered or reused.
+type QueryTxFeeEstimateResponse struct {
+	// version is the response shape version the fee components are reported
+	// for.
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// gas_unit_price defines the minimum transaction fee per gas unit.
+	GasUnitPrice types.DecCoin `protobuf:"bytes,2,opt,name=gas_unit_price,json=gasUnitPrice,proto3" json:"gas_unit_price"`
+	// estimated_fee is the estimated transaction fee combining all the fee
+	// components.
+	EstimatedFee []types.Coin `protobuf:"bytes,3,rep,name=estimated_fee,json=estimatedFee,proto3" json:"estimated_fee"`
+	// gas_fees is the gas limit fee (the min fee floor if the min fee is zero
+	// otherwise).
+	GasFees []types.Coin `protobuf:"bytes,4,rep,name=gas_fees,json=gasFees,proto3" json:"gas_fees"`
+	// tx_size_fees is the tx size surcharge for the given tx size.
+	TxSizeFees []types.Coin `protobuf:"bytes,5,rep,name=tx_size_fees,json=txSizeFees,proto3" json:"tx_size_fees"`
+	// flat_fees is the combined flat fee of the given contracts.
+	FlatFees []types.Coin `protobuf:"bytes,6,rep,name=flat_fees,json=flatFees,proto3" json:"flat_fees"`
+}
+
+func (m *QueryTxFeeEstimateResponse) Reset()         { *m = QueryTxFeeEstimateResponse{} }
+func (m *QueryTxFeeEstimateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTxFeeEstimateResponse) ProtoMessage()    {}
+func (*QueryTxFeeEstimateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{70}
+}
+func (m *QueryTxFeeEstimateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxFeeEstimateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxFeeEstimateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTxFeeEstimateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxFeeEstimateResponse.Merge(m, src)
+}
+func (m *QueryTxFeeEstimateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxFeeEstimateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxFeeEstimateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxFeeEstimateResponse proto.InternalMessageInfo
+
+func (m *QueryTxFeeEstimateResponse) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *QueryTxFeeEstimateResponse) GetGasUnitPrice() types.DecCoin {
+	if m != nil {
+		return m.GasUnitPrice
+	}
+	return types.DecCoin{}
+}
+
+func (m *QueryTxFeeEstimateResponse) GetEstimatedFee() []types.Coin {
+	if m != nil {
+		return m.EstimatedFee
+	}
+	return nil
+}
+
+func (m *QueryTxFeeEstimateResponse) GetGasFees() []types.Coin {
+	if m != nil {
+		return m.GasFees
+	}
+	return nil
+}
+
+func (m *QueryTxFeeEstimateResponse) GetTxSizeFees() []types.Coin {
+	if m != nil {
+		return m.TxSizeFees
+	}
+	return nil
+}
+
+func (m *QueryTxFeeEstimateResponse) GetFlatFees() []types.Coin {
+	if m != nil {
+		return m.FlatFees
+	}
+	return nil
+}
+
+// QueryBlockFlatFeeTxsRequest is the request for Query.BlockFlatFeeTxs.
+type QueryBlockFlatFeeTxsRequest struct {
+	// height is the block height (within the tracking data retention window).
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryBlockFlatFeeTxsRequest) Reset()         { *m = QueryBlockFlatFeeTxsRequest{} }
+func (m *QueryBlockFlatFeeTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockFlatFeeTxsRequest) ProtoMessage()    {}
+func (*QueryBlockFlatFeeTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{71}
+}
+func (m *QueryBlockFlatFeeTxsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockFlatFeeTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockFlatFeeTxsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockFlatFeeTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockFlatFeeTxsRequest.Merge(m, src)
+}
+func (m *QueryBlockFlatFeeTxsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockFlatFeeTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockFlatFeeTxsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockFlatFeeTxsRequest proto.InternalMessageInfo
+
+func (m *QueryBlockFlatFeeTxsRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryBlockFlatFeeTxsResponse is the response for Query.BlockFlatFeeTxs.
+type QueryBlockFlatFeeTxsResponse struct {
+	// txs are the block transactions which paid the contract flat fees (in the
+	// block order).
+	Txs []BlockFlatFeeTx `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs"`
+	// truncated is true if the block has more flat fee transactions than the
+	// response limit.
+	Truncated bool `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"`
+}
+
+func (m *QueryBlockFlatFeeTxsResponse) Reset()         { *m = QueryBlockFlatFeeTxsResponse{} }
+func (m *QueryBlockFlatFeeTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockFlatFeeTxsResponse) ProtoMessage()    {}
+func (*QueryBlockFlatFeeTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{72}
+}
+func (m *QueryBlockFlatFeeTxsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockFlatFeeTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockFlatFeeTxsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockFlatFeeTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockFlatFeeTxsResponse.Merge(m, src)
+}
+func (m *QueryBlockFlatFeeTxsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockFlatFeeTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockFlatFeeTxsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockFlatFeeTxsResponse proto.InternalMessageInfo
+
+func (m *QueryBlockFlatFeeTxsResponse) GetTxs() []BlockFlatFeeTx {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func (m *QueryBlockFlatFeeTxsResponse) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
+	}
+	return false
+}
+
+// BlockFlatFeeTx defines a transaction which paid the contract flat fees.
+type BlockFlatFeeTx struct {
+	// tx_id is the tracking transaction ID (x/tracking is the data source for
+	// this value).
+	TxId uint64 `protobuf:"varint,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	// tx_hash is the hex encoded transaction hash.
+	TxHash string `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// flat_fees defines the contract flat fees paid by the transaction.
+	FlatFees []types.Coin `protobuf:"bytes,3,rep,name=flat_fees,json=flatFees,proto3" json:"flat_fees"`
+}
+
+func (m *BlockFlatFeeTx) Reset()         { *m = BlockFlatFeeTx{} }
+func (m *BlockFlatFeeTx) String() string { return proto.CompactTextString(m) }
+func (*BlockFlatFeeTx) ProtoMessage()    {}
+func (*BlockFlatFeeTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{73}
+}
+func (m *BlockFlatFeeTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockFlatFeeTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockFlatFeeTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockFlatFeeTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockFlatFeeTx.Merge(m, src)
+}
+func (m *BlockFlatFeeTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockFlatFeeTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockFlatFeeTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockFlatFeeTx proto.InternalMessageInfo
+
+func (m *BlockFlatFeeTx) GetTxId() uint64 {
+	if m != nil {
+		return m.TxId
+	}
+	return 0
+}
+
+func (m *BlockFlatFeeTx) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *BlockFlatFeeTx) GetFlatFees() []types.Coin {
+	if m != nil {
+		return m.FlatFees
+	}
+	return nil
+}
+
+// QueryFlatFeesRequest is the request for Query.FlatFees.
+type QueryFlatFeesRequest struct {
+	// contract_addresses are the contract addresses (bech32 encoded) to get the
+	// flat fees for.
+	ContractAddresses []string `protobuf:"bytes,1,rep,name=contract_addresses,json=contractAddresses,proto3" json:"contract_addresses,omitempty"`
+}
+
+func (m *QueryFlatFeesRequest) Reset()         { *m = QueryFlatFeesRequest{} }
+func (m *QueryFlatFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFlatFeesRequest) ProtoMessage()    {}
+func (*QueryFlatFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{74}
+}
+func (m *QueryFlatFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFlatFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFlatFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFlatFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFlatFeesRequest.Merge(m, src)
+}
+func (m *QueryFlatFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFlatFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFlatFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFlatFeesRequest proto.InternalMessageInfo
+
+func (m *QueryFlatFeesRequest) GetContractAddresses() []string {
+	if m != nil {
+		return m.ContractAddresses
+	}
+	return nil
+}
+
+// QueryFlatFeesResponse is the response for Query.FlatFees.
+type QueryFlatFeesResponse struct {
+	// flat_fees are the contracts flat fees (in the request order).
+	FlatFees []ContractFlatFee `protobuf:"bytes,1,rep,name=flat_fees,json=flatFees,proto3" json:"flat_fees"`
+}
+
+func (m *QueryFlatFeesResponse) Reset()         { *m = QueryFlatFeesResponse{} }
+func (m *QueryFlatFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFlatFeesResponse) ProtoMessage()    {}
+func (*QueryFlatFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{75}
+}
+func (m *QueryFlatFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFlatFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFlatFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFlatFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFlatFeesResponse.Merge(m, src)
+}
+func (m *QueryFlatFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFlatFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFlatFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFlatFeesResponse proto.InternalMessageInfo
+
+func (m *QueryFlatFeesResponse) GetFlatFees() []ContractFlatFee {
+	if m != nil {
+		return m.FlatFees
+	}
+	return nil
+}
+
+// ContractFlatFee defines the current flat fee of a contract.
+type ContractFlatFee struct {
+	// contract_address is the contract address (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// flat_fee is the flat fee charged per contract execution.
+	FlatFee types.Coin `protobuf:"bytes,2,opt,name=flat_fee,json=flatFee,proto3" json:"flat_fee"`
+}
+
+func (m *ContractFlatFee) Reset()         { *m = ContractFlatFee{} }
+func (m *ContractFlatFee) String() string { return proto.CompactTextString(m) }
+func (*ContractFlatFee) ProtoMessage()    {}
+func (*ContractFlatFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{76}
+}
+func (m *ContractFlatFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractFlatFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractFlatFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractFlatFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractFlatFee.Merge(m, src)
+}
+func (m *ContractFlatFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractFlatFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractFlatFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractFlatFee proto.InternalMessageInfo
+
+func (m *ContractFlatFee) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *ContractFlatFee) GetFlatFee() types.Coin {
+	if m != nil {
+		return m.FlatFee
+	}
+	return types.Coin{}
+}
+
+// QueryFlatFeeWithPendingChangeRequest is the request for
+// Query.FlatFeeWithPendingChange.
+type QueryFlatFeeWithPendingChangeRequest struct {
+	// contract_address is the contract address (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (m *QueryFlatFeeWithPendingChangeRequest) Reset()         { *m = QueryFlatFeeWithPendingChangeRequest{} }
+func (m *QueryFlatFeeWithPendingChangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFlatFeeWithPendingChangeRequest) ProtoMessage()    {}
+func (*QueryFlatFeeWithPendingChangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{77}
+}
+func (m *QueryFlatFeeWithPendingChangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFlatFeeWithPendingChangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFlatFeeWithPendingChangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFlatFeeWithPendingChangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFlatFeeWithPendingChangeRequest.Merge(m, src)
+}
+func (m *QueryFlatFeeWithPendingChangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFlatFeeWithPendingChangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFlatFeeWithPendingChangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFlatFeeWithPendingChangeRequest proto.InternalMessageInfo
+
+func (m *QueryFlatFeeWithPendingChangeRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+// QueryFlatFeeWithPendingChangeResponse is the response for
+// Query.FlatFeeWithPendingChange.
+type QueryFlatFeeWithPendingChangeResponse struct {
+	// flat_fee is the flat fee charged per contract execution at the current
+	// block height (zero if the fee schedule starts from zero).
+	FlatFee types.Coin `protobuf:"bytes,1,opt,name=flat_fee,json=flatFee,proto3" json:"flat_fee"`
+	// pending_change is the scheduled flat fee change not reached yet (nil if
+	// there is none).
+	PendingChange *FlatFeePendingChange `protobuf:"bytes,2,opt,name=pending_change,json=pendingChange,proto3" json:"pending_change,omitempty"`
+}
+
+func (m *QueryFlatFeeWithPendingChangeResponse) Reset()         { *m = QueryFlatFeeWithPendingChangeResponse{} }
+func (m *QueryFlatFeeWithPendingChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFlatFeeWithPendingChangeResponse) ProtoMessage()    {}
+func (*QueryFlatFeeWithPendingChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{78}
+}
+func (m *QueryFlatFeeWithPendingChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFlatFeeWithPendingChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFlatFeeWithPendingChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFlatFeeWithPendingChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFlatFeeWithPendingChangeResponse.Merge(m, src)
+}
+func (m *QueryFlatFeeWithPendingChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFlatFeeWithPendingChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFlatFeeWithPendingChangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFlatFeeWithPendingChangeResponse proto.InternalMessageInfo
+
+func (m *QueryFlatFeeWithPendingChangeResponse) GetFlatFee() types.Coin {
+	if m != nil {
+		return m.FlatFee
+	}
+	return types.Coin{}
+}
+
+func (m *QueryFlatFeeWithPendingChangeResponse) GetPendingChange() *FlatFeePendingChange {
+	if m != nil {
+		return m.PendingChange
+	}
+	return nil
+}
+
+// FlatFeePendingChange defines a scheduled contract flat fee change.
+type FlatFeePendingChange struct {
+	// flat_fee is the flat fee the contract fee is changing to.
+	FlatFee types.Coin `protobuf:"bytes,1,opt,name=flat_fee,json=flatFee,proto3" json:"flat_fee"`
+	// activation_height is the block height the flat_fee is charged from.
+	ActivationHeight int64 `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// start_height is the block height the fee starts changing at (the fee
+	// changes linearly up to the activation_height).
+	StartHeight int64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+}
+
+func (m *FlatFeePendingChange) Reset()         { *m = FlatFeePendingChange{} }
+func (m *FlatFeePendingChange) String() string { return proto.CompactTextString(m) }
+func (*FlatFeePendingChange) ProtoMessage()    {}
+func (*FlatFeePendingChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{79}
+}
+func (m *FlatFeePendingChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FlatFeePendingChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FlatFeePendingChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FlatFeePendingChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlatFeePendingChange.Merge(m, src)
+}
+func (m *FlatFeePendingChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *FlatFeePendingChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlatFeePendingChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlatFeePendingChange proto.InternalMessageInfo
+
+func (m *FlatFeePendingChange) GetFlatFee() types.Coin {
+	if m != nil {
+		return m.FlatFee
+	}
+	return types.Coin{}
+}
+
+func (m *FlatFeePendingChange) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func (m *FlatFeePendingChange) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+// QueryWithdrawMinBalanceRequest is the request for Query.WithdrawMinBalance.
+type QueryWithdrawMinBalanceRequest struct {
+	// rewards_address is the target address to withdraw the rewards for (bech32
+	// encoded).
+	RewardsAddress string `protobuf:"bytes,1,opt,name=rewards_address,json=rewardsAddress,proto3" json:"rewards_address,omitempty"`
+	// gas_limit is an optional withdraw transaction gas limit (estimated by the
+	// withdrawn records number if not set).
+	GasLimit uint64 `protobuf:"varint,2,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *QueryWithdrawMinBalanceRequest) Reset()         { *m = QueryWithdrawMinBalanceRequest{} }
+func (m *QueryWithdrawMinBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWithdrawMinBalanceRequest) ProtoMessage()    {}
+func (*QueryWithdrawMinBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{80}
+}
+func (m *QueryWithdrawMinBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWithdrawMinBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWithdrawMinBalanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWithdrawMinBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWithdrawMinBalanceRequest.Merge(m, src)
+}
+func (m *QueryWithdrawMinBalanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWithdrawMinBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWithdrawMinBalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWithdrawMinBalanceRequest proto.InternalMessageInfo
+
+func (m *QueryWithdrawMinBalanceRequest) GetRewardsAddress() string {
+	if m != nil {
+		return m.RewardsAddress
+	}
+	return ""
+}
+
+func (m *QueryWithdrawMinBalanceRequest) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+// QueryWithdrawMinBalanceResponse is the response for
+// Query.WithdrawMinBalance.
+type QueryWithdrawMinBalanceResponse struct {
+	// records_num is the number of RewardsRecord objects a withdraw-all
+	// transaction would process (limited by the MaxWithdrawRecords param).
+	RecordsNum uint64 `protobuf:"varint,1,opt,name=records_num,json=recordsNum,proto3" json:"records_num,omitempty"`
+	// gas_limit is the withdraw transaction gas limit the min fee is estimated
+	// for.
+	GasLimit uint64 `protobuf:"varint,2,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// min_balance is the min fee of the withdraw transaction (contract flat fees
+	// are not charged for the withdrawals, the tx size surcharge is excluded).
+	MinBalance []types.Coin `protobuf:"bytes,3,rep,name=min_balance,json=minBalance,proto3" json:"min_balance"`
+	// sufficient defines whether the rewards address balance covers the
+	// min_balance.
+	Sufficient bool `protobuf:"varint,4,opt,name=sufficient,proto3" json:"sufficient,omitempty"`
+}
+
+func (m *QueryWithdrawMinBalanceResponse) Reset()         { *m = QueryWithdrawMinBalanceResponse{} }
+func (m *QueryWithdrawMinBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWithdrawMinBalanceResponse) ProtoMessage()    {}
+func (*QueryWithdrawMinBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{81}
+}
+func (m *QueryWithdrawMinBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWithdrawMinBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWithdrawMinBalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWithdrawMinBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWithdrawMinBalanceResponse.Merge(m, src)
+}
+func (m *QueryWithdrawMinBalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWithdrawMinBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWithdrawMinBalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWithdrawMinBalanceResponse proto.InternalMessageInfo
+
+func (m *QueryWithdrawMinBalanceResponse) GetRecordsNum() uint64 {
+	if m != nil {
+		return m.RecordsNum
+	}
+	return 0
+}
+
+func (m *QueryWithdrawMinBalanceResponse) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *QueryWithdrawMinBalanceResponse) GetMinBalance() []types.Coin {
+	if m != nil {
+		return m.MinBalance
+	}
+	return nil
+}
+
+func (m *QueryWithdrawMinBalanceResponse) GetSufficient() bool {
+	if m != nil {
+		return m.Sufficient
+	}
+	return false
+}
+
+// QueryContractRewardsHistoryRequest is the request for
+// Query.ContractRewardsHistory.
+type QueryContractRewardsHistoryRequest struct {
+	// contract_address is the contract address (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// from_height defines the first block height of the range (inclusive).
+	FromHeight int64 `protobuf:"varint,2,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// to_height defines the last block height of the range (inclusive).
+	ToHeight int64 `protobuf:"varint,3,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	// pagination is an optional pagination options for the request (only the
+	// key based pagination is supported).
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryContractRewardsHistoryRequest) Reset()         { *m = QueryContractRewardsHistoryRequest{} }
+func (m *QueryContractRewardsHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractRewardsHistoryRequest) ProtoMessage()    {}
+func (*QueryContractRewardsHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{82}
+}
+func (m *QueryContractRewardsHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractRewardsHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractRewardsHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractRewardsHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractRewardsHistoryRequest.Merge(m, src)
+}
+func (m *QueryContractRewardsHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractRewardsHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractRewardsHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractRewardsHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryContractRewardsHistoryRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *QueryContractRewardsHistoryRequest) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *QueryContractRewardsHistoryRequest) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *QueryContractRewardsHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryContractRewardsHistoryResponse is the response for
+// Query.ContractRewardsHistory.
+type QueryContractRewardsHistoryResponse struct {
+	// rows is the list of the contract distributions (ordered by height).
+	Rows []ContractRewardsHistoryRow `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows"`
+	// pagination is the pagination details in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryContractRewardsHistoryResponse) Reset()         { *m = QueryContractRewardsHistoryResponse{} }
+func (m *QueryContractRewardsHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractRewardsHistoryResponse) ProtoMessage()    {}
+func (*QueryContractRewardsHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{83}
+}
+func (m *QueryContractRewardsHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractRewardsHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractRewardsHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractRewardsHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractRewardsHistoryResponse.Merge(m, src)
+}
+func (m *QueryContractRewardsHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractRewardsHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractRewardsHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractRewardsHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryContractRewardsHistoryResponse) GetRows() []ContractRewardsHistoryRow {
+	if m != nil {
+		return m.Rows
+	}
+	return nil
+}
+
+func (m *QueryContractRewardsHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// ContractRewardsHistoryRow defines the rewards and flat fees distributed for
+// a contract within a block. Fields are scalars, so a row maps to a CSV line.
+type ContractRewardsHistoryRow struct {
+	// height defines the block height.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// contract_address defines the contract address (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// rewards defines the inflation and fee rebate rewards distributed for the
+	// contract (coins string, empty if none).
+	Rewards string `protobuf:"bytes,3,opt,name=rewards,proto3" json:"rewards,omitempty"`
+	// flat_fees defines the contract flat fees collected (coins string, empty if
+	// none).
+	FlatFees string `protobuf:"bytes,4,opt,name=flat_fees,json=flatFees,proto3" json:"flat_fees,omitempty"`
+}
+
+func (m *ContractRewardsHistoryRow) Reset()         { *m = ContractRewardsHistoryRow{} }
+func (m *ContractRewardsHistoryRow) String() string { return proto.CompactTextString(m) }
+func (*ContractRewardsHistoryRow) ProtoMessage()    {}
+func (*ContractRewardsHistoryRow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5094c979ac5beea0, []int{84}
+}
+func (m *ContractRewardsHistoryRow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractRewardsHistoryRow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractRewardsHistoryRow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractRewardsHistoryRow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractRewardsHistoryRow.Merge(m, src)
+}
+func (m *ContractRewardsHistoryRow) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractRewardsHistoryRow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractRewardsHistoryRow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractRewardsHistoryRow proto.InternalMessageInfo
+
+func (m *ContractRewardsHistoryRow) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ContractRewardsHistoryRow) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *ContractRewardsHistoryRow) GetRewards() string {
+	if m != nil {
+		return m.Rewards
+	}
+	return ""
+}
+
+func (m *ContractRewardsHistoryRow) GetFlatFees() string {
+	if m != nil {
+		return m.FlatFees
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "archway.rewards.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "archway.rewards.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryParamsMetadataRequest)(nil), "archway.rewards.v1.QueryParamsMetadataRequest")
+	proto.RegisterType((*QueryParamsMetadataResponse)(nil), "archway.rewards.v1.QueryParamsMetadataResponse")
+	proto.RegisterType((*ParamMetadata)(nil), "archway.rewards.v1.ParamMetadata")
+	proto.RegisterType((*QueryContractMetadataRequest)(nil), "archway.rewards.v1.QueryContractMetadataRequest")
+	proto.RegisterType((*QueryContractMetadataResponse)(nil), "archway.rewards.v1.QueryContractMetadataResponse")
+	proto.RegisterType((*QueryBlockRewardsTrackingRequest)(nil), "archway.rewards.v1.QueryBlockRewardsTrackingRequest")
+	proto.RegisterType((*QueryBlockRewardsTrackingResponse)(nil), "archway.rewards.v1.QueryBlockRewardsTrackingResponse")
+	proto.RegisterType((*QueryBlockRewardsTrackingRangeRequest)(nil), "archway.rewards.v1.QueryBlockRewardsTrackingRangeRequest")
+	proto.RegisterType((*QueryBlockRewardsTrackingRangeResponse)(nil), "archway.rewards.v1.QueryBlockRewardsTrackingRangeResponse")
+	proto.RegisterType((*QueryRewardsPoolRequest)(nil), "archway.rewards.v1.QueryRewardsPoolRequest")
+	proto.RegisterType((*QueryRewardsPoolResponse)(nil), "archway.rewards.v1.QueryRewardsPoolResponse")
+	proto.RegisterType((*QueryEstimateTxFeesRequest)(nil), "archway.rewards.v1.QueryEstimateTxFeesRequest")
+	proto.RegisterType((*QueryEstimateTxFeesResponse)(nil), "archway.rewards.v1.QueryEstimateTxFeesResponse")
+	proto.RegisterType((*QueryEstimateTxFeesForContractsRequest)(nil), "archway.rewards.v1.QueryEstimateTxFeesForContractsRequest")
+	proto.RegisterType((*QueryEstimateTxFeesForContractsResponse)(nil), "archway.rewards.v1.QueryEstimateTxFeesForContractsResponse")
+	proto.RegisterType((*QueryFlatFeeBreakEvenRequest)(nil), "archway.rewards.v1.QueryFlatFeeBreakEvenRequest")
+	proto.RegisterType((*QueryFlatFeeBreakEvenResponse)(nil), "archway.rewards.v1.QueryFlatFeeBreakEvenResponse")
+	proto.RegisterType((*QueryWouldAcceptFeeRequest)(nil), "archway.rewards.v1.QueryWouldAcceptFeeRequest")
+	proto.RegisterType((*QueryWouldAcceptFeeResponse)(nil), "archway.rewards.v1.QueryWouldAcceptFeeResponse")
+	proto.RegisterType((*QueryTxFeeSplitRequest)(nil), "archway.rewards.v1.QueryTxFeeSplitRequest")
+	proto.RegisterType((*QueryTxFeeSplitResponse)(nil), "archway.rewards.v1.QueryTxFeeSplitResponse")
+	proto.RegisterType((*ContractFlatFeeSplit)(nil), "archway.rewards.v1.ContractFlatFeeSplit")
+	proto.RegisterType((*BlockTracking)(nil), "archway.rewards.v1.BlockTracking")
+	proto.RegisterType((*QueryRewardsRecordsRequest)(nil), "archway.rewards.v1.QueryRewardsRecordsRequest")
+	proto.RegisterType((*QueryRewardsRecordsResponse)(nil), "archway.rewards.v1.QueryRewardsRecordsResponse")
+	proto.RegisterType((*QueryOutstandingRewardsRequest)(nil), "archway.rewards.v1.QueryOutstandingRewardsRequest")
+	proto.RegisterType((*QueryOutstandingRewardsResponse)(nil), "archway.rewards.v1.QueryOutstandingRewardsResponse")
+	proto.RegisterType((*QueryFlatFeeRequest)(nil), "archway.rewards.v1.QueryFlatFeeRequest")
+	proto.RegisterType((*QueryFlatFeeResponse)(nil), "archway.rewards.v1.QueryFlatFeeResponse")
+	proto.RegisterType((*QueryTxFeeDistributionRequest)(nil), "archway.rewards.v1.QueryTxFeeDistributionRequest")
+	proto.RegisterType((*QueryTxFeeDistributionResponse)(nil), "archway.rewards.v1.QueryTxFeeDistributionResponse")
+	proto.RegisterType((*QueryRewardsRatiosRequest)(nil), "archway.rewards.v1.QueryRewardsRatiosRequest")
+	proto.RegisterType((*QueryRewardsRatiosResponse)(nil), "archway.rewards.v1.QueryRewardsRatiosResponse")
+	proto.RegisterType((*QueryDistributionConfigRequest)(nil), "archway.rewards.v1.QueryDistributionConfigRequest")
+	proto.RegisterType((*QueryDistributionConfigResponse)(nil), "archway.rewards.v1.QueryDistributionConfigResponse")
+	proto.RegisterType((*QueryRewardsRecordByIDRequest)(nil), "archway.rewards.v1.QueryRewardsRecordByIDRequest")
+	proto.RegisterType((*QueryRewardsRecordByIDResponse)(nil), "archway.rewards.v1.QueryRewardsRecordByIDResponse")
+	proto.RegisterType((*QueryContractMetadataCountRequest)(nil), "archway.rewards.v1.QueryContractMetadataCountRequest")
+	proto.RegisterType((*QueryContractMetadataCountResponse)(nil), "archway.rewards.v1.QueryContractMetadataCountResponse")
+	proto.RegisterType((*QueryContractsByCodeIDRequest)(nil), "archway.rewards.v1.QueryContractsByCodeIDRequest")
+	proto.RegisterType((*QueryContractsByCodeIDResponse)(nil), "archway.rewards.v1.QueryContractsByCodeIDResponse")
+	proto.RegisterType((*QueryMinConsensusFeeDebugRequest)(nil), "archway.rewards.v1.QueryMinConsensusFeeDebugRequest")
+	proto.RegisterType((*QueryMinConsensusFeeDebugResponse)(nil), "archway.rewards.v1.QueryMinConsensusFeeDebugResponse")
+	proto.RegisterType((*QueryTopContractsByRewardsRequest)(nil), "archway.rewards.v1.QueryTopContractsByRewardsRequest")
+	proto.RegisterType((*QueryTopContractsByRewardsResponse)(nil), "archway.rewards.v1.QueryTopContractsByRewardsResponse")
+	proto.RegisterType((*QueryContractRewardsEligibilityRequest)(nil), "archway.rewards.v1.QueryContractRewardsEligibilityRequest")
+	proto.RegisterType((*QueryContractRewardsEligibilityResponse)(nil), "archway.rewards.v1.QueryContractRewardsEligibilityResponse")
+	proto.RegisterType((*QueryMsgTypeFlatFeeRequest)(nil), "archway.rewards.v1.QueryMsgTypeFlatFeeRequest")
+	proto.RegisterType((*QueryMsgTypeFlatFeeResponse)(nil), "archway.rewards.v1.QueryMsgTypeFlatFeeResponse")
+	proto.RegisterType((*QueryTotalPendingRewardsRequest)(nil), "archway.rewards.v1.QueryTotalPendingRewardsRequest")
+	proto.RegisterType((*QueryTotalPendingRewardsResponse)(nil), "archway.rewards.v1.QueryTotalPendingRewardsResponse")
+	proto.RegisterType((*QueryRewardsRecordsByAddressAndHeightRangeRequest)(nil), "archway.rewards.v1.QueryRewardsRecordsByAddressAndHeightRangeRequest")
+	proto.RegisterType((*QueryRewardsRecordsByAddressAndHeightRangeResponse)(nil), "archway.rewards.v1.QueryRewardsRecordsByAddressAndHeightRangeResponse")
+	proto.RegisterType((*QueryEstimateTxFeesForSimulatedGasRequest)(nil), "archway.rewards.v1.QueryEstimateTxFeesForSimulatedGasRequest")
+	proto.RegisterType((*QueryEstimateTxFeesForSimulatedGasResponse)(nil), "archway.rewards.v1.QueryEstimateTxFeesForSimulatedGasResponse")
+	proto.RegisterType((*QueryProjectedMinConsensusFeeRequest)(nil), "archway.rewards.v1.QueryProjectedMinConsensusFeeRequest")
+	proto.RegisterType((*QueryProjectedMinConsensusFeeResponse)(nil), "archway.rewards.v1.QueryProjectedMinConsensusFeeResponse")
+	proto.RegisterType((*QueryContractFlatFeeRevenueRequest)(nil), "archway.rewards.v1.QueryContractFlatFeeRevenueRequest")
+	proto.RegisterType((*QueryContractFlatFeeRevenueResponse)(nil), "archway.rewards.v1.QueryContractFlatFeeRevenueResponse")
+	proto.RegisterType((*QueryRewardsPoolSolvencyRequest)(nil), "archway.rewards.v1.QueryRewardsPoolSolvencyRequest")
+	proto.RegisterType((*QueryRewardsPoolSolvencyResponse)(nil), "archway.rewards.v1.QueryRewardsPoolSolvencyResponse")
+	proto.RegisterType((*QueryAcceptedFeeDenomsRequest)(nil), "archway.rewards.v1.QueryAcceptedFeeDenomsRequest")
+	proto.RegisterType((*QueryAcceptedFeeDenomsResponse)(nil), "archway.rewards.v1.QueryAcceptedFeeDenomsResponse")
+	proto.RegisterType((*QueryBlockPoolInflowsRequest)(nil), "archway.rewards.v1.QueryBlockPoolInflowsRequest")
+	proto.RegisterType((*QueryBlockPoolInflowsResponse)(nil), "archway.rewards.v1.QueryBlockPoolInflowsResponse")
+	proto.RegisterType((*QueryReconcileRewardsRequest)(nil), "archway.rewards.v1.QueryReconcileRewardsRequest")
+	proto.RegisterType((*QueryReconcileRewardsResponse)(nil), "archway.rewards.v1.QueryReconcileRewardsResponse")
+	proto.RegisterType((*QueryTxFeeEstimateRequest)(nil), "archway.rewards.v1.QueryTxFeeEstimateRequest")
+	proto.RegisterType((*QueryTxFeeEstimateResponse)(nil), "archway.rewards.v1.QueryTxFeeEstimateResponse")
+	proto.RegisterType((*QueryBlockFlatFeeTxsRequest)(nil), "archway.rewards.v1.QueryBlockFlatFeeTxsRequest")
+	proto.RegisterType((*QueryBlockFlatFeeTxsResponse)(nil), "archway.rewards.v1.QueryBlockFlatFeeTxsResponse")
+	proto.RegisterType((*BlockFlatFeeTx)(nil), "archway.rewards.v1.BlockFlatFeeTx")
+	proto.RegisterType((*QueryFlatFeesRequest)(nil), "archway.rewards.v1.QueryFlatFeesRequest")
+	proto.RegisterType((*QueryFlatFeesResponse)(nil), "archway.rewards.v1.QueryFlatFeesResponse")
+	proto.RegisterType((*ContractFlatFee)(nil), "archway.rewards.v1.ContractFlatFee")
+	proto.RegisterType((*QueryFlatFeeWithPendingChangeRequest)(nil), "archway.rewards.v1.QueryFlatFeeWithPendingChangeRequest")
+	proto.RegisterType((*QueryFlatFeeWithPendingChangeResponse)(nil), "archway.rewards.v1.QueryFlatFeeWithPendingChangeResponse")
+	proto.RegisterType((*FlatFeePendingChange)(nil), "archway.rewards.v1.FlatFeePendingChange")
+	proto.RegisterType((*QueryWithdrawMinBalanceRequest)(nil), "archway.rewards.v1.QueryWithdrawMinBalanceRequest")
+	proto.RegisterType((*QueryWithdrawMinBalanceResponse)(nil), "archway.rewards.v1.QueryWithdrawMinBalanceResponse")
+	proto.RegisterType((*QueryContractRewardsHistoryRequest)(nil), "archway.rewards.v1.QueryContractRewardsHistoryRequest")
+	proto.RegisterType((*QueryContractRewardsHistoryResponse)(nil), "archway.rewards.v1.QueryContractRewardsHistoryResponse")
+	proto.RegisterType((*ContractRewardsHistoryRow)(nil), "archway.rewards.v1.ContractRewardsHistoryRow")
+}
+
+func init() { proto.RegisterFile("archway/rewards/v1/query.proto", fileDescriptor_5094c979ac5beea0) }
+
+var fileDescriptor_5094c979ac5beea0 = []byte{
+	// 4073 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5c, 0x5b, 0x8c, 0x1c, 0x57,
+	0x5a, 0x4e, 0xf5, 0x8c, 0xe7, 0xf2, 0xcf, 0xfd, 0x78, 0x62, 0x8f, 0xcb, 0xf6, 0xcc, 0xb8, 0x7c,
+	0x1b, 0xdf, 0xa6, 0x33, 0xe3, 0x4b, 0x62, 0x87, 0x84, 0x9d, 0xb1, 0x3d, 0x8e, 0x49, 0xb2, 0x71,
+	0xda, 0x0e, 0x41, 0xbc, 0xd4, 0xd6, 0x74, 0x9f, 0xe9, 0xae, 0x75, 0x77, 0x55, 0x6f, 0x55, 0xf5,
+	0x4c, 0xcf, 0x0a, 0x24, 0xb2, 0x0f, 0x08, 0x1e, 0x56, 0x20, 0x2e, 0x02, 0xb1, 0x08, 0x10, 0x42,
+	0xb0, 0xdc, 0x1f, 0x88, 0xb4, 0x48, 0xac, 0x60, 0x11, 0x0f, 0x64, 0x25, 0x24, 0x16, 0x10, 0x12,
+	0x42, 0x28, 0x42, 0x09, 0x2f, 0x48, 0xbc, 0x21, 0x90, 0x78, 0x43, 0xe7, 0x9c, 0xff, 0x54, 0x57,
+	0x55, 0x57, 0x55, 0x9f, 0x6a, 0x1b, 0xd6, 0x4f, 0xee, 0x3a, 0x75, 0xfe, 0xff, 0x7c, 0xe7, 0xaf,
+	0x73, 0xfe, 0xfb, 0x18, 0x96, 0x2d, 0xaf, 0xda, 0x38, 0xb0, 0x0e, 0xcb, 0x1e, 0x3d, 0xb0, 0xbc,
+	0x9a, 0x5f, 0xde, 0xdf, 0x28, 0x7f, 0xa5, 0x43, 0xbd, 0xc3, 0xf5, 0xb6, 0xe7, 0x06, 0x2e, 0x21,
+	0xf8, 0x7e, 0x1d, 0xdf, 0xaf, 0xef, 0x6f, 0xe8, 0x8b, 0x75, 0xb7, 0xee, 0xf2, 0xd7, 0x65, 0xf6,
+	0x4b, 0xcc, 0xd4, 0x4f, 0xd5, 0x5d, 0xb7, 0xde, 0xa4, 0x65, 0xab, 0x6d, 0x97, 0x2d, 0xc7, 0x71,
+	0x03, 0x2b, 0xb0, 0x5d, 0xc7, 0xc7, 0xb7, 0xcb, 0x55, 0xd7, 0x6f, 0xb9, 0x7e, 0x79, 0xd7, 0xf2,
+	0x69, 0x79, 0x7f, 0x63, 0x97, 0x06, 0xd6, 0x46, 0xb9, 0xea, 0xda, 0x0e, 0xbe, 0x3f, 0x21, 0xde,
+	0x9b, 0x82, 0xad, 0x78, 0xc0, 0x57, 0x97, 0xa3, 0xa4, 0x1c, 0x5b, 0xc8, 0xa0, 0x6d, 0xd5, 0x6d,
+	0x87, 0xaf, 0x83, 0x73, 0x57, 0x53, 0xb6, 0x23, 0x91, 0xf3, 0x19, 0xc6, 0x22, 0x90, 0xf7, 0x19,
+	0x8f, 0x47, 0x96, 0x67, 0xb5, 0xfc, 0x0a, 0xfd, 0x4a, 0x87, 0xfa, 0x81, 0xf1, 0x1e, 0x1c, 0x8d,
+	0x8d, 0xfa, 0x6d, 0xd7, 0xf1, 0x29, 0x79, 0x0d, 0xc6, 0xda, 0x7c, 0x64, 0x49, 0x5b, 0xd5, 0xd6,
+	0xa6, 0x36, 0xf5, 0xf5, 0x7e, 0x71, 0xac, 0x0b, 0x9a, 0xed, 0xd1, 0x4f, 0x3e, 0x5d, 0x79, 0xa9,
+	0x82, 0xf3, 0x8d, 0x53, 0xa0, 0x47, 0x18, 0xbe, 0x4b, 0x03, 0xab, 0x66, 0x05, 0x96, 0x5c, 0xee,
+	0x57, 0x35, 0x38, 0x99, 0xfa, 0xfa, 0x59, 0xd7, 0x25, 0x77, 0x61, 0xa2, 0x85, 0xdc, 0x96, 0x4a,
+	0xab, 0x23, 0x6b, 0x53, 0x9b, 0x67, 0x32, 0x69, 0xe5, 0xb2, 0xc8, 0x22, 0x24, 0x34, 0xbe, 0xab,
+	0xc1, 0x4c, 0x6c, 0x06, 0x21, 0x30, 0xea, 0x58, 0x2d, 0xca, 0xe1, 0x4c, 0x56, 0xf8, 0x6f, 0x36,
+	0x16, 0x1c, 0xb6, 0xe9, 0x52, 0x49, 0x8c, 0xb1, 0xdf, 0x64, 0x1e, 0x46, 0x5a, 0xb6, 0xb3, 0x34,
+	0xc2, 0x87, 0xd8, 0x4f, 0x3e, 0x62, 0x75, 0x97, 0x46, 0x71, 0xc4, 0xea, 0x92, 0xb3, 0x30, 0xd3,
+	0xb2, 0xba, 0x26, 0xed, 0x56, 0x9b, 0x1d, 0xdf, 0xde, 0xa7, 0x4b, 0x47, 0x56, 0xb5, 0xb5, 0x89,
+	0xca, 0x74, 0xcb, 0xea, 0xde, 0x97, 0x63, 0xe4, 0x3c, 0xcc, 0x5a, 0xcd, 0xa6, 0x7b, 0x40, 0x6b,
+	0xe6, 0xbe, 0xd5, 0xec, 0x50, 0x7f, 0x69, 0x6c, 0x75, 0x64, 0x6d, 0xb2, 0x32, 0x83, 0xa3, 0x3f,
+	0xcc, 0x07, 0xc9, 0x2a, 0x4c, 0x55, 0x5d, 0xc7, 0x0f, 0x3c, 0xcb, 0x76, 0x02, 0x7f, 0x69, 0x9c,
+	0xaf, 0x12, 0x1d, 0x32, 0x1e, 0xc2, 0x29, 0x2e, 0xe9, 0xbb, 0xae, 0x13, 0x78, 0x56, 0x35, 0x48,
+	0x7c, 0x0a, 0x72, 0x09, 0xe6, 0xab, 0xf8, 0xca, 0xb4, 0x6a, 0x35, 0x8f, 0xfa, 0x3e, 0xee, 0x72,
+	0x4e, 0x8e, 0x6f, 0x89, 0x61, 0xa3, 0x0e, 0xa7, 0x33, 0x58, 0xe1, 0x67, 0xdb, 0x89, 0x08, 0x5f,
+	0x7c, 0xb8, 0x73, 0x69, 0xc2, 0x4f, 0xd2, 0xf7, 0xc9, 0xdf, 0x80, 0x55, 0xbe, 0xd0, 0x76, 0xd3,
+	0xad, 0x3e, 0xad, 0x08, 0xc2, 0x27, 0x9e, 0x55, 0x7d, 0x6a, 0x3b, 0x75, 0x79, 0x84, 0x76, 0xe1,
+	0x4c, 0xce, 0x1c, 0x04, 0xf4, 0x06, 0x1c, 0xd9, 0x65, 0xef, 0x11, 0x4d, 0xea, 0x51, 0xe0, 0x0c,
+	0x24, 0x25, 0x42, 0x11, 0x54, 0x06, 0x85, 0xf3, 0xd9, 0x6b, 0x58, 0x4e, 0x9d, 0x4a, 0x21, 0xae,
+	0xc0, 0xd4, 0x9e, 0xe7, 0xb6, 0xcc, 0x06, 0xb5, 0xeb, 0x8d, 0x80, 0xaf, 0x36, 0x52, 0x01, 0x36,
+	0xf4, 0x16, 0x1f, 0x21, 0x27, 0x61, 0x32, 0x70, 0xe5, 0xeb, 0x12, 0x7f, 0x3d, 0x11, 0xb8, 0xe2,
+	0xa5, 0x61, 0xc3, 0x85, 0x41, 0xcb, 0xe0, 0x7e, 0x7e, 0x10, 0xc6, 0x38, 0x32, 0xf6, 0x89, 0x46,
+	0x8a, 0x6c, 0x08, 0xc9, 0x8c, 0x13, 0x70, 0x9c, 0x2f, 0x85, 0xab, 0x3c, 0x72, 0xdd, 0xa6, 0x14,
+	0xe8, 0xc7, 0x1a, 0x2c, 0xf5, 0xbf, 0xc3, 0x85, 0x1f, 0xc1, 0xd1, 0x8e, 0x53, 0xb3, 0xfd, 0xc0,
+	0xb3, 0x77, 0x3b, 0x01, 0xad, 0x99, 0x7b, 0x1d, 0xa7, 0x26, 0x51, 0x9c, 0x58, 0x47, 0x7d, 0xc5,
+	0x34, 0xd4, 0x3a, 0xea, 0xa6, 0xf5, 0xbb, 0xae, 0xed, 0xe0, 0xea, 0x24, 0x46, 0xbb, 0xc3, 0x48,
+	0xc9, 0x0e, 0xcc, 0x06, 0x1e, 0xb5, 0xfc, 0x8e, 0x77, 0x88, 0xcc, 0x4a, 0x6a, 0xcc, 0x66, 0x24,
+	0x19, 0xe7, 0x63, 0xd4, 0x50, 0xd1, 0xdc, 0xf7, 0x03, 0xbb, 0x65, 0x05, 0xf4, 0x49, 0x77, 0x87,
+	0x52, 0xa9, 0xd7, 0x98, 0xdc, 0xeb, 0x96, 0x6f, 0x36, 0xed, 0x96, 0x2d, 0x3e, 0xcb, 0x68, 0x65,
+	0xa2, 0x6e, 0xf9, 0xef, 0xb0, 0xe7, 0xd4, 0xa3, 0x5f, 0x4a, 0x3f, 0xfa, 0x7f, 0x28, 0x15, 0x56,
+	0x72, 0x19, 0x94, 0xcf, 0x5b, 0x30, 0xcb, 0xd6, 0xe9, 0x38, 0x76, 0x60, 0xb6, 0x3d, 0xbb, 0x4a,
+	0xf1, 0xc4, 0x9d, 0x4a, 0xdd, 0xcd, 0x3d, 0x5a, 0x8d, 0x6c, 0x68, 0xba, 0x6e, 0xf9, 0x1f, 0x38,
+	0x76, 0xf0, 0x88, 0xd1, 0x91, 0x7b, 0x30, 0x43, 0x71, 0x8d, 0x9a, 0xb9, 0x47, 0xa9, 0xaa, 0x58,
+	0xa6, 0x43, 0xaa, 0x1d, 0x4a, 0x8d, 0xaf, 0x6b, 0x70, 0x21, 0x05, 0xef, 0x8e, 0xeb, 0xc9, 0xcb,
+	0xa7, 0x26, 0xa2, 0x6b, 0x40, 0x92, 0x22, 0xa2, 0xe2, 0x4b, 0x4d, 0x56, 0x16, 0x12, 0x42, 0xa2,
+	0x3e, 0x39, 0x0e, 0xe3, 0x41, 0xd7, 0xf4, 0xed, 0xaf, 0x52, 0xae, 0x02, 0x47, 0x2b, 0x63, 0x41,
+	0xf7, 0xb1, 0xfd, 0x55, 0x6a, 0xfc, 0x77, 0x09, 0x2e, 0x0e, 0xc4, 0xf3, 0x62, 0xca, 0x92, 0xfc,
+	0x00, 0x4c, 0xee, 0x35, 0xad, 0x80, 0x31, 0xf0, 0x97, 0x46, 0xd4, 0x38, 0x4c, 0x30, 0x0a, 0xb6,
+	0x43, 0x72, 0x07, 0x98, 0x34, 0x05, 0xf1, 0xa8, 0x1a, 0xf1, 0x78, 0xdd, 0xf2, 0x39, 0xed, 0x16,
+	0x4c, 0xa3, 0x38, 0x05, 0xfd, 0x11, 0x35, 0x7a, 0x10, 0x42, 0x67, 0x2c, 0x8c, 0x3d, 0x54, 0xff,
+	0x3b, 0x02, 0xcf, 0xb6, 0x47, 0xad, 0xa7, 0xf7, 0xf7, 0xa9, 0x53, 0x5c, 0xfd, 0xc7, 0x0f, 0x4a,
+	0x29, 0x7e, 0x50, 0x8c, 0xff, 0x2a, 0xc1, 0xe9, 0x8c, 0x85, 0x5e, 0xd0, 0xcf, 0x7a, 0x07, 0x26,
+	0xe4, 0x67, 0xe5, 0x87, 0x55, 0xe5, 0xc3, 0xe0, 0x57, 0x25, 0x1f, 0xc2, 0xac, 0xa4, 0x35, 0xfd,
+	0x86, 0xe5, 0x51, 0x61, 0xdf, 0xb7, 0x37, 0xd8, 0xb4, 0x7f, 0xfe, 0x74, 0xe5, 0xa4, 0x60, 0xe4,
+	0xd7, 0x9e, 0xae, 0xdb, 0x6e, 0xb9, 0x65, 0x05, 0x8d, 0xf5, 0x77, 0x68, 0xdd, 0xaa, 0x1e, 0xde,
+	0xa3, 0xd5, 0xbf, 0xff, 0xf8, 0x1a, 0xe0, 0x3a, 0xf7, 0x68, 0xb5, 0x32, 0x8d, 0x3c, 0x1f, 0x33,
+	0x36, 0xa4, 0x0c, 0x8b, 0xbb, 0x4c, 0x72, 0x26, 0xdd, 0xa7, 0x8e, 0xd9, 0x13, 0xf7, 0x11, 0x2e,
+	0xee, 0x85, 0x5d, 0x29, 0xd5, 0x07, 0x52, 0xee, 0xdf, 0xd0, 0x50, 0xff, 0x7d, 0xe8, 0x76, 0x9a,
+	0xb5, 0xad, 0x6a, 0x95, 0xb6, 0x19, 0x37, 0xa5, 0xcb, 0xbd, 0x01, 0x23, 0x05, 0xa4, 0xc7, 0xe6,
+	0x66, 0xe8, 0x83, 0x91, 0x0c, 0x7d, 0x60, 0x74, 0xe1, 0x64, 0x2a, 0x38, 0x3c, 0x12, 0x3a, 0x4c,
+	0x58, 0x7c, 0x90, 0xd6, 0x38, 0xb8, 0x89, 0x4a, 0xf8, 0x4c, 0xde, 0x80, 0x49, 0xbf, 0xe1, 0x7a,
+	0xc1, 0x9e, 0xd5, 0x6c, 0xaa, 0x42, 0xec, 0x51, 0x18, 0xbf, 0xa4, 0xc1, 0x31, 0xbe, 0x34, 0x57,
+	0x34, 0x8f, 0xdb, 0x4d, 0x3b, 0x78, 0x41, 0x64, 0xf2, 0x3f, 0x1a, 0x1c, 0xef, 0x43, 0xa6, 0x20,
+	0x90, 0xa8, 0x22, 0x29, 0x15, 0x54, 0x24, 0x6f, 0xf7, 0xab, 0xb0, 0xb5, 0x3c, 0xcf, 0x0c, 0x2f,
+	0x31, 0x07, 0xd7, 0xa7, 0xd1, 0x6e, 0xc3, 0xb8, 0xdf, 0xf1, 0xda, 0xcd, 0x8e, 0xba, 0x42, 0xc3,
+	0xf9, 0x46, 0x00, 0x8b, 0x69, 0x4b, 0x14, 0xd1, 0x42, 0xc5, 0x3f, 0x90, 0xf1, 0x4d, 0x0d, 0x66,
+	0x62, 0x4e, 0x11, 0x79, 0x0c, 0x0b, 0xb6, 0xc3, 0x36, 0x64, 0xbb, 0x8e, 0x89, 0xfb, 0x47, 0x75,
+	0xb4, 0x9a, 0xe9, 0x52, 0xa1, 0x5f, 0x84, 0x9c, 0xe7, 0x43, 0x06, 0x38, 0x4e, 0xb6, 0x01, 0x82,
+	0x6e, 0xc8, 0x4d, 0x00, 0x3c, 0x9d, 0xc6, 0xed, 0x49, 0x37, 0xce, 0x6a, 0x32, 0x90, 0x03, 0xc6,
+	0xd7, 0xe5, 0x75, 0xc6, 0x81, 0x0a, 0xad, 0xba, 0xfc, 0x1f, 0x71, 0x74, 0x2f, 0xc2, 0x1c, 0xf2,
+	0x49, 0x88, 0x69, 0x16, 0x87, 0xa5, 0x94, 0x76, 0x00, 0x7a, 0xb1, 0x21, 0x57, 0xd6, 0x53, 0x9b,
+	0x17, 0x62, 0xc2, 0x12, 0x41, 0xae, 0x14, 0xd9, 0x23, 0x2b, 0x74, 0x66, 0x2b, 0x11, 0x4a, 0xe3,
+	0x77, 0xa5, 0xdf, 0x93, 0xc4, 0x83, 0x07, 0x76, 0x0b, 0xc6, 0x3d, 0x31, 0x94, 0xe7, 0x91, 0xc6,
+	0x88, 0xe5, 0x99, 0x40, 0x3a, 0xf2, 0x20, 0x05, 0xea, 0xc5, 0x81, 0x50, 0xc5, 0xfa, 0x31, 0xac,
+	0x0f, 0x61, 0x99, 0x43, 0x7d, 0xaf, 0x13, 0xf8, 0x81, 0xe5, 0xd4, 0x78, 0x20, 0x80, 0x0b, 0x17,
+	0x13, 0x9f, 0xf1, 0x53, 0x1a, 0xac, 0x64, 0xf2, 0xc2, 0xad, 0xdf, 0x83, 0x99, 0xc0, 0x0d, 0xac,
+	0x66, 0xe4, 0xfc, 0xa8, 0x59, 0x21, 0x4e, 0x25, 0x0f, 0xcd, 0x0a, 0x4c, 0xa1, 0x20, 0x4c, 0xa7,
+	0xd3, 0x42, 0xb3, 0x0a, 0x38, 0xf4, 0xc5, 0x4e, 0xcb, 0xf8, 0x02, 0x46, 0xe6, 0x78, 0x5f, 0x86,
+	0x08, 0xdb, 0x4c, 0x58, 0x8c, 0x73, 0xc0, 0x0d, 0x3c, 0x80, 0xb9, 0xd0, 0x88, 0x59, 0x2d, 0xb7,
+	0xe3, 0x04, 0x78, 0x05, 0x06, 0xbb, 0xe0, 0xa8, 0x0b, 0xb6, 0x38, 0x95, 0xf1, 0x08, 0x4e, 0xf7,
+	0x14, 0xda, 0x3d, 0xe9, 0xe8, 0xf3, 0x9b, 0x21, 0xc0, 0x1e, 0x83, 0xb1, 0x58, 0x64, 0x84, 0x4f,
+	0xe8, 0x2e, 0x36, 0x2c, 0xbf, 0x81, 0x7e, 0xf7, 0x58, 0xd0, 0x7d, 0xcb, 0xf2, 0x1b, 0x86, 0x0f,
+	0xcb, 0x59, 0x1c, 0x11, 0xfc, 0xfb, 0x30, 0x53, 0x8b, 0x8c, 0x4b, 0xe9, 0x9f, 0x4f, 0xbf, 0x6f,
+	0x09, 0x2e, 0x72, 0x1b, 0x31, 0x0e, 0xc6, 0x49, 0x38, 0x11, 0x3b, 0xea, 0xec, 0x54, 0x85, 0x09,
+	0x92, 0x7f, 0x4f, 0x5e, 0x4c, 0x7c, 0x8b, 0x70, 0x6c, 0x38, 0xde, 0xa7, 0x50, 0x4c, 0x8f, 0x3d,
+	0x2e, 0x69, 0xc3, 0x7a, 0x06, 0x2f, 0x27, 0x35, 0x0c, 0x5f, 0x93, 0x7c, 0x09, 0x8e, 0x06, 0x5d,
+	0xfe, 0xd1, 0x3c, 0xba, 0x6b, 0x05, 0x14, 0x97, 0x29, 0x0d, 0xbb, 0xcc, 0x7c, 0xd0, 0xe5, 0xa7,
+	0x82, 0xf1, 0xe2, 0x2b, 0x18, 0xab, 0x28, 0xfd, 0xa8, 0xc8, 0xee, 0xba, 0xce, 0x9e, 0x1d, 0x06,
+	0xdf, 0x75, 0x58, 0xc9, 0x9c, 0x11, 0x5e, 0x8f, 0xb1, 0x2a, 0x1f, 0xc1, 0x43, 0x75, 0x21, 0xed,
+	0xcb, 0xf4, 0xd3, 0xcb, 0x78, 0x55, 0xd0, 0x1a, 0x65, 0x3c, 0x5a, 0x71, 0x0d, 0x72, 0xf8, 0xf0,
+	0x9e, 0x3c, 0x5a, 0xb3, 0x50, 0xb2, 0x6b, 0x68, 0xc5, 0x4b, 0x76, 0xcd, 0xb0, 0x60, 0x39, 0x8b,
+	0xa0, 0x17, 0x43, 0x8b, 0xeb, 0x95, 0x97, 0x14, 0x48, 0xd3, 0x58, 0x48, 0x66, 0x9c, 0xc5, 0xcc,
+	0x43, 0x32, 0x8d, 0x71, 0x97, 0x5d, 0x06, 0x29, 0xa1, 0x3b, 0x60, 0xe4, 0x4d, 0x42, 0x2c, 0x8b,
+	0x70, 0xa4, 0x1a, 0x5e, 0xbc, 0xd1, 0x8a, 0x78, 0x30, 0x7e, 0x42, 0x4b, 0x24, 0x5a, 0xfc, 0xed,
+	0xc3, 0xbb, 0x6e, 0x8d, 0xf6, 0x76, 0x7d, 0x1c, 0xc6, 0xab, 0x6e, 0x8d, 0x9a, 0xe1, 0xd6, 0xc7,
+	0xd8, 0xe3, 0xc3, 0xda, 0x73, 0xd3, 0xfb, 0xbf, 0xac, 0xc1, 0x72, 0x16, 0x04, 0xc4, 0x9e, 0xee,
+	0xf6, 0x68, 0x59, 0xa1, 0xe1, 0x73, 0x53, 0xf3, 0x77, 0x30, 0x39, 0xf4, 0xae, 0xcd, 0x8e, 0x8c,
+	0x4f, 0x1d, 0xbf, 0xe3, 0xb3, 0xfb, 0x4d, 0x77, 0x3b, 0xf5, 0x01, 0x0a, 0xc7, 0xf8, 0x97, 0x12,
+	0x9c, 0xc9, 0x21, 0xc6, 0x9d, 0xbd, 0x0d, 0x33, 0x3c, 0x5d, 0x32, 0xa4, 0x67, 0x30, 0xbd, 0x1b,
+	0x19, 0xfb, 0xbf, 0xbf, 0xae, 0xe4, 0x3e, 0x4c, 0x57, 0xdd, 0x56, 0xbb, 0x23, 0xa3, 0xa1, 0x11,
+	0xe5, 0xb0, 0x6a, 0x4a, 0xd2, 0xb1, 0x98, 0x66, 0x0b, 0xc0, 0x0f, 0x5c, 0x0f, 0x99, 0x8c, 0x2a,
+	0x33, 0x99, 0x14, 0x54, 0x2c, 0xeb, 0xf0, 0x3e, 0x4a, 0xf7, 0x89, 0xdb, 0x8e, 0x9c, 0x9b, 0x84,
+	0x11, 0x3e, 0x06, 0x63, 0x07, 0xb6, 0x53, 0x73, 0x0f, 0xe4, 0xd1, 0x15, 0x4f, 0xec, 0x2e, 0x44,
+	0x43, 0x4b, 0xf1, 0x60, 0xb4, 0xc0, 0xc8, 0x63, 0x19, 0x9a, 0xb2, 0x49, 0x79, 0xe2, 0xa4, 0x25,
+	0x38, 0x9b, 0xe7, 0xdf, 0x26, 0xfc, 0xaf, 0x90, 0xd6, 0x78, 0x8c, 0x69, 0x93, 0xc4, 0xc4, 0xfb,
+	0x4d, 0xbb, 0x6e, 0xef, 0xda, 0x4d, 0x3b, 0x38, 0x1c, 0xc2, 0x00, 0xff, 0xb5, 0x06, 0x17, 0x07,
+	0x72, 0xed, 0x45, 0x00, 0x94, 0x0f, 0x37, 0xa9, 0x8c, 0x00, 0xe4, 0x33, 0x39, 0x03, 0xd3, 0x0d,
+	0xcb, 0x37, 0x23, 0xf9, 0x6d, 0xf6, 0x7e, 0xaa, 0x61, 0x85, 0x09, 0x74, 0x72, 0x03, 0x8e, 0xb1,
+	0x29, 0xa1, 0x05, 0xa2, 0x55, 0xbb, 0x6d, 0x53, 0x96, 0x1a, 0x1e, 0xe1, 0x93, 0x17, 0x1b, 0x96,
+	0xdf, 0xd3, 0x6d, 0xf8, 0x2e, 0xea, 0x17, 0x51, 0xc7, 0xda, 0x6d, 0xd2, 0x1a, 0xff, 0xfe, 0x13,
+	0xa1, 0x5f, 0x74, 0x5f, 0x8c, 0x1a, 0x1f, 0x49, 0x2b, 0xf8, 0xae, 0x5f, 0x7f, 0x72, 0xd8, 0xa6,
+	0x09, 0xa7, 0x64, 0x15, 0xa6, 0x5b, 0x7e, 0xdd, 0x64, 0x99, 0x70, 0xb3, 0xe3, 0x35, 0x51, 0x1e,
+	0xd0, 0x12, 0x93, 0x3f, 0xf0, 0x9a, 0x05, 0x52, 0x6e, 0xec, 0x9c, 0xb4, 0x68, 0xd0, 0x70, 0x6b,
+	0x98, 0x4d, 0xc7, 0x27, 0xe3, 0x23, 0xe9, 0x92, 0x26, 0x31, 0xa0, 0x04, 0xa3, 0x71, 0xbd, 0x56,
+	0x30, 0xae, 0xbf, 0x00, 0x73, 0x62, 0x15, 0x33, 0x64, 0x21, 0x84, 0x3c, 0x23, 0x86, 0x71, 0x2d,
+	0xe3, 0x0c, 0xda, 0xbf, 0x27, 0xcc, 0x95, 0x7b, 0x44, 0x53, 0x7c, 0x4d, 0xe3, 0xcf, 0x35, 0x58,
+	0xcd, 0x9e, 0x13, 0xe6, 0x44, 0xe6, 0xda, 0xe2, 0x4d, 0x51, 0x2f, 0x72, 0xb6, 0x1d, 0xe3, 0x98,
+	0x95, 0xa0, 0x2d, 0x0d, 0x9d, 0xa0, 0x35, 0x3e, 0xd3, 0x60, 0x23, 0xc5, 0xf5, 0xdf, 0x3e, 0xc4,
+	0x0f, 0xb4, 0xe5, 0xd4, 0x44, 0xfe, 0x3a, 0x96, 0x09, 0x57, 0x8e, 0x50, 0x12, 0x29, 0xf3, 0x52,
+	0x7e, 0xca, 0x7c, 0x24, 0x9e, 0x32, 0x4f, 0xd8, 0xb9, 0xd1, 0xa1, 0xed, 0xdc, 0x77, 0x34, 0xd8,
+	0x2c, 0xb2, 0xc9, 0x17, 0x30, 0xec, 0xf9, 0x3d, 0x0d, 0x2e, 0xa5, 0xa7, 0x56, 0x1f, 0xdb, 0xad,
+	0x4e, 0xd3, 0x0a, 0x68, 0xed, 0x81, 0x15, 0x6a, 0xdf, 0xb3, 0x30, 0xe3, 0xcb, 0x61, 0x96, 0x5f,
+	0x42, 0x25, 0x3c, 0xed, 0x47, 0xe6, 0x92, 0x1f, 0x11, 0xa9, 0x3a, 0xab, 0xf6, 0xe5, 0x8e, 0x1f,
+	0xb4, 0xa8, 0x13, 0x0c, 0x6f, 0xae, 0x66, 0xea, 0x96, 0xbf, 0x15, 0xf2, 0x31, 0xbe, 0x5d, 0x82,
+	0xcb, 0x2a, 0x60, 0x9f, 0x7b, 0xce, 0xf0, 0x2a, 0x10, 0xb1, 0x1d, 0xb1, 0xed, 0x58, 0x16, 0x73,
+	0x5e, 0xbe, 0x91, 0x59, 0x35, 0xf2, 0x36, 0x2c, 0xc4, 0xa4, 0x84, 0x76, 0x55, 0xe9, 0x2e, 0xcd,
+	0x45, 0x45, 0xc9, 0x94, 0xca, 0x43, 0x98, 0x8f, 0x2d, 0x2d, 0xcc, 0xab, 0xda, 0x2d, 0x8f, 0x20,
+	0x63, 0x7a, 0xe7, 0x4d, 0x38, 0x27, 0xca, 0xa6, 0x9e, 0xfb, 0x65, 0x5a, 0x0d, 0x68, 0x2d, 0xe1,
+	0xc7, 0x0c, 0xb0, 0xb1, 0xc6, 0x7f, 0x68, 0x70, 0x7e, 0x00, 0x03, 0x94, 0xfc, 0x17, 0x61, 0xa1,
+	0xda, 0xf1, 0x3c, 0xea, 0x04, 0x1c, 0x73, 0x51, 0xe1, 0xcf, 0x21, 0xf1, 0x03, 0xcb, 0x17, 0xf2,
+	0xaf, 0xc0, 0xd1, 0xb6, 0x5c, 0x33, 0xc2, 0xb1, 0xa4, 0xcc, 0x71, 0x21, 0x24, 0x0f, 0x79, 0xae,
+	0xc0, 0x94, 0x28, 0x6b, 0x99, 0x1d, 0x9f, 0xd6, 0xb0, 0xe2, 0x00, 0x62, 0xe8, 0x03, 0x9f, 0xd6,
+	0x8c, 0x7a, 0xc2, 0x09, 0x0f, 0x4d, 0xc5, 0x3e, 0x75, 0x3a, 0x43, 0x84, 0xd2, 0x11, 0xb9, 0x96,
+	0x62, 0x72, 0xfd, 0x12, 0x9c, 0xcd, 0x5d, 0x08, 0x85, 0x7a, 0x9b, 0xa9, 0x0d, 0x3e, 0xa4, 0xaa,
+	0xe6, 0xe5, 0xfc, 0xd0, 0xe2, 0x44, 0x8a, 0x73, 0x8f, 0xdd, 0xe6, 0x3e, 0x75, 0xaa, 0xd2, 0x23,
+	0x31, 0xfe, 0x4a, 0x5a, 0x9c, 0xd4, 0x39, 0x08, 0x61, 0x09, 0xc6, 0x7d, 0x3e, 0x16, 0xa0, 0x7b,
+	0x21, 0x1f, 0xc9, 0x36, 0x4c, 0xb7, 0x5d, 0xb7, 0x69, 0xee, 0x5a, 0x4d, 0xcb, 0xa9, 0x2a, 0x67,
+	0xd8, 0xa6, 0x18, 0xd1, 0xb6, 0xa0, 0x21, 0x5b, 0x30, 0xd5, 0xb4, 0x2d, 0xee, 0xd2, 0xd8, 0xea,
+	0xc5, 0x92, 0x28, 0x8d, 0xb1, 0x82, 0xb1, 0xcf, 0x16, 0xe6, 0x3d, 0xb9, 0x77, 0xee, 0xb8, 0xbd,
+	0x56, 0x85, 0x30, 0x34, 0x49, 0x99, 0xd1, 0x53, 0x1b, 0x2d, 0xdb, 0xe9, 0x1d, 0x33, 0xa9, 0xa5,
+	0x95, 0xd4, 0x46, 0xcb, 0x76, 0xe4, 0x09, 0xf3, 0xb9, 0xda, 0x70, 0x0e, 0xcd, 0x1a, 0xe3, 0x6f,
+	0x86, 0xa9, 0x59, 0xe1, 0x13, 0xcc, 0x5b, 0xce, 0x21, 0x5f, 0x58, 0x02, 0x31, 0x6e, 0x61, 0xb1,
+	0x85, 0x07, 0x05, 0x4c, 0xfc, 0x0f, 0x9d, 0xbd, 0xa6, 0x7b, 0xe0, 0x0f, 0x0a, 0x4b, 0x28, 0x9c,
+	0xce, 0xa0, 0x0b, 0x83, 0xe9, 0x71, 0x5b, 0x0c, 0xe5, 0xd5, 0xd5, 0x93, 0xe4, 0xf2, 0x0c, 0x21,
+	0xa9, 0xb1, 0x8c, 0xf0, 0x98, 0x41, 0x72, 0xaa, 0x76, 0x93, 0x26, 0x5c, 0x96, 0x5f, 0xd0, 0xe0,
+	0x74, 0xc6, 0x04, 0xc4, 0xf1, 0x21, 0xcc, 0x7a, 0xf8, 0xce, 0x16, 0x86, 0x4b, 0xc0, 0xb9, 0x34,
+	0xc0, 0xfc, 0xf5, 0x08, 0xa4, 0x62, 0x8b, 0xb3, 0x61, 0x6e, 0x2f, 0x9e, 0x3b, 0x29, 0xdd, 0xf0,
+	0x99, 0x85, 0xc3, 0x27, 0x7a, 0xd9, 0x20, 0x69, 0x38, 0xfe, 0x5f, 0xcb, 0x97, 0x3f, 0x3d, 0x02,
+	0x7a, 0x1a, 0x84, 0xde, 0xa5, 0xda, 0xa7, 0x9e, 0x2f, 0xe5, 0x31, 0x53, 0x91, 0x8f, 0x29, 0x06,
+	0xac, 0xf4, 0xbc, 0x8a, 0x5e, 0x23, 0x43, 0x16, 0xbd, 0xbe, 0x8f, 0xd5, 0xc8, 0x78, 0x29, 0x75,
+	0xac, 0x60, 0x29, 0xf5, 0x87, 0x46, 0x27, 0xc6, 0xe7, 0xe7, 0x8d, 0x9b, 0xe8, 0xfe, 0xf3, 0xd3,
+	0x8e, 0x8a, 0xf6, 0x49, 0x77, 0xe0, 0x1d, 0xeb, 0xc2, 0xa9, 0x74, 0xb2, 0x30, 0x6c, 0x18, 0x09,
+	0xba, 0x52, 0x51, 0x18, 0x99, 0xd7, 0x2b, 0xa4, 0x94, 0x05, 0x86, 0xa0, 0xeb, 0x93, 0x53, 0x30,
+	0x19, 0x78, 0x1d, 0xa7, 0x6a, 0xf5, 0x94, 0x43, 0x6f, 0xc0, 0xf8, 0x31, 0x98, 0x8d, 0x93, 0x92,
+	0xa3, 0x70, 0x24, 0xe8, 0xf6, 0x92, 0x37, 0xa3, 0x41, 0xf7, 0x61, 0x2d, 0x33, 0x19, 0xfa, 0x6c,
+	0xf5, 0x67, 0xe3, 0x7e, 0x3c, 0xfb, 0x1b, 0xca, 0xa9, 0x58, 0xfa, 0xc6, 0x30, 0xe1, 0xe5, 0x04,
+	0x9b, 0xb0, 0xe7, 0x27, 0x82, 0x4e, 0x21, 0xf4, 0x96, 0xf5, 0xe1, 0x24, 0xce, 0x2e, 0xcc, 0x25,
+	0xa6, 0x14, 0x31, 0xcc, 0xd1, 0xa0, 0xaf, 0x54, 0x2c, 0xe8, 0x33, 0xde, 0x47, 0xa7, 0x0a, 0x97,
+	0xfd, 0xd0, 0x0e, 0x1a, 0x18, 0xae, 0xdd, 0x6d, 0x44, 0x43, 0x9b, 0x02, 0x11, 0xff, 0xc7, 0xd2,
+	0xcf, 0xca, 0xe6, 0xf9, 0x1c, 0xa2, 0xd5, 0xf7, 0x40, 0x46, 0x81, 0x66, 0x95, 0x73, 0xc5, 0xad,
+	0xa7, 0x96, 0xf6, 0x10, 0x49, 0x1c, 0xc5, 0x4c, 0x3b, 0xfa, 0x68, 0xfc, 0xa6, 0x06, 0x8b, 0x69,
+	0xf3, 0x9e, 0x09, 0xe5, 0x15, 0x58, 0xb0, 0xaa, 0x81, 0xbd, 0x2f, 0x72, 0xe3, 0xb1, 0x70, 0x6f,
+	0xbe, 0xf7, 0x02, 0xe3, 0xba, 0x33, 0x30, 0xed, 0x07, 0x96, 0x17, 0xc4, 0xe3, 0xbe, 0x29, 0x3e,
+	0x26, 0xa6, 0x18, 0x7b, 0x68, 0xfe, 0x99, 0x4c, 0x6b, 0x9e, 0x75, 0xf0, 0xae, 0xed, 0xa0, 0xfb,
+	0x51, 0x38, 0x06, 0xcd, 0xed, 0x68, 0xf8, 0x4b, 0x59, 0x03, 0x4a, 0x5b, 0x08, 0xbf, 0x5e, 0xa2,
+	0x7a, 0xa3, 0x25, 0xab, 0x37, 0xb9, 0x2b, 0x90, 0x2f, 0xc0, 0x14, 0x73, 0x53, 0xa4, 0xc3, 0xa5,
+	0x78, 0xb5, 0xa1, 0x15, 0xe2, 0x20, 0xcb, 0x00, 0x7e, 0x67, 0x6f, 0xcf, 0xae, 0xda, 0xcc, 0xa1,
+	0x13, 0x39, 0x9b, 0xc8, 0x88, 0xf1, 0x8f, 0x5a, 0xc2, 0x03, 0x46, 0x9b, 0xfc, 0x96, 0xed, 0x07,
+	0xae, 0x37, 0x44, 0x2e, 0xeb, 0x05, 0x09, 0xdb, 0xbf, 0xa5, 0xc1, 0xd9, 0xdc, 0x7d, 0x85, 0x79,
+	0xc1, 0x51, 0x4f, 0x38, 0x4d, 0x4c, 0xb4, 0xd7, 0x14, 0x52, 0x82, 0x92, 0x83, 0x7b, 0x80, 0xe2,
+	0xe6, 0x0c, 0x9e, 0x5f, 0xb4, 0xfe, 0x8b, 0x1a, 0x9c, 0xc8, 0x5c, 0x32, 0xb3, 0x50, 0x56, 0x20,
+	0x6d, 0xb6, 0xc4, 0x62, 0x0c, 0x91, 0x4a, 0x12, 0x79, 0x33, 0xf9, 0xc8, 0xbe, 0x4c, 0x4f, 0x53,
+	0x8b, 0x7e, 0xd4, 0x50, 0xfd, 0x6e, 0x7e, 0x74, 0x0b, 0x8e, 0x70, 0x89, 0x92, 0x1f, 0x87, 0x31,
+	0xd1, 0x59, 0x4b, 0x52, 0x4b, 0x36, 0xfd, 0xcd, 0xc3, 0xfa, 0xc5, 0x81, 0xf3, 0x84, 0x20, 0x0c,
+	0xe3, 0x6b, 0xff, 0xf0, 0x6f, 0x3f, 0x5f, 0x3a, 0x45, 0xf4, 0x72, 0x4a, 0x9b, 0x32, 0x36, 0xf0,
+	0xfe, 0x9a, 0x06, 0xb3, 0xf1, 0xae, 0x60, 0xb2, 0x3e, 0x80, 0x7f, 0xa2, 0xa5, 0x55, 0x2f, 0x2b,
+	0xcf, 0x47, 0x5c, 0x57, 0x38, 0xae, 0xf3, 0xe4, 0x6c, 0x36, 0xae, 0x30, 0xeb, 0x4a, 0x7e, 0x5b,
+	0x83, 0xf9, 0x64, 0x55, 0x87, 0xbc, 0x92, 0xb9, 0x64, 0x46, 0xdf, 0xad, 0xbe, 0x51, 0x80, 0x02,
+	0x61, 0x5e, 0xe3, 0x30, 0x2f, 0x92, 0xf3, 0x69, 0x30, 0xc3, 0xf3, 0x11, 0x02, 0xfd, 0x96, 0x06,
+	0x8b, 0x69, 0x2d, 0xa5, 0xe4, 0x46, 0xe6, 0xd2, 0x39, 0x0d, 0xb7, 0xfa, 0xcd, 0x82, 0x54, 0x08,
+	0x7a, 0x93, 0x83, 0xbe, 0x4a, 0x2e, 0xa7, 0x81, 0x8e, 0x95, 0x59, 0xcc, 0x40, 0x02, 0xfc, 0x1b,
+	0x0d, 0x4e, 0x64, 0x36, 0xc3, 0x92, 0xdb, 0xc5, 0x80, 0x44, 0x4c, 0xb8, 0x7e, 0x67, 0x18, 0x52,
+	0xdc, 0xc8, 0x6b, 0x7c, 0x23, 0x9b, 0xe4, 0x15, 0xf5, 0x8d, 0x98, 0x1e, 0x07, 0xfc, 0x73, 0x1a,
+	0x4c, 0x45, 0x62, 0x72, 0x72, 0x25, 0x13, 0x45, 0x7f, 0x5b, 0xae, 0x7e, 0x55, 0x6d, 0x32, 0x82,
+	0x5c, 0xe3, 0x20, 0x0d, 0xb2, 0x5a, 0xce, 0xfe, 0x43, 0x00, 0x93, 0x45, 0xec, 0xe4, 0xd7, 0x35,
+	0x98, 0x8d, 0x27, 0xe1, 0x72, 0xee, 0x59, 0x6a, 0x73, 0xad, 0x5e, 0x56, 0x9e, 0x8f, 0xe8, 0xae,
+	0x72, 0x74, 0x17, 0xc8, 0xb9, 0x34, 0x74, 0x32, 0x4e, 0x31, 0x45, 0xb9, 0xcc, 0x27, 0x7f, 0xa7,
+	0x81, 0x9e, 0xdd, 0x2e, 0x4a, 0xee, 0x28, 0xae, 0x9e, 0xd2, 0xf3, 0xaa, 0xbf, 0x3e, 0x14, 0x2d,
+	0xee, 0xe2, 0x0e, 0xdf, 0xc5, 0x0d, 0xb2, 0xa9, 0xb2, 0x0b, 0x73, 0xcf, 0xf5, 0xcc, 0xb0, 0xbe,
+	0xc4, 0xb5, 0x5b, 0x3c, 0xd5, 0x9c, 0x23, 0xf5, 0xd4, 0x1e, 0x20, 0xbd, 0xac, 0x3c, 0x5f, 0x45,
+	0xbb, 0x45, 0x2a, 0x45, 0x1c, 0xcd, 0x1f, 0x69, 0x40, 0xfa, 0x9b, 0x5e, 0xc8, 0x66, 0xe6, 0xa2,
+	0x99, 0xdd, 0x36, 0xfa, 0xf5, 0x42, 0x34, 0x08, 0xb6, 0xcc, 0xc1, 0x5e, 0x22, 0x17, 0xd3, 0xc0,
+	0xba, 0x3d, 0x3a, 0x79, 0xd7, 0xc8, 0xd7, 0x34, 0x18, 0x97, 0x01, 0x43, 0xb6, 0x21, 0x8a, 0x17,
+	0xaa, 0xf4, 0xb5, 0xc1, 0x13, 0x11, 0xcf, 0x39, 0x8e, 0x67, 0x99, 0x9c, 0x4a, 0xc3, 0x23, 0xcd,
+	0x29, 0xf9, 0x7d, 0x0d, 0x16, 0xfa, 0xba, 0x4c, 0x48, 0xb6, 0x8a, 0xcf, 0xea, 0x94, 0xd1, 0x37,
+	0x8b, 0x90, 0xa8, 0x88, 0x0c, 0x6b, 0xcf, 0xd1, 0x4e, 0x17, 0xf2, 0x2b, 0x1a, 0xcc, 0xc4, 0xda,
+	0x58, 0xc8, 0xb5, 0x81, 0x67, 0x2a, 0xda, 0x0c, 0xa3, 0xaf, 0xab, 0x4e, 0x47, 0x84, 0x97, 0x39,
+	0xc2, 0x73, 0xc4, 0xc8, 0x3d, 0x81, 0x02, 0x0a, 0x3b, 0x80, 0xfd, 0x6d, 0x21, 0x39, 0x07, 0x30,
+	0xb3, 0x4b, 0x45, 0xbf, 0x5e, 0x88, 0x46, 0x45, 0x9a, 0x51, 0x31, 0x9a, 0xa2, 0x45, 0x85, 0xfc,
+	0x81, 0x06, 0x0b, 0x7d, 0xdd, 0x26, 0x39, 0xdf, 0x3e, 0xab, 0x95, 0x45, 0xdf, 0x2c, 0x42, 0x82,
+	0x68, 0x5f, 0xe1, 0x68, 0x2f, 0x93, 0xb5, 0xc1, 0x77, 0xdb, 0xdc, 0x3d, 0x34, 0xed, 0x1a, 0xf9,
+	0x33, 0x0d, 0x5e, 0x4e, 0x6d, 0x4a, 0x21, 0x37, 0x95, 0x3d, 0x92, 0x68, 0xa7, 0x8b, 0x7e, 0xab,
+	0x28, 0x19, 0x42, 0xbf, 0xce, 0xa1, 0x5f, 0x23, 0x57, 0x94, 0xbc, 0x19, 0x93, 0xb7, 0xc6, 0x70,
+	0x61, 0xf7, 0xb5, 0xa4, 0x90, 0xc1, 0xbe, 0x54, 0xb2, 0x83, 0x46, 0xdf, 0x2c, 0x42, 0xa2, 0x22,
+	0xec, 0x50, 0xc7, 0x33, 0x39, 0x63, 0x73, 0x0e, 0xf9, 0x53, 0x0d, 0x16, 0xd3, 0x5a, 0x4d, 0x72,
+	0x5c, 0xb0, 0x9c, 0xb6, 0x16, 0xfd, 0x66, 0x41, 0x2a, 0x15, 0x49, 0xb3, 0x08, 0xb4, 0x2a, 0x49,
+	0x85, 0xae, 0xe0, 0x08, 0xbf, 0xa9, 0xc1, 0x7c, 0xb2, 0x97, 0x3f, 0xc7, 0xcd, 0xcd, 0xf8, 0xfb,
+	0x02, 0x7d, 0xa3, 0x00, 0x85, 0xca, 0x0d, 0x0c, 0x3b, 0x16, 0x7b, 0x6d, 0xf2, 0xdc, 0x95, 0x89,
+	0x77, 0x98, 0xe7, 0x18, 0xd5, 0xd4, 0x3e, 0x79, 0xbd, 0xac, 0x3c, 0x5f, 0xc5, 0x95, 0x39, 0x60,
+	0x34, 0x58, 0x2e, 0xe0, 0xf6, 0xe1, 0xdb, 0x1a, 0xbc, 0x9c, 0xda, 0xc1, 0x92, 0x73, 0xe9, 0xf2,
+	0x9a, 0x68, 0xf4, 0x5b, 0x45, 0xc9, 0x10, 0xf6, 0x0d, 0x0e, 0x7b, 0x9d, 0x5c, 0x4d, 0xb5, 0x15,
+	0x6e, 0xdb, 0x8c, 0x1d, 0x63, 0x7c, 0x47, 0x7e, 0x46, 0x03, 0xe8, 0x75, 0xab, 0x93, 0xcb, 0xf9,
+	0x46, 0x2a, 0xda, 0x6c, 0xaf, 0x5f, 0x51, 0x9a, 0xab, 0xe2, 0xbd, 0xa2, 0x25, 0xf3, 0x39, 0x84,
+	0xbf, 0xd5, 0x40, 0xcf, 0xee, 0xa6, 0xc9, 0xf1, 0x0d, 0x07, 0x36, 0xf6, 0xe8, 0xaf, 0x0f, 0x45,
+	0xab, 0x12, 0x24, 0x84, 0x4a, 0x0d, 0xc7, 0x4c, 0x1a, 0x81, 0xfc, 0x1b, 0x1a, 0xcc, 0xc6, 0x3b,
+	0x5a, 0x72, 0x0e, 0x71, 0x6a, 0xfb, 0x8d, 0x5e, 0x56, 0x9e, 0xaf, 0x12, 0x50, 0x86, 0x9d, 0x3c,
+	0xa1, 0x97, 0xf3, 0x27, 0x1a, 0x1c, 0x4d, 0xe9, 0x66, 0x21, 0xd7, 0x73, 0x0e, 0x63, 0x56, 0x7f,
+	0x8c, 0x7e, 0xa3, 0x18, 0x11, 0x22, 0xde, 0xe0, 0x88, 0xaf, 0x90, 0x4b, 0xe9, 0xe7, 0x97, 0xb5,
+	0x63, 0x27, 0x1a, 0x6a, 0xc8, 0x7f, 0x6a, 0x70, 0x5e, 0xa9, 0xbb, 0x83, 0xdc, 0x57, 0xf4, 0xac,
+	0xf3, 0x5b, 0x60, 0xf4, 0x9d, 0x67, 0x65, 0x83, 0x7b, 0x7d, 0x9d, 0xef, 0xf5, 0x26, 0xb9, 0xae,
+	0xe0, 0xb7, 0xb3, 0xdb, 0x2a, 0x72, 0x45, 0x18, 0x73, 0x7e, 0xaa, 0xc1, 0xe9, 0xdc, 0x1e, 0x0b,
+	0xf2, 0x86, 0x7a, 0x0c, 0x94, 0xd2, 0x48, 0xa2, 0xbf, 0x39, 0x2c, 0x39, 0xee, 0xee, 0x4d, 0xbe,
+	0xbb, 0xd7, 0xc8, 0x2d, 0xe5, 0x28, 0x2a, 0xd6, 0x91, 0x41, 0x3e, 0xd1, 0x60, 0x29, 0xab, 0x8b,
+	0x81, 0xbc, 0x96, 0x9d, 0x01, 0xca, 0xef, 0x9c, 0xd0, 0x6f, 0x0f, 0x41, 0x89, 0x3b, 0x7a, 0x95,
+	0xef, 0x68, 0x83, 0x94, 0x53, 0xb3, 0x48, 0x92, 0xda, 0xec, 0x33, 0xb8, 0xe4, 0x3b, 0x1a, 0x1c,
+	0x4b, 0xef, 0x1c, 0x20, 0x83, 0x9d, 0xab, 0xd4, 0x9e, 0x06, 0xfd, 0xd5, 0xc2, 0x74, 0xb8, 0x89,
+	0x9b, 0x7c, 0x13, 0x65, 0x72, 0x2d, 0x57, 0x81, 0x85, 0x56, 0x18, 0xdb, 0x13, 0xb8, 0x6a, 0x48,
+	0x69, 0x3b, 0xc8, 0x51, 0x0d, 0xd9, 0x8d, 0x0c, 0xfa, 0x8d, 0x62, 0x44, 0x2a, 0xaa, 0x21, 0x9a,
+	0xfa, 0x30, 0x7d, 0x89, 0x8e, 0x85, 0x6d, 0x7d, 0x5d, 0x04, 0x39, 0xde, 0x64, 0x56, 0x4f, 0x82,
+	0xbe, 0x59, 0x84, 0x44, 0xc5, 0xcd, 0x91, 0xad, 0x06, 0xe8, 0x90, 0x71, 0x5c, 0xbf, 0xa3, 0xc1,
+	0x7c, 0xb2, 0xc4, 0x9f, 0xe3, 0x91, 0x65, 0x34, 0x21, 0xe8, 0x1b, 0x05, 0x28, 0x10, 0xea, 0x3a,
+	0x87, 0xba, 0x46, 0x2e, 0x64, 0xa7, 0xbe, 0xb8, 0x60, 0xb1, 0xd1, 0x80, 0xa7, 0x48, 0x93, 0x3d,
+	0x04, 0x39, 0x48, 0x33, 0xfa, 0x11, 0xf4, 0x8d, 0x02, 0x14, 0x2a, 0x16, 0x4d, 0xf6, 0x1c, 0xd0,
+	0xd0, 0x36, 0x7c, 0x43, 0x83, 0x99, 0x58, 0x49, 0x3f, 0x27, 0x12, 0x4e, 0xeb, 0x3e, 0xd0, 0xd7,
+	0x55, 0xa7, 0xab, 0xe4, 0x62, 0xd0, 0xc3, 0x91, 0xca, 0x8f, 0xfc, 0x96, 0x06, 0x73, 0x89, 0x72,
+	0x35, 0x29, 0xe7, 0x7f, 0xbd, 0xbe, 0x7a, 0xb8, 0xfe, 0x8a, 0x3a, 0x81, 0xfa, 0xd7, 0x0e, 0xef,
+	0x3f, 0xab, 0x7e, 0xff, 0xa4, 0x06, 0x13, 0x3b, 0xf2, 0x8f, 0x03, 0x07, 0x66, 0x56, 0x42, 0x60,
+	0x97, 0x14, 0x66, 0x22, 0xa2, 0xf3, 0x1c, 0xd1, 0x0a, 0x39, 0x9d, 0x17, 0x11, 0xf8, 0xe4, 0xbb,
+	0x1a, 0x2c, 0x65, 0x15, 0x5c, 0x73, 0x4c, 0xc2, 0x80, 0xba, 0xaf, 0x7e, 0x7b, 0x08, 0x4a, 0x15,
+	0x77, 0x30, 0x14, 0xe2, 0x81, 0x1d, 0x34, 0xcc, 0x78, 0x25, 0x97, 0xfc, 0xb1, 0x06, 0xa4, 0xbf,
+	0xf0, 0x98, 0x93, 0x06, 0xc9, 0x2c, 0x87, 0xea, 0xd7, 0x0b, 0xd1, 0xa8, 0xc4, 0xba, 0x07, 0x48,
+	0x67, 0x46, 0xca, 0x97, 0xe4, 0x2f, 0x22, 0x56, 0x2c, 0x5e, 0xd9, 0x52, 0xb0, 0x62, 0xa9, 0x75,
+	0x49, 0xfd, 0xd5, 0xc2, 0x74, 0x2a, 0x61, 0x4e, 0x9f, 0x1b, 0xde, 0x10, 0xd4, 0xdb, 0xef, 0x7c,
+	0xf2, 0xd9, 0xb2, 0xf6, 0xbd, 0xcf, 0x96, 0xb5, 0x7f, 0xfd, 0x6c, 0x59, 0xfb, 0xd9, 0xcf, 0x97,
+	0x5f, 0xfa, 0xde, 0xe7, 0xcb, 0x2f, 0xfd, 0xd3, 0xe7, 0xcb, 0x2f, 0xfd, 0xe8, 0x66, 0xdd, 0x0e,
+	0x1a, 0x9d, 0xdd, 0xf5, 0xaa, 0xdb, 0x92, 0x1c, 0xaf, 0x39, 0x34, 0x38, 0x70, 0xbd, 0xa7, 0xe1,
+	0x0a, 0xdd, 0x70, 0x0d, 0xe6, 0x35, 0xfb, 0xbb, 0x63, 0xfc, 0xff, 0xdb, 0xb9, 0xfe, 0xbf, 0x03,
+	0x00, 0xa7, 0x36, 0x34, 0xb7, 0x62, 0x48, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns module parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ParamsMetadata returns the module parameters along with their validation
+	// constraints (type, allowed range and values).
+	ParamsMetadata(ctx context.Context, in *QueryParamsMetadataRequest, opts ...grpc.CallOption) (*QueryParamsMetadataResponse, error)
+	// ContractMetadata returns the contract rewards parameters (metadata).
+	ContractMetadata(ctx context.Context, in *QueryContractMetadataRequest, opts ...grpc.CallOption) (*QueryContractMetadataResponse, error)
+	// BlockRewardsTracking returns block rewards tracking for the current block.
+	BlockRewardsTracking(ctx context.Context, in *QueryBlockRewardsTrackingRequest, opts ...grpc.CallOption) (*QueryBlockRewardsTrackingResponse, error)
+	// BlockRewardsTrackingRange returns block rewards tracking for the given
+	// range of block heights (only blocks with tracking data are reported).
+	BlockRewardsTrackingRange(ctx context.Context, in *QueryBlockRewardsTrackingRangeRequest, opts ...grpc.CallOption) (*QueryBlockRewardsTrackingRangeResponse, error)
+	// RewardsPool returns the current undistributed rewards pool funds.
+	RewardsPool(ctx context.Context, in *QueryRewardsPoolRequest, opts ...grpc.CallOption) (*QueryRewardsPoolResponse, error)
+	// EstimateTxFees returns the estimated transaction fees for the given
+	// transaction gas limit using the minimum consensus fee value for the current
+	// block.
+	EstimateTxFees(ctx context.Context, in *QueryEstimateTxFeesRequest, opts ...grpc.CallOption) (*QueryEstimateTxFeesResponse, error)
+	// EstimateTxFeesForContracts returns the estimated transaction fees for the
+	// given transaction gas limit including the combined flat fees of the given
+	// contracts.
+	EstimateTxFeesForContracts(ctx context.Context, in *QueryEstimateTxFeesForContractsRequest, opts ...grpc.CallOption) (*QueryEstimateTxFeesForContractsResponse, error)
+	// RewardsRecords returns the paginated list of RewardsRecord objects stored
+	// for the provided rewards_address.
+	RewardsRecords(ctx context.Context, in *QueryRewardsRecordsRequest, opts ...grpc.CallOption) (*QueryRewardsRecordsResponse, error)
+	// OutstandingRewards returns total rewards credited from different contracts
+	// for the provided rewards_address.
+	OutstandingRewards(ctx context.Context, in *QueryOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryOutstandingRewardsResponse, error)
+	// FlatFee returns the flat fee set by the contract owner for the provided
+	// contract_address
+	FlatFee(ctx context.Context, in *QueryFlatFeeRequest, opts ...grpc.CallOption) (*QueryFlatFeeResponse, error)
+	// TxFeeDistribution returns how the transaction fees were distributed for
+	// the given transaction hash or for all the transactions within a block.
+	TxFeeDistribution(ctx context.Context, in *QueryTxFeeDistributionRequest, opts ...grpc.CallOption) (*QueryTxFeeDistributionResponse, error)
+	// RewardsRatios returns the current inflation rewards and tx fee rebate
+	// ratios.
+	RewardsRatios(ctx context.Context, in *QueryRewardsRatiosRequest, opts ...grpc.CallOption) (*QueryRewardsRatiosResponse, error)
+	// DistributionConfig returns all the module parameters affecting the fees
+	// and rewards distribution.
+	DistributionConfig(ctx context.Context, in *QueryDistributionConfigRequest, opts ...grpc.CallOption) (*QueryDistributionConfigResponse, error)
+	// RewardsRecordByID returns a single RewardsRecord object by its ID.
+	RewardsRecordByID(ctx context.Context, in *QueryRewardsRecordByIDRequest, opts ...grpc.CallOption) (*QueryRewardsRecordByIDResponse, error)
+	// ContractMetadataCount returns the total number of contracts with metadata
+	// set.
+	ContractMetadataCount(ctx context.Context, in *QueryContractMetadataCountRequest, opts ...grpc.CallOption) (*QueryContractMetadataCountResponse, error)
+	// ContractsByCodeID returns the addresses of contracts with metadata set
+	// instantiated from the given code ID.
+	ContractsByCodeID(ctx context.Context, in *QueryContractsByCodeIDRequest, opts ...grpc.CallOption) (*QueryContractsByCodeIDResponse, error)
+	// MinConsensusFeeDebug returns the stored minimum consensus fee inputs for
+	// the given block and the fee recomputed from them.
+	MinConsensusFeeDebug(ctx context.Context, in *QueryMinConsensusFeeDebugRequest, opts ...grpc.CallOption) (*QueryMinConsensusFeeDebugResponse, error)
+	// FlatFeeBreakEven returns the estimated transaction fees for the given
+	// transaction gas limit and contract along with the share of the contract
+	// flat fee and the gas limit the gas fees match the flat fee at.
+	FlatFeeBreakEven(ctx context.Context, in *QueryFlatFeeBreakEvenRequest, opts ...grpc.CallOption) (*QueryFlatFeeBreakEvenResponse, error)
+	// WouldAcceptFee returns whether the given transaction fee covers the
+	// minimum fee for the given gas limit and contracts (the MinFeeDecorator
+	// comparison) along with the missing amount.
+	WouldAcceptFee(ctx context.Context, in *QueryWouldAcceptFeeRequest, opts ...grpc.CallOption) (*QueryWouldAcceptFeeResponse, error)
+	// TopContractsByRewards returns the contracts with the highest rewards
+	// distributed within the given number of recent blocks.
+	TopContractsByRewards(ctx context.Context, in *QueryTopContractsByRewardsRequest, opts ...grpc.CallOption) (*QueryTopContractsByRewardsResponse, error)
+	// TxFeeSplit returns how a transaction fee would be split between the
+	// minimum fee portions (gas fees and contract flat fees) and the surplus.
+	TxFeeSplit(ctx context.Context, in *QueryTxFeeSplitRequest, opts ...grpc.CallOption) (*QueryTxFeeSplitResponse, error)
+	// ContractRewardsEligibility returns whether the contract is eligible for
+	// the dApp rewards along with the underlying conditions.
+	ContractRewardsEligibility(ctx context.Context, in *QueryContractRewardsEligibilityRequest, opts ...grpc.CallOption) (*QueryContractRewardsEligibilityResponse, error)
+	// MsgTypeFlatFee returns the contract flat fee charged for the given msg
+	// type targeting the contract.
+	MsgTypeFlatFee(ctx context.Context, in *QueryMsgTypeFlatFeeRequest, opts ...grpc.CallOption) (*QueryMsgTypeFlatFeeResponse, error)
+	// TotalPendingRewards returns the total rewards the module owes to dApps
+	// along with the rewards pool balance backing them.
+	TotalPendingRewards(ctx context.Context, in *QueryTotalPendingRewardsRequest, opts ...grpc.CallOption) (*QueryTotalPendingRewardsResponse, error)
+	// RewardsRecordsByAddressAndHeightRange returns the paginated list of
+	// RewardsRecord objects created for a rewards address within a block height
+	// range (ordered by height).
+	RewardsRecordsByAddressAndHeightRange(ctx context.Context, in *QueryRewardsRecordsByAddressAndHeightRangeRequest, opts ...grpc.CallOption) (*QueryRewardsRecordsByAddressAndHeightRangeResponse, error)
+	// EstimateTxFeesForSimulatedGas returns the minimum transaction fees for the
+	// simulated gas used and for the gas limit adjusted by the client gas
+	// adjustment factor (--gas=auto --gas-adjustment).
+	EstimateTxFeesForSimulatedGas(ctx context.Context, in *QueryEstimateTxFeesForSimulatedGasRequest, opts ...grpc.CallOption) (*QueryEstimateTxFeesForSimulatedGasResponse, error)
+	// ProjectedMinConsensusFee returns a best-effort projection of the minimum
+	// price of gas for the next block extrapolated from the recent blocks
+	// minimum consensus fee trend.
+	ProjectedMinConsensusFee(ctx context.Context, in *QueryProjectedMinConsensusFeeRequest, opts ...grpc.CallOption) (*QueryProjectedMinConsensusFeeResponse, error)
+	// ContractFlatFeeRevenue returns the contract flat fees collected within the
+	// given number of recent blocks.
+	ContractFlatFeeRevenue(ctx context.Context, in *QueryContractFlatFeeRevenueRequest, opts ...grpc.CallOption) (*QueryContractFlatFeeRevenueResponse, error)
+	// RewardsPoolSolvency checks that the rewards pool balance covers the
+	// rewards records pending withdrawal (the module solvency invariant).
+	RewardsPoolSolvency(ctx context.Context, in *QueryRewardsPoolSolvencyRequest, opts ...grpc.CallOption) (*QueryRewardsPoolSolvencyResponse, error)
+	// AcceptedFeeDenoms returns the denoms transaction fees are accepted in along
+	// with their current minimum gas prices.
+	AcceptedFeeDenoms(ctx context.Context, in *QueryAcceptedFeeDenomsRequest, opts ...grpc.CallOption) (*QueryAcceptedFeeDenomsResponse, error)
+	// BlockPoolInflows returns the breakdown of the tokens entered the rewards
+	// pool within the given block (fee rebates, inflation and flat fees).
+	BlockPoolInflows(ctx context.Context, in *QueryBlockPoolInflowsRequest, opts ...grpc.CallOption) (*QueryBlockPoolInflowsResponse, error)
+	// ReconcileRewards compares the rewards pool balance against the pending
+	// rewards and reports the discrepancies (if any).
+	ReconcileRewards(ctx context.Context, in *QueryReconcileRewardsRequest, opts ...grpc.CallOption) (*QueryReconcileRewardsResponse, error)
+	// TxFeeEstimate returns the estimated transaction fees for the given
+	// transaction gas limit, contracts and tx size in the versioned response
+	// shape (the fee components might be extended in the future versions).
+	TxFeeEstimate(ctx context.Context, in *QueryTxFeeEstimateRequest, opts ...grpc.CallOption) (*QueryTxFeeEstimateResponse, error)
+	// BlockFlatFeeTxs returns the transactions which paid the contract flat fees
+	// within the given block along with the flat fee amounts.
+	BlockFlatFeeTxs(ctx context.Context, in *QueryBlockFlatFeeTxsRequest, opts ...grpc.CallOption) (*QueryBlockFlatFeeTxsResponse, error)
+	// FlatFees returns the flat fees set for the given contracts (contracts
+	// without a flat fee are omitted).
+	FlatFees(ctx context.Context, in *QueryFlatFeesRequest, opts ...grpc.CallOption) (*QueryFlatFeesResponse, error)
+	// FlatFeeWithPendingChange returns the current contract flat fee along with
+	// its pending scheduled change (the fee and the height it is charged from).
+	FlatFeeWithPendingChange(ctx context.Context, in *QueryFlatFeeWithPendingChangeRequest, opts ...grpc.CallOption) (*QueryFlatFeeWithPendingChangeResponse, error)
+	// WithdrawMinBalance returns the min fee the rewards address must hold to
+	// submit a withdraw-all transaction for its rewards records.
+	WithdrawMinBalance(ctx context.Context, in *QueryWithdrawMinBalanceRequest, opts ...grpc.CallOption) (*QueryWithdrawMinBalanceResponse, error)
+	// ContractRewardsHistory returns the paginated list of the rewards and flat
+	// fees distributed for a contract within a block height range (one row per
+	// block, ordered by height) in a flat structure suitable for tabular
+	// exports.
+	ContractRewardsHistory(ctx context.Context, in *QueryContractRewardsHistoryRequest, opts ...grpc.CallOption) (*QueryContractRewardsHistoryResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ParamsMetadata(ctx context.Context, in *QueryParamsMetadataRequest, opts ...grpc.CallOption) (*QueryParamsMetadataResponse, error) {
+	out := new(QueryParamsMetadataResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/ParamsMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractMetadata(ctx context.Context, in *QueryContractMetadataRequest, opts ...grpc.CallOption) (*QueryContractMetadataResponse, error) {
+	out := new(QueryContractMetadataResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/ContractMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlockRewardsTracking(ctx context.Context, in *QueryBlockRewardsTrackingRequest, opts ...grpc.CallOption) (*QueryBlockRewardsTrackingResponse, error) {
+	out := new(QueryBlockRewardsTrackingResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/BlockRewardsTracking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlockRewardsTrackingRange(ctx context.Context, in *QueryBlockRewardsTrackingRangeRequest, opts ...grpc.CallOption) (*QueryBlockRewardsTrackingRangeResponse, error) {
+	out := new(QueryBlockRewardsTrackingRangeResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/BlockRewardsTrackingRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RewardsPool(ctx context.Context, in *QueryRewardsPoolRequest, opts ...grpc.CallOption) (*QueryRewardsPoolResponse, error) {
+	out := new(QueryRewardsPoolResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/RewardsPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimateTxFees(ctx context.Context, in *QueryEstimateTxFeesRequest, opts ...grpc.CallOption) (*QueryEstimateTxFeesResponse, error) {
+	out := new(QueryEstimateTxFeesResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/EstimateTxFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimateTxFeesForContracts(ctx context.Context, in *QueryEstimateTxFeesForContractsRequest, opts ...grpc.CallOption) (*QueryEstimateTxFeesForContractsResponse, error) {
+	out := new(QueryEstimateTxFeesForContractsResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/EstimateTxFeesForContracts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RewardsRecords(ctx context.Context, in *QueryRewardsRecordsRequest, opts ...grpc.CallOption) (*QueryRewardsRecordsResponse, error) {
+	out := new(QueryRewardsRecordsResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/RewardsRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OutstandingRewards(ctx context.Context, in *QueryOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryOutstandingRewardsResponse, error) {
+	out := new(QueryOutstandingRewardsResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/OutstandingRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FlatFee(ctx context.Context, in *QueryFlatFeeRequest, opts ...grpc.CallOption) (*QueryFlatFeeResponse, error) {
+	out := new(QueryFlatFeeResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/FlatFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TxFeeDistribution(ctx context.Context, in *QueryTxFeeDistributionRequest, opts ...grpc.CallOption) (*QueryTxFeeDistributionResponse, error) {
+	out := new(QueryTxFeeDistributionResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/TxFeeDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RewardsRatios(ctx context.Context, in *QueryRewardsRatiosRequest, opts ...grpc.CallOption) (*QueryRewardsRatiosResponse, error) {
+	out := new(QueryRewardsRatiosResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/RewardsRatios", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DistributionConfig(ctx context.Context, in *QueryDistributionConfigRequest, opts ...grpc.CallOption) (*QueryDistributionConfigResponse, error) {
+	out := new(QueryDistributionConfigResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/DistributionConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RewardsRecordByID(ctx context.Context, in *QueryRewardsRecordByIDRequest, opts ...grpc.CallOption) (*QueryRewardsRecordByIDResponse, error) {
+	out := new(QueryRewardsRecordByIDResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/RewardsRecordByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractMetadataCount(ctx context.Context, in *QueryContractMetadataCountRequest, opts ...grpc.CallOption) (*QueryContractMetadataCountResponse, error) {
+	out := new(QueryContractMetadataCountResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/ContractMetadataCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractsByCodeID(ctx context.Context, in *QueryContractsByCodeIDRequest, opts ...grpc.CallOption) (*QueryContractsByCodeIDResponse, error) {
+	out := new(QueryContractsByCodeIDResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/ContractsByCodeID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MinConsensusFeeDebug(ctx context.Context, in *QueryMinConsensusFeeDebugRequest, opts ...grpc.CallOption) (*QueryMinConsensusFeeDebugResponse, error) {
+	out := new(QueryMinConsensusFeeDebugResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/MinConsensusFeeDebug", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FlatFeeBreakEven(ctx context.Context, in *QueryFlatFeeBreakEvenRequest, opts ...grpc.CallOption) (*QueryFlatFeeBreakEvenResponse, error) {
+	out := new(QueryFlatFeeBreakEvenResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/FlatFeeBreakEven", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WouldAcceptFee(ctx context.Context, in *QueryWouldAcceptFeeRequest, opts ...grpc.CallOption) (*QueryWouldAcceptFeeResponse, error) {
+	out := new(QueryWouldAcceptFeeResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/WouldAcceptFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TopContractsByRewards(ctx context.Context, in *QueryTopContractsByRewardsRequest, opts ...grpc.CallOption) (*QueryTopContractsByRewardsResponse, error) {
+	out := new(QueryTopContractsByRewardsResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/TopContractsByRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TxFeeSplit(ctx context.Context, in *QueryTxFeeSplitRequest, opts ...grpc.CallOption) (*QueryTxFeeSplitResponse, error) {
+	out := new(QueryTxFeeSplitResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/TxFeeSplit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractRewardsEligibility(ctx context.Context, in *QueryContractRewardsEligibilityRequest, opts ...grpc.CallOption) (*QueryContractRewardsEligibilityResponse, error) {
+	out := new(QueryContractRewardsEligibilityResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/ContractRewardsEligibility", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MsgTypeFlatFee(ctx context.Context, in *QueryMsgTypeFlatFeeRequest, opts ...grpc.CallOption) (*QueryMsgTypeFlatFeeResponse, error) {
+	out := new(QueryMsgTypeFlatFeeResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/MsgTypeFlatFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalPendingRewards(ctx context.Context, in *QueryTotalPendingRewardsRequest, opts ...grpc.CallOption) (*QueryTotalPendingRewardsResponse, error) {
+	out := new(QueryTotalPendingRewardsResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/TotalPendingRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RewardsRecordsByAddressAndHeightRange(ctx context.Context, in *QueryRewardsRecordsByAddressAndHeightRangeRequest, opts ...grpc.CallOption) (*QueryRewardsRecordsByAddressAndHeightRangeResponse, error) {
+	out := new(QueryRewardsRecordsByAddressAndHeightRangeResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/RewardsRecordsByAddressAndHeightRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimateTxFeesForSimulatedGas(ctx context.Context, in *QueryEstimateTxFeesForSimulatedGasRequest, opts ...grpc.CallOption) (*QueryEstimateTxFeesForSimulatedGasResponse, error) {
+	out := new(QueryEstimateTxFeesForSimulatedGasResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/EstimateTxFeesForSimulatedGas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProjectedMinConsensusFee(ctx context.Context, in *QueryProjectedMinConsensusFeeRequest, opts ...grpc.CallOption) (*QueryProjectedMinConsensusFeeResponse, error) {
+	out := new(QueryProjectedMinConsensusFeeResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/ProjectedMinConsensusFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractFlatFeeRevenue(ctx context.Context, in *QueryContractFlatFeeRevenueRequest, opts ...grpc.CallOption) (*QueryContractFlatFeeRevenueResponse, error) {
+	out := new(QueryContractFlatFeeRevenueResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/ContractFlatFeeRevenue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RewardsPoolSolvency(ctx context.Context, in *QueryRewardsPoolSolvencyRequest, opts ...grpc.CallOption) (*QueryRewardsPoolSolvencyResponse, error) {
+	out := new(QueryRewardsPoolSolvencyResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/RewardsPoolSolvency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AcceptedFeeDenoms(ctx context.Context, in *QueryAcceptedFeeDenomsRequest, opts ...grpc.CallOption) (*QueryAcceptedFeeDenomsResponse, error) {
+	out := new(QueryAcceptedFeeDenomsResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/AcceptedFeeDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlockPoolInflows(ctx context.Context, in *QueryBlockPoolInflowsRequest, opts ...grpc.CallOption) (*QueryBlockPoolInflowsResponse, error) {
+	out := new(QueryBlockPoolInflowsResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/BlockPoolInflows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReconcileRewards(ctx context.Context, in *QueryReconcileRewardsRequest, opts ...grpc.CallOption) (*QueryReconcileRewardsResponse, error) {
+	out := new(QueryReconcileRewardsResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/ReconcileRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TxFeeEstimate(ctx context.Context, in *QueryTxFeeEstimateRequest, opts ...grpc.CallOption) (*QueryTxFeeEstimateResponse, error) {
+	out := new(QueryTxFeeEstimateResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/TxFeeEstimate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlockFlatFeeTxs(ctx context.Context, in *QueryBlockFlatFeeTxsRequest, opts ...grpc.CallOption) (*QueryBlockFlatFeeTxsResponse, error) {
+	out := new(QueryBlockFlatFeeTxsResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/BlockFlatFeeTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FlatFees(ctx context.Context, in *QueryFlatFeesRequest, opts ...grpc.CallOption) (*QueryFlatFeesResponse, error) {
+	out := new(QueryFlatFeesResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/FlatFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FlatFeeWithPendingChange(ctx context.Context, in *QueryFlatFeeWithPendingChangeRequest, opts ...grpc.CallOption) (*QueryFlatFeeWithPendingChangeResponse, error) {
+	out := new(QueryFlatFeeWithPendingChangeResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/FlatFeeWithPendingChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WithdrawMinBalance(ctx context.Context, in *QueryWithdrawMinBalanceRequest, opts ...grpc.CallOption) (*QueryWithdrawMinBalanceResponse, error) {
+	out := new(QueryWithdrawMinBalanceResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/WithdrawMinBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractRewardsHistory(ctx context.Context, in *QueryContractRewardsHistoryRequest, opts ...grpc.CallOption) (*QueryContractRewardsHistoryResponse, error) {
+	out := new(QueryContractRewardsHistoryResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Query/ContractRewardsHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns module parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ParamsMetadata returns the module parameters along with their validation
+	// constraints (type, allowed range and values).
+	ParamsMetadata(context.Context, *QueryParamsMetadataRequest) (*QueryParamsMetadataResponse, error)
+	// ContractMetadata returns the contract rewards parameters (metadata).
+	ContractMetadata(context.Context, *QueryContractMetadataRequest) (*QueryContractMetadataResponse, error)
+	// BlockRewardsTracking returns block rewards tracking for the current block.
+	BlockRewardsTracking(context.Context, *QueryBlockRewardsTrackingRequest) (*QueryBlockRewardsTrackingResponse, error)
+	// BlockRewardsTrackingRange returns block rewards tracking for the given
+	// range of block heights (only blocks with tracking data are reported).
+	BlockRewardsTrackingRange(context.Context, *QueryBlockRewardsTrackingRangeRequest) (*QueryBlockRewardsTrackingRangeResponse, error)
+	// RewardsPool returns the current undistributed rewards pool funds.
+	RewardsPool(context.Context, *QueryRewardsPoolRequest) (*QueryRewardsPoolResponse, error)
+	// EstimateTxFees returns the estimated transaction fees for the given
+	// transaction gas limit using the minimum consensus fee value for the current
+	// block.
+	EstimateTxFees(context.Context, *QueryEstimateTxFeesRequest) (*QueryEstimateTxFeesResponse, error)
+	// EstimateTxFeesForContracts returns the estimated transaction fees for the
+	// given transaction gas limit including the combined flat fees of the given
+	// contracts.
+	EstimateTxFeesForContracts(context.Context, *QueryEstimateTxFeesForContractsRequest) (*QueryEstimateTxFeesForContractsResponse, error)
+	// RewardsRecords returns the paginated list of RewardsRecord objects stored
+	// for the provided rewards_address.
+	RewardsRecords(context.Context, *QueryRewardsRecordsRequest) (*QueryRewardsRecordsResponse, error)
+	// OutstandingRewards returns total rewards credited from different contracts
+	// for the provided rewards_address.
+	OutstandingRewards(context.Context, *QueryOutstandingRewardsRequest) (*QueryOutstandingRewardsResponse, error)
+	// FlatFee returns the flat fee set by the contract owner for the provided
+	// contract_address
+	FlatFee(context.Context, *QueryFlatFeeRequest) (*QueryFlatFeeResponse, error)
+	// TxFeeDistribution returns how the transaction fees were distributed for
+	// the given transaction hash or for all the transactions within a block.
+	TxFeeDistribution(context.Context, *QueryTxFeeDistributionRequest) (*QueryTxFeeDistributionResponse, error)
+	// RewardsRatios returns the current inflation rewards and tx fee rebate
+	// ratios.
+	RewardsRatios(context.Context, *QueryRewardsRatiosRequest) (*QueryRewardsRatiosResponse, error)
+	// DistributionConfig returns all the module parameters affecting the fees
+	// and rewards distribution.
+	DistributionConfig(context.Context, *QueryDistributionConfigRequest) (*QueryDistributionConfigResponse, error)
+	// RewardsRecordByID returns a single RewardsRecord object by its ID.
+	RewardsRecordByID(context.Context, *QueryRewardsRecordByIDRequest) (*QueryRewardsRecordByIDResponse, error)
+	// ContractMetadataCount returns the total number of contracts with metadata
+	// set.
+	ContractMetadataCount(context.Context, *QueryContractMetadataCountRequest) (*QueryContractMetadataCountResponse, error)
+	// ContractsByCodeID returns the addresses of contracts with metadata set
+	// instantiated from the given code ID.
+	ContractsByCodeID(context.Context, *QueryContractsByCodeIDRequest) (*QueryContractsByCodeIDResponse, error)
+	// MinConsensusFeeDebug returns the stored minimum consensus fee inputs for
+	// the given block and the fee recomputed from them.
+	MinConsensusFeeDebug(context.Context, *QueryMinConsensusFeeDebugRequest) (*QueryMinConsensusFeeDebugResponse, error)
+	// FlatFeeBreakEven returns the estimated transaction fees for the given
+	// transaction gas limit and contract along with the share of the contract
+	// flat fee and the gas limit the gas fees match the flat fee at.
+	FlatFeeBreakEven(context.Context, *QueryFlatFeeBreakEvenRequest) (*QueryFlatFeeBreakEvenResponse, error)
+	// WouldAcceptFee returns whether the given transaction fee covers the
+	// minimum fee for the given gas limit and contracts (the MinFeeDecorator
+	// comparison) along with the missing amount.
+	WouldAcceptFee(context.Context, *QueryWouldAcceptFeeRequest) (*QueryWouldAcceptFeeResponse, error)
+	// TopContractsByRewards returns the contracts with the highest rewards
+	// distributed within the given number of recent blocks.
+	TopContractsByRewards(context.Context, *QueryTopContractsByRewardsRequest) (*QueryTopContractsByRewardsResponse, error)
+	// TxFeeSplit returns how a transaction fee would be split between the
+	// minimum fee portions (gas fees and contract flat fees) and the surplus.
+	TxFeeSplit(context.Context, *QueryTxFeeSplitRequest) (*QueryTxFeeSplitResponse, error)
+	// ContractRewardsEligibility returns whether the contract is eligible for
+	// the dApp rewards along with the underlying conditions.
+	ContractRewardsEligibility(context.Context, *QueryContractRewardsEligibilityRequest) (*QueryContractRewardsEligibilityResponse, error)
+	// MsgTypeFlatFee returns the contract flat fee charged for the given msg
+	// type targeting the contract.
+	MsgTypeFlatFee(context.Context, *QueryMsgTypeFlatFeeRequest) (*QueryMsgTypeFlatFeeResponse, error)
+	// TotalPendingRewards returns the total rewards the module owes to dApps
+	// along with the rewards pool balance backing them.
+	TotalPendingRewards(context.Context, *QueryTotalPendingRewardsRequest) (*QueryTotalPendingRewardsResponse, error)
+	// RewardsRecordsByAddressAndHeightRange returns the paginated list of
+	// RewardsRecord objects created for a rewards address within a block height
+	// range (ordered by height).
+	RewardsRecordsByAddressAndHeightRange(context.Context, *QueryRewardsRecordsByAddressAndHeightRangeRequest) (*QueryRewardsRecordsByAddressAndHeightRangeResponse, error)
+	// EstimateTxFeesForSimulatedGas returns the minimum transaction fees for the
+	// simulated gas used and for the gas limit adjusted by the client gas
+	// adjustment factor (--gas=auto --gas-adjustment).
+	EstimateTxFeesForSimulatedGas(context.Context, *QueryEstimateTxFeesForSimulatedGasRequest) (*QueryEstimateTxFeesForSimulatedGasResponse, error)
+	// ProjectedMinConsensusFee returns a best-effort projection of the minimum
+	// price of gas for the next block extrapolated from the recent blocks
+	// minimum consensus fee trend.
+	ProjectedMinConsensusFee(context.Context, *QueryProjectedMinConsensusFeeRequest) (*QueryProjectedMinConsensusFeeResponse, error)
+	// ContractFlatFeeRevenue returns the contract flat fees collected within the
+	// given number of recent blocks.
+	ContractFlatFeeRevenue(context.Context, *QueryContractFlatFeeRevenueRequest) (*QueryContractFlatFeeRevenueResponse, error)
+	// RewardsPoolSolvency checks that the rewards pool balance covers the
+	// rewards records pending withdrawal (the module solvency invariant).
+	RewardsPoolSolvency(context.Context, *QueryRewardsPoolSolvencyRequest) (*QueryRewardsPoolSolvencyResponse, error)
+	// AcceptedFeeDenoms returns the denoms transaction fees are accepted in along
+	// with their current minimum gas prices.
+	AcceptedFeeDenoms(context.Context, *QueryAcceptedFeeDenomsRequest) (*QueryAcceptedFeeDenomsResponse, error)
+	// BlockPoolInflows returns the breakdown of the tokens entered the rewards
+	// pool within the given block (fee rebates, inflation and flat fees).
+	BlockPoolInflows(context.Context, *QueryBlockPoolInflowsRequest) (*QueryBlockPoolInflowsResponse, error)
+	// ReconcileRewards compares the rewards pool balance against the pending
+	// rewards and reports the discrepancies (if any).
+	ReconcileRewards(context.Context, *QueryReconcileRewardsRequest) (*QueryReconcileRewardsResponse, error)
+	// TxFeeEstimate returns the estimated transaction fees for the given
+	// transaction gas limit, contracts and tx size in the versioned response
+	// shape (the fee components might be extended in the future versions).
+	TxFeeEstimate(context.Context, *QueryTxFeeEstimateRequest) (*QueryTxFeeEstimateResponse, error)
+	// BlockFlatFeeTxs returns the transactions which paid the contract flat fees
+	// within the given block along with the flat fee amounts.
+	BlockFlatFeeTxs(context.Context, *QueryBlockFlatFeeTxsRequest) (*QueryBlockFlatFeeTxsResponse, error)
+	// FlatFees returns the flat fees set for the given contracts (contracts
+	// without a flat fee are omitted).
+	FlatFees(context.Context, *QueryFlatFeesRequest) (*QueryFlatFeesResponse, error)
+	// FlatFeeWithPendingChange returns the current contract flat fee along with
+	// its pending scheduled change (the fee and the height it is charged from).
+	FlatFeeWithPendingChange(context.Context, *QueryFlatFeeWithPendingChangeRequest) (*QueryFlatFeeWithPendingChangeResponse, error)
+	// WithdrawMinBalance returns the min fee the rewards address must hold to
+	// submit a withdraw-all transaction for its rewards records.
+	WithdrawMinBalance(context.Context, *QueryWithdrawMinBalanceRequest) (*QueryWithdrawMinBalanceResponse, error)
+	// ContractRewardsHistory returns the paginated list of the rewards and flat
+	// fees distributed for a contract within a block height range (one row per
+	// block, ordered by height) in a flat structure suitable for tabular
+	// exports.
+	ContractRewardsHistory(context.Context, *QueryContractRewardsHistoryRequest) (*QueryContractRewardsHistoryResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ParamsMetadata(ctx context.Context, req *QueryParamsMetadataRequest) (*QueryParamsMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamsMetadata not implemented")
+}
+func (*UnimplementedQueryServer) ContractMetadata(ctx context.Context, req *QueryContractMetadataRequest) (*QueryContractMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractMetadata not implemented")
+}
+func (*UnimplementedQueryServer) BlockRewardsTracking(ctx context.Context, req *QueryBlockRewardsTrackingRequest) (*QueryBlockRewardsTrackingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockRewardsTracking not implemented")
+}
+func (*UnimplementedQueryServer) BlockRewardsTrackingRange(ctx context.Context, req *QueryBlockRewardsTrackingRangeRequest) (*QueryBlockRewardsTrackingRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockRewardsTrackingRange not implemented")
+}
+func (*UnimplementedQueryServer) RewardsPool(ctx context.Context, req *QueryRewardsPoolRequest) (*QueryRewardsPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardsPool not implemented")
+}
+func (*UnimplementedQueryServer) EstimateTxFees(ctx context.Context, req *QueryEstimateTxFeesRequest) (*QueryEstimateTxFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateTxFees not implemented")
+}
+func (*UnimplementedQueryServer) EstimateTxFeesForContracts(ctx context.Context, req *QueryEstimateTxFeesForContractsRequest) (*QueryEstimateTxFeesForContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateTxFeesForContracts not implemented")
+}
+func (*UnimplementedQueryServer) RewardsRecords(ctx context.Context, req *QueryRewardsRecordsRequest) (*QueryRewardsRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardsRecords not implemented")
+}
+func (*UnimplementedQueryServer) OutstandingRewards(ctx context.Context, req *QueryOutstandingRewardsRequest) (*QueryOutstandingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutstandingRewards not implemented")
+}
+func (*UnimplementedQueryServer) FlatFee(ctx context.Context, req *QueryFlatFeeRequest) (*QueryFlatFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlatFee not implemented")
+}
+func (*UnimplementedQueryServer) TxFeeDistribution(ctx context.Context, req *QueryTxFeeDistributionRequest) (*QueryTxFeeDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxFeeDistribution not implemented")
+}
+func (*UnimplementedQueryServer) RewardsRatios(ctx context.Context, req *QueryRewardsRatiosRequest) (*QueryRewardsRatiosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardsRatios not implemented")
+}
+func (*UnimplementedQueryServer) DistributionConfig(ctx context.Context, req *QueryDistributionConfigRequest) (*QueryDistributionConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DistributionConfig not implemented")
+}
+func (*UnimplementedQueryServer) RewardsRecordByID(ctx context.Context, req *QueryRewardsRecordByIDRequest) (*QueryRewardsRecordByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardsRecordByID not implemented")
+}
+func (*UnimplementedQueryServer) ContractMetadataCount(ctx context.Context, req *QueryContractMetadataCountRequest) (*QueryContractMetadataCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractMetadataCount not implemented")
+}
+func (*UnimplementedQueryServer) ContractsByCodeID(ctx context.Context, req *QueryContractsByCodeIDRequest) (*QueryContractsByCodeIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractsByCodeID not implemented")
+}
+func (*UnimplementedQueryServer) MinConsensusFeeDebug(ctx context.Context, req *QueryMinConsensusFeeDebugRequest) (*QueryMinConsensusFeeDebugResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinConsensusFeeDebug not implemented")
+}
+func (*UnimplementedQueryServer) FlatFeeBreakEven(ctx context.Context, req *QueryFlatFeeBreakEvenRequest) (*QueryFlatFeeBreakEvenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlatFeeBreakEven not implemented")
+}
+func (*UnimplementedQueryServer) WouldAcceptFee(ctx context.Context, req *QueryWouldAcceptFeeRequest) (*QueryWouldAcceptFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WouldAcceptFee not implemented")
+}
+func (*UnimplementedQueryServer) TopContractsByRewards(ctx context.Context, req *QueryTopContractsByRewardsRequest) (*QueryTopContractsByRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopContractsByRewards not implemented")
+}
+func (*UnimplementedQueryServer) TxFeeSplit(ctx context.Context, req *QueryTxFeeSplitRequest) (*QueryTxFeeSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxFeeSplit not implemented")
+}
+func (*UnimplementedQueryServer) ContractRewardsEligibility(ctx context.Context, req *QueryContractRewardsEligibilityRequest) (*QueryContractRewardsEligibilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractRewardsEligibility not implemented")
+}
+func (*UnimplementedQueryServer) MsgTypeFlatFee(ctx context.Context, req *QueryMsgTypeFlatFeeRequest) (*QueryMsgTypeFlatFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgTypeFlatFee not implemented")
+}
+func (*UnimplementedQueryServer) TotalPendingRewards(ctx context.Context, req *QueryTotalPendingRewardsRequest) (*QueryTotalPendingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalPendingRewards not implemented")
+}
+func (*UnimplementedQueryServer) RewardsRecordsByAddressAndHeightRange(ctx context.Context, req *QueryRewardsRecordsByAddressAndHeightRangeRequest) (*QueryRewardsRecordsByAddressAndHeightRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardsRecordsByAddressAndHeightRange not implemented")
+}
+func (*UnimplementedQueryServer) EstimateTxFeesForSimulatedGas(ctx context.Context, req *QueryEstimateTxFeesForSimulatedGasRequest) (*QueryEstimateTxFeesForSimulatedGasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateTxFeesForSimulatedGas not implemented")
+}
+func (*UnimplementedQueryServer) ProjectedMinConsensusFee(ctx context.Context, req *QueryProjectedMinConsensusFeeRequest) (*QueryProjectedMinConsensusFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectedMinConsensusFee not implemented")
+}
+func (*UnimplementedQueryServer) ContractFlatFeeRevenue(ctx context.Context, req *QueryContractFlatFeeRevenueRequest) (*QueryContractFlatFeeRevenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractFlatFeeRevenue not implemented")
+}
+func (*UnimplementedQueryServer) RewardsPoolSolvency(ctx context.Context, req *QueryRewardsPoolSolvencyRequest) (*QueryRewardsPoolSolvencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardsPoolSolvency not implemented")
+}
+func (*UnimplementedQueryServer) AcceptedFeeDenoms(ctx context.Context, req *QueryAcceptedFeeDenomsRequest) (*QueryAcceptedFeeDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptedFeeDenoms not implemented")
+}
+func (*UnimplementedQueryServer) BlockPoolInflows(ctx context.Context, req *QueryBlockPoolInflowsRequest) (*QueryBlockPoolInflowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockPoolInflows not implemented")
+}
+func (*UnimplementedQueryServer) ReconcileRewards(ctx context.Context, req *QueryReconcileRewardsRequest) (*QueryReconcileRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileRewards not implemented")
+}
+func (*UnimplementedQueryServer) TxFeeEstimate(ctx context.Context, req *QueryTxFeeEstimateRequest) (*QueryTxFeeEstimateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxFeeEstimate not implemented")
+}
+func (*UnimplementedQueryServer) BlockFlatFeeTxs(ctx context.Context, req *QueryBlockFlatFeeTxsRequest) (*QueryBlockFlatFeeTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockFlatFeeTxs not implemented")
+}
+func (*UnimplementedQueryServer) FlatFees(ctx context.Context, req *QueryFlatFeesRequest) (*QueryFlatFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlatFees not implemented")
+}
+func (*UnimplementedQueryServer) FlatFeeWithPendingChange(ctx context.Context, req *QueryFlatFeeWithPendingChangeRequest) (*QueryFlatFeeWithPendingChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlatFeeWithPendingChange not implemented")
+}
+func (*UnimplementedQueryServer) WithdrawMinBalance(ctx context.Context, req *QueryWithdrawMinBalanceRequest) (*QueryWithdrawMinBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawMinBalance not implemented")
+}
+func (*UnimplementedQueryServer) ContractRewardsHistory(ctx context.Context, req *QueryContractRewardsHistoryRequest) (*QueryContractRewardsHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractRewardsHistory not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParamsMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParamsMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Query/ParamsMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamsMetadata(ctx, req.(*QueryParamsMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Query/ContractMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractMetadata(ctx, req.(*QueryContractMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockRewardsTracking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockRewardsTrackingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockRewardsTracking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Query/BlockRewardsTracking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockRewardsTracking(ctx, req.(*QueryBlockRewardsTrackingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockRewardsTrackingRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockRewardsTrackingRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockRewardsTrackingRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Query/BlockRewardsTrackingRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockRewardsTrackingRange(ctx, req.(*QueryBlockRewardsTrackingRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardsPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardsPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardsPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Query/RewardsPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardsPool(ctx, req.(*QueryRewardsPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateTxFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateTxFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateTxFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Query/EstimateTxFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateTxFees(ctx, req.(*QueryEstimateTxFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateTxFeesForContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateTxFeesForContractsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateTxFeesForContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Query/EstimateTxFeesForContracts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateTxFeesForContracts(ctx, req.(*QueryEstimateTxFeesForContractsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardsRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardsRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardsRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Query/RewardsRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardsRecords(ctx, req.(*QueryRewardsRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OutstandingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOutstandingRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OutstandingRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Query/OutstandingRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutstandingRewards(ctx, req.(*QueryOutstandingRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FlatFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFlatFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FlatFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Query/FlatFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FlatFee(ctx, req.(*QueryFlatFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TxFeeDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTxFeeDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TxFeeDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Query/TxFeeDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TxFeeDistribution(ctx, req.(*QueryTxFeeDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardsRatios_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardsRatiosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardsRatios(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Query/RewardsRatios",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardsRatios(ctx, req.(*QueryRewardsRatiosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DistributionConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDistributionConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DistributionConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Query/DistributionConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DistributionConfig(ctx, req.(*QueryDistributionConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardsRecordByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardsRecordByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardsRecordByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Query/RewardsRecordByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardsRecordByID(ctx, req.(*QueryRewardsRecordByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractMetadataCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractMetadataCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractMetadataCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Query/ContractMetadataCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractMetadataCount(ctx, req.(*QueryContractMetadataCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractsByCodeID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractsByCodeIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractsByCodeID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Query/ContractsByCodeID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractsByCodeID(ctx, req.(*QueryContractsByCodeIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MinConsensusFeeDebug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMinConsensusFeeDebugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MinConsensusFeeDebug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Query/MinConsensusFeeDebug",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MinConsensusFeeDebug(ctx, req.(*QueryMinConsensusFeeDebugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FlatFeeBreakEven_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFlatFeeBreakEvenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FlatFeeBreakEven(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Query/FlatFeeBreakEven",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FlatFeeBreakEven(ctx, req.(*QueryFlatFeeBreakEvenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WouldAcceptFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWouldAcceptFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WouldAcceptFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Query/WouldAcceptFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WouldAcceptFee(ctx, req.(*QueryWouldAcceptFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TopContractsByRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopContractsByRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TopContractsByRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Query/TopContractsByRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TopContractsByRewards(ctx, req.(*QueryTopContractsByRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TxFeeSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTxFeeSplitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TxFeeSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Query/TxFeeSplit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TxFeeSplit(ctx, req.(*QueryTxFeeSplitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractRewardsEligibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractRewardsEligibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractRewardsEligibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Query/ContractRewardsEligibility",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractRewardsEligibility(ctx, req.(*QueryContractRewardsEligibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MsgTypeFlatFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMsgTypeFlatFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MsgTypeFlatFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Query/MsgTypeFlatFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MsgTypeFlatFee(ctx, req.(*QueryMsgTypeFlatFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalPendingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalPendingRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalPendingRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Query/TotalPendingRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalPendingRewards(ctx, req.(*QueryTotalPendingRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardsRecordsByAddressAndHeightRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardsRecordsByAddressAndHeightRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardsRecordsByAddressAndHeightRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Query/RewardsRecordsByAddressAndHeightRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardsRecordsByAddressAndHeightRange(ctx, req.(*QueryRewardsRecordsByAddressAndHeightRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateTxFeesForSimulatedGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateTxFeesForSimulatedGasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateTxFeesForSimulatedGas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Query/EstimateTxFeesForSimulatedGas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateTxFeesForSimulatedGas(ctx, req.(*QueryEstimateTxFeesForSimulatedGasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProjectedMinConsensusFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectedMinConsensusFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectedMinConsensusFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Query/ProjectedMinConsensusFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectedMinConsensusFee(ctx, req.(*QueryProjectedMinConsensusFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractFlatFeeRevenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractFlatFeeRevenueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractFlatFeeRevenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Query/ContractFlatFeeRevenue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractFlatFeeRevenue(ctx, req.(*QueryContractFlatFeeRevenueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardsPoolSolvency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardsPoolSolvencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardsPoolSolvency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Query/RewardsPoolSolvency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardsPoolSolvency(ctx, req.(*QueryRewardsPoolSolvencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AcceptedFeeDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAcceptedFeeDenomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AcceptedFeeDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Query/AcceptedFeeDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AcceptedFeeDenoms(ctx, req.(*QueryAcceptedFeeDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockPoolInflows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockPoolInflowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockPoolInflows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Query/BlockPoolInflows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockPoolInflows(ctx, req.(*QueryBlockPoolInflowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReconcileRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReconcileRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReconcileRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Query/ReconcileRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReconcileRewards(ctx, req.(*QueryReconcileRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TxFeeEstimate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTxFeeEstimateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TxFeeEstimate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Query/TxFeeEstimate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TxFeeEstimate(ctx, req.(*QueryTxFeeEstimateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockFlatFeeTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockFlatFeeTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockFlatFeeTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Query/BlockFlatFeeTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockFlatFeeTxs(ctx, req.(*QueryBlockFlatFeeTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FlatFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFlatFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FlatFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Query/FlatFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FlatFees(ctx, req.(*QueryFlatFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FlatFeeWithPendingChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFlatFeeWithPendingChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FlatFeeWithPendingChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Query/FlatFeeWithPendingChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FlatFeeWithPendingChange(ctx, req.(*QueryFlatFeeWithPendingChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WithdrawMinBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWithdrawMinBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WithdrawMinBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Query/WithdrawMinBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WithdrawMinBalance(ctx, req.(*QueryWithdrawMinBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractRewardsHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractRewardsHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractRewardsHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Query/ContractRewardsHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractRewardsHistory(ctx, req.(*QueryContractRewardsHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "archway.rewards.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ParamsMetadata",
+			Handler:    _Query_ParamsMetadata_Handler,
+		},
+		{
+			MethodName: "ContractMetadata",
+			Handler:    _Query_ContractMetadata_Handler,
+		},
+		{
+			MethodName: "BlockRewardsTracking",
+			Handler:    _Query_BlockRewardsTracking_Handler,
+		},
+		{
+			MethodName: "BlockRewardsTrackingRange",
+			Handler:    _Query_BlockRewardsTrackingRange_Handler,
+		},
+		{
+			MethodName: "RewardsPool",
+			Handler:    _Query_RewardsPool_Handler,
+		},
+		{
+			MethodName: "EstimateTxFees",
+			Handler:    _Query_EstimateTxFees_Handler,
+		},
+		{
+			MethodName: "EstimateTxFeesForContracts",
+			Handler:    _Query_EstimateTxFeesForContracts_Handler,
+		},
+		{
+			MethodName: "RewardsRecords",
+			Handler:    _Query_RewardsRecords_Handler,
+		},
+		{
+			MethodName: "OutstandingRewards",
+			Handler:    _Query_OutstandingRewards_Handler,
+		},
+		{
+			MethodName: "FlatFee",
+			Handler:    _Query_FlatFee_Handler,
+		},
+		{
+			MethodName: "TxFeeDistribution",
+			Handler:    _Query_TxFeeDistribution_Handler,
+		},
+		{
+			MethodName: "RewardsRatios",
+			Handler:    _Query_RewardsRatios_Handler,
+		},
+		{
+			MethodName: "DistributionConfig",
+			Handler:    _Query_DistributionConfig_Handler,
+		},
+		{
+			MethodName: "RewardsRecordByID",
+			Handler:    _Query_RewardsRecordByID_Handler,
+		},
+		{
+			MethodName: "ContractMetadataCount",
+			Handler:    _Query_ContractMetadataCount_Handler,
+		},
+		{
+			MethodName: "ContractsByCodeID",
+			Handler:    _Query_ContractsByCodeID_Handler,
+		},
+		{
+			MethodName: "MinConsensusFeeDebug",
+			Handler:    _Query_MinConsensusFeeDebug_Handler,
+		},
+		{
+			MethodName: "FlatFeeBreakEven",
+			Handler:    _Query_FlatFeeBreakEven_Handler,
+		},
+		{
+			MethodName: "WouldAcceptFee",
+			Handler:    _Query_WouldAcceptFee_Handler,
+		},
+		{
+			MethodName: "TopContractsByRewards",
+			Handler:    _Query_TopContractsByRewards_Handler,
+		},
+		{
+			MethodName: "TxFeeSplit",
+			Handler:    _Query_TxFeeSplit_Handler,
+		},
+		{
+			MethodName: "ContractRewardsEligibility",
+			Handler:    _Query_ContractRewardsEligibility_Handler,
+		},
+		{
+			MethodName: "MsgTypeFlatFee",
+			Handler:    _Query_MsgTypeFlatFee_Handler,
+		},
+		{
+			MethodName: "TotalPendingRewards",
+			Handler:    _Query_TotalPendingRewards_Handler,
+		},
+		{
+			MethodName: "RewardsRecordsByAddressAndHeightRange",
+			Handler:    _Query_RewardsRecordsByAddressAndHeightRange_Handler,
+		},
+		{
+			MethodName: "EstimateTxFeesForSimulatedGas",
+			Handler:    _Query_EstimateTxFeesForSimulatedGas_Handler,
+		},
+		{
+			MethodName: "ProjectedMinConsensusFee",
+			Handler:    _Query_ProjectedMinConsensusFee_Handler,
+		},
+		{
+			MethodName: "ContractFlatFeeRevenue",
+			Handler:    _Query_ContractFlatFeeRevenue_Handler,
+		},
+		{
+			MethodName: "RewardsPoolSolvency",
+			Handler:    _Query_RewardsPoolSolvency_Handler,
+		},
+		{
+			MethodName: "AcceptedFeeDenoms",
+			Handler:    _Query_AcceptedFeeDenoms_Handler,
+		},
+		{
+			MethodName: "BlockPoolInflows",
+			Handler:    _Query_BlockPoolInflows_Handler,
+		},
+		{
+			MethodName: "ReconcileRewards",
+			Handler:    _Query_ReconcileRewards_Handler,
+		},
+		{
+			MethodName: "TxFeeEstimate",
+			Handler:    _Query_TxFeeEstimate_Handler,
+		},
+		{
+			MethodName: "BlockFlatFeeTxs",
+			Handler:    _Query_BlockFlatFeeTxs_Handler,
+		},
+		{
+			MethodName: "FlatFees",
+			Handler:    _Query_FlatFees_Handler,
+		},
+		{
+			MethodName: "FlatFeeWithPendingChange",
+			Handler:    _Query_FlatFeeWithPendingChange_Handler,
+		},
+		{
+			MethodName: "WithdrawMinBalance",
+			Handler:    _Query_WithdrawMinBalance_Handler,
+		},
+		{
+			MethodName: "ContractRewardsHistory",
+			Handler:    _Query_ContractRewardsHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "archway/rewards/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Constraints) > 0 {
+		i -= len(m.Constraints)
+		copy(dAtA[i:], m.Constraints)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Constraints)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.AllowedValues) > 0 {
+		for iNdEx := len(m.AllowedValues) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedValues[iNdEx])
+			copy(dAtA[i:], m.AllowedValues[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.AllowedValues[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.MaxExclusive {
+		i--
+		if m.MaxExclusive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Max) > 0 {
+		i -= len(m.Max)
+		copy(dAtA[i:], m.Max)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Max)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Min) > 0 {
+		i -= len(m.Min)
+		copy(dAtA[i:], m.Min)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Min)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockRewardsTrackingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockRewardsTrackingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockRewardsTrackingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockRewardsTrackingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockRewardsTrackingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockRewardsTrackingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockRewardsTrackingRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockRewardsTrackingRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockRewardsTrackingRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockRewardsTrackingRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockRewardsTrackingRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockRewardsTrackingRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TreasuryFunds) > 0 {
+		for iNdEx := len(m.TreasuryFunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TreasuryFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.UndistributedFunds) > 0 {
+		for iNdEx := len(m.UndistributedFunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UndistributedFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateTxFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateTxFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateTxFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateTxFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateTxFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateTxFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EstimatedFee) > 0 {
+		for iNdEx := len(m.EstimatedFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EstimatedFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.GasUnitPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateTxFeesForContractsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateTxFeesForContractsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateTxFeesForContractsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TxSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ContractAddresses) > 0 {
+		for iNdEx := len(m.ContractAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ContractAddresses[iNdEx])
+			copy(dAtA[i:], m.ContractAddresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateTxFeesForContractsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateTxFeesForContractsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateTxFeesForContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxSizeFees) > 0 {
+		for iNdEx := len(m.TxSizeFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TxSizeFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.GasFees) > 0 {
+		for iNdEx := len(m.GasFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.FlatFees) > 0 {
+		for iNdEx := len(m.FlatFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FlatFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.EstimatedFee) > 0 {
+		for iNdEx := len(m.EstimatedFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EstimatedFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.GasUnitPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFlatFeeBreakEvenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFlatFeeBreakEvenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFlatFeeBreakEvenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFlatFeeBreakEvenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFlatFeeBreakEvenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFlatFeeBreakEvenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BreakEvenGasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BreakEvenGasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.FlatFeeShare.Size()
+		i -= size
+		if _, err := m.FlatFeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.FlatFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.EstimatedFee) > 0 {
+		for iNdEx := len(m.EstimatedFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EstimatedFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.GasUnitPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWouldAcceptFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWouldAcceptFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWouldAcceptFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddresses) > 0 {
+		for iNdEx := len(m.ContractAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ContractAddresses[iNdEx])
+			copy(dAtA[i:], m.ContractAddresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWouldAcceptFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWouldAcceptFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWouldAcceptFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shortfall) > 0 {
+		for iNdEx := len(m.Shortfall) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shortfall[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Accepted {
+		i--
+		if m.Accepted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxFeeSplitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxFeeSplitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxFeeSplitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddresses) > 0 {
+		for iNdEx := len(m.ContractAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ContractAddresses[iNdEx])
+			copy(dAtA[i:], m.ContractAddresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxFeeSplitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxFeeSplitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxFeeSplitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Surplus) > 0 {
+		for iNdEx := len(m.Surplus) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Surplus[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.FlatFees) > 0 {
+		for iNdEx := len(m.FlatFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FlatFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.GasFees) > 0 {
+		for iNdEx := len(m.GasFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Accepted {
+		i--
+		if m.Accepted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractFlatFeeSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractFlatFeeSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractFlatFeeSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockTracking) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockTracking) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockTracking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxRewards) > 0 {
+		for iNdEx := len(m.TxRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TxRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.InflationRewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RewardsAddress) > 0 {
+		i -= len(m.RewardsAddress)
+		copy(dAtA[i:], m.RewardsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RewardsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutstandingRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutstandingRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutstandingRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardsAddress) > 0 {
+		i -= len(m.RewardsAddress)
+		copy(dAtA[i:], m.RewardsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RewardsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutstandingRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutstandingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutstandingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecordsNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RecordsNum))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TotalRewards) > 0 {
+		for iNdEx := len(m.TotalRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFlatFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFlatFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFlatFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFlatFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFlatFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFlatFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FlatFeeAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxFeeDistributionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxFeeDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxFeeDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxFeeDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxFeeDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxFeeDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Distributions) > 0 {
+		for iNdEx := len(m.Distributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsRatiosRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsRatiosRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsRatiosRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsRatiosResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsRatiosResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsRatiosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TxFeeRebateRatio.Size()
+		i -= size
+		if _, err := m.TxFeeRebateRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.InflationRewardsRatio.Size()
+		i -= size
+		if _, err := m.InflationRewardsRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributionConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributionConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributionConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributionConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributionConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributionConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsRecordByIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsRecordByIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsRecordByIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsRecordByIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsRecordByIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsRecordByIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractMetadataCountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractMetadataCountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractMetadataCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractMetadataCountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractMetadataCountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractMetadataCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractsByCodeIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractsByCodeIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractsByCodeIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CodeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CodeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractsByCodeIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractsByCodeIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractsByCodeIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddresses) > 0 {
+		for iNdEx := len(m.ContractAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ContractAddresses[iNdEx])
+			copy(dAtA[i:], m.ContractAddresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinConsensusFeeDebugRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinConsensusFeeDebugRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinConsensusFeeDebugRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinConsensusFeeDebugResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinConsensusFeeDebugResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinConsensusFeeDebugResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.StoredFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.ComputedFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TxFeeRebateRatio.Size()
+		i -= size
+		if _, err := m.TxFeeRebateRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.BlockRewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopContractsByRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopContractsByRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopContractsByRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Window != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopContractsByRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopContractsByRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopContractsByRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Contracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractRewardsEligibilityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractRewardsEligibilityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractRewardsEligibilityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractRewardsEligibilityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractRewardsEligibilityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractRewardsEligibilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RewardsEnabled {
+		i--
+		if m.RewardsEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.HasRewardsRecipients {
+		i--
+		if m.HasRewardsRecipients {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.HasMetadata {
+		i--
+		if m.HasMetadata {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Eligible {
+		i--
+		if m.Eligible {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMsgTypeFlatFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMsgTypeFlatFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMsgTypeFlatFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMsgTypeFlatFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMsgTypeFlatFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMsgTypeFlatFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MethodFlatFee {
+		i--
+		if m.MethodFlatFee {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.FlatFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalPendingRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalPendingRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalPendingRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalPendingRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalPendingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalPendingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UndistributedFunds) > 0 {
+		for iNdEx := len(m.UndistributedFunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UndistributedFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PendingRewards) > 0 {
+		for iNdEx := len(m.PendingRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsRecordsByAddressAndHeightRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsRecordsByAddressAndHeightRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsRecordsByAddressAndHeightRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RewardsAddress) > 0 {
+		i -= len(m.RewardsAddress)
+		copy(dAtA[i:], m.RewardsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RewardsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsRecordsByAddressAndHeightRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsRecordsByAddressAndHeightRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsRecordsByAddressAndHeightRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateTxFeesForSimulatedGasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateTxFeesForSimulatedGasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateTxFeesForSimulatedGasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.GasAdjustment.Size()
+		i -= size
+		if _, err := m.GasAdjustment.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.SimulatedGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SimulatedGas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateTxFeesForSimulatedGasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateTxFeesForSimulatedGasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateTxFeesForSimulatedGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AdjustedGasFee) > 0 {
+		for iNdEx := len(m.AdjustedGasFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdjustedGasFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SimulatedGasFee) > 0 {
+		for iNdEx := len(m.SimulatedGasFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SimulatedGasFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.AdjustedGasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AdjustedGasLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.GasUnitPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectedMinConsensusFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedMinConsensusFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedMinConsensusFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectedMinConsensusFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedMinConsensusFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedMinConsensusFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlocksUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlocksUsed))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.ProjectedGasPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.CurrentGasPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractFlatFeeRevenueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractFlatFeeRevenueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractFlatFeeRevenueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractFlatFeeRevenueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractFlatFeeRevenueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractFlatFeeRevenueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Revenue) > 0 {
+		for iNdEx := len(m.Revenue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Revenue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsPoolSolvencyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsPoolSolvencyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsPoolSolvencyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsPoolSolvencyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsPoolSolvencyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsPoolSolvencyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Liabilities) > 0 {
+		for iNdEx := len(m.Liabilities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Liabilities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PoolBalance) > 0 {
+		for iNdEx := len(m.PoolBalance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Solvent {
+		i--
+		if m.Solvent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAcceptedFeeDenomsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAcceptedFeeDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAcceptedFeeDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAcceptedFeeDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAcceptedFeeDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAcceptedFeeDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AnyDenomAccepted {
+		i--
+		if m.AnyDenomAccepted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MinGasPrices) > 0 {
+		for iNdEx := len(m.MinGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockPoolInflowsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockPoolInflowsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockPoolInflowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockPoolInflowsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockPoolInflowsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockPoolInflowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Inflows.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReconcileRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReconcileRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReconcileRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReconcileRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReconcileRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReconcileRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Balanced {
+		i--
+		if m.Balanced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Reconciliation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxFeeEstimateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxFeeEstimateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxFeeEstimateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TxSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ContractAddresses) > 0 {
+		for iNdEx := len(m.ContractAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ContractAddresses[iNdEx])
+			copy(dAtA[i:], m.ContractAddresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxFeeEstimateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxFeeEstimateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxFeeEstimateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FlatFees) > 0 {
+		for iNdEx := len(m.FlatFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FlatFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.TxSizeFees) > 0 {
+		for iNdEx := len(m.TxSizeFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TxSizeFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.GasFees) > 0 {
+		for iNdEx := len(m.GasFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.EstimatedFee) > 0 {
+		for iNdEx := len(m.EstimatedFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EstimatedFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.GasUnitPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockFlatFeeTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockFlatFeeTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockFlatFeeTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockFlatFeeTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockFlatFeeTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockFlatFeeTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Truncated {
+		i--
+		if m.Truncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockFlatFeeTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockFlatFeeTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockFlatFeeTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FlatFees) > 0 {
+		for iNdEx := len(m.FlatFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FlatFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TxId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TxId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFlatFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFlatFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFlatFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddresses) > 0 {
+		for iNdEx := len(m.ContractAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ContractAddresses[iNdEx])
+			copy(dAtA[i:], m.ContractAddresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFlatFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFlatFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFlatFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FlatFees) > 0 {
+		for iNdEx := len(m.FlatFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FlatFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractFlatFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractFlatFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractFlatFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FlatFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFlatFeeWithPendingChangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFlatFeeWithPendingChangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFlatFeeWithPendingChangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFlatFeeWithPendingChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFlatFeeWithPendingChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFlatFeeWithPendingChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingChange != nil {
+		{
+			size, err := m.PendingChange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.FlatFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FlatFeePendingChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlatFeePendingChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FlatFeePendingChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ActivationHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.FlatFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawMinBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawMinBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawMinBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RewardsAddress) > 0 {
+		i -= len(m.RewardsAddress)
+		copy(dAtA[i:], m.RewardsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RewardsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawMinBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawMinBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawMinBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sufficient {
+		i--
+		if m.Sufficient {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MinBalance) > 0 {
+		for iNdEx := len(m.MinBalance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RecordsNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RecordsNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractRewardsHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractRewardsHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractRewardsHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractRewardsHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractRewardsHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractRewardsHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Rows) > 0 {
+		for iNdEx := len(m.Rows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractRewardsHistoryRow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractRewardsHistoryRow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractRewardsHistoryRow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FlatFees) > 0 {
+		i -= len(m.FlatFees)
+		copy(dAtA[i:], m.FlatFees)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FlatFees)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Rewards) > 0 {
+		i -= len(m.Rewards)
+		copy(dAtA[i:], m.Rewards)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Rewards)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Metadata) > 0 {
+		for _, e := range m.Metadata {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ParamMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Min)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Max)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxExclusive {
+		n += 2
+	}
+	if len(m.AllowedValues) > 0 {
+		for _, s := range m.AllowedValues {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Constraints)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Metadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBlockRewardsTrackingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBlockRewardsTrackingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Block.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBlockRewardsTrackingRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	return n
+}
+
+func (m *QueryBlockRewardsTrackingRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		for _, e := range m.Blocks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRewardsPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRewardsPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UndistributedFunds) > 0 {
+		for _, e := range m.UndistributedFunds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TreasuryFunds) > 0 {
+		for _, e := range m.TreasuryFunds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEstimateTxFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.GasLimit))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEstimateTxFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GasUnitPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.EstimatedFee) > 0 {
+		for _, e := range m.EstimatedFee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEstimateTxFeesForContractsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.GasLimit))
+	}
+	if len(m.ContractAddresses) > 0 {
+		for _, s := range m.ContractAddresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TxSize != 0 {
+		n += 1 + sovQuery(uint64(m.TxSize))
+	}
+	return n
+}
+
+func (m *QueryEstimateTxFeesForContractsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GasUnitPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.EstimatedFee) > 0 {
+		for _, e := range m.EstimatedFee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.FlatFees) > 0 {
+		for _, e := range m.FlatFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.GasFees) > 0 {
+		for _, e := range m.GasFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TxSizeFees) > 0 {
+		for _, e := range m.TxSizeFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFlatFeeBreakEvenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *QueryFlatFeeBreakEvenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GasUnitPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.EstimatedFee) > 0 {
+		for _, e := range m.EstimatedFee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.FlatFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.FlatFeeShare.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.BreakEvenGasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.BreakEvenGasLimit))
+	}
+	return n
+}
+
+func (m *QueryWouldAcceptFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.GasLimit))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ContractAddresses) > 0 {
+		for _, s := range m.ContractAddresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryWouldAcceptFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Accepted {
+		n += 2
+	}
+	if len(m.Shortfall) > 0 {
+		for _, e := range m.Shortfall {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTxFeeSplitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.GasLimit))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ContractAddresses) > 0 {
+		for _, s := range m.ContractAddresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTxFeeSplitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Accepted {
+		n += 2
+	}
+	if len(m.GasFees) > 0 {
+		for _, e := range m.GasFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.FlatFees) > 0 {
+		for _, e := range m.FlatFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Surplus) > 0 {
+		for _, e := range m.Surplus {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractFlatFeeSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlockTracking) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InflationRewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.TxRewards) > 0 {
+		for _, e := range m.TxRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRewardsRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RewardsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardsRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOutstandingRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RewardsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOutstandingRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TotalRewards) > 0 {
+		for _, e := range m.TotalRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.RecordsNum != 0 {
+		n += 1 + sovQuery(uint64(m.RecordsNum))
+	}
+	return n
+}
+
+func (m *QueryFlatFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFlatFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FlatFeeAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTxFeeDistributionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTxFeeDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Distributions) > 0 {
+		for _, e := range m.Distributions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRewardsRatiosRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRewardsRatiosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InflationRewardsRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TxFeeRebateRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDistributionConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDistributionConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Config.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRewardsRecordByIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryRewardsRecordByIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryContractMetadataCountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryContractMetadataCountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *QueryContractsByCodeIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeId != 0 {
+		n += 1 + sovQuery(uint64(m.CodeId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractsByCodeIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ContractAddresses) > 0 {
+		for _, s := range m.ContractAddresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMinConsensusFeeDebugRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryMinConsensusFeeDebugResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BlockRewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TxFeeRebateRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ComputedFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.StoredFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTopContractsByRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != 0 {
+		n += 1 + sovQuery(uint64(m.Window))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryTopContractsByRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Contracts) > 0 {
+		for _, e := range m.Contracts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryContractRewardsEligibilityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractRewardsEligibilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Eligible {
+		n += 2
+	}
+	if m.HasMetadata {
+		n += 2
+	}
+	if m.HasRewardsRecipients {
+		n += 2
+	}
+	if m.RewardsEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryMsgTypeFlatFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMsgTypeFlatFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FlatFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.MethodFlatFee {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryTotalPendingRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalPendingRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingRewards) > 0 {
+		for _, e := range m.PendingRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.UndistributedFunds) > 0 {
+		for _, e := range m.UndistributedFunds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRewardsRecordsByAddressAndHeightRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RewardsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardsRecordsByAddressAndHeightRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEstimateTxFeesForSimulatedGasRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SimulatedGas != 0 {
+		n += 1 + sovQuery(uint64(m.SimulatedGas))
+	}
+	l = m.GasAdjustment.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEstimateTxFeesForSimulatedGasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GasUnitPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.AdjustedGasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.AdjustedGasLimit))
+	}
+	if len(m.SimulatedGasFee) > 0 {
+		for _, e := range m.SimulatedGasFee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.AdjustedGasFee) > 0 {
+		for _, e := range m.AdjustedGasFee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProjectedMinConsensusFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != 0 {
+		n += 1 + sovQuery(uint64(m.Window))
+	}
+	return n
+}
+
+func (m *QueryProjectedMinConsensusFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrentGasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ProjectedGasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.BlocksUsed != 0 {
+		n += 1 + sovQuery(uint64(m.BlocksUsed))
+	}
+	return n
+}
+
+func (m *QueryContractFlatFeeRevenueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Window != 0 {
+		n += 1 + sovQuery(uint64(m.Window))
+	}
+	return n
+}
+
+func (m *QueryContractFlatFeeRevenueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Revenue) > 0 {
+		for _, e := range m.Revenue {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRewardsPoolSolvencyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRewardsPoolSolvencyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Solvent {
+		n += 2
+	}
+	if len(m.PoolBalance) > 0 {
+		for _, e := range m.PoolBalance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Liabilities) > 0 {
+		for _, e := range m.Liabilities {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAcceptedFeeDenomsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAcceptedFeeDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinGasPrices) > 0 {
+		for _, e := range m.MinGasPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.AnyDenomAccepted {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryBlockPoolInflowsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryBlockPoolInflowsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Inflows.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryReconcileRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryReconcileRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Reconciliation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Balanced {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryTxFeeEstimateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.GasLimit))
+	}
+	if len(m.ContractAddresses) > 0 {
+		for _, s := range m.ContractAddresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TxSize != 0 {
+		n += 1 + sovQuery(uint64(m.TxSize))
+	}
+	return n
+}
+
+func (m *QueryTxFeeEstimateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	l = m.GasUnitPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.EstimatedFee) > 0 {
+		for _, e := range m.EstimatedFee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.GasFees) > 0 {
+		for _, e := range m.GasFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TxSizeFees) > 0 {
+		for _, e := range m.TxSizeFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.FlatFees) > 0 {
+		for _, e := range m.FlatFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBlockFlatFeeTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryBlockFlatFeeTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Truncated {
+		n += 2
+	}
+	return n
+}
+
+func (m *BlockFlatFeeTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxId != 0 {
+		n += 1 + sovQuery(uint64(m.TxId))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.FlatFees) > 0 {
+		for _, e := range m.FlatFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFlatFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ContractAddresses) > 0 {
+		for _, s := range m.ContractAddresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFlatFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FlatFees) > 0 {
+		for _, e := range m.FlatFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractFlatFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.FlatFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFlatFeeWithPendingChangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFlatFeeWithPendingChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FlatFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.PendingChange != nil {
+		l = m.PendingChange.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *FlatFeePendingChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FlatFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ActivationHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ActivationHeight))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	return n
+}
+
+func (m *QueryWithdrawMinBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RewardsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *QueryWithdrawMinBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecordsNum != 0 {
+		n += 1 + sovQuery(uint64(m.RecordsNum))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.GasLimit))
+	}
+	if len(m.MinBalance) > 0 {
+		for _, e := range m.MinBalance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Sufficient {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryContractRewardsHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractRewardsHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rows) > 0 {
+		for _, e := range m.Rows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractRewardsHistoryRow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Rewards)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.FlatFees)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata, ParamMetadata{})
+			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Min = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Max = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExclusive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaxExclusive = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedValues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedValues = append(m.AllowedValues, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constraints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Constraints = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockRewardsTrackingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockRewardsTrackingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockRewardsTrackingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockRewardsTrackingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockRewardsTrackingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockRewardsTrackingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockRewardsTrackingRangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockRewardsTrackingRangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockRewardsTrackingRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockRewardsTrackingRangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockRewardsTrackingRangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockRewardsTrackingRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blocks = append(m.Blocks, BlockTracking{})
+			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UndistributedFunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UndistributedFunds = append(m.UndistributedFunds, types.Coin{})
+			if err := m.UndistributedFunds[len(m.UndistributedFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryFunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TreasuryFunds = append(m.TreasuryFunds, types.Coin{})
+			if err := m.TreasuryFunds[len(m.TreasuryFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateTxFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateTxFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateTxFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateTxFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateTxFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateTxFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUnitPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasUnitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EstimatedFee = append(m.EstimatedFee, types.Coin{})
+			if err := m.EstimatedFee[len(m.EstimatedFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateTxFeesForContractsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateTxFeesForContractsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateTxFeesForContractsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddresses = append(m.ContractAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSize", wireType)
+			}
+			m.TxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateTxFeesForContractsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateTxFeesForContractsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateTxFeesForContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUnitPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasUnitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EstimatedFee = append(m.EstimatedFee, types.Coin{})
+			if err := m.EstimatedFee[len(m.EstimatedFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FlatFees = append(m.FlatFees, types.Coin{})
+			if err := m.FlatFees[len(m.FlatFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasFees = append(m.GasFees, types.Coin{})
+			if err := m.GasFees[len(m.GasFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSizeFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxSizeFees = append(m.TxSizeFees, types.Coin{})
+			if err := m.TxSizeFees[len(m.TxSizeFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFlatFeeBreakEvenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFlatFeeBreakEvenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFlatFeeBreakEvenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFlatFeeBreakEvenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFlatFeeBreakEvenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFlatFeeBreakEvenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUnitPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasUnitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EstimatedFee = append(m.EstimatedFee, types.Coin{})
+			if err := m.EstimatedFee[len(m.EstimatedFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FlatFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFeeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FlatFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BreakEvenGasLimit", wireType)
+			}
+			m.BreakEvenGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BreakEvenGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWouldAcceptFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWouldAcceptFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWouldAcceptFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddresses = append(m.ContractAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWouldAcceptFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWouldAcceptFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWouldAcceptFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Accepted = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shortfall", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shortfall = append(m.Shortfall, types.Coin{})
+			if err := m.Shortfall[len(m.Shortfall)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxFeeSplitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxFeeSplitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxFeeSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddresses = append(m.ContractAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxFeeSplitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxFeeSplitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxFeeSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Accepted = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasFees = append(m.GasFees, types.Coin{})
+			if err := m.GasFees[len(m.GasFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FlatFees = append(m.FlatFees, ContractFlatFeeSplit{})
+			if err := m.FlatFees[len(m.FlatFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Surplus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Surplus = append(m.Surplus, types.Coin{})
+			if err := m.Surplus[len(m.Surplus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractFlatFeeSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractFlatFeeSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractFlatFeeSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockTracking) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockTracking: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockTracking: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxRewards = append(m.TxRewards, TxRewards{})
+			if err := m.TxRewards[len(m.TxRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, RewardsRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutstandingRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutstandingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutstandingRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutstandingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalRewards = append(m.TotalRewards, types.Coin{})
+			if err := m.TotalRewards[len(m.TotalRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordsNum", wireType)
+			}
+			m.RecordsNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordsNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFlatFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFlatFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFlatFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFlatFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFlatFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFlatFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFeeAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FlatFeeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxFeeDistributionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxFeeDistributionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxFeeDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxFeeDistributionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxFeeDistributionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxFeeDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distributions = append(m.Distributions, TxFeeDistribution{})
+			if err := m.Distributions[len(m.Distributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsRatiosRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsRatiosRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsRatiosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsRatiosResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsRatiosResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsRatiosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRewardsRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRewardsRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxFeeRebateRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxFeeRebateRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDistributionConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDistributionConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDistributionConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDistributionConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDistributionConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDistributionConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsRecordByIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsRecordByIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsRecordByIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsRecordByIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsRecordByIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsRecordByIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractMetadataCountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractMetadataCountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractMetadataCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractMetadataCountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractMetadataCountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractMetadataCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractsByCodeIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractsByCodeIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractsByCodeIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
+			}
+			m.CodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractsByCodeIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractsByCodeIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractsByCodeIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddresses = append(m.ContractAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMinConsensusFeeDebugRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinConsensusFeeDebugRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinConsensusFeeDebugRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMinConsensusFeeDebugResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinConsensusFeeDebugResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinConsensusFeeDebugResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxFeeRebateRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxFeeRebateRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComputedFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ComputedFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StoredFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTopContractsByRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTopContractsByRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTopContractsByRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTopContractsByRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTopContractsByRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTopContractsByRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, ContractRewards{})
+			if err := m.Contracts[len(m.Contracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractRewardsEligibilityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractRewardsEligibilityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractRewardsEligibilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractRewardsEligibilityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractRewardsEligibilityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractRewardsEligibilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eligible", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Eligible = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMetadata", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasMetadata = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasRewardsRecipients", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasRewardsRecipients = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RewardsEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMsgTypeFlatFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMsgTypeFlatFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMsgTypeFlatFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMsgTypeFlatFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMsgTypeFlatFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMsgTypeFlatFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FlatFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MethodFlatFee", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MethodFlatFee = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalPendingRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalPendingRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalPendingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalPendingRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalPendingRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalPendingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRewards = append(m.PendingRewards, types.Coin{})
+			if err := m.PendingRewards[len(m.PendingRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UndistributedFunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UndistributedFunds = append(m.UndistributedFunds, types.Coin{})
+			if err := m.UndistributedFunds[len(m.UndistributedFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsRecordsByAddressAndHeightRangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsRecordsByAddressAndHeightRangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsRecordsByAddressAndHeightRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsRecordsByAddressAndHeightRangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsRecordsByAddressAndHeightRangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsRecordsByAddressAndHeightRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, RewardsRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateTxFeesForSimulatedGasRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateTxFeesForSimulatedGasRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateTxFeesForSimulatedGasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SimulatedGas", wireType)
+			}
+			m.SimulatedGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SimulatedGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasAdjustment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasAdjustment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateTxFeesForSimulatedGasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateTxFeesForSimulatedGasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateTxFeesForSimulatedGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUnitPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasUnitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdjustedGasLimit", wireType)
+			}
+			m.AdjustedGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdjustedGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SimulatedGasFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SimulatedGasFee = append(m.SimulatedGasFee, types.Coin{})
+			if err := m.SimulatedGasFee[len(m.SimulatedGasFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdjustedGasFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdjustedGasFee = append(m.AdjustedGasFee, types.Coin{})
+			if err := m.AdjustedGasFee[len(m.AdjustedGasFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectedMinConsensusFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedMinConsensusFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedMinConsensusFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectedMinConsensusFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedMinConsensusFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedMinConsensusFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentGasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedGasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProjectedGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksUsed", wireType)
+			}
+			m.BlocksUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractFlatFeeRevenueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractFlatFeeRevenueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractFlatFeeRevenueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractFlatFeeRevenueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractFlatFeeRevenueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractFlatFeeRevenueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revenue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revenue = append(m.Revenue, types.Coin{})
+			if err := m.Revenue[len(m.Revenue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsPoolSolvencyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsPoolSolvencyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsPoolSolvencyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsPoolSolvencyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsPoolSolvencyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsPoolSolvencyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Solvent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Solvent = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolBalance = append(m.PoolBalance, types.Coin{})
+			if err := m.PoolBalance[len(m.PoolBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liabilities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Liabilities = append(m.Liabilities, types.Coin{})
+			if err := m.Liabilities[len(m.Liabilities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAcceptedFeeDenomsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAcceptedFeeDenomsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAcceptedFeeDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAcceptedFeeDenomsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAcceptedFeeDenomsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAcceptedFeeDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinGasPrices = append(m.MinGasPrices, types.DecCoin{})
+			if err := m.MinGasPrices[len(m.MinGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnyDenomAccepted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AnyDenomAccepted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockPoolInflowsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockPoolInflowsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockPoolInflowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockPoolInflowsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockPoolInflowsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockPoolInflowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReconcileRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReconcileRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReconcileRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReconcileRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReconcileRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReconcileRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reconciliation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reconciliation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balanced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Balanced = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxFeeEstimateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxFeeEstimateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxFeeEstimateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddresses = append(m.ContractAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSize", wireType)
+			}
+			m.TxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxFeeEstimateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2174,25 +18814,213 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxFeeEstimateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxFeeEstimateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUnitPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.GasUnitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EstimatedFee = append(m.EstimatedFee, types.Coin{})
+			if err := m.EstimatedFee[len(m.EstimatedFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasFees = append(m.GasFees, types.Coin{})
+			if err := m.GasFees[len(m.GasFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSizeFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxSizeFees = append(m.TxSizeFees, types.Coin{})
+			if err := m.TxSizeFees[len(m.TxSizeFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FlatFees = append(m.FlatFees, types.Coin{})
+			if err := m.FlatFees[len(m.FlatFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2214,7 +19042,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBlockFlatFeeTxsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2237,17 +19065,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlockFlatFeeTxsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlockFlatFeeTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2257,25 +19085,11 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2297,7 +19111,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryContractMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBlockFlatFeeTxsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2320,17 +19134,17 @@ func (m *QueryContractMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContractMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlockFlatFeeTxsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContractMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlockFlatFeeTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2340,24 +19154,46 @@ func (m *QueryContractMetadataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.Txs = append(m.Txs, BlockFlatFeeTx{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Truncated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2379,7 +19215,7 @@ func (m *QueryContractMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryContractMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *BlockFlatFeeTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2402,15 +19238,66 @@ func (m *QueryContractMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContractMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlockFlatFeeTx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContractMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlockFlatFeeTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			m.TxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2437,7 +19324,8 @@ func (m *QueryContractMetadataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FlatFees = append(m.FlatFees, types.Coin{})
+			if err := m.FlatFees[len(m.FlatFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2462,7 +19350,7 @@ func (m *QueryContractMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlockRewardsTrackingRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFlatFeesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2485,12 +19373,44 @@ func (m *QueryBlockRewardsTrackingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlockRewardsTrackingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFlatFeesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlockRewardsTrackingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFlatFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddresses = append(m.ContractAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2512,7 +19432,7 @@ func (m *QueryBlockRewardsTrackingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlockRewardsTrackingResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFlatFeesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2535,15 +19455,15 @@ func (m *QueryBlockRewardsTrackingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlockRewardsTrackingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFlatFeesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlockRewardsTrackingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFlatFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2570,7 +19490,8 @@ func (m *QueryBlockRewardsTrackingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FlatFees = append(m.FlatFees, ContractFlatFee{})
+			if err := m.FlatFees[len(m.FlatFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2595,57 +19516,7 @@ func (m *QueryBlockRewardsTrackingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardsPoolRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardsPoolRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardsPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryRewardsPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *ContractFlatFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2668,17 +19539,17 @@ func (m *QueryRewardsPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardsPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractFlatFee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardsPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractFlatFee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UndistributedFunds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2688,29 +19559,27 @@ func (m *QueryRewardsPoolResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UndistributedFunds = append(m.UndistributedFunds, types.Coin{})
-			if err := m.UndistributedFunds[len(m.UndistributedFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryFunds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2737,8 +19606,7 @@ func (m *QueryRewardsPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TreasuryFunds = append(m.TreasuryFunds, types.Coin{})
-			if err := m.TreasuryFunds[len(m.TreasuryFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FlatFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2763,7 +19631,7 @@ func (m *QueryRewardsPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEstimateTxFeesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFlatFeeWithPendingChangeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2786,32 +19654,13 @@ func (m *QueryEstimateTxFeesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEstimateTxFeesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFlatFeeWithPendingChangeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEstimateTxFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFlatFeeWithPendingChangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
-			}
-			m.GasLimit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GasLimit |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
@@ -2864,7 +19713,7 @@ func (m *QueryEstimateTxFeesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEstimateTxFeesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFlatFeeWithPendingChangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2887,15 +19736,15 @@ func (m *QueryEstimateTxFeesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEstimateTxFeesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFlatFeeWithPendingChangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEstimateTxFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFlatFeeWithPendingChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasUnitPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2922,13 +19771,13 @@ func (m *QueryEstimateTxFeesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GasUnitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FlatFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingChange", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2955,8 +19804,10 @@ func (m *QueryEstimateTxFeesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EstimatedFee = append(m.EstimatedFee, types.Coin{})
-			if err := m.EstimatedFee[len(m.EstimatedFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PendingChange == nil {
+				m.PendingChange = &FlatFeePendingChange{}
+			}
+			if err := m.PendingChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2981,7 +19832,7 @@ func (m *QueryEstimateTxFeesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockTracking) Unmarshal(dAtA []byte) error {
+func (m *FlatFeePendingChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3004,15 +19855,15 @@ func (m *BlockTracking) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockTracking: wiretype end group for non-group")
+			return fmt.Errorf("proto: FlatFeePendingChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockTracking: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FlatFeePendingChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3039,15 +19890,15 @@ func (m *BlockTracking) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InflationRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FlatFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxRewards", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
 			}
-			var msglen int
+			m.ActivationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3057,26 +19908,30 @@ func (m *BlockTracking) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ActivationHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			m.TxRewards = append(m.TxRewards, TxRewards{})
-			if err := m.TxRewards[len(m.TxRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3098,7 +19953,7 @@ func (m *BlockTracking) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardsRecordsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWithdrawMinBalanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3121,10 +19976,10 @@ func (m *QueryRewardsRecordsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardsRecordsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWithdrawMinBalanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardsRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWithdrawMinBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3160,10 +20015,10 @@ func (m *QueryRewardsRecordsRequest) Unmarshal(dAtA []byte) error {
 			m.RewardsAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
 			}
-			var msglen int
+			m.GasLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3173,28 +20028,11 @@ func (m *QueryRewardsRecordsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.GasLimit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3216,7 +20054,7 @@ func (m *QueryRewardsRecordsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardsRecordsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWithdrawMinBalanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3235,19 +20073,57 @@ func (m *QueryRewardsRecordsResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardsRecordsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardsRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawMinBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawMinBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordsNum", wireType)
+			}
+			m.RecordsNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordsNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBalance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3274,16 +20150,16 @@ func (m *QueryRewardsRecordsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Records = append(m.Records, RewardsRecord{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MinBalance = append(m.MinBalance, types.Coin{})
+			if err := m.MinBalance[len(m.MinBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sufficient", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3293,28 +20169,12 @@ func (m *QueryRewardsRecordsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Sufficient = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3336,7 +20196,7 @@ func (m *QueryRewardsRecordsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryContractRewardsHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3359,15 +20219,15 @@ func (m *QueryOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOutstandingRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractRewardsHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOutstandingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractRewardsHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardsAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3395,7 +20255,81 @@ func (m *QueryOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardsAddress = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3418,7 +20352,7 @@ func (m *QueryOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryContractRewardsHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3441,15 +20375,15 @@ func (m *QueryOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOutstandingRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractRewardsHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOutstandingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractRewardsHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3476,16 +20410,16 @@ func (m *QueryOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalRewards = append(m.TotalRewards, types.Coin{})
-			if err := m.TotalRewards[len(m.TotalRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rows = append(m.Rows, ContractRewardsHistoryRow{})
+			if err := m.Rows[len(m.Rows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordsNum", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.RecordsNum = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3495,11 +20429,28 @@ func (m *QueryOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RecordsNum |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3521,7 +20472,7 @@ func (m *QueryOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFlatFeeRequest) Unmarshal(dAtA []byte) error {
+func (m *ContractRewardsHistoryRow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3544,13 +20495,32 @@ func (m *QueryFlatFeeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFlatFeeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractRewardsHistoryRow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFlatFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractRewardsHistoryRow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
@@ -3582,61 +20552,43 @@ func (m *QueryFlatFeeRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryFlatFeeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFlatFeeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFlatFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Rewards = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FlatFeeAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFees", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3646,24 +20598,23 @@ func (m *QueryFlatFeeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FlatFeeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FlatFees = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2,14 +2,27 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/archway-network/archway/pkg"
 )
 
+const (
+	// MaxFlatFeeMethodLength defines the max length of the execute msg method name a flat fee could be set for.
+	MaxFlatFeeMethodLength = 64
+	// MaxFlatFeePrepayExecutions defines the max number of contract executions prepaid by a single operation.
+	MaxFlatFeePrepayExecutions = 1_000_000
+	// FlatFeePrepayDiscountBase defines the fixed denominator for the flat fee prepay discount (basis points, 100%).
+	FlatFeePrepayDiscountBase uint64 = 10_000
+	// FeePromotionDiscountBase defines the fixed denominator for the fee promotion discount (basis points, 100%).
+	FeePromotionDiscountBase uint64 = 10_000
+)
+
 // HasRewards returns true if the block rewards have been set.
 func (m BlockRewards) HasRewards() bool {
 	return !m.InflationRewards.IsZero()
@@ -97,6 +110,12 @@ func (m RewardsRecord) Validate() error {
 		return fmt.Errorf("calculatedTime: must be non-zero")
 	}
 
+	if m.ContractAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(m.ContractAddress); err != nil {
+			return fmt.Errorf("contractAddress: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -110,6 +129,63 @@ func (m FlatFee) Validate() error {
 		return errorsmod.Wrapf(sdkErrors.ErrInvalidCoins, "invalid flat fee coin: %v", err)
 	}
 
+	if m.Schedule != nil {
+		if err := m.Schedule.Validate(); err != nil {
+			return errorsmod.Wrapf(ErrInvalidRequest, "invalid flat fee schedule: %v", err)
+		}
+		if !m.Schedule.EndFee.Equal(m.FlatFee) {
+			return errorsmod.Wrapf(ErrInvalidRequest, "flat fee (%s) must match the schedule end fee (%s)", m.FlatFee, m.Schedule.EndFee)
+		}
+	}
+
+	if m.Method != "" {
+		if err := ValidateFlatFeeMethod(m.Method); err != nil {
+			return err
+		}
+		if m.Schedule != nil {
+			return errorsmod.Wrap(ErrInvalidRequest, "flat fee schedule can not be set for a method flat fee")
+		}
+	}
+
+	return ValidateFlatFeeMultiplier(m.MinConsFeeMultiplier, m.Schedule != nil, m.Method != "")
+}
+
+// HasMinConsFeeMultiplier returns true if the flat fee is charged relative to the min consensus fee.
+func (m FlatFee) HasMinConsFeeMultiplier() bool {
+	return !m.MinConsFeeMultiplier.IsNil() && !m.MinConsFeeMultiplier.IsZero()
+}
+
+// ValidateFlatFeeMultiplier validates the flat fee min consensus fee multiplier (nil or zero if not set).
+func ValidateFlatFeeMultiplier(multiplier math.LegacyDec, hasSchedule, hasMethod bool) error {
+	if multiplier.IsNil() || multiplier.IsZero() {
+		return nil
+	}
+
+	if multiplier.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidRequest, "flat fee min consensus fee multiplier must be positive: %s", multiplier)
+	}
+	if hasSchedule {
+		return errorsmod.Wrap(ErrInvalidRequest, "flat fee min consensus fee multiplier can not be set with a schedule")
+	}
+	if hasMethod {
+		return errorsmod.Wrap(ErrInvalidRequest, "flat fee min consensus fee multiplier can not be set for a method flat fee")
+	}
+
+	return nil
+}
+
+// ValidateFlatFeeMethod validates the execute msg method name a flat fee is set for.
+func ValidateFlatFeeMethod(method string) error {
+	if method == "" {
+		return errorsmod.Wrap(ErrInvalidRequest, "flat fee method: must be non-empty")
+	}
+	if len(method) > MaxFlatFeeMethodLength {
+		return errorsmod.Wrapf(ErrInvalidRequest, "flat fee method: length must be LTE %d", MaxFlatFeeMethodLength)
+	}
+	if strings.TrimSpace(method) != method || strings.ContainsAny(method, "\"\\") {
+		return errorsmod.Wrapf(ErrInvalidRequest, "flat fee method (%s): must not contain leading / trailing spaces or quotes", method)
+	}
+
 	return nil
 }
 
@@ -123,3 +199,213 @@ func (m FlatFee) MustGetContractAddress() sdk.AccAddress {
 
 	return addr
 }
+
+// Validate performs object fields validation.
+func (m FlatFeeSchedule) Validate() error {
+	if m.StartHeight < 0 {
+		return fmt.Errorf("startHeight: must be GTE 0")
+	}
+	if m.EndHeight <= m.StartHeight {
+		return fmt.Errorf("endHeight: must be GT startHeight")
+	}
+
+	if err := pkg.ValidateCoin(m.StartFee); err != nil {
+		return fmt.Errorf("startFee: %w", err)
+	}
+	if err := pkg.ValidateCoin(m.EndFee); err != nil {
+		return fmt.Errorf("endFee: %w", err)
+	}
+	if m.EndFee.IsZero() {
+		return fmt.Errorf("endFee: must be GT 0")
+	}
+	if m.StartFee.Denom != m.EndFee.Denom {
+		return fmt.Errorf("startFee and endFee denoms must match")
+	}
+
+	return nil
+}
+
+// FeeAt returns the flat fee for the given block height.
+// The fee is linearly interpolated between the start and end fees (truncated), the start / end fee is used outside the schedule range.
+func (m FlatFeeSchedule) FeeAt(height int64) sdk.Coin {
+	if height <= m.StartHeight {
+		return m.StartFee
+	}
+	if height >= m.EndHeight {
+		return m.EndFee
+	}
+
+	elapsed, duration := math.NewInt(height-m.StartHeight), math.NewInt(m.EndHeight-m.StartHeight)
+	amt := m.StartFee.Amount.Add(
+		m.EndFee.Amount.Sub(m.StartFee.Amount).Mul(elapsed).Quo(duration),
+	)
+
+	return sdk.NewCoin(m.EndFee.Denom, amt)
+}
+
+// Validate performs object fields validation.
+func (m ContractCodeID) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.ContractAddress); err != nil {
+		return fmt.Errorf("contractAddress: %w", err)
+	}
+
+	if m.CodeId == 0 {
+		return fmt.Errorf("codeId: must be GT 0")
+	}
+
+	return nil
+}
+
+// PrepaidFlatFee returns the fee for the given number of prepaid contract executions: the flat fee for every execution
+// with the discount (basis points) applied. The result is rounded up.
+func PrepaidFlatFee(flatFee sdk.Coin, executions, discount uint64) sdk.Coin {
+	amount := math.LegacyNewDecFromInt(flatFee.Amount.Mul(math.NewIntFromUint64(executions))).
+		MulInt64(int64(FlatFeePrepayDiscountBase - discount)).
+		QuoInt64(int64(FlatFeePrepayDiscountBase))
+
+	return sdk.NewCoin(flatFee.Denom, amount.Ceil().TruncateInt())
+}
+
+// Validate performs object fields validation.
+func (m FlatFeeCredit) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.ContractAddress); err != nil {
+		return fmt.Errorf("contractAddress: %w", err)
+	}
+
+	if m.Executions == 0 {
+		return fmt.Errorf("executions: must be GT 0")
+	}
+
+	return nil
+}
+
+// MustGetContractAddress returns the contract address.
+// CONTRACT: panics in case of an error.
+func (m ContractCodeID) MustGetContractAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.ContractAddress)
+	if err != nil {
+		panic(fmt.Errorf("parsing contract address: %w", err))
+	}
+
+	return addr
+}
+
+// MustGetContractAddress returns the contract address.
+// CONTRACT: panics in case of an error.
+func (m FlatFeeCredit) MustGetContractAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.ContractAddress)
+	if err != nil {
+		panic(fmt.Errorf("parsing contract address: %w", err))
+	}
+
+	return addr
+}
+
+// Validate performs object fields validation.
+func (m CodeFlatFee) Validate() error {
+	if m.CodeId == 0 {
+		return fmt.Errorf("codeId: must be GT 0")
+	}
+
+	if err := m.FlatFee.Validate(); err != nil {
+		return fmt.Errorf("flatFee: %w", err)
+	}
+	if m.FlatFee.IsZero() {
+		return fmt.Errorf("flatFee: must be GT 0")
+	}
+
+	return nil
+}
+
+// Validate performs object fields validation.
+func (m ScheduledRewardsRatios) Validate() error {
+	if m.ActivationHeight <= 0 {
+		return fmt.Errorf("activationHeight: must be GT 0")
+	}
+
+	if m.InflationRewardsRatio.IsNil() || m.TxFeeRebateRatio.IsNil() {
+		return fmt.Errorf("ratios must be set")
+	}
+
+	if err := validateInflationRewardsRatio(m.InflationRewardsRatio); err != nil {
+		return err
+	}
+
+	if err := validateTxFeeRebateRatio(m.TxFeeRebateRatio); err != nil {
+		return err
+	}
+
+	return validateRewardsRatiosSum(m.InflationRewardsRatio, m.TxFeeRebateRatio)
+}
+
+// IsActive checks if the override applies at the given block height.
+func (m FlatFeeOverride) IsActive(height int64) bool {
+	return height < m.ExpiryHeight
+}
+
+// Validate performs object fields validation.
+func (m FlatFeeOverride) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.ContractAddress); err != nil {
+		return fmt.Errorf("contractAddress: %w", err)
+	}
+
+	if err := m.FlatFee.Validate(); err != nil {
+		return fmt.Errorf("flatFee: %w", err)
+	}
+
+	if m.ExpiryHeight <= 0 {
+		return fmt.Errorf("expiryHeight: must be GT 0")
+	}
+
+	return nil
+}
+
+// MustGetContractAddress returns the contract address.
+// CONTRACT: panics in case of an error.
+func (m FlatFeeOverride) MustGetContractAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.ContractAddress)
+	if err != nil {
+		panic(fmt.Errorf("parsing contract address: %w", err))
+	}
+
+	return addr
+}
+
+// IsBalanced returns true if the rewards pool balance matches the expected tokens (no surplus and no deficit).
+func (m RewardsReconciliation) IsBalanced() bool {
+	return sdk.Coins(m.Surplus).IsZero() && sdk.Coins(m.Deficit).IsZero()
+}
+
+// Validate performs object fields validation.
+func (m ExtensionOptionFlatFeeTip) Validate() error {
+	contractsSet := make(map[string]struct{}, len(m.Tips))
+	for i, tip := range m.Tips {
+		if err := tip.Validate(); err != nil {
+			return fmt.Errorf("tips [%d]: %w", i, err)
+		}
+
+		if _, ok := contractsSet[tip.ContractAddress]; ok {
+			return fmt.Errorf("tips [%d]: duplicated contractAddress (%s)", i, tip.ContractAddress)
+		}
+		contractsSet[tip.ContractAddress] = struct{}{}
+	}
+
+	return nil
+}
+
+// Validate performs object fields validation.
+func (m FlatFeeTip) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.ContractAddress); err != nil {
+		return fmt.Errorf("contractAddress: %w", err)
+	}
+
+	amount := sdk.Coins(m.Amount)
+	if err := amount.Validate(); err != nil {
+		return fmt.Errorf("amount: %w", err)
+	}
+	if amount.IsZero() {
+		return fmt.Errorf("amount: must be GT 0")
+	}
+
+	return nil
+}
@@ -31,6 +31,41 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// FlatFeeMigrationPolicy defines how the contract flat fee is reconciled when
+// the contract is migrated to a new code ID.
+type FlatFeeMigrationPolicy int32
+
+const (
+	// FLAT_FEE_MIGRATION_POLICY_UNSPECIFIED falls back to
+	// FLAT_FEE_MIGRATION_POLICY_KEEP.
+	FlatFeeMigrationPolicy_FLAT_FEE_MIGRATION_POLICY_UNSPECIFIED FlatFeeMigrationPolicy = 0
+	// FLAT_FEE_MIGRATION_POLICY_KEEP keeps the contract flat fee configuration.
+	FlatFeeMigrationPolicy_FLAT_FEE_MIGRATION_POLICY_KEEP FlatFeeMigrationPolicy = 1
+	// FLAT_FEE_MIGRATION_POLICY_INHERIT_CODE_ID replaces the contract flat fee
+	// with the new code ID default flat fee (if set).
+	FlatFeeMigrationPolicy_FLAT_FEE_MIGRATION_POLICY_INHERIT_CODE_ID FlatFeeMigrationPolicy = 2
+)
+
+var FlatFeeMigrationPolicy_name = map[int32]string{
+	0: "FLAT_FEE_MIGRATION_POLICY_UNSPECIFIED",
+	1: "FLAT_FEE_MIGRATION_POLICY_KEEP",
+	2: "FLAT_FEE_MIGRATION_POLICY_INHERIT_CODE_ID",
+}
+
+var FlatFeeMigrationPolicy_value = map[string]int32{
+	"FLAT_FEE_MIGRATION_POLICY_UNSPECIFIED":     0,
+	"FLAT_FEE_MIGRATION_POLICY_KEEP":            1,
+	"FLAT_FEE_MIGRATION_POLICY_INHERIT_CODE_ID": 2,
+}
+
+func (x FlatFeeMigrationPolicy) String() string {
+	return proto.EnumName(FlatFeeMigrationPolicy_name, int32(x))
+}
+
+func (FlatFeeMigrationPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_187c4abc9caff98d, []int{0}
+}
+
 // Params defines the module parameters.
 type Params struct {
 	// inflation_rewards_ratio defines the percentage of minted inflation tokens
@@ -49,6 +84,135 @@ type Params struct {
 	// minimum tx computational fees, which are independent from contract flat
 	// fees (premiums)
 	MinPriceOfGas types.DecCoin `protobuf:"bytes,4,opt,name=min_price_of_gas,json=minPriceOfGas,proto3" json:"min_price_of_gas"`
+	// dynamic_fee_enabled enables the EIP-1559 like fee mode: the min consensus
+	// fee is used as a base gas price, a transaction defines its max priority
+	// gas price via the ExtensionOptionDynamicFee tx extension option and the
+	// fee surplus is refunded after the transaction execution.
+	DynamicFeeEnabled bool `protobuf:"varint,6,opt,name=dynamic_fee_enabled,json=dynamicFeeEnabled,proto3" json:"dynamic_fee_enabled,omitempty"`
+	// flat_fee_update_interval defines the minimum number of blocks between two
+	// consecutive contract flat fee updates. If set to 0, updates are not
+	// rate-limited.
+	FlatFeeUpdateInterval uint64 `protobuf:"varint,7,opt,name=flat_fee_update_interval,json=flatFeeUpdateInterval,proto3" json:"flat_fee_update_interval,omitempty"`
+	// max_flat_fee_update_contracts defines the maximum number of contracts
+	// which flat fees could be updated by a single MsgSetFlatFeeByCodeID
+	// operation. If set to 0, bulk flat fee updates are disabled.
+	MaxFlatFeeUpdateContracts uint64 `protobuf:"varint,8,opt,name=max_flat_fee_update_contracts,json=maxFlatFeeUpdateContracts,proto3" json:"max_flat_fee_update_contracts,omitempty"`
+	// flat_fee_deliver_tx_only defines whether contract flat fees are charged in
+	// DeliverTx only. If set, flat fees are not required by CheckTx (mempool),
+	// but are still enforced during the block execution.
+	FlatFeeDeliverTxOnly bool `protobuf:"varint,9,opt,name=flat_fee_deliver_tx_only,json=flatFeeDeliverTxOnly,proto3" json:"flat_fee_deliver_tx_only,omitempty"`
+	// min_fee_floor_enabled defines whether a zero minimum transaction fee is
+	// floored to 1 unit of the gas price (MinPriceOfGas) denom. If set,
+	// zero-fee transactions are rejected even if the derived minimum consensus
+	// fee is zero.
+	MinFeeFloorEnabled bool `protobuf:"varint,10,opt,name=min_fee_floor_enabled,json=minFeeFloorEnabled,proto3" json:"min_fee_floor_enabled,omitempty"`
+	// min_contract_execution_gas defines the minimum gas limit of a transaction
+	// containing contract executions (wasm msgs, authz wrapped ones included).
+	// Transactions with a lower gas limit are rejected before fees are taken.
+	// Zero value disables the check.
+	MinContractExecutionGas uint64 `protobuf:"varint,11,opt,name=min_contract_execution_gas,json=minContractExecutionGas,proto3" json:"min_contract_execution_gas,omitempty"`
+	// free_tx_budget defines the number of transactions each account (the tx fee
+	// payer) could send without covering the minimum fee. Once the budget is
+	// exhausted, the minimum fee applies. Transactions paying contract flat fees
+	// are not covered by the budget. Zero value disables the budget.
+	FreeTxBudget uint64 `protobuf:"varint,12,opt,name=free_tx_budget,json=freeTxBudget,proto3" json:"free_tx_budget,omitempty"`
+	// max_gas_rebate_multiplier defines the upper bound of the contract gas
+	// rebate multiplier (basis points, 10000 is 1.0x). Contract multipliers are
+	// clamped to this value. Values up to 10000 (zero included) disable custom
+	// multipliers.
+	MaxGasRebateMultiplier uint64 `protobuf:"varint,13,opt,name=max_gas_rebate_multiplier,json=maxGasRebateMultiplier,proto3" json:"max_gas_rebate_multiplier,omitempty"`
+	// flat_fee_once_per_block defines whether a contract flat fee is charged
+	// once per block. If set, transactions targeting a contract already charged
+	// by another transaction within the same block are not charged the contract
+	// flat fee.
+	FlatFeeOncePerBlock bool `protobuf:"varint,14,opt,name=flat_fee_once_per_block,json=flatFeeOncePerBlock,proto3" json:"flat_fee_once_per_block,omitempty"`
+	// accepted_fee_denoms defines the denoms transaction fees could be paid in.
+	// If set, transactions paying fees in other denoms are rejected. The gas
+	// price (MinPriceOfGas) denom, which is the bond denom, must be accepted.
+	// Empty list accepts fees in any denom.
+	AcceptedFeeDenoms []string `protobuf:"bytes,15,rep,name=accepted_fee_denoms,json=acceptedFeeDenoms,proto3" json:"accepted_fee_denoms,omitempty"`
+	// tx_size_fee_per_byte defines the minimum fee surcharge (in the gas price
+	// denom) charged per byte of the encoded transaction. The surcharge is added
+	// to the gas based minimum fee. Zero value disables the surcharge.
+	TxSizeFeePerByte uint64 `protobuf:"varint,16,opt,name=tx_size_fee_per_byte,json=txSizeFeePerByte,proto3" json:"tx_size_fee_per_byte,omitempty"`
+	// single_denom_fees_only defines whether transaction fees must be paid in a
+	// single denom. If set, transactions paying fees in multiple denoms are
+	// rejected.
+	SingleDenomFeesOnly bool `protobuf:"varint,17,opt,name=single_denom_fees_only,json=singleDenomFeesOnly,proto3" json:"single_denom_fees_only,omitempty"`
+	// flat_fee_prepay_discount defines the discount applied to the contract flat
+	// fee for prepaid executions (basis points, 10000 is 100%). Values must be
+	// less than 10000, zero value disables the discount.
+	FlatFeePrepayDiscount uint64 `protobuf:"varint,18,opt,name=flat_fee_prepay_discount,json=flatFeePrepayDiscount,proto3" json:"flat_fee_prepay_discount,omitempty"`
+	// flat_fee_payer_must_sign defines whether the transaction fee payer must
+	// sign the contract execute msgs charged the contract flat fees. If set,
+	// transactions charging flat fees for msgs not signed by the fee payer are
+	// rejected.
+	FlatFeePayerMustSign bool `protobuf:"varint,19,opt,name=flat_fee_payer_must_sign,json=flatFeePayerMustSign,proto3" json:"flat_fee_payer_must_sign,omitempty"`
+	// flat_fees_enabled defines whether the contract flat fees are charged. If
+	// not set, transactions are charged the gas based minimum fee only (contract
+	// flat fee configurations are kept).
+	FlatFeesEnabled bool `protobuf:"varint,20,opt,name=flat_fees_enabled,json=flatFeesEnabled,proto3" json:"flat_fees_enabled,omitempty"`
+	// fee_denom_routes defines the per denom destinations of the transaction
+	// fees sent to the x/auth fee collector. Fees in a routed denom are sent to
+	// the route module account instead, fees in other denoms are kept by the fee
+	// collector. Empty list disables the routing.
+	FeeDenomRoutes []FeeDenomRoute `protobuf:"bytes,21,rep,name=fee_denom_routes,json=feeDenomRoutes,proto3" json:"fee_denom_routes"`
+	// flat_fee_conversion_rates defines the rates the contract flat fees
+	// configured in one denom are accepted in another transaction fee denom at.
+	// Empty list disables the conversion (flat fees are only accepted in the
+	// configured denom).
+	FlatFeeConversionRates []FlatFeeConversionRate `protobuf:"bytes,22,rep,name=flat_fee_conversion_rates,json=flatFeeConversionRates,proto3" json:"flat_fee_conversion_rates"`
+	// check_tx_min_fee_event_enabled defines whether the minimum fee expected is
+	// reported by the TxFeesEstimateEvent event within the CheckTx response of
+	// an accepted transaction.
+	CheckTxMinFeeEventEnabled bool `protobuf:"varint,23,opt,name=check_tx_min_fee_event_enabled,json=checkTxMinFeeEventEnabled,proto3" json:"check_tx_min_fee_event_enabled,omitempty"`
+	// max_contract_block_rewards defines the maximum rewards (per denom) a single
+	// contract could be distributed within a block. The excess is returned to
+	// the pool (the treasury) along with other undistributed rewards. Empty list
+	// (or a denom not listed) disables the cap.
+	MaxContractBlockRewards []types.Coin `protobuf:"bytes,24,rep,name=max_contract_block_rewards,json=maxContractBlockRewards,proto3" json:"max_contract_block_rewards"`
+	// flat_fee_migration_policy defines whether a contract migrated to a new
+	// code ID keeps its flat fee (KEEP) or inherits the new code ID default flat
+	// fee set by governance (INHERIT_CODE_ID).
+	FlatFeeMigrationPolicy FlatFeeMigrationPolicy `protobuf:"varint,25,opt,name=flat_fee_migration_policy,json=flatFeeMigrationPolicy,proto3,enum=archway.rewards.v1.FlatFeeMigrationPolicy" json:"flat_fee_migration_policy,omitempty"`
+	// max_flat_fee_msgs_per_tx defines the maximum number of contract execute
+	// msgs charged the contract flat fees (authz.MsgExec wrapped ones included)
+	// a single transaction could contain. Transactions exceeding the limit are
+	// rejected. Zero value disables the limit.
+	MaxFlatFeeMsgsPerTx uint64 `protobuf:"varint,26,opt,name=max_flat_fee_msgs_per_tx,json=maxFlatFeeMsgsPerTx,proto3" json:"max_flat_fee_msgs_per_tx,omitempty"`
+	// flat_fee_absorbed_in_gas_fee defines whether the contract flat fees in the
+	// gas price denom are counted toward the gas min fee (the combined min fee
+	// for that denom is the max of the two) instead of being stacked on top of
+	// it. Not applied in the dynamic fee mode.
+	FlatFeeAbsorbedInGasFee bool `protobuf:"varint,27,opt,name=flat_fee_absorbed_in_gas_fee,json=flatFeeAbsorbedInGasFee,proto3" json:"flat_fee_absorbed_in_gas_fee,omitempty"`
+	// fee_promotion defines the governance fee promotion discounting the min fee
+	// (both gas and contract flat fees) within a block height window.
+	FeePromotion FeePromotion `protobuf:"bytes,28,opt,name=fee_promotion,json=feePromotion,proto3" json:"fee_promotion"`
+	// flat_fee_refund_on_failure defines whether the contract flat fees are
+	// refunded if the tx msgs execution fails (reverted by the VM). Flat fees of
+	// every contract are charged the flat_fee_on_success metadata flag way:
+	// only once the tx msgs are executed successfully.
+	FlatFeeRefundOnFailure bool `protobuf:"varint,29,opt,name=flat_fee_refund_on_failure,json=flatFeeRefundOnFailure,proto3" json:"flat_fee_refund_on_failure,omitempty"`
+	// check_tx_local_min_gas_price_enabled defines whether CheckTx (the mempool
+	// admission) uses the max of the node local min gas price (the
+	// minimum-gas-prices node config) in the MinPriceOfGas denom and the
+	// consensus one (the local price could only raise the bar). DeliverTx always
+	// enforces the consensus min gas price regardless of the node config.
+	CheckTxLocalMinGasPriceEnabled bool `protobuf:"varint,30,opt,name=check_tx_local_min_gas_price_enabled,json=checkTxLocalMinGasPriceEnabled,proto3" json:"check_tx_local_min_gas_price_enabled,omitempty"`
+	// min_consensus_fee_smoothing_window defines the number of recent blocks the
+	// minimum consensus fee is averaged over (the moving average dampens the
+	// block-to-block min fee volatility). Zero and one use the instantaneous
+	// value.
+	MinConsensusFeeSmoothingWindow uint64 `protobuf:"varint,31,opt,name=min_consensus_fee_smoothing_window,json=minConsensusFeeSmoothingWindow,proto3" json:"min_consensus_fee_smoothing_window,omitempty"`
+	// rewards_callback_gas_limit defines the gas limit of the contract rewards
+	// callback sudo call (refer to the ContractMetadata
+	// rewards_callback_address). If set to 0, callbacks are disabled.
+	RewardsCallbackGasLimit uint64 `protobuf:"varint,32,opt,name=rewards_callback_gas_limit,json=rewardsCallbackGasLimit,proto3" json:"rewards_callback_gas_limit,omitempty"`
+	// rewards_callbacks_block_gas_limit defines the total gas budget of the
+	// contract rewards callbacks within a block. Callbacks the budget left can't
+	// cover (rewards_callback_gas_limit) are skipped. If set to 0, callbacks are
+	// disabled.
+	RewardsCallbacksBlockGasLimit uint64 `protobuf:"varint,33,opt,name=rewards_callbacks_block_gas_limit,json=rewardsCallbacksBlockGasLimit,proto3" json:"rewards_callbacks_block_gas_limit,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -98,174 +262,226 @@ func (m *Params) GetMinPriceOfGas() types.DecCoin {
 	return types.DecCoin{}
 }
 
-// ContractMetadata defines the contract rewards distribution options for a
-// particular contract.
-type ContractMetadata struct {
-	// contract_address defines the contract address (bech32 encoded).
-	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
-	// owner_address is the contract owner address that can modify contract reward
-	// options (bech32 encoded). That could be the contract admin or the contract
-	// itself. If owner_address is set to contract address, contract can modify
-	// the metadata on its own using WASM bindings.
-	OwnerAddress string `protobuf:"bytes,2,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
-	// rewards_address is an address to distribute rewards to (bech32 encoded).
-	// If not set (empty), rewards are not distributed for this contract.
-	RewardsAddress string `protobuf:"bytes,3,opt,name=rewards_address,json=rewardsAddress,proto3" json:"rewards_address,omitempty"`
-	// withdraw_to_wallet is a flag that defines if rewards should be immediately
-	// withdrawn to the wallet instead of creating a rewards record to be lazily
-	// withdrawn after.
-	WithdrawToWallet bool `protobuf:"varint,4,opt,name=withdraw_to_wallet,json=withdrawToWallet,proto3" json:"withdraw_to_wallet,omitempty"`
+func (m *Params) GetDynamicFeeEnabled() bool {
+	if m != nil {
+		return m.DynamicFeeEnabled
+	}
+	return false
 }
 
-func (m *ContractMetadata) Reset()         { *m = ContractMetadata{} }
-func (m *ContractMetadata) String() string { return proto.CompactTextString(m) }
-func (*ContractMetadata) ProtoMessage()    {}
-func (*ContractMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_187c4abc9caff98d, []int{1}
+func (m *Params) GetFlatFeeUpdateInterval() uint64 {
+	if m != nil {
+		return m.FlatFeeUpdateInterval
+	}
+	return 0
 }
-func (m *ContractMetadata) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *Params) GetMaxFlatFeeUpdateContracts() uint64 {
+	if m != nil {
+		return m.MaxFlatFeeUpdateContracts
+	}
+	return 0
 }
-func (m *ContractMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_ContractMetadata.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *Params) GetFlatFeeDeliverTxOnly() bool {
+	if m != nil {
+		return m.FlatFeeDeliverTxOnly
 	}
+	return false
 }
-func (m *ContractMetadata) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContractMetadata.Merge(m, src)
+
+func (m *Params) GetMinFeeFloorEnabled() bool {
+	if m != nil {
+		return m.MinFeeFloorEnabled
+	}
+	return false
 }
-func (m *ContractMetadata) XXX_Size() int {
-	return m.Size()
+
+func (m *Params) GetMinContractExecutionGas() uint64 {
+	if m != nil {
+		return m.MinContractExecutionGas
+	}
+	return 0
 }
-func (m *ContractMetadata) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContractMetadata.DiscardUnknown(m)
+
+func (m *Params) GetFreeTxBudget() uint64 {
+	if m != nil {
+		return m.FreeTxBudget
+	}
+	return 0
 }
 
-var xxx_messageInfo_ContractMetadata proto.InternalMessageInfo
+func (m *Params) GetMaxGasRebateMultiplier() uint64 {
+	if m != nil {
+		return m.MaxGasRebateMultiplier
+	}
+	return 0
+}
 
-func (m *ContractMetadata) GetContractAddress() string {
+func (m *Params) GetFlatFeeOncePerBlock() bool {
 	if m != nil {
-		return m.ContractAddress
+		return m.FlatFeeOncePerBlock
 	}
-	return ""
+	return false
 }
 
-func (m *ContractMetadata) GetOwnerAddress() string {
+func (m *Params) GetAcceptedFeeDenoms() []string {
 	if m != nil {
-		return m.OwnerAddress
+		return m.AcceptedFeeDenoms
 	}
-	return ""
+	return nil
 }
 
-func (m *ContractMetadata) GetRewardsAddress() string {
+func (m *Params) GetTxSizeFeePerByte() uint64 {
 	if m != nil {
-		return m.RewardsAddress
+		return m.TxSizeFeePerByte
 	}
-	return ""
+	return 0
 }
 
-func (m *ContractMetadata) GetWithdrawToWallet() bool {
+func (m *Params) GetSingleDenomFeesOnly() bool {
 	if m != nil {
-		return m.WithdrawToWallet
+		return m.SingleDenomFeesOnly
 	}
 	return false
 }
 
-// BlockRewards defines block related rewards distribution data.
-type BlockRewards struct {
-	// height defines the block height.
-	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
-	// inflation_rewards is the rewards to be distributed.
-	InflationRewards types.Coin `protobuf:"bytes,2,opt,name=inflation_rewards,json=inflationRewards,proto3" json:"inflation_rewards"`
-	// max_gas defines the maximum gas for the block that is used to distribute
-	// inflation rewards (consensus parameter).
-	MaxGas uint64 `protobuf:"varint,3,opt,name=max_gas,json=maxGas,proto3" json:"max_gas,omitempty"`
+func (m *Params) GetFlatFeePrepayDiscount() uint64 {
+	if m != nil {
+		return m.FlatFeePrepayDiscount
+	}
+	return 0
 }
 
-func (m *BlockRewards) Reset()         { *m = BlockRewards{} }
-func (m *BlockRewards) String() string { return proto.CompactTextString(m) }
-func (*BlockRewards) ProtoMessage()    {}
-func (*BlockRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_187c4abc9caff98d, []int{2}
+func (m *Params) GetFlatFeePayerMustSign() bool {
+	if m != nil {
+		return m.FlatFeePayerMustSign
+	}
+	return false
 }
-func (m *BlockRewards) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *Params) GetFlatFeesEnabled() bool {
+	if m != nil {
+		return m.FlatFeesEnabled
+	}
+	return false
 }
-func (m *BlockRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_BlockRewards.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *Params) GetFeeDenomRoutes() []FeeDenomRoute {
+	if m != nil {
+		return m.FeeDenomRoutes
 	}
+	return nil
 }
-func (m *BlockRewards) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BlockRewards.Merge(m, src)
+
+func (m *Params) GetFlatFeeConversionRates() []FlatFeeConversionRate {
+	if m != nil {
+		return m.FlatFeeConversionRates
+	}
+	return nil
 }
-func (m *BlockRewards) XXX_Size() int {
-	return m.Size()
+
+func (m *Params) GetCheckTxMinFeeEventEnabled() bool {
+	if m != nil {
+		return m.CheckTxMinFeeEventEnabled
+	}
+	return false
 }
-func (m *BlockRewards) XXX_DiscardUnknown() {
-	xxx_messageInfo_BlockRewards.DiscardUnknown(m)
+
+func (m *Params) GetMaxContractBlockRewards() []types.Coin {
+	if m != nil {
+		return m.MaxContractBlockRewards
+	}
+	return nil
 }
 
-var xxx_messageInfo_BlockRewards proto.InternalMessageInfo
+func (m *Params) GetFlatFeeMigrationPolicy() FlatFeeMigrationPolicy {
+	if m != nil {
+		return m.FlatFeeMigrationPolicy
+	}
+	return FlatFeeMigrationPolicy_FLAT_FEE_MIGRATION_POLICY_UNSPECIFIED
+}
 
-func (m *BlockRewards) GetHeight() int64 {
+func (m *Params) GetMaxFlatFeeMsgsPerTx() uint64 {
 	if m != nil {
-		return m.Height
+		return m.MaxFlatFeeMsgsPerTx
 	}
 	return 0
 }
 
-func (m *BlockRewards) GetInflationRewards() types.Coin {
+func (m *Params) GetFlatFeeAbsorbedInGasFee() bool {
 	if m != nil {
-		return m.InflationRewards
+		return m.FlatFeeAbsorbedInGasFee
 	}
-	return types.Coin{}
+	return false
 }
 
-func (m *BlockRewards) GetMaxGas() uint64 {
+func (m *Params) GetFeePromotion() FeePromotion {
 	if m != nil {
-		return m.MaxGas
+		return m.FeePromotion
+	}
+	return FeePromotion{}
+}
+
+func (m *Params) GetFlatFeeRefundOnFailure() bool {
+	if m != nil {
+		return m.FlatFeeRefundOnFailure
+	}
+	return false
+}
+
+func (m *Params) GetCheckTxLocalMinGasPriceEnabled() bool {
+	if m != nil {
+		return m.CheckTxLocalMinGasPriceEnabled
+	}
+	return false
+}
+
+func (m *Params) GetMinConsensusFeeSmoothingWindow() uint64 {
+	if m != nil {
+		return m.MinConsensusFeeSmoothingWindow
 	}
 	return 0
 }
 
-// TxRewards defines transaction related rewards distribution data.
-type TxRewards struct {
-	// tx_id is the tracking transaction ID (x/tracking is the data source for
-	// this value).
-	TxId uint64 `protobuf:"varint,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
-	// height defines the block height.
-	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
-	// fee_rewards is the rewards to be distributed.
-	FeeRewards []types.Coin `protobuf:"bytes,3,rep,name=fee_rewards,json=feeRewards,proto3" json:"fee_rewards"`
+func (m *Params) GetRewardsCallbackGasLimit() uint64 {
+	if m != nil {
+		return m.RewardsCallbackGasLimit
+	}
+	return 0
 }
 
-func (m *TxRewards) Reset()         { *m = TxRewards{} }
-func (m *TxRewards) String() string { return proto.CompactTextString(m) }
-func (*TxRewards) ProtoMessage()    {}
-func (*TxRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_187c4abc9caff98d, []int{3}
+func (m *Params) GetRewardsCallbacksBlockGasLimit() uint64 {
+	if m != nil {
+		return m.RewardsCallbacksBlockGasLimit
+	}
+	return 0
 }
-func (m *TxRewards) XXX_Unmarshal(b []byte) error {
+
+// FeePromotion defines a min fee discount applied within a block height
+// window (ecosystem promotions).
+type FeePromotion struct {
+	// discount defines the min fee discount in basis points (1/10000). Zero
+	// value disables the promotion.
+	Discount uint64 `protobuf:"varint,1,opt,name=discount,proto3" json:"discount,omitempty"`
+	// start_height defines the first block height the discount is applied at.
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height defines the last block height the discount is applied at.
+	EndHeight int64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *FeePromotion) Reset()         { *m = FeePromotion{} }
+func (m *FeePromotion) String() string { return proto.CompactTextString(m) }
+func (*FeePromotion) ProtoMessage()    {}
+func (*FeePromotion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_187c4abc9caff98d, []int{1}
+}
+func (m *FeePromotion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TxRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FeePromotion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TxRewards.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FeePromotion.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -275,70 +491,61 @@ func (m *TxRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *TxRewards) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TxRewards.Merge(m, src)
+func (m *FeePromotion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeePromotion.Merge(m, src)
 }
-func (m *TxRewards) XXX_Size() int {
+func (m *FeePromotion) XXX_Size() int {
 	return m.Size()
 }
-func (m *TxRewards) XXX_DiscardUnknown() {
-	xxx_messageInfo_TxRewards.DiscardUnknown(m)
+func (m *FeePromotion) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeePromotion.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TxRewards proto.InternalMessageInfo
+var xxx_messageInfo_FeePromotion proto.InternalMessageInfo
 
-func (m *TxRewards) GetTxId() uint64 {
+func (m *FeePromotion) GetDiscount() uint64 {
 	if m != nil {
-		return m.TxId
+		return m.Discount
 	}
 	return 0
 }
 
-func (m *TxRewards) GetHeight() int64 {
+func (m *FeePromotion) GetStartHeight() int64 {
 	if m != nil {
-		return m.Height
+		return m.StartHeight
 	}
 	return 0
 }
 
-func (m *TxRewards) GetFeeRewards() []types.Coin {
+func (m *FeePromotion) GetEndHeight() int64 {
 	if m != nil {
-		return m.FeeRewards
+		return m.EndHeight
 	}
-	return nil
+	return 0
 }
 
-// RewardsRecord defines a record that is used to distribute rewards later (lazy
-// distribution). This record is being created by the x/rewards EndBlocker and
-// pruned after the rewards are distributed. An actual rewards x/bank transfer
-// might be triggered by a Tx (via CLI for example) or by a contract via WASM
-// bindings. For a contract to trigger rewards transfer, contract address must
-// be set as the rewards_address in a corresponding ContractMetadata.
-type RewardsRecord struct {
-	// id is the unique ID of the record.
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// rewards_address is the address to distribute rewards to (bech32 encoded).
-	RewardsAddress string `protobuf:"bytes,2,opt,name=rewards_address,json=rewardsAddress,proto3" json:"rewards_address,omitempty"`
-	// rewards are the rewards to be transferred later.
-	Rewards []types.Coin `protobuf:"bytes,3,rep,name=rewards,proto3" json:"rewards"`
-	// calculated_height defines the block height of rewards calculation event.
-	CalculatedHeight int64 `protobuf:"varint,4,opt,name=calculated_height,json=calculatedHeight,proto3" json:"calculated_height,omitempty"`
-	// calculated_time defines the block time of rewards calculation event.
-	CalculatedTime time.Time `protobuf:"bytes,5,opt,name=calculated_time,json=calculatedTime,proto3,stdtime" json:"calculated_time"`
+// FeeDenomRoute defines the destination of the fee collector fees in a
+// particular denom.
+type FeeDenomRoute struct {
+	// denom defines the routed fee denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// module_account defines the name of the module account the fees are sent
+	// to.
+	ModuleAccount string `protobuf:"bytes,2,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty"`
 }
 
-func (m *RewardsRecord) Reset()         { *m = RewardsRecord{} }
-func (m *RewardsRecord) String() string { return proto.CompactTextString(m) }
-func (*RewardsRecord) ProtoMessage()    {}
-func (*RewardsRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_187c4abc9caff98d, []int{4}
+func (m *FeeDenomRoute) Reset()         { *m = FeeDenomRoute{} }
+func (m *FeeDenomRoute) String() string { return proto.CompactTextString(m) }
+func (*FeeDenomRoute) ProtoMessage()    {}
+func (*FeeDenomRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_187c4abc9caff98d, []int{2}
 }
-func (m *RewardsRecord) XXX_Unmarshal(b []byte) error {
+func (m *FeeDenomRoute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RewardsRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FeeDenomRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RewardsRecord.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FeeDenomRoute.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -348,73 +555,157 @@ func (m *RewardsRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *RewardsRecord) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RewardsRecord.Merge(m, src)
+func (m *FeeDenomRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDenomRoute.Merge(m, src)
 }
-func (m *RewardsRecord) XXX_Size() int {
+func (m *FeeDenomRoute) XXX_Size() int {
 	return m.Size()
 }
-func (m *RewardsRecord) XXX_DiscardUnknown() {
-	xxx_messageInfo_RewardsRecord.DiscardUnknown(m)
+func (m *FeeDenomRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDenomRoute.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RewardsRecord proto.InternalMessageInfo
+var xxx_messageInfo_FeeDenomRoute proto.InternalMessageInfo
 
-func (m *RewardsRecord) GetId() uint64 {
+func (m *FeeDenomRoute) GetDenom() string {
 	if m != nil {
-		return m.Id
+		return m.Denom
 	}
-	return 0
+	return ""
 }
 
-func (m *RewardsRecord) GetRewardsAddress() string {
+func (m *FeeDenomRoute) GetModuleAccount() string {
 	if m != nil {
-		return m.RewardsAddress
+		return m.ModuleAccount
 	}
 	return ""
 }
 
-func (m *RewardsRecord) GetRewards() []types.Coin {
-	if m != nil {
-		return m.Rewards
+// FlatFeeConversionRate defines the rate a contract flat fee configured in a
+// particular denom is accepted in another transaction fee denom at.
+type FlatFeeConversionRate struct {
+	// denom defines the contract flat fee denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// fee_denom defines the transaction fee denom the flat fee is accepted in.
+	FeeDenom string `protobuf:"bytes,2,opt,name=fee_denom,json=feeDenom,proto3" json:"fee_denom,omitempty"`
+	// rate defines the number of fee_denom units charged per a single denom
+	// unit of the flat fee.
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+}
+
+func (m *FlatFeeConversionRate) Reset()         { *m = FlatFeeConversionRate{} }
+func (m *FlatFeeConversionRate) String() string { return proto.CompactTextString(m) }
+func (*FlatFeeConversionRate) ProtoMessage()    {}
+func (*FlatFeeConversionRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_187c4abc9caff98d, []int{3}
+}
+func (m *FlatFeeConversionRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FlatFeeConversionRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FlatFeeConversionRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *FlatFeeConversionRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlatFeeConversionRate.Merge(m, src)
+}
+func (m *FlatFeeConversionRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *FlatFeeConversionRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlatFeeConversionRate.DiscardUnknown(m)
 }
 
-func (m *RewardsRecord) GetCalculatedHeight() int64 {
+var xxx_messageInfo_FlatFeeConversionRate proto.InternalMessageInfo
+
+func (m *FlatFeeConversionRate) GetDenom() string {
 	if m != nil {
-		return m.CalculatedHeight
+		return m.Denom
 	}
-	return 0
+	return ""
 }
 
-func (m *RewardsRecord) GetCalculatedTime() time.Time {
+func (m *FlatFeeConversionRate) GetFeeDenom() string {
 	if m != nil {
-		return m.CalculatedTime
+		return m.FeeDenom
 	}
-	return time.Time{}
+	return ""
 }
 
-// FlatFee defines the flat fee for a particular contract.
-type FlatFee struct {
+// ContractMetadata defines the contract rewards distribution options for a
+// particular contract.
+type ContractMetadata struct {
 	// contract_address defines the contract address (bech32 encoded).
 	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
-	// flat_fee defines the minimum flat fee set by the contract_owner
-	FlatFee types.Coin `protobuf:"bytes,2,opt,name=flat_fee,json=flatFee,proto3" json:"flat_fee"`
+	// owner_address is the contract owner address that can modify contract reward
+	// options (bech32 encoded). That could be the contract admin or the contract
+	// itself. If owner_address is set to contract address, contract can modify
+	// the metadata on its own using WASM bindings.
+	OwnerAddress string `protobuf:"bytes,2,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	// rewards_address is an address to distribute rewards to (bech32 encoded).
+	// If not set (empty), rewards are not distributed for this contract.
+	RewardsAddress string `protobuf:"bytes,3,opt,name=rewards_address,json=rewardsAddress,proto3" json:"rewards_address,omitempty"`
+	// withdraw_to_wallet is a flag that defines if rewards should be immediately
+	// withdrawn to the wallet instead of creating a rewards record to be lazily
+	// withdrawn after.
+	WithdrawToWallet bool `protobuf:"varint,4,opt,name=withdraw_to_wallet,json=withdrawToWallet,proto3" json:"withdraw_to_wallet,omitempty"`
+	// flat_fee_exempt_callers is a list of caller addresses (bech32 encoded)
+	// that are not charged the contract flat fee.
+	FlatFeeExemptCallers []string `protobuf:"bytes,5,rep,name=flat_fee_exempt_callers,json=flatFeeExemptCallers,proto3" json:"flat_fee_exempt_callers,omitempty"`
+	// rewards_splits defines a list of addresses contract rewards are split
+	// between (by weight). If set, rewards_address is not used for the rewards
+	// distribution. Weights must sum up to 10000 (basis points).
+	RewardsSplits []RewardsSplit `protobuf:"bytes,6,rep,name=rewards_splits,json=rewardsSplits,proto3" json:"rewards_splits"`
+	// flat_fee_direct_payout is a flag that defines if collected contract flat
+	// fees should be transferred to the rewards address at the block end instead
+	// of creating rewards records to be lazily withdrawn after.
+	FlatFeeDirectPayout bool `protobuf:"varint,7,opt,name=flat_fee_direct_payout,json=flatFeeDirectPayout,proto3" json:"flat_fee_direct_payout,omitempty"`
+	// gas_rebate_multiplier defines the contract gas weight multiplier used to
+	// split the tx fee rebate rewards between contracts of a transaction (basis
+	// points, 10000 is 1.0x). If not set (zero), 1.0x is used. The value is
+	// clamped by the max_gas_rebate_multiplier module parameter.
+	GasRebateMultiplier uint64 `protobuf:"varint,8,opt,name=gas_rebate_multiplier,json=gasRebateMultiplier,proto3" json:"gas_rebate_multiplier,omitempty"`
+	// flat_fee_on_success is a flag that defines if the contract flat fees
+	// should be charged only once the transaction msgs are executed successfully
+	// (by the post handler) instead of being charged upfront by the ante handler.
+	FlatFeeOnSuccess bool `protobuf:"varint,9,opt,name=flat_fee_on_success,json=flatFeeOnSuccess,proto3" json:"flat_fee_on_success,omitempty"`
+	// flat_fee_accepted_denoms is a list of denoms the contract flat fee could be
+	// paid in (a subset of the accepted_fee_denoms module parameter). If set,
+	// transactions paying the flat fee in other denoms (flat fee conversion
+	// rates are applied first) are rejected.
+	FlatFeeAcceptedDenoms []string `protobuf:"bytes,10,rep,name=flat_fee_accepted_denoms,json=flatFeeAcceptedDenoms,proto3" json:"flat_fee_accepted_denoms,omitempty"`
+	// rewards_callback_address defines an optional contract address (bech32
+	// encoded) the rewards_callback_share of the contract rewards is
+	// transferred to at the distribution time. The callback contract is
+	// sudo-invoked with the transferred rewards (for example, to auto-compound
+	// them). If the callback fails, the share is distributed as usual.
+	RewardsCallbackAddress string `protobuf:"bytes,11,opt,name=rewards_callback_address,json=rewardsCallbackAddress,proto3" json:"rewards_callback_address,omitempty"`
+	// rewards_callback_share defines the contract rewards share transferred to
+	// the rewards_callback_address (basis points, must be set along with the
+	// address).
+	RewardsCallbackShare uint64 `protobuf:"varint,12,opt,name=rewards_callback_share,json=rewardsCallbackShare,proto3" json:"rewards_callback_share,omitempty"`
 }
 
-func (m *FlatFee) Reset()         { *m = FlatFee{} }
-func (m *FlatFee) String() string { return proto.CompactTextString(m) }
-func (*FlatFee) ProtoMessage()    {}
-func (*FlatFee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_187c4abc9caff98d, []int{5}
+func (m *ContractMetadata) Reset()         { *m = ContractMetadata{} }
+func (m *ContractMetadata) String() string { return proto.CompactTextString(m) }
+func (*ContractMetadata) ProtoMessage()    {}
+func (*ContractMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_187c4abc9caff98d, []int{4}
 }
-func (m *FlatFee) XXX_Unmarshal(b []byte) error {
+func (m *ContractMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *FlatFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContractMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_FlatFee.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContractMetadata.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -424,536 +715,7974 @@ func (m *FlatFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *FlatFee) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FlatFee.Merge(m, src)
+func (m *ContractMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractMetadata.Merge(m, src)
 }
-func (m *FlatFee) XXX_Size() int {
+func (m *ContractMetadata) XXX_Size() int {
 	return m.Size()
 }
-func (m *FlatFee) XXX_DiscardUnknown() {
-	xxx_messageInfo_FlatFee.DiscardUnknown(m)
+func (m *ContractMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractMetadata.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FlatFee proto.InternalMessageInfo
+var xxx_messageInfo_ContractMetadata proto.InternalMessageInfo
 
-func (m *FlatFee) GetContractAddress() string {
+func (m *ContractMetadata) GetContractAddress() string {
 	if m != nil {
 		return m.ContractAddress
 	}
 	return ""
 }
 
-func (m *FlatFee) GetFlatFee() types.Coin {
+func (m *ContractMetadata) GetOwnerAddress() string {
 	if m != nil {
-		return m.FlatFee
+		return m.OwnerAddress
 	}
-	return types.Coin{}
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "archway.rewards.v1.Params")
-	proto.RegisterType((*ContractMetadata)(nil), "archway.rewards.v1.ContractMetadata")
-	proto.RegisterType((*BlockRewards)(nil), "archway.rewards.v1.BlockRewards")
-	proto.RegisterType((*TxRewards)(nil), "archway.rewards.v1.TxRewards")
-	proto.RegisterType((*RewardsRecord)(nil), "archway.rewards.v1.RewardsRecord")
-	proto.RegisterType((*FlatFee)(nil), "archway.rewards.v1.FlatFee")
+func (m *ContractMetadata) GetRewardsAddress() string {
+	if m != nil {
+		return m.RewardsAddress
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("archway/rewards/v1/rewards.proto", fileDescriptor_187c4abc9caff98d) }
+func (m *ContractMetadata) GetWithdrawToWallet() bool {
+	if m != nil {
+		return m.WithdrawToWallet
+	}
+	return false
+}
 
-var fileDescriptor_187c4abc9caff98d = []byte{
-	// 717 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4d, 0x4f, 0xdb, 0x4c,
-	0x10, 0x8e, 0x93, 0x90, 0xc0, 0xf2, 0x15, 0x0c, 0xef, 0x4b, 0xe0, 0x7d, 0x95, 0x44, 0xe9, 0xa1,
-	0x54, 0x2d, 0x76, 0x43, 0x4f, 0xed, 0xa9, 0x0d, 0x88, 0xb6, 0x2a, 0xa8, 0xc8, 0x42, 0x42, 0xea,
-	0xc5, 0xdd, 0xac, 0x37, 0xce, 0x0a, 0xdb, 0x1b, 0xad, 0x17, 0x6c, 0xfa, 0x1f, 0x2a, 0xf1, 0x63,
-	0x7a, 0xe9, 0x3f, 0xe0, 0x88, 0xaa, 0x1e, 0xaa, 0x1e, 0x68, 0x05, 0x3f, 0xa4, 0xd5, 0x7e, 0x05,
-	0xda, 0x72, 0x80, 0xde, 0x3c, 0x33, 0xcf, 0xce, 0x3c, 0xf3, 0xcc, 0x78, 0x40, 0x0b, 0x32, 0x34,
-	0xc8, 0xe0, 0x91, 0xcb, 0x70, 0x06, 0x59, 0x90, 0xba, 0x87, 0x1d, 0xf3, 0xe9, 0x0c, 0x19, 0xe5,
-	0xd4, 0xb6, 0x35, 0xc2, 0x31, 0xee, 0xc3, 0xce, 0xf2, 0x42, 0x48, 0x43, 0x2a, 0xc3, 0xae, 0xf8,
-	0x52, 0xc8, 0xe5, 0x66, 0x48, 0x69, 0x18, 0x61, 0x57, 0x5a, 0xbd, 0x83, 0xbe, 0xcb, 0x49, 0x8c,
-	0x53, 0x0e, 0xe3, 0xa1, 0x06, 0x34, 0x10, 0x4d, 0x63, 0x9a, 0xba, 0x3d, 0x98, 0x62, 0xf7, 0xb0,
-	0xd3, 0xc3, 0x1c, 0x76, 0x5c, 0x44, 0x49, 0xa2, 0xe3, 0x4b, 0x2a, 0xee, 0xab, 0xcc, 0xca, 0x50,
-	0xa1, 0xf6, 0xe7, 0x22, 0xa8, 0xec, 0x40, 0x06, 0xe3, 0xd4, 0x26, 0x60, 0x91, 0x24, 0xfd, 0x08,
-	0x72, 0x42, 0x13, 0x5f, 0x93, 0xf2, 0x99, 0x30, 0xeb, 0x56, 0xcb, 0x5a, 0x99, 0xe8, 0x76, 0x4e,
-	0xce, 0x9a, 0x85, 0xaf, 0x67, 0xcd, 0xff, 0x54, 0x86, 0x34, 0xd8, 0x77, 0x08, 0x75, 0x63, 0xc8,
-	0x07, 0xce, 0x16, 0x0e, 0x21, 0x3a, 0xda, 0xc0, 0xe8, 0xd3, 0x87, 0x55, 0xa0, 0x0b, 0x6c, 0x60,
-	0xe4, 0xfd, 0x33, 0xca, 0xe8, 0xa9, 0x84, 0x9e, 0x30, 0xec, 0xb7, 0x60, 0x9e, 0xe7, 0x7e, 0x1f,
-	0x63, 0x9f, 0xe1, 0x1e, 0xe4, 0x58, 0x97, 0x29, 0xfe, 0x6d, 0x99, 0x1a, 0xcf, 0x37, 0x31, 0xf6,
-	0x64, 0x2e, 0x55, 0xe1, 0x21, 0x58, 0x88, 0x61, 0xee, 0x67, 0x84, 0x0f, 0x02, 0x06, 0x33, 0x9f,
-	0x61, 0x44, 0x59, 0x90, 0xd6, 0x4b, 0x2d, 0x6b, 0xa5, 0xec, 0xd9, 0x31, 0xcc, 0xf7, 0x74, 0xc8,
-	0x53, 0x11, 0xfb, 0x15, 0xa8, 0xc5, 0x24, 0xf1, 0x87, 0x8c, 0x20, 0xec, 0xd3, 0xbe, 0x1f, 0xc2,
-	0xb4, 0x5e, 0x6e, 0x59, 0x2b, 0x93, 0x6b, 0xff, 0x3b, 0xba, 0x94, 0xd0, 0xd7, 0xd1, 0xfa, 0x8a,
-	0xba, 0xeb, 0x94, 0x24, 0xdd, 0xb2, 0xa0, 0xeb, 0x4d, 0xc7, 0x24, 0xd9, 0x11, 0x4f, 0x5f, 0xf7,
-	0x9f, 0xc3, 0xb4, 0xfd, 0xd1, 0x02, 0xb5, 0x75, 0x9a, 0x70, 0x06, 0x11, 0xdf, 0xc6, 0x1c, 0x06,
-	0x90, 0x43, 0xfb, 0x1e, 0xa8, 0x21, 0xed, 0xf3, 0x61, 0x10, 0x30, 0x9c, 0xa6, 0x4a, 0x59, 0x6f,
-	0xd6, 0xf8, 0x9f, 0x29, 0xb7, 0x7d, 0x07, 0x4c, 0xd3, 0x2c, 0xc1, 0x6c, 0x84, 0x93, 0xd2, 0x78,
-	0x53, 0xd2, 0x69, 0x40, 0x77, 0xc1, 0xac, 0x19, 0x93, 0x81, 0x95, 0x24, 0x6c, 0x46, 0xbb, 0x0d,
-	0xf0, 0x01, 0xb0, 0x47, 0x42, 0x70, 0xea, 0x67, 0x30, 0x8a, 0x30, 0x97, 0xcd, 0x8d, 0x7b, 0x35,
-	0x13, 0xd9, 0xa5, 0x7b, 0xd2, 0xdf, 0x7e, 0x6f, 0x81, 0xa9, 0x6e, 0x44, 0xd1, 0xbe, 0x1e, 0x99,
-	0xfd, 0x2f, 0xa8, 0x0c, 0x30, 0x09, 0x07, 0x5c, 0xb2, 0x2d, 0x79, 0xda, 0xb2, 0xb7, 0xc0, 0xdc,
-	0x1f, 0x0b, 0x23, 0x89, 0x4e, 0xae, 0x2d, 0x5d, 0x2b, 0xd9, 0x15, 0xbd, 0x6a, 0xbf, 0x2f, 0x86,
-	0xbd, 0x08, 0xaa, 0x62, 0x62, 0x42, 0x76, 0x35, 0xa4, 0x4a, 0x0c, 0x73, 0xa1, 0xe5, 0x3b, 0x30,
-	0xb1, 0x9b, 0x1b, 0xd4, 0x3c, 0x18, 0xe3, 0xb9, 0x4f, 0x02, 0x49, 0xa5, 0xec, 0x95, 0x79, 0xfe,
-	0x32, 0xb8, 0x42, 0xb0, 0xf8, 0x0b, 0xc1, 0xa7, 0x60, 0x52, 0xed, 0x98, 0xa2, 0x56, 0x6a, 0x95,
-	0x6e, 0x42, 0x0d, 0xf4, 0xc5, 0x2a, 0xc9, 0x27, 0xed, 0x1f, 0x16, 0x98, 0x36, 0x9b, 0x2b, 0xf7,
-	0xc4, 0x9e, 0x01, 0xc5, 0x51, 0xf5, 0x22, 0x09, 0xae, 0x1b, 0x42, 0xf1, 0xda, 0x21, 0x3c, 0x06,
-	0xd5, 0x5b, 0x12, 0x31, 0x78, 0xfb, 0x3e, 0x98, 0x43, 0x30, 0x42, 0x07, 0x11, 0xe4, 0x38, 0xf0,
-	0x75, 0xab, 0x65, 0xd9, 0x6a, 0xed, 0x32, 0xf0, 0x42, 0x35, 0xbd, 0x0d, 0x66, 0xaf, 0x80, 0xc5,
-	0xa9, 0xa8, 0x8f, 0xc9, 0x99, 0x2c, 0x3b, 0xea, 0x8e, 0x38, 0xe6, 0x8e, 0x38, 0xbb, 0xe6, 0x8e,
-	0x74, 0xc7, 0x45, 0xc1, 0xe3, 0x6f, 0x4d, 0xcb, 0x9b, 0xb9, 0x7c, 0x2c, 0xc2, 0xed, 0x21, 0xa8,
-	0x6e, 0x46, 0x90, 0x6f, 0x62, 0x7c, 0x9b, 0xfd, 0x7d, 0x02, 0xc6, 0xc5, 0x78, 0xc5, 0x2f, 0x7e,
-	0xd3, 0x8d, 0xa8, 0xf6, 0x55, 0x99, 0xee, 0xd6, 0xc9, 0x79, 0xc3, 0x3a, 0x3d, 0x6f, 0x58, 0xdf,
-	0xcf, 0x1b, 0xd6, 0xf1, 0x45, 0xa3, 0x70, 0x7a, 0xd1, 0x28, 0x7c, 0xb9, 0x68, 0x14, 0xde, 0xac,
-	0x85, 0x84, 0x0f, 0x0e, 0x7a, 0x0e, 0xa2, 0xb1, 0xab, 0xaf, 0xe7, 0x6a, 0x82, 0x79, 0x46, 0xd9,
-	0xbe, 0xb1, 0xdd, 0x7c, 0x74, 0x71, 0xf9, 0xd1, 0x10, 0xa7, 0xbd, 0x8a, 0xec, 0xf6, 0xd1, 0xcf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xae, 0xfd, 0xb6, 0x5a, 0x91, 0x05, 0x00, 0x00,
+func (m *ContractMetadata) GetFlatFeeExemptCallers() []string {
+	if m != nil {
+		return m.FlatFeeExemptCallers
+	}
+	return nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ContractMetadata) GetRewardsSplits() []RewardsSplit {
+	if m != nil {
+		return m.RewardsSplits
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ContractMetadata) GetFlatFeeDirectPayout() bool {
+	if m != nil {
+		return m.FlatFeeDirectPayout
+	}
+	return false
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.MinPriceOfGas.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintRewards(dAtA, i, uint64(size))
+func (m *ContractMetadata) GetGasRebateMultiplier() uint64 {
+	if m != nil {
+		return m.GasRebateMultiplier
 	}
-	i--
-	dAtA[i] = 0x22
-	if m.MaxWithdrawRecords != 0 {
-		i = encodeVarintRewards(dAtA, i, uint64(m.MaxWithdrawRecords))
-		i--
-		dAtA[i] = 0x18
+	return 0
+}
+
+func (m *ContractMetadata) GetFlatFeeOnSuccess() bool {
+	if m != nil {
+		return m.FlatFeeOnSuccess
 	}
-	{
-		size := m.TxFeeRebateRatio.Size()
-		i -= size
-		if _, err := m.TxFeeRebateRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintRewards(dAtA, i, uint64(size))
+	return false
+}
+
+func (m *ContractMetadata) GetFlatFeeAcceptedDenoms() []string {
+	if m != nil {
+		return m.FlatFeeAcceptedDenoms
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.InflationRewardsRatio.Size()
-		i -= size
-		if _, err := m.InflationRewardsRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintRewards(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *ContractMetadata) GetRewardsCallbackAddress() string {
+	if m != nil {
+		return m.RewardsCallbackAddress
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ContractMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ContractMetadata) GetRewardsCallbackShare() uint64 {
+	if m != nil {
+		return m.RewardsCallbackShare
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ContractMetadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RewardsSplit defines a single contract rewards recipient share.
+type RewardsSplit struct {
+	// address is the rewards recipient address (bech32 encoded).
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// weight defines the recipient share of contract rewards (basis points).
+	Weight uint64 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
 }
 
-func (m *ContractMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.WithdrawToWallet {
-		i--
-		if m.WithdrawToWallet {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *RewardsSplit) Reset()         { *m = RewardsSplit{} }
+func (m *RewardsSplit) String() string { return proto.CompactTextString(m) }
+func (*RewardsSplit) ProtoMessage()    {}
+func (*RewardsSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_187c4abc9caff98d, []int{5}
+}
+func (m *RewardsSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardsSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardsSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.RewardsAddress) > 0 {
-		i -= len(m.RewardsAddress)
-		copy(dAtA[i:], m.RewardsAddress)
-		i = encodeVarintRewards(dAtA, i, uint64(len(m.RewardsAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.OwnerAddress) > 0 {
-		i -= len(m.OwnerAddress)
-		copy(dAtA[i:], m.OwnerAddress)
-		i = encodeVarintRewards(dAtA, i, uint64(len(m.OwnerAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintRewards(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RewardsSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardsSplit.Merge(m, src)
+}
+func (m *RewardsSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardsSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardsSplit.DiscardUnknown(m)
 }
 
-func (m *BlockRewards) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RewardsSplit proto.InternalMessageInfo
+
+func (m *RewardsSplit) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *BlockRewards) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RewardsSplit) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
 }
 
-func (m *BlockRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxGas != 0 {
-		i = encodeVarintRewards(dAtA, i, uint64(m.MaxGas))
-		i--
-		dAtA[i] = 0x18
-	}
-	{
-		size, err := m.InflationRewards.MarshalToSizedBuffer(dAtA[:i])
+// BlockRewards defines block related rewards distribution data.
+type BlockRewards struct {
+	// height defines the block height.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// inflation_rewards is the rewards to be distributed.
+	InflationRewards types.Coin `protobuf:"bytes,2,opt,name=inflation_rewards,json=inflationRewards,proto3" json:"inflation_rewards"`
+	// max_gas defines the maximum gas for the block that is used to distribute
+	// inflation rewards (consensus parameter).
+	MaxGas uint64 `protobuf:"varint,3,opt,name=max_gas,json=maxGas,proto3" json:"max_gas,omitempty"`
+}
+
+func (m *BlockRewards) Reset()         { *m = BlockRewards{} }
+func (m *BlockRewards) String() string { return proto.CompactTextString(m) }
+func (*BlockRewards) ProtoMessage()    {}
+func (*BlockRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_187c4abc9caff98d, []int{6}
+}
+func (m *BlockRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintRewards(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.Height != 0 {
-		i = encodeVarintRewards(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *BlockRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockRewards.Merge(m, src)
+}
+func (m *BlockRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockRewards proto.InternalMessageInfo
+
+func (m *BlockRewards) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *TxRewards) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BlockRewards) GetInflationRewards() types.Coin {
+	if m != nil {
+		return m.InflationRewards
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *TxRewards) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BlockRewards) GetMaxGas() uint64 {
+	if m != nil {
+		return m.MaxGas
+	}
+	return 0
 }
 
-func (m *TxRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FeeRewards) > 0 {
-		for iNdEx := len(m.FeeRewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FeeRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRewards(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+// TxRewards defines transaction related rewards distribution data.
+type TxRewards struct {
+	// tx_id is the tracking transaction ID (x/tracking is the data source for
+	// this value).
+	TxId uint64 `protobuf:"varint,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	// height defines the block height.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// fee_rewards is the rewards to be distributed.
+	FeeRewards []types.Coin `protobuf:"bytes,3,rep,name=fee_rewards,json=feeRewards,proto3" json:"fee_rewards"`
+}
+
+func (m *TxRewards) Reset()         { *m = TxRewards{} }
+func (m *TxRewards) String() string { return proto.CompactTextString(m) }
+func (*TxRewards) ProtoMessage()    {}
+func (*TxRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_187c4abc9caff98d, []int{7}
+}
+func (m *TxRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Height != 0 {
-		i = encodeVarintRewards(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *TxRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxRewards.Merge(m, src)
+}
+func (m *TxRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxRewards proto.InternalMessageInfo
+
+func (m *TxRewards) GetTxId() uint64 {
+	if m != nil {
+		return m.TxId
 	}
-	if m.TxId != 0 {
-		i = encodeVarintRewards(dAtA, i, uint64(m.TxId))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *TxRewards) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *RewardsRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TxRewards) GetFeeRewards() []types.Coin {
+	if m != nil {
+		return m.FeeRewards
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *RewardsRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// TxFeeDistribution defines how the fees paid by a transaction were
+// distributed. Objects are pruned together with the block rewards tracking
+// data.
+type TxFeeDistribution struct {
+	// tx_id is the tracking transaction ID (x/tracking is the data source for
+	// this value).
+	TxId uint64 `protobuf:"varint,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	// height defines the block height.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// tx_hash is the hex encoded transaction hash.
+	TxHash string `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// fee_collector_fees defines the fees sent to the x/auth fee collector. These
+	// are distributed by the x/distribution module between the validators (block
+	// proposer) and the community pool.
+	FeeCollectorFees []types.Coin `protobuf:"bytes,4,rep,name=fee_collector_fees,json=feeCollectorFees,proto3" json:"fee_collector_fees"`
+	// burnt_fees defines the fees burnt.
+	BurntFees []types.Coin `protobuf:"bytes,5,rep,name=burnt_fees,json=burntFees,proto3" json:"burnt_fees"`
+	// rewards_fees defines the fee rebates sent to the dApp rewards pool.
+	RewardsFees []types.Coin `protobuf:"bytes,6,rep,name=rewards_fees,json=rewardsFees,proto3" json:"rewards_fees"`
+	// flat_fees defines the contract flat fees sent to the dApp rewards pool.
+	FlatFees []types.Coin `protobuf:"bytes,7,rep,name=flat_fees,json=flatFees,proto3" json:"flat_fees"`
+	// routed_fees defines the fee collector fees sent to the fee denom route
+	// module accounts (FeeDenomRoutes param).
+	RoutedFees []types.Coin `protobuf:"bytes,8,rep,name=routed_fees,json=routedFees,proto3" json:"routed_fees"`
 }
 
-func (m *RewardsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CalculatedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CalculatedTime):])
-	if err3 != nil {
-		return 0, err3
-	}
-	i -= n3
-	i = encodeVarintRewards(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x2a
-	if m.CalculatedHeight != 0 {
-		i = encodeVarintRewards(dAtA, i, uint64(m.CalculatedHeight))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRewards(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *TxFeeDistribution) Reset()         { *m = TxFeeDistribution{} }
+func (m *TxFeeDistribution) String() string { return proto.CompactTextString(m) }
+func (*TxFeeDistribution) ProtoMessage()    {}
+func (*TxFeeDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_187c4abc9caff98d, []int{8}
+}
+func (m *TxFeeDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxFeeDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxFeeDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.RewardsAddress) > 0 {
-		i -= len(m.RewardsAddress)
-		copy(dAtA[i:], m.RewardsAddress)
-		i = encodeVarintRewards(dAtA, i, uint64(len(m.RewardsAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintRewards(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *TxFeeDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxFeeDistribution.Merge(m, src)
+}
+func (m *TxFeeDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxFeeDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxFeeDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxFeeDistribution proto.InternalMessageInfo
+
+func (m *TxFeeDistribution) GetTxId() uint64 {
+	if m != nil {
+		return m.TxId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *FlatFee) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TxFeeDistribution) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *FlatFee) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TxFeeDistribution) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
 }
 
-func (m *FlatFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.FlatFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintRewards(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintRewards(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *TxFeeDistribution) GetFeeCollectorFees() []types.Coin {
+	if m != nil {
+		return m.FeeCollectorFees
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func encodeVarintRewards(dAtA []byte, offset int, v uint64) int {
-	offset -= sovRewards(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TxFeeDistribution) GetBurntFees() []types.Coin {
+	if m != nil {
+		return m.BurntFees
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.InflationRewardsRatio.Size()
-	n += 1 + l + sovRewards(uint64(l))
-	l = m.TxFeeRebateRatio.Size()
-	n += 1 + l + sovRewards(uint64(l))
-	if m.MaxWithdrawRecords != 0 {
-		n += 1 + sovRewards(uint64(m.MaxWithdrawRecords))
+
+func (m *TxFeeDistribution) GetRewardsFees() []types.Coin {
+	if m != nil {
+		return m.RewardsFees
 	}
-	l = m.MinPriceOfGas.Size()
-	n += 1 + l + sovRewards(uint64(l))
-	return n
+	return nil
 }
 
-func (m *ContractMetadata) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovRewards(uint64(l))
-	}
-	l = len(m.OwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovRewards(uint64(l))
+func (m *TxFeeDistribution) GetFlatFees() []types.Coin {
+	if m != nil {
+		return m.FlatFees
 	}
-	l = len(m.RewardsAddress)
-	if l > 0 {
-		n += 1 + l + sovRewards(uint64(l))
+	return nil
+}
+
+func (m *TxFeeDistribution) GetRoutedFees() []types.Coin {
+	if m != nil {
+		return m.RoutedFees
 	}
-	if m.WithdrawToWallet {
-		n += 2
+	return nil
+}
+
+// ContractTxRewards defines the rewards a contract earned attributable to a
+// particular transaction gas usage. Objects are pruned together with the block
+// rewards tracking data.
+type ContractTxRewards struct {
+	// tx_id is the tracking transaction ID (x/tracking is the data source for
+	// this value).
+	TxId uint64 `protobuf:"varint,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	// height defines the block height.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// contract_address defines the contract address (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,3,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// gas_used is the contract operations gas used within the transaction.
+	GasUsed uint64 `protobuf:"varint,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// fee_rewards is the contract share of the transaction fee rebate rewards.
+	FeeRewards []types.Coin `protobuf:"bytes,5,rep,name=fee_rewards,json=feeRewards,proto3" json:"fee_rewards"`
+	// inflation_rewards is the contract share of the block inflation rewards
+	// attributable to the transaction gas used.
+	InflationRewards []types.Coin `protobuf:"bytes,6,rep,name=inflation_rewards,json=inflationRewards,proto3" json:"inflation_rewards"`
+}
+
+func (m *ContractTxRewards) Reset()         { *m = ContractTxRewards{} }
+func (m *ContractTxRewards) String() string { return proto.CompactTextString(m) }
+func (*ContractTxRewards) ProtoMessage()    {}
+func (*ContractTxRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_187c4abc9caff98d, []int{9}
+}
+func (m *ContractTxRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractTxRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractTxRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *ContractTxRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractTxRewards.Merge(m, src)
+}
+func (m *ContractTxRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractTxRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractTxRewards.DiscardUnknown(m)
 }
 
-func (m *BlockRewards) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_ContractTxRewards proto.InternalMessageInfo
+
+func (m *ContractTxRewards) GetTxId() uint64 {
+	if m != nil {
+		return m.TxId
 	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovRewards(uint64(m.Height))
+	return 0
+}
+
+func (m *ContractTxRewards) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	l = m.InflationRewards.Size()
-	n += 1 + l + sovRewards(uint64(l))
-	if m.MaxGas != 0 {
-		n += 1 + sovRewards(uint64(m.MaxGas))
+	return 0
+}
+
+func (m *ContractTxRewards) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	return n
+	return ""
 }
 
-func (m *TxRewards) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ContractTxRewards) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
 	}
-	var l int
-	_ = l
-	if m.TxId != 0 {
-		n += 1 + sovRewards(uint64(m.TxId))
+	return 0
+}
+
+func (m *ContractTxRewards) GetFeeRewards() []types.Coin {
+	if m != nil {
+		return m.FeeRewards
 	}
-	if m.Height != 0 {
-		n += 1 + sovRewards(uint64(m.Height))
+	return nil
+}
+
+func (m *ContractTxRewards) GetInflationRewards() []types.Coin {
+	if m != nil {
+		return m.InflationRewards
 	}
-	if len(m.FeeRewards) > 0 {
-		for _, e := range m.FeeRewards {
-			l = e.Size()
-			n += 1 + l + sovRewards(uint64(l))
+	return nil
+}
+
+// RewardsRecord defines a record that is used to distribute rewards later (lazy
+// distribution). This record is being created by the x/rewards EndBlocker and
+// pruned after the rewards are distributed. An actual rewards x/bank transfer
+// might be triggered by a Tx (via CLI for example) or by a contract via WASM
+// bindings. For a contract to trigger rewards transfer, contract address must
+// be set as the rewards_address in a corresponding ContractMetadata.
+type RewardsRecord struct {
+	// id is the unique ID of the record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// rewards_address is the address to distribute rewards to (bech32 encoded).
+	RewardsAddress string `protobuf:"bytes,2,opt,name=rewards_address,json=rewardsAddress,proto3" json:"rewards_address,omitempty"`
+	// rewards are the rewards to be transferred later.
+	Rewards []types.Coin `protobuf:"bytes,3,rep,name=rewards,proto3" json:"rewards"`
+	// calculated_height defines the block height of rewards calculation event.
+	CalculatedHeight int64 `protobuf:"varint,4,opt,name=calculated_height,json=calculatedHeight,proto3" json:"calculated_height,omitempty"`
+	// calculated_time defines the block time of rewards calculation event.
+	CalculatedTime time.Time `protobuf:"bytes,5,opt,name=calculated_time,json=calculatedTime,proto3,stdtime" json:"calculated_time"`
+	// contract_address defines the contract the rewards were accrued for (bech32
+	// encoded). Might be empty for records created before this field was
+	// introduced.
+	ContractAddress string `protobuf:"bytes,6,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (m *RewardsRecord) Reset()         { *m = RewardsRecord{} }
+func (m *RewardsRecord) String() string { return proto.CompactTextString(m) }
+func (*RewardsRecord) ProtoMessage()    {}
+func (*RewardsRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_187c4abc9caff98d, []int{10}
+}
+func (m *RewardsRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardsRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardsRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *RewardsRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardsRecord.Merge(m, src)
+}
+func (m *RewardsRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardsRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardsRecord.DiscardUnknown(m)
 }
 
-func (m *RewardsRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovRewards(uint64(m.Id))
-	}
-	l = len(m.RewardsAddress)
-	if l > 0 {
-		n += 1 + l + sovRewards(uint64(l))
-	}
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovRewards(uint64(l))
-		}
+var xxx_messageInfo_RewardsRecord proto.InternalMessageInfo
+
+func (m *RewardsRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if m.CalculatedHeight != 0 {
-		n += 1 + sovRewards(uint64(m.CalculatedHeight))
+	return 0
+}
+
+func (m *RewardsRecord) GetRewardsAddress() string {
+	if m != nil {
+		return m.RewardsAddress
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CalculatedTime)
-	n += 1 + l + sovRewards(uint64(l))
-	return n
+	return ""
 }
 
-func (m *FlatFee) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RewardsRecord) GetRewards() []types.Coin {
+	if m != nil {
+		return m.Rewards
 	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovRewards(uint64(l))
+	return nil
+}
+
+func (m *RewardsRecord) GetCalculatedHeight() int64 {
+	if m != nil {
+		return m.CalculatedHeight
 	}
-	l = m.FlatFee.Size()
-	n += 1 + l + sovRewards(uint64(l))
-	return n
+	return 0
+}
+
+func (m *RewardsRecord) GetCalculatedTime() time.Time {
+	if m != nil {
+		return m.CalculatedTime
+	}
+	return time.Time{}
+}
+
+func (m *RewardsRecord) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+// FlatFee defines the flat fee for a particular contract.
+type FlatFee struct {
+	// contract_address defines the contract address (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// flat_fee defines the minimum flat fee set by the contract_owner
+	FlatFee types.Coin `protobuf:"bytes,2,opt,name=flat_fee,json=flatFee,proto3" json:"flat_fee"`
+	// schedule defines an optional flat fee schedule (flat_fee must match the
+	// schedule end_fee).
+	Schedule *FlatFeeSchedule `protobuf:"bytes,3,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// method defines an optional execute msg method name the flat fee is charged
+	// for (the contract-wide flat fee is charged for other methods). Schedules
+	// are not supported for method flat fees.
+	Method string `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	// min_cons_fee_multiplier defines an optional multiplier of the minimum
+	// consensus fee (in the flat_fee denom) the flat fee is charged relative to
+	// (flat_fee is the minimum charged). Schedules and method flat fees are not
+	// supported.
+	MinConsFeeMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=min_cons_fee_multiplier,json=minConsFeeMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_cons_fee_multiplier"`
+}
+
+func (m *FlatFee) Reset()         { *m = FlatFee{} }
+func (m *FlatFee) String() string { return proto.CompactTextString(m) }
+func (*FlatFee) ProtoMessage()    {}
+func (*FlatFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_187c4abc9caff98d, []int{11}
+}
+func (m *FlatFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FlatFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FlatFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FlatFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlatFee.Merge(m, src)
+}
+func (m *FlatFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *FlatFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlatFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlatFee proto.InternalMessageInfo
+
+func (m *FlatFee) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *FlatFee) GetFlatFee() types.Coin {
+	if m != nil {
+		return m.FlatFee
+	}
+	return types.Coin{}
+}
+
+func (m *FlatFee) GetSchedule() *FlatFeeSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
+}
+
+func (m *FlatFee) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+// FlatFeeSchedule defines a contract flat fee changing linearly over a range of
+// block heights (for example, a promotional fee ramping up to the target).
+type FlatFeeSchedule struct {
+	// start_height defines the block height the fee starts changing at
+	// (start_fee is charged up to this height).
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height defines the block height the fee reaches the end_fee at
+	// (end_fee is charged from this height on).
+	EndHeight int64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// start_fee defines the flat fee at the start of the schedule (could be
+	// zero).
+	StartFee types.Coin `protobuf:"bytes,3,opt,name=start_fee,json=startFee,proto3" json:"start_fee"`
+	// end_fee defines the flat fee at the end of the schedule.
+	EndFee types.Coin `protobuf:"bytes,4,opt,name=end_fee,json=endFee,proto3" json:"end_fee"`
+}
+
+func (m *FlatFeeSchedule) Reset()         { *m = FlatFeeSchedule{} }
+func (m *FlatFeeSchedule) String() string { return proto.CompactTextString(m) }
+func (*FlatFeeSchedule) ProtoMessage()    {}
+func (*FlatFeeSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_187c4abc9caff98d, []int{12}
+}
+func (m *FlatFeeSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FlatFeeSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FlatFeeSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FlatFeeSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlatFeeSchedule.Merge(m, src)
+}
+func (m *FlatFeeSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *FlatFeeSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlatFeeSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlatFeeSchedule proto.InternalMessageInfo
+
+func (m *FlatFeeSchedule) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *FlatFeeSchedule) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *FlatFeeSchedule) GetStartFee() types.Coin {
+	if m != nil {
+		return m.StartFee
+	}
+	return types.Coin{}
+}
+
+func (m *FlatFeeSchedule) GetEndFee() types.Coin {
+	if m != nil {
+		return m.EndFee
+	}
+	return types.Coin{}
+}
+
+// ContractCodeID defines the code ID a contract with metadata was instantiated
+// from (contract by code ID index entry).
+type ContractCodeID struct {
+	// contract_address defines the contract address (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// code_id defines the contract code ID.
+	CodeId uint64 `protobuf:"varint,2,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+}
+
+func (m *ContractCodeID) Reset()         { *m = ContractCodeID{} }
+func (m *ContractCodeID) String() string { return proto.CompactTextString(m) }
+func (*ContractCodeID) ProtoMessage()    {}
+func (*ContractCodeID) Descriptor() ([]byte, []int) {
+	return fileDescriptor_187c4abc9caff98d, []int{13}
+}
+func (m *ContractCodeID) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractCodeID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractCodeID.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractCodeID) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractCodeID.Merge(m, src)
+}
+func (m *ContractCodeID) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractCodeID) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractCodeID.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractCodeID proto.InternalMessageInfo
+
+func (m *ContractCodeID) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *ContractCodeID) GetCodeId() uint64 {
+	if m != nil {
+		return m.CodeId
+	}
+	return 0
+}
+
+// MinConsensusFees defines the minimum consensus fee (minimum gas unit price)
+// values for each fee denom.
+type MinConsensusFees struct {
+	// fees defines the minimum consensus fee per denom.
+	Fees []types.DecCoin `protobuf:"bytes,1,rep,name=fees,proto3" json:"fees"`
+}
+
+func (m *MinConsensusFees) Reset()         { *m = MinConsensusFees{} }
+func (m *MinConsensusFees) String() string { return proto.CompactTextString(m) }
+func (*MinConsensusFees) ProtoMessage()    {}
+func (*MinConsensusFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_187c4abc9caff98d, []int{14}
+}
+func (m *MinConsensusFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinConsensusFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MinConsensusFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MinConsensusFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinConsensusFees.Merge(m, src)
+}
+func (m *MinConsensusFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinConsensusFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinConsensusFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinConsensusFees proto.InternalMessageInfo
+
+func (m *MinConsensusFees) GetFees() []types.DecCoin {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+// ContractRewardsStats defines the contract rewards counters (lifetime and
+// recent) used to estimate the contract rewards yield.
+type ContractRewardsStats struct {
+	// contract_address defines the contract address (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// lifetime_rewards defines the total rewards distributed for the contract.
+	LifetimeRewards []types.Coin `protobuf:"bytes,2,rep,name=lifetime_rewards,json=lifetimeRewards,proto3" json:"lifetime_rewards"`
+	// recent_rewards defines the rewards distributed within the current window.
+	RecentRewards []types.Coin `protobuf:"bytes,3,rep,name=recent_rewards,json=recentRewards,proto3" json:"recent_rewards"`
+	// recent_start_time defines the block time the current window started at.
+	RecentStartTime time.Time `protobuf:"bytes,4,opt,name=recent_start_time,json=recentStartTime,proto3,stdtime" json:"recent_start_time"`
+	// previous_rewards defines the rewards distributed within the previous
+	// window.
+	PreviousRewards []types.Coin `protobuf:"bytes,5,rep,name=previous_rewards,json=previousRewards,proto3" json:"previous_rewards"`
+	// previous_start_time defines the block time the previous window started at
+	// (zero if there is no previous window).
+	PreviousStartTime time.Time `protobuf:"bytes,6,opt,name=previous_start_time,json=previousStartTime,proto3,stdtime" json:"previous_start_time"`
+}
+
+func (m *ContractRewardsStats) Reset()         { *m = ContractRewardsStats{} }
+func (m *ContractRewardsStats) String() string { return proto.CompactTextString(m) }
+func (*ContractRewardsStats) ProtoMessage()    {}
+func (*ContractRewardsStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_187c4abc9caff98d, []int{15}
+}
+func (m *ContractRewardsStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractRewardsStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractRewardsStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractRewardsStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractRewardsStats.Merge(m, src)
+}
+func (m *ContractRewardsStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractRewardsStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractRewardsStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractRewardsStats proto.InternalMessageInfo
+
+func (m *ContractRewardsStats) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *ContractRewardsStats) GetLifetimeRewards() []types.Coin {
+	if m != nil {
+		return m.LifetimeRewards
+	}
+	return nil
+}
+
+func (m *ContractRewardsStats) GetRecentRewards() []types.Coin {
+	if m != nil {
+		return m.RecentRewards
+	}
+	return nil
+}
+
+func (m *ContractRewardsStats) GetRecentStartTime() time.Time {
+	if m != nil {
+		return m.RecentStartTime
+	}
+	return time.Time{}
+}
+
+func (m *ContractRewardsStats) GetPreviousRewards() []types.Coin {
+	if m != nil {
+		return m.PreviousRewards
+	}
+	return nil
+}
+
+func (m *ContractRewardsStats) GetPreviousStartTime() time.Time {
+	if m != nil {
+		return m.PreviousStartTime
+	}
+	return time.Time{}
+}
+
+// ContractRewards defines the rewards distributed for a contract (within a
+// block or a range of blocks).
+type ContractRewards struct {
+	// contract_address defines the contract address (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// rewards defines the rewards distributed for the contract.
+	Rewards []types.Coin `protobuf:"bytes,2,rep,name=rewards,proto3" json:"rewards"`
+}
+
+func (m *ContractRewards) Reset()         { *m = ContractRewards{} }
+func (m *ContractRewards) String() string { return proto.CompactTextString(m) }
+func (*ContractRewards) ProtoMessage()    {}
+func (*ContractRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_187c4abc9caff98d, []int{16}
+}
+func (m *ContractRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractRewards.Merge(m, src)
+}
+func (m *ContractRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractRewards proto.InternalMessageInfo
+
+func (m *ContractRewards) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *ContractRewards) GetRewards() []types.Coin {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+// BlockPoolInflows defines the tokens entered the rewards pool within a block
+// by source.
+type BlockPoolInflows struct {
+	// height defines the block height.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// fee_rebates defines the transaction fee rebate rewards.
+	FeeRebates []types.Coin `protobuf:"bytes,2,rep,name=fee_rebates,json=feeRebates,proto3" json:"fee_rebates"`
+	// inflation defines the inflation rewards.
+	Inflation []types.Coin `protobuf:"bytes,3,rep,name=inflation,proto3" json:"inflation"`
+	// flat_fees defines the contract flat fees collected (direct payouts
+	// included).
+	FlatFees []types.Coin `protobuf:"bytes,4,rep,name=flat_fees,json=flatFees,proto3" json:"flat_fees"`
+}
+
+func (m *BlockPoolInflows) Reset()         { *m = BlockPoolInflows{} }
+func (m *BlockPoolInflows) String() string { return proto.CompactTextString(m) }
+func (*BlockPoolInflows) ProtoMessage()    {}
+func (*BlockPoolInflows) Descriptor() ([]byte, []int) {
+	return fileDescriptor_187c4abc9caff98d, []int{17}
+}
+func (m *BlockPoolInflows) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockPoolInflows) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockPoolInflows.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockPoolInflows) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockPoolInflows.Merge(m, src)
+}
+func (m *BlockPoolInflows) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockPoolInflows) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockPoolInflows.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockPoolInflows proto.InternalMessageInfo
+
+func (m *BlockPoolInflows) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockPoolInflows) GetFeeRebates() []types.Coin {
+	if m != nil {
+		return m.FeeRebates
+	}
+	return nil
+}
+
+func (m *BlockPoolInflows) GetInflation() []types.Coin {
+	if m != nil {
+		return m.Inflation
+	}
+	return nil
+}
+
+func (m *BlockPoolInflows) GetFlatFees() []types.Coin {
+	if m != nil {
+		return m.FlatFees
+	}
+	return nil
+}
+
+// DistributionConfig defines the module parameters affecting the fees and
+// rewards distribution combined.
+type DistributionConfig struct {
+	// inflation_rewards_ratio defines the percentage of minted inflation tokens
+	// that are used for dApp rewards.
+	InflationRewardsRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=inflation_rewards_ratio,json=inflationRewardsRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation_rewards_ratio"`
+	// tx_fee_rebate_ratio defines the percentage of tx fees that are used for
+	// dApp rewards.
+	TxFeeRebateRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=tx_fee_rebate_ratio,json=txFeeRebateRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"tx_fee_rebate_ratio"`
+	// min_price_of_gas defines the minimum price for each single unit of gas.
+	MinPriceOfGas types.DecCoin `protobuf:"bytes,3,opt,name=min_price_of_gas,json=minPriceOfGas,proto3" json:"min_price_of_gas"`
+	// min_fee_floor_enabled defines whether a zero minimum transaction fee is
+	// floored to 1 unit of the gas price denom.
+	MinFeeFloorEnabled bool `protobuf:"varint,5,opt,name=min_fee_floor_enabled,json=minFeeFloorEnabled,proto3" json:"min_fee_floor_enabled,omitempty"`
+	// dynamic_fee_enabled defines whether the EIP-1559 like fee mode is enabled.
+	DynamicFeeEnabled bool `protobuf:"varint,6,opt,name=dynamic_fee_enabled,json=dynamicFeeEnabled,proto3" json:"dynamic_fee_enabled,omitempty"`
+	// flat_fee_deliver_tx_only defines whether contract flat fees are charged in
+	// DeliverTx only.
+	FlatFeeDeliverTxOnly bool `protobuf:"varint,7,opt,name=flat_fee_deliver_tx_only,json=flatFeeDeliverTxOnly,proto3" json:"flat_fee_deliver_tx_only,omitempty"`
+	// flat_fee_update_interval defines the minimum number of blocks between two
+	// consecutive contract flat fee updates.
+	FlatFeeUpdateInterval uint64 `protobuf:"varint,8,opt,name=flat_fee_update_interval,json=flatFeeUpdateInterval,proto3" json:"flat_fee_update_interval,omitempty"`
+	// max_flat_fee_update_contracts defines the maximum number of contracts
+	// which flat fees could be updated by a single MsgSetFlatFeeByCodeID
+	// operation.
+	MaxFlatFeeUpdateContracts uint64 `protobuf:"varint,9,opt,name=max_flat_fee_update_contracts,json=maxFlatFeeUpdateContracts,proto3" json:"max_flat_fee_update_contracts,omitempty"`
+	// flat_fee_once_per_block defines whether a contract flat fee is charged
+	// once per block.
+	FlatFeeOncePerBlock bool `protobuf:"varint,10,opt,name=flat_fee_once_per_block,json=flatFeeOncePerBlock,proto3" json:"flat_fee_once_per_block,omitempty"`
+	// accepted_fee_denoms defines the denoms transaction fees could be paid in
+	// (any denom if empty).
+	AcceptedFeeDenoms []string `protobuf:"bytes,11,rep,name=accepted_fee_denoms,json=acceptedFeeDenoms,proto3" json:"accepted_fee_denoms,omitempty"`
+	// tx_size_fee_per_byte defines the minimum fee surcharge (in the gas price
+	// denom) charged per encoded transaction byte.
+	TxSizeFeePerByte uint64 `protobuf:"varint,12,opt,name=tx_size_fee_per_byte,json=txSizeFeePerByte,proto3" json:"tx_size_fee_per_byte,omitempty"`
+	// single_denom_fees_only defines whether transaction fees must be paid in a
+	// single denom.
+	SingleDenomFeesOnly bool `protobuf:"varint,13,opt,name=single_denom_fees_only,json=singleDenomFeesOnly,proto3" json:"single_denom_fees_only,omitempty"`
+	// flat_fee_prepay_discount defines the discount applied to the contract flat
+	// fee for prepaid executions (basis points).
+	FlatFeePrepayDiscount uint64 `protobuf:"varint,14,opt,name=flat_fee_prepay_discount,json=flatFeePrepayDiscount,proto3" json:"flat_fee_prepay_discount,omitempty"`
+	// flat_fee_payer_must_sign defines whether the transaction fee payer must
+	// sign the contract execute msgs charged the contract flat fees.
+	FlatFeePayerMustSign bool `protobuf:"varint,15,opt,name=flat_fee_payer_must_sign,json=flatFeePayerMustSign,proto3" json:"flat_fee_payer_must_sign,omitempty"`
+	// flat_fees_enabled defines whether the contract flat fees are charged.
+	FlatFeesEnabled bool `protobuf:"varint,16,opt,name=flat_fees_enabled,json=flatFeesEnabled,proto3" json:"flat_fees_enabled,omitempty"`
+	// fee_denom_routes defines the per denom destinations of the fee collector
+	// fees.
+	FeeDenomRoutes []FeeDenomRoute `protobuf:"bytes,17,rep,name=fee_denom_routes,json=feeDenomRoutes,proto3" json:"fee_denom_routes"`
+	// flat_fee_conversion_rates defines the rates the contract flat fees are
+	// accepted in other transaction fee denoms at.
+	FlatFeeConversionRates []FlatFeeConversionRate `protobuf:"bytes,18,rep,name=flat_fee_conversion_rates,json=flatFeeConversionRates,proto3" json:"flat_fee_conversion_rates"`
+}
+
+func (m *DistributionConfig) Reset()         { *m = DistributionConfig{} }
+func (m *DistributionConfig) String() string { return proto.CompactTextString(m) }
+func (*DistributionConfig) ProtoMessage()    {}
+func (*DistributionConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_187c4abc9caff98d, []int{18}
+}
+func (m *DistributionConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionConfig.Merge(m, src)
+}
+func (m *DistributionConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionConfig proto.InternalMessageInfo
+
+func (m *DistributionConfig) GetMinPriceOfGas() types.DecCoin {
+	if m != nil {
+		return m.MinPriceOfGas
+	}
+	return types.DecCoin{}
+}
+
+func (m *DistributionConfig) GetMinFeeFloorEnabled() bool {
+	if m != nil {
+		return m.MinFeeFloorEnabled
+	}
+	return false
+}
+
+func (m *DistributionConfig) GetDynamicFeeEnabled() bool {
+	if m != nil {
+		return m.DynamicFeeEnabled
+	}
+	return false
+}
+
+func (m *DistributionConfig) GetFlatFeeDeliverTxOnly() bool {
+	if m != nil {
+		return m.FlatFeeDeliverTxOnly
+	}
+	return false
+}
+
+func (m *DistributionConfig) GetFlatFeeUpdateInterval() uint64 {
+	if m != nil {
+		return m.FlatFeeUpdateInterval
+	}
+	return 0
+}
+
+func (m *DistributionConfig) GetMaxFlatFeeUpdateContracts() uint64 {
+	if m != nil {
+		return m.MaxFlatFeeUpdateContracts
+	}
+	return 0
+}
+
+func (m *DistributionConfig) GetFlatFeeOncePerBlock() bool {
+	if m != nil {
+		return m.FlatFeeOncePerBlock
+	}
+	return false
+}
+
+func (m *DistributionConfig) GetAcceptedFeeDenoms() []string {
+	if m != nil {
+		return m.AcceptedFeeDenoms
+	}
+	return nil
+}
+
+func (m *DistributionConfig) GetTxSizeFeePerByte() uint64 {
+	if m != nil {
+		return m.TxSizeFeePerByte
+	}
+	return 0
+}
+
+func (m *DistributionConfig) GetSingleDenomFeesOnly() bool {
+	if m != nil {
+		return m.SingleDenomFeesOnly
+	}
+	return false
+}
+
+func (m *DistributionConfig) GetFlatFeePrepayDiscount() uint64 {
+	if m != nil {
+		return m.FlatFeePrepayDiscount
+	}
+	return 0
+}
+
+func (m *DistributionConfig) GetFlatFeePayerMustSign() bool {
+	if m != nil {
+		return m.FlatFeePayerMustSign
+	}
+	return false
+}
+
+func (m *DistributionConfig) GetFlatFeesEnabled() bool {
+	if m != nil {
+		return m.FlatFeesEnabled
+	}
+	return false
+}
+
+func (m *DistributionConfig) GetFeeDenomRoutes() []FeeDenomRoute {
+	if m != nil {
+		return m.FeeDenomRoutes
+	}
+	return nil
+}
+
+func (m *DistributionConfig) GetFlatFeeConversionRates() []FlatFeeConversionRate {
+	if m != nil {
+		return m.FlatFeeConversionRates
+	}
+	return nil
+}
+
+// FlatFeeCredit defines the number of prepaid contract executions which are
+// not charged the contract flat fee.
+type FlatFeeCredit struct {
+	// contract_address defines the contract address (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// executions defines the number of prepaid executions left.
+	Executions uint64 `protobuf:"varint,2,opt,name=executions,proto3" json:"executions,omitempty"`
+}
+
+func (m *FlatFeeCredit) Reset()         { *m = FlatFeeCredit{} }
+func (m *FlatFeeCredit) String() string { return proto.CompactTextString(m) }
+func (*FlatFeeCredit) ProtoMessage()    {}
+func (*FlatFeeCredit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_187c4abc9caff98d, []int{19}
+}
+func (m *FlatFeeCredit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FlatFeeCredit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FlatFeeCredit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FlatFeeCredit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlatFeeCredit.Merge(m, src)
+}
+func (m *FlatFeeCredit) XXX_Size() int {
+	return m.Size()
+}
+func (m *FlatFeeCredit) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlatFeeCredit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlatFeeCredit proto.InternalMessageInfo
+
+func (m *FlatFeeCredit) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *FlatFeeCredit) GetExecutions() uint64 {
+	if m != nil {
+		return m.Executions
+	}
+	return 0
+}
+
+// FlatFeeOverride defines the governance set contract flat fee which takes
+// precedence over the contract owner flat fees until the expiry height.
+type FlatFeeOverride struct {
+	// contract_address defines the contract address (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// flat_fee defines the flat fee charged for the contract executions (zero
+	// amount waives the flat fees).
+	FlatFee types.Coin `protobuf:"bytes,2,opt,name=flat_fee,json=flatFee,proto3" json:"flat_fee"`
+	// expiry_height defines the block height the override stops applying at.
+	ExpiryHeight int64 `protobuf:"varint,3,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (m *FlatFeeOverride) Reset()         { *m = FlatFeeOverride{} }
+func (m *FlatFeeOverride) String() string { return proto.CompactTextString(m) }
+func (*FlatFeeOverride) ProtoMessage()    {}
+func (*FlatFeeOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_187c4abc9caff98d, []int{20}
+}
+func (m *FlatFeeOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FlatFeeOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FlatFeeOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FlatFeeOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlatFeeOverride.Merge(m, src)
+}
+func (m *FlatFeeOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *FlatFeeOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlatFeeOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlatFeeOverride proto.InternalMessageInfo
+
+func (m *FlatFeeOverride) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *FlatFeeOverride) GetFlatFee() types.Coin {
+	if m != nil {
+		return m.FlatFee
+	}
+	return types.Coin{}
+}
+
+func (m *FlatFeeOverride) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+// ScheduledRewardsRatios defines the inflation rewards and tx fee rebate ratios
+// queued by governance to be applied at the activation height.
+type ScheduledRewardsRatios struct {
+	// activation_height defines the block height the ratios are applied at
+	// (BeginBlock).
+	ActivationHeight int64 `protobuf:"varint,1,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// inflation_rewards_ratio defines the new percentage of minted inflation
+	// tokens that are used for dApp rewards.
+	InflationRewardsRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=inflation_rewards_ratio,json=inflationRewardsRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation_rewards_ratio"`
+	// tx_fee_rebate_ratio defines the new percentage of tx fees that are used
+	// for dApp rewards.
+	TxFeeRebateRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=tx_fee_rebate_ratio,json=txFeeRebateRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"tx_fee_rebate_ratio"`
+}
+
+func (m *ScheduledRewardsRatios) Reset()         { *m = ScheduledRewardsRatios{} }
+func (m *ScheduledRewardsRatios) String() string { return proto.CompactTextString(m) }
+func (*ScheduledRewardsRatios) ProtoMessage()    {}
+func (*ScheduledRewardsRatios) Descriptor() ([]byte, []int) {
+	return fileDescriptor_187c4abc9caff98d, []int{21}
+}
+func (m *ScheduledRewardsRatios) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledRewardsRatios) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledRewardsRatios.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledRewardsRatios) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledRewardsRatios.Merge(m, src)
+}
+func (m *ScheduledRewardsRatios) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledRewardsRatios) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledRewardsRatios.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledRewardsRatios proto.InternalMessageInfo
+
+func (m *ScheduledRewardsRatios) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+// CodeFlatFee defines the default flat fee set by governance for the contracts
+// instantiated from (or migrated to) a particular code ID.
+type CodeFlatFee struct {
+	// code_id defines the contract code ID.
+	CodeId uint64 `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// flat_fee defines the code ID default flat fee.
+	FlatFee types.Coin `protobuf:"bytes,2,opt,name=flat_fee,json=flatFee,proto3" json:"flat_fee"`
+}
+
+func (m *CodeFlatFee) Reset()         { *m = CodeFlatFee{} }
+func (m *CodeFlatFee) String() string { return proto.CompactTextString(m) }
+func (*CodeFlatFee) ProtoMessage()    {}
+func (*CodeFlatFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_187c4abc9caff98d, []int{22}
+}
+func (m *CodeFlatFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CodeFlatFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CodeFlatFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CodeFlatFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodeFlatFee.Merge(m, src)
+}
+func (m *CodeFlatFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *CodeFlatFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodeFlatFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodeFlatFee proto.InternalMessageInfo
+
+func (m *CodeFlatFee) GetCodeId() uint64 {
+	if m != nil {
+		return m.CodeId
+	}
+	return 0
+}
+
+func (m *CodeFlatFee) GetFlatFee() types.Coin {
+	if m != nil {
+		return m.FlatFee
+	}
+	return types.Coin{}
+}
+
+// RewardsReconciliation defines the rewards pool balance compared against the
+// tokens the module expects to hold.
+type RewardsReconciliation struct {
+	// expected defines the tokens the rewards pool is expected to hold: the
+	// pending rewards (outstanding rewards records, flat fees queued for the
+	// direct payout and the current block tracked rewards) and the rewards
+	// remainders reserve.
+	Expected []types.Coin `protobuf:"bytes,1,rep,name=expected,proto3" json:"expected"`
+	// pool_balance defines the current rewards pool (ContractRewardCollector)
+	// balance.
+	PoolBalance []types.Coin `protobuf:"bytes,2,rep,name=pool_balance,json=poolBalance,proto3" json:"pool_balance"`
+	// surplus defines the pool balance exceeding the expected tokens (per
+	// denom).
+	Surplus []types.Coin `protobuf:"bytes,3,rep,name=surplus,proto3" json:"surplus"`
+	// deficit defines the expected tokens not covered by the pool balance (per
+	// denom).
+	Deficit []types.Coin `protobuf:"bytes,4,rep,name=deficit,proto3" json:"deficit"`
+}
+
+func (m *RewardsReconciliation) Reset()         { *m = RewardsReconciliation{} }
+func (m *RewardsReconciliation) String() string { return proto.CompactTextString(m) }
+func (*RewardsReconciliation) ProtoMessage()    {}
+func (*RewardsReconciliation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_187c4abc9caff98d, []int{23}
+}
+func (m *RewardsReconciliation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardsReconciliation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardsReconciliation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardsReconciliation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardsReconciliation.Merge(m, src)
+}
+func (m *RewardsReconciliation) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardsReconciliation) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardsReconciliation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardsReconciliation proto.InternalMessageInfo
+
+func (m *RewardsReconciliation) GetExpected() []types.Coin {
+	if m != nil {
+		return m.Expected
+	}
+	return nil
+}
+
+func (m *RewardsReconciliation) GetPoolBalance() []types.Coin {
+	if m != nil {
+		return m.PoolBalance
+	}
+	return nil
+}
+
+func (m *RewardsReconciliation) GetSurplus() []types.Coin {
+	if m != nil {
+		return m.Surplus
+	}
+	return nil
+}
+
+func (m *RewardsReconciliation) GetDeficit() []types.Coin {
+	if m != nil {
+		return m.Deficit
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("archway.rewards.v1.FlatFeeMigrationPolicy", FlatFeeMigrationPolicy_name, FlatFeeMigrationPolicy_value)
+	proto.RegisterType((*Params)(nil), "archway.rewards.v1.Params")
+	proto.RegisterType((*FeePromotion)(nil), "archway.rewards.v1.FeePromotion")
+	proto.RegisterType((*FeeDenomRoute)(nil), "archway.rewards.v1.FeeDenomRoute")
+	proto.RegisterType((*FlatFeeConversionRate)(nil), "archway.rewards.v1.FlatFeeConversionRate")
+	proto.RegisterType((*ContractMetadata)(nil), "archway.rewards.v1.ContractMetadata")
+	proto.RegisterType((*RewardsSplit)(nil), "archway.rewards.v1.RewardsSplit")
+	proto.RegisterType((*BlockRewards)(nil), "archway.rewards.v1.BlockRewards")
+	proto.RegisterType((*TxRewards)(nil), "archway.rewards.v1.TxRewards")
+	proto.RegisterType((*TxFeeDistribution)(nil), "archway.rewards.v1.TxFeeDistribution")
+	proto.RegisterType((*ContractTxRewards)(nil), "archway.rewards.v1.ContractTxRewards")
+	proto.RegisterType((*RewardsRecord)(nil), "archway.rewards.v1.RewardsRecord")
+	proto.RegisterType((*FlatFee)(nil), "archway.rewards.v1.FlatFee")
+	proto.RegisterType((*FlatFeeSchedule)(nil), "archway.rewards.v1.FlatFeeSchedule")
+	proto.RegisterType((*ContractCodeID)(nil), "archway.rewards.v1.ContractCodeID")
+	proto.RegisterType((*MinConsensusFees)(nil), "archway.rewards.v1.MinConsensusFees")
+	proto.RegisterType((*ContractRewardsStats)(nil), "archway.rewards.v1.ContractRewardsStats")
+	proto.RegisterType((*ContractRewards)(nil), "archway.rewards.v1.ContractRewards")
+	proto.RegisterType((*BlockPoolInflows)(nil), "archway.rewards.v1.BlockPoolInflows")
+	proto.RegisterType((*DistributionConfig)(nil), "archway.rewards.v1.DistributionConfig")
+	proto.RegisterType((*FlatFeeCredit)(nil), "archway.rewards.v1.FlatFeeCredit")
+	proto.RegisterType((*FlatFeeOverride)(nil), "archway.rewards.v1.FlatFeeOverride")
+	proto.RegisterType((*ScheduledRewardsRatios)(nil), "archway.rewards.v1.ScheduledRewardsRatios")
+	proto.RegisterType((*CodeFlatFee)(nil), "archway.rewards.v1.CodeFlatFee")
+	proto.RegisterType((*RewardsReconciliation)(nil), "archway.rewards.v1.RewardsReconciliation")
+}
+
+func init() { proto.RegisterFile("archway/rewards/v1/rewards.proto", fileDescriptor_187c4abc9caff98d) }
+
+var fileDescriptor_187c4abc9caff98d = []byte{
+	// 2667 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0x4b, 0x73, 0x1b, 0xc7,
+	0xb5, 0x16, 0x1e, 0x24, 0xc1, 0xc3, 0x17, 0xd8, 0x7c, 0x0d, 0x69, 0x0b, 0xa2, 0x60, 0xbb, 0x2e,
+	0xe5, 0x7b, 0x05, 0x5e, 0xc9, 0xf6, 0xbd, 0x7e, 0xc4, 0x89, 0xf9, 0x00, 0x24, 0xc8, 0xa4, 0x89,
+	0x80, 0x74, 0xb9, 0xe2, 0x4a, 0xd5, 0xa4, 0x31, 0xd3, 0x00, 0x3a, 0x9a, 0x99, 0x46, 0x4d, 0x37,
+	0x48, 0xd0, 0xab, 0xac, 0xb2, 0x4b, 0xca, 0xc9, 0xc2, 0xbb, 0xfc, 0x81, 0x54, 0x76, 0xc9, 0x8f,
+	0x70, 0x2a, 0x1b, 0x57, 0x56, 0xa9, 0x2c, 0x9c, 0x94, 0xbd, 0xca, 0x36, 0xbf, 0x20, 0xd5, 0xaf,
+	0x21, 0x40, 0x81, 0x14, 0x40, 0x3b, 0x5e, 0x64, 0x87, 0xe9, 0xf3, 0xe8, 0x33, 0xa7, 0x4f, 0x7f,
+	0xe7, 0x9b, 0x43, 0xc2, 0x26, 0x8e, 0xbd, 0xf6, 0x19, 0x3e, 0xdf, 0x8e, 0xc9, 0x19, 0x8e, 0x7d,
+	0xbe, 0x7d, 0xfa, 0xc0, 0xfe, 0x2c, 0x75, 0x62, 0x26, 0x18, 0x42, 0x46, 0xa3, 0x64, 0x97, 0x4f,
+	0x1f, 0x6c, 0x2c, 0xb7, 0x58, 0x8b, 0x29, 0xf1, 0xb6, 0xfc, 0xa5, 0x35, 0x37, 0xee, 0xb4, 0x18,
+	0x6b, 0x05, 0x64, 0x5b, 0x3d, 0x35, 0xba, 0xcd, 0x6d, 0x41, 0x43, 0xc2, 0x05, 0x0e, 0x3b, 0x46,
+	0xa1, 0xe0, 0x31, 0x1e, 0x32, 0xbe, 0xdd, 0xc0, 0x9c, 0x6c, 0x9f, 0x3e, 0x68, 0x10, 0x81, 0x1f,
+	0x6c, 0x7b, 0x8c, 0x46, 0x46, 0xbe, 0xae, 0xe5, 0xae, 0xf6, 0xac, 0x1f, 0xb4, 0xa8, 0xf8, 0xcf,
+	0x3c, 0x4c, 0xd6, 0x70, 0x8c, 0x43, 0x8e, 0x28, 0xac, 0xd1, 0xa8, 0x19, 0x60, 0x41, 0x59, 0xe4,
+	0x9a, 0xa0, 0xdc, 0x58, 0x3e, 0x3a, 0xa9, 0xcd, 0xd4, 0xd6, 0xf4, 0xee, 0x83, 0xcf, 0xbf, 0xbc,
+	0x73, 0xeb, 0xaf, 0x5f, 0xde, 0x79, 0x41, 0x7b, 0xe0, 0xfe, 0xd3, 0x12, 0x65, 0xdb, 0x21, 0x16,
+	0xed, 0xd2, 0x01, 0x69, 0x61, 0xef, 0x7c, 0x9f, 0x78, 0x7f, 0xfe, 0xc3, 0x7d, 0x30, 0x1b, 0xec,
+	0x13, 0xaf, 0xbe, 0x92, 0x78, 0xac, 0x6b, 0x87, 0x75, 0xf9, 0x80, 0x7e, 0x02, 0x4b, 0xa2, 0xe7,
+	0x36, 0x09, 0x71, 0x63, 0xd2, 0xc0, 0x82, 0x98, 0x6d, 0xd2, 0x37, 0xdd, 0x26, 0x2f, 0x7a, 0x15,
+	0x42, 0xea, 0xca, 0x97, 0xde, 0xe1, 0x7f, 0x61, 0x39, 0xc4, 0x3d, 0xf7, 0x8c, 0x8a, 0xb6, 0x1f,
+	0xe3, 0x33, 0x37, 0x26, 0x1e, 0x8b, 0x7d, 0xee, 0x64, 0x36, 0x53, 0x5b, 0xd9, 0x3a, 0x0a, 0x71,
+	0xef, 0x23, 0x23, 0xaa, 0x6b, 0x09, 0x7a, 0x1f, 0xf2, 0x21, 0x8d, 0xdc, 0x4e, 0x4c, 0x3d, 0xe2,
+	0xb2, 0xa6, 0xdb, 0xc2, 0xdc, 0xc9, 0x6e, 0xa6, 0xb6, 0x66, 0x1e, 0xbe, 0x58, 0x32, 0x5b, 0xc9,
+	0xfc, 0x96, 0x4c, 0x7e, 0xe5, 0xbe, 0x7b, 0x8c, 0x46, 0xbb, 0x59, 0x19, 0x6e, 0x7d, 0x2e, 0xa4,
+	0x51, 0x4d, 0x9a, 0x1e, 0x35, 0x1f, 0x61, 0x8e, 0x4a, 0xb0, 0xe4, 0x9f, 0x47, 0x38, 0xa4, 0x9e,
+	0x7a, 0x4b, 0x12, 0xe1, 0x46, 0x40, 0x7c, 0x67, 0x72, 0x33, 0xb5, 0x95, 0xab, 0x2f, 0x1a, 0x51,
+	0x85, 0x90, 0xb2, 0x16, 0xa0, 0xff, 0x07, 0x47, 0xe6, 0x49, 0x29, 0x77, 0x3b, 0xbe, 0x4c, 0x09,
+	0x8d, 0x04, 0x89, 0x4f, 0x71, 0xe0, 0x4c, 0xa9, 0x90, 0x57, 0xa4, 0xbc, 0x42, 0xc8, 0x87, 0x4a,
+	0x5a, 0x35, 0x42, 0xf4, 0x1e, 0xdc, 0x96, 0xef, 0x79, 0xd9, 0xd8, 0x63, 0x91, 0x88, 0xb1, 0x27,
+	0xb8, 0x93, 0x53, 0xd6, 0xeb, 0x21, 0xee, 0x55, 0xfa, 0x1d, 0xec, 0x59, 0x05, 0xf4, 0x7f, 0x7d,
+	0x5b, 0xfb, 0x24, 0xa0, 0xa7, 0x24, 0x76, 0x45, 0xcf, 0x65, 0x51, 0x70, 0xee, 0x4c, 0xab, 0x78,
+	0x97, 0xcd, 0xd6, 0xfb, 0x5a, 0x7a, 0xd2, 0x3b, 0x8a, 0x82, 0x73, 0xf4, 0x00, 0x56, 0x64, 0xbe,
+	0xa4, 0x59, 0x33, 0x60, 0x2c, 0x4e, 0x5e, 0x12, 0x94, 0x11, 0x0a, 0x69, 0x54, 0x21, 0xa4, 0x22,
+	0x45, 0xf6, 0x2d, 0xdf, 0x81, 0x0d, 0x69, 0x62, 0x83, 0x73, 0x49, 0x8f, 0x78, 0x5d, 0x55, 0x6e,
+	0x32, 0xd9, 0x33, 0x2a, 0xd2, 0xb5, 0x90, 0x46, 0x36, 0xb8, 0xb2, 0x95, 0xcb, 0x94, 0xbe, 0x0c,
+	0xf3, 0xcd, 0x98, 0x10, 0x19, 0x5b, 0xa3, 0xeb, 0xb7, 0x88, 0x70, 0x66, 0x95, 0xc1, 0xac, 0x5c,
+	0x3d, 0xe9, 0xed, 0xaa, 0x35, 0xf4, 0x16, 0xc8, 0x57, 0x95, 0xfe, 0x6c, 0x69, 0x85, 0xdd, 0x40,
+	0xd0, 0x4e, 0x40, 0x49, 0xec, 0xcc, 0x29, 0x83, 0xd5, 0x10, 0xf7, 0x1e, 0x61, 0xae, 0xab, 0xe5,
+	0x30, 0x91, 0xa2, 0xd7, 0x61, 0x2d, 0x49, 0x04, 0x8b, 0x3c, 0xe2, 0x76, 0x48, 0xec, 0x36, 0x02,
+	0xe6, 0x3d, 0x75, 0xe6, 0xd5, 0x2b, 0x2d, 0x99, 0x3c, 0x1c, 0x45, 0x1e, 0xa9, 0x91, 0x78, 0x57,
+	0x8a, 0xe4, 0x49, 0x63, 0xcf, 0x23, 0x1d, 0x41, 0x7c, 0x93, 0xc2, 0x88, 0x85, 0xdc, 0x59, 0xd8,
+	0xcc, 0x6c, 0x4d, 0xd7, 0x17, 0xad, 0x48, 0x65, 0x4f, 0x0a, 0x50, 0x09, 0x96, 0x45, 0xcf, 0xe5,
+	0xf4, 0x13, 0xa2, 0xd4, 0xd5, 0x1e, 0xe7, 0x82, 0x38, 0x79, 0x15, 0x5b, 0x5e, 0xf4, 0x8e, 0xe9,
+	0x27, 0xa4, 0x42, 0xd4, 0x06, 0xe7, 0x82, 0xa0, 0xd7, 0x60, 0x95, 0xd3, 0xa8, 0x15, 0x18, 0xcf,
+	0xd2, 0x88, 0xeb, 0xc3, 0x59, 0xd4, 0x41, 0x69, 0xa9, 0xf2, 0x5e, 0x21, 0x84, 0xab, 0xb3, 0xe9,
+	0x2f, 0xa7, 0x4e, 0x4c, 0x3a, 0xf8, 0xdc, 0xf5, 0x29, 0xf7, 0x58, 0x37, 0x12, 0x0e, 0x1a, 0x28,
+	0xa7, 0x9a, 0x92, 0xee, 0x1b, 0xe1, 0x40, 0x31, 0x74, 0xf0, 0x39, 0x89, 0xdd, 0xb0, 0xcb, 0x85,
+	0xcb, 0x69, 0x2b, 0x72, 0x96, 0x06, 0x8a, 0xa1, 0x26, 0xa5, 0x87, 0x5d, 0x2e, 0x8e, 0x69, 0x2b,
+	0x42, 0xaf, 0xc2, 0xa2, 0xb5, 0xe3, 0x49, 0x21, 0x2c, 0x2b, 0x83, 0x05, 0x63, 0xc0, 0x6d, 0x15,
+	0xfc, 0x10, 0xf2, 0x49, 0xa2, 0xdc, 0x98, 0x75, 0x05, 0xe1, 0xce, 0xca, 0x66, 0x66, 0x6b, 0xe6,
+	0xe1, 0xdd, 0xd2, 0xb3, 0x98, 0x58, 0xb2, 0xa9, 0xab, 0x4b, 0x4d, 0x73, 0xdb, 0xe6, 0x9b, 0xfd,
+	0x8b, 0x1c, 0xfd, 0x14, 0xd6, 0x93, 0xb0, 0x3d, 0x16, 0x9d, 0x92, 0x98, 0x2b, 0x10, 0xc3, 0xd2,
+	0xf7, 0xaa, 0xf2, 0x7d, 0x6f, 0xa8, 0x6f, 0x1d, 0xda, 0x5e, 0x62, 0x52, 0xc7, 0xc9, 0x1e, 0xab,
+	0xcd, 0x61, 0x42, 0x8e, 0x76, 0xa0, 0xe0, 0xb5, 0x89, 0xf7, 0x54, 0x16, 0xa2, 0xbd, 0x00, 0xe4,
+	0x94, 0x44, 0x22, 0x79, 0xef, 0x35, 0xf5, 0xde, 0xeb, 0x4a, 0xeb, 0xa4, 0x77, 0xa8, 0xee, 0x41,
+	0x59, 0x6a, 0xd8, 0x0c, 0xfc, 0x18, 0x36, 0x64, 0x91, 0x26, 0xf7, 0x40, 0x15, 0x99, 0x85, 0x5c,
+	0xc7, 0x51, 0xf1, 0xae, 0x0f, 0x05, 0x9d, 0x3e, 0xc4, 0x59, 0x0b, 0x71, 0xcf, 0x5e, 0x14, 0x55,
+	0x8a, 0x06, 0x61, 0x11, 0xe9, 0x4b, 0x46, 0x48, 0x5b, 0xb1, 0x06, 0xf4, 0x0e, 0x0b, 0xa8, 0x77,
+	0xee, 0xac, 0x6f, 0xa6, 0xb6, 0xe6, 0x1f, 0xbe, 0x7a, 0x4d, 0x32, 0x0e, 0xad, 0x49, 0x4d, 0x59,
+	0x24, 0x79, 0xb8, 0xb4, 0x8e, 0xde, 0x00, 0x67, 0x00, 0x79, 0x42, 0xde, 0xe2, 0xaa, 0x9c, 0x45,
+	0xcf, 0xd9, 0x50, 0x35, 0xb6, 0x74, 0x01, 0x3a, 0x87, 0xbc, 0xc5, 0x6b, 0x12, 0x3a, 0xd0, 0xbb,
+	0xf0, 0x62, 0x62, 0x82, 0x1b, 0x9c, 0xc5, 0x0d, 0xe2, 0xbb, 0x54, 0x21, 0x80, 0x5c, 0x73, 0x5e,
+	0x50, 0xc9, 0x5b, 0x33, 0x9b, 0xee, 0x18, 0x8d, 0xaa, 0x84, 0x80, 0x0a, 0x21, 0xe8, 0x7d, 0x98,
+	0xd3, 0x45, 0xcd, 0x42, 0x26, 0x83, 0x71, 0x5e, 0x54, 0x10, 0xbd, 0x79, 0x45, 0xe5, 0xd4, 0xac,
+	0x9e, 0x49, 0xda, 0x6c, 0xb3, 0x6f, 0x0d, 0xbd, 0x0d, 0x1b, 0x49, 0x2c, 0x31, 0x69, 0x76, 0x23,
+	0xdf, 0x65, 0x91, 0xdb, 0xc4, 0x34, 0xe8, 0xc6, 0xc4, 0xb9, 0xad, 0x22, 0xb1, 0xaf, 0x5f, 0x57,
+	0xf2, 0xa3, 0xa8, 0xa2, 0xa5, 0xe8, 0x00, 0x5e, 0x4e, 0xca, 0x20, 0x60, 0x1e, 0x0e, 0xdc, 0xd0,
+	0xbc, 0x85, 0xee, 0x20, 0xb6, 0x18, 0x0a, 0xca, 0x4b, 0xc1, 0x14, 0xc3, 0x81, 0xd4, 0x3c, 0xa4,
+	0xf2, 0x6d, 0x54, 0xb7, 0xb0, 0x15, 0xf1, 0x04, 0x8a, 0x06, 0x19, 0x39, 0x89, 0x78, 0x57, 0xa5,
+	0xc2, 0xe5, 0x21, 0x63, 0xa2, 0x4d, 0xa3, 0x96, 0x7b, 0x46, 0x23, 0x9f, 0x9d, 0x39, 0x77, 0x54,
+	0x5a, 0x0b, 0x1a, 0x21, 0xb5, 0x62, 0x85, 0x90, 0x63, 0xab, 0xf6, 0x91, 0xd2, 0x92, 0x28, 0x6b,
+	0xbb, 0xb7, 0x87, 0x83, 0xa0, 0x81, 0xbd, 0xa7, 0x2a, 0xae, 0x80, 0x86, 0x54, 0x38, 0x9b, 0x1a,
+	0x65, 0x8d, 0xc6, 0x9e, 0x51, 0x78, 0x84, 0xf9, 0x81, 0x14, 0xa3, 0xc7, 0x70, 0xf7, 0xb2, 0x31,
+	0x37, 0xf5, 0x79, 0xe1, 0xe3, 0xae, 0xf2, 0x71, 0xfb, 0x92, 0x0f, 0xae, 0xaa, 0xd0, 0x7a, 0x7a,
+	0x92, 0xcd, 0x4d, 0xe4, 0x27, 0xeb, 0x4b, 0xf6, 0x8a, 0xe8, 0xeb, 0x1e, 0xb0, 0x16, 0xf5, 0x8a,
+	0x01, 0xcc, 0xf6, 0x9f, 0x0d, 0xda, 0x80, 0x5c, 0x02, 0x4f, 0x29, 0xe5, 0x3b, 0x79, 0x46, 0x77,
+	0x61, 0x96, 0x0b, 0x1c, 0x0b, 0xb7, 0x4d, 0x68, 0xab, 0x2d, 0x14, 0x47, 0xc8, 0xd4, 0x67, 0xd4,
+	0xda, 0x63, 0xb5, 0x84, 0x6e, 0x03, 0x90, 0xc8, 0xb7, 0x0a, 0x19, 0xa5, 0x30, 0x4d, 0x22, 0x5f,
+	0x8b, 0x8b, 0x07, 0x30, 0x37, 0x80, 0x21, 0x68, 0x19, 0x26, 0x54, 0x34, 0x9a, 0xd6, 0xd4, 0xf5,
+	0x03, 0x7a, 0x05, 0xe6, 0x43, 0xe6, 0x77, 0x03, 0xe2, 0x62, 0x4f, 0x87, 0xa2, 0xe8, 0x48, 0x7d,
+	0x4e, 0xaf, 0xee, 0xe8, 0xc5, 0xe2, 0xaf, 0x52, 0xb0, 0x32, 0x14, 0x36, 0xae, 0x70, 0xfb, 0x02,
+	0x4c, 0x27, 0xaf, 0x6f, 0x3c, 0xe6, 0x2c, 0x7a, 0xa1, 0x32, 0x64, 0x63, 0x2c, 0x88, 0x93, 0xb9,
+	0x29, 0xf1, 0x51, 0xe6, 0xc5, 0x9f, 0x4d, 0x40, 0xde, 0x42, 0xc1, 0x21, 0x11, 0xd8, 0xc7, 0x02,
+	0xa3, 0x7b, 0x90, 0x4f, 0x00, 0x06, 0xfb, 0x7e, 0x4c, 0x38, 0x37, 0x91, 0x2d, 0xd8, 0xf5, 0x1d,
+	0xbd, 0x8c, 0x5e, 0x82, 0x39, 0x76, 0x16, 0x91, 0x38, 0xd1, 0xd3, 0x71, 0xce, 0xaa, 0x45, 0xab,
+	0xf4, 0x5f, 0xb0, 0x60, 0x2b, 0xc3, 0xaa, 0xa9, 0xb0, 0xeb, 0xf3, 0x66, 0xd9, 0x2a, 0xfe, 0x0f,
+	0xa0, 0x84, 0x76, 0x09, 0xe6, 0x9e, 0xe1, 0x20, 0x20, 0x42, 0x51, 0xa9, 0x5c, 0x3d, 0x6f, 0x25,
+	0x27, 0xec, 0x23, 0xb5, 0x8e, 0xde, 0xe8, 0xeb, 0xba, 0xa4, 0x47, 0xc2, 0x8e, 0x50, 0x85, 0x47,
+	0x62, 0xee, 0x4c, 0xa8, 0x1e, 0x6a, 0x1b, 0x4e, 0x59, 0x09, 0xf7, 0xb4, 0x0c, 0x1d, 0x82, 0xdd,
+	0xd6, 0xe5, 0x9d, 0x80, 0x0a, 0xee, 0x4c, 0x6e, 0x66, 0xae, 0x02, 0x02, 0x83, 0x8c, 0xc7, 0x52,
+	0xd1, 0xf2, 0xb5, 0xb8, 0x6f, 0x8d, 0xcb, 0x2e, 0x7b, 0x41, 0x82, 0x68, 0x4c, 0x3c, 0x21, 0xdb,
+	0x1f, 0xeb, 0x0a, 0x67, 0x6a, 0xa0, 0xf5, 0xef, 0x2b, 0x59, 0x4d, 0x89, 0xd0, 0x43, 0x58, 0x19,
+	0xce, 0x33, 0x34, 0xe7, 0x5a, 0x6a, 0x0d, 0x21, 0x19, 0xf7, 0x61, 0xa9, 0x8f, 0x64, 0xb8, 0xbc,
+	0xeb, 0x79, 0x32, 0x93, 0x9a, 0x68, 0xe5, 0x13, 0x82, 0x71, 0xac, 0xd7, 0x07, 0x1a, 0x79, 0x42,
+	0x33, 0x0c, 0xc5, 0x00, 0x95, 0x1e, 0xdb, 0xc8, 0x77, 0x8c, 0xd4, 0xd0, 0x8c, 0x37, 0xc1, 0x79,
+	0x06, 0x04, 0xec, 0xb1, 0xcd, 0xa8, 0x63, 0x5b, 0xbd, 0x74, 0x7d, 0xed, 0xf1, 0xbd, 0x0e, 0xab,
+	0xcf, 0x58, 0xf2, 0x36, 0x8e, 0x89, 0xe1, 0x5b, 0xcb, 0x97, 0xec, 0x8e, 0xa5, 0xac, 0xf8, 0x1e,
+	0xcc, 0xf6, 0x67, 0x19, 0x39, 0x30, 0x35, 0x58, 0x74, 0xf6, 0x11, 0xad, 0xc2, 0xe4, 0xd9, 0xc5,
+	0x55, 0xce, 0xd6, 0xcd, 0x53, 0xf1, 0x17, 0x29, 0x98, 0x1d, 0xe8, 0x63, 0xab, 0x30, 0x69, 0xae,
+	0x74, 0x4a, 0x5d, 0x69, 0xf3, 0x84, 0x0e, 0x60, 0xf1, 0x99, 0xef, 0x14, 0xe5, 0x6b, 0x84, 0xa6,
+	0x99, 0xbf, 0xfc, 0x3d, 0x82, 0xd6, 0x60, 0xca, 0x10, 0x46, 0xf3, 0x6d, 0x30, 0xa9, 0xe9, 0x61,
+	0xf1, 0x13, 0x98, 0x3e, 0xe9, 0x59, 0xad, 0x25, 0x98, 0x10, 0x3d, 0x97, 0xfa, 0x06, 0x9e, 0xb2,
+	0xa2, 0x57, 0xf5, 0xfb, 0x02, 0x4c, 0x0f, 0x04, 0xf8, 0x1e, 0xcc, 0xe8, 0x8e, 0xa2, 0x43, 0xcb,
+	0x8c, 0xd6, 0xcf, 0xa1, 0x49, 0x88, 0xd9, 0xae, 0xf8, 0xbb, 0x0c, 0x2c, 0x9e, 0xf4, 0x54, 0xbd,
+	0x71, 0x11, 0xd3, 0x86, 0x22, 0xc1, 0xe3, 0x05, 0xb1, 0x06, 0x53, 0xa2, 0xe7, 0xb6, 0x31, 0x6f,
+	0x9b, 0x6b, 0x3a, 0x29, 0x7a, 0x8f, 0x31, 0x6f, 0xa3, 0x43, 0x40, 0x9a, 0x26, 0x05, 0x01, 0xf1,
+	0x04, 0x8b, 0x15, 0x67, 0x73, 0xb2, 0xa3, 0x05, 0x29, 0x99, 0xdb, 0x9e, 0xb5, 0xac, 0x10, 0xc2,
+	0xd1, 0xf7, 0x01, 0x1a, 0xdd, 0x38, 0xd2, 0xd4, 0xcf, 0x99, 0x18, 0xcd, 0xcd, 0xb4, 0x32, 0x51,
+	0xf6, 0xbb, 0x30, 0x6b, 0xcb, 0x4d, 0x79, 0x98, 0x1c, 0xcd, 0xc3, 0x8c, 0x31, 0x52, 0x3e, 0xbe,
+	0x07, 0xd3, 0x09, 0xfb, 0x74, 0xa6, 0x46, 0x73, 0x90, 0xb3, 0xb4, 0x54, 0x1e, 0x97, 0x62, 0xa1,
+	0xbe, 0xb6, 0xcf, 0x8d, 0x78, 0x5c, 0xda, 0x46, 0x7a, 0x28, 0x7e, 0x96, 0x86, 0x45, 0x8b, 0xbf,
+	0x37, 0xac, 0x99, 0x61, 0x68, 0x9d, 0x19, 0x8e, 0xd6, 0xeb, 0x90, 0x93, 0xb0, 0xd3, 0xe5, 0xc4,
+	0x57, 0xa8, 0x9a, 0xad, 0x4f, 0xb5, 0x30, 0xff, 0x90, 0x13, 0xff, 0x72, 0xe5, 0x4d, 0x8c, 0x5d,
+	0x79, 0xc3, 0x2f, 0xd7, 0x88, 0x67, 0xf2, 0xcc, 0xe5, 0x2a, 0xfe, 0x36, 0x0d, 0x73, 0xe6, 0xb7,
+	0xfe, 0xcc, 0x46, 0xf3, 0x90, 0x4e, 0x32, 0x92, 0xa6, 0xfe, 0xb0, 0xae, 0x92, 0x1e, 0xda, 0x55,
+	0xde, 0x82, 0xa9, 0x31, 0x2f, 0x94, 0xd5, 0x47, 0xff, 0x0d, 0x8b, 0x1e, 0x0e, 0xbc, 0x6e, 0x80,
+	0xe5, 0x21, 0x9b, 0xf4, 0x67, 0x55, 0xfa, 0xf3, 0x17, 0x02, 0x43, 0x26, 0x0e, 0x61, 0xa1, 0x4f,
+	0x59, 0xd0, 0x90, 0x38, 0x13, 0x0a, 0x5b, 0x36, 0x4a, 0x7a, 0x0c, 0x53, 0xb2, 0x63, 0x98, 0xd2,
+	0x89, 0x1d, 0xc3, 0xec, 0xe6, 0xe4, 0x86, 0x9f, 0xfe, 0xed, 0x4e, 0xaa, 0x3e, 0x7f, 0x61, 0x2c,
+	0xc5, 0x43, 0xcf, 0x75, 0x72, 0xe8, 0xb9, 0x16, 0x7f, 0x9f, 0x86, 0x29, 0xc3, 0x2c, 0xc6, 0x69,
+	0xde, 0x6f, 0x43, 0xce, 0x16, 0xff, 0xa8, 0x28, 0x38, 0x65, 0x6a, 0x1f, 0xfd, 0x00, 0x72, 0xdc,
+	0x6b, 0x13, 0xc9, 0x6f, 0x54, 0xb5, 0xcd, 0x3c, 0x7c, 0xe9, 0x9a, 0x2f, 0x83, 0x63, 0xa3, 0x5a,
+	0x4f, 0x8c, 0x64, 0x39, 0x87, 0x44, 0xb4, 0x99, 0xae, 0xc4, 0xe9, 0xba, 0x79, 0x42, 0x6d, 0x58,
+	0xb3, 0x7c, 0x56, 0x7f, 0x1c, 0x5c, 0x34, 0xc7, 0x89, 0x9b, 0x72, 0x9d, 0x65, 0xc3, 0x7b, 0xe5,
+	0xe7, 0x44, 0xe2, 0xae, 0xf8, 0xa7, 0x14, 0x2c, 0x5c, 0x8a, 0xef, 0x19, 0xce, 0x98, 0x7a, 0x1e,
+	0x67, 0x4c, 0x5f, 0xe2, 0x8c, 0x12, 0x51, 0xb4, 0x87, 0x26, 0xb1, 0x99, 0x79, 0x3e, 0xa2, 0x28,
+	0x0b, 0x99, 0xd6, 0x37, 0x61, 0x4a, 0x3a, 0x97, 0xb6, 0xd9, 0xd1, 0x6c, 0x27, 0x49, 0x24, 0xa1,
+	0xa4, 0x78, 0x02, 0xf3, 0x16, 0x48, 0xf6, 0x98, 0x4f, 0xaa, 0xfb, 0xe3, 0x54, 0xc2, 0x1a, 0x4c,
+	0x79, 0xcc, 0x27, 0x12, 0x72, 0x4c, 0x6b, 0x95, 0x8f, 0x55, 0xbf, 0xf8, 0x04, 0xf2, 0x87, 0x83,
+	0xdf, 0x0c, 0x72, 0xec, 0x93, 0x55, 0x70, 0x97, 0xda, 0xcc, 0x8c, 0x38, 0xe2, 0x52, 0xfa, 0xc5,
+	0x3f, 0x66, 0x60, 0xd9, 0x86, 0x68, 0x3b, 0xbe, 0xc0, 0x82, 0x8f, 0x13, 0xe8, 0x13, 0xc8, 0x07,
+	0xb4, 0x49, 0xe4, 0xe5, 0xea, 0x6b, 0xe0, 0x23, 0x5d, 0xea, 0x05, 0x6b, 0x68, 0x01, 0xab, 0x22,
+	0x89, 0xa0, 0x47, 0x22, 0x31, 0x6e, 0xbf, 0x9d, 0xd3, 0x66, 0xd6, 0x4f, 0x0d, 0x16, 0x8d, 0x1f,
+	0x7d, 0xf0, 0xea, 0xe6, 0x67, 0xc7, 0xb8, 0xf9, 0x0b, 0xda, 0xfc, 0x58, 0x5a, 0xab, 0xab, 0xff,
+	0x04, 0xf2, 0x9d, 0x98, 0x9c, 0x52, 0xd6, 0xe5, 0xe3, 0x22, 0xf2, 0x82, 0x35, 0xb4, 0xd1, 0x9d,
+	0xc0, 0x52, 0xe2, 0xab, 0x2f, 0xbe, 0xc9, 0x31, 0xe2, 0x5b, 0xb4, 0x0e, 0x92, 0x08, 0x8b, 0x67,
+	0xb0, 0x70, 0xe9, 0x28, 0xc7, 0x39, 0xc5, 0x3e, 0x44, 0x4e, 0x8f, 0x87, 0xc8, 0xc5, 0x7f, 0xa4,
+	0x20, 0xaf, 0xb8, 0x5e, 0x8d, 0xb1, 0xa0, 0x1a, 0x35, 0x03, 0x76, 0x76, 0x35, 0xdf, 0x4b, 0x9a,
+	0x5a, 0x43, 0x8d, 0x73, 0xd2, 0xe3, 0x34, 0x35, 0x65, 0x82, 0xde, 0x85, 0xe9, 0xa4, 0x35, 0x8d,
+	0x5a, 0x1e, 0x17, 0x16, 0x83, 0xf4, 0x22, 0x3b, 0x26, 0xbd, 0x28, 0xfe, 0x7c, 0x1a, 0x50, 0x3f,
+	0x8d, 0xdb, 0x63, 0x51, 0x93, 0xb6, 0xfe, 0xb3, 0xa6, 0xed, 0xc3, 0x66, 0xe7, 0x99, 0x9b, 0xce,
+	0xce, 0xaf, 0x1c, 0x2c, 0x4f, 0x5c, 0x39, 0x58, 0x1e, 0x77, 0xdc, 0x7e, 0xdd, 0xcc, 0x7b, 0xea,
+	0x9a, 0x99, 0xf7, 0x75, 0x63, 0xfa, 0xdc, 0x37, 0x1a, 0xd3, 0x4f, 0x3f, 0x6f, 0x4c, 0x7f, 0xcd,
+	0x74, 0x1a, 0xc6, 0x9e, 0x4e, 0xcf, 0x8c, 0x3b, 0x9d, 0x9e, 0x1d, 0x7b, 0x3a, 0x3d, 0x77, 0xb3,
+	0xe9, 0xf4, 0xfc, 0x4d, 0xa7, 0xd3, 0x0b, 0xe3, 0x4e, 0xa7, 0xf3, 0xa3, 0x4f, 0xa7, 0x17, 0xff,
+	0x8d, 0xd3, 0x69, 0xf4, 0xad, 0x4e, 0xa7, 0x9f, 0x64, 0x73, 0xd9, 0xfc, 0xc4, 0xf0, 0xa9, 0xdb,
+	0xc7, 0x30, 0x67, 0x3d, 0xc6, 0xc4, 0xa7, 0x62, 0x1c, 0xac, 0x2f, 0x00, 0x24, 0x7f, 0xac, 0xe1,
+	0x86, 0x5d, 0xf4, 0xad, 0x14, 0x7f, 0x73, 0xc1, 0xc2, 0x8e, 0x4e, 0x49, 0x1c, 0x53, 0xff, 0x3b,
+	0xe3, 0xb0, 0x2f, 0xc1, 0x1c, 0xe9, 0x75, 0x68, 0x7c, 0x3e, 0x38, 0x00, 0x9c, 0xd5, 0x8b, 0x66,
+	0x06, 0xf8, 0xeb, 0x34, 0xac, 0x5a, 0x7a, 0xe8, 0xf7, 0x83, 0xa3, 0xfa, 0x3a, 0xc0, 0x9e, 0xa0,
+	0xa7, 0x1a, 0x89, 0x07, 0x3a, 0x50, 0xfe, 0x42, 0x60, 0x78, 0xe1, 0x35, 0xa8, 0x9d, 0xfe, 0x6e,
+	0x50, 0x3b, 0xf3, 0xad, 0xa1, 0x76, 0xb1, 0x01, 0x33, 0x92, 0x64, 0xda, 0x6f, 0x8e, 0x3e, 0xfa,
+	0x98, 0xea, 0xa7, 0x8f, 0xdf, 0xe4, 0x74, 0x8a, 0xbf, 0x4c, 0xc3, 0x4a, 0xdf, 0x17, 0x60, 0xe4,
+	0xd1, 0x80, 0xea, 0xae, 0xfa, 0x0e, 0xe4, 0x48, 0xaf, 0x43, 0x3c, 0x41, 0x7c, 0x43, 0x42, 0x9f,
+	0xdf, 0x54, 0xad, 0x81, 0x9c, 0x1a, 0x74, 0x18, 0x0b, 0xdc, 0x06, 0x0e, 0x70, 0xe4, 0x91, 0x51,
+	0x49, 0xc1, 0x8c, 0x34, 0xda, 0xd5, 0x36, 0x92, 0xbf, 0xf0, 0x6e, 0xdc, 0x09, 0xba, 0xa3, 0x7f,
+	0x51, 0x1a, 0x7d, 0x69, 0xea, 0x93, 0x26, 0xf5, 0xa8, 0x18, 0x95, 0x0f, 0x58, 0xfd, 0x57, 0x3f,
+	0x4b, 0xc1, 0xea, 0xf0, 0xbf, 0xb4, 0xa0, 0x7b, 0xf0, 0x4a, 0xe5, 0x60, 0xe7, 0xc4, 0xad, 0x94,
+	0xcb, 0xee, 0x61, 0xf5, 0x51, 0x7d, 0xe7, 0xa4, 0x7a, 0xf4, 0x81, 0x5b, 0x3b, 0x3a, 0xa8, 0xee,
+	0xfd, 0xc8, 0xfd, 0xf0, 0x83, 0xe3, 0x5a, 0x79, 0xaf, 0x5a, 0xa9, 0x96, 0xf7, 0xf3, 0xb7, 0x50,
+	0x11, 0x0a, 0x57, 0xab, 0xbe, 0x5f, 0x2e, 0xd7, 0xf2, 0x29, 0x74, 0x1f, 0xee, 0x5d, 0xad, 0x53,
+	0xfd, 0xe0, 0x71, 0xb9, 0x5e, 0x3d, 0x71, 0xf7, 0x8e, 0xf6, 0xcb, 0x6e, 0x75, 0x3f, 0x9f, 0xde,
+	0x3d, 0xf8, 0xfc, 0xab, 0x42, 0xea, 0x8b, 0xaf, 0x0a, 0xa9, 0xbf, 0x7f, 0x55, 0x48, 0x7d, 0xfa,
+	0x75, 0xe1, 0xd6, 0x17, 0x5f, 0x17, 0x6e, 0xfd, 0xe5, 0xeb, 0xc2, 0xad, 0x8f, 0x1f, 0xb6, 0xa8,
+	0x68, 0x77, 0x1b, 0x25, 0x8f, 0x85, 0xdb, 0x06, 0xa6, 0xee, 0x47, 0x44, 0x9c, 0xb1, 0xf8, 0xa9,
+	0x7d, 0xde, 0xee, 0x25, 0xff, 0xe8, 0x20, 0xce, 0x3b, 0x84, 0x37, 0x26, 0x15, 0x17, 0x7d, 0xed,
+	0x5f, 0x03, 0x00, 0x19, 0xf9, 0x59, 0xe0, 0x08, 0x21, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RewardsCallbacksBlockGasLimit != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.RewardsCallbacksBlockGasLimit))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.RewardsCallbackGasLimit != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.RewardsCallbackGasLimit))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.MinConsensusFeeSmoothingWindow != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.MinConsensusFeeSmoothingWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.CheckTxLocalMinGasPriceEnabled {
+		i--
+		if m.CheckTxLocalMinGasPriceEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
+	}
+	if m.FlatFeeRefundOnFailure {
+		i--
+		if m.FlatFeeRefundOnFailure {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	{
+		size, err := m.FeePromotion.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xe2
+	if m.FlatFeeAbsorbedInGasFee {
+		i--
+		if m.FlatFeeAbsorbedInGasFee {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.MaxFlatFeeMsgsPerTx != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.MaxFlatFeeMsgsPerTx))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.FlatFeeMigrationPolicy != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.FlatFeeMigrationPolicy))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if len(m.MaxContractBlockRewards) > 0 {
+		for iNdEx := len(m.MaxContractBlockRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxContractBlockRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if m.CheckTxMinFeeEventEnabled {
+		i--
+		if m.CheckTxMinFeeEventEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if len(m.FlatFeeConversionRates) > 0 {
+		for iNdEx := len(m.FlatFeeConversionRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FlatFeeConversionRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.FeeDenomRoutes) > 0 {
+		for iNdEx := len(m.FeeDenomRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeDenomRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if m.FlatFeesEnabled {
+		i--
+		if m.FlatFeesEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.FlatFeePayerMustSign {
+		i--
+		if m.FlatFeePayerMustSign {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.FlatFeePrepayDiscount != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.FlatFeePrepayDiscount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.SingleDenomFeesOnly {
+		i--
+		if m.SingleDenomFeesOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.TxSizeFeePerByte != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.TxSizeFeePerByte))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.AcceptedFeeDenoms) > 0 {
+		for iNdEx := len(m.AcceptedFeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AcceptedFeeDenoms[iNdEx])
+			copy(dAtA[i:], m.AcceptedFeeDenoms[iNdEx])
+			i = encodeVarintRewards(dAtA, i, uint64(len(m.AcceptedFeeDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.FlatFeeOncePerBlock {
+		i--
+		if m.FlatFeeOncePerBlock {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MaxGasRebateMultiplier != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.MaxGasRebateMultiplier))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.FreeTxBudget != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.FreeTxBudget))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.MinContractExecutionGas != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.MinContractExecutionGas))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MinFeeFloorEnabled {
+		i--
+		if m.MinFeeFloorEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.FlatFeeDeliverTxOnly {
+		i--
+		if m.FlatFeeDeliverTxOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxFlatFeeUpdateContracts != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.MaxFlatFeeUpdateContracts))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.FlatFeeUpdateInterval != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.FlatFeeUpdateInterval))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.DynamicFeeEnabled {
+		i--
+		if m.DynamicFeeEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.MinPriceOfGas.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.MaxWithdrawRecords != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.MaxWithdrawRecords))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.TxFeeRebateRatio.Size()
+		i -= size
+		if _, err := m.TxFeeRebateRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.InflationRewardsRatio.Size()
+		i -= size
+		if _, err := m.InflationRewardsRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FeePromotion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeePromotion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeePromotion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Discount != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.Discount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeDenomRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDenomRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDenomRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FlatFeeConversionRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlatFeeConversionRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FlatFeeConversionRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.FeeDenom) > 0 {
+		i -= len(m.FeeDenom)
+		copy(dAtA[i:], m.FeeDenom)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.FeeDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RewardsCallbackShare != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.RewardsCallbackShare))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.RewardsCallbackAddress) > 0 {
+		i -= len(m.RewardsCallbackAddress)
+		copy(dAtA[i:], m.RewardsCallbackAddress)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.RewardsCallbackAddress)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.FlatFeeAcceptedDenoms) > 0 {
+		for iNdEx := len(m.FlatFeeAcceptedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FlatFeeAcceptedDenoms[iNdEx])
+			copy(dAtA[i:], m.FlatFeeAcceptedDenoms[iNdEx])
+			i = encodeVarintRewards(dAtA, i, uint64(len(m.FlatFeeAcceptedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.FlatFeeOnSuccess {
+		i--
+		if m.FlatFeeOnSuccess {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.GasRebateMultiplier != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.GasRebateMultiplier))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.FlatFeeDirectPayout {
+		i--
+		if m.FlatFeeDirectPayout {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RewardsSplits) > 0 {
+		for iNdEx := len(m.RewardsSplits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardsSplits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.FlatFeeExemptCallers) > 0 {
+		for iNdEx := len(m.FlatFeeExemptCallers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FlatFeeExemptCallers[iNdEx])
+			copy(dAtA[i:], m.FlatFeeExemptCallers[iNdEx])
+			i = encodeVarintRewards(dAtA, i, uint64(len(m.FlatFeeExemptCallers[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.WithdrawToWallet {
+		i--
+		if m.WithdrawToWallet {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.RewardsAddress) > 0 {
+		i -= len(m.RewardsAddress)
+		copy(dAtA[i:], m.RewardsAddress)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.RewardsAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardsSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardsSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardsSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxGas != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.MaxGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.InflationRewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeRewards) > 0 {
+		for iNdEx := len(m.FeeRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TxId != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.TxId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxFeeDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxFeeDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxFeeDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RoutedFees) > 0 {
+		for iNdEx := len(m.RoutedFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoutedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.FlatFees) > 0 {
+		for iNdEx := len(m.FlatFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FlatFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RewardsFees) > 0 {
+		for iNdEx := len(m.RewardsFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardsFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.BurntFees) > 0 {
+		for iNdEx := len(m.BurntFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurntFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.FeeCollectorFees) > 0 {
+		for iNdEx := len(m.FeeCollectorFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeCollectorFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TxId != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.TxId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractTxRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractTxRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractTxRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InflationRewards) > 0 {
+		for iNdEx := len(m.InflationRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InflationRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.FeeRewards) > 0 {
+		for iNdEx := len(m.FeeRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TxId != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.TxId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardsRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardsRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CalculatedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CalculatedTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintRewards(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x2a
+	if m.CalculatedHeight != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.CalculatedHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RewardsAddress) > 0 {
+		i -= len(m.RewardsAddress)
+		copy(dAtA[i:], m.RewardsAddress)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.RewardsAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FlatFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlatFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FlatFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinConsFeeMultiplier.Size()
+		i -= size
+		if _, err := m.MinConsFeeMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Schedule != nil {
+		{
+			size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRewards(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.FlatFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FlatFeeSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlatFeeSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FlatFeeSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EndFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.StartFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.EndHeight != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCodeID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCodeID) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCodeID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CodeId != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.CodeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MinConsensusFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MinConsensusFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinConsensusFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractRewardsStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractRewardsStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractRewardsStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PreviousStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreviousStartTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintRewards(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x32
+	if len(m.PreviousRewards) > 0 {
+		for iNdEx := len(m.PreviousRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreviousRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RecentStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RecentStartTime):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintRewards(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x22
+	if len(m.RecentRewards) > 0 {
+		for iNdEx := len(m.RecentRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecentRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.LifetimeRewards) > 0 {
+		for iNdEx := len(m.LifetimeRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LifetimeRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockPoolInflows) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockPoolInflows) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockPoolInflows) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FlatFees) > 0 {
+		for iNdEx := len(m.FlatFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FlatFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Inflation) > 0 {
+		for iNdEx := len(m.Inflation) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Inflation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.FeeRebates) > 0 {
+		for iNdEx := len(m.FeeRebates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeRebates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FlatFeeConversionRates) > 0 {
+		for iNdEx := len(m.FlatFeeConversionRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FlatFeeConversionRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.FeeDenomRoutes) > 0 {
+		for iNdEx := len(m.FeeDenomRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeDenomRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.FlatFeesEnabled {
+		i--
+		if m.FlatFeesEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.FlatFeePayerMustSign {
+		i--
+		if m.FlatFeePayerMustSign {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.FlatFeePrepayDiscount != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.FlatFeePrepayDiscount))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.SingleDenomFeesOnly {
+		i--
+		if m.SingleDenomFeesOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.TxSizeFeePerByte != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.TxSizeFeePerByte))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.AcceptedFeeDenoms) > 0 {
+		for iNdEx := len(m.AcceptedFeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AcceptedFeeDenoms[iNdEx])
+			copy(dAtA[i:], m.AcceptedFeeDenoms[iNdEx])
+			i = encodeVarintRewards(dAtA, i, uint64(len(m.AcceptedFeeDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.FlatFeeOncePerBlock {
+		i--
+		if m.FlatFeeOncePerBlock {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxFlatFeeUpdateContracts != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.MaxFlatFeeUpdateContracts))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.FlatFeeUpdateInterval != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.FlatFeeUpdateInterval))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.FlatFeeDeliverTxOnly {
+		i--
+		if m.FlatFeeDeliverTxOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.DynamicFeeEnabled {
+		i--
+		if m.DynamicFeeEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MinFeeFloorEnabled {
+		i--
+		if m.MinFeeFloorEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.MinPriceOfGas.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TxFeeRebateRatio.Size()
+		i -= size
+		if _, err := m.TxFeeRebateRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.InflationRewardsRatio.Size()
+		i -= size
+		if _, err := m.InflationRewardsRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FlatFeeCredit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlatFeeCredit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FlatFeeCredit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Executions != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.Executions))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FlatFeeOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlatFeeOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FlatFeeOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.FlatFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledRewardsRatios) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledRewardsRatios) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledRewardsRatios) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TxFeeRebateRatio.Size()
+		i -= size
+		if _, err := m.TxFeeRebateRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.InflationRewardsRatio.Size()
+		i -= size
+		if _, err := m.InflationRewardsRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ActivationHeight != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CodeFlatFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodeFlatFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeFlatFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FlatFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.CodeId != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.CodeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardsReconciliation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardsReconciliation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardsReconciliation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deficit) > 0 {
+		for iNdEx := len(m.Deficit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deficit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Surplus) > 0 {
+		for iNdEx := len(m.Surplus) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Surplus[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PoolBalance) > 0 {
+		for iNdEx := len(m.PoolBalance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Expected) > 0 {
+		for iNdEx := len(m.Expected) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Expected[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRewards(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRewards(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InflationRewardsRatio.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	l = m.TxFeeRebateRatio.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	if m.MaxWithdrawRecords != 0 {
+		n += 1 + sovRewards(uint64(m.MaxWithdrawRecords))
+	}
+	l = m.MinPriceOfGas.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	if m.DynamicFeeEnabled {
+		n += 2
+	}
+	if m.FlatFeeUpdateInterval != 0 {
+		n += 1 + sovRewards(uint64(m.FlatFeeUpdateInterval))
+	}
+	if m.MaxFlatFeeUpdateContracts != 0 {
+		n += 1 + sovRewards(uint64(m.MaxFlatFeeUpdateContracts))
+	}
+	if m.FlatFeeDeliverTxOnly {
+		n += 2
+	}
+	if m.MinFeeFloorEnabled {
+		n += 2
+	}
+	if m.MinContractExecutionGas != 0 {
+		n += 1 + sovRewards(uint64(m.MinContractExecutionGas))
+	}
+	if m.FreeTxBudget != 0 {
+		n += 1 + sovRewards(uint64(m.FreeTxBudget))
+	}
+	if m.MaxGasRebateMultiplier != 0 {
+		n += 1 + sovRewards(uint64(m.MaxGasRebateMultiplier))
+	}
+	if m.FlatFeeOncePerBlock {
+		n += 2
+	}
+	if len(m.AcceptedFeeDenoms) > 0 {
+		for _, s := range m.AcceptedFeeDenoms {
+			l = len(s)
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	if m.TxSizeFeePerByte != 0 {
+		n += 2 + sovRewards(uint64(m.TxSizeFeePerByte))
+	}
+	if m.SingleDenomFeesOnly {
+		n += 3
+	}
+	if m.FlatFeePrepayDiscount != 0 {
+		n += 2 + sovRewards(uint64(m.FlatFeePrepayDiscount))
+	}
+	if m.FlatFeePayerMustSign {
+		n += 3
+	}
+	if m.FlatFeesEnabled {
+		n += 3
+	}
+	if len(m.FeeDenomRoutes) > 0 {
+		for _, e := range m.FeeDenomRoutes {
+			l = e.Size()
+			n += 2 + l + sovRewards(uint64(l))
+		}
+	}
+	if len(m.FlatFeeConversionRates) > 0 {
+		for _, e := range m.FlatFeeConversionRates {
+			l = e.Size()
+			n += 2 + l + sovRewards(uint64(l))
+		}
+	}
+	if m.CheckTxMinFeeEventEnabled {
+		n += 3
+	}
+	if len(m.MaxContractBlockRewards) > 0 {
+		for _, e := range m.MaxContractBlockRewards {
+			l = e.Size()
+			n += 2 + l + sovRewards(uint64(l))
+		}
+	}
+	if m.FlatFeeMigrationPolicy != 0 {
+		n += 2 + sovRewards(uint64(m.FlatFeeMigrationPolicy))
+	}
+	if m.MaxFlatFeeMsgsPerTx != 0 {
+		n += 2 + sovRewards(uint64(m.MaxFlatFeeMsgsPerTx))
+	}
+	if m.FlatFeeAbsorbedInGasFee {
+		n += 3
+	}
+	l = m.FeePromotion.Size()
+	n += 2 + l + sovRewards(uint64(l))
+	if m.FlatFeeRefundOnFailure {
+		n += 3
+	}
+	if m.CheckTxLocalMinGasPriceEnabled {
+		n += 3
+	}
+	if m.MinConsensusFeeSmoothingWindow != 0 {
+		n += 2 + sovRewards(uint64(m.MinConsensusFeeSmoothingWindow))
+	}
+	if m.RewardsCallbackGasLimit != 0 {
+		n += 2 + sovRewards(uint64(m.RewardsCallbackGasLimit))
+	}
+	if m.RewardsCallbacksBlockGasLimit != 0 {
+		n += 2 + sovRewards(uint64(m.RewardsCallbacksBlockGasLimit))
+	}
+	return n
+}
+
+func (m *FeePromotion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Discount != 0 {
+		n += 1 + sovRewards(uint64(m.Discount))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovRewards(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovRewards(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *FeeDenomRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	return n
+}
+
+func (m *FlatFeeConversionRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	l = len(m.FeeDenom)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	return n
+}
+
+func (m *ContractMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	l = len(m.RewardsAddress)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	if m.WithdrawToWallet {
+		n += 2
+	}
+	if len(m.FlatFeeExemptCallers) > 0 {
+		for _, s := range m.FlatFeeExemptCallers {
+			l = len(s)
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	if len(m.RewardsSplits) > 0 {
+		for _, e := range m.RewardsSplits {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	if m.FlatFeeDirectPayout {
+		n += 2
+	}
+	if m.GasRebateMultiplier != 0 {
+		n += 1 + sovRewards(uint64(m.GasRebateMultiplier))
+	}
+	if m.FlatFeeOnSuccess {
+		n += 2
+	}
+	if len(m.FlatFeeAcceptedDenoms) > 0 {
+		for _, s := range m.FlatFeeAcceptedDenoms {
+			l = len(s)
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	l = len(m.RewardsCallbackAddress)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	if m.RewardsCallbackShare != 0 {
+		n += 1 + sovRewards(uint64(m.RewardsCallbackShare))
+	}
+	return n
+}
+
+func (m *RewardsSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovRewards(uint64(m.Weight))
+	}
+	return n
+}
+
+func (m *BlockRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovRewards(uint64(m.Height))
+	}
+	l = m.InflationRewards.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	if m.MaxGas != 0 {
+		n += 1 + sovRewards(uint64(m.MaxGas))
+	}
+	return n
+}
+
+func (m *TxRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxId != 0 {
+		n += 1 + sovRewards(uint64(m.TxId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovRewards(uint64(m.Height))
+	}
+	if len(m.FeeRewards) > 0 {
+		for _, e := range m.FeeRewards {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TxFeeDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxId != 0 {
+		n += 1 + sovRewards(uint64(m.TxId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovRewards(uint64(m.Height))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	if len(m.FeeCollectorFees) > 0 {
+		for _, e := range m.FeeCollectorFees {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	if len(m.BurntFees) > 0 {
+		for _, e := range m.BurntFees {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	if len(m.RewardsFees) > 0 {
+		for _, e := range m.RewardsFees {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	if len(m.FlatFees) > 0 {
+		for _, e := range m.FlatFees {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	if len(m.RoutedFees) > 0 {
+		for _, e := range m.RoutedFees {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractTxRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxId != 0 {
+		n += 1 + sovRewards(uint64(m.TxId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovRewards(uint64(m.Height))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovRewards(uint64(m.GasUsed))
+	}
+	if len(m.FeeRewards) > 0 {
+		for _, e := range m.FeeRewards {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	if len(m.InflationRewards) > 0 {
+		for _, e := range m.InflationRewards {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RewardsRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRewards(uint64(m.Id))
+	}
+	l = len(m.RewardsAddress)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	if m.CalculatedHeight != 0 {
+		n += 1 + sovRewards(uint64(m.CalculatedHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CalculatedTime)
+	n += 1 + l + sovRewards(uint64(l))
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	return n
+}
+
+func (m *FlatFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	l = m.FlatFee.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	if m.Schedule != nil {
+		l = m.Schedule.Size()
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	l = m.MinConsFeeMultiplier.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	return n
+}
+
+func (m *FlatFeeSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovRewards(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovRewards(uint64(m.EndHeight))
+	}
+	l = m.StartFee.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	l = m.EndFee.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	return n
+}
+
+func (m *ContractCodeID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	if m.CodeId != 0 {
+		n += 1 + sovRewards(uint64(m.CodeId))
+	}
+	return n
+}
+
+func (m *MinConsensusFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractRewardsStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	if len(m.LifetimeRewards) > 0 {
+		for _, e := range m.LifetimeRewards {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	if len(m.RecentRewards) > 0 {
+		for _, e := range m.RecentRewards {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RecentStartTime)
+	n += 1 + l + sovRewards(uint64(l))
+	if len(m.PreviousRewards) > 0 {
+		for _, e := range m.PreviousRewards {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreviousStartTime)
+	n += 1 + l + sovRewards(uint64(l))
+	return n
+}
+
+func (m *ContractRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlockPoolInflows) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovRewards(uint64(m.Height))
+	}
+	if len(m.FeeRebates) > 0 {
+		for _, e := range m.FeeRebates {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	if len(m.Inflation) > 0 {
+		for _, e := range m.Inflation {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	if len(m.FlatFees) > 0 {
+		for _, e := range m.FlatFees {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DistributionConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InflationRewardsRatio.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	l = m.TxFeeRebateRatio.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	l = m.MinPriceOfGas.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	if m.MinFeeFloorEnabled {
+		n += 2
+	}
+	if m.DynamicFeeEnabled {
+		n += 2
+	}
+	if m.FlatFeeDeliverTxOnly {
+		n += 2
+	}
+	if m.FlatFeeUpdateInterval != 0 {
+		n += 1 + sovRewards(uint64(m.FlatFeeUpdateInterval))
+	}
+	if m.MaxFlatFeeUpdateContracts != 0 {
+		n += 1 + sovRewards(uint64(m.MaxFlatFeeUpdateContracts))
+	}
+	if m.FlatFeeOncePerBlock {
+		n += 2
+	}
+	if len(m.AcceptedFeeDenoms) > 0 {
+		for _, s := range m.AcceptedFeeDenoms {
+			l = len(s)
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	if m.TxSizeFeePerByte != 0 {
+		n += 1 + sovRewards(uint64(m.TxSizeFeePerByte))
+	}
+	if m.SingleDenomFeesOnly {
+		n += 2
+	}
+	if m.FlatFeePrepayDiscount != 0 {
+		n += 1 + sovRewards(uint64(m.FlatFeePrepayDiscount))
+	}
+	if m.FlatFeePayerMustSign {
+		n += 2
+	}
+	if m.FlatFeesEnabled {
+		n += 3
+	}
+	if len(m.FeeDenomRoutes) > 0 {
+		for _, e := range m.FeeDenomRoutes {
+			l = e.Size()
+			n += 2 + l + sovRewards(uint64(l))
+		}
+	}
+	if len(m.FlatFeeConversionRates) > 0 {
+		for _, e := range m.FlatFeeConversionRates {
+			l = e.Size()
+			n += 2 + l + sovRewards(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FlatFeeCredit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	if m.Executions != 0 {
+		n += 1 + sovRewards(uint64(m.Executions))
+	}
+	return n
+}
+
+func (m *FlatFeeOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	l = m.FlatFee.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovRewards(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *ScheduledRewardsRatios) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		n += 1 + sovRewards(uint64(m.ActivationHeight))
+	}
+	l = m.InflationRewardsRatio.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	l = m.TxFeeRebateRatio.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	return n
+}
+
+func (m *CodeFlatFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeId != 0 {
+		n += 1 + sovRewards(uint64(m.CodeId))
+	}
+	l = m.FlatFee.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	return n
+}
+
+func (m *RewardsReconciliation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Expected) > 0 {
+		for _, e := range m.Expected {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	if len(m.PoolBalance) > 0 {
+		for _, e := range m.PoolBalance {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	if len(m.Surplus) > 0 {
+		for _, e := range m.Surplus {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	if len(m.Deficit) > 0 {
+		for _, e := range m.Deficit {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	return n
 }
 
 func sovRewards(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
-func sozRewards(x uint64) (n int) {
-	return sovRewards(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func sozRewards(x uint64) (n int) {
+	return sovRewards(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRewardsRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRewardsRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxFeeRebateRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxFeeRebateRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWithdrawRecords", wireType)
+			}
+			m.MaxWithdrawRecords = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxWithdrawRecords |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPriceOfGas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinPriceOfGas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicFeeEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DynamicFeeEnabled = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFeeUpdateInterval", wireType)
+			}
+			m.FlatFeeUpdateInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FlatFeeUpdateInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFlatFeeUpdateContracts", wireType)
+			}
+			m.MaxFlatFeeUpdateContracts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFlatFeeUpdateContracts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFeeDeliverTxOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FlatFeeDeliverTxOnly = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFeeFloorEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MinFeeFloorEnabled = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinContractExecutionGas", wireType)
+			}
+			m.MinContractExecutionGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinContractExecutionGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeTxBudget", wireType)
+			}
+			m.FreeTxBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FreeTxBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasRebateMultiplier", wireType)
+			}
+			m.MaxGasRebateMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasRebateMultiplier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFeeOncePerBlock", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FlatFeeOncePerBlock = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedFeeDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptedFeeDenoms = append(m.AcceptedFeeDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSizeFeePerByte", wireType)
+			}
+			m.TxSizeFeePerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxSizeFeePerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SingleDenomFeesOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SingleDenomFeesOnly = bool(v != 0)
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFeePrepayDiscount", wireType)
+			}
+			m.FlatFeePrepayDiscount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FlatFeePrepayDiscount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFeePayerMustSign", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FlatFeePayerMustSign = bool(v != 0)
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFeesEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FlatFeesEnabled = bool(v != 0)
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenomRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenomRoutes = append(m.FeeDenomRoutes, FeeDenomRoute{})
+			if err := m.FeeDenomRoutes[len(m.FeeDenomRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFeeConversionRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FlatFeeConversionRates = append(m.FlatFeeConversionRates, FlatFeeConversionRate{})
+			if err := m.FlatFeeConversionRates[len(m.FlatFeeConversionRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckTxMinFeeEventEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CheckTxMinFeeEventEnabled = bool(v != 0)
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxContractBlockRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxContractBlockRewards = append(m.MaxContractBlockRewards, types.Coin{})
+			if err := m.MaxContractBlockRewards[len(m.MaxContractBlockRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFeeMigrationPolicy", wireType)
+			}
+			m.FlatFeeMigrationPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FlatFeeMigrationPolicy |= FlatFeeMigrationPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFlatFeeMsgsPerTx", wireType)
+			}
+			m.MaxFlatFeeMsgsPerTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFlatFeeMsgsPerTx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFeeAbsorbedInGasFee", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FlatFeeAbsorbedInGasFee = bool(v != 0)
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePromotion", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeePromotion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFeeRefundOnFailure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FlatFeeRefundOnFailure = bool(v != 0)
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckTxLocalMinGasPriceEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CheckTxLocalMinGasPriceEnabled = bool(v != 0)
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinConsensusFeeSmoothingWindow", wireType)
+			}
+			m.MinConsensusFeeSmoothingWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinConsensusFeeSmoothingWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsCallbackGasLimit", wireType)
+			}
+			m.RewardsCallbackGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardsCallbackGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsCallbacksBlockGasLimit", wireType)
+			}
+			m.RewardsCallbacksBlockGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardsCallbacksBlockGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeePromotion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeePromotion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeePromotion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
+			}
+			m.Discount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Discount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDenomRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDenomRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDenomRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FlatFeeConversionRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FlatFeeConversionRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FlatFeeConversionRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawToWallet", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithdrawToWallet = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFeeExemptCallers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FlatFeeExemptCallers = append(m.FlatFeeExemptCallers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsSplits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsSplits = append(m.RewardsSplits, RewardsSplit{})
+			if err := m.RewardsSplits[len(m.RewardsSplits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFeeDirectPayout", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FlatFeeDirectPayout = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasRebateMultiplier", wireType)
+			}
+			m.GasRebateMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasRebateMultiplier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFeeOnSuccess", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FlatFeeOnSuccess = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFeeAcceptedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FlatFeeAcceptedDenoms = append(m.FlatFeeAcceptedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsCallbackAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsCallbackAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsCallbackShare", wireType)
+			}
+			m.RewardsCallbackShare = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardsCallbackShare |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardsSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardsSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardsSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGas", wireType)
+			}
+			m.MaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			m.TxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeRewards = append(m.FeeRewards, types.Coin{})
+			if err := m.FeeRewards[len(m.FeeRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxFeeDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxFeeDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxFeeDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			m.TxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollectorFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeCollectorFees = append(m.FeeCollectorFees, types.Coin{})
+			if err := m.FeeCollectorFees[len(m.FeeCollectorFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurntFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurntFees = append(m.BurntFees, types.Coin{})
+			if err := m.BurntFees[len(m.BurntFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsFees = append(m.RewardsFees, types.Coin{})
+			if err := m.RewardsFees[len(m.RewardsFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FlatFees = append(m.FlatFees, types.Coin{})
+			if err := m.FlatFees[len(m.FlatFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoutedFees = append(m.RoutedFees, types.Coin{})
+			if err := m.RoutedFees[len(m.RoutedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractTxRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractTxRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractTxRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			m.TxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeRewards = append(m.FeeRewards, types.Coin{})
+			if err := m.FeeRewards[len(m.FeeRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InflationRewards = append(m.InflationRewards, types.Coin{})
+			if err := m.InflationRewards[len(m.InflationRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardsRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardsRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CalculatedHeight", wireType)
+			}
+			m.CalculatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CalculatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CalculatedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CalculatedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FlatFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FlatFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FlatFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FlatFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schedule == nil {
+				m.Schedule = &FlatFeeSchedule{}
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinConsFeeMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinConsFeeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FlatFeeSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FlatFeeSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FlatFeeSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EndFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractCodeID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractCodeID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractCodeID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
+			}
+			m.CodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MinConsensusFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinConsensusFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinConsensusFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.DecCoin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractRewardsStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractRewardsStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractRewardsStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LifetimeRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LifetimeRewards = append(m.LifetimeRewards, types.Coin{})
+			if err := m.LifetimeRewards[len(m.LifetimeRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecentRewards = append(m.RecentRewards, types.Coin{})
+			if err := m.RecentRewards[len(m.RecentRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RecentStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousRewards = append(m.PreviousRewards, types.Coin{})
+			if err := m.PreviousRewards[len(m.PreviousRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PreviousStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockPoolInflows) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockPoolInflows: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockPoolInflows: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRebates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeRebates = append(m.FeeRebates, types.Coin{})
+			if err := m.FeeRebates[len(m.FeeRebates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inflation = append(m.Inflation, types.Coin{})
+			if err := m.Inflation[len(m.Inflation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FlatFees = append(m.FlatFees, types.Coin{})
+			if err := m.FlatFees[len(m.FlatFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *DistributionConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -963,28 +8692,247 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowRewards
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRewardsRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRewardsRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxFeeRebateRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxFeeRebateRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPriceOfGas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinPriceOfGas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFeeFloorEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MinFeeFloorEnabled = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicFeeEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DynamicFeeEnabled = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFeeDeliverTxOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FlatFeeDeliverTxOnly = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFeeUpdateInterval", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.FlatFeeUpdateInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FlatFeeUpdateInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFlatFeeUpdateContracts", wireType)
+			}
+			m.MaxFlatFeeUpdateContracts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFlatFeeUpdateContracts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFeeOncePerBlock", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FlatFeeOncePerBlock = bool(v != 0)
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationRewardsRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedFeeDenoms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1012,15 +8960,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InflationRewardsRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AcceptedFeeDenoms = append(m.AcceptedFeeDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxFeeRebateRatio", wireType)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSizeFeePerByte", wireType)
 			}
-			var stringLen uint64
+			m.TxSizeFeePerByte = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRewards
@@ -1030,31 +8976,55 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TxSizeFeePerByte |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRewards
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SingleDenomFeesOnly", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRewards
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.SingleDenomFeesOnly = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFeePrepayDiscount", wireType)
 			}
-			if err := m.TxFeeRebateRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.FlatFeePrepayDiscount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FlatFeePrepayDiscount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 3:
+		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxWithdrawRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFeePayerMustSign", wireType)
 			}
-			m.MaxWithdrawRecords = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRewards
@@ -1064,14 +9034,35 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxWithdrawRecords |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			m.FlatFeePayerMustSign = bool(v != 0)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFeesEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FlatFeesEnabled = bool(v != 0)
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinPriceOfGas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenomRoutes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1098,7 +9089,42 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinPriceOfGas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FeeDenomRoutes = append(m.FeeDenomRoutes, FeeDenomRoute{})
+			if err := m.FeeDenomRoutes[len(m.FeeDenomRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFeeConversionRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FlatFeeConversionRates = append(m.FlatFeeConversionRates, FlatFeeConversionRate{})
+			if err := m.FlatFeeConversionRates[len(m.FlatFeeConversionRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1123,7 +9149,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractMetadata) Unmarshal(dAtA []byte) error {
+func (m *FlatFeeCredit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1144,81 +9170,17 @@ func (m *ContractMetadata) Unmarshal(dAtA []byte) error {
 			}
 		}
 		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ContractMetadata: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRewards
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRewards
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRewards
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRewards
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRewards
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRewards
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FlatFeeCredit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FlatFeeCredit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardsAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1246,13 +9208,13 @@ func (m *ContractMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardsAddress = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawToWallet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
 			}
-			var v int
+			m.Executions = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRewards
@@ -1262,12 +9224,11 @@ func (m *ContractMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Executions |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.WithdrawToWallet = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRewards(dAtA[iNdEx:])
@@ -1289,7 +9250,7 @@ func (m *ContractMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockRewards) Unmarshal(dAtA []byte) error {
+func (m *FlatFeeOverride) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1312,17 +9273,17 @@ func (m *BlockRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: FlatFeeOverride: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FlatFeeOverride: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRewards
@@ -1332,14 +9293,27 @@ func (m *BlockRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1366,15 +9340,15 @@ func (m *BlockRewards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InflationRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FlatFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxGas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
 			}
-			m.MaxGas = 0
+			m.ExpiryHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRewards
@@ -1384,7 +9358,7 @@ func (m *BlockRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxGas |= uint64(b&0x7F) << shift
+				m.ExpiryHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1410,7 +9384,7 @@ func (m *BlockRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxRewards) Unmarshal(dAtA []byte) error {
+func (m *ScheduledRewardsRatios) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1433,17 +9407,17 @@ func (m *TxRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduledRewardsRatios: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduledRewardsRatios: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
 			}
-			m.TxId = 0
+			m.ActivationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRewards
@@ -1453,16 +9427,16 @@ func (m *TxRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TxId |= uint64(b&0x7F) << shift
+				m.ActivationHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRewardsRatio", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRewards
@@ -1472,16 +9446,31 @@ func (m *TxRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRewardsRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxFeeRebateRatio", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRewards
@@ -1491,23 +9480,23 @@ func (m *TxRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRewards
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRewards
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeRewards = append(m.FeeRewards, types.Coin{})
-			if err := m.FeeRewards[len(m.FeeRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TxFeeRebateRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1532,7 +9521,7 @@ func (m *TxRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RewardsRecord) Unmarshal(dAtA []byte) error {
+func (m *CodeFlatFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1555,17 +9544,17 @@ func (m *RewardsRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RewardsRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: CodeFlatFee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RewardsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CodeFlatFee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
 			}
-			m.Id = 0
+			m.CodeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRewards
@@ -1575,16 +9564,16 @@ func (m *RewardsRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.CodeId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardsAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRewards
@@ -1594,27 +9583,78 @@ func (m *RewardsRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRewards
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRewards
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardsAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.FlatFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardsReconciliation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardsReconciliation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardsReconciliation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expected", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1641,33 +9681,14 @@ func (m *RewardsRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, types.Coin{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Expected = append(m.Expected, types.Coin{})
+			if err := m.Expected[len(m.Expected)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CalculatedHeight", wireType)
-			}
-			m.CalculatedHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRewards
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CalculatedHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CalculatedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolBalance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1694,65 +9715,16 @@ func (m *RewardsRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CalculatedTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.PoolBalance = append(m.PoolBalance, types.Coin{})
+			if err := m.PoolBalance[len(m.PoolBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRewards(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRewards
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FlatFee) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRewards
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FlatFee: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FlatFee: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Surplus", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRewards
@@ -1762,27 +9734,29 @@ func (m *FlatFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRewards
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRewards
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.Surplus = append(m.Surplus, types.Coin{})
+			if err := m.Surplus[len(m.Surplus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FlatFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deficit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1809,7 +9783,8 @@ func (m *FlatFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FlatFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Deficit = append(m.Deficit, types.Coin{})
+			if err := m.Deficit[len(m.Deficit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
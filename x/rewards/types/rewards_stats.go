@@ -0,0 +1,52 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ContractRewardsStatsWindow defines the duration of the recent rewards window.
+	// Once exceeded, the current window becomes the previous one and a new window is started.
+	ContractRewardsStatsWindow = 7 * 24 * time.Hour
+	// ContractRewardsHistoryBlocks defines the number of recent blocks the contract rewards are kept per block for.
+	ContractRewardsHistoryBlocks = 10000
+	// BlockPoolInflowsHistoryBlocks defines the number of recent blocks the rewards pool inflows are kept for.
+	BlockPoolInflowsHistoryBlocks = 10000
+	// MaxTopContractsLimit defines the max number of contracts returned by the top contracts by rewards query.
+	MaxTopContractsLimit = 100
+)
+
+// NewContractRewardsStats creates a new empty ContractRewardsStats instance.
+func NewContractRewardsStats(contractAddr sdk.AccAddress) ContractRewardsStats {
+	return ContractRewardsStats{
+		ContractAddress: contractAddr.String(),
+	}
+}
+
+// AddRewards returns the stats with the rewards distributed at the given block time added.
+// The recent window is rolled over if the block time is beyond the window.
+func (m ContractRewardsStats) AddRewards(rewards sdk.Coins, blockTime time.Time) ContractRewardsStats {
+	switch {
+	case m.RecentStartTime.IsZero():
+		m.RecentStartTime = blockTime
+	case blockTime.Sub(m.RecentStartTime) >= ContractRewardsStatsWindow:
+		m.PreviousRewards, m.PreviousStartTime = m.RecentRewards, m.RecentStartTime
+		m.RecentRewards, m.RecentStartTime = nil, blockTime
+	}
+
+	m.LifetimeRewards = sdk.Coins(m.LifetimeRewards).Add(rewards...)
+	m.RecentRewards = sdk.Coins(m.RecentRewards).Add(rewards...)
+
+	return m
+}
+
+// HistoryRewards returns the rewards distributed within the previous and the current windows and the history start time.
+func (m ContractRewardsStats) HistoryRewards() (sdk.Coins, time.Time) {
+	if m.PreviousStartTime.IsZero() {
+		return m.RecentRewards, m.RecentStartTime
+	}
+
+	return sdk.Coins(m.PreviousRewards).Add(m.RecentRewards...), m.PreviousStartTime
+}
@@ -291,6 +291,61 @@ func TestFlatFeeValidate(t *testing.T) {
 			},
 			errExpected: true,
 		},
+		{
+			name: "OK: with schedule",
+			flatFee: rewardsTypes.FlatFee{
+				ContractAddress: contractAddr.String(),
+				FlatFee:         sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+				Schedule: &rewardsTypes.FlatFeeSchedule{
+					StartHeight: 10,
+					EndHeight:   20,
+					StartFee:    sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000),
+					EndFee:      sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+				},
+			},
+		},
+		{
+			name: "Fail: schedule end fee mismatch",
+			flatFee: rewardsTypes.FlatFee{
+				ContractAddress: contractAddr.String(),
+				FlatFee:         sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+				Schedule: &rewardsTypes.FlatFeeSchedule{
+					StartHeight: 10,
+					EndHeight:   20,
+					StartFee:    sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000),
+					EndFee:      sdk.NewInt64Coin(sdk.DefaultBondDenom, 200),
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: schedule end height before start height",
+			flatFee: rewardsTypes.FlatFee{
+				ContractAddress: contractAddr.String(),
+				FlatFee:         sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+				Schedule: &rewardsTypes.FlatFeeSchedule{
+					StartHeight: 20,
+					EndHeight:   20,
+					StartFee:    sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000),
+					EndFee:      sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: schedule denoms mismatch",
+			flatFee: rewardsTypes.FlatFee{
+				ContractAddress: contractAddr.String(),
+				FlatFee:         sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+				Schedule: &rewardsTypes.FlatFeeSchedule{
+					StartHeight: 10,
+					EndHeight:   20,
+					StartFee:    sdk.NewInt64Coin("uarch", 1000),
+					EndFee:      sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+				},
+			},
+			errExpected: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -304,3 +359,65 @@ func TestFlatFeeValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestFlatFeeScheduleFeeAt(t *testing.T) {
+	schedule := rewardsTypes.FlatFeeSchedule{
+		StartHeight: 100,
+		EndHeight:   200,
+		StartFee:    sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000),
+		EndFee:      sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+	}
+
+	assert.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), schedule.FeeAt(50))
+	assert.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), schedule.FeeAt(100))
+	assert.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 550), schedule.FeeAt(150))
+	assert.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 991), schedule.FeeAt(101))
+	assert.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), schedule.FeeAt(200))
+	assert.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), schedule.FeeAt(300))
+}
+
+func TestContractRewardsStatsAddRewards(t *testing.T) {
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	stats := rewardsTypes.NewContractRewardsStats(contractAddr)
+
+	// First rewards start the recent window
+	stats = stats.AddRewards(rewards, startTime)
+	assert.Equal(t, "100stake", sdk.Coins(stats.LifetimeRewards).String())
+	assert.Equal(t, "100stake", sdk.Coins(stats.RecentRewards).String())
+	assert.Equal(t, startTime, stats.RecentStartTime)
+	assert.True(t, stats.PreviousStartTime.IsZero())
+
+	// Rewards within the window are accumulated
+	stats = stats.AddRewards(rewards, startTime.Add(rewardsTypes.ContractRewardsStatsWindow-time.Second))
+	assert.Equal(t, "200stake", sdk.Coins(stats.LifetimeRewards).String())
+	assert.Equal(t, "200stake", sdk.Coins(stats.RecentRewards).String())
+
+	historyRewards, historyStart := stats.HistoryRewards()
+	assert.Equal(t, "200stake", historyRewards.String())
+	assert.Equal(t, startTime, historyStart)
+
+	// Rewards beyond the window roll it over
+	nextWindowTime := startTime.Add(rewardsTypes.ContractRewardsStatsWindow)
+	stats = stats.AddRewards(rewards, nextWindowTime)
+	assert.Equal(t, "300stake", sdk.Coins(stats.LifetimeRewards).String())
+	assert.Equal(t, "100stake", sdk.Coins(stats.RecentRewards).String())
+	assert.Equal(t, nextWindowTime, stats.RecentStartTime)
+	assert.Equal(t, "200stake", sdk.Coins(stats.PreviousRewards).String())
+	assert.Equal(t, startTime, stats.PreviousStartTime)
+
+	historyRewards, historyStart = stats.HistoryRewards()
+	assert.Equal(t, "300stake", historyRewards.String())
+	assert.Equal(t, startTime, historyStart)
+
+	// The oldest window is dropped on the next roll over
+	lastWindowTime := nextWindowTime.Add(rewardsTypes.ContractRewardsStatsWindow)
+	stats = stats.AddRewards(rewards, lastWindowTime)
+	assert.Equal(t, "400stake", sdk.Coins(stats.LifetimeRewards).String())
+
+	historyRewards, historyStart = stats.HistoryRewards()
+	assert.Equal(t, "200stake", historyRewards.String())
+	assert.Equal(t, nextWindowTime, historyStart)
+}
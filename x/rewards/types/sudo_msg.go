@@ -0,0 +1,52 @@
+package types
+
+import (
+	"encoding/json"
+
+	wasmVmTypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SudoMsg is the rewards callback message sent to a contract.
+// This is encoded as JSON input to the contract sudo entrypoint.
+type SudoMsg struct {
+	// RewardsCallback is the endpoint name at the contract which is called.
+	RewardsCallback *RewardsCallbackMsg `json:"rewards_callback,omitempty"`
+}
+
+// RewardsCallbackMsg is the rewards callback message sent to a contract.
+type RewardsCallbackMsg struct {
+	// ContractAddress is the contract address the rewards are distributed for (bech32 encoded).
+	ContractAddress string `json:"contract_address"`
+	// Rewards are the rewards transferred to the callback contract.
+	Rewards wasmVmTypes.Coins `json:"rewards"`
+}
+
+// NewRewardsCallbackMsg creates a new SudoMsg instance.
+func NewRewardsCallbackMsg(contractAddr sdk.AccAddress, rewards sdk.Coins) SudoMsg {
+	coins := make(wasmVmTypes.Coins, 0, len(rewards))
+	for _, coin := range rewards {
+		coins = append(coins, wasmVmTypes.Coin{Denom: coin.Denom, Amount: coin.Amount.String()})
+	}
+
+	return SudoMsg{
+		RewardsCallback: &RewardsCallbackMsg{
+			ContractAddress: contractAddr.String(),
+			Rewards:         coins,
+		},
+	}
+}
+
+// Bytes returns the rewards callback message as JSON bytes.
+func (s SudoMsg) Bytes() []byte {
+	msgBz, err := json.Marshal(s)
+	if err != nil {
+		panic(err)
+	}
+	return msgBz
+}
+
+// String returns the rewards callback message as JSON string.
+func (s SudoMsg) String() string {
+	return string(s.Bytes())
+}
@@ -6,7 +6,9 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -38,6 +40,10 @@ type MsgSetContractMetadata struct {
 	// metadata is the contract metadata to set / update.
 	// If metadata exists, non-empty fields will be updated.
 	Metadata ContractMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata"`
+	// migrate_rewards_records defines whether the outstanding contract rewards
+	// records of the previous rewards address are re-pointed to the new one (if
+	// the rewards address is changed).
+	MigrateRewardsRecords bool `protobuf:"varint,3,opt,name=migrate_rewards_records,json=migrateRewardsRecords,proto3" json:"migrate_rewards_records,omitempty"`
 }
 
 func (m *MsgSetContractMetadata) Reset()         { *m = MsgSetContractMetadata{} }
@@ -87,8 +93,18 @@ func (m *MsgSetContractMetadata) GetMetadata() ContractMetadata {
 	return ContractMetadata{}
 }
 
+func (m *MsgSetContractMetadata) GetMigrateRewardsRecords() bool {
+	if m != nil {
+		return m.MigrateRewardsRecords
+	}
+	return false
+}
+
 // MsgSetContractMetadataResponse is the response for Msg.SetContractMetadata.
 type MsgSetContractMetadataResponse struct {
+	// migrated_records_num is the number of rewards records re-pointed to the
+	// new rewards address.
+	MigratedRecordsNum uint64 `protobuf:"varint,1,opt,name=migrated_records_num,json=migratedRecordsNum,proto3" json:"migrated_records_num,omitempty"`
 }
 
 func (m *MsgSetContractMetadataResponse) Reset()         { *m = MsgSetContractMetadataResponse{} }
@@ -124,6 +140,13 @@ func (m *MsgSetContractMetadataResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetContractMetadataResponse proto.InternalMessageInfo
 
+func (m *MsgSetContractMetadataResponse) GetMigratedRecordsNum() uint64 {
+	if m != nil {
+		return m.MigratedRecordsNum
+	}
+	return 0
+}
+
 // MsgWithdrawRewards is the request for Msg.WithdrawRewards.
 type MsgWithdrawRewards struct {
 	// rewards_address is the address to distribute rewards to (bech32 encoded).
@@ -134,6 +157,15 @@ type MsgWithdrawRewards struct {
 	//	*MsgWithdrawRewards_RecordsLimit_
 	//	*MsgWithdrawRewards_RecordIds
 	Mode isMsgWithdrawRewards_Mode `protobuf_oneof:"mode"`
+	// denoms defines an optional list of denoms to withdraw. If set, only the
+	// rewards in these denoms are withdrawn and the rest of the record rewards
+	// stays pending (records are kept until all their rewards are withdrawn).
+	Denoms []string `protobuf:"bytes,4,rep,name=denoms,proto3" json:"denoms,omitempty"`
+	// ibc_unwrap defines optional auto-unwrap options. If set, the withdrawn
+	// rewards in single-hop IBC voucher denoms are transferred back to the
+	// source chain over the channel they were received from (the rest of
+	// rewards stays with the rewards_address).
+	IbcUnwrap *MsgWithdrawRewards_IBCUnwrap `protobuf:"bytes,5,opt,name=ibc_unwrap,json=ibcUnwrap,proto3" json:"ibc_unwrap,omitempty"`
 }
 
 func (m *MsgWithdrawRewards) Reset()         { *m = MsgWithdrawRewards{} }
@@ -213,6 +245,20 @@ func (m *MsgWithdrawRewards) GetRecordIds() *MsgWithdrawRewards_RecordIDs {
 	return nil
 }
 
+func (m *MsgWithdrawRewards) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+func (m *MsgWithdrawRewards) GetIbcUnwrap() *MsgWithdrawRewards_IBCUnwrap {
+	if m != nil {
+		return m.IbcUnwrap
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*MsgWithdrawRewards) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -309,12 +355,72 @@ func (m *MsgWithdrawRewards_RecordIDs) GetIds() []uint64 {
 	return nil
 }
 
+// IBCUnwrap defines the IBC voucher rewards auto-unwrap options.
+type MsgWithdrawRewards_IBCUnwrap struct {
+	// receiver is the address on the voucher source chain to transfer the
+	// rewards to.
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// timeout_seconds defines the IBC transfer timeout relative to the block
+	// time (10 minutes if not set).
+	TimeoutSeconds uint64 `protobuf:"varint,2,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+}
+
+func (m *MsgWithdrawRewards_IBCUnwrap) Reset()         { *m = MsgWithdrawRewards_IBCUnwrap{} }
+func (m *MsgWithdrawRewards_IBCUnwrap) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawRewards_IBCUnwrap) ProtoMessage()    {}
+func (*MsgWithdrawRewards_IBCUnwrap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5741d3c1465c0f5, []int{2, 2}
+}
+func (m *MsgWithdrawRewards_IBCUnwrap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawRewards_IBCUnwrap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawRewards_IBCUnwrap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawRewards_IBCUnwrap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawRewards_IBCUnwrap.Merge(m, src)
+}
+func (m *MsgWithdrawRewards_IBCUnwrap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawRewards_IBCUnwrap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawRewards_IBCUnwrap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawRewards_IBCUnwrap proto.InternalMessageInfo
+
+func (m *MsgWithdrawRewards_IBCUnwrap) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *MsgWithdrawRewards_IBCUnwrap) GetTimeoutSeconds() uint64 {
+	if m != nil {
+		return m.TimeoutSeconds
+	}
+	return 0
+}
+
 // MsgWithdrawRewardsResponse is the response for Msg.WithdrawRewards.
 type MsgWithdrawRewardsResponse struct {
 	// records_num is the number of RewardsRecord objects processed.
 	RecordsNum uint64 `protobuf:"varint,1,opt,name=records_num,json=recordsNum,proto3" json:"records_num,omitempty"`
 	// rewards are the total rewards transferred.
 	TotalRewards []types.Coin `protobuf:"bytes,2,rep,name=total_rewards,json=totalRewards,proto3" json:"total_rewards"`
+	// unwrapped_rewards are the withdrawn rewards transferred back to the IBC
+	// voucher source chains (a part of the total_rewards).
+	UnwrappedRewards []types.Coin `protobuf:"bytes,3,rep,name=unwrapped_rewards,json=unwrappedRewards,proto3" json:"unwrapped_rewards"`
 }
 
 func (m *MsgWithdrawRewardsResponse) Reset()         { *m = MsgWithdrawRewardsResponse{} }
@@ -364,6 +470,13 @@ func (m *MsgWithdrawRewardsResponse) GetTotalRewards() []types.Coin {
 	return nil
 }
 
+func (m *MsgWithdrawRewardsResponse) GetUnwrappedRewards() []types.Coin {
+	if m != nil {
+		return m.UnwrappedRewards
+	}
+	return nil
+}
+
 // MsgSetFlatFee is the request for Msg.SetFlatFee.
 type MsgSetFlatFee struct {
 	// sender_address is the msg sender address (bech32 encoded).
@@ -372,6 +485,18 @@ type MsgSetFlatFee struct {
 	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 	// flat_fee_amount defines the minimum flat fee set by the contract_owner
 	FlatFeeAmount types.Coin `protobuf:"bytes,3,opt,name=flat_fee_amount,json=flatFeeAmount,proto3" json:"flat_fee_amount"`
+	// schedule defines an optional flat fee schedule (flat_fee_amount must match
+	// the schedule end_fee).
+	Schedule *FlatFeeSchedule `protobuf:"bytes,4,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// method defines an optional execute msg method name (the top-level key of
+	// the execute msg JSON) to set the flat fee for. If empty, the contract-wide
+	// flat fee is set.
+	Method string `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`
+	// min_cons_fee_multiplier defines an optional multiplier of the minimum
+	// consensus fee (in the flat_fee_amount denom) to charge the flat fee
+	// relative to (flat_fee_amount is the minimum charged). The fee follows the
+	// minimum consensus fee changes. Can not be used with a schedule or a method.
+	MinConsFeeMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=min_cons_fee_multiplier,json=minConsFeeMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_cons_fee_multiplier"`
 }
 
 func (m *MsgSetFlatFee) Reset()         { *m = MsgSetFlatFee{} }
@@ -428,6 +553,20 @@ func (m *MsgSetFlatFee) GetFlatFeeAmount() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgSetFlatFee) GetSchedule() *FlatFeeSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
+}
+
+func (m *MsgSetFlatFee) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
 // MsgSetFlatFeeResponse is the response for Msg.SetFlatFee.
 type MsgSetFlatFeeResponse struct {
 }
@@ -564,849 +703,6297 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgSetContractMetadata)(nil), "archway.rewards.v1.MsgSetContractMetadata")
-	proto.RegisterType((*MsgSetContractMetadataResponse)(nil), "archway.rewards.v1.MsgSetContractMetadataResponse")
-	proto.RegisterType((*MsgWithdrawRewards)(nil), "archway.rewards.v1.MsgWithdrawRewards")
-	proto.RegisterType((*MsgWithdrawRewards_RecordsLimit)(nil), "archway.rewards.v1.MsgWithdrawRewards.RecordsLimit")
-	proto.RegisterType((*MsgWithdrawRewards_RecordIDs)(nil), "archway.rewards.v1.MsgWithdrawRewards.RecordIDs")
-	proto.RegisterType((*MsgWithdrawRewardsResponse)(nil), "archway.rewards.v1.MsgWithdrawRewardsResponse")
-	proto.RegisterType((*MsgSetFlatFee)(nil), "archway.rewards.v1.MsgSetFlatFee")
-	proto.RegisterType((*MsgSetFlatFeeResponse)(nil), "archway.rewards.v1.MsgSetFlatFeeResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "archway.rewards.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "archway.rewards.v1.MsgUpdateParamsResponse")
+// MsgSetRewardsRatios is the request for Msg.SetRewardsRatios.
+type MsgSetRewardsRatios struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// inflation_rewards_ratio defines the new percentage of minted inflation
+	// tokens that are used for dApp rewards.
+	InflationRewardsRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=inflation_rewards_ratio,json=inflationRewardsRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation_rewards_ratio"`
+	// tx_fee_rebate_ratio defines the new percentage of tx fees that are used
+	// for dApp rewards.
+	TxFeeRebateRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=tx_fee_rebate_ratio,json=txFeeRebateRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"tx_fee_rebate_ratio"`
+	// activation_height defines the block height the ratios are applied at
+	// (optional). If set, the change is queued and applied at the beginning of
+	// that block. If not set, the ratios are updated immediately.
+	ActivationHeight int64 `protobuf:"varint,4,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+}
+
+func (m *MsgSetRewardsRatios) Reset()         { *m = MsgSetRewardsRatios{} }
+func (m *MsgSetRewardsRatios) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRewardsRatios) ProtoMessage()    {}
+func (*MsgSetRewardsRatios) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5741d3c1465c0f5, []int{8}
+}
+func (m *MsgSetRewardsRatios) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRewardsRatios) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRewardsRatios.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRewardsRatios) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRewardsRatios.Merge(m, src)
+}
+func (m *MsgSetRewardsRatios) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRewardsRatios) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRewardsRatios.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("archway/rewards/v1/tx.proto", fileDescriptor_d5741d3c1465c0f5) }
+var xxx_messageInfo_MsgSetRewardsRatios proto.InternalMessageInfo
 
-var fileDescriptor_d5741d3c1465c0f5 = []byte{
-	// 730 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xc1, 0x4e, 0xdb, 0x4a,
-	0x14, 0x8d, 0x93, 0x80, 0x5e, 0x2e, 0x09, 0x41, 0x86, 0xf7, 0x08, 0x7e, 0xad, 0x49, 0x53, 0xda,
-	0x06, 0xda, 0xda, 0x4d, 0xd8, 0xb1, 0x23, 0x20, 0x0a, 0x12, 0xa9, 0x5a, 0x57, 0x55, 0x25, 0x36,
-	0xe9, 0x24, 0x1e, 0x1c, 0xab, 0xb1, 0x27, 0xf2, 0x4c, 0x08, 0xd9, 0x55, 0xed, 0xba, 0x52, 0x3f,
-	0xa0, 0x9f, 0xd0, 0x05, 0x7f, 0xd0, 0x2d, 0xbb, 0xb2, 0xec, 0x0a, 0x55, 0xb0, 0x40, 0xea, 0x57,
-	0x54, 0xf6, 0x8c, 0x4d, 0x08, 0x46, 0xa4, 0x3b, 0xcf, 0xdc, 0x73, 0xcf, 0x39, 0x77, 0xe6, 0xd8,
-	0x86, 0xff, 0x91, 0xd7, 0x6a, 0xf7, 0xd1, 0x40, 0xf7, 0x70, 0x1f, 0x79, 0x26, 0xd5, 0x0f, 0x2a,
-	0x3a, 0x3b, 0xd4, 0xba, 0x1e, 0x61, 0x44, 0x96, 0x45, 0x51, 0x13, 0x45, 0xed, 0xa0, 0xa2, 0xcc,
-	0x59, 0xc4, 0x22, 0x41, 0x59, 0xf7, 0x9f, 0x38, 0x52, 0x51, 0x5b, 0x84, 0x3a, 0x84, 0xea, 0x4d,
-	0x44, 0xb1, 0x7e, 0x50, 0x69, 0x62, 0x86, 0x2a, 0x7a, 0x8b, 0xd8, 0xae, 0xa8, 0xcf, 0x8b, 0xba,
-	0x43, 0x2d, 0x5f, 0xc1, 0xa1, 0x96, 0x28, 0x14, 0x63, 0xf4, 0x43, 0xb5, 0x00, 0x51, 0xfa, 0x2a,
-	0xc1, 0x7f, 0x75, 0x6a, 0xbd, 0xc6, 0x6c, 0x83, 0xb8, 0xcc, 0x43, 0x2d, 0x56, 0xc7, 0x0c, 0x99,
-	0x88, 0x21, 0xf9, 0x01, 0x4c, 0x53, 0xec, 0x9a, 0xd8, 0x6b, 0x20, 0xd3, 0xf4, 0x30, 0xa5, 0x05,
-	0xa9, 0x28, 0x95, 0x33, 0x46, 0x8e, 0xef, 0xae, 0xf3, 0x4d, 0x79, 0x0b, 0xfe, 0x71, 0x44, 0x4b,
-	0x21, 0x59, 0x94, 0xca, 0x53, 0xd5, 0x25, 0xed, 0xfa, 0x64, 0xda, 0x28, 0x7d, 0x2d, 0x7d, 0x7c,
-	0xba, 0x98, 0x30, 0xa2, 0xde, 0xb5, 0xd9, 0x8f, 0x17, 0x47, 0x2b, 0x23, 0x8a, 0xa5, 0x22, 0xa8,
-	0xf1, 0xee, 0x0c, 0x4c, 0xbb, 0xc4, 0xa5, 0xb8, 0xf4, 0x23, 0x09, 0x72, 0x9d, 0x5a, 0x6f, 0x6d,
-	0xd6, 0x36, 0x3d, 0xd4, 0x37, 0xb8, 0xa2, 0xfc, 0x08, 0xf2, 0x42, 0x7c, 0xc4, 0xfd, 0xb4, 0xd8,
-	0x0e, 0xed, 0xef, 0x41, 0xce, 0xc3, 0x2d, 0xe2, 0x03, 0x3b, 0xb6, 0x63, 0x33, 0x31, 0xc3, 0x6a,
-	0xdc, 0x0c, 0xd7, 0x75, 0x34, 0x83, 0xf7, 0xee, 0xfa, 0xad, 0xdb, 0x09, 0x23, 0xeb, 0x0d, 0xad,
-	0xe5, 0x57, 0x00, 0x7c, 0xdd, 0xb0, 0x4d, 0x5a, 0x48, 0x05, 0xc4, 0xcf, 0xfe, 0x8a, 0x78, 0x67,
-	0x93, 0x6e, 0x27, 0x8c, 0x0c, 0x67, 0xd9, 0x31, 0xa9, 0xb2, 0x04, 0xd9, 0x61, 0x49, 0x79, 0x0e,
-	0x26, 0xb8, 0x6d, 0x7f, 0xba, 0xb4, 0xc1, 0x17, 0xca, 0x5d, 0xc8, 0x44, 0xfd, 0xf2, 0x0c, 0xa4,
-	0x7c, 0x79, 0xa9, 0x98, 0x2a, 0xa7, 0x0d, 0xff, 0x71, 0x6d, 0xce, 0x3f, 0xea, 0xd1, 0xf3, 0xa9,
-	0x4d, 0x42, 0xda, 0x21, 0x26, 0x2e, 0x7d, 0x92, 0x40, 0xb9, 0x6e, 0x28, 0x3c, 0x70, 0x79, 0x11,
-	0xa6, 0xc2, 0x03, 0x73, 0x7b, 0x8e, 0xd0, 0x15, 0x73, 0xd2, 0x17, 0x3d, 0x47, 0xde, 0x84, 0x1c,
-	0x23, 0x0c, 0x75, 0x1a, 0x42, 0xa0, 0x90, 0x2c, 0xa6, 0xca, 0x53, 0xd5, 0x05, 0x8d, 0xa7, 0x54,
-	0xf3, 0x53, 0xac, 0x89, 0x14, 0x6b, 0x1b, 0xc4, 0x76, 0x45, 0x14, 0xb2, 0x41, 0x97, 0x90, 0x2b,
-	0x7d, 0x97, 0x20, 0xc7, 0xaf, 0x7e, 0xab, 0x83, 0xd8, 0x16, 0xc6, 0xe3, 0xe6, 0x71, 0x19, 0x66,
-	0x5a, 0x22, 0x2c, 0x11, 0x30, 0x19, 0x00, 0xf3, 0xe1, 0x7e, 0x08, 0x7d, 0x0e, 0xf9, 0xfd, 0x0e,
-	0x62, 0x8d, 0x7d, 0x8c, 0x1b, 0xc8, 0x21, 0x3d, 0x97, 0x89, 0x4b, 0xba, 0xd5, 0x6b, 0x6e, 0x9f,
-	0x9b, 0x5a, 0x0f, 0xba, 0xe2, 0xb3, 0x3b, 0x0f, 0xff, 0x5e, 0x19, 0x20, 0x8a, 0xec, 0x67, 0x09,
-	0xf2, 0x75, 0x6a, 0xbd, 0xe9, 0x9a, 0x88, 0xe1, 0x97, 0xc8, 0x43, 0x0e, 0x95, 0xef, 0x40, 0x06,
-	0xf5, 0x58, 0x9b, 0x78, 0x36, 0x1b, 0x88, 0xb9, 0x2e, 0x37, 0xe4, 0x5d, 0x98, 0xec, 0x06, 0x38,
-	0x91, 0x4e, 0x25, 0x2e, 0x44, 0x9c, 0xa9, 0x56, 0xf0, 0x0d, 0xfe, 0x3e, 0x5d, 0x9c, 0xe1, 0x1d,
-	0x4f, 0x88, 0x63, 0x33, 0xec, 0x74, 0xd9, 0xc0, 0x10, 0x1c, 0x6b, 0xd3, 0xbe, 0xdb, 0x4b, 0xf6,
-	0xd2, 0x02, 0xcc, 0x8f, 0xd8, 0x09, 0xad, 0x56, 0xbf, 0xa5, 0x20, 0x55, 0xa7, 0x96, 0xdc, 0x83,
-	0xd9, 0xb8, 0x4f, 0xc4, 0xca, 0x0d, 0x61, 0x8e, 0xc1, 0x2a, 0xd5, 0xf1, 0xb1, 0x51, 0xd6, 0x6c,
-	0xc8, 0x8f, 0xbe, 0xd8, 0x0f, 0xc7, 0x7b, 0x7f, 0x14, 0x6d, 0x3c, 0x5c, 0x24, 0xb5, 0x07, 0x30,
-	0x94, 0xb5, 0x7b, 0x37, 0x9b, 0x15, 0x10, 0x65, 0xf9, 0x56, 0x48, 0xc4, 0xfd, 0x0e, 0xb2, 0x57,
-	0x2e, 0xfb, 0xfe, 0x0d, 0xad, 0xc3, 0x20, 0xe5, 0xf1, 0x18, 0xa0, 0x50, 0x41, 0x99, 0xf8, 0x70,
-	0x71, 0xb4, 0x22, 0xd5, 0x76, 0x8f, 0xcf, 0x54, 0xe9, 0xe4, 0x4c, 0x95, 0x7e, 0x9d, 0xa9, 0xd2,
-	0x97, 0x73, 0x35, 0x71, 0x72, 0xae, 0x26, 0x7e, 0x9e, 0xab, 0x89, 0xbd, 0xaa, 0x65, 0xb3, 0x76,
-	0xaf, 0xa9, 0xb5, 0x88, 0xa3, 0x0b, 0xde, 0xa7, 0x2e, 0x66, 0x7d, 0xe2, 0xbd, 0x0f, 0xd7, 0xfa,
-	0x61, 0xf4, 0x9b, 0x60, 0x83, 0x2e, 0xa6, 0xcd, 0xc9, 0xe0, 0x17, 0xb1, 0xfa, 0x27, 0x00, 0x00,
-	0xff, 0xff, 0x3c, 0x3a, 0x25, 0x7f, 0xc6, 0x06, 0x00, 0x00,
+func (m *MsgSetRewardsRatios) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgSetRewardsRatios) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// SetContractMetadata creates or updates an existing contract metadata.
-	// Method is authorized to the contract owner (admin if no metadata exists).
-	SetContractMetadata(ctx context.Context, in *MsgSetContractMetadata, opts ...grpc.CallOption) (*MsgSetContractMetadataResponse, error)
-	// WithdrawRewards performs collected rewards distribution.
-	// Rewards might be credited from multiple contracts (rewards_address must be
-	// set in the corresponding contract metadata).
-	WithdrawRewards(ctx context.Context, in *MsgWithdrawRewards, opts ...grpc.CallOption) (*MsgWithdrawRewardsResponse, error)
-	// SetFlatFee sets or updates or removes the flat fee to interact with the
-	// contract Method is authorized to the contract owner.
-	SetFlatFee(ctx context.Context, in *MsgSetFlatFee, opts ...grpc.CallOption) (*MsgSetFlatFeeResponse, error)
-	// UpdateParams defines a governance operation for updating the x/rewards
-	// module parameters. The authority is defined in the keeper.
-	//
-	// Since: archway v5 && cosmos-sdk 0.47
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+// MsgSetRewardsRatiosResponse is the response for Msg.SetRewardsRatios.
+type MsgSetRewardsRatiosResponse struct {
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSetRewardsRatiosResponse) Reset()         { *m = MsgSetRewardsRatiosResponse{} }
+func (m *MsgSetRewardsRatiosResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRewardsRatiosResponse) ProtoMessage()    {}
+func (*MsgSetRewardsRatiosResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5741d3c1465c0f5, []int{9}
+}
+func (m *MsgSetRewardsRatiosResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRewardsRatiosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRewardsRatiosResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRewardsRatiosResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRewardsRatiosResponse.Merge(m, src)
+}
+func (m *MsgSetRewardsRatiosResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRewardsRatiosResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRewardsRatiosResponse.DiscardUnknown(m)
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+var xxx_messageInfo_MsgSetRewardsRatiosResponse proto.InternalMessageInfo
+
+// MsgRemoveContractMetadata is the request for Msg.RemoveContractMetadata.
+type MsgRemoveContractMetadata struct {
+	// sender_address is the msg sender address (bech32 encoded).
+	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	// contract_address is the contract address (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// rewards_sweep_address is an optional address (bech32 encoded) to send the
+	// outstanding contract rewards to. If not set, the existing RewardsRecord
+	// objects are kept and could be withdrawn by their rewards addresses.
+	RewardsSweepAddress string `protobuf:"bytes,3,opt,name=rewards_sweep_address,json=rewardsSweepAddress,proto3" json:"rewards_sweep_address,omitempty"`
 }
 
-func (c *msgClient) SetContractMetadata(ctx context.Context, in *MsgSetContractMetadata, opts ...grpc.CallOption) (*MsgSetContractMetadataResponse, error) {
-	out := new(MsgSetContractMetadataResponse)
-	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Msg/SetContractMetadata", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveContractMetadata) Reset()         { *m = MsgRemoveContractMetadata{} }
+func (m *MsgRemoveContractMetadata) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveContractMetadata) ProtoMessage()    {}
+func (*MsgRemoveContractMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5741d3c1465c0f5, []int{10}
+}
+func (m *MsgRemoveContractMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveContractMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveContractMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRemoveContractMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveContractMetadata.Merge(m, src)
+}
+func (m *MsgRemoveContractMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveContractMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveContractMetadata.DiscardUnknown(m)
 }
 
-func (c *msgClient) WithdrawRewards(ctx context.Context, in *MsgWithdrawRewards, opts ...grpc.CallOption) (*MsgWithdrawRewardsResponse, error) {
-	out := new(MsgWithdrawRewardsResponse)
-	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Msg/WithdrawRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRemoveContractMetadata proto.InternalMessageInfo
+
+func (m *MsgRemoveContractMetadata) GetSenderAddress() string {
+	if m != nil {
+		return m.SenderAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SetFlatFee(ctx context.Context, in *MsgSetFlatFee, opts ...grpc.CallOption) (*MsgSetFlatFeeResponse, error) {
-	out := new(MsgSetFlatFeeResponse)
-	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Msg/SetFlatFee", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveContractMetadata) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveContractMetadata) GetRewardsSweepAddress() string {
+	if m != nil {
+		return m.RewardsSweepAddress
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// SetContractMetadata creates or updates an existing contract metadata.
-	// Method is authorized to the contract owner (admin if no metadata exists).
-	SetContractMetadata(context.Context, *MsgSetContractMetadata) (*MsgSetContractMetadataResponse, error)
-	// WithdrawRewards performs collected rewards distribution.
-	// Rewards might be credited from multiple contracts (rewards_address must be
-	// set in the corresponding contract metadata).
-	WithdrawRewards(context.Context, *MsgWithdrawRewards) (*MsgWithdrawRewardsResponse, error)
-	// SetFlatFee sets or updates or removes the flat fee to interact with the
-	// contract Method is authorized to the contract owner.
-	SetFlatFee(context.Context, *MsgSetFlatFee) (*MsgSetFlatFeeResponse, error)
-	// UpdateParams defines a governance operation for updating the x/rewards
-	// module parameters. The authority is defined in the keeper.
-	//
-	// Since: archway v5 && cosmos-sdk 0.47
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+// MsgRemoveContractMetadataResponse is the response for
+// Msg.RemoveContractMetadata.
+type MsgRemoveContractMetadataResponse struct {
+	// swept_rewards are the total outstanding rewards transferred to the
+	// rewards_sweep_address.
+	SweptRewards []types.Coin `protobuf:"bytes,1,rep,name=swept_rewards,json=sweptRewards,proto3" json:"swept_rewards"`
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgRemoveContractMetadataResponse) Reset()         { *m = MsgRemoveContractMetadataResponse{} }
+func (m *MsgRemoveContractMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveContractMetadataResponse) ProtoMessage()    {}
+func (*MsgRemoveContractMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5741d3c1465c0f5, []int{11}
 }
-
-func (*UnimplementedMsgServer) SetContractMetadata(ctx context.Context, req *MsgSetContractMetadata) (*MsgSetContractMetadataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetContractMetadata not implemented")
+func (m *MsgRemoveContractMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) WithdrawRewards(ctx context.Context, req *MsgWithdrawRewards) (*MsgWithdrawRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawRewards not implemented")
+func (m *MsgRemoveContractMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveContractMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) SetFlatFee(ctx context.Context, req *MsgSetFlatFee) (*MsgSetFlatFeeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetFlatFee not implemented")
+func (m *MsgRemoveContractMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveContractMetadataResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgRemoveContractMetadataResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgRemoveContractMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveContractMetadataResponse.DiscardUnknown(m)
 }
 
-func _Msg_SetContractMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetContractMetadata)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetContractMetadata(ctx, in)
+var xxx_messageInfo_MsgRemoveContractMetadataResponse proto.InternalMessageInfo
+
+func (m *MsgRemoveContractMetadataResponse) GetSweptRewards() []types.Coin {
+	if m != nil {
+		return m.SweptRewards
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/archway.rewards.v1.Msg/SetContractMetadata",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetContractMetadata(ctx, req.(*MsgSetContractMetadata))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_WithdrawRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawRewards)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WithdrawRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/archway.rewards.v1.Msg/WithdrawRewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WithdrawRewards(ctx, req.(*MsgWithdrawRewards))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgSetFlatFeeByCodeID is the request for Msg.SetFlatFeeByCodeID.
+type MsgSetFlatFeeByCodeID struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// code_id is the code ID the target contracts are instantiated from.
+	CodeId uint64 `protobuf:"varint,2,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// flat_fee_amount defines the flat fee to set for every contract (zero
+	// amount removes the flat fee).
+	FlatFeeAmount types.Coin `protobuf:"bytes,3,opt,name=flat_fee_amount,json=flatFeeAmount,proto3" json:"flat_fee_amount"`
 }
 
-func _Msg_SetFlatFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetFlatFee)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetFlatFee(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/archway.rewards.v1.Msg/SetFlatFee",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetFlatFee(ctx, req.(*MsgSetFlatFee))
+func (m *MsgSetFlatFeeByCodeID) Reset()         { *m = MsgSetFlatFeeByCodeID{} }
+func (m *MsgSetFlatFeeByCodeID) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFlatFeeByCodeID) ProtoMessage()    {}
+func (*MsgSetFlatFeeByCodeID) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5741d3c1465c0f5, []int{12}
+}
+func (m *MsgSetFlatFeeByCodeID) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFlatFeeByCodeID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFlatFeeByCodeID.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetFlatFeeByCodeID) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFlatFeeByCodeID.Merge(m, src)
+}
+func (m *MsgSetFlatFeeByCodeID) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFlatFeeByCodeID) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFlatFeeByCodeID.DiscardUnknown(m)
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/archway.rewards.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+var xxx_messageInfo_MsgSetFlatFeeByCodeID proto.InternalMessageInfo
+
+func (m *MsgSetFlatFeeByCodeID) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "archway.rewards.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SetContractMetadata",
-			Handler:    _Msg_SetContractMetadata_Handler,
-		},
-		{
-			MethodName: "WithdrawRewards",
-			Handler:    _Msg_WithdrawRewards_Handler,
-		},
-		{
-			MethodName: "SetFlatFee",
-			Handler:    _Msg_SetFlatFee_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "archway/rewards/v1/tx.proto",
+func (m *MsgSetFlatFeeByCodeID) GetCodeId() uint64 {
+	if m != nil {
+		return m.CodeId
+	}
+	return 0
 }
 
-func (m *MsgSetContractMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetFlatFeeByCodeID) GetFlatFeeAmount() types.Coin {
+	if m != nil {
+		return m.FlatFeeAmount
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *MsgSetContractMetadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSetFlatFeeByCodeIDResponse is the response for Msg.SetFlatFeeByCodeID.
+type MsgSetFlatFeeByCodeIDResponse struct {
+	// contracts_updated is the number of contracts which flat fees were updated
+	// (contracts without a rewards address are skipped if the flat fee is set).
+	ContractsUpdated uint64 `protobuf:"varint,1,opt,name=contracts_updated,json=contractsUpdated,proto3" json:"contracts_updated,omitempty"`
 }
 
-func (m *MsgSetContractMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgSetFlatFeeByCodeIDResponse) Reset()         { *m = MsgSetFlatFeeByCodeIDResponse{} }
+func (m *MsgSetFlatFeeByCodeIDResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFlatFeeByCodeIDResponse) ProtoMessage()    {}
+func (*MsgSetFlatFeeByCodeIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5741d3c1465c0f5, []int{13}
+}
+func (m *MsgSetFlatFeeByCodeIDResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFlatFeeByCodeIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFlatFeeByCodeIDResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.SenderAddress) > 0 {
-		i -= len(m.SenderAddress)
-		copy(dAtA[i:], m.SenderAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetFlatFeeByCodeIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFlatFeeByCodeIDResponse.Merge(m, src)
+}
+func (m *MsgSetFlatFeeByCodeIDResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFlatFeeByCodeIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFlatFeeByCodeIDResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSetContractMetadataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetFlatFeeByCodeIDResponse proto.InternalMessageInfo
+
+func (m *MsgSetFlatFeeByCodeIDResponse) GetContractsUpdated() uint64 {
+	if m != nil {
+		return m.ContractsUpdated
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgSetContractMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRebuildRewardsIndexes is the request for Msg.RebuildRewardsIndexes.
+type MsgRebuildRewardsIndexes struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 }
 
-func (m *MsgSetContractMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRebuildRewardsIndexes) Reset()         { *m = MsgRebuildRewardsIndexes{} }
+func (m *MsgRebuildRewardsIndexes) String() string { return proto.CompactTextString(m) }
+func (*MsgRebuildRewardsIndexes) ProtoMessage()    {}
+func (*MsgRebuildRewardsIndexes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5741d3c1465c0f5, []int{14}
 }
-
-func (m *MsgWithdrawRewards) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRebuildRewardsIndexes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRebuildRewardsIndexes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRebuildRewardsIndexes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRebuildRewardsIndexes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRebuildRewardsIndexes.Merge(m, src)
+}
+func (m *MsgRebuildRewardsIndexes) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRebuildRewardsIndexes) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRebuildRewardsIndexes.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawRewards) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRebuildRewardsIndexes proto.InternalMessageInfo
+
+func (m *MsgRebuildRewardsIndexes) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func (m *MsgWithdrawRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Mode != nil {
-		{
-			size := m.Mode.Size()
-			i -= size
-			if _, err := m.Mode.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+// MsgRebuildRewardsIndexesResponse is the response for
+// Msg.RebuildRewardsIndexes.
+type MsgRebuildRewardsIndexesResponse struct {
+	// contracts_num is the number of contracts with metadata (code ID index
+	// entries and the contract metadata counter).
+	ContractsNum uint64 `protobuf:"varint,1,opt,name=contracts_num,json=contractsNum,proto3" json:"contracts_num,omitempty"`
+	// rewards_records_num is the number of rewards records (rewards address
+	// index entries).
+	RewardsRecordsNum uint64 `protobuf:"varint,2,opt,name=rewards_records_num,json=rewardsRecordsNum,proto3" json:"rewards_records_num,omitempty"`
+	// tx_rewards_num is the number of tracked tx rewards (block index entries).
+	TxRewardsNum uint64 `protobuf:"varint,3,opt,name=tx_rewards_num,json=txRewardsNum,proto3" json:"tx_rewards_num,omitempty"`
+	// tx_fee_distributions_num is the number of tracked tx fee distributions
+	// (block and tx hash index entries).
+	TxFeeDistributionsNum uint64 `protobuf:"varint,4,opt,name=tx_fee_distributions_num,json=txFeeDistributionsNum,proto3" json:"tx_fee_distributions_num,omitempty"`
+}
+
+func (m *MsgRebuildRewardsIndexesResponse) Reset()         { *m = MsgRebuildRewardsIndexesResponse{} }
+func (m *MsgRebuildRewardsIndexesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRebuildRewardsIndexesResponse) ProtoMessage()    {}
+func (*MsgRebuildRewardsIndexesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5741d3c1465c0f5, []int{15}
+}
+func (m *MsgRebuildRewardsIndexesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRebuildRewardsIndexesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRebuildRewardsIndexesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.RewardsAddress) > 0 {
-		i -= len(m.RewardsAddress)
-		copy(dAtA[i:], m.RewardsAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardsAddress)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgRebuildRewardsIndexesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRebuildRewardsIndexesResponse.Merge(m, src)
+}
+func (m *MsgRebuildRewardsIndexesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRebuildRewardsIndexesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRebuildRewardsIndexesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRebuildRewardsIndexesResponse proto.InternalMessageInfo
+
+func (m *MsgRebuildRewardsIndexesResponse) GetContractsNum() uint64 {
+	if m != nil {
+		return m.ContractsNum
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgWithdrawRewards_RecordsLimit_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRebuildRewardsIndexesResponse) GetRewardsRecordsNum() uint64 {
+	if m != nil {
+		return m.RewardsRecordsNum
+	}
+	return 0
 }
 
-func (m *MsgWithdrawRewards_RecordsLimit_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.RecordsLimit != nil {
-		{
-			size, err := m.RecordsLimit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *MsgRebuildRewardsIndexesResponse) GetTxRewardsNum() uint64 {
+	if m != nil {
+		return m.TxRewardsNum
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MsgWithdrawRewards_RecordIds) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return 0
 }
 
-func (m *MsgWithdrawRewards_RecordIds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.RecordIds != nil {
-		{
-			size, err := m.RecordIds.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	return len(dAtA) - i, nil
-}
-func (m *MsgWithdrawRewards_RecordsLimit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRebuildRewardsIndexesResponse) GetTxFeeDistributionsNum() uint64 {
+	if m != nil {
+		return m.TxFeeDistributionsNum
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgWithdrawRewards_RecordsLimit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ExtensionOptionDynamicFee is a tx extension option used to define the max
+// priority gas price a transaction is willing to pay on top of the base gas
+// price if the dynamic fee mode is enabled.
+type ExtensionOptionDynamicFee struct {
+	// max_priority_price defines the max priority price for a single unit of
+	// gas (in the min consensus fee denom).
+	MaxPriorityPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=max_priority_price,json=maxPriorityPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_priority_price"`
 }
 
-func (m *MsgWithdrawRewards_RecordsLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Limit != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *ExtensionOptionDynamicFee) Reset()         { *m = ExtensionOptionDynamicFee{} }
+func (m *ExtensionOptionDynamicFee) String() string { return proto.CompactTextString(m) }
+func (*ExtensionOptionDynamicFee) ProtoMessage()    {}
+func (*ExtensionOptionDynamicFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5741d3c1465c0f5, []int{16}
 }
-
-func (m *MsgWithdrawRewards_RecordIDs) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ExtensionOptionDynamicFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtensionOptionDynamicFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtensionOptionDynamicFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *ExtensionOptionDynamicFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtensionOptionDynamicFee.Merge(m, src)
+}
+func (m *ExtensionOptionDynamicFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtensionOptionDynamicFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtensionOptionDynamicFee.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawRewards_RecordIDs) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ExtensionOptionDynamicFee proto.InternalMessageInfo
+
+// ExtensionOptionFlatFeeTip is a tx extension option used to voluntarily pay
+// the contracts executed by the transaction on top of their flat fees. Tips
+// are distributed to the contracts the same way the flat fees are.
+type ExtensionOptionFlatFeeTip struct {
+	// tips defines the extra fees paid per executed contract.
+	Tips []FlatFeeTip `protobuf:"bytes,1,rep,name=tips,proto3" json:"tips"`
 }
 
-func (m *MsgWithdrawRewards_RecordIDs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Ids) > 0 {
-		dAtA5 := make([]byte, len(m.Ids)*10)
-		var j4 int
-		for _, num := range m.Ids {
-			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j4++
-			}
-			dAtA5[j4] = uint8(num)
-			j4++
+func (m *ExtensionOptionFlatFeeTip) Reset()         { *m = ExtensionOptionFlatFeeTip{} }
+func (m *ExtensionOptionFlatFeeTip) String() string { return proto.CompactTextString(m) }
+func (*ExtensionOptionFlatFeeTip) ProtoMessage()    {}
+func (*ExtensionOptionFlatFeeTip) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5741d3c1465c0f5, []int{17}
+}
+func (m *ExtensionOptionFlatFeeTip) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtensionOptionFlatFeeTip) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtensionOptionFlatFeeTip.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j4
-		copy(dAtA[i:], dAtA5[:j4])
-		i = encodeVarintTx(dAtA, i, uint64(j4))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ExtensionOptionFlatFeeTip) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtensionOptionFlatFeeTip.Merge(m, src)
+}
+func (m *ExtensionOptionFlatFeeTip) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtensionOptionFlatFeeTip) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtensionOptionFlatFeeTip.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawRewardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ExtensionOptionFlatFeeTip proto.InternalMessageInfo
+
+func (m *ExtensionOptionFlatFeeTip) GetTips() []FlatFeeTip {
+	if m != nil {
+		return m.Tips
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgWithdrawRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// FlatFeeTip defines the extra fees paid to a contract on top of its flat fee.
+type FlatFeeTip struct {
+	// contract_address defines the contract address (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// amount defines the tip amount.
+	Amount []types.Coin `protobuf:"bytes,2,rep,name=amount,proto3" json:"amount"`
 }
 
-func (m *MsgWithdrawRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TotalRewards) > 0 {
-		for iNdEx := len(m.TotalRewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *FlatFeeTip) Reset()         { *m = FlatFeeTip{} }
+func (m *FlatFeeTip) String() string { return proto.CompactTextString(m) }
+func (*FlatFeeTip) ProtoMessage()    {}
+func (*FlatFeeTip) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5741d3c1465c0f5, []int{18}
+}
+func (m *FlatFeeTip) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FlatFeeTip) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FlatFeeTip.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.RecordsNum != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.RecordsNum))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *FlatFeeTip) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlatFeeTip.Merge(m, src)
+}
+func (m *FlatFeeTip) XXX_Size() int {
+	return m.Size()
+}
+func (m *FlatFeeTip) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlatFeeTip.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlatFeeTip proto.InternalMessageInfo
+
+func (m *FlatFeeTip) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgSetFlatFee) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FlatFeeTip) GetAmount() []types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgSetFlatFee) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRecoverContractRewards is the request for Msg.RecoverContractRewards.
+type MsgRecoverContractRewards struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// contract_address is the contract address (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// recovery_address is the address the contract rewards are sent to (bech32
+	// encoded).
+	RecoveryAddress string `protobuf:"bytes,3,opt,name=recovery_address,json=recoveryAddress,proto3" json:"recovery_address,omitempty"`
 }
 
-func (m *MsgSetFlatFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.FlatFeeAmount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgRecoverContractRewards) Reset()         { *m = MsgRecoverContractRewards{} }
+func (m *MsgRecoverContractRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgRecoverContractRewards) ProtoMessage()    {}
+func (*MsgRecoverContractRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5741d3c1465c0f5, []int{19}
+}
+func (m *MsgRecoverContractRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecoverContractRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecoverContractRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.SenderAddress) > 0 {
-		i -= len(m.SenderAddress)
-		copy(dAtA[i:], m.SenderAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgRecoverContractRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecoverContractRewards.Merge(m, src)
+}
+func (m *MsgRecoverContractRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecoverContractRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecoverContractRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecoverContractRewards proto.InternalMessageInfo
+
+func (m *MsgRecoverContractRewards) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgSetFlatFeeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRecoverContractRewards) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSetFlatFeeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRecoverContractRewards) GetRecoveryAddress() string {
+	if m != nil {
+		return m.RecoveryAddress
+	}
+	return ""
 }
 
-func (m *MsgSetFlatFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgRecoverContractRewardsResponse is the response for
+// Msg.RecoverContractRewards.
+type MsgRecoverContractRewardsResponse struct {
+	// recovered_rewards are the total rewards transferred to the
+	// recovery_address.
+	RecoveredRewards []types.Coin `protobuf:"bytes,1,rep,name=recovered_rewards,json=recoveredRewards,proto3" json:"recovered_rewards"`
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRecoverContractRewardsResponse) Reset()         { *m = MsgRecoverContractRewardsResponse{} }
+func (m *MsgRecoverContractRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRecoverContractRewardsResponse) ProtoMessage()    {}
+func (*MsgRecoverContractRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5741d3c1465c0f5, []int{20}
+}
+func (m *MsgRecoverContractRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecoverContractRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecoverContractRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRecoverContractRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecoverContractRewardsResponse.Merge(m, src)
+}
+func (m *MsgRecoverContractRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecoverContractRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecoverContractRewardsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+var xxx_messageInfo_MsgRecoverContractRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgRecoverContractRewardsResponse) GetRecoveredRewards() []types.Coin {
+	if m != nil {
+		return m.RecoveredRewards
+	}
+	return nil
+}
+
+// MsgPrepayFlatFee is the request for Msg.PrepayFlatFee.
+type MsgPrepayFlatFee struct {
+	// sender_address is the msg sender address (bech32 encoded).
+	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	// contract_address is the contract address (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// executions is the number of contract executions to prepay.
+	Executions uint64 `protobuf:"varint,3,opt,name=executions,proto3" json:"executions,omitempty"`
+}
+
+func (m *MsgPrepayFlatFee) Reset()         { *m = MsgPrepayFlatFee{} }
+func (m *MsgPrepayFlatFee) String() string { return proto.CompactTextString(m) }
+func (*MsgPrepayFlatFee) ProtoMessage()    {}
+func (*MsgPrepayFlatFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5741d3c1465c0f5, []int{21}
+}
+func (m *MsgPrepayFlatFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPrepayFlatFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPrepayFlatFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgPrepayFlatFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPrepayFlatFee.Merge(m, src)
+}
+func (m *MsgPrepayFlatFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPrepayFlatFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPrepayFlatFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPrepayFlatFee proto.InternalMessageInfo
+
+func (m *MsgPrepayFlatFee) GetSenderAddress() string {
+	if m != nil {
+		return m.SenderAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgPrepayFlatFee) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgPrepayFlatFee) GetExecutions() uint64 {
+	if m != nil {
+		return m.Executions
+	}
+	return 0
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgPrepayFlatFeeResponse is the response for Msg.PrepayFlatFee.
+type MsgPrepayFlatFeeResponse struct {
+	// paid_fees are the discounted flat fees paid for the executions.
+	PaidFees []types.Coin `protobuf:"bytes,1,rep,name=paid_fees,json=paidFees,proto3" json:"paid_fees"`
+	// credits is the total number of prepaid executions left.
+	Credits uint64 `protobuf:"varint,2,opt,name=credits,proto3" json:"credits,omitempty"`
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgPrepayFlatFeeResponse) Reset()         { *m = MsgPrepayFlatFeeResponse{} }
+func (m *MsgPrepayFlatFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPrepayFlatFeeResponse) ProtoMessage()    {}
+func (*MsgPrepayFlatFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5741d3c1465c0f5, []int{22}
 }
-func (m *MsgSetContractMetadata) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SenderAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgPrepayFlatFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPrepayFlatFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPrepayFlatFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Metadata.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgPrepayFlatFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPrepayFlatFeeResponse.Merge(m, src)
+}
+func (m *MsgPrepayFlatFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPrepayFlatFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPrepayFlatFeeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSetContractMetadataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgPrepayFlatFeeResponse proto.InternalMessageInfo
+
+func (m *MsgPrepayFlatFeeResponse) GetPaidFees() []types.Coin {
+	if m != nil {
+		return m.PaidFees
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *MsgWithdrawRewards) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RewardsAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Mode != nil {
-		n += m.Mode.Size()
+func (m *MsgPrepayFlatFeeResponse) GetCredits() uint64 {
+	if m != nil {
+		return m.Credits
 	}
-	return n
+	return 0
 }
 
-func (m *MsgWithdrawRewards_RecordsLimit_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RecordsLimit != nil {
-		l = m.RecordsLimit.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+// MsgSetFlatFeeOverride is the request for Msg.SetFlatFeeOverride.
+type MsgSetFlatFeeOverride struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// contract_address is the contract address (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// flat_fee_amount defines the flat fee charged instead of the contract
+	// owner flat fees (zero amount waives the flat fees).
+	FlatFeeAmount types.Coin `protobuf:"bytes,3,opt,name=flat_fee_amount,json=flatFeeAmount,proto3" json:"flat_fee_amount"`
+	// expiry_height defines the block height the override stops applying at.
+	ExpiryHeight int64 `protobuf:"varint,4,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
 }
-func (m *MsgWithdrawRewards_RecordIds) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RecordIds != nil {
-		l = m.RecordIds.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+
+func (m *MsgSetFlatFeeOverride) Reset()         { *m = MsgSetFlatFeeOverride{} }
+func (m *MsgSetFlatFeeOverride) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFlatFeeOverride) ProtoMessage()    {}
+func (*MsgSetFlatFeeOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5741d3c1465c0f5, []int{23}
 }
-func (m *MsgWithdrawRewards_RecordsLimit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Limit != 0 {
-		n += 1 + sovTx(uint64(m.Limit))
-	}
-	return n
+func (m *MsgSetFlatFeeOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgWithdrawRewards_RecordIDs) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Ids) > 0 {
-		l = 0
-		for _, e := range m.Ids {
-			l += sovTx(uint64(e))
+func (m *MsgSetFlatFeeOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFlatFeeOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		n += 1 + sovTx(uint64(l)) + l
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgSetFlatFeeOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFlatFeeOverride.Merge(m, src)
+}
+func (m *MsgSetFlatFeeOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFlatFeeOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFlatFeeOverride.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RecordsNum != 0 {
-		n += 1 + sovTx(uint64(m.RecordsNum))
-	}
-	if len(m.TotalRewards) > 0 {
-		for _, e := range m.TotalRewards {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+var xxx_messageInfo_MsgSetFlatFeeOverride proto.InternalMessageInfo
+
+func (m *MsgSetFlatFeeOverride) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return n
+	return ""
 }
 
-func (m *MsgSetFlatFee) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SenderAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetFlatFeeOverride) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	l = m.FlatFeeAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return ""
 }
 
-func (m *MsgSetFlatFeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetFlatFeeOverride) GetFlatFeeAmount() types.Coin {
+	if m != nil {
+		return m.FlatFeeAmount
 	}
-	var l int
-	_ = l
-	return n
+	return types.Coin{}
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetFlatFeeOverride) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
 	}
-	l = m.Params.Size()
+	return 0
+}
+
+// MsgSetFlatFeeOverrideResponse is the response for Msg.SetFlatFeeOverride.
+type MsgSetFlatFeeOverrideResponse struct {
+}
+
+func (m *MsgSetFlatFeeOverrideResponse) Reset()         { *m = MsgSetFlatFeeOverrideResponse{} }
+func (m *MsgSetFlatFeeOverrideResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFlatFeeOverrideResponse) ProtoMessage()    {}
+func (*MsgSetFlatFeeOverrideResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5741d3c1465c0f5, []int{24}
+}
+func (m *MsgSetFlatFeeOverrideResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFlatFeeOverrideResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFlatFeeOverrideResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFlatFeeOverrideResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFlatFeeOverrideResponse.Merge(m, src)
+}
+func (m *MsgSetFlatFeeOverrideResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFlatFeeOverrideResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFlatFeeOverrideResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFlatFeeOverrideResponse proto.InternalMessageInfo
+
+// MsgTransferContractOwnership is the request for
+// Msg.TransferContractOwnership.
+type MsgTransferContractOwnership struct {
+	// sender_address is the msg sender address (bech32 encoded).
+	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	// contract_address is the contract address (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// new_owner_address is the new contract owner address (bech32 encoded).
+	NewOwnerAddress string `protobuf:"bytes,3,opt,name=new_owner_address,json=newOwnerAddress,proto3" json:"new_owner_address,omitempty"`
+	// new_rewards_address is the new contract rewards address (bech32 encoded).
+	// The rewards address is kept if empty.
+	NewRewardsAddress string `protobuf:"bytes,4,opt,name=new_rewards_address,json=newRewardsAddress,proto3" json:"new_rewards_address,omitempty"`
+	// migrate_rewards_records defines whether the outstanding contract rewards
+	// records of the previous rewards address are re-pointed to the new one (if
+	// the rewards address is changed).
+	MigrateRewardsRecords bool `protobuf:"varint,5,opt,name=migrate_rewards_records,json=migrateRewardsRecords,proto3" json:"migrate_rewards_records,omitempty"`
+}
+
+func (m *MsgTransferContractOwnership) Reset()         { *m = MsgTransferContractOwnership{} }
+func (m *MsgTransferContractOwnership) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferContractOwnership) ProtoMessage()    {}
+func (*MsgTransferContractOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5741d3c1465c0f5, []int{25}
+}
+func (m *MsgTransferContractOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferContractOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferContractOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferContractOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferContractOwnership.Merge(m, src)
+}
+func (m *MsgTransferContractOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferContractOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferContractOwnership.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferContractOwnership proto.InternalMessageInfo
+
+func (m *MsgTransferContractOwnership) GetSenderAddress() string {
+	if m != nil {
+		return m.SenderAddress
+	}
+	return ""
+}
+
+func (m *MsgTransferContractOwnership) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MsgTransferContractOwnership) GetNewOwnerAddress() string {
+	if m != nil {
+		return m.NewOwnerAddress
+	}
+	return ""
+}
+
+func (m *MsgTransferContractOwnership) GetNewRewardsAddress() string {
+	if m != nil {
+		return m.NewRewardsAddress
+	}
+	return ""
+}
+
+func (m *MsgTransferContractOwnership) GetMigrateRewardsRecords() bool {
+	if m != nil {
+		return m.MigrateRewardsRecords
+	}
+	return false
+}
+
+// MsgTransferContractOwnershipResponse is the response for
+// Msg.TransferContractOwnership.
+type MsgTransferContractOwnershipResponse struct {
+	// migrated_records_num is the number of rewards records re-pointed to the
+	// new rewards address.
+	MigratedRecordsNum uint64 `protobuf:"varint,1,opt,name=migrated_records_num,json=migratedRecordsNum,proto3" json:"migrated_records_num,omitempty"`
+}
+
+func (m *MsgTransferContractOwnershipResponse) Reset()         { *m = MsgTransferContractOwnershipResponse{} }
+func (m *MsgTransferContractOwnershipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferContractOwnershipResponse) ProtoMessage()    {}
+func (*MsgTransferContractOwnershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5741d3c1465c0f5, []int{26}
+}
+func (m *MsgTransferContractOwnershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferContractOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferContractOwnershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferContractOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferContractOwnershipResponse.Merge(m, src)
+}
+func (m *MsgTransferContractOwnershipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferContractOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferContractOwnershipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferContractOwnershipResponse proto.InternalMessageInfo
+
+func (m *MsgTransferContractOwnershipResponse) GetMigratedRecordsNum() uint64 {
+	if m != nil {
+		return m.MigratedRecordsNum
+	}
+	return 0
+}
+
+// MsgResetLifetimeRewards is the request for Msg.ResetLifetimeRewards.
+type MsgResetLifetimeRewards struct {
+	// sender_address is the msg sender address (bech32 encoded): the contract
+	// owner or the module authority.
+	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	// contract_address is the contract address (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (m *MsgResetLifetimeRewards) Reset()         { *m = MsgResetLifetimeRewards{} }
+func (m *MsgResetLifetimeRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgResetLifetimeRewards) ProtoMessage()    {}
+func (*MsgResetLifetimeRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5741d3c1465c0f5, []int{27}
+}
+func (m *MsgResetLifetimeRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResetLifetimeRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetLifetimeRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResetLifetimeRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetLifetimeRewards.Merge(m, src)
+}
+func (m *MsgResetLifetimeRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResetLifetimeRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetLifetimeRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResetLifetimeRewards proto.InternalMessageInfo
+
+func (m *MsgResetLifetimeRewards) GetSenderAddress() string {
+	if m != nil {
+		return m.SenderAddress
+	}
+	return ""
+}
+
+func (m *MsgResetLifetimeRewards) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+// MsgResetLifetimeRewardsResponse is the response for
+// Msg.ResetLifetimeRewards.
+type MsgResetLifetimeRewardsResponse struct {
+	// reset_rewards are the lifetime rewards the counter had before the reset.
+	ResetRewards []types.Coin `protobuf:"bytes,1,rep,name=reset_rewards,json=resetRewards,proto3" json:"reset_rewards"`
+}
+
+func (m *MsgResetLifetimeRewardsResponse) Reset()         { *m = MsgResetLifetimeRewardsResponse{} }
+func (m *MsgResetLifetimeRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResetLifetimeRewardsResponse) ProtoMessage()    {}
+func (*MsgResetLifetimeRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5741d3c1465c0f5, []int{28}
+}
+func (m *MsgResetLifetimeRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResetLifetimeRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetLifetimeRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResetLifetimeRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetLifetimeRewardsResponse.Merge(m, src)
+}
+func (m *MsgResetLifetimeRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResetLifetimeRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetLifetimeRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResetLifetimeRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgResetLifetimeRewardsResponse) GetResetRewards() []types.Coin {
+	if m != nil {
+		return m.ResetRewards
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgSetContractMetadata)(nil), "archway.rewards.v1.MsgSetContractMetadata")
+	proto.RegisterType((*MsgSetContractMetadataResponse)(nil), "archway.rewards.v1.MsgSetContractMetadataResponse")
+	proto.RegisterType((*MsgWithdrawRewards)(nil), "archway.rewards.v1.MsgWithdrawRewards")
+	proto.RegisterType((*MsgWithdrawRewards_RecordsLimit)(nil), "archway.rewards.v1.MsgWithdrawRewards.RecordsLimit")
+	proto.RegisterType((*MsgWithdrawRewards_RecordIDs)(nil), "archway.rewards.v1.MsgWithdrawRewards.RecordIDs")
+	proto.RegisterType((*MsgWithdrawRewards_IBCUnwrap)(nil), "archway.rewards.v1.MsgWithdrawRewards.IBCUnwrap")
+	proto.RegisterType((*MsgWithdrawRewardsResponse)(nil), "archway.rewards.v1.MsgWithdrawRewardsResponse")
+	proto.RegisterType((*MsgSetFlatFee)(nil), "archway.rewards.v1.MsgSetFlatFee")
+	proto.RegisterType((*MsgSetFlatFeeResponse)(nil), "archway.rewards.v1.MsgSetFlatFeeResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "archway.rewards.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "archway.rewards.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetRewardsRatios)(nil), "archway.rewards.v1.MsgSetRewardsRatios")
+	proto.RegisterType((*MsgSetRewardsRatiosResponse)(nil), "archway.rewards.v1.MsgSetRewardsRatiosResponse")
+	proto.RegisterType((*MsgRemoveContractMetadata)(nil), "archway.rewards.v1.MsgRemoveContractMetadata")
+	proto.RegisterType((*MsgRemoveContractMetadataResponse)(nil), "archway.rewards.v1.MsgRemoveContractMetadataResponse")
+	proto.RegisterType((*MsgSetFlatFeeByCodeID)(nil), "archway.rewards.v1.MsgSetFlatFeeByCodeID")
+	proto.RegisterType((*MsgSetFlatFeeByCodeIDResponse)(nil), "archway.rewards.v1.MsgSetFlatFeeByCodeIDResponse")
+	proto.RegisterType((*MsgRebuildRewardsIndexes)(nil), "archway.rewards.v1.MsgRebuildRewardsIndexes")
+	proto.RegisterType((*MsgRebuildRewardsIndexesResponse)(nil), "archway.rewards.v1.MsgRebuildRewardsIndexesResponse")
+	proto.RegisterType((*ExtensionOptionDynamicFee)(nil), "archway.rewards.v1.ExtensionOptionDynamicFee")
+	proto.RegisterType((*ExtensionOptionFlatFeeTip)(nil), "archway.rewards.v1.ExtensionOptionFlatFeeTip")
+	proto.RegisterType((*FlatFeeTip)(nil), "archway.rewards.v1.FlatFeeTip")
+	proto.RegisterType((*MsgRecoverContractRewards)(nil), "archway.rewards.v1.MsgRecoverContractRewards")
+	proto.RegisterType((*MsgRecoverContractRewardsResponse)(nil), "archway.rewards.v1.MsgRecoverContractRewardsResponse")
+	proto.RegisterType((*MsgPrepayFlatFee)(nil), "archway.rewards.v1.MsgPrepayFlatFee")
+	proto.RegisterType((*MsgPrepayFlatFeeResponse)(nil), "archway.rewards.v1.MsgPrepayFlatFeeResponse")
+	proto.RegisterType((*MsgSetFlatFeeOverride)(nil), "archway.rewards.v1.MsgSetFlatFeeOverride")
+	proto.RegisterType((*MsgSetFlatFeeOverrideResponse)(nil), "archway.rewards.v1.MsgSetFlatFeeOverrideResponse")
+	proto.RegisterType((*MsgTransferContractOwnership)(nil), "archway.rewards.v1.MsgTransferContractOwnership")
+	proto.RegisterType((*MsgTransferContractOwnershipResponse)(nil), "archway.rewards.v1.MsgTransferContractOwnershipResponse")
+	proto.RegisterType((*MsgResetLifetimeRewards)(nil), "archway.rewards.v1.MsgResetLifetimeRewards")
+	proto.RegisterType((*MsgResetLifetimeRewardsResponse)(nil), "archway.rewards.v1.MsgResetLifetimeRewardsResponse")
+}
+
+func init() { proto.RegisterFile("archway/rewards/v1/tx.proto", fileDescriptor_d5741d3c1465c0f5) }
+
+var fileDescriptor_d5741d3c1465c0f5 = []byte{
+	// 1826 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x8a, 0xb4, 0x22, 0x3e, 0x89, 0x12, 0xb5, 0x92, 0x22, 0x6a, 0x1d, 0x53, 0x0a, 0xad,
+	0x36, 0xf2, 0x17, 0x19, 0xc9, 0x4d, 0x13, 0x18, 0x05, 0x8a, 0x48, 0xaa, 0x6a, 0x01, 0x62, 0xad,
+	0xae, 0x6d, 0xb4, 0xf0, 0x85, 0x59, 0xee, 0x8e, 0xc8, 0x41, 0xb8, 0x3b, 0xdb, 0x99, 0xa1, 0x48,
+	0xa2, 0x45, 0x11, 0xe4, 0x52, 0xa0, 0x40, 0x81, 0x5e, 0x73, 0xea, 0xb1, 0xd7, 0x1c, 0x8a, 0xde,
+	0x7a, 0xcf, 0x31, 0x2d, 0x8a, 0xa2, 0xe8, 0xc1, 0x28, 0xec, 0x43, 0x8a, 0xfe, 0x15, 0xc5, 0xcc,
+	0xce, 0x8e, 0xf8, 0xb1, 0x6b, 0x91, 0xae, 0x73, 0xe3, 0xcc, 0xfb, 0xbd, 0x8f, 0x79, 0x5f, 0xf3,
+	0x66, 0x09, 0xd7, 0x1d, 0xea, 0xb6, 0xba, 0x4e, 0xbf, 0x4a, 0x51, 0xd7, 0xa1, 0x1e, 0xab, 0x5e,
+	0xec, 0x55, 0x79, 0xaf, 0x12, 0x52, 0xc2, 0x89, 0x69, 0x2a, 0x62, 0x45, 0x11, 0x2b, 0x17, 0x7b,
+	0xd6, 0x5a, 0x93, 0x34, 0x89, 0x24, 0x57, 0xc5, 0xaf, 0x08, 0x69, 0x95, 0x5c, 0xc2, 0x7c, 0xc2,
+	0xaa, 0x0d, 0x87, 0xa1, 0xea, 0xc5, 0x5e, 0x03, 0x71, 0x67, 0xaf, 0xea, 0x12, 0x1c, 0x28, 0xfa,
+	0x86, 0xa2, 0xfb, 0xac, 0x29, 0x34, 0xf8, 0xac, 0xa9, 0x08, 0x9b, 0x11, 0xa1, 0x1e, 0x49, 0x8c,
+	0x16, 0x8a, 0xb4, 0x9d, 0x60, 0x5a, 0x6c, 0x88, 0x44, 0x94, 0xff, 0x6e, 0xc0, 0xdb, 0x35, 0xd6,
+	0x7c, 0x8c, 0xf8, 0x21, 0x09, 0x38, 0x75, 0x5c, 0x5e, 0x43, 0xdc, 0xf1, 0x1c, 0xee, 0x98, 0xdf,
+	0x81, 0x25, 0x86, 0x02, 0x0f, 0xd1, 0xba, 0xe3, 0x79, 0x14, 0x31, 0x56, 0x34, 0xb6, 0x8d, 0xdd,
+	0x9c, 0x9d, 0x8f, 0x76, 0x3f, 0x8e, 0x36, 0xcd, 0x63, 0x98, 0xf7, 0x15, 0x4b, 0x71, 0x76, 0xdb,
+	0xd8, 0x5d, 0xd8, 0xdf, 0xa9, 0x8c, 0x1f, 0xba, 0x32, 0x2a, 0xfe, 0x20, 0xfb, 0xd5, 0xf3, 0xad,
+	0x19, 0x5b, 0xf3, 0x9a, 0xdf, 0x87, 0x0d, 0x1f, 0x37, 0xa9, 0xc3, 0x51, 0x5d, 0xb1, 0xd5, 0x29,
+	0x72, 0x09, 0xf5, 0x58, 0x31, 0xb3, 0x6d, 0xec, 0xce, 0xdb, 0xeb, 0x8a, 0x6c, 0x47, 0x54, 0x3b,
+	0x22, 0x3e, 0x58, 0xfd, 0xfc, 0x9b, 0x2f, 0x6f, 0x8f, 0x58, 0x5a, 0xb6, 0xa1, 0x94, 0x7c, 0x2a,
+	0x1b, 0xb1, 0x90, 0x04, 0x0c, 0x99, 0xef, 0xc3, 0x9a, 0x92, 0xe7, 0xc5, 0x7a, 0xea, 0x41, 0xc7,
+	0x97, 0x67, 0xcc, 0xda, 0x66, 0x4c, 0x53, 0x5a, 0x7e, 0xd2, 0xf1, 0xcb, 0xbf, 0xcd, 0x82, 0x59,
+	0x63, 0xcd, 0x9f, 0x61, 0xde, 0xf2, 0xa8, 0xd3, 0x55, 0x66, 0x98, 0xef, 0xc1, 0x72, 0x6c, 0xef,
+	0xb0, 0x9f, 0x96, 0xd4, 0x76, 0xec, 0xa8, 0x67, 0x90, 0x8f, 0x15, 0xb5, 0xb1, 0x8f, 0xb9, 0xf2,
+	0xd6, 0xfd, 0x24, 0x6f, 0x8d, 0xeb, 0xa9, 0x28, 0x4b, 0x4e, 0x05, 0xeb, 0xc3, 0x19, 0x7b, 0x91,
+	0x0e, 0xac, 0xcd, 0x9f, 0x02, 0x44, 0xeb, 0x3a, 0x56, 0xfe, 0x5a, 0xd8, 0x7f, 0x7f, 0x2a, 0xc1,
+	0x27, 0x47, 0xec, 0xe1, 0x8c, 0x9d, 0x8b, 0xa4, 0x9c, 0x78, 0xcc, 0x7c, 0x1b, 0xe6, 0x3c, 0x14,
+	0x10, 0x9f, 0x15, 0xb3, 0xdb, 0x99, 0xdd, 0x9c, 0xad, 0x56, 0xe6, 0x23, 0x00, 0xdc, 0x70, 0xeb,
+	0x9d, 0xa0, 0x4b, 0x9d, 0xb0, 0x78, 0x6d, 0x2a, 0x55, 0x27, 0x07, 0x87, 0x4f, 0x25, 0x9f, 0x9d,
+	0xc3, 0x0d, 0x37, 0xfa, 0x69, 0xed, 0xc0, 0xe2, 0xe0, 0xd9, 0xcc, 0x35, 0xb8, 0x16, 0xf9, 0x27,
+	0x0a, 0x45, 0xb4, 0xb0, 0x6e, 0x40, 0x4e, 0x1b, 0x6a, 0x16, 0x20, 0x23, 0xce, 0x69, 0x6c, 0x67,
+	0x76, 0xb3, 0xb6, 0xf8, 0x69, 0x9d, 0x41, 0x4e, 0x0b, 0x37, 0x2d, 0x98, 0xa7, 0xc8, 0x45, 0xf8,
+	0x02, 0x51, 0x15, 0x0b, 0xbd, 0x16, 0xe1, 0xe2, 0xd8, 0x47, 0xa4, 0xc3, 0xeb, 0x0c, 0xb9, 0x24,
+	0xf0, 0x98, 0x8c, 0x43, 0xd6, 0x5e, 0x52, 0xdb, 0x8f, 0xa3, 0xdd, 0x07, 0x6b, 0x22, 0xaf, 0x46,
+	0x43, 0x7b, 0x30, 0x07, 0x59, 0x9f, 0x78, 0xa8, 0xfc, 0x57, 0x03, 0xac, 0xf1, 0x03, 0xea, 0xec,
+	0xda, 0x82, 0x85, 0xf1, 0xa4, 0x02, 0xaa, 0x93, 0xc9, 0x3c, 0x82, 0x3c, 0x27, 0xdc, 0x69, 0xc7,
+	0xb9, 0x5e, 0x9c, 0xdd, 0xce, 0xec, 0x2e, 0xec, 0x6f, 0x56, 0x54, 0xfd, 0x8a, 0x2e, 0x50, 0x51,
+	0x5d, 0xa0, 0x72, 0x48, 0x70, 0xa0, 0xea, 0x65, 0x51, 0x72, 0xc5, 0xb9, 0x77, 0x0a, 0x2b, 0x51,
+	0x1c, 0x42, 0x99, 0xc5, 0x91, 0xa4, 0xcc, 0x64, 0x92, 0x0a, 0x9a, 0x53, 0x49, 0x2b, 0x7f, 0x9e,
+	0x81, 0x7c, 0x54, 0x35, 0xc7, 0x6d, 0x87, 0x1f, 0x23, 0x34, 0x69, 0x0b, 0xb8, 0x05, 0x05, 0x57,
+	0xd5, 0x99, 0x06, 0xce, 0x4a, 0xe0, 0x72, 0xbc, 0x1f, 0x43, 0x7f, 0x0c, 0xcb, 0xe7, 0x6d, 0x87,
+	0xd7, 0xcf, 0x11, 0xaa, 0x3b, 0x3e, 0xe9, 0x04, 0x5c, 0x65, 0xeb, 0x95, 0xf6, 0xe6, 0xcf, 0x23,
+	0xa3, 0x3e, 0x96, 0x5c, 0xe6, 0x0f, 0x61, 0x9e, 0xb9, 0x2d, 0xe4, 0x75, 0xda, 0xa8, 0x98, 0x95,
+	0x12, 0x6e, 0x26, 0x25, 0xa1, 0x3a, 0xc9, 0x63, 0x05, 0xb5, 0x35, 0x93, 0xc8, 0x6f, 0x1f, 0xf1,
+	0x16, 0xf1, 0x64, 0x0e, 0xe7, 0x6c, 0xb5, 0x32, 0x5b, 0xa2, 0x0f, 0x05, 0x75, 0x97, 0x04, 0x4c,
+	0x5a, 0xe9, 0x77, 0xda, 0x1c, 0x87, 0x6d, 0x8c, 0x68, 0x71, 0x4e, 0x00, 0x0f, 0xf6, 0x84, 0x39,
+	0xff, 0x7a, 0xbe, 0x75, 0x3d, 0x32, 0x98, 0x79, 0x9f, 0x56, 0x30, 0xa9, 0xfa, 0x0e, 0x6f, 0x55,
+	0x4e, 0x51, 0xd3, 0x71, 0xfb, 0x47, 0xc8, 0xfd, 0xdb, 0x9f, 0xee, 0x81, 0x3a, 0xcf, 0x11, 0x72,
+	0xed, 0x35, 0x1f, 0x07, 0x87, 0x24, 0x60, 0xc7, 0x08, 0xd5, 0xb4, 0xb8, 0xe4, 0xce, 0xb5, 0x01,
+	0xeb, 0x43, 0x31, 0x88, 0x53, 0xaa, 0xfc, 0x3b, 0x03, 0x96, 0x6b, 0xac, 0xf9, 0x34, 0xf4, 0x1c,
+	0x8e, 0xce, 0x1c, 0xea, 0xf8, 0xcc, 0x7c, 0x07, 0x72, 0x4e, 0x87, 0xb7, 0x08, 0xc5, 0xbc, 0xaf,
+	0x42, 0x73, 0xb9, 0x61, 0x9e, 0xc2, 0x5c, 0x28, 0x71, 0xaa, 0xd3, 0x58, 0x49, 0x0e, 0x8a, 0x24,
+	0x1d, 0x14, 0xc5, 0xa1, 0xfe, 0xfb, 0x7c, 0xab, 0x10, 0x71, 0xdc, 0x25, 0x3e, 0xe6, 0xc8, 0x0f,
+	0x79, 0xdf, 0x56, 0x32, 0x1e, 0x2c, 0x09, 0x6b, 0x2f, 0xa5, 0x97, 0x37, 0x61, 0x63, 0xc4, 0x1c,
+	0x6d, 0xea, 0x5f, 0x66, 0x61, 0x35, 0x3a, 0x44, 0x5c, 0x17, 0x0e, 0xc7, 0xe4, 0x2a, 0x73, 0x31,
+	0x6c, 0xe0, 0x40, 0x04, 0x19, 0x93, 0xe0, 0xf2, 0x0a, 0x10, 0xcb, 0xe2, 0xec, 0xeb, 0x3a, 0x7e,
+	0x5d, 0x4b, 0x1c, 0xb4, 0xc4, 0xfc, 0x04, 0x56, 0x79, 0x4f, 0x46, 0x97, 0xa2, 0x86, 0xbc, 0x71,
+	0xa4, 0x9a, 0xcc, 0xeb, 0xaa, 0x29, 0xf0, 0x9e, 0x0c, 0x95, 0x90, 0x15, 0x69, 0xb8, 0x03, 0x2b,
+	0x8e, 0xcb, 0xf1, 0x45, 0x74, 0x9a, 0x16, 0xc2, 0xcd, 0x16, 0x97, 0x79, 0x9a, 0xb1, 0x0b, 0x97,
+	0x84, 0x87, 0x72, 0x7f, 0xcc, 0xb5, 0x37, 0xe0, 0x7a, 0x82, 0xfb, 0xb4, 0x7b, 0xff, 0x6c, 0xc0,
+	0x66, 0x8d, 0x35, 0x6d, 0xe4, 0x93, 0x0b, 0xf4, 0xba, 0xd7, 0xf6, 0x14, 0x35, 0xbb, 0x0f, 0xeb,
+	0x71, 0x38, 0x58, 0x17, 0xa1, 0x50, 0xe3, 0xa5, 0xbf, 0xec, 0x55, 0x45, 0x7c, 0x2c, 0x68, 0x8a,
+	0x27, 0x39, 0xb7, 0x31, 0xbc, 0x9b, 0x6a, 0xb7, 0x6e, 0x9d, 0x47, 0x90, 0x67, 0x5d, 0x14, 0x72,
+	0xdd, 0xcf, 0x8c, 0x09, 0x3b, 0xa3, 0xe4, 0x8a, 0x7b, 0xd9, 0x1f, 0x8d, 0x91, 0x3a, 0x3a, 0xe8,
+	0x1f, 0x12, 0x0f, 0x9d, 0x1c, 0x5d, 0x91, 0x84, 0x1b, 0xf0, 0x96, 0x4b, 0x3c, 0x54, 0xc7, 0x9e,
+	0xba, 0x16, 0xe6, 0xc4, 0xf2, 0xc4, 0x7b, 0x63, 0x8d, 0x6b, 0x2c, 0xd8, 0xa7, 0x70, 0x23, 0xd1,
+	0x50, 0xed, 0x90, 0x3b, 0xb0, 0x12, 0x47, 0x84, 0xd5, 0x3b, 0xb2, 0xde, 0x3c, 0x75, 0xa3, 0xe8,
+	0x10, 0xb2, 0xa8, 0x0e, 0xbd, 0xf2, 0x43, 0x28, 0x4a, 0x17, 0x37, 0x3a, 0xb8, 0x1d, 0x37, 0xf6,
+	0x93, 0xc0, 0x43, 0x3d, 0x74, 0x45, 0xf9, 0x8d, 0xd9, 0xf5, 0x0f, 0x03, 0xb6, 0xd3, 0x44, 0x69,
+	0xdb, 0x6e, 0x42, 0xfe, 0xd2, 0xb6, 0xcb, 0x9b, 0x6e, 0x51, 0x6f, 0x8a, 0xbb, 0xae, 0x02, 0xab,
+	0x23, 0x13, 0x9d, 0x84, 0x46, 0xfe, 0x5d, 0xa1, 0x43, 0xe3, 0x9c, 0xc0, 0xef, 0xc0, 0x12, 0xef,
+	0xe9, 0x0e, 0x20, 0xa0, 0x99, 0x48, 0x2a, 0xef, 0x29, 0x33, 0x04, 0xea, 0x43, 0x28, 0xaa, 0x1a,
+	0xf6, 0x30, 0xe3, 0x14, 0x37, 0x3a, 0xa2, 0xa2, 0x22, 0x7c, 0x56, 0xe2, 0xd7, 0x65, 0x55, 0x1e,
+	0x0d, 0x52, 0xc5, 0x1c, 0xf7, 0x2b, 0xd8, 0xfc, 0x51, 0x8f, 0xa3, 0x80, 0x61, 0x12, 0x3c, 0x0a,
+	0xc5, 0xf6, 0x51, 0x3f, 0x70, 0x7c, 0xec, 0x8a, 0x1b, 0xaf, 0x0e, 0xa6, 0xef, 0xf4, 0xea, 0x21,
+	0xc5, 0xd2, 0x0b, 0xe2, 0x87, 0x8b, 0x8a, 0xc6, 0x6b, 0x37, 0x06, 0xdf, 0xe9, 0x9d, 0x29, 0x59,
+	0x67, 0x42, 0x54, 0xf9, 0xe9, 0x98, 0x76, 0x15, 0xf7, 0x27, 0x38, 0x34, 0x3f, 0x82, 0x2c, 0xc7,
+	0x61, 0x9c, 0xf2, 0xa5, 0x57, 0x5c, 0x68, 0x4f, 0x70, 0xa8, 0xd2, 0x4b, 0x72, 0x94, 0x43, 0x80,
+	0x01, 0x39, 0x49, 0xc5, 0x6d, 0x24, 0x17, 0xf7, 0x87, 0x30, 0xa7, 0xd2, 0x79, 0xc2, 0x09, 0x44,
+	0xc1, 0xcb, 0x7f, 0x88, 0xbb, 0x90, 0x4b, 0x2e, 0x10, 0x8d, 0xcb, 0x39, 0x9e, 0x4c, 0x5e, 0x5d,
+	0x65, 0x53, 0x34, 0x9f, 0x5b, 0x50, 0xa0, 0x91, 0x8a, 0xfe, 0x48, 0xdf, 0x59, 0x8e, 0xf7, 0xe3,
+	0x9e, 0x33, 0x9a, 0xc1, 0xbf, 0x50, 0xed, 0x26, 0xc9, 0x40, 0x9d, 0xc1, 0xa7, 0xb0, 0xa2, 0xe4,
+	0x0c, 0x8c, 0x50, 0x13, 0xb6, 0x9c, 0x82, 0xe6, 0x8c, 0xdb, 0xce, 0x17, 0x06, 0x14, 0x6a, 0xac,
+	0x79, 0x46, 0x51, 0xe8, 0xf4, 0xbf, 0xbd, 0x29, 0xaa, 0x04, 0x80, 0x7a, 0xc8, 0x8d, 0x72, 0x5a,
+	0x55, 0xc7, 0xc0, 0x4e, 0x72, 0xf7, 0xa5, 0x50, 0x1c, 0x35, 0x4d, 0x7b, 0xe1, 0x07, 0x90, 0x0b,
+	0x1d, 0xec, 0x89, 0x72, 0x9a, 0xf8, 0xf4, 0xf3, 0x82, 0xe3, 0x18, 0x21, 0x66, 0x16, 0xe1, 0x2d,
+	0x97, 0x22, 0x0f, 0xf3, 0x78, 0x96, 0x8e, 0x97, 0xe5, 0xe7, 0xa3, 0x6d, 0xf8, 0xd1, 0x05, 0xa2,
+	0x14, 0x7b, 0xe8, 0xcd, 0x25, 0xc8, 0x1b, 0x9b, 0x28, 0x6f, 0x42, 0x1e, 0xf5, 0x42, 0x4c, 0xfb,
+	0xc3, 0xd7, 0xf5, 0x62, 0xb4, 0x99, 0x72, 0x55, 0x6f, 0xc1, 0x8d, 0xc4, 0xf3, 0xe9, 0xcb, 0xfa,
+	0x8b, 0x59, 0x78, 0xa7, 0xc6, 0x9a, 0x4f, 0xa8, 0x13, 0xb0, 0xf3, 0xcb, 0x34, 0x7c, 0xd4, 0x0d,
+	0x10, 0x65, 0x2d, 0x1c, 0x7e, 0x0b, 0xd9, 0x71, 0x1b, 0x56, 0x02, 0xd4, 0xad, 0x13, 0xa1, 0x62,
+	0xb4, 0x66, 0x02, 0xd4, 0x95, 0xaa, 0x63, 0x6c, 0x05, 0x56, 0x05, 0x76, 0xf4, 0x05, 0x9b, 0x95,
+	0x68, 0x21, 0xc6, 0x1e, 0x7e, 0xc4, 0xbe, 0xe2, 0x95, 0x7e, 0x6d, 0xea, 0x57, 0xfa, 0xcf, 0x61,
+	0xe7, 0x55, 0xae, 0xf9, 0x3f, 0xde, 0xea, 0x9f, 0x19, 0x72, 0x3a, 0xb5, 0x11, 0x43, 0xfc, 0x14,
+	0x9f, 0x23, 0xf1, 0xb6, 0x8b, 0x5b, 0xd3, 0x1b, 0x77, 0x78, 0xf2, 0xe1, 0x9a, 0xb0, 0x95, 0x62,
+	0xc1, 0xe0, 0xa8, 0x43, 0x05, 0x7d, 0xea, 0x51, 0x47, 0x72, 0x29, 0x69, 0xfb, 0xff, 0x59, 0x80,
+	0x4c, 0x8d, 0x35, 0xcd, 0x0e, 0xac, 0x26, 0x7d, 0xc6, 0xb9, 0x9d, 0xf2, 0x36, 0x4f, 0xc0, 0x5a,
+	0xfb, 0x93, 0x63, 0xf5, 0x21, 0x30, 0x2c, 0x8f, 0x7e, 0x12, 0xf9, 0xee, 0x64, 0x9f, 0x03, 0xac,
+	0xca, 0x64, 0x38, 0xad, 0xea, 0x19, 0xc0, 0xc0, 0xe3, 0xf4, 0xdd, 0x74, 0x63, 0x15, 0xc4, 0xba,
+	0x75, 0x25, 0x44, 0xcb, 0xfe, 0x04, 0x16, 0x87, 0x9e, 0x56, 0x37, 0x53, 0x58, 0x07, 0x41, 0xd6,
+	0x9d, 0x09, 0x40, 0x5a, 0x43, 0x1b, 0x0a, 0x63, 0x2f, 0xa2, 0xf7, 0xd2, 0x0d, 0x1c, 0x02, 0x5a,
+	0xd5, 0x09, 0x81, 0x5a, 0xdb, 0xaf, 0xe1, 0xed, 0x94, 0x07, 0xc2, 0xbd, 0x14, 0x51, 0xc9, 0x70,
+	0xeb, 0x83, 0xa9, 0xe0, 0x5a, 0x3f, 0x05, 0x33, 0x61, 0xf8, 0xbe, 0x3a, 0x20, 0x31, 0xd4, 0xda,
+	0x9b, 0x18, 0xaa, 0x75, 0xfe, 0x12, 0xd6, 0x93, 0x27, 0xdf, 0xbb, 0xa9, 0x67, 0x48, 0x40, 0x5b,
+	0xdf, 0x9b, 0x06, 0x3d, 0xec, 0xf0, 0xc4, 0x59, 0x28, 0xdd, 0xe1, 0x49, 0x70, 0xeb, 0x83, 0xa9,
+	0xe0, 0x5a, 0xbf, 0x0b, 0xf9, 0xe1, 0xb1, 0x63, 0x27, 0x45, 0xce, 0x10, 0xca, 0xba, 0x3b, 0x09,
+	0x2a, 0x39, 0xaa, 0xfa, 0x2e, 0xbf, 0x3a, 0xaa, 0x31, 0xd4, 0xda, 0x9b, 0x18, 0xaa, 0x75, 0xfe,
+	0xc6, 0x80, 0xcd, 0xf4, 0xeb, 0x33, 0xed, 0xd3, 0x63, 0x2a, 0x87, 0xf5, 0xd1, 0xb4, 0x1c, 0xda,
+	0x92, 0x1e, 0xac, 0x25, 0xde, 0x28, 0x77, 0x52, 0x23, 0x36, 0x0e, 0xb6, 0xee, 0x4f, 0x01, 0x8e,
+	0x35, 0x5b, 0xd7, 0x3e, 0xfb, 0xe6, 0xcb, 0xdb, 0xc6, 0xc1, 0xe9, 0x57, 0x2f, 0x4a, 0xc6, 0xd7,
+	0x2f, 0x4a, 0xc6, 0xbf, 0x5f, 0x94, 0x8c, 0xdf, 0xbf, 0x2c, 0xcd, 0x7c, 0xfd, 0xb2, 0x34, 0xf3,
+	0xcf, 0x97, 0xa5, 0x99, 0x67, 0xfb, 0x4d, 0xcc, 0x5b, 0x9d, 0x46, 0xc5, 0x25, 0x7e, 0x55, 0xc9,
+	0xbf, 0x17, 0x20, 0xde, 0x25, 0xf4, 0xd3, 0x78, 0x5d, 0xed, 0xe9, 0xbf, 0x01, 0x78, 0x3f, 0x44,
+	0xac, 0x31, 0x27, 0xff, 0x02, 0xb8, 0xff, 0xbf, 0x01, 0x00, 0xed, 0x4c, 0x86, 0x5c, 0xc1, 0x18,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// SetContractMetadata creates or updates an existing contract metadata.
+	// Method is authorized to the contract owner (admin if no metadata exists).
+	SetContractMetadata(ctx context.Context, in *MsgSetContractMetadata, opts ...grpc.CallOption) (*MsgSetContractMetadataResponse, error)
+	// WithdrawRewards performs collected rewards distribution.
+	// Rewards might be credited from multiple contracts (rewards_address must be
+	// set in the corresponding contract metadata).
+	WithdrawRewards(ctx context.Context, in *MsgWithdrawRewards, opts ...grpc.CallOption) (*MsgWithdrawRewardsResponse, error)
+	// SetFlatFee sets or updates or removes the flat fee to interact with the
+	// contract Method is authorized to the contract owner.
+	SetFlatFee(ctx context.Context, in *MsgSetFlatFee, opts ...grpc.CallOption) (*MsgSetFlatFeeResponse, error)
+	// UpdateParams defines a governance operation for updating the x/rewards
+	// module parameters. The authority is defined in the keeper.
+	//
+	// Since: archway v5 && cosmos-sdk 0.47
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetRewardsRatios defines a governance operation for updating the inflation
+	// rewards and tx fee rebate ratios without replacing the rest of the module
+	// parameters. The authority is defined in the keeper.
+	SetRewardsRatios(ctx context.Context, in *MsgSetRewardsRatios, opts ...grpc.CallOption) (*MsgSetRewardsRatiosResponse, error)
+	// RemoveContractMetadata removes an existing contract metadata along with
+	// the dependent state (flat fee). Outstanding contract rewards could be
+	// swept to a specified address. Method is authorized to the contract owner.
+	RemoveContractMetadata(ctx context.Context, in *MsgRemoveContractMetadata, opts ...grpc.CallOption) (*MsgRemoveContractMetadataResponse, error)
+	// SetFlatFeeByCodeID defines a governance operation for setting (or
+	// removing) the flat fee for all the contracts with metadata instantiated
+	// from the given code ID. The authority is defined in the keeper.
+	SetFlatFeeByCodeID(ctx context.Context, in *MsgSetFlatFeeByCodeID, opts ...grpc.CallOption) (*MsgSetFlatFeeByCodeIDResponse, error)
+	// RebuildRewardsIndexes defines a governance operation for regenerating all
+	// the module secondary indexes (and the contract metadata counter) from the
+	// primary state. The authority is defined in the keeper.
+	RebuildRewardsIndexes(ctx context.Context, in *MsgRebuildRewardsIndexes, opts ...grpc.CallOption) (*MsgRebuildRewardsIndexesResponse, error)
+	// RecoverContractRewards defines a governance operation for transferring the
+	// contract outstanding (rewards records) and pending (flat fees queued for
+	// the direct payout) rewards to a recovery address. The authority is defined
+	// in the keeper.
+	RecoverContractRewards(ctx context.Context, in *MsgRecoverContractRewards, opts ...grpc.CallOption) (*MsgRecoverContractRewardsResponse, error)
+	// PrepayFlatFee prepays a number of contract executions at the discounted
+	// flat fee. Prepaid executions are not charged the contract flat fee.
+	// Method is authorized to the contract owner.
+	PrepayFlatFee(ctx context.Context, in *MsgPrepayFlatFee, opts ...grpc.CallOption) (*MsgPrepayFlatFeeResponse, error)
+	// SetFlatFeeOverride defines a governance operation for overriding (capping
+	// or waiving) the contract flat fees set by the contract owner until the
+	// expiry height. The authority is defined in the keeper.
+	SetFlatFeeOverride(ctx context.Context, in *MsgSetFlatFeeOverride, opts ...grpc.CallOption) (*MsgSetFlatFeeOverrideResponse, error)
+	// TransferContractOwnership transfers the contract metadata ownership and
+	// (optionally) the rewards address at once.
+	// Method is authorized to the contract owner.
+	TransferContractOwnership(ctx context.Context, in *MsgTransferContractOwnership, opts ...grpc.CallOption) (*MsgTransferContractOwnershipResponse, error)
+	// ResetLifetimeRewards zeroes the contract lifetime rewards counter (after an
+	// ownership transfer or an accounting correction, for example).
+	// Method is authorized to the contract owner and the authority defined in
+	// the keeper.
+	ResetLifetimeRewards(ctx context.Context, in *MsgResetLifetimeRewards, opts ...grpc.CallOption) (*MsgResetLifetimeRewardsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SetContractMetadata(ctx context.Context, in *MsgSetContractMetadata, opts ...grpc.CallOption) (*MsgSetContractMetadataResponse, error) {
+	out := new(MsgSetContractMetadataResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Msg/SetContractMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawRewards(ctx context.Context, in *MsgWithdrawRewards, opts ...grpc.CallOption) (*MsgWithdrawRewardsResponse, error) {
+	out := new(MsgWithdrawRewardsResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Msg/WithdrawRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFlatFee(ctx context.Context, in *MsgSetFlatFee, opts ...grpc.CallOption) (*MsgSetFlatFeeResponse, error) {
+	out := new(MsgSetFlatFeeResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Msg/SetFlatFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetRewardsRatios(ctx context.Context, in *MsgSetRewardsRatios, opts ...grpc.CallOption) (*MsgSetRewardsRatiosResponse, error) {
+	out := new(MsgSetRewardsRatiosResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Msg/SetRewardsRatios", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveContractMetadata(ctx context.Context, in *MsgRemoveContractMetadata, opts ...grpc.CallOption) (*MsgRemoveContractMetadataResponse, error) {
+	out := new(MsgRemoveContractMetadataResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Msg/RemoveContractMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFlatFeeByCodeID(ctx context.Context, in *MsgSetFlatFeeByCodeID, opts ...grpc.CallOption) (*MsgSetFlatFeeByCodeIDResponse, error) {
+	out := new(MsgSetFlatFeeByCodeIDResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Msg/SetFlatFeeByCodeID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RebuildRewardsIndexes(ctx context.Context, in *MsgRebuildRewardsIndexes, opts ...grpc.CallOption) (*MsgRebuildRewardsIndexesResponse, error) {
+	out := new(MsgRebuildRewardsIndexesResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Msg/RebuildRewardsIndexes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RecoverContractRewards(ctx context.Context, in *MsgRecoverContractRewards, opts ...grpc.CallOption) (*MsgRecoverContractRewardsResponse, error) {
+	out := new(MsgRecoverContractRewardsResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Msg/RecoverContractRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PrepayFlatFee(ctx context.Context, in *MsgPrepayFlatFee, opts ...grpc.CallOption) (*MsgPrepayFlatFeeResponse, error) {
+	out := new(MsgPrepayFlatFeeResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Msg/PrepayFlatFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFlatFeeOverride(ctx context.Context, in *MsgSetFlatFeeOverride, opts ...grpc.CallOption) (*MsgSetFlatFeeOverrideResponse, error) {
+	out := new(MsgSetFlatFeeOverrideResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Msg/SetFlatFeeOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferContractOwnership(ctx context.Context, in *MsgTransferContractOwnership, opts ...grpc.CallOption) (*MsgTransferContractOwnershipResponse, error) {
+	out := new(MsgTransferContractOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Msg/TransferContractOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResetLifetimeRewards(ctx context.Context, in *MsgResetLifetimeRewards, opts ...grpc.CallOption) (*MsgResetLifetimeRewardsResponse, error) {
+	out := new(MsgResetLifetimeRewardsResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1.Msg/ResetLifetimeRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// SetContractMetadata creates or updates an existing contract metadata.
+	// Method is authorized to the contract owner (admin if no metadata exists).
+	SetContractMetadata(context.Context, *MsgSetContractMetadata) (*MsgSetContractMetadataResponse, error)
+	// WithdrawRewards performs collected rewards distribution.
+	// Rewards might be credited from multiple contracts (rewards_address must be
+	// set in the corresponding contract metadata).
+	WithdrawRewards(context.Context, *MsgWithdrawRewards) (*MsgWithdrawRewardsResponse, error)
+	// SetFlatFee sets or updates or removes the flat fee to interact with the
+	// contract Method is authorized to the contract owner.
+	SetFlatFee(context.Context, *MsgSetFlatFee) (*MsgSetFlatFeeResponse, error)
+	// UpdateParams defines a governance operation for updating the x/rewards
+	// module parameters. The authority is defined in the keeper.
+	//
+	// Since: archway v5 && cosmos-sdk 0.47
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetRewardsRatios defines a governance operation for updating the inflation
+	// rewards and tx fee rebate ratios without replacing the rest of the module
+	// parameters. The authority is defined in the keeper.
+	SetRewardsRatios(context.Context, *MsgSetRewardsRatios) (*MsgSetRewardsRatiosResponse, error)
+	// RemoveContractMetadata removes an existing contract metadata along with
+	// the dependent state (flat fee). Outstanding contract rewards could be
+	// swept to a specified address. Method is authorized to the contract owner.
+	RemoveContractMetadata(context.Context, *MsgRemoveContractMetadata) (*MsgRemoveContractMetadataResponse, error)
+	// SetFlatFeeByCodeID defines a governance operation for setting (or
+	// removing) the flat fee for all the contracts with metadata instantiated
+	// from the given code ID. The authority is defined in the keeper.
+	SetFlatFeeByCodeID(context.Context, *MsgSetFlatFeeByCodeID) (*MsgSetFlatFeeByCodeIDResponse, error)
+	// RebuildRewardsIndexes defines a governance operation for regenerating all
+	// the module secondary indexes (and the contract metadata counter) from the
+	// primary state. The authority is defined in the keeper.
+	RebuildRewardsIndexes(context.Context, *MsgRebuildRewardsIndexes) (*MsgRebuildRewardsIndexesResponse, error)
+	// RecoverContractRewards defines a governance operation for transferring the
+	// contract outstanding (rewards records) and pending (flat fees queued for
+	// the direct payout) rewards to a recovery address. The authority is defined
+	// in the keeper.
+	RecoverContractRewards(context.Context, *MsgRecoverContractRewards) (*MsgRecoverContractRewardsResponse, error)
+	// PrepayFlatFee prepays a number of contract executions at the discounted
+	// flat fee. Prepaid executions are not charged the contract flat fee.
+	// Method is authorized to the contract owner.
+	PrepayFlatFee(context.Context, *MsgPrepayFlatFee) (*MsgPrepayFlatFeeResponse, error)
+	// SetFlatFeeOverride defines a governance operation for overriding (capping
+	// or waiving) the contract flat fees set by the contract owner until the
+	// expiry height. The authority is defined in the keeper.
+	SetFlatFeeOverride(context.Context, *MsgSetFlatFeeOverride) (*MsgSetFlatFeeOverrideResponse, error)
+	// TransferContractOwnership transfers the contract metadata ownership and
+	// (optionally) the rewards address at once.
+	// Method is authorized to the contract owner.
+	TransferContractOwnership(context.Context, *MsgTransferContractOwnership) (*MsgTransferContractOwnershipResponse, error)
+	// ResetLifetimeRewards zeroes the contract lifetime rewards counter (after an
+	// ownership transfer or an accounting correction, for example).
+	// Method is authorized to the contract owner and the authority defined in
+	// the keeper.
+	ResetLifetimeRewards(context.Context, *MsgResetLifetimeRewards) (*MsgResetLifetimeRewardsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SetContractMetadata(ctx context.Context, req *MsgSetContractMetadata) (*MsgSetContractMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetContractMetadata not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawRewards(ctx context.Context, req *MsgWithdrawRewards) (*MsgWithdrawRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawRewards not implemented")
+}
+func (*UnimplementedMsgServer) SetFlatFee(ctx context.Context, req *MsgSetFlatFee) (*MsgSetFlatFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFlatFee not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) SetRewardsRatios(ctx context.Context, req *MsgSetRewardsRatios) (*MsgSetRewardsRatiosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRewardsRatios not implemented")
+}
+func (*UnimplementedMsgServer) RemoveContractMetadata(ctx context.Context, req *MsgRemoveContractMetadata) (*MsgRemoveContractMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveContractMetadata not implemented")
+}
+func (*UnimplementedMsgServer) SetFlatFeeByCodeID(ctx context.Context, req *MsgSetFlatFeeByCodeID) (*MsgSetFlatFeeByCodeIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFlatFeeByCodeID not implemented")
+}
+func (*UnimplementedMsgServer) RebuildRewardsIndexes(ctx context.Context, req *MsgRebuildRewardsIndexes) (*MsgRebuildRewardsIndexesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebuildRewardsIndexes not implemented")
+}
+func (*UnimplementedMsgServer) RecoverContractRewards(ctx context.Context, req *MsgRecoverContractRewards) (*MsgRecoverContractRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverContractRewards not implemented")
+}
+func (*UnimplementedMsgServer) PrepayFlatFee(ctx context.Context, req *MsgPrepayFlatFee) (*MsgPrepayFlatFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrepayFlatFee not implemented")
+}
+func (*UnimplementedMsgServer) SetFlatFeeOverride(ctx context.Context, req *MsgSetFlatFeeOverride) (*MsgSetFlatFeeOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFlatFeeOverride not implemented")
+}
+func (*UnimplementedMsgServer) TransferContractOwnership(ctx context.Context, req *MsgTransferContractOwnership) (*MsgTransferContractOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferContractOwnership not implemented")
+}
+func (*UnimplementedMsgServer) ResetLifetimeRewards(ctx context.Context, req *MsgResetLifetimeRewards) (*MsgResetLifetimeRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetLifetimeRewards not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SetContractMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetContractMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetContractMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Msg/SetContractMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetContractMetadata(ctx, req.(*MsgSetContractMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Msg/WithdrawRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawRewards(ctx, req.(*MsgWithdrawRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFlatFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFlatFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFlatFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Msg/SetFlatFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFlatFee(ctx, req.(*MsgSetFlatFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRewardsRatios_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRewardsRatios)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRewardsRatios(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Msg/SetRewardsRatios",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRewardsRatios(ctx, req.(*MsgSetRewardsRatios))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveContractMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveContractMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveContractMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Msg/RemoveContractMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveContractMetadata(ctx, req.(*MsgRemoveContractMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFlatFeeByCodeID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFlatFeeByCodeID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFlatFeeByCodeID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Msg/SetFlatFeeByCodeID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFlatFeeByCodeID(ctx, req.(*MsgSetFlatFeeByCodeID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RebuildRewardsIndexes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRebuildRewardsIndexes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RebuildRewardsIndexes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Msg/RebuildRewardsIndexes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RebuildRewardsIndexes(ctx, req.(*MsgRebuildRewardsIndexes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RecoverContractRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRecoverContractRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RecoverContractRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Msg/RecoverContractRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RecoverContractRewards(ctx, req.(*MsgRecoverContractRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PrepayFlatFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPrepayFlatFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PrepayFlatFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Msg/PrepayFlatFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PrepayFlatFee(ctx, req.(*MsgPrepayFlatFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFlatFeeOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFlatFeeOverride)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFlatFeeOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Msg/SetFlatFeeOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFlatFeeOverride(ctx, req.(*MsgSetFlatFeeOverride))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferContractOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferContractOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferContractOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Msg/TransferContractOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferContractOwnership(ctx, req.(*MsgTransferContractOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResetLifetimeRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResetLifetimeRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResetLifetimeRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1.Msg/ResetLifetimeRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResetLifetimeRewards(ctx, req.(*MsgResetLifetimeRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "archway.rewards.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetContractMetadata",
+			Handler:    _Msg_SetContractMetadata_Handler,
+		},
+		{
+			MethodName: "WithdrawRewards",
+			Handler:    _Msg_WithdrawRewards_Handler,
+		},
+		{
+			MethodName: "SetFlatFee",
+			Handler:    _Msg_SetFlatFee_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "SetRewardsRatios",
+			Handler:    _Msg_SetRewardsRatios_Handler,
+		},
+		{
+			MethodName: "RemoveContractMetadata",
+			Handler:    _Msg_RemoveContractMetadata_Handler,
+		},
+		{
+			MethodName: "SetFlatFeeByCodeID",
+			Handler:    _Msg_SetFlatFeeByCodeID_Handler,
+		},
+		{
+			MethodName: "RebuildRewardsIndexes",
+			Handler:    _Msg_RebuildRewardsIndexes_Handler,
+		},
+		{
+			MethodName: "RecoverContractRewards",
+			Handler:    _Msg_RecoverContractRewards_Handler,
+		},
+		{
+			MethodName: "PrepayFlatFee",
+			Handler:    _Msg_PrepayFlatFee_Handler,
+		},
+		{
+			MethodName: "SetFlatFeeOverride",
+			Handler:    _Msg_SetFlatFeeOverride_Handler,
+		},
+		{
+			MethodName: "TransferContractOwnership",
+			Handler:    _Msg_TransferContractOwnership_Handler,
+		},
+		{
+			MethodName: "ResetLifetimeRewards",
+			Handler:    _Msg_ResetLifetimeRewards_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "archway/rewards/v1/tx.proto",
+}
+
+func (m *MsgSetContractMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetContractMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetContractMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MigrateRewardsRecords {
+		i--
+		if m.MigrateRewardsRecords {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetContractMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetContractMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetContractMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MigratedRecordsNum != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MigratedRecordsNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IbcUnwrap != nil {
+		{
+			size, err := m.IbcUnwrap.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Mode != nil {
+		{
+			size := m.Mode.Size()
+			i -= size
+			if _, err := m.Mode.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(m.RewardsAddress) > 0 {
+		i -= len(m.RewardsAddress)
+		copy(dAtA[i:], m.RewardsAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawRewards_RecordsLimit_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRewards_RecordsLimit_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RecordsLimit != nil {
+		{
+			size, err := m.RecordsLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *MsgWithdrawRewards_RecordIds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRewards_RecordIds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RecordIds != nil {
+		{
+			size, err := m.RecordIds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *MsgWithdrawRewards_RecordsLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawRewards_RecordsLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRewards_RecordsLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawRewards_RecordIDs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawRewards_RecordIDs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRewards_RecordIDs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		dAtA6 := make([]byte, len(m.Ids)*10)
+		var j5 int
+		for _, num := range m.Ids {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintTx(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawRewards_IBCUnwrap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawRewards_IBCUnwrap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRewards_IBCUnwrap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutSeconds != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnwrappedRewards) > 0 {
+		for iNdEx := len(m.UnwrappedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnwrappedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TotalRewards) > 0 {
+		for iNdEx := len(m.TotalRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.RecordsNum != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RecordsNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFlatFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFlatFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFlatFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinConsFeeMultiplier.Size()
+		i -= size
+		if _, err := m.MinConsFeeMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Schedule != nil {
+		{
+			size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.FlatFeeAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFlatFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFlatFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFlatFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRewardsRatios) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRewardsRatios) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRewardsRatios) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.TxFeeRebateRatio.Size()
+		i -= size
+		if _, err := m.TxFeeRebateRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.InflationRewardsRatio.Size()
+		i -= size
+		if _, err := m.InflationRewardsRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRewardsRatiosResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRewardsRatiosResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRewardsRatiosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveContractMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveContractMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveContractMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardsSweepAddress) > 0 {
+		i -= len(m.RewardsSweepAddress)
+		copy(dAtA[i:], m.RewardsSweepAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardsSweepAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveContractMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveContractMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveContractMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SweptRewards) > 0 {
+		for iNdEx := len(m.SweptRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SweptRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFlatFeeByCodeID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFlatFeeByCodeID) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFlatFeeByCodeID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FlatFeeAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.CodeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CodeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFlatFeeByCodeIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFlatFeeByCodeIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFlatFeeByCodeIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractsUpdated != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractsUpdated))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRebuildRewardsIndexes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRebuildRewardsIndexes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRebuildRewardsIndexes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRebuildRewardsIndexesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRebuildRewardsIndexesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRebuildRewardsIndexesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxFeeDistributionsNum != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TxFeeDistributionsNum))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TxRewardsNum != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TxRewardsNum))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RewardsRecordsNum != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RewardsRecordsNum))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ContractsNum != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractsNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtensionOptionDynamicFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionOptionDynamicFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionOptionDynamicFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxPriorityPrice.Size()
+		i -= size
+		if _, err := m.MaxPriorityPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtensionOptionFlatFeeTip) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionOptionFlatFeeTip) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionOptionFlatFeeTip) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tips) > 0 {
+		for iNdEx := len(m.Tips) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tips[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FlatFeeTip) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlatFeeTip) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FlatFeeTip) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRecoverContractRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecoverContractRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecoverContractRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecoveryAddress) > 0 {
+		i -= len(m.RecoveryAddress)
+		copy(dAtA[i:], m.RecoveryAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecoveryAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRecoverContractRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecoverContractRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecoverContractRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecoveredRewards) > 0 {
+		for iNdEx := len(m.RecoveredRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecoveredRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPrepayFlatFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPrepayFlatFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPrepayFlatFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Executions != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Executions))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPrepayFlatFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPrepayFlatFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPrepayFlatFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Credits != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Credits))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PaidFees) > 0 {
+		for iNdEx := len(m.PaidFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PaidFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFlatFeeOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFlatFeeOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFlatFeeOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.FlatFeeAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFlatFeeOverrideResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFlatFeeOverrideResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFlatFeeOverrideResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferContractOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferContractOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferContractOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MigrateRewardsRecords {
+		i--
+		if m.MigrateRewardsRecords {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.NewRewardsAddress) > 0 {
+		i -= len(m.NewRewardsAddress)
+		copy(dAtA[i:], m.NewRewardsAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewRewardsAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NewOwnerAddress) > 0 {
+		i -= len(m.NewOwnerAddress)
+		copy(dAtA[i:], m.NewOwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwnerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferContractOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferContractOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferContractOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MigratedRecordsNum != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MigratedRecordsNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResetLifetimeRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetLifetimeRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetLifetimeRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResetLifetimeRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetLifetimeRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetLifetimeRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ResetRewards) > 0 {
+		for iNdEx := len(m.ResetRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ResetRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetContractMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.MigrateRewardsRecords {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetContractMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MigratedRecordsNum != 0 {
+		n += 1 + sovTx(uint64(m.MigratedRecordsNum))
+	}
+	return n
+}
+
+func (m *MsgWithdrawRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RewardsAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Mode != nil {
+		n += m.Mode.Size()
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.IbcUnwrap != nil {
+		l = m.IbcUnwrap.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawRewards_RecordsLimit_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecordsLimit != nil {
+		l = m.RecordsLimit.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *MsgWithdrawRewards_RecordIds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecordIds != nil {
+		l = m.RecordIds.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *MsgWithdrawRewards_RecordsLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovTx(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *MsgWithdrawRewards_RecordIDs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgWithdrawRewards_IBCUnwrap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeoutSeconds != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutSeconds))
+	}
+	return n
+}
+
+func (m *MsgWithdrawRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecordsNum != 0 {
+		n += 1 + sovTx(uint64(m.RecordsNum))
+	}
+	if len(m.TotalRewards) > 0 {
+		for _, e := range m.TotalRewards {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.UnwrappedRewards) > 0 {
+		for _, e := range m.UnwrappedRewards {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetFlatFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.FlatFeeAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Schedule != nil {
+		l = m.Schedule.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MinConsFeeMultiplier.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetFlatFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetRewardsRatios) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.InflationRewardsRatio.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TxFeeRebateRatio.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.ActivationHeight != 0 {
+		n += 1 + sovTx(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func (m *MsgSetRewardsRatiosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveContractMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RewardsSweepAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveContractMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SweptRewards) > 0 {
+		for _, e := range m.SweptRewards {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetFlatFeeByCodeID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CodeId != 0 {
+		n += 1 + sovTx(uint64(m.CodeId))
+	}
+	l = m.FlatFeeAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetFlatFeeByCodeIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractsUpdated != 0 {
+		n += 1 + sovTx(uint64(m.ContractsUpdated))
+	}
+	return n
+}
+
+func (m *MsgRebuildRewardsIndexes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRebuildRewardsIndexesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractsNum != 0 {
+		n += 1 + sovTx(uint64(m.ContractsNum))
+	}
+	if m.RewardsRecordsNum != 0 {
+		n += 1 + sovTx(uint64(m.RewardsRecordsNum))
+	}
+	if m.TxRewardsNum != 0 {
+		n += 1 + sovTx(uint64(m.TxRewardsNum))
+	}
+	if m.TxFeeDistributionsNum != 0 {
+		n += 1 + sovTx(uint64(m.TxFeeDistributionsNum))
+	}
+	return n
+}
+
+func (m *ExtensionOptionDynamicFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxPriorityPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *ExtensionOptionFlatFeeTip) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tips) > 0 {
+		for _, e := range m.Tips {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FlatFeeTip) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRecoverContractRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RecoveryAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRecoverContractRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RecoveredRewards) > 0 {
+		for _, e := range m.RecoveredRewards {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgPrepayFlatFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Executions != 0 {
+		n += 1 + sovTx(uint64(m.Executions))
+	}
+	return n
+}
+
+func (m *MsgPrepayFlatFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PaidFees) > 0 {
+		for _, e := range m.PaidFees {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Credits != 0 {
+		n += 1 + sovTx(uint64(m.Credits))
+	}
+	return n
+}
+
+func (m *MsgSetFlatFeeOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.FlatFeeAmount.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *MsgSetFlatFeeOverrideResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferContractOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewRewardsAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MigrateRewardsRecords {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgTransferContractOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MigratedRecordsNum != 0 {
+		n += 1 + sovTx(uint64(m.MigratedRecordsNum))
+	}
+	return n
+}
+
+func (m *MsgResetLifetimeRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResetLifetimeRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ResetRewards) > 0 {
+		for _, e := range m.ResetRewards {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetContractMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetContractMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetContractMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrateRewardsRecords", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MigrateRewardsRecords = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetContractMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetContractMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetContractMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigratedRecordsNum", wireType)
+			}
+			m.MigratedRecordsNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MigratedRecordsNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordsLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgWithdrawRewards_RecordsLimit{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Mode = &MsgWithdrawRewards_RecordsLimit_{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgWithdrawRewards_RecordIDs{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Mode = &MsgWithdrawRewards_RecordIds{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcUnwrap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IbcUnwrap == nil {
+				m.IbcUnwrap = &MsgWithdrawRewards_IBCUnwrap{}
+			}
+			if err := m.IbcUnwrap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawRewards_RecordsLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordsLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordsLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawRewards_RecordIDs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordIDs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordIDs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawRewards_IBCUnwrap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IBCUnwrap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IBCUnwrap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
+			}
+			m.TimeoutSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordsNum", wireType)
+			}
+			m.RecordsNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordsNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalRewards = append(m.TotalRewards, types.Coin{})
+			if err := m.TotalRewards[len(m.TotalRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnwrappedRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnwrappedRewards = append(m.UnwrappedRewards, types.Coin{})
+			if err := m.UnwrappedRewards[len(m.UnwrappedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFlatFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFlatFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFlatFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFeeAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FlatFeeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schedule == nil {
+				m.Schedule = &FlatFeeSchedule{}
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinConsFeeMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinConsFeeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFlatFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFlatFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFlatFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRewardsRatios) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRewardsRatios: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRewardsRatios: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRewardsRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRewardsRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxFeeRebateRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxFeeRebateRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRewardsRatiosResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRewardsRatiosResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRewardsRatiosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveContractMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveContractMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveContractMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsSweepAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsSweepAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveContractMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveContractMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveContractMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SweptRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SweptRewards = append(m.SweptRewards, types.Coin{})
+			if err := m.SweptRewards[len(m.SweptRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFlatFeeByCodeID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFlatFeeByCodeID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFlatFeeByCodeID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
+			}
+			m.CodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFeeAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FlatFeeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFlatFeeByCodeIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFlatFeeByCodeIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFlatFeeByCodeIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractsUpdated", wireType)
+			}
+			m.ContractsUpdated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractsUpdated |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRebuildRewardsIndexes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRebuildRewardsIndexes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRebuildRewardsIndexes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRebuildRewardsIndexesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRebuildRewardsIndexesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRebuildRewardsIndexesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractsNum", wireType)
+			}
+			m.ContractsNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractsNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsRecordsNum", wireType)
+			}
+			m.RewardsRecordsNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardsRecordsNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxRewardsNum", wireType)
+			}
+			m.TxRewardsNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxRewardsNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxFeeDistributionsNum", wireType)
+			}
+			m.TxFeeDistributionsNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxFeeDistributionsNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtensionOptionDynamicFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtensionOptionDynamicFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtensionOptionDynamicFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriorityPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriorityPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *ExtensionOptionFlatFeeTip) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtensionOptionFlatFeeTip: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtensionOptionFlatFeeTip: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tips", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tips = append(m.Tips, FlatFeeTip{})
+			if err := m.Tips[len(m.Tips)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *FlatFeeTip) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FlatFeeTip: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FlatFeeTip: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgSetContractMetadata) Unmarshal(dAtA []byte) error {
+func (m *MsgRecoverContractRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1425,19 +7012,51 @@ func (m *MsgSetContractMetadata) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetContractMetadata: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetContractMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecoverContractRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecoverContractRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1465,13 +7084,13 @@ func (m *MsgSetContractMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1481,24 +7100,23 @@ func (m *MsgSetContractMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RecoveryAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1521,7 +7139,7 @@ func (m *MsgSetContractMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetContractMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRecoverContractRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1544,12 +7162,46 @@ func (m *MsgSetContractMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetContractMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRecoverContractRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetContractMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRecoverContractRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveredRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecoveredRewards = append(m.RecoveredRewards, types.Coin{})
+			if err := m.RecoveredRewards[len(m.RecoveredRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1571,7 +7223,7 @@ func (m *MsgSetContractMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawRewards) Unmarshal(dAtA []byte) error {
+func (m *MsgPrepayFlatFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1594,15 +7246,15 @@ func (m *MsgWithdrawRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPrepayFlatFee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPrepayFlatFee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardsAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1630,13 +7282,13 @@ func (m *MsgWithdrawRewards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardsAddress = string(dAtA[iNdEx:postIndex])
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordsLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1646,32 +7298,29 @@ func (m *MsgWithdrawRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MsgWithdrawRewards_RecordsLimit{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Mode = &MsgWithdrawRewards_RecordsLimit_{v}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordIds", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
 			}
-			var msglen int
+			m.Executions = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1681,27 +7330,11 @@ func (m *MsgWithdrawRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Executions |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &MsgWithdrawRewards_RecordIDs{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Mode = &MsgWithdrawRewards_RecordIds{v}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1723,7 +7356,7 @@ func (m *MsgWithdrawRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawRewards_RecordsLimit) Unmarshal(dAtA []byte) error {
+func (m *MsgPrepayFlatFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1746,17 +7379,51 @@ func (m *MsgWithdrawRewards_RecordsLimit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordsLimit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPrepayFlatFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordsLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPrepayFlatFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaidFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaidFees = append(m.PaidFees, types.Coin{})
+			if err := m.PaidFees[len(m.PaidFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Credits", wireType)
 			}
-			m.Limit = 0
+			m.Credits = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1766,7 +7433,7 @@ func (m *MsgWithdrawRewards_RecordsLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= uint64(b&0x7F) << shift
+				m.Credits |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1792,7 +7459,7 @@ func (m *MsgWithdrawRewards_RecordsLimit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawRewards_RecordIDs) Unmarshal(dAtA []byte) error {
+func (m *MsgSetFlatFeeOverride) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1815,87 +7482,127 @@ func (m *MsgWithdrawRewards_RecordIDs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordIDs: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetFlatFeeOverride: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordIDs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetFlatFeeOverride: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				m.Ids = append(m.Ids, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFeeAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.Ids) == 0 {
-					m.Ids = make([]uint64, 0, elementCount)
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FlatFeeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Ids = append(m.Ids, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -1918,7 +7625,7 @@ func (m *MsgWithdrawRewards_RecordIDs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetFlatFeeOverrideResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1941,65 +7648,12 @@ func (m *MsgWithdrawRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetFlatFeeOverrideResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetFlatFeeOverrideResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordsNum", wireType)
-			}
-			m.RecordsNum = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RecordsNum |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalRewards", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TotalRewards = append(m.TotalRewards, types.Coin{})
-			if err := m.TotalRewards[len(m.TotalRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2021,7 +7675,7 @@ func (m *MsgWithdrawRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetFlatFee) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferContractOwnership) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2044,10 +7698,10 @@ func (m *MsgSetFlatFee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetFlatFee: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferContractOwnership: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetFlatFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferContractOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2116,9 +7770,9 @@ func (m *MsgSetFlatFee) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FlatFeeAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwnerAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2128,25 +7782,76 @@ func (m *MsgSetFlatFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FlatFeeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NewOwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRewardsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.NewRewardsAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrateRewardsRecords", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MigrateRewardsRecords = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2168,7 +7873,7 @@ func (m *MsgSetFlatFee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetFlatFeeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferContractOwnershipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2191,12 +7896,31 @@ func (m *MsgSetFlatFeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetFlatFeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferContractOwnershipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetFlatFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferContractOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigratedRecordsNum", wireType)
+			}
+			m.MigratedRecordsNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MigratedRecordsNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2218,7 +7942,7 @@ func (m *MsgSetFlatFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgResetLifetimeRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2241,15 +7965,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResetLifetimeRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResetLifetimeRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2277,13 +8001,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2293,24 +8017,23 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2333,7 +8056,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResetLifetimeRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2356,12 +8079,46 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResetLifetimeRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResetLifetimeRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResetRewards = append(m.ResetRewards, types.Coin{})
+			if err := m.ResetRewards[len(m.ResetRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])